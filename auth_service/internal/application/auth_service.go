@@ -2,9 +2,8 @@ package application
 
 import (
 	"context"
-	"fmt"
-	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/service"
+	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/db"
@@ -17,6 +16,7 @@ type AuthService struct {
 	authService   *service.AuthService
 	clientService *service.ClientService
 	userService   *service.UserService
+	keyService    *service.KeyService
 	db            db.Database
 	reqAnalyzer   req_analyzer.ReqAnalyzer
 }
@@ -25,12 +25,14 @@ func NewAuthService(
 	authService *service.AuthService,
 	clientService *service.ClientService,
 	userService *service.UserService,
+	keyService *service.KeyService,
 	db db.Database,
 	reqAnalyzer req_analyzer.ReqAnalyzer) *AuthService {
 	return &AuthService{
 		authService:   authService,
 		clientService: clientService,
 		userService:   userService,
+		keyService:    keyService,
 		db:            db,
 		reqAnalyzer:   reqAnalyzer,
 	}
@@ -83,22 +85,177 @@ func (s *AuthService) Login(ctx context.Context, req *auth.LoginRequest) (*auth.
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
 
-	var loginErr *cus_err.CusError
+	device := s.loginDevice(ctx, req.Ip, req.UserAgent)
 
 	// Begin transaction
 	ctx, err := s.db.Begin(ctx)
 	if err != nil {
 		return nil, err
 	}
+
+	// Login
+	// A risky login is denied or held back until the unusualLogin verification is passed
+	result, loginErr := s.authService.Login(ctx, req.AccessToken, req.UserId, req.Password, req.ForceLogin, device)
+
+	err = s.recordLogin(ctx, req.UserId, device, loginErr)
+	if err != nil {
+		return nil, err
+	}
+
+	// If login failed, return the error
+	if loginErr != nil {
+		cus_otel.Error(ctx, loginErr.Error())
+		return nil, loginErr
+	}
+
+	return &auth.AuthResponse{
+		AccessToken:            result.Token,
+		TokenExpireSecs:        int64(result.TokenExpireSecs),
+		RefreshToken:           result.RefreshToken,
+		RefreshTokenExpireSecs: int64(result.RefreshTokenExpireSecs),
+		PasswordExpired:        result.PasswordExpired,
+	}, nil
+}
+
+func (s *AuthService) OAuthLogin(ctx context.Context, req *auth.OAuthLoginRequest) (*auth.AuthResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	device := s.loginDevice(ctx, req.Ip, req.UserAgent)
+
+	// Begin transaction
+	ctx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Login, the user is verified by the oauth provider
+	result, loginErr := s.authService.OAuthLogin(ctx, req.AccessToken, req.UserId, req.ForceLogin, device)
+
+	err = s.recordLogin(ctx, req.UserId, device, loginErr)
+	if err != nil {
+		return nil, err
+	}
+
+	// If login failed, return the error
+	if loginErr != nil {
+		cus_otel.Error(ctx, loginErr.Error())
+		return nil, loginErr
+	}
+
+	return &auth.AuthResponse{
+		AccessToken:            result.Token,
+		TokenExpireSecs:        int64(result.TokenExpireSecs),
+		RefreshToken:           result.RefreshToken,
+		RefreshTokenExpireSecs: int64(result.RefreshTokenExpireSecs),
+		PasswordExpired:        result.PasswordExpired,
+	}, nil
+}
+
+// loginDevice analyzes the ip and the user agent of the login
+func (s *AuthService) loginDevice(ctx context.Context, ip string, userAgent string) vo.Device {
+	// Get user ip and user agent info
+	ipInfo := s.reqAnalyzer.GetIpInfo(ctx, ip)
+	userAgentInfo := s.reqAnalyzer.GetUserAgentInfo(ctx, userAgent)
+
+	return vo.Device{
+		Ip:          ipInfo.Ip,
+		Browser:     userAgentInfo.Browser,
+		BrowserVer:  userAgentInfo.BrowserVer,
+		Os:          userAgentInfo.OS,
+		Platform:    userAgentInfo.Platform,
+		IsMobile:    userAgentInfo.IsMobile,
+		IsBot:       userAgentInfo.IsBot,
+		City:        ipInfo.City,
+		Country:     ipInfo.Country,
+		CountryCode: ipInfo.CountryCode,
+		Latitude:    ipInfo.Latitude,
+		Longitude:   ipInfo.Longitude,
+		Asp:         ipInfo.Asp,
+	}
+}
+
+// recordLogin records the login attempt and ends the transaction of the login.
+// The failures which change the user, like the password fail times, are recorded in the same transaction,
+// the others are rolled back and recorded in a new transaction.
+func (s *AuthService) recordLogin(ctx context.Context, userId int64, device vo.Device, loginErr *cus_err.CusError) *cus_err.CusError {
+	var err *cus_err.CusError
+	if loginErr != nil && !service.IsLoginFailureKept(loginErr) {
+		ctx, err = s.db.Rollback(ctx)
+		if err != nil {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		ctx, err = s.db.Begin(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Every attempt is recorded, a failed one with the code of its error
+	_, err = s.authService.RecordLogin(ctx, userId, device, loginErr)
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+		}
+		return err
+	}
+
+	// Commit the transaction
+	_, err = s.db.Commit(ctx)
+	if err != nil {
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (s *AuthService) RefreshToken(ctx context.Context, req *auth.RefreshTokenRequest) (*auth.AuthResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.RefreshToken == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "missing refresh token")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Rotate refresh token
+	result, err := s.authService.RefreshToken(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auth.AuthResponse{
+		AccessToken:            result.Token,
+		TokenExpireSecs:        int64(result.TokenExpireSecs),
+		RefreshToken:           result.RefreshToken,
+		RefreshTokenExpireSecs: int64(result.RefreshTokenExpireSecs),
+	}, nil
+}
+
+func (s *AuthService) Logout(ctx context.Context, req *auth.LogoutRequest) (res *auth.Empty, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Begin transaction
+	ctx, cusErr := s.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
 	defer func() {
 		// If there is an error, rollback the transaction
-		if loginErr != nil &&
-			loginErr.Code().Int() != cus_err.AccountPasswordError &&
-			loginErr.Code().Int() != cus_err.AccountLocked {
+		if err != nil {
 			_, rollbackErr := s.db.Rollback(ctx)
 			if rollbackErr != nil {
 				cus_otel.Error(ctx, rollbackErr.Error())
-				loginErr = rollbackErr
+				err = rollbackErr
 			}
 			return
 		}
@@ -111,61 +268,391 @@ func (s *AuthService) Login(ctx context.Context, req *auth.LoginRequest) (*auth.
 		}
 	}()
 
-	// Login
-	result, loginErr := s.authService.Login(ctx, req.AccessToken, req.UserId, req.Password, false)
+	// Revoke token
+	_, cusErr = s.authService.Logout(ctx, req.AccessToken)
+	if cusErr != nil {
+		return nil, cusErr
+	}
 
-	// Get user ip and user agent info
-	ipInfo := s.reqAnalyzer.GetIpInfo(ctx, req.Ip)
-	userAgentInfo := s.reqAnalyzer.GetUserAgentInfo(ctx, req.UserAgent)
-	// check if last login record is unusual
-	isUnusual, err := s.authService.IsLoginRecordUnusual(ctx, req.UserId, ipInfo, userAgentInfo)
+	return &auth.Empty{}, nil
+}
+
+func (s *AuthService) RevokeUserTokens(ctx context.Context, req *auth.RevokeUserTokensRequest) (res *auth.RevokeUserTokensResponse, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Begin transaction
+	ctx, cusErr := s.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := s.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := s.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Revoke tokens of the user or every user of the client
+	revocation, cusErr := s.authService.RevokeUserTokens(ctx, req.ClientId, req.UserId, req.Reason, req.OperatorId)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.RevokeUserTokensResponse{
+		RevokedCount: int32(revocation.RevokedCount),
+	}, nil
+}
+
+func (s *AuthService) ListSessions(ctx context.Context, req *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Only a user token has sessions
+	payload, err := validateUserToken(ctx, s.authService, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.authService.ListSessions(ctx, *payload.UserId)
 	if err != nil {
-		cusErr := cus_err.New(cus_err.InternalServerError, "Failed to check login unusualness", err)
+		return nil, err
+	}
+
+	res := &auth.ListSessionsResponse{
+		Sessions: make([]*auth.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		res.Sessions = append(res.Sessions, &auth.Session{
+			SessionId:    session.Id,
+			Ip:           session.Device.Ip,
+			Browser:      session.Device.Browser,
+			BrowserVer:   session.Device.BrowserVer,
+			Os:           session.Device.Os,
+			Platform:     session.Device.Platform,
+			IsMobile:     session.Device.IsMobile,
+			CreateAt:     session.CreateAt.Unix(),
+			LastActiveAt: session.LastActiveAt.Unix(),
+			IsCurrent:    payload.SessionId != nil && *payload.SessionId == session.Id,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *AuthService) RevokeSession(ctx context.Context, req *auth.RevokeSessionRequest) (res *auth.Empty, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.SessionId == "" {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "missing session id")
 		cus_otel.Error(ctx, cusErr.Error())
 		return nil, cusErr
 	}
 
-	// add new login record
-	// If login failed, there will be error message
-	errMsg := ""
-	if loginErr != nil {
-		errMsg = loginErr.Error()
+	// Begin transaction
+	ctx, cusErr := s.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
 	}
-	loginRecord := &entity.LoginRecord{
-		Browser:     userAgentInfo.Browser,
-		BrowserVer:  userAgentInfo.BrowserVer,
-		Ip:          ipInfo.Ip,
-		Os:          userAgentInfo.OS,
-		Platform:    userAgentInfo.Platform,
-		Country:     ipInfo.Country,
-		CountryCode: ipInfo.CountryCode,
-		City:        ipInfo.City,
-		Asp:         ipInfo.Asp,
-		IsMobile:    userAgentInfo.IsMobile,
-		IsSuccess:   loginErr == nil,
-		ErrMessage:  errMsg,
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := s.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := s.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Only a user token has sessions
+	payload, cusErr := validateUserToken(ctx, s.authService, req.AccessToken)
+	if cusErr != nil {
+		return nil, cusErr
 	}
-	_, err = s.authService.AddLoginRecord(ctx, req.UserId, loginRecord)
+
+	_, cusErr = s.authService.RevokeSession(ctx, payload.ClientId, *payload.UserId, req.SessionId)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.Empty{}, nil
+}
+
+func (s *AuthService) GetJwks(ctx context.Context, req *auth.Empty) (*auth.JwksResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	jwks, err := s.keyService.GetJwks(ctx)
 	if err != nil {
-		cus_otel.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	// If login failed, return the error
-	if loginErr != nil {
-		cus_otel.Error(ctx, loginErr.Error())
-		return nil, loginErr
+	res := &auth.JwksResponse{
+		Keys: make([]*auth.Jwk, 0, len(jwks)),
+	}
+	for _, jwk := range jwks {
+		res.Keys = append(res.Keys, &auth.Jwk{
+			Kty: jwk.Kty,
+			Kid: jwk.Kid,
+			Use: jwk.Use,
+			Alg: jwk.Alg,
+			N:   jwk.N,
+			E:   jwk.E,
+			Crv: jwk.Crv,
+			X:   jwk.X,
+			Y:   jwk.Y,
+		})
+	}
+
+	return res, nil
+}
+
+func (s *AuthService) VerifySecondFactor(ctx context.Context, req *auth.VerifySecondFactorRequest) (res *auth.AuthResponse, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.ChallengeToken == "" || req.Code == "" {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "missing challenge token or code")
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
 	}
 
-	if isUnusual {
-		cusErr := cus_err.New(cus_err.UnusualLogin, fmt.Sprintf("Unusual login detected for user %d", req.UserId))
-		cus_otel.Warn(ctx, cusErr.Error())
+	// Begin transaction
+	ctx, cusErr := s.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := s.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := s.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	result, recoveryCodes, cusErr := s.authService.VerifySecondFactor(ctx, req.ChallengeToken, req.Code)
+	if cusErr != nil {
 		return nil, cusErr
 	}
 
 	return &auth.AuthResponse{
-		AccessToken:     result.Token,
-		TokenExpireSecs: int64(result.TokenExpireSecs),
+		AccessToken:            result.Token,
+		TokenExpireSecs:        int64(result.TokenExpireSecs),
+		RefreshToken:           result.RefreshToken,
+		RefreshTokenExpireSecs: int64(result.RefreshTokenExpireSecs),
+		RecoveryCodes:          recoveryCodes,
+		PasswordExpired:        result.PasswordExpired,
+	}, nil
+}
+
+func (s *AuthService) FindPendingLogin(ctx context.Context, req *auth.FindPendingLoginRequest) (*auth.FindPendingLoginResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.PendingLoginToken == "" {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "missing pending login token")
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	pendingLogin, cusErr := s.authService.FindPendingLogin(ctx, req.PendingLoginToken)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.FindPendingLoginResponse{
+		UserId: pendingLogin.UserId,
+	}, nil
+}
+
+func (s *AuthService) CompleteUnusualLogin(ctx context.Context, req *auth.CompleteUnusualLoginRequest) (res *auth.AuthResponse, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.PendingLoginToken == "" || req.UserId == 0 {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "missing pending login token or user id")
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Begin transaction
+	ctx, cusErr := s.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := s.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := s.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	result, cusErr := s.authService.CompleteUnusualLogin(ctx, req.PendingLoginToken, req.UserId)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.AuthResponse{
+		AccessToken:            result.Token,
+		TokenExpireSecs:        int64(result.TokenExpireSecs),
+		RefreshToken:           result.RefreshToken,
+		RefreshTokenExpireSecs: int64(result.RefreshTokenExpireSecs),
+		PasswordExpired:        result.PasswordExpired,
+	}, nil
+}
+
+func (s *AuthService) EnrollTotp(ctx context.Context, req *auth.EnrollTotpRequest) (res *auth.EnrollTotpResponse, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Begin transaction
+	ctx, cusErr := s.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := s.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := s.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Only a user can enroll an authenticator
+	payload, cusErr := validateUserToken(ctx, s.authService, req.AccessToken)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	secret, uri, cusErr := s.authService.EnrollTotp(ctx, *payload.UserId)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.EnrollTotpResponse{
+		Secret:     secret,
+		OtpauthUri: uri,
+	}, nil
+}
+
+func (s *AuthService) ConfirmTotp(ctx context.Context, req *auth.ConfirmTotpRequest) (res *auth.ConfirmTotpResponse, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.Code == "" {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "missing code")
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Begin transaction
+	ctx, cusErr := s.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := s.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := s.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Only a user can enroll an authenticator
+	payload, cusErr := validateUserToken(ctx, s.authService, req.AccessToken)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	recoveryCodes, cusErr := s.authService.ConfirmTotp(ctx, *payload.UserId, req.Code)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.ConfirmTotpResponse{
+		RecoveryCodes: recoveryCodes,
 	}, nil
 }
 
@@ -207,3 +694,19 @@ func (s *AuthService) ValidToken(ctx context.Context, req *auth.ValidTokenReques
 
 	return res, nil
 }
+
+// validateUserToken validates the token and makes sure it is a user token
+func validateUserToken(ctx context.Context, authService *service.AuthService, token string) (*vo.TokenPayload, *cus_err.CusError) {
+	payload, err := authService.ValidateToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.UserId == nil {
+		err = cus_err.New(cus_err.Unauthorized, "User token is required")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return payload, nil
+}
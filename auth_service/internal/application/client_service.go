@@ -5,6 +5,7 @@ import (
 	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/service"
 	"go_micro_service_api/auth_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/db"
 	"go_micro_service_api/pkg/enum"
@@ -59,14 +60,46 @@ func (c *ClientService) CreateClient(ctx context.Context, req *auth.CreateClient
 		return nil, cusErr
 	}
 
+	// Convert session policy, 0 means using the default policy
+	var sessionPolicy enum.SessionPolicy
+	if req.SessionPolicy != 0 {
+		sessionPolicy, cusErr = enum.SessionPolicyFromInt(int(req.SessionPolicy))
+		if cusErr != nil {
+			return nil, cusErr
+		}
+	}
+
+	// Convert signing method, 0 means using the default method
+	var signingMethod enum.SigningMethod
+	if req.SigningMethod != 0 {
+		signingMethod, cusErr = enum.SigningMethodFromId(int(req.SigningMethod))
+		if cusErr != nil {
+			return nil, cusErr
+		}
+	}
+
+	// Convert password policy, nil means no password rule
+	passwordPolicy, cusErr := toPasswordPolicy(req.PasswordPolicy)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
 	// Map request to client info
 	clientInfo := vo.ClientInfo{
-		Id:               req.ClientId,
-		MerchantId:       req.MerchantId,
-		ClientType:       clientType,
-		LoginFailedTimes: int(req.LoginFailedTimes),
-		TokenExpireSecs:  int(req.TokenExpireSecs),
-		Active:           req.IsActive,
+		Id:                     req.ClientId,
+		MerchantId:             req.MerchantId,
+		ClientType:             clientType,
+		LoginFailedTimes:       int(req.LoginFailedTimes),
+		TokenExpireSecs:        int(req.TokenExpireSecs),
+		Active:                 req.IsActive,
+		RefreshTokenExpireSecs: int(req.RefreshTokenExpireSecs),
+		SessionPolicy:          sessionPolicy,
+		MaxSessions:            int(req.MaxSessions),
+		SigningMethod:          signingMethod,
+		MfaRequired:            req.MfaRequired,
+		PasswordPolicy:         passwordPolicy,
+		LockoutPolicy:          toLockoutPolicy(req.LockoutPolicy),
+		RiskPolicy:             toRiskPolicy(req.RiskPolicy),
 	}
 
 	// Create client
@@ -107,12 +140,44 @@ func (c *ClientService) UpdateClient(ctx context.Context, req *auth.UpdateClient
 		}
 	}()
 
+	// Convert session policy, 0 means keeping the current policy
+	var sessionPolicy enum.SessionPolicy
+	if req.SessionPolicy != 0 {
+		sessionPolicy, cusErr = enum.SessionPolicyFromInt(int(req.SessionPolicy))
+		if cusErr != nil {
+			return nil, cusErr
+		}
+	}
+
+	// Convert signing method, 0 means keeping the current method
+	var signingMethod enum.SigningMethod
+	if req.SigningMethod != 0 {
+		signingMethod, cusErr = enum.SigningMethodFromId(int(req.SigningMethod))
+		if cusErr != nil {
+			return nil, cusErr
+		}
+	}
+
+	// Convert password policy, nil means keeping the current policy
+	passwordPolicy, cusErr := toPasswordPolicy(req.PasswordPolicy)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
 	// Map request to client info
 	clientInfo := vo.ClientInfo{
-		Id:               req.ClientId,
-		LoginFailedTimes: int(req.LoginFailedTimes),
-		TokenExpireSecs:  int(req.TokenExpireSecs),
-		Active:           req.IsActive,
+		Id:                     req.ClientId,
+		LoginFailedTimes:       int(req.LoginFailedTimes),
+		TokenExpireSecs:        int(req.TokenExpireSecs),
+		Active:                 req.IsActive,
+		RefreshTokenExpireSecs: int(req.RefreshTokenExpireSecs),
+		SessionPolicy:          sessionPolicy,
+		MaxSessions:            int(req.MaxSessions),
+		SigningMethod:          signingMethod,
+		MfaRequired:            req.MfaRequired,
+		PasswordPolicy:         passwordPolicy,
+		LockoutPolicy:          toLockoutPolicy(req.LockoutPolicy),
+		RiskPolicy:             toRiskPolicy(req.RiskPolicy),
 	}
 
 	// Update client
@@ -124,6 +189,54 @@ func (c *ClientService) UpdateClient(ctx context.Context, req *auth.UpdateClient
 	return &auth.Empty{}, nil
 }
 
+// toPasswordPolicy converts the password policy of the request, nil is returned if it is not set
+func toPasswordPolicy(policy *auth.PasswordPolicy) (*vo.PasswordPolicy, *cus_err.CusError) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	charClasses := make([]enum.PasswordCharClass, 0, len(policy.CharClasses))
+	for _, val := range policy.CharClasses {
+		charClass, err := enum.PasswordCharClassFromString(val)
+		if err != nil {
+			return nil, err
+		}
+		charClasses = append(charClasses, charClass)
+	}
+
+	return &vo.PasswordPolicy{
+		MinLength:       int(policy.MinLength),
+		CharClasses:     charClasses,
+		HistoryCount:    int(policy.HistoryCount),
+		MaxAgeSecs:      int(policy.MaxAgeSecs),
+		BannedPasswords: policy.BannedPasswords,
+	}, nil
+}
+
+// toLockoutPolicy converts the lockout policy of the request, nil is returned if it is not set
+func toLockoutPolicy(policy *auth.LockoutPolicy) *vo.LockoutPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &vo.LockoutPolicy{
+		LockoutSecs:    int(policy.LockoutSecs),
+		MaxLockoutSecs: int(policy.MaxLockoutSecs),
+	}
+}
+
+// toRiskPolicy converts the risk policy of the request, nil is returned if it is not set
+func toRiskPolicy(policy *auth.RiskPolicy) *vo.RiskPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &vo.RiskPolicy{
+		ChallengeScore: int(policy.ChallengeScore),
+		DenyScore:      int(policy.DenyScore),
+	}
+}
+
 func (c *ClientService) CreateRole(ctx context.Context, req *auth.CreateRoleRequest) (res *auth.Role, err error) {
 	// Start trace
 	ctx, span := cus_otel.StartTrace(ctx)
@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/domain/service"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
+type KeyService struct {
+	keyService *service.KeyService
+	db         db.Database
+}
+
+func NewKeyService(keyService *service.KeyService, db db.Database) *KeyService {
+	return &KeyService{
+		keyService: keyService,
+		db:         db,
+	}
+}
+
+// RotateDueKeys rotates the signing keys which are older than the interval,
+// the rotated keys stay valid for verification during the retention.
+// Each signing method is rotated in its own transaction, the one rotated by another instance at the same time is skipped.
+func (k *KeyService) RotateDueKeys(ctx context.Context, interval time.Duration, retention time.Duration) error {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	for _, signingMethod := range enum.AsymmetricSigningMethods {
+		cusErr := k.rotateDueKey(ctx, signingMethod, interval, retention)
+		if cusErr != nil {
+			if cusErr.Code().Int() == cus_err.Conflict {
+				cus_otel.Info(ctx, cusErr.Error())
+				continue
+			}
+			return cusErr
+		}
+	}
+
+	return nil
+}
+
+func (k *KeyService) rotateDueKey(ctx context.Context, signingMethod enum.SigningMethod, interval time.Duration, retention time.Duration) (err *cus_err.CusError) {
+	// Begin transaction
+	ctx, err = k.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := k.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := k.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	_, err = k.keyService.RotateDueKey(ctx, signingMethod, interval, retention)
+	return err
+}
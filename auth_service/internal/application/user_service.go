@@ -2,23 +2,28 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"go_micro_service_api/auth_service/internal/domain/service"
 	"go_micro_service_api/auth_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/db"
 	"go_micro_service_api/pkg/enum"
 	"go_micro_service_api/pkg/pb/gen/auth"
+	"time"
 )
 
 type UserService struct {
 	auth.UnimplementedUserServiceServer
 	userService *service.UserService
+	authService *service.AuthService
 	db          db.Database
 }
 
-func NewUserService(userService *service.UserService, db db.Database) *UserService {
+func NewUserService(userService *service.UserService, authService *service.AuthService, db db.Database) *UserService {
 	return &UserService{
 		userService: userService,
+		authService: authService,
 		db:          db,
 	}
 }
@@ -144,3 +149,443 @@ func (u *UserService) CheckAccountExistence(ctx context.Context, req *auth.Accou
 		Existence: exist,
 	}, nil
 }
+
+func (u *UserService) CreatePasswordResetToken(ctx context.Context, req *auth.CreatePasswordResetTokenRequest) (res *auth.PasswordResetTokenResponse, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Check the parameters
+	if req.UserId == 0 {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "user id is required")
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Create password reset token
+	resetToken, cusErr := u.userService.CreatePasswordResetToken(ctx, req.UserId)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.PasswordResetTokenResponse{
+		ResetToken: resetToken.Token,
+		ExpireSecs: int64(resetToken.ExpireSecs),
+	}, nil
+}
+
+func (u *UserService) ResetPassword(ctx context.Context, req *auth.ResetPasswordRequest) (res *auth.Empty, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Begin transaction
+	ctx, cusErr := u.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := u.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := u.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Reset password
+	user, cusErr := u.userService.ResetPassword(ctx, req.ResetToken, req.Password)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	// Logout every session of the user, the old password may be leaked
+	client, cusErr := user.Client(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+	_, cusErr = u.authService.RevokeUserTokens(ctx, client.Id, &user.Id, "Password reset", nil)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.Empty{}, nil
+}
+
+func (u *UserService) ChangePassword(ctx context.Context, req *auth.ChangePasswordRequest) (res *auth.Empty, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Only the user can change the password
+	payload, cusErr := validateUserToken(ctx, u.authService, req.AccessToken)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	// Begin transaction
+	ctx, cusErr = u.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := u.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := u.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Change password
+	user, cusErr := u.userService.ChangePassword(ctx, *payload.UserId, req.CurrentPassword, req.NewPassword)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	// Logout the other sessions of the user, the current session stays logged in
+	_, cusErr = u.authService.RevokeOtherSessions(ctx, payload.ClientId, user.Id, payload.SessionId, "Password changed")
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.Empty{}, nil
+}
+
+func (u *UserService) UnlockUser(ctx context.Context, req *auth.UnlockUserRequest) (res *auth.Empty, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Begin transaction
+	ctx, cusErr := u.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := u.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := u.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Unlock user
+	_, cusErr = u.userService.UnlockUser(ctx, req.ClientId, req.UserId)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.Empty{}, nil
+}
+
+func (u *UserService) DeleteUser(ctx context.Context, req *auth.DeleteUserRequest) (res *auth.Empty, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Begin transaction
+	ctx, cusErr := u.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := u.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := u.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Delete user
+	cusErr = u.userService.DeleteUser(ctx, req.ClientId, req.UserId)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.Empty{}, nil
+}
+
+func (u *UserService) PromoteKycVerifiedUser(ctx context.Context, req *auth.PromoteKycVerifiedUserRequest) (res *auth.Empty, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Begin transaction
+	ctx, cusErr := u.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := u.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := u.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Bind the KYC verified role
+	user, cusErr := u.userService.PromoteKycVerifiedUser(ctx, req.UserId)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	// The access tokens still carry the old role id, the user refreshes them to get the new one
+	cusErr = u.authService.ExpireAccessTokens(ctx, user.Id)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &auth.Empty{}, nil
+}
+
+func (u *UserService) ListLoginRecords(ctx context.Context, req *auth.ListLoginRecordsRequest) (*auth.ListLoginRecordsResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Players can only see their own login records
+	payload, err := validateUserToken(ctx, u.authService, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := toLoginRecordFilter(ctx, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := u.userService.ListUserLoginRecords(ctx, *payload.UserId, filter, vo.Pagination{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toListLoginRecordsResponse(page), nil
+}
+
+func (u *UserService) ListMerchantLoginRecords(ctx context.Context, req *auth.ListMerchantLoginRecordsRequest) (*auth.ListLoginRecordsResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	payload, err := validateUserToken(ctx, u.authService, req.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	filter, err := toLoginRecordFilter(ctx, req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	if req.UserId != 0 {
+		filter.UserId = &req.UserId
+	}
+
+	page, err := u.userService.ListMerchantLoginRecords(ctx, payload, filter, vo.Pagination{
+		Page:     int(req.Page),
+		PageSize: int(req.PageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toListLoginRecordsResponse(page), nil
+}
+
+func (u *UserService) SearchUsers(ctx context.Context, req *auth.SearchUsersRequest) (*auth.SearchUsersResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	search := vo.UserSearch{
+		AccountPrefix: req.AccountPrefix,
+		Sort:          vo.UserSort(req.Sort),
+		Ascending:     req.Ascending,
+		Limit:         int(req.Limit),
+	}
+	if len(req.UserIds) > 0 {
+		search.UserIds = req.UserIds
+	}
+	if req.Status != 0 {
+		status, err := enum.UserStatusFromInt(int(req.Status))
+		if err != nil {
+			err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("Invalid user status: %v", req.Status))
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+		search.Status = &status
+	}
+	if req.MerchantId != 0 {
+		search.MerchantId = &req.MerchantId
+	}
+	if req.ClientId != 0 {
+		search.ClientId = &req.ClientId
+	}
+	if req.CreatedFrom != 0 {
+		createdFrom := time.Unix(req.CreatedFrom, 0)
+		search.CreatedFrom = &createdFrom
+	}
+	if req.CreatedTo != 0 {
+		createdTo := time.Unix(req.CreatedTo, 0)
+		search.CreatedTo = &createdTo
+	}
+	if req.Cursor != "" {
+		cursor, err := vo.DecodeUserCursor(req.Cursor)
+		if err != nil {
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+		search.Cursor = cursor
+	}
+
+	page, err := u.userService.SearchUsers(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &auth.SearchUsersResponse{
+		Users: make([]*auth.UserSummary, 0, len(page.Users)),
+	}
+	for _, user := range page.Users {
+		res.Users = append(res.Users, &auth.UserSummary{
+			Id:         user.Id,
+			Account:    user.Account,
+			Status:     int32(user.Status),
+			RoleId:     user.RoleId,
+			RoleName:   user.RoleName,
+			ClientId:   user.ClientId,
+			MerchantId: user.MerchantId,
+			CreateAt:   user.CreatedAt.Unix(),
+		})
+	}
+	if page.NextCursor != nil {
+		res.NextCursor = page.NextCursor.Encode()
+	}
+
+	return res, nil
+}
+
+// toLoginRecordFilter converts the login record filter of the request, the zero values don't filter
+func toLoginRecordFilter(ctx context.Context, filter *auth.LoginRecordFilter) (vo.LoginRecordFilter, *cus_err.CusError) {
+	res := vo.LoginRecordFilter{}
+	if filter == nil {
+		return res, nil
+	}
+
+	if filter.StartTime != 0 {
+		startTime := time.Unix(filter.StartTime, 0)
+		res.StartTime = &startTime
+	}
+	if filter.EndTime != 0 {
+		endTime := time.Unix(filter.EndTime, 0)
+		res.EndTime = &endTime
+	}
+
+	switch filter.Result {
+	case 0:
+	case 1, 2:
+		isSuccess := filter.Result == 1
+		res.IsSuccess = &isSuccess
+	default:
+		err := cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("Invalid login result: %v", filter.Result))
+		cus_otel.Error(ctx, err.Error())
+		return res, err
+	}
+
+	res.Ip = filter.Ip
+	res.CountryCode = filter.CountryCode
+
+	return res, nil
+}
+
+func toListLoginRecordsResponse(page *vo.LoginRecordPage) *auth.ListLoginRecordsResponse {
+	res := &auth.ListLoginRecordsResponse{
+		Records:  make([]*auth.LoginRecord, 0, len(page.Records)),
+		Total:    int64(page.Total),
+		Page:     int32(page.Pagination.Page),
+		PageSize: int32(page.Pagination.PageSize),
+	}
+	for _, record := range page.Records {
+		res.Records = append(res.Records, &auth.LoginRecord{
+			Id:          record.Id,
+			UserId:      record.UserId,
+			Ip:          record.Ip,
+			Browser:     record.Browser,
+			BrowserVer:  record.BrowserVer,
+			Os:          record.Os,
+			Platform:    record.Platform,
+			IsMobile:    record.IsMobile,
+			Country:     record.Country,
+			CountryCode: record.CountryCode,
+			City:        record.City,
+			Asp:         record.Asp,
+			IsSuccess:   record.IsSuccess,
+			ErrCode:     int32(record.ErrCode),
+			ErrMessage:  record.ErrMessage,
+			CreateAt:    record.CreateAt.Unix(),
+		})
+	}
+
+	return res
+}
@@ -38,11 +38,32 @@ type (
 		AutoMigrate bool   `env:"AUTO_MIGRATE"`
 	}
 
+	SigningKey struct {
+		RotationIntervalSecs int `env:"SIGNING_KEY_ROTATION_INTERVAL_SECS"`
+		RetentionSecs        int `env:"SIGNING_KEY_RETENTION_SECS"`
+	}
+
+	Token struct {
+		Issuer     string `env:"TOKEN_ISSUER"`
+		Audience   string `env:"TOKEN_AUDIENCE"`
+		LeewaySecs int    `env:"TOKEN_LEEWAY_SECS"`
+	}
+
+	RabbitMQ struct {
+		RabbitMQUser string `env:"RABBITMQ_USER"`
+		RabbitMQPass string `env:"RABBITMQ_PASS"`
+		RabbitMQHost string `env:"RABBITMQ_HOST"`
+		RabbitMQPort int    `env:"RABBITMQ_PORT"`
+	}
+
 	Config struct {
 		Host
 		Otel
 		Redis
 		DB
+		SigningKey
+		Token
+		RabbitMQ
 	}
 )
 
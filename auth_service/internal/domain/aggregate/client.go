@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"go_micro_service_api/auth_service/internal/domain/entity"
+	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/enum"
 )
@@ -16,7 +17,23 @@ type Client struct {
 	Active           bool
 	TokenExpireSecs  int
 	LoginFailedTimes int
-	rolesLoader      func(ctx context.Context) (*map[int64]entity.Role, *cus_err.CusError)
+	// RefreshTokenExpireSecs is the lifetime of a refresh token, it is renewed on every rotation
+	RefreshTokenExpireSecs int
+	// SessionPolicy decides how many devices a user can stay logged in at the same time
+	SessionPolicy enum.SessionPolicy
+	// MaxSessions is the limit of the concurrent sessions, only used by the limited policy
+	MaxSessions int
+	// SigningMethod decides how the tokens of the client are signed, the client secret is only used by HS256
+	SigningMethod enum.SigningMethod
+	// MfaRequired forces every user of the client to pass two-factor authentication, only backend clients can require it
+	MfaRequired bool
+	// PasswordPolicy is checked whenever the password of a user is set
+	PasswordPolicy vo.PasswordPolicy
+	// LockoutPolicy decides how long a user is locked after reaching LoginFailedTimes
+	LockoutPolicy vo.LockoutPolicy
+	// RiskPolicy decides which logins are challenged or denied by their risk score
+	RiskPolicy  vo.RiskPolicy
+	rolesLoader func(ctx context.Context) (*map[int64]entity.Role, *cus_err.CusError)
 }
 
 func (c *Client) Roles(ctx context.Context) (*map[int64]entity.Role, *cus_err.CusError) {
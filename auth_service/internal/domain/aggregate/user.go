@@ -5,6 +5,7 @@ import (
 	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/enum"
+	"time"
 )
 
 type User struct {
@@ -13,11 +14,21 @@ type User struct {
 	Password              string                                                             // Hashed password
 	PasswordFailTimes     int                                                                // Number of times the user has failed to login
 	Status                enum.UserStatus                                                    // Status of the user
+	PasswordChangedAt     *time.Time                                                         // When the password is set, nil if it is set before the password policy exists
+	LockedUntil           *time.Time                                                         // When the lockout expires, nil if the user is not locked or locked until an admin unlocks it
+	LockCount             int                                                                // Number of times the user has been locked since the last successful login
 	clientLoader          func(ctx context.Context) (*Client, *cus_err.CusError)             // Lazy loader for the client
 	roleLoader            func(ctx context.Context) (*entity.Role, *cus_err.CusError)        // Lazy loader for the role
 	lastLoginRecordLoader func(ctx context.Context) (*entity.LoginRecord, *cus_err.CusError) // Lazy loader for the last login record
 }
 
+// Unlock activates the locked user and clears the failed logins.
+func (u *User) Unlock() {
+	u.Status = enum.UserStatusType.Active
+	u.PasswordFailTimes = 0
+	u.LockedUntil = nil
+}
+
 func (u *User) SetRoleLoader(loader func(ctx context.Context) (*entity.Role, *cus_err.CusError)) {
 	u.roleLoader = loader
 }
@@ -1,13 +1,13 @@
 package entity
 
 import (
-	"context"
 	"time"
 )
 
 // LoginRecord represents a record of a user's login.
 type LoginRecord struct {
 	Id          int64
+	UserId      int64 // Only set by the queries which load the user
 	Browser     string
 	BrowserVer  string
 	Ip          string
@@ -17,36 +17,11 @@ type LoginRecord struct {
 	CountryCode string
 	City        string
 	Asp         string
+	Latitude    float64
+	Longitude   float64
 	IsMobile    bool
 	IsSuccess   bool
 	CreateAt    time.Time
+	ErrCode     int // The cus_err code of the failure, 0 when the login succeeds
 	ErrMessage  string
 }
-
-func NewLoginRecord(ctx context.Context, userId int64, isSuccess bool) *LoginRecord {
-	r := &LoginRecord{}
-
-	if browser, ok := ctx.Value("browser").(string); ok {
-		r.Browser = browser
-	}
-
-	if ip, ok := ctx.Value("ip").(string); ok {
-		r.Ip = ip
-	}
-
-	if os, ok := ctx.Value("os").(string); ok {
-		r.Os = os
-	}
-
-	if country, ok := ctx.Value("country").(string); ok {
-		r.Country = country
-	}
-
-	if city, ok := ctx.Value("city").(string); ok {
-		r.City = city
-	}
-
-	r.IsSuccess = isSuccess
-
-	return r
-}
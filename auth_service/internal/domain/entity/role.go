@@ -91,14 +91,14 @@ var BackendRoles = struct {
 	Admin: Role{
 		Id:          101,
 		Name:        "Admin",
-		Permissions: []enum.Permission{}, // TODO: Add more backend permissions here
+		Permissions: []enum.Permission{enum.PermissionType.SearchUser, enum.PermissionType.ViewLoginRecord}, // TODO: Add more backend permissions here
 		isSystem:    true,
 		ClientType:  enum.ClientType.Backend,
 	},
 	CustomerSupport: Role{
 		Id:          102,
 		Name:        "CustomerSupport",
-		Permissions: []enum.Permission{}, // TODO: Add more backend permissions here
+		Permissions: []enum.Permission{enum.PermissionType.SearchUser, enum.PermissionType.ViewLoginRecord}, // TODO: Add more backend permissions here
 		isSystem:    true,
 		ClientType:  enum.ClientType.Backend,
 	},
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
+// SigningKey is an asymmetric key pair used to sign the tokens.
+//
+// Only the active key of a signing method signs new tokens,
+// a rotated key stays valid for verification until it expires.
+type SigningKey struct {
+	Id            int64
+	Kid           string
+	SigningMethod enum.SigningMethod
+	PrivateKey    string `json:"-"` // PrivateKey is never cached
+	PublicKey     string
+	Active        bool
+	RotatedAt     *time.Time // RotatedAt is nil when the key is active
+	ExpireAt      *time.Time // ExpireAt is nil when the key is active
+	CreateAt      time.Time
+}
+
+// IsExpired checks the key can't be used to verify tokens at the given time
+func (k *SigningKey) IsExpired(now time.Time) bool {
+	return k.ExpireAt != nil && !now.Before(*k.ExpireAt)
+}
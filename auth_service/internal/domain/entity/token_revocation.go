@@ -0,0 +1,24 @@
+package entity
+
+import "time"
+
+type RevocationScope string
+
+const (
+	RevocationScopeLogout  RevocationScope = "logout"  // The user logs out the current session
+	RevocationScopeSession RevocationScope = "session" // The user ends another session of the same account
+	RevocationScopeUser    RevocationScope = "user"    // A user is forced to logout, by admin or password reset
+	RevocationScopeClient  RevocationScope = "client"  // Admin forces every user of a client to logout
+)
+
+// TokenRevocation is the record of revoked tokens.
+type TokenRevocation struct {
+	Id           int64
+	ClientId     int64
+	UserId       *int64 // UserId is nil when every user of the client is revoked
+	Scope        RevocationScope
+	Reason       string
+	OperatorId   *int64 // OperatorId is nil when the user revokes the own tokens
+	RevokedCount int
+	CreateAt     time.Time
+}
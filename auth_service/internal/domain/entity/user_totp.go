@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// UserTotp is the TOTP authenticator of a user.
+type UserTotp struct {
+	Id            int64
+	UserId        int64
+	Secret        string     `json:"-"` // Base32 encoded secret shared with the authenticator app
+	Enabled       bool       // The secret is pending until the user confirms it with the first code
+	RecoveryCodes []string   `json:"-"` // Hashed recovery codes, a code is removed once it's used
+	EnabledAt     *time.Time // EnabledAt is nil until the secret is confirmed
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/domain/entity"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+)
+
+type SigningKeyRepo interface {
+	Create(ctx context.Context, key *entity.SigningKey) (*entity.SigningKey, *cus_err.CusError)
+	Retire(ctx context.Context, key *entity.SigningKey) *cus_err.CusError
+	FindActive(ctx context.Context, signingMethod enum.SigningMethod) ([]*entity.SigningKey, *cus_err.CusError)
+	FindByKid(ctx context.Context, kid string) (*entity.SigningKey, *cus_err.CusError)
+	FindUnexpired(ctx context.Context) ([]*entity.SigningKey, *cus_err.CusError)
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_err"
+	"time"
+)
+
+type TokenRepo interface {
+	SaveRefreshToken(ctx context.Context, refreshToken *vo.RefreshToken) *cus_err.CusError
+	FindRefreshToken(ctx context.Context, token string) (*vo.RefreshToken, *cus_err.CusError)
+	ConsumeRefreshToken(ctx context.Context, refreshToken *vo.RefreshToken) (bool, *cus_err.CusError)
+	CreateSession(ctx context.Context, session *vo.Session, limit vo.SessionLimit) ([]string, *cus_err.CusError)
+	UpdateSession(ctx context.Context, session *vo.Session) *cus_err.CusError
+	FindSession(ctx context.Context, userId int64, sessionId string) (*vo.Session, *cus_err.CusError)
+	FindSessions(ctx context.Context, userId int64) ([]*vo.Session, *cus_err.CusError)
+	DeleteSession(ctx context.Context, userId int64, sessionId string) *cus_err.CusError
+	SaveChallenge(ctx context.Context, challenge *vo.SecondFactorChallenge) *cus_err.CusError
+	FindChallenge(ctx context.Context, token string) (*vo.SecondFactorChallenge, *cus_err.CusError)
+	DeleteChallenge(ctx context.Context, token string) *cus_err.CusError
+	FailChallenge(ctx context.Context, token string, maxAttempts int) (int, *cus_err.CusError)
+	SaveTotpStep(ctx context.Context, userId int64, step int64, expiration time.Duration) (bool, *cus_err.CusError)
+	SavePasswordResetToken(ctx context.Context, resetToken *vo.PasswordResetToken) *cus_err.CusError
+	ConsumePasswordResetToken(ctx context.Context, token string) (*vo.PasswordResetToken, *cus_err.CusError)
+	SavePendingLogin(ctx context.Context, pendingLogin *vo.PendingLogin) *cus_err.CusError
+	FindPendingLogin(ctx context.Context, token string) (*vo.PendingLogin, *cus_err.CusError)
+	ConsumePendingLogin(ctx context.Context, token string) (*vo.PendingLogin, *cus_err.CusError)
+}
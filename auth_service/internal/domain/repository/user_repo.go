@@ -4,6 +4,7 @@ import (
 	"context"
 	"go_micro_service_api/auth_service/internal/domain/aggregate"
 	"go_micro_service_api/auth_service/internal/domain/entity"
+	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/pkg/cus_err"
 )
 
@@ -11,8 +12,20 @@ type UserRepo interface {
 	Find(ctx context.Context, id int64) (*aggregate.User, *cus_err.CusError)
 	Create(ctx context.Context, clientId int64, user *aggregate.User) (*aggregate.User, *cus_err.CusError)
 	Update(ctx context.Context, user *aggregate.User) (*aggregate.User, *cus_err.CusError)
+	Delete(ctx context.Context, userId int64) *cus_err.CusError
 	AddLoginRecord(ctx context.Context, userId int64, loginRecord *entity.LoginRecord) (*entity.LoginRecord, *cus_err.CusError)
 	BindRole(ctx context.Context, userId int64, roleId int64) (*aggregate.User, *cus_err.CusError)
 	CheckAccountExistence(ctx context.Context, account string) (bool, *cus_err.CusError)
-	GetLastLoginRecord(ctx context.Context, userId int64) (*entity.LoginRecord, *cus_err.CusError)
+	GetLastSuccessfulLoginRecord(ctx context.Context, userId int64) (*entity.LoginRecord, *cus_err.CusError)
+	FindRecentLoginRecords(ctx context.Context, userId int64, limit int) ([]*entity.LoginRecord, *cus_err.CusError)
+	FindLoginRecords(ctx context.Context, filter vo.LoginRecordFilter, pagination vo.Pagination) (*vo.LoginRecordPage, *cus_err.CusError)
+	FindUserIdsByClient(ctx context.Context, clientId int64) ([]int64, *cus_err.CusError)
+	SearchUsers(ctx context.Context, search vo.UserSearch) ([]*vo.UserSummary, *cus_err.CusError)
+	AddTokenRevocation(ctx context.Context, revocation *entity.TokenRevocation) (*entity.TokenRevocation, *cus_err.CusError)
+	FindTotp(ctx context.Context, userId int64) (*entity.UserTotp, *cus_err.CusError)
+	SaveTotp(ctx context.Context, totp *entity.UserTotp) (*entity.UserTotp, *cus_err.CusError)
+	AddPasswordHistory(ctx context.Context, userId int64, hashedPassword string) *cus_err.CusError
+	FindPasswordHistory(ctx context.Context, userId int64, limit int) ([]string, *cus_err.CusError)
+	AddTrustedDevice(ctx context.Context, userId int64, device vo.Device) *cus_err.CusError
+	IsTrustedDevice(ctx context.Context, userId int64, device vo.Device) (bool, *cus_err.CusError)
 }
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/aggregate"
 	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/repository"
 	"go_micro_service_api/auth_service/internal/domain/vo"
@@ -12,7 +13,8 @@ import (
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/db"
 	"go_micro_service_api/pkg/enum"
-	"go_micro_service_api/pkg/req_analyzer"
+	"math"
+	"sort"
 	"time"
 )
 
@@ -20,21 +22,42 @@ import (
 type AuthService struct {
 	clientRepo  repository.ClientRepo
 	userRepo    repository.UserRepo
+	tokenRepo   repository.TokenRepo
+	eventRepo   repository.EventRepo
+	keyService  *KeyService
+	riskEngine  *RiskEngine
 	tokenHelper token_helper.TokenHelper
 	cache       db.Cache
 	crypto      cus_crypto.CusCrypto
 }
 
-const TokenPrefix = "token"
+const (
+	TokenPrefix = "token"
+
+	// refreshTokenLength is the number of random bytes of a refresh token
+	refreshTokenLength = 32
+	// sessionIdLength is the number of random bytes of a session id
+	sessionIdLength = 16
+	// tokenIdLength is the number of random bytes of a token id (jti)
+	tokenIdLength = 16
+)
 
 func NewAuthService(
 	clientRepo repository.ClientRepo,
 	userRepo repository.UserRepo,
+	tokenRepo repository.TokenRepo,
+	eventRepo repository.EventRepo,
+	keyService *KeyService,
+	riskEngine *RiskEngine,
 	cache db.Cache,
 	helper token_helper.TokenHelper) *AuthService {
 	return &AuthService{
 		clientRepo:  clientRepo,
 		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		eventRepo:   eventRepo,
+		keyService:  keyService,
+		riskEngine:  riskEngine,
 		tokenHelper: helper,
 		cache:       cache,
 		crypto:      cus_crypto.New(),
@@ -61,8 +84,17 @@ func (a *AuthService) CreateClientToken(ctx context.Context, clientId int64) (*v
 	}
 
 	// Create token
-	payload := vo.NewTokenPayload(client.MerchantId, client.Id)
-	token, err := a.tokenHelper.Create(ctx, client.Secret, payload.ToMap())
+	tokenId, err := a.newTokenId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	payload := vo.NewTokenPayload(
+		client.MerchantId,
+		client.Id,
+		vo.WithExpireSecs(client.TokenExpireSecs),
+		vo.WithTokenId(tokenId),
+	)
+	token, err := a.signToken(ctx, client, payload.ToMap())
 	if err != nil {
 		return nil, err
 	}
@@ -88,8 +120,111 @@ func (a *AuthService) CreateClientToken(ctx context.Context, clientId int64) (*v
 // Login authenticates a user with the provided token (containing 'cid'), user ID, and password.
 // It returns a new token upon successful login.
 // When login is successful , the old token is going to delete from cache.
-// Regardless of success or failure, a login record is created.
-func (a *AuthService) Login(ctx context.Context, token string, userId int64, password string, forceLogin bool) (*vo.LoginTokenList, *cus_err.CusError) {
+// Regardless of success or failure, the caller records the attempt by RecordLogin.
+func (a *AuthService) Login(
+	ctx context.Context,
+	token string,
+	userId int64,
+	password string,
+	forceLogin bool,
+	device vo.Device,
+) (*vo.LoginTokenList, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Find user by id
+	user, err := a.userRepo.Find(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	client, key, err := a.validateLoginClient(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// A lockout is lifted once it expires
+	now := time.Now()
+	unlocked, err := a.checkLoginUser(ctx, user, now)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check user password is correct
+	if !a.crypto.CompareHashAndPassword(ctx, user.Password, password) {
+		// Increase login error count
+		user.PasswordFailTimes += 1
+
+		// Create password error with remaining times
+		data := map[string]interface{}{
+			"errorCount":    user.PasswordFailTimes,
+			"totalAttempts": client.LoginFailedTimes,
+		}
+
+		// If password failed time is more than client login failed times, then lock user,
+		// the lockout gets longer every time the user is locked again before logging in successfully
+		if user.PasswordFailTimes >= client.LoginFailedTimes {
+			user.Status = enum.UserStatusType.Locked
+			user.LockCount += 1
+			user.LockedUntil = client.LockoutPolicy.LockedUntil(user.LockCount, now)
+			if user.LockedUntil != nil {
+				data["lockedUntil"] = user.LockedUntil.Unix()
+			}
+		}
+
+		err := cus_err.New(cus_err.AccountPasswordError, "Invalid password").WithData(data)
+		cus_otel.Error(ctx, err.Error())
+
+		// Update user
+		_, updateErr := a.userRepo.Update(ctx, user)
+		if updateErr != nil {
+			cus_otel.Error(ctx, updateErr.Error())
+			return nil, err
+		}
+		if user.Status == enum.UserStatusType.Locked {
+			updateErr = a.eventRepo.AddEvents(ctx, vo.NewUserLockedEvent(vo.UserLockedPayload{
+				UserId:      user.Id,
+				ClientId:    client.Id,
+				LockCount:   user.LockCount,
+				LockedUntil: user.LockedUntil,
+			}))
+			if updateErr != nil {
+				return nil, err
+			}
+		}
+		return &vo.LoginTokenList{
+			Token:           token,
+			TokenExpireSecs: client.TokenExpireSecs,
+			ErrorCount:      user.PasswordFailTimes,
+			TotalAttempts:   client.LoginFailedTimes,
+		}, err
+	}
+	if unlocked || user.PasswordFailTimes != 0 || user.LockCount != 0 {
+		// PasswordFailedTime and the lockout escalation are reset
+		user.PasswordFailTimes = 0
+		user.LockCount = 0
+		// Update
+		_, updateErr := a.userRepo.Update(ctx, user)
+		if updateErr != nil {
+			cus_otel.Error(ctx, updateErr.Error())
+			return nil, err
+		}
+	}
+
+	return a.continueLogin(ctx, client, user, key, forceLogin, device)
+}
+
+// OAuthLogin logs in the user whose identity is verified by an oauth provider, the password of the user isn't checked.
+// The caller must verify the oauth token and find the user bound to its openID before.
+// The lockout, the risk assessment and the second factor apply as they do to Login.
+func (a *AuthService) OAuthLogin(
+	ctx context.Context,
+	token string,
+	userId int64,
+	forceLogin bool,
+	device vo.Device,
+) (*vo.LoginTokenList, *cus_err.CusError) {
 	// Start trace
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
@@ -100,35 +235,253 @@ func (a *AuthService) Login(ctx context.Context, token string, userId int64, pas
 		return nil, err
 	}
 
+	client, key, err := a.validateLoginClient(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	// The openID isn't bound to a client, so the user of another client can't login with it
+	userClient, err := user.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userClient.Id != client.Id {
+		err = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("User id: %v not found in client id: %v", user.Id, client.Id))
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	unlocked, err := a.checkLoginUser(ctx, user, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if unlocked {
+		user.PasswordFailTimes = 0
+		user.LockCount = 0
+		_, err = a.userRepo.Update(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return a.continueLogin(ctx, client, user, key, forceLogin, device)
+}
+
+// validateLoginClient validates the client token of the login, and returns its client and the cache key of the token
+func (a *AuthService) validateLoginClient(ctx context.Context, token string) (*aggregate.Client, string, *cus_err.CusError) {
 	// Validate client token
 	claims, err := a.GetTokenPayload(ctx, token)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Get client, this is going to find from cache first and then from database
 	client, err := a.clientRepo.Find(ctx, claims.ClientId)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Validate token
-	_, err = a.tokenHelper.Validate(ctx, token, client.Secret)
+	_, err = a.verifyToken(ctx, client, token)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// Check token is in cache
-	key := ""
-	if claims.UserId != nil {
-		key = fmt.Sprintf("%s:%d", TokenPrefix, *claims.UserId)
-	} else {
-		key = fmt.Sprintf("%s:%s", TokenPrefix, token)
-	}
+	key := a.tokenKey(claims, token)
 	cacheToken, err := a.cache.Get(ctx, key)
 	if err != nil || cacheToken != token {
 		err = cus_err.New(cus_err.TokenExpired, "Token is expired")
 		cus_otel.Error(ctx, err.Error())
+		return nil, "", err
+	}
+
+	// Check client is active
+	if !client.Active {
+		err = cus_err.New(cus_err.ClientInactive, fmt.Sprintf("Client id: %v is not active", client.Id))
+		cus_otel.Error(ctx, err.Error())
+		return nil, "", err
+	}
+
+	return client, key, nil
+}
+
+// checkLoginUser checks the user can login, an expired lockout is lifted and true is returned then.
+func (a *AuthService) checkLoginUser(ctx context.Context, user *aggregate.User, now time.Time) (bool, *cus_err.CusError) {
+	unlocked := false
+	if user.Status == enum.UserStatusType.Locked && user.LockedUntil != nil && !now.Before(*user.LockedUntil) {
+		user.Unlock()
+		unlocked = true
+	}
+
+	// Check user status
+	if user.Status != enum.UserStatusType.Active {
+		err := a.accountLockedError(user, now)
+		cus_otel.Error(ctx, err.Error())
+		return false, err
+	}
+
+	return unlocked, nil
+}
+
+// continueLogin continues the login of the authenticated user, the risky login is denied or held back,
+// and the user who needs the second factor is challenged. Otherwise the login is completed.
+func (a *AuthService) continueLogin(
+	ctx context.Context,
+	client *aggregate.Client,
+	user *aggregate.User,
+	key string,
+	forceLogin bool,
+	device vo.Device,
+) (*vo.LoginTokenList, *cus_err.CusError) {
+	// Assess the risk of the login, the client decides which score is challenged or denied
+	assessment, loginErr := a.riskEngine.Assess(ctx, client, user.Id, device)
+	if loginErr != nil {
+		return nil, loginErr
+	}
+	if assessment.Decision == vo.RiskDecisionDeny {
+		loginErr = cus_err.New(cus_err.LoginDenied, fmt.Sprintf("Login of user %d is denied", user.Id))
+		cus_otel.Warn(ctx, loginErr.Error())
+		return nil, loginErr
+	}
+
+	// Users with an authenticator, or users of clients requiring it, have to pass the second factor,
+	// the login is completed by VerifySecondFactor then, it also covers the challenged login
+	required, loginErr := a.isSecondFactorRequired(ctx, client, user.Id)
+	if loginErr != nil {
+		return nil, loginErr
+	}
+	if required {
+		return nil, a.challengeSecondFactor(ctx, client, user, key, forceLogin, device)
+	}
+
+	// A challenged login is completed after the user passes the unusualLogin verification
+	if assessment.Decision == vo.RiskDecisionChallenge {
+		return nil, a.holdUnusualLogin(ctx, client, user, key, forceLogin, device)
+	}
+
+	return a.completeLogin(ctx, client, user, key, forceLogin, device)
+}
+
+// accountLockedError returns the error of a user who can't login, the lock expiry is returned
+// in the error data when the user is locked for a period of time.
+func (a *AuthService) accountLockedError(user *aggregate.User, now time.Time) *cus_err.CusError {
+	err := cus_err.New(cus_err.AccountLocked, fmt.Sprintf("User id: %v is not active", user.Id))
+	if user.Status == enum.UserStatusType.Locked && user.LockedUntil != nil {
+		err = err.WithData(map[string]interface{}{
+			"lockedUntil":   user.LockedUntil.Unix(),
+			"remainingSecs": int64(math.Ceil(user.LockedUntil.Sub(now).Seconds())),
+		})
+	}
+	return err
+}
+
+// completeLogin starts a new session of the user and deletes the client token used to login.
+func (a *AuthService) completeLogin(
+	ctx context.Context,
+	client *aggregate.Client,
+	user *aggregate.User,
+	clientTokenKey string,
+	forceLogin bool,
+	device vo.Device,
+) (*vo.LoginTokenList, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Every login starts a new session, the session policy of the client is checked with the creation atomically.
+	// The session is created before the old token is deleted, so the login rejected by the policy can be forced with the same token
+	session, evictedSessionIds, loginErr := a.createSession(ctx, client, user.Id, forceLogin, device)
+	if loginErr != nil {
+		return nil, loginErr
+	}
+
+	// Delete old token from cache
+	loginErr = a.cache.Delete(ctx, clientTokenKey)
+	if loginErr != nil {
+		// The token is used by another login, the new session is dropped
+		if revokeErr := a.revokeSession(ctx, user.Id, session.Id); revokeErr != nil {
+			cus_otel.Error(ctx, revokeErr.Error())
+		}
+		cusErr := cus_err.New(cus_err.TokenExpired, "Token is expired", loginErr)
+		cus_otel.Error(ctx, cusErr.Error())
+		loginErr = cusErr
+		return nil, loginErr
+	}
+
+	// Create new token
+	newToken, loginErr := a.createUserToken(ctx, client, user, session.Id)
+	if loginErr != nil {
+		return nil, loginErr
+	}
+	refreshToken, loginErr := a.createRefreshToken(ctx, client, user.Id, session.Id)
+	if loginErr != nil {
+		return nil, loginErr
+	}
+
+	// Revoke the access tokens of the sessions replaced by the new session
+	for _, sessionId := range evictedSessionIds {
+		loginErr = a.revokeSession(ctx, user.Id, sessionId)
+		if loginErr != nil {
+			return nil, loginErr
+		}
+	}
+
+	return &vo.LoginTokenList{
+		Token:                  newToken,
+		TokenExpireSecs:        client.TokenExpireSecs,
+		RefreshToken:           refreshToken.Token,
+		RefreshTokenExpireSecs: refreshToken.ExpireSecs,
+		PasswordExpired:        client.PasswordPolicy.IsExpired(user.PasswordChangedAt, time.Now()),
+	}, nil
+}
+
+// RefreshToken exchanges a refresh token for a new access token and a new refresh token.
+// The refresh token can only be used once, if a rotated refresh token is presented again
+// every session of the user is revoked, including the current access tokens, since the stolen token may have been refreshed on any of them.
+func (a *AuthService) RefreshToken(ctx context.Context, token string) (*vo.LoginTokenList, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Find refresh token
+	refreshToken, err := a.tokenRepo.FindRefreshToken(ctx, token)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Refresh token is expired", err)
+		}
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// The refresh token must belong to an active session of the user
+	session, err := a.tokenRepo.FindSession(ctx, refreshToken.UserId, refreshToken.SessionId)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Refresh token is revoked", err)
+		}
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Mark refresh token as used, a used token means the session is compromised
+	isFirstUse, err := a.tokenRepo.ConsumeRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if !isFirstUse {
+		err = cus_err.New(cus_err.Unauthorized, fmt.Sprintf("Refresh token reuse detected for user %d", refreshToken.UserId))
+		cus_otel.Warn(ctx, err.Error())
+
+		if revokeErr := a.revokeUserTokens(ctx, session.UserId); revokeErr != nil {
+			return nil, revokeErr
+		}
+		return nil, err
+	}
+
+	// Get client, this is going to find from cache first and then from database
+	client, err := a.clientRepo.Find(ctx, refreshToken.ClientId)
+	if err != nil {
 		return nil, err
 	}
 
@@ -139,100 +492,482 @@ func (a *AuthService) Login(ctx context.Context, token string, userId int64, pas
 		return nil, err
 	}
 
-	// Check user status
+	// Find user and check user status
+	user, err := a.userRepo.Find(ctx, refreshToken.UserId)
+	if err != nil {
+		return nil, err
+	}
 	if user.Status != enum.UserStatusType.Active {
-		err = cus_err.New(cus_err.AccountLocked, fmt.Sprintf("User id: %v is not active", user.Status))
+		err = cus_err.New(cus_err.AccountLocked, fmt.Sprintf("User id: %v is not active", user.Id))
 		cus_otel.Error(ctx, err.Error())
 		return nil, err
 	}
 
-	// Check user password is correct
-	if forceLogin || !a.crypto.CompareHashAndPassword(ctx, user.Password, password) {
-		// Increase login error count
-		user.PasswordFailTimes += 1
+	// Renew the session, it fails if the session is revoked after it's found
+	now := time.Now()
+	session.LastActiveAt = now
+	session.ExpireAt = now.Add(time.Second * time.Duration(client.RefreshTokenExpireSecs))
+	err = a.tokenRepo.UpdateSession(ctx, session)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Refresh token is revoked", err)
+		}
+		return nil, err
+	}
 
-		// If password failed time is more than client login failed times, then lock user
+	// Create new token
+	newToken, err := a.createUserToken(ctx, client, user, session.Id)
+	if err != nil {
+		return nil, err
+	}
 
-		fmt.Println(client.LoginFailedTimes)
-		if user.PasswordFailTimes >= client.LoginFailedTimes {
+	// Rotate refresh token in the same session
+	newRefreshToken, err := a.createRefreshToken(ctx, client, user.Id, session.Id)
+	if err != nil {
+		return nil, err
+	}
 
-			user.Status = enum.UserStatusType.Locked
+	return &vo.LoginTokenList{
+		Token:                  newToken,
+		TokenExpireSecs:        client.TokenExpireSecs,
+		RefreshToken:           newRefreshToken.Token,
+		RefreshTokenExpireSecs: newRefreshToken.ExpireSecs,
+	}, nil
+}
+
+// Logout revokes the given token.
+// For a user token the session of the token is ended, the other sessions of the user stay logged in.
+func (a *AuthService) Logout(ctx context.Context, token string) (*entity.TokenRevocation, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Only a valid token can be logged out
+	claims, err := a.ValidateToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case claims.UserId != nil && claims.SessionId != nil:
+		err = a.revokeSession(ctx, *claims.UserId, *claims.SessionId)
+	case claims.UserId != nil:
+		err = a.revokeUserTokens(ctx, *claims.UserId)
+	default:
+		err = a.cache.Delete(ctx, a.tokenKey(*claims, token))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the revocation
+	return a.userRepo.AddTokenRevocation(ctx, &entity.TokenRevocation{
+		ClientId:     claims.ClientId,
+		UserId:       claims.UserId,
+		Scope:        entity.RevocationScopeLogout,
+		RevokedCount: 1,
+	})
+}
+
+// RevokeUserTokens forces the user to logout, if userId is nil every user of the client is forced to logout.
+// The access tokens and refresh token families of the users are revoked.
+func (a *AuthService) RevokeUserTokens(
+	ctx context.Context,
+	clientId int64,
+	userId *int64,
+	reason string,
+	operatorId *int64,
+) (*entity.TokenRevocation, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Check client exists
+	client, err := a.clientRepo.Find(ctx, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	scope := entity.RevocationScopeClient
+	var userIds []int64
+	if userId != nil {
+		// Check the user belongs to the client
+		user, err := a.userRepo.Find(ctx, *userId)
+		if err != nil {
+			return nil, err
+		}
+		userClient, err := user.Client(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if userClient.Id != client.Id {
+			err = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("User id: %v not found in client id: %v", user.Id, client.Id))
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
 		}
 
-		// Create password error with remaining times
-		err := cus_err.New(cus_err.AccountPasswordError, "Invalid password").
-			WithData(map[string]interface{}{
-				"errorCount":    user.PasswordFailTimes,
-				"totalAttempts": client.LoginFailedTimes,
-			})
-		cus_otel.Error(ctx, err.Error())
+		scope = entity.RevocationScopeUser
+		userIds = []int64{user.Id}
+	} else {
+		userIds, err = a.userRepo.FindUserIdsByClient(ctx, client.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-		// Update user
-		_, updateErr := a.userRepo.Update(ctx, user)
-		if updateErr != nil {
-			cus_otel.Error(ctx, updateErr.Error())
+	// Revoke tokens
+	for _, id := range userIds {
+		err = a.revokeUserTokens(ctx, id)
+		if err != nil {
 			return nil, err
 		}
-		return &vo.LoginTokenList{
-			Token:           token,
-			TokenExpireSecs: client.TokenExpireSecs,
-			ErrorCount:      user.PasswordFailTimes,
-			TotalAttempts:   client.LoginFailedTimes,
-		}, err
 	}
-	if user.PasswordFailTimes != 0 {
-		// PasswordFailedTime is reset to 0
-		user.PasswordFailTimes = 0
-		// Update
-		_, updateErr := a.userRepo.Update(ctx, user)
-		if updateErr != nil {
-			cus_otel.Error(ctx, updateErr.Error())
+
+	// Record the revocation
+	return a.userRepo.AddTokenRevocation(ctx, &entity.TokenRevocation{
+		ClientId:     client.Id,
+		UserId:       userId,
+		Scope:        scope,
+		Reason:       reason,
+		OperatorId:   operatorId,
+		RevokedCount: len(userIds),
+	})
+}
+
+// RevokeOtherSessions ends every session of the user except the current one, e.g. after the password is changed.
+func (a *AuthService) RevokeOtherSessions(
+	ctx context.Context,
+	clientId int64,
+	userId int64,
+	currentSessionId *string,
+	reason string,
+) (*entity.TokenRevocation, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	sessions, err := a.tokenRepo.FindSessions(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	revokedCount := 0
+	for _, session := range sessions {
+		if currentSessionId != nil && session.Id == *currentSessionId {
+			continue
+		}
+		err = a.revokeSession(ctx, session.UserId, session.Id)
+		if err != nil {
 			return nil, err
 		}
+		revokedCount++
+	}
+
+	// Record the revocation
+	return a.userRepo.AddTokenRevocation(ctx, &entity.TokenRevocation{
+		ClientId:     clientId,
+		UserId:       &userId,
+		Scope:        entity.RevocationScopeSession,
+		Reason:       reason,
+		RevokedCount: revokedCount,
+	})
+}
+
+// ExpireAccessTokens expires the access tokens of the user but keeps the sessions,
+// the user gets a token carrying the current role by refreshing it, e.g. after the role is changed.
+func (a *AuthService) ExpireAccessTokens(ctx context.Context, userId int64) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	sessions, err := a.tokenRepo.FindSessions(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		err = a.cache.Delete(ctx, a.sessionTokenKey(session.UserId, session.Id))
+		if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+			return err
+		}
+	}
+
+	// Tokens issued without a session are cached by user id
+	err = a.cache.Delete(ctx, fmt.Sprintf("%s:%d", TokenPrefix, userId))
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// ListSessions lists the active sessions of the user, ordered by creation time.
+func (a *AuthService) ListSessions(ctx context.Context, userId int64) ([]*vo.Session, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	sessions, err := a.tokenRepo.FindSessions(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreateAt.Before(sessions[j].CreateAt)
+	})
+
+	return sessions, nil
+}
+
+// RevokeSession ends a session of the user, the access token and the refresh tokens of the session are revoked.
+func (a *AuthService) RevokeSession(ctx context.Context, clientId int64, userId int64, sessionId string) (*entity.TokenRevocation, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Check the session belongs to the user
+	session, err := a.tokenRepo.FindSession(ctx, userId, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.revokeSession(ctx, session.UserId, session.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Record the revocation
+	return a.userRepo.AddTokenRevocation(ctx, &entity.TokenRevocation{
+		ClientId:     clientId,
+		UserId:       &userId,
+		Scope:        entity.RevocationScopeSession,
+		RevokedCount: 1,
+	})
+}
+
+// sessionLimit is the limit of the sessions of the user by the session policy of the client.
+// A single session is replaced by the new one, and only a forced login replaces the least recently active sessions at the max sessions.
+func (a *AuthService) sessionLimit(client *aggregate.Client, forceLogin bool) vo.SessionLimit {
+	switch client.SessionPolicy {
+	case enum.SessionPolicyType.Unlimited:
+		return vo.SessionLimit{}
+	case enum.SessionPolicyType.Limited:
+		return vo.SessionLimit{MaxSessions: max(client.MaxSessions, 1), Evict: forceLogin}
+	default:
+		return vo.SessionLimit{MaxSessions: 1, Evict: true}
+	}
+}
+
+// createSession starts a new session of the user on the given device within the session limit of the client.
+// It returns the ids of the sessions replaced by the new session, their access tokens are still to be revoked.
+func (a *AuthService) createSession(ctx context.Context, client *aggregate.Client, userId int64, forceLogin bool, device vo.Device) (*vo.Session, []string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	b, err := a.crypto.GenerateRandomSecret(ctx, sessionIdLength)
+	if err != nil {
+		return nil, nil, err
 	}
 
+	now := time.Now()
+	session := &vo.Session{
+		Id:           a.crypto.EncodeHex(ctx, b),
+		UserId:       userId,
+		ClientId:     client.Id,
+		Device:       device,
+		CreateAt:     now,
+		LastActiveAt: now,
+		ExpireAt:     now.Add(time.Second * time.Duration(client.RefreshTokenExpireSecs)),
+	}
+	evictedSessionIds, err := a.tokenRepo.CreateSession(ctx, session, a.sessionLimit(client, forceLogin))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return session, evictedSessionIds, nil
+}
+
+// createUserToken creates an access token for the session and caches it as the only valid token of the session.
+func (a *AuthService) createUserToken(ctx context.Context, client *aggregate.Client, user *aggregate.User, sessionId string) (string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
 	// Get user role.
 	// If user role not found(no role) it just continue to create token
-	role, loginErr := user.Role(ctx)
-	if loginErr != nil && loginErr.Code().Int() != cus_err.ResourceNotFound {
-		cus_otel.Warn(ctx, loginErr.Error())
-		return nil, nil
+	role, err := user.Role(ctx)
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		cus_otel.Warn(ctx, err.Error())
+		return "", err
 	}
 
 	// Create new token
+	tokenId, err := a.newTokenId(ctx)
+	if err != nil {
+		return "", err
+	}
 	opts := []vo.TokenPayloadOption{
 		vo.WithUserId(user.Id),
 		vo.WithAccount(user.Account),
+		vo.WithSessionId(sessionId),
+		vo.WithExpireSecs(client.TokenExpireSecs),
+		vo.WithTokenId(tokenId),
 	}
 	if role != nil {
 		opts = append(opts, vo.WithRoleId(role.Id))
 	}
 	payload := vo.NewTokenPayload(client.MerchantId, client.Id, opts...)
-	newToken, loginErr := a.tokenHelper.Create(ctx, client.Secret, payload.ToMap())
-	if loginErr != nil {
-		return nil, loginErr
+	token, err := a.signToken(ctx, client, payload.ToMap())
+	if err != nil {
+		return "", err
 	}
 
-	// Delete old token from cache
-	loginErr = a.cache.Delete(ctx, key)
-	if loginErr != nil {
-		cusErr := cus_err.New(cus_err.TokenExpired, "Token is expired", loginErr)
-		cus_otel.Error(ctx, cusErr.Error())
-		loginErr = cusErr
-		return nil, loginErr
+	// Cache token
+	key := a.sessionTokenKey(user.Id, sessionId)
+	err = a.cache.Set(ctx, key, token, time.Second*time.Duration(client.TokenExpireSecs))
+	if err != nil {
+		return "", err
 	}
 
-	// Cache token
-	key = fmt.Sprintf("%s:%d", TokenPrefix, user.Id)
-	loginErr = a.cache.Set(ctx, key, newToken, time.Second*time.Duration(client.TokenExpireSecs))
-	if loginErr != nil {
-		return nil, loginErr
+	// The session may be revoked before the token is cached, revokeSession deletes the session before the token,
+	// so the token is dropped here if the session is gone
+	_, err = a.tokenRepo.FindSession(ctx, user.Id, sessionId)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			if deleteErr := a.cache.Delete(ctx, key); deleteErr != nil && deleteErr.Code().Int() != cus_err.ResourceNotFound {
+				return "", deleteErr
+			}
+			err = cus_err.New(cus_err.TokenExpired, "Session is revoked", err)
+			cus_otel.Warn(ctx, err.Error())
+		}
+		return "", err
 	}
 
-	return &vo.LoginTokenList{
-		Token:           newToken,
-		TokenExpireSecs: client.TokenExpireSecs,
-	}, nil
+	return token, nil
+}
+
+// createRefreshToken creates a refresh token in the given session.
+func (a *AuthService) createRefreshToken(ctx context.Context, client *aggregate.Client, userId int64, sessionId string) (*vo.RefreshToken, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	token, err := a.newRandomToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken := &vo.RefreshToken{
+		Token:      token,
+		UserId:     userId,
+		ClientId:   client.Id,
+		SessionId:  sessionId,
+		ExpireSecs: client.RefreshTokenExpireSecs,
+	}
+	err = a.tokenRepo.SaveRefreshToken(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return refreshToken, nil
+}
+
+// revokeUserTokens revokes every session of the user.
+func (a *AuthService) revokeUserTokens(ctx context.Context, userId int64) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	sessions, err := a.tokenRepo.FindSessions(ctx, userId)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		err = a.revokeSession(ctx, session.UserId, session.Id)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Tokens issued without a session are cached by user id
+	key := fmt.Sprintf("%s:%d", TokenPrefix, userId)
+	err = a.cache.Delete(ctx, key)
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// revokeSession revokes the access token and the refresh tokens of the session.
+func (a *AuthService) revokeSession(ctx context.Context, userId int64, sessionId string) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// The refresh tokens are invalid once the session is deleted.
+	// It's deleted before the access token, so a token cached by a concurrent refresh is dropped by either of them
+	err := a.tokenRepo.DeleteSession(ctx, userId, sessionId)
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		return err
+	}
+
+	err = a.cache.Delete(ctx, a.sessionTokenKey(userId, sessionId))
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		return err
+	}
+
+	return nil
+}
+
+// signToken signs the claims by the signing method of the client.
+func (a *AuthService) signToken(ctx context.Context, client *aggregate.Client, claims map[string]any) (string, *cus_err.CusError) {
+	if client.SigningMethod.IsAsymmetric() {
+		return a.keyService.Sign(ctx, client.SigningMethod, claims)
+	}
+	return a.tokenHelper.Create(ctx, client.Secret, claims)
+}
+
+// verifyToken verifies the token by the signing method of the client.
+func (a *AuthService) verifyToken(ctx context.Context, client *aggregate.Client, token string) (map[string]any, *cus_err.CusError) {
+	if client.SigningMethod.IsAsymmetric() {
+		return a.keyService.Verify(ctx, client.SigningMethod, token)
+	}
+	return a.tokenHelper.Validate(ctx, token, client.Secret)
+}
+
+// tokenKey returns the cache key of the given token.
+func (a *AuthService) tokenKey(claims vo.TokenPayload, token string) string {
+	switch {
+	case claims.UserId != nil && claims.SessionId != nil:
+		return a.sessionTokenKey(*claims.UserId, *claims.SessionId)
+	case claims.UserId != nil:
+		return fmt.Sprintf("%s:%d", TokenPrefix, *claims.UserId)
+	default:
+		return fmt.Sprintf("%s:%s", TokenPrefix, token)
+	}
+}
+
+// sessionTokenKey returns the cache key of the access token of the session.
+func (a *AuthService) sessionTokenKey(userId int64, sessionId string) string {
+	return fmt.Sprintf("%s:%d:%s", TokenPrefix, userId, sessionId)
+}
+
+func (a *AuthService) newRandomToken(ctx context.Context) (string, *cus_err.CusError) {
+	b, err := a.crypto.GenerateRandomSecret(ctx, refreshTokenLength)
+	if err != nil {
+		return "", err
+	}
+	return a.crypto.EncodeHex(ctx, b), nil
+}
+
+// newTokenId generates a unique id (jti) of a token.
+func (a *AuthService) newTokenId(ctx context.Context) (string, *cus_err.CusError) {
+	b, err := a.crypto.GenerateRandomSecret(ctx, tokenIdLength)
+	if err != nil {
+		return "", err
+	}
+	return a.crypto.EncodeHex(ctx, b), nil
 }
 
 // ValidateToken validates the given token.
@@ -254,7 +989,7 @@ func (a *AuthService) ValidateToken(ctx context.Context, token string) (*vo.Toke
 	}
 
 	// Validate token
-	_, err = a.tokenHelper.Validate(ctx, token, client.Secret)
+	_, err = a.verifyToken(ctx, client, token)
 	if err != nil {
 		return nil, err
 	}
@@ -266,15 +1001,8 @@ func (a *AuthService) ValidateToken(ctx context.Context, token string) (*vo.Toke
 		return nil, err
 	}
 
-	key := ""
-	if claims.UserId != nil {
-		key = fmt.Sprintf("%s:%d", TokenPrefix, *claims.UserId)
-	} else {
-		key = fmt.Sprintf("%s:%s", TokenPrefix, token)
-	}
-
 	// Get token from cache
-	cacheToken, err := a.cache.Get(ctx, key)
+	cacheToken, err := a.cache.Get(ctx, a.tokenKey(claims, token))
 	if err != nil {
 		err = cus_err.New(cus_err.TokenExpired, "Token is expired")
 		cus_otel.Error(ctx, err.Error())
@@ -333,28 +1061,61 @@ func (a *AuthService) AddLoginRecord(ctx context.Context, userId int64, record *
 	return a.userRepo.AddLoginRecord(ctx, userId, record)
 }
 
-func (a *AuthService) IsLoginRecordUnusual(
-	ctx context.Context,
-	userId int64,
-	ipInfo req_analyzer.IPInfo,
-	userAgentInfo req_analyzer.UserAgentInfo,
-) (bool, *cus_err.CusError) {
+// RecordLogin records the login attempt of the user from the device, a failed attempt is recorded with the cus_err code of loginErr.
+// The attempt of an unknown user is recorded without the user.
+func (a *AuthService) RecordLogin(ctx context.Context, userId int64, device vo.Device, loginErr *cus_err.CusError) (*entity.LoginRecord, *cus_err.CusError) {
 	// Start trace
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
 
-	// get last login record
-	lastRecord, err := a.userRepo.GetLastLoginRecord(ctx, userId)
-	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
-		cusErr := cus_err.New(cus_err.InternalServerError, "Failed to get last login record", err)
-		cus_otel.Error(ctx, cusErr.Error())
-		return false, cusErr
+	if loginErr != nil && loginErr.Code().Int() == cus_err.ResourceNotFound {
+		_, err := a.userRepo.Find(ctx, userId)
+		if err != nil {
+			if err.Code().Int() != cus_err.ResourceNotFound {
+				return nil, err
+			}
+			userId = 0
+		}
 	}
 
-	// and check if the login is unusual
-	if lastRecord != nil {
-		return lastRecord.City != ipInfo.City && lastRecord.Browser != userAgentInfo.Browser, nil
+	return a.userRepo.AddLoginRecord(ctx, userId, newLoginRecord(device, loginErr))
+}
+
+// IsLoginFailureKept checks the failed login still changes the user, like the password fail times or the lockout,
+// so its transaction is committed. The other failures change nothing and are rolled back.
+func IsLoginFailureKept(loginErr *cus_err.CusError) bool {
+	switch loginErr.Code().Int() {
+	case cus_err.AccountPasswordError,
+		cus_err.AccountLocked,
+		cus_err.SecondFactorRequired,
+		cus_err.UnusualLogin,
+		cus_err.LoginDenied:
+		return true
+	}
+	return false
+}
+
+// newLoginRecord creates the login record of the device, loginErr is nil when the login succeeds
+func newLoginRecord(device vo.Device, loginErr *cus_err.CusError) *entity.LoginRecord {
+	record := &entity.LoginRecord{
+		Browser:     device.Browser,
+		BrowserVer:  device.BrowserVer,
+		Ip:          device.Ip,
+		Os:          device.Os,
+		Platform:    device.Platform,
+		Country:     device.Country,
+		CountryCode: device.CountryCode,
+		City:        device.City,
+		Asp:         device.Asp,
+		Latitude:    device.Latitude,
+		Longitude:   device.Longitude,
+		IsMobile:    device.IsMobile,
+		IsSuccess:   loginErr == nil,
+	}
+	if loginErr != nil {
+		record.ErrCode = loginErr.Code().Int()
+		record.ErrMessage = loginErr.Message()
 	}
 
-	return false, nil
+	return record
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"go_micro_service_api/auth_service/internal/domain/aggregate"
 	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/repository"
@@ -12,14 +13,19 @@ import (
 	"go_micro_service_api/pkg/enum"
 )
 
+// DefaultRefreshTokenExpireSecs is used when a client doesn't specify the refresh token lifetime (14 days)
+const DefaultRefreshTokenExpireSecs = 14 * 24 * 60 * 60
+
 type ClientService struct {
 	clientRepo repository.ClientRepo
+	eventRepo  repository.EventRepo
 	crypto     cus_crypto.CusCrypto
 }
 
-func NewClientService(clientRepo repository.ClientRepo) *ClientService {
+func NewClientService(clientRepo repository.ClientRepo, eventRepo repository.EventRepo) *ClientService {
 	return &ClientService{
 		clientRepo: clientRepo,
+		eventRepo:  eventRepo,
 		crypto:     cus_crypto.New(),
 	}
 }
@@ -36,14 +42,80 @@ func (c *ClientService) CreateClient(ctx context.Context, clientInfo vo.ClientIn
 	}
 	secret := c.crypto.EncodeHex(ctx, secretByte)
 
+	// Use default refresh token lifetime if not set
+	refreshTokenExpireSecs := clientInfo.RefreshTokenExpireSecs
+	if refreshTokenExpireSecs == 0 {
+		refreshTokenExpireSecs = DefaultRefreshTokenExpireSecs
+	}
+
+	// Use single session policy if not set
+	sessionPolicy := clientInfo.SessionPolicy
+	if sessionPolicy == 0 {
+		sessionPolicy = enum.SessionPolicyType.Single
+	}
+	maxSessions := clientInfo.MaxSessions
+	if maxSessions == 0 {
+		maxSessions = 1
+	}
+
+	// Use HS256 if not set
+	signingMethod := clientInfo.SigningMethod
+	if signingMethod.Id == 0 {
+		signingMethod = enum.SigningMethodType.HS256
+	}
+
+	// Only backend clients can require two-factor authentication
+	err = c.validateMfaRequired(ctx, clientInfo.ClientType, clientInfo.MfaRequired)
+	if err != nil {
+		return nil, err
+	}
+
+	// No password rule if not set
+	var passwordPolicy vo.PasswordPolicy
+	if clientInfo.PasswordPolicy != nil {
+		err = c.validatePasswordPolicy(ctx, *clientInfo.PasswordPolicy)
+		if err != nil {
+			return nil, err
+		}
+		passwordPolicy = *clientInfo.PasswordPolicy
+	}
+
+	// Locked until an admin unlocks the user if not set
+	var lockoutPolicy vo.LockoutPolicy
+	if clientInfo.LockoutPolicy != nil {
+		err = c.validateLockoutPolicy(ctx, *clientInfo.LockoutPolicy)
+		if err != nil {
+			return nil, err
+		}
+		lockoutPolicy = *clientInfo.LockoutPolicy
+	}
+
+	// The default challenge score and never deny if not set
+	var riskPolicy vo.RiskPolicy
+	if clientInfo.RiskPolicy != nil {
+		err = c.validateRiskPolicy(ctx, *clientInfo.RiskPolicy)
+		if err != nil {
+			return nil, err
+		}
+		riskPolicy = *clientInfo.RiskPolicy
+	}
+
 	client := &aggregate.Client{
-		Id:               clientInfo.Id,
-		MerchantId:       clientInfo.MerchantId,
-		ClientType:       clientInfo.ClientType,
-		LoginFailedTimes: clientInfo.LoginFailedTimes,
-		TokenExpireSecs:  clientInfo.TokenExpireSecs,
-		Secret:           secret,
-		Active:           clientInfo.Active,
+		Id:                     clientInfo.Id,
+		MerchantId:             clientInfo.MerchantId,
+		ClientType:             clientInfo.ClientType,
+		LoginFailedTimes:       clientInfo.LoginFailedTimes,
+		TokenExpireSecs:        clientInfo.TokenExpireSecs,
+		RefreshTokenExpireSecs: refreshTokenExpireSecs,
+		SessionPolicy:          sessionPolicy,
+		MaxSessions:            maxSessions,
+		SigningMethod:          signingMethod,
+		MfaRequired:            clientInfo.MfaRequired,
+		PasswordPolicy:         passwordPolicy,
+		LockoutPolicy:          lockoutPolicy,
+		RiskPolicy:             riskPolicy,
+		Secret:                 secret,
+		Active:                 clientInfo.Active,
 	}
 
 	// Create client
@@ -93,9 +165,48 @@ func (c *ClientService) UpdateClient(ctx context.Context, clientInfo vo.ClientIn
 		return nil, err
 	}
 
+	deactivated := client.Active && !clientInfo.Active
 	client.LoginFailedTimes = clientInfo.LoginFailedTimes
 	client.TokenExpireSecs = clientInfo.TokenExpireSecs
 	client.Active = clientInfo.Active
+	if clientInfo.RefreshTokenExpireSecs != 0 {
+		client.RefreshTokenExpireSecs = clientInfo.RefreshTokenExpireSecs
+	}
+	if clientInfo.SessionPolicy != 0 {
+		client.SessionPolicy = clientInfo.SessionPolicy
+	}
+	if clientInfo.MaxSessions != 0 {
+		client.MaxSessions = clientInfo.MaxSessions
+	}
+	if clientInfo.SigningMethod.Id != 0 {
+		client.SigningMethod = clientInfo.SigningMethod
+	}
+	err = c.validateMfaRequired(ctx, client.ClientType, clientInfo.MfaRequired)
+	if err != nil {
+		return nil, err
+	}
+	client.MfaRequired = clientInfo.MfaRequired
+	if clientInfo.PasswordPolicy != nil {
+		err = c.validatePasswordPolicy(ctx, *clientInfo.PasswordPolicy)
+		if err != nil {
+			return nil, err
+		}
+		client.PasswordPolicy = *clientInfo.PasswordPolicy
+	}
+	if clientInfo.LockoutPolicy != nil {
+		err = c.validateLockoutPolicy(ctx, *clientInfo.LockoutPolicy)
+		if err != nil {
+			return nil, err
+		}
+		client.LockoutPolicy = *clientInfo.LockoutPolicy
+	}
+	if clientInfo.RiskPolicy != nil {
+		err = c.validateRiskPolicy(ctx, *clientInfo.RiskPolicy)
+		if err != nil {
+			return nil, err
+		}
+		client.RiskPolicy = *clientInfo.RiskPolicy
+	}
 
 	// Update client
 	client, err = c.clientRepo.Update(ctx, client)
@@ -103,9 +214,73 @@ func (c *ClientService) UpdateClient(ctx context.Context, clientInfo vo.ClientIn
 		return nil, err
 	}
 
+	if deactivated {
+		err = c.eventRepo.AddEvents(ctx, vo.NewClientDeactivatedEvent(vo.ClientDeactivatedPayload{
+			ClientId:   client.Id,
+			MerchantId: client.MerchantId,
+		}))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return client, nil
 }
 
+// validateMfaRequired checks the client type can require two-factor authentication.
+func (c *ClientService) validateMfaRequired(ctx context.Context, clientType enum.Client, mfaRequired bool) *cus_err.CusError {
+	if mfaRequired && clientType != enum.ClientType.Backend {
+		err := cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("Client type %s can't require two-factor authentication", clientType.String))
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+	return nil
+}
+
+// validatePasswordPolicy checks the rules of the password policy are not negative.
+func (c *ClientService) validatePasswordPolicy(ctx context.Context, policy vo.PasswordPolicy) *cus_err.CusError {
+	if policy.MinLength < 0 || policy.HistoryCount < 0 || policy.MaxAgeSecs < 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "Password policy min length, history count and max age can't be negative")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+	return nil
+}
+
+// validateLockoutPolicy checks the lockout durations are not negative and the max is not less than the first lockout.
+func (c *ClientService) validateLockoutPolicy(ctx context.Context, policy vo.LockoutPolicy) *cus_err.CusError {
+	if policy.LockoutSecs < 0 || policy.MaxLockoutSecs < 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "Lockout policy lockout secs and max lockout secs can't be negative")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+	if policy.MaxLockoutSecs > 0 && policy.MaxLockoutSecs < policy.LockoutSecs {
+		err := cus_err.New(cus_err.InvalidArgument, "Lockout policy max lockout secs can't be less than lockout secs")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+	return nil
+}
+
+// validateRiskPolicy checks the scores are not negative and a login is challenged before it is denied.
+func (c *ClientService) validateRiskPolicy(ctx context.Context, policy vo.RiskPolicy) *cus_err.CusError {
+	if policy.ChallengeScore < 0 || policy.DenyScore < 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "Risk policy challenge score and deny score can't be negative")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+	challengeScore := policy.ChallengeScore
+	if challengeScore == 0 {
+		challengeScore = vo.DefaultRiskChallengeScore
+	}
+	if policy.DenyScore > 0 && policy.DenyScore <= challengeScore {
+		err := cus_err.New(cus_err.InvalidArgument, "Risk policy deny score must be greater than challenge score")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+	return nil
+}
+
 func (c *ClientService) CreateRoles(ctx context.Context, clientId int64, roles ...entity.Role) ([]entity.Role, *cus_err.CusError) {
 	// Start trace
 	ctx, span := cus_otel.StartTrace(ctx)
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/entity"
+	"go_micro_service_api/auth_service/internal/domain/repository"
+	"go_micro_service_api/auth_service/internal/infrastructure/token_helper"
+	"go_micro_service_api/pkg/cus_crypto"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
+// kidLength is the number of random bytes of a kid
+const kidLength = 8
+
+// KeyService manages the asymmetric signing keys of the RS256/ES256 clients.
+type KeyService struct {
+	signingKeyRepo repository.SigningKeyRepo
+	tokenHelper    token_helper.TokenHelper
+	crypto         cus_crypto.CusCrypto
+}
+
+func NewKeyService(signingKeyRepo repository.SigningKeyRepo, helper token_helper.TokenHelper) *KeyService {
+	return &KeyService{
+		signingKeyRepo: signingKeyRepo,
+		tokenHelper:    helper,
+		crypto:         cus_crypto.New(),
+	}
+}
+
+// Sign signs the claims with the active key of the signing method.
+func (k *KeyService) Sign(ctx context.Context, signingMethod enum.SigningMethod, claims map[string]any) (string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	keys, err := k.signingKeyRepo.FindActive(ctx, signingMethod)
+	if err != nil {
+		return "", err
+	}
+	if len(keys) == 0 {
+		err = cus_err.New(cus_err.InternalServerError, fmt.Sprintf("No signing key of %s, keys are not rotated yet", signingMethod.Alg))
+		cus_otel.Error(ctx, err.Error())
+		return "", err
+	}
+
+	// Sign with the latest key
+	return k.tokenHelper.CreateWithKey(ctx, k.toHelperKey(keys[0]), claims)
+}
+
+// Verify verifies the token with the key of its kid,
+// the key must belong to the signing method and must not be expired.
+func (k *KeyService) Verify(ctx context.Context, signingMethod enum.SigningMethod, token string) (map[string]any, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	kid, err := k.tokenHelper.GetKeyId(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := k.signingKeyRepo.FindByKid(ctx, kid)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.Unauthorized, fmt.Sprintf("Unknown kid: %s", kid), err)
+			cus_otel.Error(ctx, err.Error())
+		}
+		return nil, err
+	}
+
+	if key.SigningMethod != signingMethod {
+		err = cus_err.New(cus_err.Unauthorized, fmt.Sprintf("Kid: %s is not a %s key", kid, signingMethod.Alg))
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if key.IsExpired(time.Now()) {
+		err = cus_err.New(cus_err.TokenExpired, fmt.Sprintf("Signing key %s is expired", kid))
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return k.tokenHelper.ValidateWithKey(ctx, token, k.toHelperKey(key))
+}
+
+// RotateKey creates a new active key of the signing method.
+// The previous active key stops signing but stays valid for verification during the retention.
+// It fails with Conflict if the key is rotated by another instance at the same time,
+// the transaction should be rolled back then.
+func (k *KeyService) RotateKey(ctx context.Context, signingMethod enum.SigningMethod, retention time.Duration) (*entity.SigningKey, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if !signingMethod.IsAsymmetric() {
+		err := cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("%s doesn't use signing keys", signingMethod.Alg))
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Retire the current key
+	currentKeys, err := k.signingKeyRepo.FindActive(ctx, signingMethod)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	expireAt := now.Add(retention)
+	for _, current := range currentKeys {
+		current.Active = false
+		current.RotatedAt = &now
+		current.ExpireAt = &expireAt
+		err = k.signingKeyRepo.Retire(ctx, current)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Generate the new key
+	b, err := k.crypto.GenerateRandomSecret(ctx, kidLength)
+	if err != nil {
+		return nil, err
+	}
+	kid := fmt.Sprintf("%s-%s", signingMethod.Alg, k.crypto.EncodeHex(ctx, b))
+	helperKey, err := k.tokenHelper.GenerateKey(ctx, kid, signingMethod.Alg)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := k.signingKeyRepo.Create(ctx, &entity.SigningKey{
+		Kid:           helperKey.Kid,
+		SigningMethod: signingMethod,
+		PrivateKey:    helperKey.PrivateKey,
+		PublicKey:     helperKey.PublicKey,
+		Active:        true,
+	})
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceIsExist {
+			err = cus_err.New(cus_err.Conflict, fmt.Sprintf("Signing key of %s is rotated by another instance", signingMethod.Alg), err)
+			cus_otel.Warn(ctx, err.Error())
+		}
+		return nil, err
+	}
+
+	cus_otel.Info(ctx, fmt.Sprintf("Signing key of %s is rotated to %s", signingMethod.Alg, key.Kid))
+	return key, nil
+}
+
+// RotateDueKey rotates the key of the signing method if there is no active key
+// or the active key is older than the interval, nil is returned if the key is not due.
+func (k *KeyService) RotateDueKey(ctx context.Context, signingMethod enum.SigningMethod, interval time.Duration, retention time.Duration) (*entity.SigningKey, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	currentKeys, err := k.signingKeyRepo.FindActive(ctx, signingMethod)
+	if err != nil {
+		return nil, err
+	}
+	if len(currentKeys) > 0 && time.Since(currentKeys[0].CreateAt) < interval {
+		return nil, nil
+	}
+
+	return k.RotateKey(ctx, signingMethod, retention)
+}
+
+// GetJwks returns the public keys which can verify tokens as JSON Web Keys.
+func (k *KeyService) GetJwks(ctx context.Context) ([]*token_helper.Jwk, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	keys, err := k.signingKeyRepo.FindUnexpired(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	jwks := make([]*token_helper.Jwk, 0, len(keys))
+	for _, key := range keys {
+		jwk, err := k.tokenHelper.ToJwk(ctx, k.toHelperKey(key))
+		if err != nil {
+			return nil, err
+		}
+		jwks = append(jwks, jwk)
+	}
+
+	return jwks, nil
+}
+
+func (k *KeyService) toHelperKey(key *entity.SigningKey) token_helper.SigningKey {
+	return token_helper.SigningKey{
+		Kid:        key.Kid,
+		Alg:        key.SigningMethod.Alg,
+		PrivateKey: key.PrivateKey,
+		PublicKey:  key.PublicKey,
+	}
+}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/aggregate"
+	"go_micro_service_api/auth_service/internal/domain/entity"
+	"go_micro_service_api/auth_service/internal/domain/repository"
+	"go_micro_service_api/auth_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"time"
+)
+
+// riskHistoryLimit is the number of recent login records the signals look at
+const riskHistoryLimit = 20
+
+// RiskSignal is a weighted rule of the risk engine.
+// The weight of every matched signal is added to the score of the login.
+type RiskSignal interface {
+	// Name is returned in the reasons of the assessment when the signal matches
+	Name() string
+	Weight() int
+	Match(ctx context.Context, input *RiskInput) bool
+}
+
+// RiskInput is what the signals know about the login
+type RiskInput struct {
+	UserId int64
+	Device vo.Device
+	Now    time.Time
+	// Trusted is true when the user has confirmed the browser and the city before
+	Trusted bool
+	// Records are the recent login records of the user, latest first
+	Records []*entity.LoginRecord
+}
+
+// LastSuccess returns the latest successful login record, nil if the user has never logged in
+func (i *RiskInput) LastSuccess() *entity.LoginRecord {
+	for _, record := range i.Records {
+		if record.IsSuccess {
+			return record
+		}
+	}
+	return nil
+}
+
+// RiskEngine scores a login with its signals and decides it by the risk policy of the client.
+type RiskEngine struct {
+	userRepo repository.UserRepo
+	signals  []RiskSignal
+}
+
+// NewRiskEngine creates a risk engine with the default signals, use WithSignals to plug other signals.
+func NewRiskEngine(userRepo repository.UserRepo) *RiskEngine {
+	return &RiskEngine{
+		userRepo: userRepo,
+		signals:  DefaultRiskSignals(),
+	}
+}
+
+// WithSignals replaces the signals of the engine
+func (r *RiskEngine) WithSignals(signals ...RiskSignal) *RiskEngine {
+	r.signals = signals
+	return r
+}
+
+// Assess scores the login of the user from the device.
+func (r *RiskEngine) Assess(ctx context.Context, client *aggregate.Client, userId int64, device vo.Device) (*vo.RiskAssessment, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	trusted, err := r.userRepo.IsTrustedDevice(ctx, userId, device)
+	if err != nil {
+		return nil, err
+	}
+	records, err := r.userRepo.FindRecentLoginRecords(ctx, userId, riskHistoryLimit)
+	if err != nil {
+		return nil, err
+	}
+	input := &RiskInput{
+		UserId:  userId,
+		Device:  device,
+		Now:     time.Now(),
+		Trusted: trusted,
+		Records: records,
+	}
+
+	assessment := &vo.RiskAssessment{Reasons: []string{}}
+	for _, signal := range r.signals {
+		if signal.Match(ctx, input) {
+			assessment.Score += signal.Weight()
+			assessment.Reasons = append(assessment.Reasons, signal.Name())
+		}
+	}
+	assessment.Decision = client.RiskPolicy.Decide(assessment.Score)
+
+	if assessment.Decision != vo.RiskDecisionAllow {
+		cus_otel.Warn(ctx, fmt.Sprintf("Login of user %d is risky", userId),
+			cus_otel.NewField("score", assessment.Score),
+			cus_otel.NewField("reasons", assessment.Reasons),
+			cus_otel.NewField("decision", string(assessment.Decision)),
+		)
+	}
+
+	return assessment, nil
+}
@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+)
+
+// Names of the default risk signals, they are returned as the reasons of the assessment
+const (
+	RiskSignalNewCountry       = "newCountry"
+	RiskSignalImpossibleTravel = "impossibleTravel"
+	RiskSignalNewDevice        = "newDevice"
+	RiskSignalFailureBurst     = "failureBurst"
+	RiskSignalBadIsp           = "badIsp"
+	RiskSignalBotUserAgent     = "botUserAgent"
+	RiskSignalUnusualHour      = "unusualHour"
+)
+
+const (
+	// maxTravelSpeedKmh is faster than an airliner, a user can't move between two logins faster than this
+	maxTravelSpeedKmh = 1000
+	// minTravelDistanceKm ignores the distance caused by the inaccurate ip location
+	minTravelDistanceKm = 300
+	// failureBurstWindow and failureBurstCount make a burst of failed logins
+	failureBurstWindow = 15 * time.Minute
+	failureBurstCount  = 3
+	// usualHourRange is how far from the hours of the past logins a login is still usual
+	usualHourRange = 2
+	// usualHourMinRecords is the number of successful logins needed to know the usual hours
+	usualHourMinRecords = 5
+	earthRadiusKm       = 6371
+)
+
+// DefaultBadIsps are the hosting and proxy networks where users don't login from, matched case-insensitively
+var DefaultBadIsps = []string{
+	"amazon",
+	"google cloud",
+	"microsoft azure",
+	"digitalocean",
+	"linode",
+	"vultr",
+	"choopa",
+	"ovh",
+	"hetzner",
+	"m247",
+	"datacamp",
+	"tor exit",
+}
+
+// riskSignal is a signal matched by a function
+type riskSignal struct {
+	name   string
+	weight int
+	match  func(ctx context.Context, input *RiskInput) bool
+}
+
+// NewRiskSignal creates a signal which matches the login by the function
+func NewRiskSignal(name string, weight int, match func(ctx context.Context, input *RiskInput) bool) RiskSignal {
+	return &riskSignal{
+		name:   name,
+		weight: weight,
+		match:  match,
+	}
+}
+
+func (s *riskSignal) Name() string {
+	return s.name
+}
+
+func (s *riskSignal) Weight() int {
+	return s.weight
+}
+
+func (s *riskSignal) Match(ctx context.Context, input *RiskInput) bool {
+	return s.match(ctx, input)
+}
+
+// DefaultRiskSignals returns the signals of the risk engine, their weights add up to the score.
+func DefaultRiskSignals() []RiskSignal {
+	return []RiskSignal{
+		NewRiskSignal(RiskSignalNewCountry, 30, matchNewCountry),
+		NewRiskSignal(RiskSignalImpossibleTravel, 50, matchImpossibleTravel),
+		NewRiskSignal(RiskSignalNewDevice, 25, matchNewDevice),
+		NewRiskSignal(RiskSignalFailureBurst, 30, matchFailureBurst),
+		NewRiskSignal(RiskSignalBadIsp, 40, BadIspMatcher(DefaultBadIsps)),
+		NewRiskSignal(RiskSignalBotUserAgent, 60, matchBotUserAgent),
+		NewRiskSignal(RiskSignalUnusualHour, 10, matchUnusualHour),
+	}
+}
+
+// matchNewCountry matches the login from another country than the last successful login.
+func matchNewCountry(_ context.Context, input *RiskInput) bool {
+	last := input.LastSuccess()
+	if input.Trusted || last == nil || last.CountryCode == "" || input.Device.CountryCode == "" {
+		return false
+	}
+	return last.CountryCode != input.Device.CountryCode
+}
+
+// matchImpossibleTravel matches the login too far away from the last successful login to travel in time.
+func matchImpossibleTravel(_ context.Context, input *RiskInput) bool {
+	last := input.LastSuccess()
+	if last == nil || !hasCoordinates(last.Latitude, last.Longitude) ||
+		!hasCoordinates(input.Device.Latitude, input.Device.Longitude) {
+		return false
+	}
+
+	distance := distanceKm(last.Latitude, last.Longitude, input.Device.Latitude, input.Device.Longitude)
+	if distance < minTravelDistanceKm {
+		return false
+	}
+	hours := input.Now.Sub(last.CreateAt).Hours()
+	if hours <= 0 {
+		return true
+	}
+	return distance/hours > maxTravelSpeedKmh
+}
+
+// matchNewDevice matches the untrusted browser which the user hasn't logged in successfully with recently.
+func matchNewDevice(_ context.Context, input *RiskInput) bool {
+	if input.Trusted || input.LastSuccess() == nil {
+		return false
+	}
+	for _, record := range input.Records {
+		if record.IsSuccess && record.Browser == input.Device.Browser && record.Os == input.Device.Os {
+			return false
+		}
+	}
+	return true
+}
+
+// matchFailureBurst matches the login right after many failed logins.
+func matchFailureBurst(_ context.Context, input *RiskInput) bool {
+	failures := 0
+	for _, record := range input.Records {
+		if input.Now.Sub(record.CreateAt) > failureBurstWindow {
+			break
+		}
+		if !record.IsSuccess {
+			failures++
+		}
+	}
+	return failures >= failureBurstCount
+}
+
+// BadIspMatcher matches the login from the networks containing any of the names.
+func BadIspMatcher(isps []string) func(ctx context.Context, input *RiskInput) bool {
+	return func(_ context.Context, input *RiskInput) bool {
+		asp := strings.ToLower(input.Device.Asp)
+		if asp == "" {
+			return false
+		}
+		for _, isp := range isps {
+			if strings.Contains(asp, strings.ToLower(isp)) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// matchBotUserAgent matches the login from a crawler or a script.
+func matchBotUserAgent(_ context.Context, input *RiskInput) bool {
+	return input.Device.IsBot
+}
+
+// matchUnusualHour matches the login at an hour far from the hours of the past successful logins, in UTC.
+func matchUnusualHour(_ context.Context, input *RiskInput) bool {
+	hour := input.Now.UTC().Hour()
+	successes := 0
+	for _, record := range input.Records {
+		if !record.IsSuccess {
+			continue
+		}
+		successes++
+		diff := int(math.Abs(float64(record.CreateAt.UTC().Hour() - hour)))
+		if diff > 12 {
+			diff = 24 - diff
+		}
+		if diff <= usualHourRange {
+			return false
+		}
+	}
+	return successes >= usualHourMinRecords
+}
+
+func hasCoordinates(latitude float64, longitude float64) bool {
+	return latitude != 0 || longitude != 0
+}
+
+// distanceKm is the great-circle distance between two coordinates
+func distanceKm(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(a))
+}
@@ -0,0 +1,381 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/aggregate"
+	"go_micro_service_api/auth_service/internal/domain/entity"
+	"go_micro_service_api/auth_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_crypto"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+	"strings"
+	"time"
+)
+
+const (
+	// TotpIssuer is the issuer shown in the authenticator apps
+	TotpIssuer = "GoMicroServiceApi"
+	// SecondFactorChallengeExpireSecs is how long a login waits for the second factor
+	SecondFactorChallengeExpireSecs = 300
+	// SecondFactorMaxAttempts is the number of wrong codes before the challenge is dropped
+	SecondFactorMaxAttempts = 5
+	// RecoveryCodeCount is the number of recovery codes generated when TOTP is enabled
+	RecoveryCodeCount = 10
+	// recoveryCodeLength is the number of random bytes of a recovery code
+	recoveryCodeLength = 5
+	// challengeTokenLength is the number of random bytes of a challenge token
+	challengeTokenLength = 32
+)
+
+// isSecondFactorRequired checks the user has enabled TOTP or the client requires it.
+func (a *AuthService) isSecondFactorRequired(ctx context.Context, client *aggregate.Client, userId int64) (bool, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if client.MfaRequired {
+		return true, nil
+	}
+
+	totp, err := a.userRepo.FindTotp(ctx, userId)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	return totp.Enabled, nil
+}
+
+// challengeSecondFactor creates the challenge of the login and returns the SecondFactorRequired error with the challenge token.
+// If the client requires two-factor authentication but the user has no authenticator yet,
+// a new secret is returned as well and it's enabled when the login is completed.
+func (a *AuthService) challengeSecondFactor(
+	ctx context.Context,
+	client *aggregate.Client,
+	user *aggregate.User,
+	clientTokenKey string,
+	forceLogin bool,
+	device vo.Device,
+) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	enrolled := true
+	totp, err := a.userRepo.FindTotp(ctx, user.Id)
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		return err
+	}
+	if totp == nil || !totp.Enabled {
+		enrolled = false
+	}
+
+	b, err := a.crypto.GenerateRandomSecret(ctx, challengeTokenLength)
+	if err != nil {
+		return err
+	}
+	challenge := &vo.SecondFactorChallenge{
+		Token:          a.crypto.EncodeHex(ctx, b),
+		UserId:         user.Id,
+		ClientId:       client.Id,
+		ClientTokenKey: clientTokenKey,
+		ForceLogin:     forceLogin,
+		Device:         device,
+		ExpireSecs:     SecondFactorChallengeExpireSecs,
+	}
+	data := map[string]interface{}{
+		"challengeToken": challenge.Token,
+		"expireSecs":     challenge.ExpireSecs,
+		"enrolled":       enrolled,
+	}
+
+	// The user has to enroll during the login
+	if !enrolled {
+		secret, err := a.crypto.GenerateTotpSecret(ctx)
+		if err != nil {
+			return err
+		}
+		challenge.PendingSecret = secret
+		data["secret"] = secret
+		data["otpauthUri"] = a.crypto.TotpUri(ctx, TotpIssuer, user.Account, secret)
+	}
+
+	err = a.tokenRepo.SaveChallenge(ctx, challenge)
+	if err != nil {
+		return err
+	}
+
+	err = cus_err.New(cus_err.SecondFactorRequired, fmt.Sprintf("User %d has to pass the second factor", user.Id)).
+		WithData(data)
+	cus_otel.Info(ctx, err.Error())
+	return err
+}
+
+// VerifySecondFactor completes the login of the challenge with a TOTP code or a recovery code.
+// The recovery codes are returned only when the user is enrolled during the login.
+func (a *AuthService) VerifySecondFactor(ctx context.Context, challengeToken string, code string) (*vo.LoginTokenList, []string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	challenge, err := a.tokenRepo.FindChallenge(ctx, challengeToken)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Challenge token is expired", err)
+			cus_otel.Warn(ctx, err.Error())
+		}
+		return nil, nil, err
+	}
+
+	// Check the client and the user are still allowed to login
+	client, err := a.clientRepo.Find(ctx, challenge.ClientId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !client.Active {
+		err = cus_err.New(cus_err.ClientInactive, fmt.Sprintf("Client id: %v is not active", client.Id))
+		cus_otel.Error(ctx, err.Error())
+		return nil, nil, err
+	}
+	user, err := a.userRepo.Find(ctx, challenge.UserId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user.Status != enum.UserStatusType.Active {
+		err = cus_err.New(cus_err.AccountLocked, fmt.Sprintf("User id: %v is not active", user.Id))
+		cus_otel.Error(ctx, err.Error())
+		return nil, nil, err
+	}
+
+	// Check the code
+	var recoveryCodes []string
+	if challenge.PendingSecret != "" {
+		ok, err := a.checkTotpCode(ctx, user.Id, challenge.PendingSecret, code)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			return nil, nil, a.failChallenge(ctx, challenge)
+		}
+
+		// Enable the authenticator enrolled during the login
+		recoveryCodes, err = a.enableTotp(ctx, user.Id, challenge.PendingSecret)
+		if err != nil {
+			return nil, nil, err
+		}
+	} else {
+		totp, err := a.userRepo.FindTotp(ctx, user.Id)
+		if err != nil {
+			return nil, nil, err
+		}
+		ok, err := a.checkTotpOrRecoveryCode(ctx, totp, code)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !ok {
+			return nil, nil, a.failChallenge(ctx, challenge)
+		}
+	}
+
+	// The challenge can be used only once
+	err = a.tokenRepo.DeleteChallenge(ctx, challenge.Token)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Challenge token is expired", err)
+			cus_otel.Warn(ctx, err.Error())
+		}
+		return nil, nil, err
+	}
+
+	tokens, err := a.completeLogin(ctx, client, user, challenge.ClientTokenKey, challenge.ForceLogin, challenge.Device)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return tokens, recoveryCodes, nil
+}
+
+// EnrollTotp generates a new TOTP secret of the user, it's pending until ConfirmTotp is called with the first code.
+// It returns the secret and the otpauth URI of the secret.
+func (a *AuthService) EnrollTotp(ctx context.Context, userId int64) (string, string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	user, err := a.userRepo.Find(ctx, userId)
+	if err != nil {
+		return "", "", err
+	}
+
+	totp, err := a.userRepo.FindTotp(ctx, userId)
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		return "", "", err
+	}
+	if totp != nil && totp.Enabled {
+		err = cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("TOTP of user %d is already enabled", userId))
+		cus_otel.Warn(ctx, err.Error())
+		return "", "", err
+	}
+
+	secret, err := a.crypto.GenerateTotpSecret(ctx)
+	if err != nil {
+		return "", "", err
+	}
+	_, err = a.userRepo.SaveTotp(ctx, &entity.UserTotp{
+		UserId: userId,
+		Secret: secret,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return secret, a.crypto.TotpUri(ctx, TotpIssuer, user.Account, secret), nil
+}
+
+// ConfirmTotp enables the pending TOTP secret of the user with the first code and returns the recovery codes.
+func (a *AuthService) ConfirmTotp(ctx context.Context, userId int64, code string) ([]string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	totp, err := a.userRepo.FindTotp(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if totp.Enabled {
+		err = cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("TOTP of user %d is already enabled", userId))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	ok, err := a.checkTotpCode(ctx, userId, totp.Secret, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		err = cus_err.New(cus_err.InvalidVerificationCode, "Invalid TOTP code")
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	return a.enableTotp(ctx, userId, totp.Secret)
+}
+
+// enableTotp enables the secret of the user with new recovery codes, only the hashes of the codes are stored.
+func (a *AuthService) enableTotp(ctx context.Context, userId int64, secret string) ([]string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	codes := make([]string, 0, RecoveryCodeCount)
+	hashes := make([]string, 0, RecoveryCodeCount)
+	for i := 0; i < RecoveryCodeCount; i++ {
+		b, err := a.crypto.GenerateRandomSecret(ctx, recoveryCodeLength)
+		if err != nil {
+			return nil, err
+		}
+		code := a.crypto.EncodeHex(ctx, b)
+		hash, err := a.crypto.HashPassword(ctx, code)
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, code)
+		hashes = append(hashes, hash)
+	}
+
+	now := time.Now()
+	_, err := a.userRepo.SaveTotp(ctx, &entity.UserTotp{
+		UserId:        userId,
+		Secret:        secret,
+		Enabled:       true,
+		RecoveryCodes: hashes,
+		EnabledAt:     &now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// checkTotpOrRecoveryCode checks the code is a valid TOTP code or an unused recovery code,
+// the recovery code is removed once it's used.
+func (a *AuthService) checkTotpOrRecoveryCode(ctx context.Context, totp *entity.UserTotp, code string) (bool, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	code = strings.ToLower(strings.TrimSpace(code))
+	ok, err := a.checkTotpCode(ctx, totp.UserId, totp.Secret, code)
+	if err != nil || ok {
+		return ok, err
+	}
+
+	for i, hash := range totp.RecoveryCodes {
+		if !a.crypto.CompareHashAndPassword(ctx, hash, code) {
+			continue
+		}
+
+		// Consume the recovery code
+		totp.RecoveryCodes = append(totp.RecoveryCodes[:i:i], totp.RecoveryCodes[i+1:]...)
+		_, err := a.userRepo.SaveTotp(ctx, totp)
+		if err != nil {
+			return false, err
+		}
+		cus_otel.Info(ctx, fmt.Sprintf("Recovery code of user %d is used, %d left", totp.UserId, len(totp.RecoveryCodes)))
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// checkTotpCode checks the TOTP code of the user, a code is accepted only once.
+// The time step of the code has to be after the last accepted one, so the used code and the earlier codes can't be replayed.
+func (a *AuthService) checkTotpCode(ctx context.Context, userId int64, secret string, code string) (bool, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	step, ok := a.crypto.ValidateTotpCode(ctx, secret, code, time.Now())
+	if !ok {
+		return false, nil
+	}
+
+	ok, err := a.tokenRepo.SaveTotpStep(ctx, userId, step, cus_crypto.TotpStepWindow)
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		cus_otel.Warn(ctx, fmt.Sprintf("TOTP code of user %d is replayed", userId))
+	}
+
+	return ok, nil
+}
+
+// failChallenge counts the wrong code of the challenge, the challenge is dropped after too many attempts.
+func (a *AuthService) failChallenge(ctx context.Context, challenge *vo.SecondFactorChallenge) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	attempts, err := a.tokenRepo.FailChallenge(ctx, challenge.Token, SecondFactorMaxAttempts)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Challenge token is expired", err)
+			cus_otel.Warn(ctx, err.Error())
+		}
+		return err
+	}
+
+	err = cus_err.New(cus_err.InvalidVerificationCode, "Invalid second factor code").
+		WithData(map[string]interface{}{
+			"errorCount":    attempts,
+			"totalAttempts": SecondFactorMaxAttempts,
+		})
+	cus_otel.Warn(ctx, err.Error())
+	return err
+}
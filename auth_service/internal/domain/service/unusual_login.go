@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/aggregate"
+	"go_micro_service_api/auth_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+)
+
+const (
+	// PendingLoginExpireSecs is how long an unusual login waits for the verification
+	PendingLoginExpireSecs = 600
+	// pendingLoginTokenLength is the number of random bytes of a pending login token
+	pendingLoginTokenLength = 32
+)
+
+// holdUnusualLogin creates the pending login and returns the UnusualLogin error with the pending login token.
+// No token is issued until the pending login is completed by CompleteUnusualLogin.
+func (a *AuthService) holdUnusualLogin(
+	ctx context.Context,
+	client *aggregate.Client,
+	user *aggregate.User,
+	clientTokenKey string,
+	forceLogin bool,
+	device vo.Device,
+) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	b, err := a.crypto.GenerateRandomSecret(ctx, pendingLoginTokenLength)
+	if err != nil {
+		return err
+	}
+	pendingLogin := &vo.PendingLogin{
+		Token:          a.crypto.EncodeHex(ctx, b),
+		UserId:         user.Id,
+		ClientId:       client.Id,
+		ClientTokenKey: clientTokenKey,
+		ForceLogin:     forceLogin,
+		Device:         device,
+		ExpireSecs:     PendingLoginExpireSecs,
+	}
+	err = a.tokenRepo.SavePendingLogin(ctx, pendingLogin)
+	if err != nil {
+		return err
+	}
+
+	err = cus_err.New(cus_err.UnusualLogin, fmt.Sprintf("Unusual login detected for user %d", user.Id)).
+		WithData(map[string]interface{}{
+			"pendingLoginToken": pendingLogin.Token,
+			"expireSecs":        pendingLogin.ExpireSecs,
+		})
+	cus_otel.Warn(ctx, err.Error())
+	return err
+}
+
+// FindPendingLogin finds the pending login without consuming it,
+// the unusualLogin verification has to be sent to the contact of its user.
+func (a *AuthService) FindPendingLogin(ctx context.Context, pendingLoginToken string) (*vo.PendingLogin, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	pendingLogin, err := a.tokenRepo.FindPendingLogin(ctx, pendingLoginToken)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Pending login token is expired", err)
+			cus_otel.Warn(ctx, err.Error())
+		}
+		return nil, err
+	}
+
+	return pendingLogin, nil
+}
+
+// CompleteUnusualLogin exchanges the pending login for the tokens after the user passes the unusualLogin verification.
+// The userId is the owner of the verified identity, it must be the user of the pending login.
+// The device of the login is trusted, so the next login from it is not held back.
+func (a *AuthService) CompleteUnusualLogin(ctx context.Context, pendingLoginToken string, userId int64) (*vo.LoginTokenList, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// The pending login can be used only once
+	pendingLogin, err := a.tokenRepo.ConsumePendingLogin(ctx, pendingLoginToken)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Pending login token is expired", err)
+			cus_otel.Warn(ctx, err.Error())
+		}
+		return nil, err
+	}
+	if pendingLogin.UserId != userId {
+		err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("Pending login is not issued for user %d", userId))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	// Check the client and the user are still allowed to login
+	client, err := a.clientRepo.Find(ctx, pendingLogin.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	if !client.Active {
+		err = cus_err.New(cus_err.ClientInactive, fmt.Sprintf("Client id: %v is not active", client.Id))
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+	user, err := a.userRepo.Find(ctx, pendingLogin.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status != enum.UserStatusType.Active {
+		err = cus_err.New(cus_err.AccountLocked, fmt.Sprintf("User id: %v is not active", user.Id))
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	err = a.userRepo.AddTrustedDevice(ctx, user.Id, pendingLogin.Device)
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := a.completeLogin(ctx, client, user, pendingLogin.ClientTokenKey, pendingLogin.ForceLogin, pendingLogin.Device)
+	if err != nil {
+		return nil, err
+	}
+
+	// The held back login is recorded as failed, record the completed one
+	_, err = a.userRepo.AddLoginRecord(ctx, user.Id, newLoginRecord(pendingLogin.Device, nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
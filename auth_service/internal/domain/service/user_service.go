@@ -2,24 +2,40 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"go_micro_service_api/auth_service/internal/domain/aggregate"
+	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/repository"
 	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/pkg/cus_crypto"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+	"time"
 )
 
 type UserService struct {
 	clientRepo repository.ClientRepo
 	userRepo   repository.UserRepo
+	tokenRepo  repository.TokenRepo
+	eventRepo  repository.EventRepo
 	crypto     cus_crypto.CusCrypto
 }
 
-func NewUserService(clientRepo repository.ClientRepo, userRepo repository.UserRepo) *UserService {
+const (
+	// PasswordResetTokenExpireSecs is how long the user can reset the password after the verification
+	PasswordResetTokenExpireSecs = 900
+
+	// passwordResetTokenLength is the number of random bytes of a password reset token
+	passwordResetTokenLength = 32
+)
+
+func NewUserService(clientRepo repository.ClientRepo, userRepo repository.UserRepo, tokenRepo repository.TokenRepo, eventRepo repository.EventRepo) *UserService {
 	return &UserService{
 		userRepo:   userRepo,
 		clientRepo: clientRepo,
+		tokenRepo:  tokenRepo,
+		eventRepo:  eventRepo,
 		crypto:     cus_crypto.New(),
 	}
 }
@@ -30,7 +46,7 @@ func (u *UserService) CreateUser(ctx context.Context, clientId int64, userInfo v
 	defer span.End()
 
 	// Find client
-	_, err := u.clientRepo.Find(ctx, clientId)
+	client, err := u.clientRepo.Find(ctx, clientId)
 	if err != nil {
 		return nil, err
 	}
@@ -54,26 +70,44 @@ func (u *UserService) CreateUser(ctx context.Context, clientId int64, userInfo v
 		return nil, err
 	}
 
-	// Password could be empty ,if not empty, hash with Md5 , and encode with base64
+	// Create user
+	user := &aggregate.User{
+		Id:      userInfo.Id,
+		Account: userInfo.Account,
+		Status:  userInfo.Status,
+	}
+
+	// Password could be empty, if not empty, check it by the password policy and hash it
 	if userInfo.Password != "" {
-		hashPwd, err := u.crypto.HashPassword(ctx, userInfo.Password)
+		err = u.setPassword(ctx, client, user, userInfo.Password)
 		if err != nil {
 			return nil, err
 		}
-		userInfo.Password = hashPwd
 	}
 
-	// Create user
-	user := &aggregate.User{
-		Id:       userInfo.Id,
-		Account:  userInfo.Account,
-		Status:   userInfo.Status,
-		Password: userInfo.Password,
+	user, err = u.userRepo.Create(ctx, clientId, user)
+	if err != nil {
+		return nil, err
 	}
 
-	user, err = u.userRepo.Create(ctx, clientId, user)
+	if userInfo.Password != "" {
+		err = u.userRepo.AddPasswordHistory(ctx, user.Id, user.Password)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return user, err
+	err = u.eventRepo.AddEvents(ctx, vo.NewUserCreatedEvent(vo.UserCreatedPayload{
+		UserId:   user.Id,
+		ClientId: clientId,
+		Account:  user.Account,
+		Status:   user.Status.Int(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
 }
 
 func (u *UserService) UpdateUser(ctx context.Context, userInfo vo.UserInfo) (*aggregate.User, *cus_err.CusError) {
@@ -97,15 +131,17 @@ func (u *UserService) UpdateUser(ctx context.Context, userInfo vo.UserInfo) (*ag
 	user.Account = userInfo.Account
 	user.Status = userInfo.Status
 
-	if userInfo.Password != "" {
-		secretByte := u.crypto.HashMD5(ctx, userInfo.Password)
-		user.Password = u.crypto.EncodeBase64(ctx, secretByte)
+	if userInfo.Password == "" {
+		// Update user
+		return u.userRepo.Update(ctx, user)
 	}
 
-	// Update user
-	user, err = u.userRepo.Update(ctx, user)
+	client, err := user.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	return user, err
+	return u.updatePassword(ctx, client, user, userInfo.Password)
 }
 
 func (u *UserService) GetUser(ctx context.Context, userId int64) (*aggregate.User, *cus_err.CusError) {
@@ -129,3 +165,475 @@ func (u *UserService) CheckAccountExistence(ct context.Context, Account string)
 
 	return exist, err
 }
+
+// CreatePasswordResetToken issues a one-time token to reset the password of the user,
+// the caller must verify the identity of the user before.
+func (u *UserService) CreatePasswordResetToken(ctx context.Context, userId int64) (*vo.PasswordResetToken, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Find user
+	user, err := u.userRepo.Find(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate token
+	b, err := u.crypto.GenerateRandomSecret(ctx, passwordResetTokenLength)
+	if err != nil {
+		return nil, err
+	}
+
+	resetToken := &vo.PasswordResetToken{
+		Token:      u.crypto.EncodeHex(ctx, b),
+		UserId:     user.Id,
+		ExpireSecs: PasswordResetTokenExpireSecs,
+	}
+	err = u.tokenRepo.SavePasswordResetToken(ctx, resetToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return resetToken, nil
+}
+
+// ResetPassword consumes the password reset token and sets the new password of its user.
+// The password fail times are cleared and a locked account is unlocked.
+func (u *UserService) ResetPassword(ctx context.Context, resetToken string, password string) (*aggregate.User, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Check the parameters
+	if resetToken == "" || password == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "reset token and password are required")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Consume token, it can't be used again even if the reset fails
+	token, err := u.tokenRepo.ConsumePasswordResetToken(ctx, resetToken)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.TokenExpired, "Password reset token is expired", err)
+		}
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Find user
+	user, err := u.userRepo.Find(ctx, token.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := user.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	user.PasswordFailTimes = 0
+	user.LockCount = 0
+	if user.Status == enum.UserStatusType.Locked {
+		user.Unlock()
+	}
+
+	return u.updatePassword(ctx, client, user, password)
+}
+
+// ChangePassword sets the new password of the user after the current password is confirmed.
+func (u *UserService) ChangePassword(ctx context.Context, userId int64, currentPassword string, newPassword string) (*aggregate.User, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Check the parameters
+	if currentPassword == "" || newPassword == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "current password and new password are required")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Find user
+	user, err := u.userRepo.Find(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !u.crypto.CompareHashAndPassword(ctx, user.Password, currentPassword) {
+		err = cus_err.New(cus_err.AccountPasswordError, "Current password is incorrect")
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	client, err := user.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.updatePassword(ctx, client, user, newPassword)
+}
+
+// UnlockUser unlocks the user of the client before the lockout expires.
+// The lockout escalation is reset, the next lockout of the user starts from the first lockout duration again.
+func (u *UserService) UnlockUser(ctx context.Context, clientId int64, userId int64) (*aggregate.User, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Find user
+	user, err := u.userRepo.Find(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the user belongs to the client
+	client, err := user.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if client.Id != clientId {
+		err = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("User id: %v not found in client id: %v", user.Id, clientId))
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	if user.Status != enum.UserStatusType.Locked {
+		err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("User id: %v is not locked", user.Id))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	user.Unlock()
+	user.LockCount = 0
+
+	return u.userRepo.Update(ctx, user)
+}
+
+// DeleteUser removes the user of the client who has never logged in, it compensates the registration which fails after the user is created.
+// Deleting a deleted user is a no-op so the compensation can be retried.
+func (u *UserService) DeleteUser(ctx context.Context, clientId int64, userId int64) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Find user
+	user, err := u.userRepo.Find(ctx, userId)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			return nil
+		}
+		return err
+	}
+
+	// Check the user belongs to the client
+	client, err := user.Client(ctx)
+	if err != nil {
+		return err
+	}
+	if client.Id != clientId {
+		err = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("User id: %v not found in client id: %v", user.Id, clientId))
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	// The user who has logged in is a real user, it must not be removed.
+	// The failed logins don't count, anyone can try the account of a registering user
+	_, err = u.userRepo.GetLastSuccessfulLoginRecord(ctx, user.Id)
+	if err == nil {
+		err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("User id: %v has logged in and can't be deleted", user.Id))
+		cus_otel.Warn(ctx, err.Error())
+		return err
+	}
+	if err.Code().Int() != cus_err.ResourceNotFound {
+		return err
+	}
+
+	err = u.userRepo.Delete(ctx, user.Id)
+	if err != nil {
+		return err
+	}
+
+	return u.eventRepo.AddEvents(ctx, vo.NewUserDeletedEvent(vo.UserDeletedPayload{
+		UserId:   user.Id,
+		ClientId: clientId,
+	}))
+}
+
+// PromoteKycVerifiedUser binds the KycVerifiedPlayer role to the user after the KYC submission is approved.
+// The client of the user must have the role, promoting a verified user again is a no-op.
+func (u *UserService) PromoteKycVerifiedUser(ctx context.Context, userId int64) (*aggregate.User, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Find user
+	user, err := u.userRepo.Find(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the frontend clients have the role
+	client, err := user.Client(ctx)
+	if err != nil {
+		return nil, err
+	}
+	role, err := u.clientRepo.FindRole(ctx, client.Id, entity.FrontendRoles.KycVerifiedPlayer.Id)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("Client id: %v has no KYC verified role", client.Id), err)
+		}
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	current, err := user.Role(ctx)
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		return nil, err
+	}
+	if current != nil && current.Id == role.Id {
+		return user, nil
+	}
+
+	user, err = u.userRepo.BindRole(ctx, user.Id, role.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := vo.UserRoleChangedPayload{
+		UserId:   user.Id,
+		ClientId: client.Id,
+		RoleId:   role.Id,
+	}
+	if current != nil {
+		payload.PreviousRoleId = current.Id
+	}
+	err = u.eventRepo.AddEvents(ctx, vo.NewUserRoleChangedEvent(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// ListUserLoginRecords finds a page of the login records of the user
+func (u *UserService) ListUserLoginRecords(ctx context.Context, userId int64, filter vo.LoginRecordFilter, pagination vo.Pagination) (*vo.LoginRecordPage, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if err := u.validateLoginRecordFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	filter.UserId = &userId
+	filter.MerchantId = nil
+
+	return u.userRepo.FindLoginRecords(ctx, filter, pagination)
+}
+
+// ListMerchantLoginRecords finds a page of the login records of the users of the operator's merchant.
+// The operator must be a user of a backend client with a backend role, the user id of the filter is optional.
+func (u *UserService) ListMerchantLoginRecords(ctx context.Context, operator *vo.TokenPayload, filter vo.LoginRecordFilter, pagination vo.Pagination) (*vo.LoginRecordPage, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client, err := u.clientRepo.Find(ctx, operator.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	if client.ClientType != enum.ClientType.Backend || !isBackendRole(operator.RoleId) {
+		err = cus_err.New(cus_err.NoPermission, fmt.Sprintf("Client id: %v can't query the login records of the merchant", client.Id))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	if err = u.validateLoginRecordFilter(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	merchantId := client.MerchantId
+	filter.MerchantId = &merchantId
+
+	return u.userRepo.FindLoginRecords(ctx, filter, pagination)
+}
+
+// validateLoginRecordFilter checks the time range of the filter
+func (u *UserService) validateLoginRecordFilter(ctx context.Context, filter vo.LoginRecordFilter) *cus_err.CusError {
+	if filter.StartTime != nil && filter.EndTime != nil && !filter.StartTime.Before(*filter.EndTime) {
+		err := cus_err.New(cus_err.InvalidArgument, "start time must be before end time")
+		cus_otel.Warn(ctx, err.Error())
+		return err
+	}
+	return nil
+}
+
+// SearchUsers finds a page of the users matching the search, sorted by the column of the search and then the id.
+// The next cursor is only returned when there are more users after the page.
+func (u *UserService) SearchUsers(ctx context.Context, search vo.UserSearch) (*vo.UserSearchPage, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	switch search.Sort {
+	case "":
+		search.Sort = vo.UserSortCreatedAt
+	case vo.UserSortCreatedAt, vo.UserSortAccount, vo.UserSortId:
+	default:
+		err := cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("Invalid sort: %v", search.Sort))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+	if search.Cursor != nil && (search.Cursor.Sort != search.Sort || search.Cursor.Ascending != search.Ascending) {
+		err := cus_err.New(cus_err.InvalidArgument, "the cursor doesn't match the sort")
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+	if search.CreatedFrom != nil && search.CreatedTo != nil && !search.CreatedFrom.Before(*search.CreatedTo) {
+		err := cus_err.New(cus_err.InvalidArgument, "created from must be before created to")
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+	if search.Limit < 1 {
+		search.Limit = vo.DefaultPageSize
+	}
+	if search.Limit > vo.MaxPageSize {
+		search.Limit = vo.MaxPageSize
+	}
+	page := &vo.UserSearchPage{Users: []*vo.UserSummary{}}
+	if search.UserIds != nil && len(search.UserIds) == 0 {
+		return page, nil
+	}
+
+	// Find one more user to know whether there is a next page
+	limit := search.Limit
+	search.Limit++
+	users, err := u.userRepo.SearchUsers(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+	if len(users) <= limit {
+		page.Users = users
+		return page, nil
+	}
+
+	page.Users = users[:limit]
+	last := page.Users[limit-1]
+	page.NextCursor = &vo.UserCursor{
+		Sort:      search.Sort,
+		Ascending: search.Ascending,
+		Account:   last.Account,
+		CreatedAt: last.CreatedAt,
+		Id:        last.Id,
+	}
+	return page, nil
+}
+
+// isBackendRole checks the role is one of the backend roles
+func isBackendRole(roleId *int64) bool {
+	if roleId == nil {
+		return false
+	}
+	for _, role := range entity.AllBackendRoles {
+		if role.Id == *roleId {
+			return true
+		}
+	}
+	return false
+}
+
+// updatePassword sets the password to the existing user, saves the user and records the password history.
+func (u *UserService) updatePassword(ctx context.Context, client *aggregate.Client, user *aggregate.User, password string) (*aggregate.User, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	err := u.setPassword(ctx, client, user, password)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update user
+	user, err = u.userRepo.Update(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.userRepo.AddPasswordHistory(ctx, user.Id, user.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// setPassword checks the password by the password policy of the client, then sets its hash to the user.
+func (u *UserService) setPassword(ctx context.Context, client *aggregate.Client, user *aggregate.User, password string) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	policy := client.PasswordPolicy
+	failedRules := policy.Check(password)
+
+	// The password can't be reused, the current password counts as the latest one
+	if policy.HistoryCount > 0 && user.Password != "" {
+		reused, err := u.isPasswordReused(ctx, user, password, policy.HistoryCount)
+		if err != nil {
+			return err
+		}
+		if reused {
+			failedRules = append(failedRules, vo.PasswordRuleHistory)
+		}
+	}
+
+	if len(failedRules) > 0 {
+		err := cus_err.New(cus_err.PasswordPolicyViolation, "Password doesn't meet the password policy").WithData(map[string]interface{}{
+			"failedRules":        failedRules,
+			"minLength":          policy.MinLength,
+			"missingCharClasses": policy.MissingCharClasses(password),
+			"historyCount":       policy.HistoryCount,
+		})
+		cus_otel.Warn(ctx, err.Error())
+		return err
+	}
+
+	hashPwd, err := u.crypto.HashPassword(ctx, password)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	user.Password = hashPwd
+	user.PasswordChangedAt = &now
+
+	return nil
+}
+
+// isPasswordReused checks the password is the current password or one of the last passwords of the user.
+func (u *UserService) isPasswordReused(ctx context.Context, user *aggregate.User, password string, historyCount int) (bool, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	history, err := u.userRepo.FindPasswordHistory(ctx, user.Id, historyCount)
+	if err != nil {
+		return false, err
+	}
+
+	hashes := []string{user.Password}
+	for _, hash := range history {
+		if hash != user.Password && len(hashes) < historyCount {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	for _, hash := range hashes {
+		if u.crypto.CompareHashAndPassword(ctx, hash, password) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -9,4 +9,19 @@ type ClientInfo struct {
 	LoginFailedTimes int
 	TokenExpireSecs  int
 	Active           bool
+	// RefreshTokenExpireSecs falls back to the default lifetime when it is 0
+	RefreshTokenExpireSecs int
+	// SessionPolicy falls back to single session when it is not set
+	SessionPolicy enum.SessionPolicy
+	MaxSessions   int
+	// SigningMethod falls back to HS256 when it is not set
+	SigningMethod enum.SigningMethod
+	// MfaRequired is only allowed for backend clients
+	MfaRequired bool
+	// PasswordPolicy is kept when it is nil on update
+	PasswordPolicy *PasswordPolicy
+	// LockoutPolicy is kept when it is nil on update
+	LockoutPolicy *LockoutPolicy
+	// RiskPolicy is kept when it is nil on update
+	RiskPolicy *RiskPolicy
 }
@@ -0,0 +1,90 @@
+package vo
+
+import (
+	"go_micro_service_api/pkg/outbox"
+	"strconv"
+	"time"
+)
+
+// The aggregates of the domain events
+const (
+	UserAggregate   = "user"
+	ClientAggregate = "client"
+)
+
+// The types of the domain events published to the auth exchange, they are the routing keys.
+// The version of a type is increased when its payload changes incompatibly.
+const (
+	UserCreatedEvent       = "user.created"
+	UserRoleChangedEvent   = "user.roleChanged"
+	UserLockedEvent        = "user.locked"
+	UserDeletedEvent       = "user.deleted"
+	ClientDeactivatedEvent = "client.deactivated"
+)
+
+type UserCreatedPayload struct {
+	UserId   int64  `json:"userId"`
+	ClientId int64  `json:"clientId"`
+	Account  string `json:"account"`
+	Status   int    `json:"status"`
+}
+
+type UserRoleChangedPayload struct {
+	UserId         int64 `json:"userId"`
+	ClientId       int64 `json:"clientId"`
+	RoleId         int64 `json:"roleId"`
+	PreviousRoleId int64 `json:"previousRoleId"` // 0 if the user had no role
+}
+
+type UserLockedPayload struct {
+	UserId      int64      `json:"userId"`
+	ClientId    int64      `json:"clientId"`
+	LockCount   int        `json:"lockCount"`
+	LockedUntil *time.Time `json:"lockedUntil"` // nil until an admin unlocks the user
+}
+
+type UserDeletedPayload struct {
+	UserId   int64 `json:"userId"`
+	ClientId int64 `json:"clientId"`
+}
+
+type ClientDeactivatedPayload struct {
+	ClientId   int64 `json:"clientId"`
+	MerchantId int64 `json:"merchantId"`
+}
+
+func NewUserCreatedEvent(payload UserCreatedPayload) *outbox.Event {
+	return newUserEvent(payload.UserId, UserCreatedEvent, payload)
+}
+
+func NewUserRoleChangedEvent(payload UserRoleChangedPayload) *outbox.Event {
+	return newUserEvent(payload.UserId, UserRoleChangedEvent, payload)
+}
+
+func NewUserLockedEvent(payload UserLockedPayload) *outbox.Event {
+	return newUserEvent(payload.UserId, UserLockedEvent, payload)
+}
+
+func NewUserDeletedEvent(payload UserDeletedPayload) *outbox.Event {
+	return newUserEvent(payload.UserId, UserDeletedEvent, payload)
+}
+
+func NewClientDeactivatedEvent(payload ClientDeactivatedPayload) *outbox.Event {
+	return &outbox.Event{
+		AggregateType: ClientAggregate,
+		AggregateId:   strconv.FormatInt(payload.ClientId, 10),
+		Type:          ClientDeactivatedEvent,
+		Version:       1,
+		Payload:       payload,
+	}
+}
+
+func newUserEvent(userId int64, eventType string, payload any) *outbox.Event {
+	return &outbox.Event{
+		AggregateType: UserAggregate,
+		AggregateId:   strconv.FormatInt(userId, 10),
+		Type:          eventType,
+		Version:       1,
+		Payload:       payload,
+	}
+}
@@ -0,0 +1,31 @@
+package vo
+
+import "time"
+
+// LockoutPolicy decides how long a user is locked after too many failed logins.
+// The user is locked until an admin unlocks it when LockoutSecs is zero.
+type LockoutPolicy struct {
+	LockoutSecs    int // The lock duration of the first lockout, it is doubled on every repeated lockout
+	MaxLockoutSecs int // The upper bound of the escalated lock duration, zero means no bound
+}
+
+// LockedUntil returns the time the lockout expires for the given lockout count, nil means the lock never expires.
+func (p LockoutPolicy) LockedUntil(lockCount int, now time.Time) *time.Time {
+	if p.LockoutSecs <= 0 {
+		return nil
+	}
+
+	secs := p.LockoutSecs
+	for i := 1; i < lockCount; i++ {
+		secs *= 2
+		if p.MaxLockoutSecs > 0 && secs >= p.MaxLockoutSecs {
+			break
+		}
+	}
+	if p.MaxLockoutSecs > 0 && secs > p.MaxLockoutSecs {
+		secs = p.MaxLockoutSecs
+	}
+
+	lockedUntil := now.Add(time.Duration(secs) * time.Second)
+	return &lockedUntil
+}
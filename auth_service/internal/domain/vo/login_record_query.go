@@ -0,0 +1,57 @@
+package vo
+
+import (
+	"go_micro_service_api/auth_service/internal/domain/entity"
+	"time"
+)
+
+const (
+	// DefaultPageSize is the page size of the queries which don't set it
+	DefaultPageSize = 20
+
+	// MaxPageSize caps the page size of the queries
+	MaxPageSize = 100
+)
+
+// Pagination is the page of a query, the page starts from 1
+type Pagination struct {
+	Page     int
+	PageSize int
+}
+
+// Normalize falls back to the first page and the default page size, and caps the page size
+func (p Pagination) Normalize() Pagination {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = DefaultPageSize
+	}
+	if p.PageSize > MaxPageSize {
+		p.PageSize = MaxPageSize
+	}
+	return p
+}
+
+// Offset is the number of rows before the page
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
+
+// LoginRecordFilter filters the login records, the empty fields don't filter
+type LoginRecordFilter struct {
+	UserId      *int64     // Only the records of the user
+	MerchantId  *int64     // Only the records of the users of the merchant
+	StartTime   *time.Time // Inclusive
+	EndTime     *time.Time // Exclusive
+	IsSuccess   *bool
+	Ip          string
+	CountryCode string
+}
+
+// LoginRecordPage is a page of the login records, ordered from the latest
+type LoginRecordPage struct {
+	Records    []*entity.LoginRecord
+	Total      int // The number of the records matching the filter
+	Pagination Pagination
+}
@@ -1,8 +1,11 @@
 package vo
 
 type LoginTokenList struct {
-	Token           string
-	TokenExpireSecs int
-	ErrorCount      int
-	TotalAttempts   int
+	Token                  string
+	TokenExpireSecs        int
+	RefreshToken           string
+	RefreshTokenExpireSecs int
+	ErrorCount             int
+	TotalAttempts          int
+	PasswordExpired        bool // The password is older than the max age of the password policy, the user should change it
 }
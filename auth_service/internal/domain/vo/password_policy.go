@@ -0,0 +1,85 @@
+package vo
+
+import (
+	"go_micro_service_api/pkg/enum"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Names of the password policy rules, they are returned in the error data when a password breaks them
+const (
+	PasswordRuleMinLength   = "minLength"
+	PasswordRuleCharClasses = "charClasses"
+	PasswordRuleHistory     = "history"
+	PasswordRuleBanned      = "banned"
+)
+
+// PasswordPolicy is the password rules of a client, a rule is not checked when it is the zero value.
+type PasswordPolicy struct {
+	MinLength       int
+	CharClasses     []enum.PasswordCharClass // The password must contain at least one character of every class
+	HistoryCount    int                      // The password can't be any of the last N passwords of the user
+	MaxAgeSecs      int                      // The password should be changed once it is older than this
+	BannedPasswords []string                 // Compared case-insensitively
+}
+
+// Check returns the rules broken by the password, the history rule is checked by the caller.
+func (p PasswordPolicy) Check(password string) []string {
+	failedRules := []string{}
+
+	if utf8.RuneCountInString(password) < p.MinLength {
+		failedRules = append(failedRules, PasswordRuleMinLength)
+	}
+
+	if len(p.MissingCharClasses(password)) > 0 {
+		failedRules = append(failedRules, PasswordRuleCharClasses)
+	}
+
+	for _, banned := range p.BannedPasswords {
+		if strings.EqualFold(password, banned) {
+			failedRules = append(failedRules, PasswordRuleBanned)
+			break
+		}
+	}
+
+	return failedRules
+}
+
+// MissingCharClasses returns the required char classes which the password doesn't contain.
+func (p PasswordPolicy) MissingCharClasses(password string) []enum.PasswordCharClass {
+	missing := []enum.PasswordCharClass{}
+	for _, class := range p.CharClasses {
+		if !strings.ContainsFunc(password, charClassMatcher(class)) {
+			missing = append(missing, class)
+		}
+	}
+	return missing
+}
+
+// IsExpired checks the password changed at the given time is older than the max age.
+// A password without the changed time never expires, it is set before the policy exists.
+func (p PasswordPolicy) IsExpired(changedAt *time.Time, now time.Time) bool {
+	if p.MaxAgeSecs <= 0 || changedAt == nil {
+		return false
+	}
+	return !now.Before(changedAt.Add(time.Duration(p.MaxAgeSecs) * time.Second))
+}
+
+func charClassMatcher(class enum.PasswordCharClass) func(r rune) bool {
+	switch class {
+	case enum.PasswordCharClassType.Alpha:
+		return unicode.IsLetter
+	case enum.PasswordCharClassType.Lower:
+		return unicode.IsLower
+	case enum.PasswordCharClassType.Upper:
+		return unicode.IsUpper
+	case enum.PasswordCharClassType.Digit:
+		return unicode.IsDigit
+	default:
+		return func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package vo
+
+// PasswordResetToken is issued after the user passes the forgot password verification.
+//
+// It can only be used once to reset the password of the user.
+type PasswordResetToken struct {
+	Token      string `json:"-"` // Token is never persisted, only its hash is used as the key
+	UserId     int64
+	ExpireSecs int
+}
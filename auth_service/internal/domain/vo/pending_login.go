@@ -0,0 +1,15 @@
+package vo
+
+// PendingLogin is the login held back because it's unusual.
+//
+// It's created when the password is correct but the login comes from an unknown browser in another city,
+// the login is completed by the pending login token after the user passes the unusualLogin verification.
+type PendingLogin struct {
+	Token          string `json:"-"` // Token is never persisted, only its hash is used as the key
+	UserId         int64
+	ClientId       int64
+	ClientTokenKey string // The cache key of the client token, it's deleted when the login is completed
+	ForceLogin     bool
+	Device         Device // The device is trusted when the login is completed
+	ExpireSecs     int
+}
@@ -0,0 +1,13 @@
+package vo
+
+// RefreshToken is the server side state of a refresh token.
+//
+// Every login starts a new session. Each refresh rotates the token inside the same session,
+// so a rotated token being presented again means the session is compromised.
+type RefreshToken struct {
+	Token      string `json:"-"` // Token is never persisted, only its hash is used as the key
+	UserId     int64
+	ClientId   int64
+	SessionId  string
+	ExpireSecs int
+}
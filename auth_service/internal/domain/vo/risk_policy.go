@@ -0,0 +1,44 @@
+package vo
+
+// RiskDecision is what happens to a login after its risk is assessed
+type RiskDecision string
+
+const (
+	RiskDecisionAllow     RiskDecision = "allow"     // The login continues
+	RiskDecisionChallenge RiskDecision = "challenge" // The login is held until the user passes the unusualLogin verification
+	RiskDecisionDeny      RiskDecision = "deny"      // The login is rejected
+)
+
+// DefaultRiskChallengeScore is the challenge threshold of the clients which don't set it
+const DefaultRiskChallengeScore = 50
+
+// RiskPolicy is the score thresholds of a client.
+// A login is challenged from ChallengeScore and denied from DenyScore, it is never denied when DenyScore is zero.
+type RiskPolicy struct {
+	ChallengeScore int // Falls back to DefaultRiskChallengeScore when it is zero
+	DenyScore      int
+}
+
+// Decide returns the decision of the score
+func (p RiskPolicy) Decide(score int) RiskDecision {
+	challengeScore := p.ChallengeScore
+	if challengeScore <= 0 {
+		challengeScore = DefaultRiskChallengeScore
+	}
+
+	switch {
+	case p.DenyScore > 0 && score >= p.DenyScore:
+		return RiskDecisionDeny
+	case score >= challengeScore:
+		return RiskDecisionChallenge
+	default:
+		return RiskDecisionAllow
+	}
+}
+
+// RiskAssessment is the result of the risk engine
+type RiskAssessment struct {
+	Score    int
+	Reasons  []string // The names of the matched signals
+	Decision RiskDecision
+}
@@ -0,0 +1,16 @@
+package vo
+
+// SecondFactorChallenge is the pending login waiting for the second factor.
+//
+// It's created when the password is correct but the user has to pass two-factor authentication,
+// the login is completed by the challenge token and a TOTP code or a recovery code.
+type SecondFactorChallenge struct {
+	Token          string `json:"-"` // Token is never persisted, only its hash is used as the key
+	UserId         int64
+	ClientId       int64
+	ClientTokenKey string // The cache key of the client token, it's deleted when the login is completed
+	ForceLogin     bool
+	Device         Device
+	PendingSecret  string // The TOTP secret to enable, only set when the user is enrolled during the login
+	ExpireSecs     int    // The wrong codes are counted apart from the challenge, see TokenRepo.FailChallenge
+}
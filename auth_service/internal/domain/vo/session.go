@@ -0,0 +1,47 @@
+package vo
+
+import "time"
+
+// Session is a logged in device of a user.
+//
+// Every login starts a new session, the refresh tokens of the session are rotated inside it,
+// so a session is also the refresh token family.
+type Session struct {
+	Id           string
+	UserId       int64
+	ClientId     int64
+	Device       Device
+	CreateAt     time.Time
+	LastActiveAt time.Time // Renewed on every refresh
+	ExpireAt     time.Time // The session lives as long as its latest refresh token
+}
+
+// IsExpired checks the session is expired at the given time
+func (s *Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpireAt)
+}
+
+// SessionLimit is how many sessions a user can keep when a new session starts, 0 MaxSessions means no limit.
+// When the limit is reached the least recently active sessions are evicted if Evict, otherwise the new session is rejected.
+type SessionLimit struct {
+	MaxSessions int
+	Evict       bool
+}
+
+// Device is the metadata of the device which starts the session
+type Device struct {
+	Ip         string
+	Browser    string
+	BrowserVer string
+	Os         string
+	Platform   string
+	IsMobile   bool
+	IsBot      bool
+	// The location and the network of the ip, they are used to assess the risk of the login
+	City        string
+	Country     string
+	CountryCode string
+	Latitude    float64
+	Longitude   float64
+	Asp         string
+}
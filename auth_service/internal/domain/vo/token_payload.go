@@ -15,16 +15,27 @@ const (
 	_accountKey  = "acc"
 	_merchantId  = "mid"
 	_issueAt     = "iat"
+	_sessionId   = "sid"
+	_expireAt    = "exp"
+	_notBefore   = "nbf"
+	_issuer      = "iss"
+	_audience    = "aud"
+	_tokenId     = "jti"
 )
 
 type TokenPayload struct {
-	MerchantId int64   // Merchant id is required
-	ClientId   int64   // Client id is required
-	IssueAt    int64   // Issue at is required
-	UserId     *int64  // User if could be nil
-	Account    *string // Account could be nil
-	RoleId     *int64  // Role id could be nil
-
+	MerchantId int64    // Merchant id is required
+	ClientId   int64    // Client id is required
+	IssueAt    int64    // Issue at is required
+	UserId     *int64   // User if could be nil
+	Account    *string  // Account could be nil
+	RoleId     *int64   // Role id could be nil
+	SessionId  *string  // Session id could be nil, only user tokens have it
+	ExpireAt   int64    // Expire at could be 0 for the tokens issued before it's added
+	NotBefore  int64    // Not before is the same as issue at by default
+	Issuer     string   // Issuer is stamped by the token helper if it's empty
+	Audience   []string // Audience is stamped by the token helper if it's empty
+	TokenId    string   // Token id is unique of each token
 }
 
 type TokenPayloadOption func(*TokenPayload)
@@ -47,11 +58,44 @@ func WithAccount(account string) TokenPayloadOption {
 	}
 }
 
+func WithSessionId(sessionId string) TokenPayloadOption {
+	return func(tp *TokenPayload) {
+		tp.SessionId = &sessionId
+	}
+}
+
+// WithExpireSecs sets the token to expire after the given seconds from the issue time.
+func WithExpireSecs(expireSecs int) TokenPayloadOption {
+	return func(tp *TokenPayload) {
+		tp.ExpireAt = tp.IssueAt + int64(expireSecs)
+	}
+}
+
+func WithIssuer(issuer string) TokenPayloadOption {
+	return func(tp *TokenPayload) {
+		tp.Issuer = issuer
+	}
+}
+
+func WithAudience(audience ...string) TokenPayloadOption {
+	return func(tp *TokenPayload) {
+		tp.Audience = audience
+	}
+}
+
+func WithTokenId(tokenId string) TokenPayloadOption {
+	return func(tp *TokenPayload) {
+		tp.TokenId = tokenId
+	}
+}
+
 func NewTokenPayload(merchantId int64, clientId int64, opts ...TokenPayloadOption) TokenPayload {
+	now := time.Now().Unix()
 	tp := TokenPayload{
 		MerchantId: merchantId,
 		ClientId:   clientId,
-		IssueAt:    time.Now().Unix(),
+		IssueAt:    now,
+		NotBefore:  now,
 	}
 
 	for _, opt := range opts {
@@ -135,6 +179,58 @@ func ToTokenPayload(ctx context.Context, payload map[string]interface{}) (TokenP
 		}
 	}
 
+	// Try to get session id from payload
+	if sidVal, exists := payload[_sessionId]; exists {
+		if sid, ok := sidVal.(string); ok {
+			tp.SessionId = &sid
+		}
+	}
+
+	// Try to get expire at from payload
+	if expVal, exists := payload[_expireAt]; exists {
+		if exp, ok := expVal.(float64); ok {
+			tp.ExpireAt = int64(exp)
+		}
+	}
+
+	// Try to get not before from payload
+	if nbfVal, exists := payload[_notBefore]; exists {
+		if nbf, ok := nbfVal.(float64); ok {
+			tp.NotBefore = int64(nbf)
+		}
+	}
+
+	// Try to get issuer from payload
+	if issVal, exists := payload[_issuer]; exists {
+		if iss, ok := issVal.(string); ok {
+			tp.Issuer = iss
+		}
+	}
+
+	// Try to get audience from payload
+	// the audience could be a string or a list of strings
+	if audVal, exists := payload[_audience]; exists {
+		switch aud := audVal.(type) {
+		case string:
+			tp.Audience = []string{aud}
+		case []string:
+			tp.Audience = aud
+		case []interface{}:
+			for _, a := range aud {
+				if audStr, ok := a.(string); ok {
+					tp.Audience = append(tp.Audience, audStr)
+				}
+			}
+		}
+	}
+
+	// Try to get token id from payload
+	if jtiVal, exists := payload[_tokenId]; exists {
+		if jti, ok := jtiVal.(string); ok {
+			tp.TokenId = jti
+		}
+	}
+
 	return tp, nil
 }
 
@@ -157,5 +253,29 @@ func (t *TokenPayload) ToMap() map[string]any {
 		payload[_accountKey] = *t.Account
 	}
 
+	if t.SessionId != nil {
+		payload[_sessionId] = *t.SessionId
+	}
+
+	if t.ExpireAt != 0 {
+		payload[_expireAt] = t.ExpireAt
+	}
+
+	if t.NotBefore != 0 {
+		payload[_notBefore] = t.NotBefore
+	}
+
+	if t.Issuer != "" {
+		payload[_issuer] = t.Issuer
+	}
+
+	if len(t.Audience) > 0 {
+		payload[_audience] = t.Audience
+	}
+
+	if t.TokenId != "" {
+		payload[_tokenId] = t.TokenId
+	}
+
 	return payload
 }
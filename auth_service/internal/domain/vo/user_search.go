@@ -0,0 +1,82 @@
+package vo
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
+// UserSort is the column the users are sorted by, the id breaks the ties
+type UserSort string
+
+const (
+	UserSortCreatedAt UserSort = "createdAt"
+	UserSortAccount   UserSort = "account"
+	UserSortId        UserSort = "id"
+)
+
+// UserSearch filters the users, the empty fields don't filter
+type UserSearch struct {
+	UserIds       []int64 // Only the users of the ids, it doesn't filter when it is nil
+	AccountPrefix string
+	Status        *enum.UserStatus
+	MerchantId    *int64     // Only the users of the clients of the merchant
+	ClientId      *int64     // Only the users of the client
+	CreatedFrom   *time.Time // Inclusive
+	CreatedTo     *time.Time // Exclusive
+	Sort          UserSort
+	Ascending     bool
+	Cursor        *UserCursor // The position after which the page starts, nil for the first page
+	Limit         int
+}
+
+// UserCursor is the sort key of the last user of a page.
+//
+// The sort and the order are kept so a cursor can't be reused with another sort.
+type UserCursor struct {
+	Sort      UserSort
+	Ascending bool
+	Account   string
+	CreatedAt time.Time
+	Id        int64
+}
+
+// Encode encodes the cursor as an opaque string for the clients
+func (c UserCursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeUserCursor decodes the cursor returned by the previous page
+func DecodeUserCursor(cursor string) (*UserCursor, *cus_err.CusError) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, cus_err.New(cus_err.InvalidArgument, "invalid cursor", err)
+	}
+
+	res := &UserCursor{}
+	if err = json.Unmarshal(b, res); err != nil {
+		return nil, cus_err.New(cus_err.InvalidArgument, "invalid cursor", err)
+	}
+	return res, nil
+}
+
+// UserSummary is the user with the role and the client, listed by the backend
+type UserSummary struct {
+	Id         int64
+	Account    string
+	Status     enum.UserStatus
+	RoleId     int64
+	RoleName   string
+	ClientId   int64
+	MerchantId int64
+	CreatedAt  time.Time
+}
+
+// UserSearchPage is a page of the users, NextCursor is nil on the last page
+type UserSearchPage struct {
+	Users      []*UserSummary
+	NextCursor *UserCursor
+}
@@ -6,6 +6,7 @@ import (
 	"go_micro_service_api/auth_service/internal/domain/aggregate"
 	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/repository"
+	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/authclient"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/role"
@@ -78,15 +79,37 @@ func (c *ClientRepoImpl) Find(ctx context.Context, id int64) (*aggregate.Client,
 		return nil, cusErr
 	}
 
+	// Map session policy to enum
+	sessionPolicy, cusErr := enum.SessionPolicyFromInt(entEntity.SessionPolicy)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Map signing method to enum
+	signingMethod, cusErr := enum.SigningMethodFromId(entEntity.SigningMethod)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
 	// Create aggregate client
 	authClient := &aggregate.Client{
-		Id:               entEntity.ID,
-		ClientType:       clientType,
-		MerchantId:       entEntity.MerchantID,
-		Secret:           entEntity.Secret,
-		Active:           entEntity.Active,
-		TokenExpireSecs:  entEntity.TokenExpireSecs,
-		LoginFailedTimes: entEntity.LoginFailedTimes,
+		Id:                     entEntity.ID,
+		ClientType:             clientType,
+		MerchantId:             entEntity.MerchantID,
+		Secret:                 entEntity.Secret,
+		Active:                 entEntity.Active,
+		TokenExpireSecs:        entEntity.TokenExpireSecs,
+		LoginFailedTimes:       entEntity.LoginFailedTimes,
+		RefreshTokenExpireSecs: entEntity.RefreshTokenExpireSecs,
+		SessionPolicy:          sessionPolicy,
+		MaxSessions:            entEntity.MaxSessions,
+		SigningMethod:          signingMethod,
+		MfaRequired:            entEntity.MfaRequired,
+		PasswordPolicy:         toPasswordPolicy(entEntity),
+		LockoutPolicy:          toLockoutPolicy(entEntity),
+		RiskPolicy:             toRiskPolicy(entEntity),
 	}
 	setClientLoader(c.db, authClient)
 
@@ -136,7 +159,7 @@ func (c *ClientRepoImpl) Create(ctx context.Context, authClient *aggregate.Clien
 	}
 
 	// Create client
-	entity, err := tx.AuthClient.Create().
+	create := tx.AuthClient.Create().
 		SetID(authClient.Id).
 		SetMerchantID(authClient.MerchantId).
 		SetClientType(authClient.ClientType.Id).
@@ -144,28 +167,66 @@ func (c *ClientRepoImpl) Create(ctx context.Context, authClient *aggregate.Clien
 		SetActive(authClient.Active).
 		SetTokenExpireSecs(authClient.TokenExpireSecs).
 		SetLoginFailedTimes(authClient.LoginFailedTimes).
-		Save(ctx)
+		SetRefreshTokenExpireSecs(authClient.RefreshTokenExpireSecs).
+		SetMfaRequired(authClient.MfaRequired).
+		SetPasswordMinLength(authClient.PasswordPolicy.MinLength).
+		SetPasswordCharClasses(authClient.PasswordPolicy.CharClasses).
+		SetPasswordHistoryCount(authClient.PasswordPolicy.HistoryCount).
+		SetPasswordMaxAgeSecs(authClient.PasswordPolicy.MaxAgeSecs).
+		SetBannedPasswords(authClient.PasswordPolicy.BannedPasswords).
+		SetLockoutSecs(authClient.LockoutPolicy.LockoutSecs).
+		SetMaxLockoutSecs(authClient.LockoutPolicy.MaxLockoutSecs).
+		SetRiskChallengeScore(authClient.RiskPolicy.ChallengeScore).
+		SetRiskDenyScore(authClient.RiskPolicy.DenyScore)
+
+	// Session policy falls back to the schema default when it is not set
+	if authClient.SessionPolicy != 0 {
+		create.SetSessionPolicy(authClient.SessionPolicy.Int())
+	}
+	if authClient.MaxSessions != 0 {
+		create.SetMaxSessions(authClient.MaxSessions)
+	}
+	if authClient.SigningMethod.Id != 0 {
+		create.SetSigningMethod(authClient.SigningMethod.Id)
+	}
+
+	entity, err := create.Save(ctx)
 	if err != nil {
 		cusErr := cus_err.New(cus_err.InternalServerError, "failed to create client", err)
 		cus_otel.Error(ctx, cusErr.Error())
 		return nil, cusErr
 	}
 
+	// Map signing method to enum
+	signingMethod, cusErr := enum.SigningMethodFromId(entity.SigningMethod)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
 	// Create aggregate client
 	createdClient := &aggregate.Client{
-		Id:               entity.ID,
-		ClientType:       authClient.ClientType,
-		MerchantId:       entity.MerchantID,
-		Secret:           entity.Secret,
-		Active:           entity.Active,
-		TokenExpireSecs:  entity.TokenExpireSecs,
-		LoginFailedTimes: entity.LoginFailedTimes,
+		Id:                     entity.ID,
+		ClientType:             authClient.ClientType,
+		MerchantId:             entity.MerchantID,
+		Secret:                 entity.Secret,
+		Active:                 entity.Active,
+		TokenExpireSecs:        entity.TokenExpireSecs,
+		LoginFailedTimes:       entity.LoginFailedTimes,
+		RefreshTokenExpireSecs: entity.RefreshTokenExpireSecs,
+		SessionPolicy:          enum.SessionPolicy(entity.SessionPolicy),
+		MaxSessions:            entity.MaxSessions,
+		SigningMethod:          signingMethod,
+		MfaRequired:            entity.MfaRequired,
+		PasswordPolicy:         toPasswordPolicy(entity),
+		LockoutPolicy:          toLockoutPolicy(entity),
+		RiskPolicy:             toRiskPolicy(entity),
 	}
 	setClientLoader(c.db, createdClient)
 
 	// Save client in cache
 	key := fmt.Sprintf("%s:%d", ClientInfoPrefix, createdClient.Id)
-	cusErr := c.cache.SetObject(ctx, key, createdClient, 0)
+	cusErr = c.cache.SetObject(ctx, key, createdClient, 0)
 	if cusErr != nil {
 		cus_otel.Error(ctx, cusErr.Error())
 		return nil, cusErr
@@ -188,32 +249,70 @@ func (c *ClientRepoImpl) Update(ctx context.Context, authClient *aggregate.Clien
 	}
 
 	// Update client
-	entity, err := tx.AuthClient.UpdateOneID(authClient.Id).
+	update := tx.AuthClient.UpdateOneID(authClient.Id).
 		SetActive(authClient.Active).
 		SetTokenExpireSecs(authClient.TokenExpireSecs).
 		SetLoginFailedTimes(authClient.LoginFailedTimes).
-		Save(ctx)
+		SetRefreshTokenExpireSecs(authClient.RefreshTokenExpireSecs).
+		SetMfaRequired(authClient.MfaRequired).
+		SetPasswordMinLength(authClient.PasswordPolicy.MinLength).
+		SetPasswordCharClasses(authClient.PasswordPolicy.CharClasses).
+		SetPasswordHistoryCount(authClient.PasswordPolicy.HistoryCount).
+		SetPasswordMaxAgeSecs(authClient.PasswordPolicy.MaxAgeSecs).
+		SetBannedPasswords(authClient.PasswordPolicy.BannedPasswords).
+		SetLockoutSecs(authClient.LockoutPolicy.LockoutSecs).
+		SetMaxLockoutSecs(authClient.LockoutPolicy.MaxLockoutSecs).
+		SetRiskChallengeScore(authClient.RiskPolicy.ChallengeScore).
+		SetRiskDenyScore(authClient.RiskPolicy.DenyScore)
+
+	// Session policy is kept when it is not set
+	if authClient.SessionPolicy != 0 {
+		update.SetSessionPolicy(authClient.SessionPolicy.Int())
+	}
+	if authClient.MaxSessions != 0 {
+		update.SetMaxSessions(authClient.MaxSessions)
+	}
+	if authClient.SigningMethod.Id != 0 {
+		update.SetSigningMethod(authClient.SigningMethod.Id)
+	}
+
+	entity, err := update.Save(ctx)
 	if err != nil {
 		cusErr := cus_err.New(cus_err.InternalServerError, "failed to update client", err)
 		cus_otel.Error(ctx, cusErr.Error())
 		return nil, cusErr
 	}
 
+	// Map signing method to enum
+	signingMethod, cusErr := enum.SigningMethodFromId(entity.SigningMethod)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
 	// Create aggregate client
 	updatedClient := &aggregate.Client{
-		Id:               entity.ID,
-		ClientType:       authClient.ClientType,
-		MerchantId:       entity.MerchantID,
-		Secret:           entity.Secret,
-		Active:           entity.Active,
-		TokenExpireSecs:  entity.TokenExpireSecs,
-		LoginFailedTimes: entity.LoginFailedTimes,
+		Id:                     entity.ID,
+		ClientType:             authClient.ClientType,
+		MerchantId:             entity.MerchantID,
+		Secret:                 entity.Secret,
+		Active:                 entity.Active,
+		TokenExpireSecs:        entity.TokenExpireSecs,
+		LoginFailedTimes:       entity.LoginFailedTimes,
+		RefreshTokenExpireSecs: entity.RefreshTokenExpireSecs,
+		SessionPolicy:          enum.SessionPolicy(entity.SessionPolicy),
+		MaxSessions:            entity.MaxSessions,
+		SigningMethod:          signingMethod,
+		MfaRequired:            entity.MfaRequired,
+		PasswordPolicy:         toPasswordPolicy(entity),
+		LockoutPolicy:          toLockoutPolicy(entity),
+		RiskPolicy:             toRiskPolicy(entity),
 	}
 	setClientLoader(c.db, updatedClient)
 
 	// Save client in cache
 	key := fmt.Sprintf("%s:%d", ClientInfoPrefix, updatedClient.Id)
-	cusErr := c.cache.SetObject(ctx, key, updatedClient, 0)
+	cusErr = c.cache.SetObject(ctx, key, updatedClient, 0)
 	if cusErr != nil {
 		cus_otel.Error(ctx, cusErr.Error())
 		return nil, cusErr
@@ -535,6 +634,33 @@ func (c *ClientRepoImpl) validateParameters(authClient *aggregate.Client) *cus_e
 	return nil
 }
 
+// toPasswordPolicy maps the password policy columns of the client
+func toPasswordPolicy(entClient *ent.AuthClient) vo.PasswordPolicy {
+	return vo.PasswordPolicy{
+		MinLength:       entClient.PasswordMinLength,
+		CharClasses:     entClient.PasswordCharClasses,
+		HistoryCount:    entClient.PasswordHistoryCount,
+		MaxAgeSecs:      entClient.PasswordMaxAgeSecs,
+		BannedPasswords: entClient.BannedPasswords,
+	}
+}
+
+// toLockoutPolicy maps the lockout policy columns of the client
+func toLockoutPolicy(entClient *ent.AuthClient) vo.LockoutPolicy {
+	return vo.LockoutPolicy{
+		LockoutSecs:    entClient.LockoutSecs,
+		MaxLockoutSecs: entClient.MaxLockoutSecs,
+	}
+}
+
+// toRiskPolicy maps the risk policy columns of the client
+func toRiskPolicy(entClient *ent.AuthClient) vo.RiskPolicy {
+	return vo.RiskPolicy{
+		ChallengeScore: entClient.RiskChallengeScore,
+		DenyScore:      entClient.RiskDenyScore,
+	}
+}
+
 func setClientLoader(db db.Database, authClient *aggregate.Client) {
 	authClient.SetRolesLoader(
 		func(ctx context.Context) (*map[int64]entity.Role, *cus_err.CusError) {
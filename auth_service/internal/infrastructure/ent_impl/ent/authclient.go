@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/authclient"
+	"go_micro_service_api/pkg/enum"
 	"strings"
 	"time"
 
@@ -33,6 +35,34 @@ type AuthClient struct {
 	TokenExpireSecs int `json:"token_expire_secs,omitempty"`
 	// LoginFailedTimes holds the value of the "login_failed_times" field.
 	LoginFailedTimes int `json:"login_failed_times,omitempty"`
+	// RefreshTokenExpireSecs holds the value of the "refresh_token_expire_secs" field.
+	RefreshTokenExpireSecs int `json:"refresh_token_expire_secs,omitempty"`
+	// SessionPolicy holds the value of the "session_policy" field.
+	SessionPolicy int `json:"session_policy,omitempty"`
+	// MaxSessions holds the value of the "max_sessions" field.
+	MaxSessions int `json:"max_sessions,omitempty"`
+	// SigningMethod holds the value of the "signing_method" field.
+	SigningMethod int `json:"signing_method,omitempty"`
+	// MfaRequired holds the value of the "mfa_required" field.
+	MfaRequired bool `json:"mfa_required,omitempty"`
+	// PasswordMinLength holds the value of the "password_min_length" field.
+	PasswordMinLength int `json:"password_min_length,omitempty"`
+	// PasswordCharClasses holds the value of the "password_char_classes" field.
+	PasswordCharClasses []enum.PasswordCharClass `json:"password_char_classes,omitempty"`
+	// PasswordHistoryCount holds the value of the "password_history_count" field.
+	PasswordHistoryCount int `json:"password_history_count,omitempty"`
+	// PasswordMaxAgeSecs holds the value of the "password_max_age_secs" field.
+	PasswordMaxAgeSecs int `json:"password_max_age_secs,omitempty"`
+	// BannedPasswords holds the value of the "banned_passwords" field.
+	BannedPasswords []string `json:"banned_passwords,omitempty"`
+	// LockoutSecs holds the value of the "lockout_secs" field.
+	LockoutSecs int `json:"lockout_secs,omitempty"`
+	// MaxLockoutSecs holds the value of the "max_lockout_secs" field.
+	MaxLockoutSecs int `json:"max_lockout_secs,omitempty"`
+	// RiskChallengeScore holds the value of the "risk_challenge_score" field.
+	RiskChallengeScore int `json:"risk_challenge_score,omitempty"`
+	// RiskDenyScore holds the value of the "risk_deny_score" field.
+	RiskDenyScore int `json:"risk_deny_score,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AuthClientQuery when eager-loading is set.
 	Edges        AuthClientEdges `json:"edges"`
@@ -73,9 +103,11 @@ func (*AuthClient) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case authclient.FieldActive:
+		case authclient.FieldPasswordCharClasses, authclient.FieldBannedPasswords:
+			values[i] = new([]byte)
+		case authclient.FieldActive, authclient.FieldMfaRequired:
 			values[i] = new(sql.NullBool)
-		case authclient.FieldID, authclient.FieldClientType, authclient.FieldMerchantID, authclient.FieldTokenExpireSecs, authclient.FieldLoginFailedTimes:
+		case authclient.FieldID, authclient.FieldClientType, authclient.FieldMerchantID, authclient.FieldTokenExpireSecs, authclient.FieldLoginFailedTimes, authclient.FieldRefreshTokenExpireSecs, authclient.FieldSessionPolicy, authclient.FieldMaxSessions, authclient.FieldSigningMethod, authclient.FieldPasswordMinLength, authclient.FieldPasswordHistoryCount, authclient.FieldPasswordMaxAgeSecs, authclient.FieldLockoutSecs, authclient.FieldMaxLockoutSecs, authclient.FieldRiskChallengeScore, authclient.FieldRiskDenyScore:
 			values[i] = new(sql.NullInt64)
 		case authclient.FieldSecret:
 			values[i] = new(sql.NullString)
@@ -150,6 +182,94 @@ func (ac *AuthClient) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ac.LoginFailedTimes = int(value.Int64)
 			}
+		case authclient.FieldRefreshTokenExpireSecs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field refresh_token_expire_secs", values[i])
+			} else if value.Valid {
+				ac.RefreshTokenExpireSecs = int(value.Int64)
+			}
+		case authclient.FieldSessionPolicy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field session_policy", values[i])
+			} else if value.Valid {
+				ac.SessionPolicy = int(value.Int64)
+			}
+		case authclient.FieldMaxSessions:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_sessions", values[i])
+			} else if value.Valid {
+				ac.MaxSessions = int(value.Int64)
+			}
+		case authclient.FieldSigningMethod:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_method", values[i])
+			} else if value.Valid {
+				ac.SigningMethod = int(value.Int64)
+			}
+		case authclient.FieldMfaRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field mfa_required", values[i])
+			} else if value.Valid {
+				ac.MfaRequired = value.Bool
+			}
+		case authclient.FieldPasswordMinLength:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field password_min_length", values[i])
+			} else if value.Valid {
+				ac.PasswordMinLength = int(value.Int64)
+			}
+		case authclient.FieldPasswordCharClasses:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field password_char_classes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ac.PasswordCharClasses); err != nil {
+					return fmt.Errorf("unmarshal field password_char_classes: %w", err)
+				}
+			}
+		case authclient.FieldPasswordHistoryCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field password_history_count", values[i])
+			} else if value.Valid {
+				ac.PasswordHistoryCount = int(value.Int64)
+			}
+		case authclient.FieldPasswordMaxAgeSecs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field password_max_age_secs", values[i])
+			} else if value.Valid {
+				ac.PasswordMaxAgeSecs = int(value.Int64)
+			}
+		case authclient.FieldBannedPasswords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field banned_passwords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ac.BannedPasswords); err != nil {
+					return fmt.Errorf("unmarshal field banned_passwords: %w", err)
+				}
+			}
+		case authclient.FieldLockoutSecs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lockout_secs", values[i])
+			} else if value.Valid {
+				ac.LockoutSecs = int(value.Int64)
+			}
+		case authclient.FieldMaxLockoutSecs:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_lockout_secs", values[i])
+			} else if value.Valid {
+				ac.MaxLockoutSecs = int(value.Int64)
+			}
+		case authclient.FieldRiskChallengeScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_challenge_score", values[i])
+			} else if value.Valid {
+				ac.RiskChallengeScore = int(value.Int64)
+			}
+		case authclient.FieldRiskDenyScore:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field risk_deny_score", values[i])
+			} else if value.Valid {
+				ac.RiskDenyScore = int(value.Int64)
+			}
 		default:
 			ac.selectValues.Set(columns[i], values[i])
 		}
@@ -219,6 +339,48 @@ func (ac *AuthClient) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("login_failed_times=")
 	builder.WriteString(fmt.Sprintf("%v", ac.LoginFailedTimes))
+	builder.WriteString(", ")
+	builder.WriteString("refresh_token_expire_secs=")
+	builder.WriteString(fmt.Sprintf("%v", ac.RefreshTokenExpireSecs))
+	builder.WriteString(", ")
+	builder.WriteString("session_policy=")
+	builder.WriteString(fmt.Sprintf("%v", ac.SessionPolicy))
+	builder.WriteString(", ")
+	builder.WriteString("max_sessions=")
+	builder.WriteString(fmt.Sprintf("%v", ac.MaxSessions))
+	builder.WriteString(", ")
+	builder.WriteString("signing_method=")
+	builder.WriteString(fmt.Sprintf("%v", ac.SigningMethod))
+	builder.WriteString(", ")
+	builder.WriteString("mfa_required=")
+	builder.WriteString(fmt.Sprintf("%v", ac.MfaRequired))
+	builder.WriteString(", ")
+	builder.WriteString("password_min_length=")
+	builder.WriteString(fmt.Sprintf("%v", ac.PasswordMinLength))
+	builder.WriteString(", ")
+	builder.WriteString("password_char_classes=")
+	builder.WriteString(fmt.Sprintf("%v", ac.PasswordCharClasses))
+	builder.WriteString(", ")
+	builder.WriteString("password_history_count=")
+	builder.WriteString(fmt.Sprintf("%v", ac.PasswordHistoryCount))
+	builder.WriteString(", ")
+	builder.WriteString("password_max_age_secs=")
+	builder.WriteString(fmt.Sprintf("%v", ac.PasswordMaxAgeSecs))
+	builder.WriteString(", ")
+	builder.WriteString("banned_passwords=")
+	builder.WriteString(fmt.Sprintf("%v", ac.BannedPasswords))
+	builder.WriteString(", ")
+	builder.WriteString("lockout_secs=")
+	builder.WriteString(fmt.Sprintf("%v", ac.LockoutSecs))
+	builder.WriteString(", ")
+	builder.WriteString("max_lockout_secs=")
+	builder.WriteString(fmt.Sprintf("%v", ac.MaxLockoutSecs))
+	builder.WriteString(", ")
+	builder.WriteString("risk_challenge_score=")
+	builder.WriteString(fmt.Sprintf("%v", ac.RiskChallengeScore))
+	builder.WriteString(", ")
+	builder.WriteString("risk_deny_score=")
+	builder.WriteString(fmt.Sprintf("%v", ac.RiskDenyScore))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -30,6 +30,34 @@ const (
 	FieldTokenExpireSecs = "token_expire_secs"
 	// FieldLoginFailedTimes holds the string denoting the login_failed_times field in the database.
 	FieldLoginFailedTimes = "login_failed_times"
+	// FieldRefreshTokenExpireSecs holds the string denoting the refresh_token_expire_secs field in the database.
+	FieldRefreshTokenExpireSecs = "refresh_token_expire_secs"
+	// FieldSessionPolicy holds the string denoting the session_policy field in the database.
+	FieldSessionPolicy = "session_policy"
+	// FieldMaxSessions holds the string denoting the max_sessions field in the database.
+	FieldMaxSessions = "max_sessions"
+	// FieldSigningMethod holds the string denoting the signing_method field in the database.
+	FieldSigningMethod = "signing_method"
+	// FieldMfaRequired holds the string denoting the mfa_required field in the database.
+	FieldMfaRequired = "mfa_required"
+	// FieldPasswordMinLength holds the string denoting the password_min_length field in the database.
+	FieldPasswordMinLength = "password_min_length"
+	// FieldPasswordCharClasses holds the string denoting the password_char_classes field in the database.
+	FieldPasswordCharClasses = "password_char_classes"
+	// FieldPasswordHistoryCount holds the string denoting the password_history_count field in the database.
+	FieldPasswordHistoryCount = "password_history_count"
+	// FieldPasswordMaxAgeSecs holds the string denoting the password_max_age_secs field in the database.
+	FieldPasswordMaxAgeSecs = "password_max_age_secs"
+	// FieldBannedPasswords holds the string denoting the banned_passwords field in the database.
+	FieldBannedPasswords = "banned_passwords"
+	// FieldLockoutSecs holds the string denoting the lockout_secs field in the database.
+	FieldLockoutSecs = "lockout_secs"
+	// FieldMaxLockoutSecs holds the string denoting the max_lockout_secs field in the database.
+	FieldMaxLockoutSecs = "max_lockout_secs"
+	// FieldRiskChallengeScore holds the string denoting the risk_challenge_score field in the database.
+	FieldRiskChallengeScore = "risk_challenge_score"
+	// FieldRiskDenyScore holds the string denoting the risk_deny_score field in the database.
+	FieldRiskDenyScore = "risk_deny_score"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
 	// EdgeRoles holds the string denoting the roles edge name in mutations.
@@ -61,6 +89,20 @@ var Columns = []string{
 	FieldActive,
 	FieldTokenExpireSecs,
 	FieldLoginFailedTimes,
+	FieldRefreshTokenExpireSecs,
+	FieldSessionPolicy,
+	FieldMaxSessions,
+	FieldSigningMethod,
+	FieldMfaRequired,
+	FieldPasswordMinLength,
+	FieldPasswordCharClasses,
+	FieldPasswordHistoryCount,
+	FieldPasswordMaxAgeSecs,
+	FieldBannedPasswords,
+	FieldLockoutSecs,
+	FieldMaxLockoutSecs,
+	FieldRiskChallengeScore,
+	FieldRiskDenyScore,
 }
 
 var (
@@ -86,6 +128,30 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultRefreshTokenExpireSecs holds the default value on creation for the "refresh_token_expire_secs" field.
+	DefaultRefreshTokenExpireSecs int
+	// DefaultSessionPolicy holds the default value on creation for the "session_policy" field.
+	DefaultSessionPolicy int
+	// DefaultMaxSessions holds the default value on creation for the "max_sessions" field.
+	DefaultMaxSessions int
+	// DefaultSigningMethod holds the default value on creation for the "signing_method" field.
+	DefaultSigningMethod int
+	// DefaultMfaRequired holds the default value on creation for the "mfa_required" field.
+	DefaultMfaRequired bool
+	// DefaultPasswordMinLength holds the default value on creation for the "password_min_length" field.
+	DefaultPasswordMinLength int
+	// DefaultPasswordHistoryCount holds the default value on creation for the "password_history_count" field.
+	DefaultPasswordHistoryCount int
+	// DefaultPasswordMaxAgeSecs holds the default value on creation for the "password_max_age_secs" field.
+	DefaultPasswordMaxAgeSecs int
+	// DefaultLockoutSecs holds the default value on creation for the "lockout_secs" field.
+	DefaultLockoutSecs int
+	// DefaultMaxLockoutSecs holds the default value on creation for the "max_lockout_secs" field.
+	DefaultMaxLockoutSecs int
+	// DefaultRiskChallengeScore holds the default value on creation for the "risk_challenge_score" field.
+	DefaultRiskChallengeScore int
+	// DefaultRiskDenyScore holds the default value on creation for the "risk_deny_score" field.
+	DefaultRiskDenyScore int
 )
 
 // OrderOption defines the ordering options for the AuthClient queries.
@@ -136,6 +202,66 @@ func ByLoginFailedTimes(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLoginFailedTimes, opts...).ToFunc()
 }
 
+// ByRefreshTokenExpireSecs orders the results by the refresh_token_expire_secs field.
+func ByRefreshTokenExpireSecs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefreshTokenExpireSecs, opts...).ToFunc()
+}
+
+// BySessionPolicy orders the results by the session_policy field.
+func BySessionPolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSessionPolicy, opts...).ToFunc()
+}
+
+// ByMaxSessions orders the results by the max_sessions field.
+func ByMaxSessions(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxSessions, opts...).ToFunc()
+}
+
+// BySigningMethod orders the results by the signing_method field.
+func BySigningMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSigningMethod, opts...).ToFunc()
+}
+
+// ByMfaRequired orders the results by the mfa_required field.
+func ByMfaRequired(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMfaRequired, opts...).ToFunc()
+}
+
+// ByPasswordMinLength orders the results by the password_min_length field.
+func ByPasswordMinLength(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordMinLength, opts...).ToFunc()
+}
+
+// ByPasswordHistoryCount orders the results by the password_history_count field.
+func ByPasswordHistoryCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordHistoryCount, opts...).ToFunc()
+}
+
+// ByPasswordMaxAgeSecs orders the results by the password_max_age_secs field.
+func ByPasswordMaxAgeSecs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordMaxAgeSecs, opts...).ToFunc()
+}
+
+// ByLockoutSecs orders the results by the lockout_secs field.
+func ByLockoutSecs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockoutSecs, opts...).ToFunc()
+}
+
+// ByMaxLockoutSecs orders the results by the max_lockout_secs field.
+func ByMaxLockoutSecs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxLockoutSecs, opts...).ToFunc()
+}
+
+// ByRiskChallengeScore orders the results by the risk_challenge_score field.
+func ByRiskChallengeScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRiskChallengeScore, opts...).ToFunc()
+}
+
+// ByRiskDenyScore orders the results by the risk_deny_score field.
+func ByRiskDenyScore(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRiskDenyScore, opts...).ToFunc()
+}
+
 // ByUsersCount orders the results by users count.
 func ByUsersCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -95,6 +95,66 @@ func LoginFailedTimes(v int) predicate.AuthClient {
 	return predicate.AuthClient(sql.FieldEQ(FieldLoginFailedTimes, v))
 }
 
+// RefreshTokenExpireSecs applies equality check predicate on the "refresh_token_expire_secs" field. It's identical to RefreshTokenExpireSecsEQ.
+func RefreshTokenExpireSecs(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldRefreshTokenExpireSecs, v))
+}
+
+// SessionPolicy applies equality check predicate on the "session_policy" field. It's identical to SessionPolicyEQ.
+func SessionPolicy(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldSessionPolicy, v))
+}
+
+// MaxSessions applies equality check predicate on the "max_sessions" field. It's identical to MaxSessionsEQ.
+func MaxSessions(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldMaxSessions, v))
+}
+
+// SigningMethod applies equality check predicate on the "signing_method" field. It's identical to SigningMethodEQ.
+func SigningMethod(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldSigningMethod, v))
+}
+
+// MfaRequired applies equality check predicate on the "mfa_required" field. It's identical to MfaRequiredEQ.
+func MfaRequired(v bool) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldMfaRequired, v))
+}
+
+// PasswordMinLength applies equality check predicate on the "password_min_length" field. It's identical to PasswordMinLengthEQ.
+func PasswordMinLength(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldPasswordMinLength, v))
+}
+
+// PasswordHistoryCount applies equality check predicate on the "password_history_count" field. It's identical to PasswordHistoryCountEQ.
+func PasswordHistoryCount(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldPasswordHistoryCount, v))
+}
+
+// PasswordMaxAgeSecs applies equality check predicate on the "password_max_age_secs" field. It's identical to PasswordMaxAgeSecsEQ.
+func PasswordMaxAgeSecs(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldPasswordMaxAgeSecs, v))
+}
+
+// LockoutSecs applies equality check predicate on the "lockout_secs" field. It's identical to LockoutSecsEQ.
+func LockoutSecs(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldLockoutSecs, v))
+}
+
+// MaxLockoutSecs applies equality check predicate on the "max_lockout_secs" field. It's identical to MaxLockoutSecsEQ.
+func MaxLockoutSecs(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldMaxLockoutSecs, v))
+}
+
+// RiskChallengeScore applies equality check predicate on the "risk_challenge_score" field. It's identical to RiskChallengeScoreEQ.
+func RiskChallengeScore(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldRiskChallengeScore, v))
+}
+
+// RiskDenyScore applies equality check predicate on the "risk_deny_score" field. It's identical to RiskDenyScoreEQ.
+func RiskDenyScore(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldRiskDenyScore, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.AuthClient {
 	return predicate.AuthClient(sql.FieldEQ(FieldCreatedAt, v))
@@ -410,6 +470,476 @@ func LoginFailedTimesLTE(v int) predicate.AuthClient {
 	return predicate.AuthClient(sql.FieldLTE(FieldLoginFailedTimes, v))
 }
 
+// RefreshTokenExpireSecsEQ applies the EQ predicate on the "refresh_token_expire_secs" field.
+func RefreshTokenExpireSecsEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldRefreshTokenExpireSecs, v))
+}
+
+// RefreshTokenExpireSecsNEQ applies the NEQ predicate on the "refresh_token_expire_secs" field.
+func RefreshTokenExpireSecsNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldRefreshTokenExpireSecs, v))
+}
+
+// RefreshTokenExpireSecsIn applies the In predicate on the "refresh_token_expire_secs" field.
+func RefreshTokenExpireSecsIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldRefreshTokenExpireSecs, vs...))
+}
+
+// RefreshTokenExpireSecsNotIn applies the NotIn predicate on the "refresh_token_expire_secs" field.
+func RefreshTokenExpireSecsNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldRefreshTokenExpireSecs, vs...))
+}
+
+// RefreshTokenExpireSecsGT applies the GT predicate on the "refresh_token_expire_secs" field.
+func RefreshTokenExpireSecsGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldRefreshTokenExpireSecs, v))
+}
+
+// RefreshTokenExpireSecsGTE applies the GTE predicate on the "refresh_token_expire_secs" field.
+func RefreshTokenExpireSecsGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldRefreshTokenExpireSecs, v))
+}
+
+// RefreshTokenExpireSecsLT applies the LT predicate on the "refresh_token_expire_secs" field.
+func RefreshTokenExpireSecsLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldRefreshTokenExpireSecs, v))
+}
+
+// RefreshTokenExpireSecsLTE applies the LTE predicate on the "refresh_token_expire_secs" field.
+func RefreshTokenExpireSecsLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldRefreshTokenExpireSecs, v))
+}
+
+// SessionPolicyEQ applies the EQ predicate on the "session_policy" field.
+func SessionPolicyEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldSessionPolicy, v))
+}
+
+// SessionPolicyNEQ applies the NEQ predicate on the "session_policy" field.
+func SessionPolicyNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldSessionPolicy, v))
+}
+
+// SessionPolicyIn applies the In predicate on the "session_policy" field.
+func SessionPolicyIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldSessionPolicy, vs...))
+}
+
+// SessionPolicyNotIn applies the NotIn predicate on the "session_policy" field.
+func SessionPolicyNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldSessionPolicy, vs...))
+}
+
+// SessionPolicyGT applies the GT predicate on the "session_policy" field.
+func SessionPolicyGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldSessionPolicy, v))
+}
+
+// SessionPolicyGTE applies the GTE predicate on the "session_policy" field.
+func SessionPolicyGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldSessionPolicy, v))
+}
+
+// SessionPolicyLT applies the LT predicate on the "session_policy" field.
+func SessionPolicyLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldSessionPolicy, v))
+}
+
+// SessionPolicyLTE applies the LTE predicate on the "session_policy" field.
+func SessionPolicyLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldSessionPolicy, v))
+}
+
+// MaxSessionsEQ applies the EQ predicate on the "max_sessions" field.
+func MaxSessionsEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldMaxSessions, v))
+}
+
+// MaxSessionsNEQ applies the NEQ predicate on the "max_sessions" field.
+func MaxSessionsNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldMaxSessions, v))
+}
+
+// MaxSessionsIn applies the In predicate on the "max_sessions" field.
+func MaxSessionsIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldMaxSessions, vs...))
+}
+
+// MaxSessionsNotIn applies the NotIn predicate on the "max_sessions" field.
+func MaxSessionsNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldMaxSessions, vs...))
+}
+
+// MaxSessionsGT applies the GT predicate on the "max_sessions" field.
+func MaxSessionsGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldMaxSessions, v))
+}
+
+// MaxSessionsGTE applies the GTE predicate on the "max_sessions" field.
+func MaxSessionsGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldMaxSessions, v))
+}
+
+// MaxSessionsLT applies the LT predicate on the "max_sessions" field.
+func MaxSessionsLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldMaxSessions, v))
+}
+
+// MaxSessionsLTE applies the LTE predicate on the "max_sessions" field.
+func MaxSessionsLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldMaxSessions, v))
+}
+
+// SigningMethodEQ applies the EQ predicate on the "signing_method" field.
+func SigningMethodEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldSigningMethod, v))
+}
+
+// SigningMethodNEQ applies the NEQ predicate on the "signing_method" field.
+func SigningMethodNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldSigningMethod, v))
+}
+
+// SigningMethodIn applies the In predicate on the "signing_method" field.
+func SigningMethodIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldSigningMethod, vs...))
+}
+
+// SigningMethodNotIn applies the NotIn predicate on the "signing_method" field.
+func SigningMethodNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldSigningMethod, vs...))
+}
+
+// SigningMethodGT applies the GT predicate on the "signing_method" field.
+func SigningMethodGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldSigningMethod, v))
+}
+
+// SigningMethodGTE applies the GTE predicate on the "signing_method" field.
+func SigningMethodGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldSigningMethod, v))
+}
+
+// SigningMethodLT applies the LT predicate on the "signing_method" field.
+func SigningMethodLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldSigningMethod, v))
+}
+
+// SigningMethodLTE applies the LTE predicate on the "signing_method" field.
+func SigningMethodLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldSigningMethod, v))
+}
+
+// MfaRequiredEQ applies the EQ predicate on the "mfa_required" field.
+func MfaRequiredEQ(v bool) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldMfaRequired, v))
+}
+
+// MfaRequiredNEQ applies the NEQ predicate on the "mfa_required" field.
+func MfaRequiredNEQ(v bool) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldMfaRequired, v))
+}
+
+// PasswordMinLengthEQ applies the EQ predicate on the "password_min_length" field.
+func PasswordMinLengthEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthNEQ applies the NEQ predicate on the "password_min_length" field.
+func PasswordMinLengthNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthIn applies the In predicate on the "password_min_length" field.
+func PasswordMinLengthIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldPasswordMinLength, vs...))
+}
+
+// PasswordMinLengthNotIn applies the NotIn predicate on the "password_min_length" field.
+func PasswordMinLengthNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldPasswordMinLength, vs...))
+}
+
+// PasswordMinLengthGT applies the GT predicate on the "password_min_length" field.
+func PasswordMinLengthGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthGTE applies the GTE predicate on the "password_min_length" field.
+func PasswordMinLengthGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthLT applies the LT predicate on the "password_min_length" field.
+func PasswordMinLengthLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldPasswordMinLength, v))
+}
+
+// PasswordMinLengthLTE applies the LTE predicate on the "password_min_length" field.
+func PasswordMinLengthLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldPasswordMinLength, v))
+}
+
+// PasswordCharClassesIsNil applies the IsNil predicate on the "password_char_classes" field.
+func PasswordCharClassesIsNil() predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIsNull(FieldPasswordCharClasses))
+}
+
+// PasswordCharClassesNotNil applies the NotNil predicate on the "password_char_classes" field.
+func PasswordCharClassesNotNil() predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotNull(FieldPasswordCharClasses))
+}
+
+// PasswordHistoryCountEQ applies the EQ predicate on the "password_history_count" field.
+func PasswordHistoryCountEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldPasswordHistoryCount, v))
+}
+
+// PasswordHistoryCountNEQ applies the NEQ predicate on the "password_history_count" field.
+func PasswordHistoryCountNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldPasswordHistoryCount, v))
+}
+
+// PasswordHistoryCountIn applies the In predicate on the "password_history_count" field.
+func PasswordHistoryCountIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldPasswordHistoryCount, vs...))
+}
+
+// PasswordHistoryCountNotIn applies the NotIn predicate on the "password_history_count" field.
+func PasswordHistoryCountNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldPasswordHistoryCount, vs...))
+}
+
+// PasswordHistoryCountGT applies the GT predicate on the "password_history_count" field.
+func PasswordHistoryCountGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldPasswordHistoryCount, v))
+}
+
+// PasswordHistoryCountGTE applies the GTE predicate on the "password_history_count" field.
+func PasswordHistoryCountGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldPasswordHistoryCount, v))
+}
+
+// PasswordHistoryCountLT applies the LT predicate on the "password_history_count" field.
+func PasswordHistoryCountLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldPasswordHistoryCount, v))
+}
+
+// PasswordHistoryCountLTE applies the LTE predicate on the "password_history_count" field.
+func PasswordHistoryCountLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldPasswordHistoryCount, v))
+}
+
+// PasswordMaxAgeSecsEQ applies the EQ predicate on the "password_max_age_secs" field.
+func PasswordMaxAgeSecsEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldPasswordMaxAgeSecs, v))
+}
+
+// PasswordMaxAgeSecsNEQ applies the NEQ predicate on the "password_max_age_secs" field.
+func PasswordMaxAgeSecsNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldPasswordMaxAgeSecs, v))
+}
+
+// PasswordMaxAgeSecsIn applies the In predicate on the "password_max_age_secs" field.
+func PasswordMaxAgeSecsIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldPasswordMaxAgeSecs, vs...))
+}
+
+// PasswordMaxAgeSecsNotIn applies the NotIn predicate on the "password_max_age_secs" field.
+func PasswordMaxAgeSecsNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldPasswordMaxAgeSecs, vs...))
+}
+
+// PasswordMaxAgeSecsGT applies the GT predicate on the "password_max_age_secs" field.
+func PasswordMaxAgeSecsGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldPasswordMaxAgeSecs, v))
+}
+
+// PasswordMaxAgeSecsGTE applies the GTE predicate on the "password_max_age_secs" field.
+func PasswordMaxAgeSecsGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldPasswordMaxAgeSecs, v))
+}
+
+// PasswordMaxAgeSecsLT applies the LT predicate on the "password_max_age_secs" field.
+func PasswordMaxAgeSecsLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldPasswordMaxAgeSecs, v))
+}
+
+// PasswordMaxAgeSecsLTE applies the LTE predicate on the "password_max_age_secs" field.
+func PasswordMaxAgeSecsLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldPasswordMaxAgeSecs, v))
+}
+
+// BannedPasswordsIsNil applies the IsNil predicate on the "banned_passwords" field.
+func BannedPasswordsIsNil() predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIsNull(FieldBannedPasswords))
+}
+
+// BannedPasswordsNotNil applies the NotNil predicate on the "banned_passwords" field.
+func BannedPasswordsNotNil() predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotNull(FieldBannedPasswords))
+}
+
+// LockoutSecsEQ applies the EQ predicate on the "lockout_secs" field.
+func LockoutSecsEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldLockoutSecs, v))
+}
+
+// LockoutSecsNEQ applies the NEQ predicate on the "lockout_secs" field.
+func LockoutSecsNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldLockoutSecs, v))
+}
+
+// LockoutSecsIn applies the In predicate on the "lockout_secs" field.
+func LockoutSecsIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldLockoutSecs, vs...))
+}
+
+// LockoutSecsNotIn applies the NotIn predicate on the "lockout_secs" field.
+func LockoutSecsNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldLockoutSecs, vs...))
+}
+
+// LockoutSecsGT applies the GT predicate on the "lockout_secs" field.
+func LockoutSecsGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldLockoutSecs, v))
+}
+
+// LockoutSecsGTE applies the GTE predicate on the "lockout_secs" field.
+func LockoutSecsGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldLockoutSecs, v))
+}
+
+// LockoutSecsLT applies the LT predicate on the "lockout_secs" field.
+func LockoutSecsLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldLockoutSecs, v))
+}
+
+// LockoutSecsLTE applies the LTE predicate on the "lockout_secs" field.
+func LockoutSecsLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldLockoutSecs, v))
+}
+
+// MaxLockoutSecsEQ applies the EQ predicate on the "max_lockout_secs" field.
+func MaxLockoutSecsEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldMaxLockoutSecs, v))
+}
+
+// MaxLockoutSecsNEQ applies the NEQ predicate on the "max_lockout_secs" field.
+func MaxLockoutSecsNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldMaxLockoutSecs, v))
+}
+
+// MaxLockoutSecsIn applies the In predicate on the "max_lockout_secs" field.
+func MaxLockoutSecsIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldMaxLockoutSecs, vs...))
+}
+
+// MaxLockoutSecsNotIn applies the NotIn predicate on the "max_lockout_secs" field.
+func MaxLockoutSecsNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldMaxLockoutSecs, vs...))
+}
+
+// MaxLockoutSecsGT applies the GT predicate on the "max_lockout_secs" field.
+func MaxLockoutSecsGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldMaxLockoutSecs, v))
+}
+
+// MaxLockoutSecsGTE applies the GTE predicate on the "max_lockout_secs" field.
+func MaxLockoutSecsGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldMaxLockoutSecs, v))
+}
+
+// MaxLockoutSecsLT applies the LT predicate on the "max_lockout_secs" field.
+func MaxLockoutSecsLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldMaxLockoutSecs, v))
+}
+
+// MaxLockoutSecsLTE applies the LTE predicate on the "max_lockout_secs" field.
+func MaxLockoutSecsLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldMaxLockoutSecs, v))
+}
+
+// RiskChallengeScoreEQ applies the EQ predicate on the "risk_challenge_score" field.
+func RiskChallengeScoreEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldRiskChallengeScore, v))
+}
+
+// RiskChallengeScoreNEQ applies the NEQ predicate on the "risk_challenge_score" field.
+func RiskChallengeScoreNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldRiskChallengeScore, v))
+}
+
+// RiskChallengeScoreIn applies the In predicate on the "risk_challenge_score" field.
+func RiskChallengeScoreIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldRiskChallengeScore, vs...))
+}
+
+// RiskChallengeScoreNotIn applies the NotIn predicate on the "risk_challenge_score" field.
+func RiskChallengeScoreNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldRiskChallengeScore, vs...))
+}
+
+// RiskChallengeScoreGT applies the GT predicate on the "risk_challenge_score" field.
+func RiskChallengeScoreGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldRiskChallengeScore, v))
+}
+
+// RiskChallengeScoreGTE applies the GTE predicate on the "risk_challenge_score" field.
+func RiskChallengeScoreGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldRiskChallengeScore, v))
+}
+
+// RiskChallengeScoreLT applies the LT predicate on the "risk_challenge_score" field.
+func RiskChallengeScoreLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldRiskChallengeScore, v))
+}
+
+// RiskChallengeScoreLTE applies the LTE predicate on the "risk_challenge_score" field.
+func RiskChallengeScoreLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldRiskChallengeScore, v))
+}
+
+// RiskDenyScoreEQ applies the EQ predicate on the "risk_deny_score" field.
+func RiskDenyScoreEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldEQ(FieldRiskDenyScore, v))
+}
+
+// RiskDenyScoreNEQ applies the NEQ predicate on the "risk_deny_score" field.
+func RiskDenyScoreNEQ(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNEQ(FieldRiskDenyScore, v))
+}
+
+// RiskDenyScoreIn applies the In predicate on the "risk_deny_score" field.
+func RiskDenyScoreIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldIn(FieldRiskDenyScore, vs...))
+}
+
+// RiskDenyScoreNotIn applies the NotIn predicate on the "risk_deny_score" field.
+func RiskDenyScoreNotIn(vs ...int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldNotIn(FieldRiskDenyScore, vs...))
+}
+
+// RiskDenyScoreGT applies the GT predicate on the "risk_deny_score" field.
+func RiskDenyScoreGT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGT(FieldRiskDenyScore, v))
+}
+
+// RiskDenyScoreGTE applies the GTE predicate on the "risk_deny_score" field.
+func RiskDenyScoreGTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldGTE(FieldRiskDenyScore, v))
+}
+
+// RiskDenyScoreLT applies the LT predicate on the "risk_deny_score" field.
+func RiskDenyScoreLT(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLT(FieldRiskDenyScore, v))
+}
+
+// RiskDenyScoreLTE applies the LTE predicate on the "risk_deny_score" field.
+func RiskDenyScoreLTE(v int) predicate.AuthClient {
+	return predicate.AuthClient(sql.FieldLTE(FieldRiskDenyScore, v))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.AuthClient {
 	return predicate.AuthClient(func(s *sql.Selector) {
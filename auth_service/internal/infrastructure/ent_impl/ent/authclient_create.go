@@ -9,6 +9,7 @@ import (
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/authclient"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/role"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/user"
+	"go_micro_service_api/pkg/enum"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -88,6 +89,186 @@ func (acc *AuthClientCreate) SetLoginFailedTimes(i int) *AuthClientCreate {
 	return acc
 }
 
+// SetRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field.
+func (acc *AuthClientCreate) SetRefreshTokenExpireSecs(i int) *AuthClientCreate {
+	acc.mutation.SetRefreshTokenExpireSecs(i)
+	return acc
+}
+
+// SetNillableRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableRefreshTokenExpireSecs(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetRefreshTokenExpireSecs(*i)
+	}
+	return acc
+}
+
+// SetSessionPolicy sets the "session_policy" field.
+func (acc *AuthClientCreate) SetSessionPolicy(i int) *AuthClientCreate {
+	acc.mutation.SetSessionPolicy(i)
+	return acc
+}
+
+// SetNillableSessionPolicy sets the "session_policy" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableSessionPolicy(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetSessionPolicy(*i)
+	}
+	return acc
+}
+
+// SetMaxSessions sets the "max_sessions" field.
+func (acc *AuthClientCreate) SetMaxSessions(i int) *AuthClientCreate {
+	acc.mutation.SetMaxSessions(i)
+	return acc
+}
+
+// SetNillableMaxSessions sets the "max_sessions" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableMaxSessions(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetMaxSessions(*i)
+	}
+	return acc
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (acc *AuthClientCreate) SetSigningMethod(i int) *AuthClientCreate {
+	acc.mutation.SetSigningMethod(i)
+	return acc
+}
+
+// SetNillableSigningMethod sets the "signing_method" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableSigningMethod(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetSigningMethod(*i)
+	}
+	return acc
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (acc *AuthClientCreate) SetMfaRequired(b bool) *AuthClientCreate {
+	acc.mutation.SetMfaRequired(b)
+	return acc
+}
+
+// SetNillableMfaRequired sets the "mfa_required" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableMfaRequired(b *bool) *AuthClientCreate {
+	if b != nil {
+		acc.SetMfaRequired(*b)
+	}
+	return acc
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (acc *AuthClientCreate) SetPasswordMinLength(i int) *AuthClientCreate {
+	acc.mutation.SetPasswordMinLength(i)
+	return acc
+}
+
+// SetNillablePasswordMinLength sets the "password_min_length" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillablePasswordMinLength(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetPasswordMinLength(*i)
+	}
+	return acc
+}
+
+// SetPasswordCharClasses sets the "password_char_classes" field.
+func (acc *AuthClientCreate) SetPasswordCharClasses(ecc []enum.PasswordCharClass) *AuthClientCreate {
+	acc.mutation.SetPasswordCharClasses(ecc)
+	return acc
+}
+
+// SetPasswordHistoryCount sets the "password_history_count" field.
+func (acc *AuthClientCreate) SetPasswordHistoryCount(i int) *AuthClientCreate {
+	acc.mutation.SetPasswordHistoryCount(i)
+	return acc
+}
+
+// SetNillablePasswordHistoryCount sets the "password_history_count" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillablePasswordHistoryCount(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetPasswordHistoryCount(*i)
+	}
+	return acc
+}
+
+// SetPasswordMaxAgeSecs sets the "password_max_age_secs" field.
+func (acc *AuthClientCreate) SetPasswordMaxAgeSecs(i int) *AuthClientCreate {
+	acc.mutation.SetPasswordMaxAgeSecs(i)
+	return acc
+}
+
+// SetNillablePasswordMaxAgeSecs sets the "password_max_age_secs" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillablePasswordMaxAgeSecs(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetPasswordMaxAgeSecs(*i)
+	}
+	return acc
+}
+
+// SetBannedPasswords sets the "banned_passwords" field.
+func (acc *AuthClientCreate) SetBannedPasswords(s []string) *AuthClientCreate {
+	acc.mutation.SetBannedPasswords(s)
+	return acc
+}
+
+// SetLockoutSecs sets the "lockout_secs" field.
+func (acc *AuthClientCreate) SetLockoutSecs(i int) *AuthClientCreate {
+	acc.mutation.SetLockoutSecs(i)
+	return acc
+}
+
+// SetNillableLockoutSecs sets the "lockout_secs" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableLockoutSecs(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetLockoutSecs(*i)
+	}
+	return acc
+}
+
+// SetMaxLockoutSecs sets the "max_lockout_secs" field.
+func (acc *AuthClientCreate) SetMaxLockoutSecs(i int) *AuthClientCreate {
+	acc.mutation.SetMaxLockoutSecs(i)
+	return acc
+}
+
+// SetNillableMaxLockoutSecs sets the "max_lockout_secs" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableMaxLockoutSecs(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetMaxLockoutSecs(*i)
+	}
+	return acc
+}
+
+// SetRiskChallengeScore sets the "risk_challenge_score" field.
+func (acc *AuthClientCreate) SetRiskChallengeScore(i int) *AuthClientCreate {
+	acc.mutation.SetRiskChallengeScore(i)
+	return acc
+}
+
+// SetNillableRiskChallengeScore sets the "risk_challenge_score" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableRiskChallengeScore(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetRiskChallengeScore(*i)
+	}
+	return acc
+}
+
+// SetRiskDenyScore sets the "risk_deny_score" field.
+func (acc *AuthClientCreate) SetRiskDenyScore(i int) *AuthClientCreate {
+	acc.mutation.SetRiskDenyScore(i)
+	return acc
+}
+
+// SetNillableRiskDenyScore sets the "risk_deny_score" field if the given value is not nil.
+func (acc *AuthClientCreate) SetNillableRiskDenyScore(i *int) *AuthClientCreate {
+	if i != nil {
+		acc.SetRiskDenyScore(*i)
+	}
+	return acc
+}
+
 // SetID sets the "id" field.
 func (acc *AuthClientCreate) SetID(i int64) *AuthClientCreate {
 	acc.mutation.SetID(i)
@@ -167,6 +348,54 @@ func (acc *AuthClientCreate) defaults() {
 		v := authclient.DefaultUpdatedAt()
 		acc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := acc.mutation.RefreshTokenExpireSecs(); !ok {
+		v := authclient.DefaultRefreshTokenExpireSecs
+		acc.mutation.SetRefreshTokenExpireSecs(v)
+	}
+	if _, ok := acc.mutation.SessionPolicy(); !ok {
+		v := authclient.DefaultSessionPolicy
+		acc.mutation.SetSessionPolicy(v)
+	}
+	if _, ok := acc.mutation.MaxSessions(); !ok {
+		v := authclient.DefaultMaxSessions
+		acc.mutation.SetMaxSessions(v)
+	}
+	if _, ok := acc.mutation.SigningMethod(); !ok {
+		v := authclient.DefaultSigningMethod
+		acc.mutation.SetSigningMethod(v)
+	}
+	if _, ok := acc.mutation.MfaRequired(); !ok {
+		v := authclient.DefaultMfaRequired
+		acc.mutation.SetMfaRequired(v)
+	}
+	if _, ok := acc.mutation.PasswordMinLength(); !ok {
+		v := authclient.DefaultPasswordMinLength
+		acc.mutation.SetPasswordMinLength(v)
+	}
+	if _, ok := acc.mutation.PasswordHistoryCount(); !ok {
+		v := authclient.DefaultPasswordHistoryCount
+		acc.mutation.SetPasswordHistoryCount(v)
+	}
+	if _, ok := acc.mutation.PasswordMaxAgeSecs(); !ok {
+		v := authclient.DefaultPasswordMaxAgeSecs
+		acc.mutation.SetPasswordMaxAgeSecs(v)
+	}
+	if _, ok := acc.mutation.LockoutSecs(); !ok {
+		v := authclient.DefaultLockoutSecs
+		acc.mutation.SetLockoutSecs(v)
+	}
+	if _, ok := acc.mutation.MaxLockoutSecs(); !ok {
+		v := authclient.DefaultMaxLockoutSecs
+		acc.mutation.SetMaxLockoutSecs(v)
+	}
+	if _, ok := acc.mutation.RiskChallengeScore(); !ok {
+		v := authclient.DefaultRiskChallengeScore
+		acc.mutation.SetRiskChallengeScore(v)
+	}
+	if _, ok := acc.mutation.RiskDenyScore(); !ok {
+		v := authclient.DefaultRiskDenyScore
+		acc.mutation.SetRiskDenyScore(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -195,6 +424,42 @@ func (acc *AuthClientCreate) check() error {
 	if _, ok := acc.mutation.LoginFailedTimes(); !ok {
 		return &ValidationError{Name: "login_failed_times", err: errors.New(`ent: missing required field "AuthClient.login_failed_times"`)}
 	}
+	if _, ok := acc.mutation.RefreshTokenExpireSecs(); !ok {
+		return &ValidationError{Name: "refresh_token_expire_secs", err: errors.New(`ent: missing required field "AuthClient.refresh_token_expire_secs"`)}
+	}
+	if _, ok := acc.mutation.SessionPolicy(); !ok {
+		return &ValidationError{Name: "session_policy", err: errors.New(`ent: missing required field "AuthClient.session_policy"`)}
+	}
+	if _, ok := acc.mutation.MaxSessions(); !ok {
+		return &ValidationError{Name: "max_sessions", err: errors.New(`ent: missing required field "AuthClient.max_sessions"`)}
+	}
+	if _, ok := acc.mutation.SigningMethod(); !ok {
+		return &ValidationError{Name: "signing_method", err: errors.New(`ent: missing required field "AuthClient.signing_method"`)}
+	}
+	if _, ok := acc.mutation.MfaRequired(); !ok {
+		return &ValidationError{Name: "mfa_required", err: errors.New(`ent: missing required field "AuthClient.mfa_required"`)}
+	}
+	if _, ok := acc.mutation.PasswordMinLength(); !ok {
+		return &ValidationError{Name: "password_min_length", err: errors.New(`ent: missing required field "AuthClient.password_min_length"`)}
+	}
+	if _, ok := acc.mutation.PasswordHistoryCount(); !ok {
+		return &ValidationError{Name: "password_history_count", err: errors.New(`ent: missing required field "AuthClient.password_history_count"`)}
+	}
+	if _, ok := acc.mutation.PasswordMaxAgeSecs(); !ok {
+		return &ValidationError{Name: "password_max_age_secs", err: errors.New(`ent: missing required field "AuthClient.password_max_age_secs"`)}
+	}
+	if _, ok := acc.mutation.LockoutSecs(); !ok {
+		return &ValidationError{Name: "lockout_secs", err: errors.New(`ent: missing required field "AuthClient.lockout_secs"`)}
+	}
+	if _, ok := acc.mutation.MaxLockoutSecs(); !ok {
+		return &ValidationError{Name: "max_lockout_secs", err: errors.New(`ent: missing required field "AuthClient.max_lockout_secs"`)}
+	}
+	if _, ok := acc.mutation.RiskChallengeScore(); !ok {
+		return &ValidationError{Name: "risk_challenge_score", err: errors.New(`ent: missing required field "AuthClient.risk_challenge_score"`)}
+	}
+	if _, ok := acc.mutation.RiskDenyScore(); !ok {
+		return &ValidationError{Name: "risk_deny_score", err: errors.New(`ent: missing required field "AuthClient.risk_deny_score"`)}
+	}
 	return nil
 }
 
@@ -260,6 +525,62 @@ func (acc *AuthClientCreate) createSpec() (*AuthClient, *sqlgraph.CreateSpec) {
 		_spec.SetField(authclient.FieldLoginFailedTimes, field.TypeInt, value)
 		_node.LoginFailedTimes = value
 	}
+	if value, ok := acc.mutation.RefreshTokenExpireSecs(); ok {
+		_spec.SetField(authclient.FieldRefreshTokenExpireSecs, field.TypeInt, value)
+		_node.RefreshTokenExpireSecs = value
+	}
+	if value, ok := acc.mutation.SessionPolicy(); ok {
+		_spec.SetField(authclient.FieldSessionPolicy, field.TypeInt, value)
+		_node.SessionPolicy = value
+	}
+	if value, ok := acc.mutation.MaxSessions(); ok {
+		_spec.SetField(authclient.FieldMaxSessions, field.TypeInt, value)
+		_node.MaxSessions = value
+	}
+	if value, ok := acc.mutation.SigningMethod(); ok {
+		_spec.SetField(authclient.FieldSigningMethod, field.TypeInt, value)
+		_node.SigningMethod = value
+	}
+	if value, ok := acc.mutation.MfaRequired(); ok {
+		_spec.SetField(authclient.FieldMfaRequired, field.TypeBool, value)
+		_node.MfaRequired = value
+	}
+	if value, ok := acc.mutation.PasswordMinLength(); ok {
+		_spec.SetField(authclient.FieldPasswordMinLength, field.TypeInt, value)
+		_node.PasswordMinLength = value
+	}
+	if value, ok := acc.mutation.PasswordCharClasses(); ok {
+		_spec.SetField(authclient.FieldPasswordCharClasses, field.TypeJSON, value)
+		_node.PasswordCharClasses = value
+	}
+	if value, ok := acc.mutation.PasswordHistoryCount(); ok {
+		_spec.SetField(authclient.FieldPasswordHistoryCount, field.TypeInt, value)
+		_node.PasswordHistoryCount = value
+	}
+	if value, ok := acc.mutation.PasswordMaxAgeSecs(); ok {
+		_spec.SetField(authclient.FieldPasswordMaxAgeSecs, field.TypeInt, value)
+		_node.PasswordMaxAgeSecs = value
+	}
+	if value, ok := acc.mutation.BannedPasswords(); ok {
+		_spec.SetField(authclient.FieldBannedPasswords, field.TypeJSON, value)
+		_node.BannedPasswords = value
+	}
+	if value, ok := acc.mutation.LockoutSecs(); ok {
+		_spec.SetField(authclient.FieldLockoutSecs, field.TypeInt, value)
+		_node.LockoutSecs = value
+	}
+	if value, ok := acc.mutation.MaxLockoutSecs(); ok {
+		_spec.SetField(authclient.FieldMaxLockoutSecs, field.TypeInt, value)
+		_node.MaxLockoutSecs = value
+	}
+	if value, ok := acc.mutation.RiskChallengeScore(); ok {
+		_spec.SetField(authclient.FieldRiskChallengeScore, field.TypeInt, value)
+		_node.RiskChallengeScore = value
+	}
+	if value, ok := acc.mutation.RiskDenyScore(); ok {
+		_spec.SetField(authclient.FieldRiskDenyScore, field.TypeInt, value)
+		_node.RiskDenyScore = value
+	}
 	if nodes := acc.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -452,6 +773,252 @@ func (u *AuthClientUpsert) AddLoginFailedTimes(v int) *AuthClientUpsert {
 	return u
 }
 
+// SetRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field.
+func (u *AuthClientUpsert) SetRefreshTokenExpireSecs(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldRefreshTokenExpireSecs, v)
+	return u
+}
+
+// UpdateRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateRefreshTokenExpireSecs() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldRefreshTokenExpireSecs)
+	return u
+}
+
+// AddRefreshTokenExpireSecs adds v to the "refresh_token_expire_secs" field.
+func (u *AuthClientUpsert) AddRefreshTokenExpireSecs(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldRefreshTokenExpireSecs, v)
+	return u
+}
+
+// SetSessionPolicy sets the "session_policy" field.
+func (u *AuthClientUpsert) SetSessionPolicy(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldSessionPolicy, v)
+	return u
+}
+
+// UpdateSessionPolicy sets the "session_policy" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateSessionPolicy() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldSessionPolicy)
+	return u
+}
+
+// AddSessionPolicy adds v to the "session_policy" field.
+func (u *AuthClientUpsert) AddSessionPolicy(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldSessionPolicy, v)
+	return u
+}
+
+// SetMaxSessions sets the "max_sessions" field.
+func (u *AuthClientUpsert) SetMaxSessions(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldMaxSessions, v)
+	return u
+}
+
+// UpdateMaxSessions sets the "max_sessions" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateMaxSessions() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldMaxSessions)
+	return u
+}
+
+// AddMaxSessions adds v to the "max_sessions" field.
+func (u *AuthClientUpsert) AddMaxSessions(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldMaxSessions, v)
+	return u
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (u *AuthClientUpsert) SetSigningMethod(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldSigningMethod, v)
+	return u
+}
+
+// UpdateSigningMethod sets the "signing_method" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateSigningMethod() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldSigningMethod)
+	return u
+}
+
+// AddSigningMethod adds v to the "signing_method" field.
+func (u *AuthClientUpsert) AddSigningMethod(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldSigningMethod, v)
+	return u
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (u *AuthClientUpsert) SetMfaRequired(v bool) *AuthClientUpsert {
+	u.Set(authclient.FieldMfaRequired, v)
+	return u
+}
+
+// UpdateMfaRequired sets the "mfa_required" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateMfaRequired() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldMfaRequired)
+	return u
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (u *AuthClientUpsert) SetPasswordMinLength(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldPasswordMinLength, v)
+	return u
+}
+
+// UpdatePasswordMinLength sets the "password_min_length" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdatePasswordMinLength() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldPasswordMinLength)
+	return u
+}
+
+// AddPasswordMinLength adds v to the "password_min_length" field.
+func (u *AuthClientUpsert) AddPasswordMinLength(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldPasswordMinLength, v)
+	return u
+}
+
+// SetPasswordCharClasses sets the "password_char_classes" field.
+func (u *AuthClientUpsert) SetPasswordCharClasses(v []enum.PasswordCharClass) *AuthClientUpsert {
+	u.Set(authclient.FieldPasswordCharClasses, v)
+	return u
+}
+
+// UpdatePasswordCharClasses sets the "password_char_classes" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdatePasswordCharClasses() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldPasswordCharClasses)
+	return u
+}
+
+// ClearPasswordCharClasses clears the value of the "password_char_classes" field.
+func (u *AuthClientUpsert) ClearPasswordCharClasses() *AuthClientUpsert {
+	u.SetNull(authclient.FieldPasswordCharClasses)
+	return u
+}
+
+// SetPasswordHistoryCount sets the "password_history_count" field.
+func (u *AuthClientUpsert) SetPasswordHistoryCount(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldPasswordHistoryCount, v)
+	return u
+}
+
+// UpdatePasswordHistoryCount sets the "password_history_count" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdatePasswordHistoryCount() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldPasswordHistoryCount)
+	return u
+}
+
+// AddPasswordHistoryCount adds v to the "password_history_count" field.
+func (u *AuthClientUpsert) AddPasswordHistoryCount(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldPasswordHistoryCount, v)
+	return u
+}
+
+// SetPasswordMaxAgeSecs sets the "password_max_age_secs" field.
+func (u *AuthClientUpsert) SetPasswordMaxAgeSecs(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldPasswordMaxAgeSecs, v)
+	return u
+}
+
+// UpdatePasswordMaxAgeSecs sets the "password_max_age_secs" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdatePasswordMaxAgeSecs() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldPasswordMaxAgeSecs)
+	return u
+}
+
+// AddPasswordMaxAgeSecs adds v to the "password_max_age_secs" field.
+func (u *AuthClientUpsert) AddPasswordMaxAgeSecs(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldPasswordMaxAgeSecs, v)
+	return u
+}
+
+// SetBannedPasswords sets the "banned_passwords" field.
+func (u *AuthClientUpsert) SetBannedPasswords(v []string) *AuthClientUpsert {
+	u.Set(authclient.FieldBannedPasswords, v)
+	return u
+}
+
+// UpdateBannedPasswords sets the "banned_passwords" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateBannedPasswords() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldBannedPasswords)
+	return u
+}
+
+// ClearBannedPasswords clears the value of the "banned_passwords" field.
+func (u *AuthClientUpsert) ClearBannedPasswords() *AuthClientUpsert {
+	u.SetNull(authclient.FieldBannedPasswords)
+	return u
+}
+
+// SetLockoutSecs sets the "lockout_secs" field.
+func (u *AuthClientUpsert) SetLockoutSecs(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldLockoutSecs, v)
+	return u
+}
+
+// UpdateLockoutSecs sets the "lockout_secs" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateLockoutSecs() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldLockoutSecs)
+	return u
+}
+
+// AddLockoutSecs adds v to the "lockout_secs" field.
+func (u *AuthClientUpsert) AddLockoutSecs(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldLockoutSecs, v)
+	return u
+}
+
+// SetMaxLockoutSecs sets the "max_lockout_secs" field.
+func (u *AuthClientUpsert) SetMaxLockoutSecs(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldMaxLockoutSecs, v)
+	return u
+}
+
+// UpdateMaxLockoutSecs sets the "max_lockout_secs" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateMaxLockoutSecs() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldMaxLockoutSecs)
+	return u
+}
+
+// AddMaxLockoutSecs adds v to the "max_lockout_secs" field.
+func (u *AuthClientUpsert) AddMaxLockoutSecs(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldMaxLockoutSecs, v)
+	return u
+}
+
+// SetRiskChallengeScore sets the "risk_challenge_score" field.
+func (u *AuthClientUpsert) SetRiskChallengeScore(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldRiskChallengeScore, v)
+	return u
+}
+
+// UpdateRiskChallengeScore sets the "risk_challenge_score" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateRiskChallengeScore() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldRiskChallengeScore)
+	return u
+}
+
+// AddRiskChallengeScore adds v to the "risk_challenge_score" field.
+func (u *AuthClientUpsert) AddRiskChallengeScore(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldRiskChallengeScore, v)
+	return u
+}
+
+// SetRiskDenyScore sets the "risk_deny_score" field.
+func (u *AuthClientUpsert) SetRiskDenyScore(v int) *AuthClientUpsert {
+	u.Set(authclient.FieldRiskDenyScore, v)
+	return u
+}
+
+// UpdateRiskDenyScore sets the "risk_deny_score" field to the value that was provided on create.
+func (u *AuthClientUpsert) UpdateRiskDenyScore() *AuthClientUpsert {
+	u.SetExcluded(authclient.FieldRiskDenyScore)
+	return u
+}
+
+// AddRiskDenyScore adds v to the "risk_deny_score" field.
+func (u *AuthClientUpsert) AddRiskDenyScore(v int) *AuthClientUpsert {
+	u.Add(authclient.FieldRiskDenyScore, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -629,11 +1196,298 @@ func (u *AuthClientUpsertOne) UpdateLoginFailedTimes() *AuthClientUpsertOne {
 	})
 }
 
-// Exec executes the query.
-func (u *AuthClientUpsertOne) Exec(ctx context.Context) error {
-	if len(u.create.conflict) == 0 {
-		return errors.New("ent: missing options for AuthClientCreate.OnConflict")
-	}
+// SetRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field.
+func (u *AuthClientUpsertOne) SetRefreshTokenExpireSecs(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetRefreshTokenExpireSecs(v)
+	})
+}
+
+// AddRefreshTokenExpireSecs adds v to the "refresh_token_expire_secs" field.
+func (u *AuthClientUpsertOne) AddRefreshTokenExpireSecs(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddRefreshTokenExpireSecs(v)
+	})
+}
+
+// UpdateRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateRefreshTokenExpireSecs() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateRefreshTokenExpireSecs()
+	})
+}
+
+// SetSessionPolicy sets the "session_policy" field.
+func (u *AuthClientUpsertOne) SetSessionPolicy(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetSessionPolicy(v)
+	})
+}
+
+// AddSessionPolicy adds v to the "session_policy" field.
+func (u *AuthClientUpsertOne) AddSessionPolicy(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddSessionPolicy(v)
+	})
+}
+
+// UpdateSessionPolicy sets the "session_policy" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateSessionPolicy() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateSessionPolicy()
+	})
+}
+
+// SetMaxSessions sets the "max_sessions" field.
+func (u *AuthClientUpsertOne) SetMaxSessions(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetMaxSessions(v)
+	})
+}
+
+// AddMaxSessions adds v to the "max_sessions" field.
+func (u *AuthClientUpsertOne) AddMaxSessions(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddMaxSessions(v)
+	})
+}
+
+// UpdateMaxSessions sets the "max_sessions" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateMaxSessions() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateMaxSessions()
+	})
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (u *AuthClientUpsertOne) SetSigningMethod(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetSigningMethod(v)
+	})
+}
+
+// AddSigningMethod adds v to the "signing_method" field.
+func (u *AuthClientUpsertOne) AddSigningMethod(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddSigningMethod(v)
+	})
+}
+
+// UpdateSigningMethod sets the "signing_method" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateSigningMethod() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateSigningMethod()
+	})
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (u *AuthClientUpsertOne) SetMfaRequired(v bool) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetMfaRequired(v)
+	})
+}
+
+// UpdateMfaRequired sets the "mfa_required" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateMfaRequired() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateMfaRequired()
+	})
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (u *AuthClientUpsertOne) SetPasswordMinLength(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetPasswordMinLength(v)
+	})
+}
+
+// AddPasswordMinLength adds v to the "password_min_length" field.
+func (u *AuthClientUpsertOne) AddPasswordMinLength(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddPasswordMinLength(v)
+	})
+}
+
+// UpdatePasswordMinLength sets the "password_min_length" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdatePasswordMinLength() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdatePasswordMinLength()
+	})
+}
+
+// SetPasswordCharClasses sets the "password_char_classes" field.
+func (u *AuthClientUpsertOne) SetPasswordCharClasses(v []enum.PasswordCharClass) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetPasswordCharClasses(v)
+	})
+}
+
+// UpdatePasswordCharClasses sets the "password_char_classes" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdatePasswordCharClasses() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdatePasswordCharClasses()
+	})
+}
+
+// ClearPasswordCharClasses clears the value of the "password_char_classes" field.
+func (u *AuthClientUpsertOne) ClearPasswordCharClasses() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.ClearPasswordCharClasses()
+	})
+}
+
+// SetPasswordHistoryCount sets the "password_history_count" field.
+func (u *AuthClientUpsertOne) SetPasswordHistoryCount(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetPasswordHistoryCount(v)
+	})
+}
+
+// AddPasswordHistoryCount adds v to the "password_history_count" field.
+func (u *AuthClientUpsertOne) AddPasswordHistoryCount(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddPasswordHistoryCount(v)
+	})
+}
+
+// UpdatePasswordHistoryCount sets the "password_history_count" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdatePasswordHistoryCount() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdatePasswordHistoryCount()
+	})
+}
+
+// SetPasswordMaxAgeSecs sets the "password_max_age_secs" field.
+func (u *AuthClientUpsertOne) SetPasswordMaxAgeSecs(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetPasswordMaxAgeSecs(v)
+	})
+}
+
+// AddPasswordMaxAgeSecs adds v to the "password_max_age_secs" field.
+func (u *AuthClientUpsertOne) AddPasswordMaxAgeSecs(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddPasswordMaxAgeSecs(v)
+	})
+}
+
+// UpdatePasswordMaxAgeSecs sets the "password_max_age_secs" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdatePasswordMaxAgeSecs() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdatePasswordMaxAgeSecs()
+	})
+}
+
+// SetBannedPasswords sets the "banned_passwords" field.
+func (u *AuthClientUpsertOne) SetBannedPasswords(v []string) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetBannedPasswords(v)
+	})
+}
+
+// UpdateBannedPasswords sets the "banned_passwords" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateBannedPasswords() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateBannedPasswords()
+	})
+}
+
+// ClearBannedPasswords clears the value of the "banned_passwords" field.
+func (u *AuthClientUpsertOne) ClearBannedPasswords() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.ClearBannedPasswords()
+	})
+}
+
+// SetLockoutSecs sets the "lockout_secs" field.
+func (u *AuthClientUpsertOne) SetLockoutSecs(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetLockoutSecs(v)
+	})
+}
+
+// AddLockoutSecs adds v to the "lockout_secs" field.
+func (u *AuthClientUpsertOne) AddLockoutSecs(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddLockoutSecs(v)
+	})
+}
+
+// UpdateLockoutSecs sets the "lockout_secs" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateLockoutSecs() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateLockoutSecs()
+	})
+}
+
+// SetMaxLockoutSecs sets the "max_lockout_secs" field.
+func (u *AuthClientUpsertOne) SetMaxLockoutSecs(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetMaxLockoutSecs(v)
+	})
+}
+
+// AddMaxLockoutSecs adds v to the "max_lockout_secs" field.
+func (u *AuthClientUpsertOne) AddMaxLockoutSecs(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddMaxLockoutSecs(v)
+	})
+}
+
+// UpdateMaxLockoutSecs sets the "max_lockout_secs" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateMaxLockoutSecs() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateMaxLockoutSecs()
+	})
+}
+
+// SetRiskChallengeScore sets the "risk_challenge_score" field.
+func (u *AuthClientUpsertOne) SetRiskChallengeScore(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetRiskChallengeScore(v)
+	})
+}
+
+// AddRiskChallengeScore adds v to the "risk_challenge_score" field.
+func (u *AuthClientUpsertOne) AddRiskChallengeScore(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddRiskChallengeScore(v)
+	})
+}
+
+// UpdateRiskChallengeScore sets the "risk_challenge_score" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateRiskChallengeScore() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateRiskChallengeScore()
+	})
+}
+
+// SetRiskDenyScore sets the "risk_deny_score" field.
+func (u *AuthClientUpsertOne) SetRiskDenyScore(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetRiskDenyScore(v)
+	})
+}
+
+// AddRiskDenyScore adds v to the "risk_deny_score" field.
+func (u *AuthClientUpsertOne) AddRiskDenyScore(v int) *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddRiskDenyScore(v)
+	})
+}
+
+// UpdateRiskDenyScore sets the "risk_deny_score" field to the value that was provided on create.
+func (u *AuthClientUpsertOne) UpdateRiskDenyScore() *AuthClientUpsertOne {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateRiskDenyScore()
+	})
+}
+
+// Exec executes the query.
+func (u *AuthClientUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for AuthClientCreate.OnConflict")
+	}
 	return u.create.Exec(ctx)
 }
 
@@ -972,6 +1826,293 @@ func (u *AuthClientUpsertBulk) UpdateLoginFailedTimes() *AuthClientUpsertBulk {
 	})
 }
 
+// SetRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field.
+func (u *AuthClientUpsertBulk) SetRefreshTokenExpireSecs(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetRefreshTokenExpireSecs(v)
+	})
+}
+
+// AddRefreshTokenExpireSecs adds v to the "refresh_token_expire_secs" field.
+func (u *AuthClientUpsertBulk) AddRefreshTokenExpireSecs(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddRefreshTokenExpireSecs(v)
+	})
+}
+
+// UpdateRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateRefreshTokenExpireSecs() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateRefreshTokenExpireSecs()
+	})
+}
+
+// SetSessionPolicy sets the "session_policy" field.
+func (u *AuthClientUpsertBulk) SetSessionPolicy(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetSessionPolicy(v)
+	})
+}
+
+// AddSessionPolicy adds v to the "session_policy" field.
+func (u *AuthClientUpsertBulk) AddSessionPolicy(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddSessionPolicy(v)
+	})
+}
+
+// UpdateSessionPolicy sets the "session_policy" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateSessionPolicy() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateSessionPolicy()
+	})
+}
+
+// SetMaxSessions sets the "max_sessions" field.
+func (u *AuthClientUpsertBulk) SetMaxSessions(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetMaxSessions(v)
+	})
+}
+
+// AddMaxSessions adds v to the "max_sessions" field.
+func (u *AuthClientUpsertBulk) AddMaxSessions(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddMaxSessions(v)
+	})
+}
+
+// UpdateMaxSessions sets the "max_sessions" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateMaxSessions() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateMaxSessions()
+	})
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (u *AuthClientUpsertBulk) SetSigningMethod(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetSigningMethod(v)
+	})
+}
+
+// AddSigningMethod adds v to the "signing_method" field.
+func (u *AuthClientUpsertBulk) AddSigningMethod(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddSigningMethod(v)
+	})
+}
+
+// UpdateSigningMethod sets the "signing_method" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateSigningMethod() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateSigningMethod()
+	})
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (u *AuthClientUpsertBulk) SetMfaRequired(v bool) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetMfaRequired(v)
+	})
+}
+
+// UpdateMfaRequired sets the "mfa_required" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateMfaRequired() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateMfaRequired()
+	})
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (u *AuthClientUpsertBulk) SetPasswordMinLength(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetPasswordMinLength(v)
+	})
+}
+
+// AddPasswordMinLength adds v to the "password_min_length" field.
+func (u *AuthClientUpsertBulk) AddPasswordMinLength(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddPasswordMinLength(v)
+	})
+}
+
+// UpdatePasswordMinLength sets the "password_min_length" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdatePasswordMinLength() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdatePasswordMinLength()
+	})
+}
+
+// SetPasswordCharClasses sets the "password_char_classes" field.
+func (u *AuthClientUpsertBulk) SetPasswordCharClasses(v []enum.PasswordCharClass) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetPasswordCharClasses(v)
+	})
+}
+
+// UpdatePasswordCharClasses sets the "password_char_classes" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdatePasswordCharClasses() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdatePasswordCharClasses()
+	})
+}
+
+// ClearPasswordCharClasses clears the value of the "password_char_classes" field.
+func (u *AuthClientUpsertBulk) ClearPasswordCharClasses() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.ClearPasswordCharClasses()
+	})
+}
+
+// SetPasswordHistoryCount sets the "password_history_count" field.
+func (u *AuthClientUpsertBulk) SetPasswordHistoryCount(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetPasswordHistoryCount(v)
+	})
+}
+
+// AddPasswordHistoryCount adds v to the "password_history_count" field.
+func (u *AuthClientUpsertBulk) AddPasswordHistoryCount(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddPasswordHistoryCount(v)
+	})
+}
+
+// UpdatePasswordHistoryCount sets the "password_history_count" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdatePasswordHistoryCount() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdatePasswordHistoryCount()
+	})
+}
+
+// SetPasswordMaxAgeSecs sets the "password_max_age_secs" field.
+func (u *AuthClientUpsertBulk) SetPasswordMaxAgeSecs(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetPasswordMaxAgeSecs(v)
+	})
+}
+
+// AddPasswordMaxAgeSecs adds v to the "password_max_age_secs" field.
+func (u *AuthClientUpsertBulk) AddPasswordMaxAgeSecs(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddPasswordMaxAgeSecs(v)
+	})
+}
+
+// UpdatePasswordMaxAgeSecs sets the "password_max_age_secs" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdatePasswordMaxAgeSecs() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdatePasswordMaxAgeSecs()
+	})
+}
+
+// SetBannedPasswords sets the "banned_passwords" field.
+func (u *AuthClientUpsertBulk) SetBannedPasswords(v []string) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetBannedPasswords(v)
+	})
+}
+
+// UpdateBannedPasswords sets the "banned_passwords" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateBannedPasswords() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateBannedPasswords()
+	})
+}
+
+// ClearBannedPasswords clears the value of the "banned_passwords" field.
+func (u *AuthClientUpsertBulk) ClearBannedPasswords() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.ClearBannedPasswords()
+	})
+}
+
+// SetLockoutSecs sets the "lockout_secs" field.
+func (u *AuthClientUpsertBulk) SetLockoutSecs(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetLockoutSecs(v)
+	})
+}
+
+// AddLockoutSecs adds v to the "lockout_secs" field.
+func (u *AuthClientUpsertBulk) AddLockoutSecs(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddLockoutSecs(v)
+	})
+}
+
+// UpdateLockoutSecs sets the "lockout_secs" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateLockoutSecs() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateLockoutSecs()
+	})
+}
+
+// SetMaxLockoutSecs sets the "max_lockout_secs" field.
+func (u *AuthClientUpsertBulk) SetMaxLockoutSecs(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetMaxLockoutSecs(v)
+	})
+}
+
+// AddMaxLockoutSecs adds v to the "max_lockout_secs" field.
+func (u *AuthClientUpsertBulk) AddMaxLockoutSecs(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddMaxLockoutSecs(v)
+	})
+}
+
+// UpdateMaxLockoutSecs sets the "max_lockout_secs" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateMaxLockoutSecs() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateMaxLockoutSecs()
+	})
+}
+
+// SetRiskChallengeScore sets the "risk_challenge_score" field.
+func (u *AuthClientUpsertBulk) SetRiskChallengeScore(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetRiskChallengeScore(v)
+	})
+}
+
+// AddRiskChallengeScore adds v to the "risk_challenge_score" field.
+func (u *AuthClientUpsertBulk) AddRiskChallengeScore(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddRiskChallengeScore(v)
+	})
+}
+
+// UpdateRiskChallengeScore sets the "risk_challenge_score" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateRiskChallengeScore() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateRiskChallengeScore()
+	})
+}
+
+// SetRiskDenyScore sets the "risk_deny_score" field.
+func (u *AuthClientUpsertBulk) SetRiskDenyScore(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.SetRiskDenyScore(v)
+	})
+}
+
+// AddRiskDenyScore adds v to the "risk_deny_score" field.
+func (u *AuthClientUpsertBulk) AddRiskDenyScore(v int) *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.AddRiskDenyScore(v)
+	})
+}
+
+// UpdateRiskDenyScore sets the "risk_deny_score" field to the value that was provided on create.
+func (u *AuthClientUpsertBulk) UpdateRiskDenyScore() *AuthClientUpsertBulk {
+	return u.Update(func(s *AuthClientUpsert) {
+		s.UpdateRiskDenyScore()
+	})
+}
+
 // Exec executes the query.
 func (u *AuthClientUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
@@ -10,10 +10,12 @@ import (
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/role"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/user"
+	"go_micro_service_api/pkg/enum"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 )
 
@@ -148,6 +150,287 @@ func (acu *AuthClientUpdate) AddLoginFailedTimes(i int) *AuthClientUpdate {
 	return acu
 }
 
+// SetRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field.
+func (acu *AuthClientUpdate) SetRefreshTokenExpireSecs(i int) *AuthClientUpdate {
+	acu.mutation.ResetRefreshTokenExpireSecs()
+	acu.mutation.SetRefreshTokenExpireSecs(i)
+	return acu
+}
+
+// SetNillableRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableRefreshTokenExpireSecs(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetRefreshTokenExpireSecs(*i)
+	}
+	return acu
+}
+
+// AddRefreshTokenExpireSecs adds i to the "refresh_token_expire_secs" field.
+func (acu *AuthClientUpdate) AddRefreshTokenExpireSecs(i int) *AuthClientUpdate {
+	acu.mutation.AddRefreshTokenExpireSecs(i)
+	return acu
+}
+
+// SetSessionPolicy sets the "session_policy" field.
+func (acu *AuthClientUpdate) SetSessionPolicy(i int) *AuthClientUpdate {
+	acu.mutation.ResetSessionPolicy()
+	acu.mutation.SetSessionPolicy(i)
+	return acu
+}
+
+// SetNillableSessionPolicy sets the "session_policy" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableSessionPolicy(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetSessionPolicy(*i)
+	}
+	return acu
+}
+
+// AddSessionPolicy adds i to the "session_policy" field.
+func (acu *AuthClientUpdate) AddSessionPolicy(i int) *AuthClientUpdate {
+	acu.mutation.AddSessionPolicy(i)
+	return acu
+}
+
+// SetMaxSessions sets the "max_sessions" field.
+func (acu *AuthClientUpdate) SetMaxSessions(i int) *AuthClientUpdate {
+	acu.mutation.ResetMaxSessions()
+	acu.mutation.SetMaxSessions(i)
+	return acu
+}
+
+// SetNillableMaxSessions sets the "max_sessions" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableMaxSessions(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetMaxSessions(*i)
+	}
+	return acu
+}
+
+// AddMaxSessions adds i to the "max_sessions" field.
+func (acu *AuthClientUpdate) AddMaxSessions(i int) *AuthClientUpdate {
+	acu.mutation.AddMaxSessions(i)
+	return acu
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (acu *AuthClientUpdate) SetSigningMethod(i int) *AuthClientUpdate {
+	acu.mutation.ResetSigningMethod()
+	acu.mutation.SetSigningMethod(i)
+	return acu
+}
+
+// SetNillableSigningMethod sets the "signing_method" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableSigningMethod(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetSigningMethod(*i)
+	}
+	return acu
+}
+
+// AddSigningMethod adds i to the "signing_method" field.
+func (acu *AuthClientUpdate) AddSigningMethod(i int) *AuthClientUpdate {
+	acu.mutation.AddSigningMethod(i)
+	return acu
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (acu *AuthClientUpdate) SetMfaRequired(b bool) *AuthClientUpdate {
+	acu.mutation.SetMfaRequired(b)
+	return acu
+}
+
+// SetNillableMfaRequired sets the "mfa_required" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableMfaRequired(b *bool) *AuthClientUpdate {
+	if b != nil {
+		acu.SetMfaRequired(*b)
+	}
+	return acu
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (acu *AuthClientUpdate) SetPasswordMinLength(i int) *AuthClientUpdate {
+	acu.mutation.ResetPasswordMinLength()
+	acu.mutation.SetPasswordMinLength(i)
+	return acu
+}
+
+// SetNillablePasswordMinLength sets the "password_min_length" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillablePasswordMinLength(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetPasswordMinLength(*i)
+	}
+	return acu
+}
+
+// AddPasswordMinLength adds i to the "password_min_length" field.
+func (acu *AuthClientUpdate) AddPasswordMinLength(i int) *AuthClientUpdate {
+	acu.mutation.AddPasswordMinLength(i)
+	return acu
+}
+
+// SetPasswordCharClasses sets the "password_char_classes" field.
+func (acu *AuthClientUpdate) SetPasswordCharClasses(ecc []enum.PasswordCharClass) *AuthClientUpdate {
+	acu.mutation.SetPasswordCharClasses(ecc)
+	return acu
+}
+
+// AppendPasswordCharClasses appends ecc to the "password_char_classes" field.
+func (acu *AuthClientUpdate) AppendPasswordCharClasses(ecc []enum.PasswordCharClass) *AuthClientUpdate {
+	acu.mutation.AppendPasswordCharClasses(ecc)
+	return acu
+}
+
+// ClearPasswordCharClasses clears the value of the "password_char_classes" field.
+func (acu *AuthClientUpdate) ClearPasswordCharClasses() *AuthClientUpdate {
+	acu.mutation.ClearPasswordCharClasses()
+	return acu
+}
+
+// SetPasswordHistoryCount sets the "password_history_count" field.
+func (acu *AuthClientUpdate) SetPasswordHistoryCount(i int) *AuthClientUpdate {
+	acu.mutation.ResetPasswordHistoryCount()
+	acu.mutation.SetPasswordHistoryCount(i)
+	return acu
+}
+
+// SetNillablePasswordHistoryCount sets the "password_history_count" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillablePasswordHistoryCount(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetPasswordHistoryCount(*i)
+	}
+	return acu
+}
+
+// AddPasswordHistoryCount adds i to the "password_history_count" field.
+func (acu *AuthClientUpdate) AddPasswordHistoryCount(i int) *AuthClientUpdate {
+	acu.mutation.AddPasswordHistoryCount(i)
+	return acu
+}
+
+// SetPasswordMaxAgeSecs sets the "password_max_age_secs" field.
+func (acu *AuthClientUpdate) SetPasswordMaxAgeSecs(i int) *AuthClientUpdate {
+	acu.mutation.ResetPasswordMaxAgeSecs()
+	acu.mutation.SetPasswordMaxAgeSecs(i)
+	return acu
+}
+
+// SetNillablePasswordMaxAgeSecs sets the "password_max_age_secs" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillablePasswordMaxAgeSecs(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetPasswordMaxAgeSecs(*i)
+	}
+	return acu
+}
+
+// AddPasswordMaxAgeSecs adds i to the "password_max_age_secs" field.
+func (acu *AuthClientUpdate) AddPasswordMaxAgeSecs(i int) *AuthClientUpdate {
+	acu.mutation.AddPasswordMaxAgeSecs(i)
+	return acu
+}
+
+// SetBannedPasswords sets the "banned_passwords" field.
+func (acu *AuthClientUpdate) SetBannedPasswords(s []string) *AuthClientUpdate {
+	acu.mutation.SetBannedPasswords(s)
+	return acu
+}
+
+// AppendBannedPasswords appends s to the "banned_passwords" field.
+func (acu *AuthClientUpdate) AppendBannedPasswords(s []string) *AuthClientUpdate {
+	acu.mutation.AppendBannedPasswords(s)
+	return acu
+}
+
+// ClearBannedPasswords clears the value of the "banned_passwords" field.
+func (acu *AuthClientUpdate) ClearBannedPasswords() *AuthClientUpdate {
+	acu.mutation.ClearBannedPasswords()
+	return acu
+}
+
+// SetLockoutSecs sets the "lockout_secs" field.
+func (acu *AuthClientUpdate) SetLockoutSecs(i int) *AuthClientUpdate {
+	acu.mutation.ResetLockoutSecs()
+	acu.mutation.SetLockoutSecs(i)
+	return acu
+}
+
+// SetNillableLockoutSecs sets the "lockout_secs" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableLockoutSecs(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetLockoutSecs(*i)
+	}
+	return acu
+}
+
+// AddLockoutSecs adds i to the "lockout_secs" field.
+func (acu *AuthClientUpdate) AddLockoutSecs(i int) *AuthClientUpdate {
+	acu.mutation.AddLockoutSecs(i)
+	return acu
+}
+
+// SetMaxLockoutSecs sets the "max_lockout_secs" field.
+func (acu *AuthClientUpdate) SetMaxLockoutSecs(i int) *AuthClientUpdate {
+	acu.mutation.ResetMaxLockoutSecs()
+	acu.mutation.SetMaxLockoutSecs(i)
+	return acu
+}
+
+// SetNillableMaxLockoutSecs sets the "max_lockout_secs" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableMaxLockoutSecs(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetMaxLockoutSecs(*i)
+	}
+	return acu
+}
+
+// AddMaxLockoutSecs adds i to the "max_lockout_secs" field.
+func (acu *AuthClientUpdate) AddMaxLockoutSecs(i int) *AuthClientUpdate {
+	acu.mutation.AddMaxLockoutSecs(i)
+	return acu
+}
+
+// SetRiskChallengeScore sets the "risk_challenge_score" field.
+func (acu *AuthClientUpdate) SetRiskChallengeScore(i int) *AuthClientUpdate {
+	acu.mutation.ResetRiskChallengeScore()
+	acu.mutation.SetRiskChallengeScore(i)
+	return acu
+}
+
+// SetNillableRiskChallengeScore sets the "risk_challenge_score" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableRiskChallengeScore(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetRiskChallengeScore(*i)
+	}
+	return acu
+}
+
+// AddRiskChallengeScore adds i to the "risk_challenge_score" field.
+func (acu *AuthClientUpdate) AddRiskChallengeScore(i int) *AuthClientUpdate {
+	acu.mutation.AddRiskChallengeScore(i)
+	return acu
+}
+
+// SetRiskDenyScore sets the "risk_deny_score" field.
+func (acu *AuthClientUpdate) SetRiskDenyScore(i int) *AuthClientUpdate {
+	acu.mutation.ResetRiskDenyScore()
+	acu.mutation.SetRiskDenyScore(i)
+	return acu
+}
+
+// SetNillableRiskDenyScore sets the "risk_deny_score" field if the given value is not nil.
+func (acu *AuthClientUpdate) SetNillableRiskDenyScore(i *int) *AuthClientUpdate {
+	if i != nil {
+		acu.SetRiskDenyScore(*i)
+	}
+	return acu
+}
+
+// AddRiskDenyScore adds i to the "risk_deny_score" field.
+func (acu *AuthClientUpdate) AddRiskDenyScore(i int) *AuthClientUpdate {
+	acu.mutation.AddRiskDenyScore(i)
+	return acu
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (acu *AuthClientUpdate) AddUserIDs(ids ...int64) *AuthClientUpdate {
 	acu.mutation.AddUserIDs(ids...)
@@ -303,6 +586,97 @@ func (acu *AuthClientUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := acu.mutation.AddedLoginFailedTimes(); ok {
 		_spec.AddField(authclient.FieldLoginFailedTimes, field.TypeInt, value)
 	}
+	if value, ok := acu.mutation.RefreshTokenExpireSecs(); ok {
+		_spec.SetField(authclient.FieldRefreshTokenExpireSecs, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedRefreshTokenExpireSecs(); ok {
+		_spec.AddField(authclient.FieldRefreshTokenExpireSecs, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.SessionPolicy(); ok {
+		_spec.SetField(authclient.FieldSessionPolicy, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedSessionPolicy(); ok {
+		_spec.AddField(authclient.FieldSessionPolicy, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.MaxSessions(); ok {
+		_spec.SetField(authclient.FieldMaxSessions, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedMaxSessions(); ok {
+		_spec.AddField(authclient.FieldMaxSessions, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.SigningMethod(); ok {
+		_spec.SetField(authclient.FieldSigningMethod, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedSigningMethod(); ok {
+		_spec.AddField(authclient.FieldSigningMethod, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.MfaRequired(); ok {
+		_spec.SetField(authclient.FieldMfaRequired, field.TypeBool, value)
+	}
+	if value, ok := acu.mutation.PasswordMinLength(); ok {
+		_spec.SetField(authclient.FieldPasswordMinLength, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedPasswordMinLength(); ok {
+		_spec.AddField(authclient.FieldPasswordMinLength, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.PasswordCharClasses(); ok {
+		_spec.SetField(authclient.FieldPasswordCharClasses, field.TypeJSON, value)
+	}
+	if value, ok := acu.mutation.AppendedPasswordCharClasses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authclient.FieldPasswordCharClasses, value)
+		})
+	}
+	if acu.mutation.PasswordCharClassesCleared() {
+		_spec.ClearField(authclient.FieldPasswordCharClasses, field.TypeJSON)
+	}
+	if value, ok := acu.mutation.PasswordHistoryCount(); ok {
+		_spec.SetField(authclient.FieldPasswordHistoryCount, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedPasswordHistoryCount(); ok {
+		_spec.AddField(authclient.FieldPasswordHistoryCount, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.PasswordMaxAgeSecs(); ok {
+		_spec.SetField(authclient.FieldPasswordMaxAgeSecs, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedPasswordMaxAgeSecs(); ok {
+		_spec.AddField(authclient.FieldPasswordMaxAgeSecs, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.BannedPasswords(); ok {
+		_spec.SetField(authclient.FieldBannedPasswords, field.TypeJSON, value)
+	}
+	if value, ok := acu.mutation.AppendedBannedPasswords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authclient.FieldBannedPasswords, value)
+		})
+	}
+	if acu.mutation.BannedPasswordsCleared() {
+		_spec.ClearField(authclient.FieldBannedPasswords, field.TypeJSON)
+	}
+	if value, ok := acu.mutation.LockoutSecs(); ok {
+		_spec.SetField(authclient.FieldLockoutSecs, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedLockoutSecs(); ok {
+		_spec.AddField(authclient.FieldLockoutSecs, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.MaxLockoutSecs(); ok {
+		_spec.SetField(authclient.FieldMaxLockoutSecs, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedMaxLockoutSecs(); ok {
+		_spec.AddField(authclient.FieldMaxLockoutSecs, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.RiskChallengeScore(); ok {
+		_spec.SetField(authclient.FieldRiskChallengeScore, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedRiskChallengeScore(); ok {
+		_spec.AddField(authclient.FieldRiskChallengeScore, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.RiskDenyScore(); ok {
+		_spec.SetField(authclient.FieldRiskDenyScore, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedRiskDenyScore(); ok {
+		_spec.AddField(authclient.FieldRiskDenyScore, field.TypeInt, value)
+	}
 	if acu.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -531,6 +905,287 @@ func (acuo *AuthClientUpdateOne) AddLoginFailedTimes(i int) *AuthClientUpdateOne
 	return acuo
 }
 
+// SetRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field.
+func (acuo *AuthClientUpdateOne) SetRefreshTokenExpireSecs(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetRefreshTokenExpireSecs()
+	acuo.mutation.SetRefreshTokenExpireSecs(i)
+	return acuo
+}
+
+// SetNillableRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableRefreshTokenExpireSecs(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetRefreshTokenExpireSecs(*i)
+	}
+	return acuo
+}
+
+// AddRefreshTokenExpireSecs adds i to the "refresh_token_expire_secs" field.
+func (acuo *AuthClientUpdateOne) AddRefreshTokenExpireSecs(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddRefreshTokenExpireSecs(i)
+	return acuo
+}
+
+// SetSessionPolicy sets the "session_policy" field.
+func (acuo *AuthClientUpdateOne) SetSessionPolicy(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetSessionPolicy()
+	acuo.mutation.SetSessionPolicy(i)
+	return acuo
+}
+
+// SetNillableSessionPolicy sets the "session_policy" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableSessionPolicy(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetSessionPolicy(*i)
+	}
+	return acuo
+}
+
+// AddSessionPolicy adds i to the "session_policy" field.
+func (acuo *AuthClientUpdateOne) AddSessionPolicy(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddSessionPolicy(i)
+	return acuo
+}
+
+// SetMaxSessions sets the "max_sessions" field.
+func (acuo *AuthClientUpdateOne) SetMaxSessions(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetMaxSessions()
+	acuo.mutation.SetMaxSessions(i)
+	return acuo
+}
+
+// SetNillableMaxSessions sets the "max_sessions" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableMaxSessions(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetMaxSessions(*i)
+	}
+	return acuo
+}
+
+// AddMaxSessions adds i to the "max_sessions" field.
+func (acuo *AuthClientUpdateOne) AddMaxSessions(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddMaxSessions(i)
+	return acuo
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (acuo *AuthClientUpdateOne) SetSigningMethod(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetSigningMethod()
+	acuo.mutation.SetSigningMethod(i)
+	return acuo
+}
+
+// SetNillableSigningMethod sets the "signing_method" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableSigningMethod(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetSigningMethod(*i)
+	}
+	return acuo
+}
+
+// AddSigningMethod adds i to the "signing_method" field.
+func (acuo *AuthClientUpdateOne) AddSigningMethod(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddSigningMethod(i)
+	return acuo
+}
+
+// SetMfaRequired sets the "mfa_required" field.
+func (acuo *AuthClientUpdateOne) SetMfaRequired(b bool) *AuthClientUpdateOne {
+	acuo.mutation.SetMfaRequired(b)
+	return acuo
+}
+
+// SetNillableMfaRequired sets the "mfa_required" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableMfaRequired(b *bool) *AuthClientUpdateOne {
+	if b != nil {
+		acuo.SetMfaRequired(*b)
+	}
+	return acuo
+}
+
+// SetPasswordMinLength sets the "password_min_length" field.
+func (acuo *AuthClientUpdateOne) SetPasswordMinLength(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetPasswordMinLength()
+	acuo.mutation.SetPasswordMinLength(i)
+	return acuo
+}
+
+// SetNillablePasswordMinLength sets the "password_min_length" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillablePasswordMinLength(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetPasswordMinLength(*i)
+	}
+	return acuo
+}
+
+// AddPasswordMinLength adds i to the "password_min_length" field.
+func (acuo *AuthClientUpdateOne) AddPasswordMinLength(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddPasswordMinLength(i)
+	return acuo
+}
+
+// SetPasswordCharClasses sets the "password_char_classes" field.
+func (acuo *AuthClientUpdateOne) SetPasswordCharClasses(ecc []enum.PasswordCharClass) *AuthClientUpdateOne {
+	acuo.mutation.SetPasswordCharClasses(ecc)
+	return acuo
+}
+
+// AppendPasswordCharClasses appends ecc to the "password_char_classes" field.
+func (acuo *AuthClientUpdateOne) AppendPasswordCharClasses(ecc []enum.PasswordCharClass) *AuthClientUpdateOne {
+	acuo.mutation.AppendPasswordCharClasses(ecc)
+	return acuo
+}
+
+// ClearPasswordCharClasses clears the value of the "password_char_classes" field.
+func (acuo *AuthClientUpdateOne) ClearPasswordCharClasses() *AuthClientUpdateOne {
+	acuo.mutation.ClearPasswordCharClasses()
+	return acuo
+}
+
+// SetPasswordHistoryCount sets the "password_history_count" field.
+func (acuo *AuthClientUpdateOne) SetPasswordHistoryCount(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetPasswordHistoryCount()
+	acuo.mutation.SetPasswordHistoryCount(i)
+	return acuo
+}
+
+// SetNillablePasswordHistoryCount sets the "password_history_count" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillablePasswordHistoryCount(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetPasswordHistoryCount(*i)
+	}
+	return acuo
+}
+
+// AddPasswordHistoryCount adds i to the "password_history_count" field.
+func (acuo *AuthClientUpdateOne) AddPasswordHistoryCount(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddPasswordHistoryCount(i)
+	return acuo
+}
+
+// SetPasswordMaxAgeSecs sets the "password_max_age_secs" field.
+func (acuo *AuthClientUpdateOne) SetPasswordMaxAgeSecs(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetPasswordMaxAgeSecs()
+	acuo.mutation.SetPasswordMaxAgeSecs(i)
+	return acuo
+}
+
+// SetNillablePasswordMaxAgeSecs sets the "password_max_age_secs" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillablePasswordMaxAgeSecs(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetPasswordMaxAgeSecs(*i)
+	}
+	return acuo
+}
+
+// AddPasswordMaxAgeSecs adds i to the "password_max_age_secs" field.
+func (acuo *AuthClientUpdateOne) AddPasswordMaxAgeSecs(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddPasswordMaxAgeSecs(i)
+	return acuo
+}
+
+// SetBannedPasswords sets the "banned_passwords" field.
+func (acuo *AuthClientUpdateOne) SetBannedPasswords(s []string) *AuthClientUpdateOne {
+	acuo.mutation.SetBannedPasswords(s)
+	return acuo
+}
+
+// AppendBannedPasswords appends s to the "banned_passwords" field.
+func (acuo *AuthClientUpdateOne) AppendBannedPasswords(s []string) *AuthClientUpdateOne {
+	acuo.mutation.AppendBannedPasswords(s)
+	return acuo
+}
+
+// ClearBannedPasswords clears the value of the "banned_passwords" field.
+func (acuo *AuthClientUpdateOne) ClearBannedPasswords() *AuthClientUpdateOne {
+	acuo.mutation.ClearBannedPasswords()
+	return acuo
+}
+
+// SetLockoutSecs sets the "lockout_secs" field.
+func (acuo *AuthClientUpdateOne) SetLockoutSecs(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetLockoutSecs()
+	acuo.mutation.SetLockoutSecs(i)
+	return acuo
+}
+
+// SetNillableLockoutSecs sets the "lockout_secs" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableLockoutSecs(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetLockoutSecs(*i)
+	}
+	return acuo
+}
+
+// AddLockoutSecs adds i to the "lockout_secs" field.
+func (acuo *AuthClientUpdateOne) AddLockoutSecs(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddLockoutSecs(i)
+	return acuo
+}
+
+// SetMaxLockoutSecs sets the "max_lockout_secs" field.
+func (acuo *AuthClientUpdateOne) SetMaxLockoutSecs(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetMaxLockoutSecs()
+	acuo.mutation.SetMaxLockoutSecs(i)
+	return acuo
+}
+
+// SetNillableMaxLockoutSecs sets the "max_lockout_secs" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableMaxLockoutSecs(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetMaxLockoutSecs(*i)
+	}
+	return acuo
+}
+
+// AddMaxLockoutSecs adds i to the "max_lockout_secs" field.
+func (acuo *AuthClientUpdateOne) AddMaxLockoutSecs(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddMaxLockoutSecs(i)
+	return acuo
+}
+
+// SetRiskChallengeScore sets the "risk_challenge_score" field.
+func (acuo *AuthClientUpdateOne) SetRiskChallengeScore(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetRiskChallengeScore()
+	acuo.mutation.SetRiskChallengeScore(i)
+	return acuo
+}
+
+// SetNillableRiskChallengeScore sets the "risk_challenge_score" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableRiskChallengeScore(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetRiskChallengeScore(*i)
+	}
+	return acuo
+}
+
+// AddRiskChallengeScore adds i to the "risk_challenge_score" field.
+func (acuo *AuthClientUpdateOne) AddRiskChallengeScore(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddRiskChallengeScore(i)
+	return acuo
+}
+
+// SetRiskDenyScore sets the "risk_deny_score" field.
+func (acuo *AuthClientUpdateOne) SetRiskDenyScore(i int) *AuthClientUpdateOne {
+	acuo.mutation.ResetRiskDenyScore()
+	acuo.mutation.SetRiskDenyScore(i)
+	return acuo
+}
+
+// SetNillableRiskDenyScore sets the "risk_deny_score" field if the given value is not nil.
+func (acuo *AuthClientUpdateOne) SetNillableRiskDenyScore(i *int) *AuthClientUpdateOne {
+	if i != nil {
+		acuo.SetRiskDenyScore(*i)
+	}
+	return acuo
+}
+
+// AddRiskDenyScore adds i to the "risk_deny_score" field.
+func (acuo *AuthClientUpdateOne) AddRiskDenyScore(i int) *AuthClientUpdateOne {
+	acuo.mutation.AddRiskDenyScore(i)
+	return acuo
+}
+
 // AddUserIDs adds the "users" edge to the User entity by IDs.
 func (acuo *AuthClientUpdateOne) AddUserIDs(ids ...int64) *AuthClientUpdateOne {
 	acuo.mutation.AddUserIDs(ids...)
@@ -716,6 +1371,97 @@ func (acuo *AuthClientUpdateOne) sqlSave(ctx context.Context) (_node *AuthClient
 	if value, ok := acuo.mutation.AddedLoginFailedTimes(); ok {
 		_spec.AddField(authclient.FieldLoginFailedTimes, field.TypeInt, value)
 	}
+	if value, ok := acuo.mutation.RefreshTokenExpireSecs(); ok {
+		_spec.SetField(authclient.FieldRefreshTokenExpireSecs, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedRefreshTokenExpireSecs(); ok {
+		_spec.AddField(authclient.FieldRefreshTokenExpireSecs, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.SessionPolicy(); ok {
+		_spec.SetField(authclient.FieldSessionPolicy, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedSessionPolicy(); ok {
+		_spec.AddField(authclient.FieldSessionPolicy, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.MaxSessions(); ok {
+		_spec.SetField(authclient.FieldMaxSessions, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedMaxSessions(); ok {
+		_spec.AddField(authclient.FieldMaxSessions, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.SigningMethod(); ok {
+		_spec.SetField(authclient.FieldSigningMethod, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedSigningMethod(); ok {
+		_spec.AddField(authclient.FieldSigningMethod, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.MfaRequired(); ok {
+		_spec.SetField(authclient.FieldMfaRequired, field.TypeBool, value)
+	}
+	if value, ok := acuo.mutation.PasswordMinLength(); ok {
+		_spec.SetField(authclient.FieldPasswordMinLength, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedPasswordMinLength(); ok {
+		_spec.AddField(authclient.FieldPasswordMinLength, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.PasswordCharClasses(); ok {
+		_spec.SetField(authclient.FieldPasswordCharClasses, field.TypeJSON, value)
+	}
+	if value, ok := acuo.mutation.AppendedPasswordCharClasses(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authclient.FieldPasswordCharClasses, value)
+		})
+	}
+	if acuo.mutation.PasswordCharClassesCleared() {
+		_spec.ClearField(authclient.FieldPasswordCharClasses, field.TypeJSON)
+	}
+	if value, ok := acuo.mutation.PasswordHistoryCount(); ok {
+		_spec.SetField(authclient.FieldPasswordHistoryCount, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedPasswordHistoryCount(); ok {
+		_spec.AddField(authclient.FieldPasswordHistoryCount, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.PasswordMaxAgeSecs(); ok {
+		_spec.SetField(authclient.FieldPasswordMaxAgeSecs, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedPasswordMaxAgeSecs(); ok {
+		_spec.AddField(authclient.FieldPasswordMaxAgeSecs, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.BannedPasswords(); ok {
+		_spec.SetField(authclient.FieldBannedPasswords, field.TypeJSON, value)
+	}
+	if value, ok := acuo.mutation.AppendedBannedPasswords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, authclient.FieldBannedPasswords, value)
+		})
+	}
+	if acuo.mutation.BannedPasswordsCleared() {
+		_spec.ClearField(authclient.FieldBannedPasswords, field.TypeJSON)
+	}
+	if value, ok := acuo.mutation.LockoutSecs(); ok {
+		_spec.SetField(authclient.FieldLockoutSecs, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedLockoutSecs(); ok {
+		_spec.AddField(authclient.FieldLockoutSecs, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.MaxLockoutSecs(); ok {
+		_spec.SetField(authclient.FieldMaxLockoutSecs, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedMaxLockoutSecs(); ok {
+		_spec.AddField(authclient.FieldMaxLockoutSecs, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.RiskChallengeScore(); ok {
+		_spec.SetField(authclient.FieldRiskChallengeScore, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedRiskChallengeScore(); ok {
+		_spec.AddField(authclient.FieldRiskChallengeScore, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.RiskDenyScore(); ok {
+		_spec.SetField(authclient.FieldRiskDenyScore, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedRiskDenyScore(); ok {
+		_spec.AddField(authclient.FieldRiskDenyScore, field.TypeInt, value)
+	}
 	if acuo.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -13,8 +13,14 @@ import (
 
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/authclient"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/loginrecord"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/outboxevent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/passwordhistory"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/role"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/signingkey"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/user"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -33,10 +39,22 @@ type Client struct {
 	AuthClient *AuthClientClient
 	// LoginRecord is the client for interacting with the LoginRecord builders.
 	LoginRecord *LoginRecordClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// PasswordHistory is the client for interacting with the PasswordHistory builders.
+	PasswordHistory *PasswordHistoryClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
+	// TokenRevocation is the client for interacting with the TokenRevocation builders.
+	TokenRevocation *TokenRevocationClient
+	// TrustedDevice is the client for interacting with the TrustedDevice builders.
+	TrustedDevice *TrustedDeviceClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserTotp is the client for interacting with the UserTotp builders.
+	UserTotp *UserTotpClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -50,8 +68,14 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.AuthClient = NewAuthClientClient(c.config)
 	c.LoginRecord = NewLoginRecordClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
+	c.PasswordHistory = NewPasswordHistoryClient(c.config)
 	c.Role = NewRoleClient(c.config)
+	c.SigningKey = NewSigningKeyClient(c.config)
+	c.TokenRevocation = NewTokenRevocationClient(c.config)
+	c.TrustedDevice = NewTrustedDeviceClient(c.config)
 	c.User = NewUserClient(c.config)
+	c.UserTotp = NewUserTotpClient(c.config)
 }
 
 type (
@@ -142,12 +166,18 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AuthClient:  NewAuthClientClient(cfg),
-		LoginRecord: NewLoginRecordClient(cfg),
-		Role:        NewRoleClient(cfg),
-		User:        NewUserClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuthClient:      NewAuthClientClient(cfg),
+		LoginRecord:     NewLoginRecordClient(cfg),
+		OutboxEvent:     NewOutboxEventClient(cfg),
+		PasswordHistory: NewPasswordHistoryClient(cfg),
+		Role:            NewRoleClient(cfg),
+		SigningKey:      NewSigningKeyClient(cfg),
+		TokenRevocation: NewTokenRevocationClient(cfg),
+		TrustedDevice:   NewTrustedDeviceClient(cfg),
+		User:            NewUserClient(cfg),
+		UserTotp:        NewUserTotpClient(cfg),
 	}, nil
 }
 
@@ -165,12 +195,18 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:         ctx,
-		config:      cfg,
-		AuthClient:  NewAuthClientClient(cfg),
-		LoginRecord: NewLoginRecordClient(cfg),
-		Role:        NewRoleClient(cfg),
-		User:        NewUserClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuthClient:      NewAuthClientClient(cfg),
+		LoginRecord:     NewLoginRecordClient(cfg),
+		OutboxEvent:     NewOutboxEventClient(cfg),
+		PasswordHistory: NewPasswordHistoryClient(cfg),
+		Role:            NewRoleClient(cfg),
+		SigningKey:      NewSigningKeyClient(cfg),
+		TokenRevocation: NewTokenRevocationClient(cfg),
+		TrustedDevice:   NewTrustedDeviceClient(cfg),
+		User:            NewUserClient(cfg),
+		UserTotp:        NewUserTotpClient(cfg),
 	}, nil
 }
 
@@ -199,19 +235,23 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.AuthClient.Use(hooks...)
-	c.LoginRecord.Use(hooks...)
-	c.Role.Use(hooks...)
-	c.User.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuthClient, c.LoginRecord, c.OutboxEvent, c.PasswordHistory, c.Role,
+		c.SigningKey, c.TokenRevocation, c.TrustedDevice, c.User, c.UserTotp,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.AuthClient.Intercept(interceptors...)
-	c.LoginRecord.Intercept(interceptors...)
-	c.Role.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuthClient, c.LoginRecord, c.OutboxEvent, c.PasswordHistory, c.Role,
+		c.SigningKey, c.TokenRevocation, c.TrustedDevice, c.User, c.UserTotp,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -221,10 +261,22 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.AuthClient.mutate(ctx, m)
 	case *LoginRecordMutation:
 		return c.LoginRecord.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
+	case *PasswordHistoryMutation:
+		return c.PasswordHistory.mutate(ctx, m)
 	case *RoleMutation:
 		return c.Role.mutate(ctx, m)
+	case *SigningKeyMutation:
+		return c.SigningKey.mutate(ctx, m)
+	case *TokenRevocationMutation:
+		return c.TokenRevocation.mutate(ctx, m)
+	case *TrustedDeviceMutation:
+		return c.TrustedDevice.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
+	case *UserTotpMutation:
+		return c.UserTotp.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -544,6 +596,272 @@ func (c *LoginRecordClient) mutate(ctx context.Context, m *LoginRecordMutation)
 	}
 }
 
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(oe *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(oe))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id int) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(oe *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(oe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id int) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id int) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id int) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
+// PasswordHistoryClient is a client for the PasswordHistory schema.
+type PasswordHistoryClient struct {
+	config
+}
+
+// NewPasswordHistoryClient returns a client for the PasswordHistory from the given config.
+func NewPasswordHistoryClient(c config) *PasswordHistoryClient {
+	return &PasswordHistoryClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `passwordhistory.Hooks(f(g(h())))`.
+func (c *PasswordHistoryClient) Use(hooks ...Hook) {
+	c.hooks.PasswordHistory = append(c.hooks.PasswordHistory, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `passwordhistory.Intercept(f(g(h())))`.
+func (c *PasswordHistoryClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PasswordHistory = append(c.inters.PasswordHistory, interceptors...)
+}
+
+// Create returns a builder for creating a PasswordHistory entity.
+func (c *PasswordHistoryClient) Create() *PasswordHistoryCreate {
+	mutation := newPasswordHistoryMutation(c.config, OpCreate)
+	return &PasswordHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PasswordHistory entities.
+func (c *PasswordHistoryClient) CreateBulk(builders ...*PasswordHistoryCreate) *PasswordHistoryCreateBulk {
+	return &PasswordHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PasswordHistoryClient) MapCreateBulk(slice any, setFunc func(*PasswordHistoryCreate, int)) *PasswordHistoryCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PasswordHistoryCreateBulk{err: fmt.Errorf("calling to PasswordHistoryClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PasswordHistoryCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PasswordHistoryCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PasswordHistory.
+func (c *PasswordHistoryClient) Update() *PasswordHistoryUpdate {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdate)
+	return &PasswordHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PasswordHistoryClient) UpdateOne(ph *PasswordHistory) *PasswordHistoryUpdateOne {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdateOne, withPasswordHistory(ph))
+	return &PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PasswordHistoryClient) UpdateOneID(id int64) *PasswordHistoryUpdateOne {
+	mutation := newPasswordHistoryMutation(c.config, OpUpdateOne, withPasswordHistoryID(id))
+	return &PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PasswordHistory.
+func (c *PasswordHistoryClient) Delete() *PasswordHistoryDelete {
+	mutation := newPasswordHistoryMutation(c.config, OpDelete)
+	return &PasswordHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PasswordHistoryClient) DeleteOne(ph *PasswordHistory) *PasswordHistoryDeleteOne {
+	return c.DeleteOneID(ph.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PasswordHistoryClient) DeleteOneID(id int64) *PasswordHistoryDeleteOne {
+	builder := c.Delete().Where(passwordhistory.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PasswordHistoryDeleteOne{builder}
+}
+
+// Query returns a query builder for PasswordHistory.
+func (c *PasswordHistoryClient) Query() *PasswordHistoryQuery {
+	return &PasswordHistoryQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePasswordHistory},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PasswordHistory entity by its id.
+func (c *PasswordHistoryClient) Get(ctx context.Context, id int64) (*PasswordHistory, error) {
+	return c.Query().Where(passwordhistory.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PasswordHistoryClient) GetX(ctx context.Context, id int64) *PasswordHistory {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PasswordHistoryClient) Hooks() []Hook {
+	return c.hooks.PasswordHistory
+}
+
+// Interceptors returns the client interceptors.
+func (c *PasswordHistoryClient) Interceptors() []Interceptor {
+	return c.inters.PasswordHistory
+}
+
+func (c *PasswordHistoryClient) mutate(ctx context.Context, m *PasswordHistoryMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PasswordHistoryCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PasswordHistoryUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PasswordHistoryUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PasswordHistoryDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PasswordHistory mutation op: %q", m.Op())
+	}
+}
+
 // RoleClient is a client for the Role schema.
 type RoleClient struct {
 	config
@@ -709,6 +1027,405 @@ func (c *RoleClient) mutate(ctx context.Context, m *RoleMutation) (Value, error)
 	}
 }
 
+// SigningKeyClient is a client for the SigningKey schema.
+type SigningKeyClient struct {
+	config
+}
+
+// NewSigningKeyClient returns a client for the SigningKey from the given config.
+func NewSigningKeyClient(c config) *SigningKeyClient {
+	return &SigningKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `signingkey.Hooks(f(g(h())))`.
+func (c *SigningKeyClient) Use(hooks ...Hook) {
+	c.hooks.SigningKey = append(c.hooks.SigningKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `signingkey.Intercept(f(g(h())))`.
+func (c *SigningKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.SigningKey = append(c.inters.SigningKey, interceptors...)
+}
+
+// Create returns a builder for creating a SigningKey entity.
+func (c *SigningKeyClient) Create() *SigningKeyCreate {
+	mutation := newSigningKeyMutation(c.config, OpCreate)
+	return &SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of SigningKey entities.
+func (c *SigningKeyClient) CreateBulk(builders ...*SigningKeyCreate) *SigningKeyCreateBulk {
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SigningKeyClient) MapCreateBulk(slice any, setFunc func(*SigningKeyCreate, int)) *SigningKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SigningKeyCreateBulk{err: fmt.Errorf("calling to SigningKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SigningKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SigningKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for SigningKey.
+func (c *SigningKeyClient) Update() *SigningKeyUpdate {
+	mutation := newSigningKeyMutation(c.config, OpUpdate)
+	return &SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SigningKeyClient) UpdateOne(sk *SigningKey) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKey(sk))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SigningKeyClient) UpdateOneID(id int64) *SigningKeyUpdateOne {
+	mutation := newSigningKeyMutation(c.config, OpUpdateOne, withSigningKeyID(id))
+	return &SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for SigningKey.
+func (c *SigningKeyClient) Delete() *SigningKeyDelete {
+	mutation := newSigningKeyMutation(c.config, OpDelete)
+	return &SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SigningKeyClient) DeleteOne(sk *SigningKey) *SigningKeyDeleteOne {
+	return c.DeleteOneID(sk.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SigningKeyClient) DeleteOneID(id int64) *SigningKeyDeleteOne {
+	builder := c.Delete().Where(signingkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SigningKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for SigningKey.
+func (c *SigningKeyClient) Query() *SigningKeyQuery {
+	return &SigningKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSigningKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a SigningKey entity by its id.
+func (c *SigningKeyClient) Get(ctx context.Context, id int64) (*SigningKey, error) {
+	return c.Query().Where(signingkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SigningKeyClient) GetX(ctx context.Context, id int64) *SigningKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SigningKeyClient) Hooks() []Hook {
+	return c.hooks.SigningKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *SigningKeyClient) Interceptors() []Interceptor {
+	return c.inters.SigningKey
+}
+
+func (c *SigningKeyClient) mutate(ctx context.Context, m *SigningKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SigningKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SigningKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SigningKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SigningKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown SigningKey mutation op: %q", m.Op())
+	}
+}
+
+// TokenRevocationClient is a client for the TokenRevocation schema.
+type TokenRevocationClient struct {
+	config
+}
+
+// NewTokenRevocationClient returns a client for the TokenRevocation from the given config.
+func NewTokenRevocationClient(c config) *TokenRevocationClient {
+	return &TokenRevocationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `tokenrevocation.Hooks(f(g(h())))`.
+func (c *TokenRevocationClient) Use(hooks ...Hook) {
+	c.hooks.TokenRevocation = append(c.hooks.TokenRevocation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `tokenrevocation.Intercept(f(g(h())))`.
+func (c *TokenRevocationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TokenRevocation = append(c.inters.TokenRevocation, interceptors...)
+}
+
+// Create returns a builder for creating a TokenRevocation entity.
+func (c *TokenRevocationClient) Create() *TokenRevocationCreate {
+	mutation := newTokenRevocationMutation(c.config, OpCreate)
+	return &TokenRevocationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TokenRevocation entities.
+func (c *TokenRevocationClient) CreateBulk(builders ...*TokenRevocationCreate) *TokenRevocationCreateBulk {
+	return &TokenRevocationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenRevocationClient) MapCreateBulk(slice any, setFunc func(*TokenRevocationCreate, int)) *TokenRevocationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenRevocationCreateBulk{err: fmt.Errorf("calling to TokenRevocationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenRevocationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenRevocationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TokenRevocation.
+func (c *TokenRevocationClient) Update() *TokenRevocationUpdate {
+	mutation := newTokenRevocationMutation(c.config, OpUpdate)
+	return &TokenRevocationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TokenRevocationClient) UpdateOne(tr *TokenRevocation) *TokenRevocationUpdateOne {
+	mutation := newTokenRevocationMutation(c.config, OpUpdateOne, withTokenRevocation(tr))
+	return &TokenRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TokenRevocationClient) UpdateOneID(id int64) *TokenRevocationUpdateOne {
+	mutation := newTokenRevocationMutation(c.config, OpUpdateOne, withTokenRevocationID(id))
+	return &TokenRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TokenRevocation.
+func (c *TokenRevocationClient) Delete() *TokenRevocationDelete {
+	mutation := newTokenRevocationMutation(c.config, OpDelete)
+	return &TokenRevocationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TokenRevocationClient) DeleteOne(tr *TokenRevocation) *TokenRevocationDeleteOne {
+	return c.DeleteOneID(tr.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TokenRevocationClient) DeleteOneID(id int64) *TokenRevocationDeleteOne {
+	builder := c.Delete().Where(tokenrevocation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TokenRevocationDeleteOne{builder}
+}
+
+// Query returns a query builder for TokenRevocation.
+func (c *TokenRevocationClient) Query() *TokenRevocationQuery {
+	return &TokenRevocationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTokenRevocation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TokenRevocation entity by its id.
+func (c *TokenRevocationClient) Get(ctx context.Context, id int64) (*TokenRevocation, error) {
+	return c.Query().Where(tokenrevocation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TokenRevocationClient) GetX(ctx context.Context, id int64) *TokenRevocation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TokenRevocationClient) Hooks() []Hook {
+	return c.hooks.TokenRevocation
+}
+
+// Interceptors returns the client interceptors.
+func (c *TokenRevocationClient) Interceptors() []Interceptor {
+	return c.inters.TokenRevocation
+}
+
+func (c *TokenRevocationClient) mutate(ctx context.Context, m *TokenRevocationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TokenRevocationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenRevocationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenRevocationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenRevocationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TokenRevocation mutation op: %q", m.Op())
+	}
+}
+
+// TrustedDeviceClient is a client for the TrustedDevice schema.
+type TrustedDeviceClient struct {
+	config
+}
+
+// NewTrustedDeviceClient returns a client for the TrustedDevice from the given config.
+func NewTrustedDeviceClient(c config) *TrustedDeviceClient {
+	return &TrustedDeviceClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `trusteddevice.Hooks(f(g(h())))`.
+func (c *TrustedDeviceClient) Use(hooks ...Hook) {
+	c.hooks.TrustedDevice = append(c.hooks.TrustedDevice, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `trusteddevice.Intercept(f(g(h())))`.
+func (c *TrustedDeviceClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TrustedDevice = append(c.inters.TrustedDevice, interceptors...)
+}
+
+// Create returns a builder for creating a TrustedDevice entity.
+func (c *TrustedDeviceClient) Create() *TrustedDeviceCreate {
+	mutation := newTrustedDeviceMutation(c.config, OpCreate)
+	return &TrustedDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TrustedDevice entities.
+func (c *TrustedDeviceClient) CreateBulk(builders ...*TrustedDeviceCreate) *TrustedDeviceCreateBulk {
+	return &TrustedDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TrustedDeviceClient) MapCreateBulk(slice any, setFunc func(*TrustedDeviceCreate, int)) *TrustedDeviceCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TrustedDeviceCreateBulk{err: fmt.Errorf("calling to TrustedDeviceClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TrustedDeviceCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TrustedDeviceCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TrustedDevice.
+func (c *TrustedDeviceClient) Update() *TrustedDeviceUpdate {
+	mutation := newTrustedDeviceMutation(c.config, OpUpdate)
+	return &TrustedDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TrustedDeviceClient) UpdateOne(td *TrustedDevice) *TrustedDeviceUpdateOne {
+	mutation := newTrustedDeviceMutation(c.config, OpUpdateOne, withTrustedDevice(td))
+	return &TrustedDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TrustedDeviceClient) UpdateOneID(id int64) *TrustedDeviceUpdateOne {
+	mutation := newTrustedDeviceMutation(c.config, OpUpdateOne, withTrustedDeviceID(id))
+	return &TrustedDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TrustedDevice.
+func (c *TrustedDeviceClient) Delete() *TrustedDeviceDelete {
+	mutation := newTrustedDeviceMutation(c.config, OpDelete)
+	return &TrustedDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TrustedDeviceClient) DeleteOne(td *TrustedDevice) *TrustedDeviceDeleteOne {
+	return c.DeleteOneID(td.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TrustedDeviceClient) DeleteOneID(id int64) *TrustedDeviceDeleteOne {
+	builder := c.Delete().Where(trusteddevice.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TrustedDeviceDeleteOne{builder}
+}
+
+// Query returns a query builder for TrustedDevice.
+func (c *TrustedDeviceClient) Query() *TrustedDeviceQuery {
+	return &TrustedDeviceQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTrustedDevice},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TrustedDevice entity by its id.
+func (c *TrustedDeviceClient) Get(ctx context.Context, id int64) (*TrustedDevice, error) {
+	return c.Query().Where(trusteddevice.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TrustedDeviceClient) GetX(ctx context.Context, id int64) *TrustedDevice {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TrustedDeviceClient) Hooks() []Hook {
+	return c.hooks.TrustedDevice
+}
+
+// Interceptors returns the client interceptors.
+func (c *TrustedDeviceClient) Interceptors() []Interceptor {
+	return c.inters.TrustedDevice
+}
+
+func (c *TrustedDeviceClient) mutate(ctx context.Context, m *TrustedDeviceMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TrustedDeviceCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TrustedDeviceUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TrustedDeviceUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TrustedDeviceDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TrustedDevice mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -890,13 +1607,148 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 	}
 }
 
+// UserTotpClient is a client for the UserTotp schema.
+type UserTotpClient struct {
+	config
+}
+
+// NewUserTotpClient returns a client for the UserTotp from the given config.
+func NewUserTotpClient(c config) *UserTotpClient {
+	return &UserTotpClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `usertotp.Hooks(f(g(h())))`.
+func (c *UserTotpClient) Use(hooks ...Hook) {
+	c.hooks.UserTotp = append(c.hooks.UserTotp, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `usertotp.Intercept(f(g(h())))`.
+func (c *UserTotpClient) Intercept(interceptors ...Interceptor) {
+	c.inters.UserTotp = append(c.inters.UserTotp, interceptors...)
+}
+
+// Create returns a builder for creating a UserTotp entity.
+func (c *UserTotpClient) Create() *UserTotpCreate {
+	mutation := newUserTotpMutation(c.config, OpCreate)
+	return &UserTotpCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of UserTotp entities.
+func (c *UserTotpClient) CreateBulk(builders ...*UserTotpCreate) *UserTotpCreateBulk {
+	return &UserTotpCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *UserTotpClient) MapCreateBulk(slice any, setFunc func(*UserTotpCreate, int)) *UserTotpCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &UserTotpCreateBulk{err: fmt.Errorf("calling to UserTotpClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*UserTotpCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &UserTotpCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for UserTotp.
+func (c *UserTotpClient) Update() *UserTotpUpdate {
+	mutation := newUserTotpMutation(c.config, OpUpdate)
+	return &UserTotpUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *UserTotpClient) UpdateOne(ut *UserTotp) *UserTotpUpdateOne {
+	mutation := newUserTotpMutation(c.config, OpUpdateOne, withUserTotp(ut))
+	return &UserTotpUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *UserTotpClient) UpdateOneID(id int64) *UserTotpUpdateOne {
+	mutation := newUserTotpMutation(c.config, OpUpdateOne, withUserTotpID(id))
+	return &UserTotpUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for UserTotp.
+func (c *UserTotpClient) Delete() *UserTotpDelete {
+	mutation := newUserTotpMutation(c.config, OpDelete)
+	return &UserTotpDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *UserTotpClient) DeleteOne(ut *UserTotp) *UserTotpDeleteOne {
+	return c.DeleteOneID(ut.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *UserTotpClient) DeleteOneID(id int64) *UserTotpDeleteOne {
+	builder := c.Delete().Where(usertotp.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &UserTotpDeleteOne{builder}
+}
+
+// Query returns a query builder for UserTotp.
+func (c *UserTotpClient) Query() *UserTotpQuery {
+	return &UserTotpQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeUserTotp},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a UserTotp entity by its id.
+func (c *UserTotpClient) Get(ctx context.Context, id int64) (*UserTotp, error) {
+	return c.Query().Where(usertotp.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *UserTotpClient) GetX(ctx context.Context, id int64) *UserTotp {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *UserTotpClient) Hooks() []Hook {
+	return c.hooks.UserTotp
+}
+
+// Interceptors returns the client interceptors.
+func (c *UserTotpClient) Interceptors() []Interceptor {
+	return c.inters.UserTotp
+}
+
+func (c *UserTotpClient) mutate(ctx context.Context, m *UserTotpMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&UserTotpCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&UserTotpUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&UserTotpUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&UserTotpDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown UserTotp mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		AuthClient, LoginRecord, Role, User []ent.Hook
+		AuthClient, LoginRecord, OutboxEvent, PasswordHistory, Role, SigningKey,
+		TokenRevocation, TrustedDevice, User, UserTotp []ent.Hook
 	}
 	inters struct {
-		AuthClient, LoginRecord, Role, User []ent.Interceptor
+		AuthClient, LoginRecord, OutboxEvent, PasswordHistory, Role, SigningKey,
+		TokenRevocation, TrustedDevice, User, UserTotp []ent.Interceptor
 	}
 )
 
@@ -8,8 +8,14 @@ import (
 	"fmt"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/authclient"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/loginrecord"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/outboxevent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/passwordhistory"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/role"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/signingkey"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/user"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
 	"reflect"
 	"sync"
 
@@ -76,10 +82,16 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			authclient.Table:  authclient.ValidColumn,
-			loginrecord.Table: loginrecord.ValidColumn,
-			role.Table:        role.ValidColumn,
-			user.Table:        user.ValidColumn,
+			authclient.Table:      authclient.ValidColumn,
+			loginrecord.Table:     loginrecord.ValidColumn,
+			outboxevent.Table:     outboxevent.ValidColumn,
+			passwordhistory.Table: passwordhistory.ValidColumn,
+			role.Table:            role.ValidColumn,
+			signingkey.Table:      signingkey.ValidColumn,
+			tokenrevocation.Table: tokenrevocation.ValidColumn,
+			trusteddevice.Table:   trusteddevice.ValidColumn,
+			user.Table:            user.ValidColumn,
+			usertotp.Table:        usertotp.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
@@ -46,6 +46,12 @@ type LoginRecord struct {
 	IsSuccess bool `json:"is_success,omitempty"`
 	// ErrMessage holds the value of the "err_message" field.
 	ErrMessage string `json:"err_message,omitempty"`
+	// ErrCode holds the value of the "err_code" field.
+	ErrCode int `json:"err_code,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude float64 `json:"longitude,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the LoginRecordQuery when eager-loading is set.
 	Edges              LoginRecordEdges `json:"edges"`
@@ -80,7 +86,9 @@ func (*LoginRecord) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case loginrecord.FieldIsMobile, loginrecord.FieldIsSuccess:
 			values[i] = new(sql.NullBool)
-		case loginrecord.FieldID:
+		case loginrecord.FieldLatitude, loginrecord.FieldLongitude:
+			values[i] = new(sql.NullFloat64)
+		case loginrecord.FieldID, loginrecord.FieldErrCode:
 			values[i] = new(sql.NullInt64)
 		case loginrecord.FieldBrowser, loginrecord.FieldBrowserVer, loginrecord.FieldIP, loginrecord.FieldOs, loginrecord.FieldPlatform, loginrecord.FieldCountry, loginrecord.FieldCountryCode, loginrecord.FieldCity, loginrecord.FieldAsp, loginrecord.FieldErrMessage:
 			values[i] = new(sql.NullString)
@@ -193,6 +201,24 @@ func (lr *LoginRecord) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				lr.ErrMessage = value.String
 			}
+		case loginrecord.FieldErrCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field err_code", values[i])
+			} else if value.Valid {
+				lr.ErrCode = int(value.Int64)
+			}
+		case loginrecord.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				lr.Latitude = value.Float64
+			}
+		case loginrecord.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				lr.Longitude = value.Float64
+			}
 		case loginrecord.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field user_login_records", value)
@@ -282,6 +308,15 @@ func (lr *LoginRecord) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("err_message=")
 	builder.WriteString(lr.ErrMessage)
+	builder.WriteString(", ")
+	builder.WriteString("err_code=")
+	builder.WriteString(fmt.Sprintf("%v", lr.ErrCode))
+	builder.WriteString(", ")
+	builder.WriteString("latitude=")
+	builder.WriteString(fmt.Sprintf("%v", lr.Latitude))
+	builder.WriteString(", ")
+	builder.WriteString("longitude=")
+	builder.WriteString(fmt.Sprintf("%v", lr.Longitude))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -42,6 +42,12 @@ const (
 	FieldIsSuccess = "is_success"
 	// FieldErrMessage holds the string denoting the err_message field in the database.
 	FieldErrMessage = "err_message"
+	// FieldErrCode holds the string denoting the err_code field in the database.
+	FieldErrCode = "err_code"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
 	// EdgeUsers holds the string denoting the users edge name in mutations.
 	EdgeUsers = "users"
 	// Table holds the table name of the loginrecord in the database.
@@ -72,6 +78,9 @@ var Columns = []string{
 	FieldIsMobile,
 	FieldIsSuccess,
 	FieldErrMessage,
+	FieldErrCode,
+	FieldLatitude,
+	FieldLongitude,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "login_records"
@@ -102,6 +111,12 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultErrCode holds the default value on creation for the "err_code" field.
+	DefaultErrCode int
+	// DefaultLatitude holds the default value on creation for the "latitude" field.
+	DefaultLatitude float64
+	// DefaultLongitude holds the default value on creation for the "longitude" field.
+	DefaultLongitude float64
 )
 
 // OrderOption defines the ordering options for the LoginRecord queries.
@@ -182,6 +197,21 @@ func ByErrMessage(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldErrMessage, opts...).ToFunc()
 }
 
+// ByErrCode orders the results by the err_code field.
+func ByErrCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrCode, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
 // ByUsersField orders the results by users field.
 func ByUsersField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
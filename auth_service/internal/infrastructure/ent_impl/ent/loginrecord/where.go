@@ -125,6 +125,21 @@ func ErrMessage(v string) predicate.LoginRecord {
 	return predicate.LoginRecord(sql.FieldEQ(FieldErrMessage, v))
 }
 
+// ErrCode applies equality check predicate on the "err_code" field. It's identical to ErrCodeEQ.
+func ErrCode(v int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldEQ(FieldErrCode, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldEQ(FieldLongitude, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.LoginRecord {
 	return predicate.LoginRecord(sql.FieldEQ(FieldCreatedAt, v))
@@ -875,6 +890,126 @@ func ErrMessageContainsFold(v string) predicate.LoginRecord {
 	return predicate.LoginRecord(sql.FieldContainsFold(FieldErrMessage, v))
 }
 
+// ErrCodeEQ applies the EQ predicate on the "err_code" field.
+func ErrCodeEQ(v int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldEQ(FieldErrCode, v))
+}
+
+// ErrCodeNEQ applies the NEQ predicate on the "err_code" field.
+func ErrCodeNEQ(v int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldNEQ(FieldErrCode, v))
+}
+
+// ErrCodeIn applies the In predicate on the "err_code" field.
+func ErrCodeIn(vs ...int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldIn(FieldErrCode, vs...))
+}
+
+// ErrCodeNotIn applies the NotIn predicate on the "err_code" field.
+func ErrCodeNotIn(vs ...int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldNotIn(FieldErrCode, vs...))
+}
+
+// ErrCodeGT applies the GT predicate on the "err_code" field.
+func ErrCodeGT(v int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldGT(FieldErrCode, v))
+}
+
+// ErrCodeGTE applies the GTE predicate on the "err_code" field.
+func ErrCodeGTE(v int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldGTE(FieldErrCode, v))
+}
+
+// ErrCodeLT applies the LT predicate on the "err_code" field.
+func ErrCodeLT(v int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldLT(FieldErrCode, v))
+}
+
+// ErrCodeLTE applies the LTE predicate on the "err_code" field.
+func ErrCodeLTE(v int) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldLTE(FieldErrCode, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.LoginRecord {
+	return predicate.LoginRecord(sql.FieldLTE(FieldLongitude, v))
+}
+
 // HasUsers applies the HasEdge predicate on the "users" edge.
 func HasUsers() predicate.LoginRecord {
 	return predicate.LoginRecord(func(s *sql.Selector) {
@@ -123,6 +123,48 @@ func (lrc *LoginRecordCreate) SetErrMessage(s string) *LoginRecordCreate {
 	return lrc
 }
 
+// SetErrCode sets the "err_code" field.
+func (lrc *LoginRecordCreate) SetErrCode(i int) *LoginRecordCreate {
+	lrc.mutation.SetErrCode(i)
+	return lrc
+}
+
+// SetNillableErrCode sets the "err_code" field if the given value is not nil.
+func (lrc *LoginRecordCreate) SetNillableErrCode(i *int) *LoginRecordCreate {
+	if i != nil {
+		lrc.SetErrCode(*i)
+	}
+	return lrc
+}
+
+// SetLatitude sets the "latitude" field.
+func (lrc *LoginRecordCreate) SetLatitude(f float64) *LoginRecordCreate {
+	lrc.mutation.SetLatitude(f)
+	return lrc
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (lrc *LoginRecordCreate) SetNillableLatitude(f *float64) *LoginRecordCreate {
+	if f != nil {
+		lrc.SetLatitude(*f)
+	}
+	return lrc
+}
+
+// SetLongitude sets the "longitude" field.
+func (lrc *LoginRecordCreate) SetLongitude(f float64) *LoginRecordCreate {
+	lrc.mutation.SetLongitude(f)
+	return lrc
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (lrc *LoginRecordCreate) SetNillableLongitude(f *float64) *LoginRecordCreate {
+	if f != nil {
+		lrc.SetLongitude(*f)
+	}
+	return lrc
+}
+
 // SetID sets the "id" field.
 func (lrc *LoginRecordCreate) SetID(i int64) *LoginRecordCreate {
 	lrc.mutation.SetID(i)
@@ -191,6 +233,18 @@ func (lrc *LoginRecordCreate) defaults() {
 		v := loginrecord.DefaultUpdatedAt()
 		lrc.mutation.SetUpdatedAt(v)
 	}
+	if _, ok := lrc.mutation.ErrCode(); !ok {
+		v := loginrecord.DefaultErrCode
+		lrc.mutation.SetErrCode(v)
+	}
+	if _, ok := lrc.mutation.Latitude(); !ok {
+		v := loginrecord.DefaultLatitude
+		lrc.mutation.SetLatitude(v)
+	}
+	if _, ok := lrc.mutation.Longitude(); !ok {
+		v := loginrecord.DefaultLongitude
+		lrc.mutation.SetLongitude(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -237,6 +291,15 @@ func (lrc *LoginRecordCreate) check() error {
 	if _, ok := lrc.mutation.ErrMessage(); !ok {
 		return &ValidationError{Name: "err_message", err: errors.New(`ent: missing required field "LoginRecord.err_message"`)}
 	}
+	if _, ok := lrc.mutation.ErrCode(); !ok {
+		return &ValidationError{Name: "err_code", err: errors.New(`ent: missing required field "LoginRecord.err_code"`)}
+	}
+	if _, ok := lrc.mutation.Latitude(); !ok {
+		return &ValidationError{Name: "latitude", err: errors.New(`ent: missing required field "LoginRecord.latitude"`)}
+	}
+	if _, ok := lrc.mutation.Longitude(); !ok {
+		return &ValidationError{Name: "longitude", err: errors.New(`ent: missing required field "LoginRecord.longitude"`)}
+	}
 	return nil
 }
 
@@ -326,6 +389,18 @@ func (lrc *LoginRecordCreate) createSpec() (*LoginRecord, *sqlgraph.CreateSpec)
 		_spec.SetField(loginrecord.FieldErrMessage, field.TypeString, value)
 		_node.ErrMessage = value
 	}
+	if value, ok := lrc.mutation.ErrCode(); ok {
+		_spec.SetField(loginrecord.FieldErrCode, field.TypeInt, value)
+		_node.ErrCode = value
+	}
+	if value, ok := lrc.mutation.Latitude(); ok {
+		_spec.SetField(loginrecord.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = value
+	}
+	if value, ok := lrc.mutation.Longitude(); ok {
+		_spec.SetField(loginrecord.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = value
+	}
 	if nodes := lrc.mutation.UsersIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -551,6 +626,60 @@ func (u *LoginRecordUpsert) UpdateErrMessage() *LoginRecordUpsert {
 	return u
 }
 
+// SetErrCode sets the "err_code" field.
+func (u *LoginRecordUpsert) SetErrCode(v int) *LoginRecordUpsert {
+	u.Set(loginrecord.FieldErrCode, v)
+	return u
+}
+
+// UpdateErrCode sets the "err_code" field to the value that was provided on create.
+func (u *LoginRecordUpsert) UpdateErrCode() *LoginRecordUpsert {
+	u.SetExcluded(loginrecord.FieldErrCode)
+	return u
+}
+
+// AddErrCode adds v to the "err_code" field.
+func (u *LoginRecordUpsert) AddErrCode(v int) *LoginRecordUpsert {
+	u.Add(loginrecord.FieldErrCode, v)
+	return u
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *LoginRecordUpsert) SetLatitude(v float64) *LoginRecordUpsert {
+	u.Set(loginrecord.FieldLatitude, v)
+	return u
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *LoginRecordUpsert) UpdateLatitude() *LoginRecordUpsert {
+	u.SetExcluded(loginrecord.FieldLatitude)
+	return u
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *LoginRecordUpsert) AddLatitude(v float64) *LoginRecordUpsert {
+	u.Add(loginrecord.FieldLatitude, v)
+	return u
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *LoginRecordUpsert) SetLongitude(v float64) *LoginRecordUpsert {
+	u.Set(loginrecord.FieldLongitude, v)
+	return u
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *LoginRecordUpsert) UpdateLongitude() *LoginRecordUpsert {
+	u.SetExcluded(loginrecord.FieldLongitude)
+	return u
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *LoginRecordUpsert) AddLongitude(v float64) *LoginRecordUpsert {
+	u.Add(loginrecord.FieldLongitude, v)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -784,6 +913,69 @@ func (u *LoginRecordUpsertOne) UpdateErrMessage() *LoginRecordUpsertOne {
 	})
 }
 
+// SetErrCode sets the "err_code" field.
+func (u *LoginRecordUpsertOne) SetErrCode(v int) *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.SetErrCode(v)
+	})
+}
+
+// AddErrCode adds v to the "err_code" field.
+func (u *LoginRecordUpsertOne) AddErrCode(v int) *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.AddErrCode(v)
+	})
+}
+
+// UpdateErrCode sets the "err_code" field to the value that was provided on create.
+func (u *LoginRecordUpsertOne) UpdateErrCode() *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.UpdateErrCode()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *LoginRecordUpsertOne) SetLatitude(v float64) *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *LoginRecordUpsertOne) AddLatitude(v float64) *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.AddLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *LoginRecordUpsertOne) UpdateLatitude() *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *LoginRecordUpsertOne) SetLongitude(v float64) *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *LoginRecordUpsertOne) AddLongitude(v float64) *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.AddLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *LoginRecordUpsertOne) UpdateLongitude() *LoginRecordUpsertOne {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
 // Exec executes the query.
 func (u *LoginRecordUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -1183,6 +1375,69 @@ func (u *LoginRecordUpsertBulk) UpdateErrMessage() *LoginRecordUpsertBulk {
 	})
 }
 
+// SetErrCode sets the "err_code" field.
+func (u *LoginRecordUpsertBulk) SetErrCode(v int) *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.SetErrCode(v)
+	})
+}
+
+// AddErrCode adds v to the "err_code" field.
+func (u *LoginRecordUpsertBulk) AddErrCode(v int) *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.AddErrCode(v)
+	})
+}
+
+// UpdateErrCode sets the "err_code" field to the value that was provided on create.
+func (u *LoginRecordUpsertBulk) UpdateErrCode() *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.UpdateErrCode()
+	})
+}
+
+// SetLatitude sets the "latitude" field.
+func (u *LoginRecordUpsertBulk) SetLatitude(v float64) *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.SetLatitude(v)
+	})
+}
+
+// AddLatitude adds v to the "latitude" field.
+func (u *LoginRecordUpsertBulk) AddLatitude(v float64) *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.AddLatitude(v)
+	})
+}
+
+// UpdateLatitude sets the "latitude" field to the value that was provided on create.
+func (u *LoginRecordUpsertBulk) UpdateLatitude() *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.UpdateLatitude()
+	})
+}
+
+// SetLongitude sets the "longitude" field.
+func (u *LoginRecordUpsertBulk) SetLongitude(v float64) *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.SetLongitude(v)
+	})
+}
+
+// AddLongitude adds v to the "longitude" field.
+func (u *LoginRecordUpsertBulk) AddLongitude(v float64) *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.AddLongitude(v)
+	})
+}
+
+// UpdateLongitude sets the "longitude" field to the value that was provided on create.
+func (u *LoginRecordUpsertBulk) UpdateLongitude() *LoginRecordUpsertBulk {
+	return u.Update(func(s *LoginRecordUpsert) {
+		s.UpdateLongitude()
+	})
+}
+
 // Exec executes the query.
 func (u *LoginRecordUpsertBulk) Exec(ctx context.Context) error {
 	if u.create.err != nil {
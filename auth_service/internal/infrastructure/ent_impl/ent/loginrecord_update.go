@@ -203,6 +203,69 @@ func (lru *LoginRecordUpdate) SetNillableErrMessage(s *string) *LoginRecordUpdat
 	return lru
 }
 
+// SetErrCode sets the "err_code" field.
+func (lru *LoginRecordUpdate) SetErrCode(i int) *LoginRecordUpdate {
+	lru.mutation.ResetErrCode()
+	lru.mutation.SetErrCode(i)
+	return lru
+}
+
+// SetNillableErrCode sets the "err_code" field if the given value is not nil.
+func (lru *LoginRecordUpdate) SetNillableErrCode(i *int) *LoginRecordUpdate {
+	if i != nil {
+		lru.SetErrCode(*i)
+	}
+	return lru
+}
+
+// AddErrCode adds i to the "err_code" field.
+func (lru *LoginRecordUpdate) AddErrCode(i int) *LoginRecordUpdate {
+	lru.mutation.AddErrCode(i)
+	return lru
+}
+
+// SetLatitude sets the "latitude" field.
+func (lru *LoginRecordUpdate) SetLatitude(f float64) *LoginRecordUpdate {
+	lru.mutation.ResetLatitude()
+	lru.mutation.SetLatitude(f)
+	return lru
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (lru *LoginRecordUpdate) SetNillableLatitude(f *float64) *LoginRecordUpdate {
+	if f != nil {
+		lru.SetLatitude(*f)
+	}
+	return lru
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (lru *LoginRecordUpdate) AddLatitude(f float64) *LoginRecordUpdate {
+	lru.mutation.AddLatitude(f)
+	return lru
+}
+
+// SetLongitude sets the "longitude" field.
+func (lru *LoginRecordUpdate) SetLongitude(f float64) *LoginRecordUpdate {
+	lru.mutation.ResetLongitude()
+	lru.mutation.SetLongitude(f)
+	return lru
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (lru *LoginRecordUpdate) SetNillableLongitude(f *float64) *LoginRecordUpdate {
+	if f != nil {
+		lru.SetLongitude(*f)
+	}
+	return lru
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (lru *LoginRecordUpdate) AddLongitude(f float64) *LoginRecordUpdate {
+	lru.mutation.AddLongitude(f)
+	return lru
+}
+
 // SetUsersID sets the "users" edge to the User entity by ID.
 func (lru *LoginRecordUpdate) SetUsersID(id int64) *LoginRecordUpdate {
 	lru.mutation.SetUsersID(id)
@@ -317,6 +380,24 @@ func (lru *LoginRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := lru.mutation.ErrMessage(); ok {
 		_spec.SetField(loginrecord.FieldErrMessage, field.TypeString, value)
 	}
+	if value, ok := lru.mutation.ErrCode(); ok {
+		_spec.SetField(loginrecord.FieldErrCode, field.TypeInt, value)
+	}
+	if value, ok := lru.mutation.AddedErrCode(); ok {
+		_spec.AddField(loginrecord.FieldErrCode, field.TypeInt, value)
+	}
+	if value, ok := lru.mutation.Latitude(); ok {
+		_spec.SetField(loginrecord.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := lru.mutation.AddedLatitude(); ok {
+		_spec.AddField(loginrecord.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := lru.mutation.Longitude(); ok {
+		_spec.SetField(loginrecord.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := lru.mutation.AddedLongitude(); ok {
+		_spec.AddField(loginrecord.FieldLongitude, field.TypeFloat64, value)
+	}
 	if lru.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -540,6 +621,69 @@ func (lruo *LoginRecordUpdateOne) SetNillableErrMessage(s *string) *LoginRecordU
 	return lruo
 }
 
+// SetErrCode sets the "err_code" field.
+func (lruo *LoginRecordUpdateOne) SetErrCode(i int) *LoginRecordUpdateOne {
+	lruo.mutation.ResetErrCode()
+	lruo.mutation.SetErrCode(i)
+	return lruo
+}
+
+// SetNillableErrCode sets the "err_code" field if the given value is not nil.
+func (lruo *LoginRecordUpdateOne) SetNillableErrCode(i *int) *LoginRecordUpdateOne {
+	if i != nil {
+		lruo.SetErrCode(*i)
+	}
+	return lruo
+}
+
+// AddErrCode adds i to the "err_code" field.
+func (lruo *LoginRecordUpdateOne) AddErrCode(i int) *LoginRecordUpdateOne {
+	lruo.mutation.AddErrCode(i)
+	return lruo
+}
+
+// SetLatitude sets the "latitude" field.
+func (lruo *LoginRecordUpdateOne) SetLatitude(f float64) *LoginRecordUpdateOne {
+	lruo.mutation.ResetLatitude()
+	lruo.mutation.SetLatitude(f)
+	return lruo
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (lruo *LoginRecordUpdateOne) SetNillableLatitude(f *float64) *LoginRecordUpdateOne {
+	if f != nil {
+		lruo.SetLatitude(*f)
+	}
+	return lruo
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (lruo *LoginRecordUpdateOne) AddLatitude(f float64) *LoginRecordUpdateOne {
+	lruo.mutation.AddLatitude(f)
+	return lruo
+}
+
+// SetLongitude sets the "longitude" field.
+func (lruo *LoginRecordUpdateOne) SetLongitude(f float64) *LoginRecordUpdateOne {
+	lruo.mutation.ResetLongitude()
+	lruo.mutation.SetLongitude(f)
+	return lruo
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (lruo *LoginRecordUpdateOne) SetNillableLongitude(f *float64) *LoginRecordUpdateOne {
+	if f != nil {
+		lruo.SetLongitude(*f)
+	}
+	return lruo
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (lruo *LoginRecordUpdateOne) AddLongitude(f float64) *LoginRecordUpdateOne {
+	lruo.mutation.AddLongitude(f)
+	return lruo
+}
+
 // SetUsersID sets the "users" edge to the User entity by ID.
 func (lruo *LoginRecordUpdateOne) SetUsersID(id int64) *LoginRecordUpdateOne {
 	lruo.mutation.SetUsersID(id)
@@ -684,6 +828,24 @@ func (lruo *LoginRecordUpdateOne) sqlSave(ctx context.Context) (_node *LoginReco
 	if value, ok := lruo.mutation.ErrMessage(); ok {
 		_spec.SetField(loginrecord.FieldErrMessage, field.TypeString, value)
 	}
+	if value, ok := lruo.mutation.ErrCode(); ok {
+		_spec.SetField(loginrecord.FieldErrCode, field.TypeInt, value)
+	}
+	if value, ok := lruo.mutation.AddedErrCode(); ok {
+		_spec.AddField(loginrecord.FieldErrCode, field.TypeInt, value)
+	}
+	if value, ok := lruo.mutation.Latitude(); ok {
+		_spec.SetField(loginrecord.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := lruo.mutation.AddedLatitude(); ok {
+		_spec.AddField(loginrecord.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := lruo.mutation.Longitude(); ok {
+		_spec.SetField(loginrecord.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := lruo.mutation.AddedLongitude(); ok {
+		_spec.AddField(loginrecord.FieldLongitude, field.TypeFloat64, value)
+	}
 	if lruo.mutation.UsersCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -19,6 +20,20 @@ var (
 		{Name: "active", Type: field.TypeBool},
 		{Name: "token_expire_secs", Type: field.TypeInt},
 		{Name: "login_failed_times", Type: field.TypeInt},
+		{Name: "refresh_token_expire_secs", Type: field.TypeInt, Default: 1209600},
+		{Name: "session_policy", Type: field.TypeInt, Default: 1},
+		{Name: "max_sessions", Type: field.TypeInt, Default: 1},
+		{Name: "signing_method", Type: field.TypeInt, Default: 1},
+		{Name: "mfa_required", Type: field.TypeBool, Default: false},
+		{Name: "password_min_length", Type: field.TypeInt, Default: 0},
+		{Name: "password_char_classes", Type: field.TypeJSON, Nullable: true},
+		{Name: "password_history_count", Type: field.TypeInt, Default: 0},
+		{Name: "password_max_age_secs", Type: field.TypeInt, Default: 0},
+		{Name: "banned_passwords", Type: field.TypeJSON, Nullable: true},
+		{Name: "lockout_secs", Type: field.TypeInt, Default: 0},
+		{Name: "max_lockout_secs", Type: field.TypeInt, Default: 0},
+		{Name: "risk_challenge_score", Type: field.TypeInt, Default: 0},
+		{Name: "risk_deny_score", Type: field.TypeInt, Default: 0},
 	}
 	// AuthClientsTable holds the schema information for the "auth_clients" table.
 	AuthClientsTable = &schema.Table{
@@ -43,6 +58,9 @@ var (
 		{Name: "is_mobile", Type: field.TypeBool},
 		{Name: "is_success", Type: field.TypeBool},
 		{Name: "err_message", Type: field.TypeString},
+		{Name: "err_code", Type: field.TypeInt, Default: 0},
+		{Name: "latitude", Type: field.TypeFloat64, Default: 0},
+		{Name: "longitude", Type: field.TypeFloat64, Default: 0},
 		{Name: "user_login_records", Type: field.TypeInt64, Nullable: true},
 	}
 	// LoginRecordsTable holds the schema information for the "login_records" table.
@@ -53,12 +71,75 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "login_records_users_login_records",
-				Columns:    []*schema.Column{LoginRecordsColumns[15]},
+				Columns:    []*schema.Column{LoginRecordsColumns[18]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeString, Unique: true, Comment: "The consumers dedupe the events by it"},
+		{Name: "aggregate_type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString, Comment: "The routing key of the event"},
+		{Name: "event_version", Type: field.TypeInt, Comment: "The version of the payload schema of the event type"},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Comment: "The failed publishing attempts", Default: 0},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true, Comment: "Claimed by a relay or waiting for the retry until then"},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "parked_at", Type: field.TypeTime, Nullable: true, Comment: "Not retried after the max attempts, the later events of the aggregate wait until it's resolved"},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Comment:    "The domain events written in the transactions of the changes, published by the outbox relay",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[0]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "published_at IS NULL",
+				},
+			},
+			{
+				Name:    "outboxevent_aggregate_type_aggregate_id_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[4], OutboxEventsColumns[5], OutboxEventsColumns[0]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "published_at IS NULL",
+				},
+			},
+		},
+	}
+	// PasswordHistoriesColumns holds the columns for the "password_histories" table.
+	PasswordHistoriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "password", Type: field.TypeString},
+	}
+	// PasswordHistoriesTable holds the schema information for the "password_histories" table.
+	PasswordHistoriesTable = &schema.Table{
+		Name:       "password_histories",
+		Columns:    PasswordHistoriesColumns,
+		PrimaryKey: []*schema.Column{PasswordHistoriesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "passwordhistory_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{PasswordHistoriesColumns[3]},
+			},
+		},
+	}
 	// RolesColumns holds the columns for the "roles" table.
 	RolesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -75,6 +156,93 @@ var (
 		Columns:    RolesColumns,
 		PrimaryKey: []*schema.Column{RolesColumns[0]},
 	}
+	// SigningKeysColumns holds the columns for the "signing_keys" table.
+	SigningKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "kid", Type: field.TypeString, Unique: true},
+		{Name: "signing_method", Type: field.TypeInt},
+		{Name: "private_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "public_key", Type: field.TypeString, Size: 2147483647},
+		{Name: "active", Type: field.TypeBool},
+		{Name: "rotated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "expire_at", Type: field.TypeTime, Nullable: true},
+	}
+	// SigningKeysTable holds the schema information for the "signing_keys" table.
+	SigningKeysTable = &schema.Table{
+		Name:       "signing_keys",
+		Columns:    SigningKeysColumns,
+		PrimaryKey: []*schema.Column{SigningKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "signingkey_signing_method_active",
+				Unique:  false,
+				Columns: []*schema.Column{SigningKeysColumns[4], SigningKeysColumns[7]},
+			},
+			{
+				Name:    "signingkey_signing_method",
+				Unique:  true,
+				Columns: []*schema.Column{SigningKeysColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "active",
+				},
+			},
+		},
+	}
+	// TokenRevocationsColumns holds the columns for the "token_revocations" table.
+	TokenRevocationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "client_id", Type: field.TypeInt64},
+		{Name: "user_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "scope", Type: field.TypeString},
+		{Name: "reason", Type: field.TypeString, Default: ""},
+		{Name: "operator_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "revoked_count", Type: field.TypeInt},
+	}
+	// TokenRevocationsTable holds the schema information for the "token_revocations" table.
+	TokenRevocationsTable = &schema.Table{
+		Name:       "token_revocations",
+		Columns:    TokenRevocationsColumns,
+		PrimaryKey: []*schema.Column{TokenRevocationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tokenrevocation_client_id",
+				Unique:  false,
+				Columns: []*schema.Column{TokenRevocationsColumns[3]},
+			},
+			{
+				Name:    "tokenrevocation_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TokenRevocationsColumns[4]},
+			},
+		},
+	}
+	// TrustedDevicesColumns holds the columns for the "trusted_devices" table.
+	TrustedDevicesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "browser", Type: field.TypeString},
+		{Name: "city", Type: field.TypeString},
+		{Name: "country_code", Type: field.TypeString},
+	}
+	// TrustedDevicesTable holds the schema information for the "trusted_devices" table.
+	TrustedDevicesTable = &schema.Table{
+		Name:       "trusted_devices",
+		Columns:    TrustedDevicesColumns,
+		PrimaryKey: []*schema.Column{TrustedDevicesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trusteddevice_user_id_browser_city",
+				Unique:  true,
+				Columns: []*schema.Column{TrustedDevicesColumns[3], TrustedDevicesColumns[4], TrustedDevicesColumns[5]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
@@ -84,6 +252,9 @@ var (
 		{Name: "password", Type: field.TypeString},
 		{Name: "password_fail_times", Type: field.TypeInt},
 		{Name: "status", Type: field.TypeInt},
+		{Name: "password_changed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true},
+		{Name: "lock_count", Type: field.TypeInt, Default: 0},
 		{Name: "auth_client_users", Type: field.TypeInt64, Nullable: true},
 		{Name: "user_roles", Type: field.TypeInt64, Nullable: true},
 	}
@@ -95,18 +266,35 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "users_auth_clients_users",
-				Columns:    []*schema.Column{UsersColumns[7]},
+				Columns:    []*schema.Column{UsersColumns[10]},
 				RefColumns: []*schema.Column{AuthClientsColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "users_roles_roles",
-				Columns:    []*schema.Column{UsersColumns[8]},
+				Columns:    []*schema.Column{UsersColumns[11]},
 				RefColumns: []*schema.Column{RolesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// UserTotpsColumns holds the columns for the "user_totps" table.
+	UserTotpsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64, Unique: true},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "enabled", Type: field.TypeBool, Default: false},
+		{Name: "recovery_codes", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled_at", Type: field.TypeTime, Nullable: true},
+	}
+	// UserTotpsTable holds the schema information for the "user_totps" table.
+	UserTotpsTable = &schema.Table{
+		Name:       "user_totps",
+		Columns:    UserTotpsColumns,
+		PrimaryKey: []*schema.Column{UserTotpsColumns[0]},
+	}
 	// AuthClientRolesColumns holds the columns for the "auth_client_roles" table.
 	AuthClientRolesColumns = []*schema.Column{
 		{Name: "auth_client_id", Type: field.TypeInt64},
@@ -136,14 +324,21 @@ var (
 	Tables = []*schema.Table{
 		AuthClientsTable,
 		LoginRecordsTable,
+		OutboxEventsTable,
+		PasswordHistoriesTable,
 		RolesTable,
+		SigningKeysTable,
+		TokenRevocationsTable,
+		TrustedDevicesTable,
 		UsersTable,
+		UserTotpsTable,
 		AuthClientRolesTable,
 	}
 )
 
 func init() {
 	LoginRecordsTable.ForeignKeys[0].RefTable = UsersTable
+	OutboxEventsTable.Annotation = &entsql.Annotation{}
 	UsersTable.ForeignKeys[0].RefTable = AuthClientsTable
 	UsersTable.ForeignKeys[1].RefTable = RolesTable
 	AuthClientRolesTable.ForeignKeys[0].RefTable = AuthClientsTable
@@ -8,9 +8,15 @@ import (
 	"fmt"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/authclient"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/loginrecord"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/outboxevent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/passwordhistory"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/role"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/signingkey"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/user"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
 	"go_micro_service_api/pkg/enum"
 	"sync"
 	"time"
@@ -28,40 +34,73 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAuthClient  = "AuthClient"
-	TypeLoginRecord = "LoginRecord"
-	TypeRole        = "Role"
-	TypeUser        = "User"
+	TypeAuthClient      = "AuthClient"
+	TypeLoginRecord     = "LoginRecord"
+	TypeOutboxEvent     = "OutboxEvent"
+	TypePasswordHistory = "PasswordHistory"
+	TypeRole            = "Role"
+	TypeSigningKey      = "SigningKey"
+	TypeTokenRevocation = "TokenRevocation"
+	TypeTrustedDevice   = "TrustedDevice"
+	TypeUser            = "User"
+	TypeUserTotp        = "UserTotp"
 )
 
 // AuthClientMutation represents an operation that mutates the AuthClient nodes in the graph.
 type AuthClientMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *int64
-	created_at            *time.Time
-	updated_at            *time.Time
-	client_type           *int
-	addclient_type        *int
-	merchant_id           *int64
-	addmerchant_id        *int64
-	secret                *string
-	active                *bool
-	token_expire_secs     *int
-	addtoken_expire_secs  *int
-	login_failed_times    *int
-	addlogin_failed_times *int
-	clearedFields         map[string]struct{}
-	users                 map[int64]struct{}
-	removedusers          map[int64]struct{}
-	clearedusers          bool
-	roles                 map[int64]struct{}
-	removedroles          map[int64]struct{}
-	clearedroles          bool
-	done                  bool
-	oldValue              func(context.Context) (*AuthClient, error)
-	predicates            []predicate.AuthClient
+	op                           Op
+	typ                          string
+	id                           *int64
+	created_at                   *time.Time
+	updated_at                   *time.Time
+	client_type                  *int
+	addclient_type               *int
+	merchant_id                  *int64
+	addmerchant_id               *int64
+	secret                       *string
+	active                       *bool
+	token_expire_secs            *int
+	addtoken_expire_secs         *int
+	login_failed_times           *int
+	addlogin_failed_times        *int
+	refresh_token_expire_secs    *int
+	addrefresh_token_expire_secs *int
+	session_policy               *int
+	addsession_policy            *int
+	max_sessions                 *int
+	addmax_sessions              *int
+	signing_method               *int
+	addsigning_method            *int
+	mfa_required                 *bool
+	password_min_length          *int
+	addpassword_min_length       *int
+	password_char_classes        *[]enum.PasswordCharClass
+	appendpassword_char_classes  []enum.PasswordCharClass
+	password_history_count       *int
+	addpassword_history_count    *int
+	password_max_age_secs        *int
+	addpassword_max_age_secs     *int
+	banned_passwords             *[]string
+	appendbanned_passwords       []string
+	lockout_secs                 *int
+	addlockout_secs              *int
+	max_lockout_secs             *int
+	addmax_lockout_secs          *int
+	risk_challenge_score         *int
+	addrisk_challenge_score      *int
+	risk_deny_score              *int
+	addrisk_deny_score           *int
+	clearedFields                map[string]struct{}
+	users                        map[int64]struct{}
+	removedusers                 map[int64]struct{}
+	clearedusers                 bool
+	roles                        map[int64]struct{}
+	removedroles                 map[int64]struct{}
+	clearedroles                 bool
+	done                         bool
+	oldValue                     func(context.Context) (*AuthClient, error)
+	predicates                   []predicate.AuthClient
 }
 
 var _ ent.Mutation = (*AuthClientMutation)(nil)
@@ -536,306 +575,6213 @@ func (m *AuthClientMutation) ResetLoginFailedTimes() {
 	m.addlogin_failed_times = nil
 }
 
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *AuthClientMutation) AddUserIDs(ids ...int64) {
-	if m.users == nil {
-		m.users = make(map[int64]struct{})
+// SetRefreshTokenExpireSecs sets the "refresh_token_expire_secs" field.
+func (m *AuthClientMutation) SetRefreshTokenExpireSecs(i int) {
+	m.refresh_token_expire_secs = &i
+	m.addrefresh_token_expire_secs = nil
+}
+
+// RefreshTokenExpireSecs returns the value of the "refresh_token_expire_secs" field in the mutation.
+func (m *AuthClientMutation) RefreshTokenExpireSecs() (r int, exists bool) {
+	v := m.refresh_token_expire_secs
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldRefreshTokenExpireSecs returns the old "refresh_token_expire_secs" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldRefreshTokenExpireSecs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefreshTokenExpireSecs is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefreshTokenExpireSecs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefreshTokenExpireSecs: %w", err)
+	}
+	return oldValue.RefreshTokenExpireSecs, nil
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *AuthClientMutation) ClearUsers() {
-	m.clearedusers = true
+// AddRefreshTokenExpireSecs adds i to the "refresh_token_expire_secs" field.
+func (m *AuthClientMutation) AddRefreshTokenExpireSecs(i int) {
+	if m.addrefresh_token_expire_secs != nil {
+		*m.addrefresh_token_expire_secs += i
+	} else {
+		m.addrefresh_token_expire_secs = &i
+	}
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *AuthClientMutation) UsersCleared() bool {
-	return m.clearedusers
+// AddedRefreshTokenExpireSecs returns the value that was added to the "refresh_token_expire_secs" field in this mutation.
+func (m *AuthClientMutation) AddedRefreshTokenExpireSecs() (r int, exists bool) {
+	v := m.addrefresh_token_expire_secs
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *AuthClientMutation) RemoveUserIDs(ids ...int64) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int64]struct{})
+// ResetRefreshTokenExpireSecs resets all changes to the "refresh_token_expire_secs" field.
+func (m *AuthClientMutation) ResetRefreshTokenExpireSecs() {
+	m.refresh_token_expire_secs = nil
+	m.addrefresh_token_expire_secs = nil
+}
+
+// SetSessionPolicy sets the "session_policy" field.
+func (m *AuthClientMutation) SetSessionPolicy(i int) {
+	m.session_policy = &i
+	m.addsession_policy = nil
+}
+
+// SessionPolicy returns the value of the "session_policy" field in the mutation.
+func (m *AuthClientMutation) SessionPolicy() (r int, exists bool) {
+	v := m.session_policy
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldSessionPolicy returns the old "session_policy" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldSessionPolicy(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionPolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionPolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionPolicy: %w", err)
 	}
+	return oldValue.SessionPolicy, nil
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *AuthClientMutation) RemovedUsersIDs() (ids []int64) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// AddSessionPolicy adds i to the "session_policy" field.
+func (m *AuthClientMutation) AddSessionPolicy(i int) {
+	if m.addsession_policy != nil {
+		*m.addsession_policy += i
+	} else {
+		m.addsession_policy = &i
 	}
-	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *AuthClientMutation) UsersIDs() (ids []int64) {
-	for id := range m.users {
-		ids = append(ids, id)
+// AddedSessionPolicy returns the value that was added to the "session_policy" field in this mutation.
+func (m *AuthClientMutation) AddedSessionPolicy() (r int, exists bool) {
+	v := m.addsession_policy
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *AuthClientMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetSessionPolicy resets all changes to the "session_policy" field.
+func (m *AuthClientMutation) ResetSessionPolicy() {
+	m.session_policy = nil
+	m.addsession_policy = nil
 }
 
-// AddRoleIDs adds the "roles" edge to the Role entity by ids.
-func (m *AuthClientMutation) AddRoleIDs(ids ...int64) {
-	if m.roles == nil {
-		m.roles = make(map[int64]struct{})
+// SetMaxSessions sets the "max_sessions" field.
+func (m *AuthClientMutation) SetMaxSessions(i int) {
+	m.max_sessions = &i
+	m.addmax_sessions = nil
+}
+
+// MaxSessions returns the value of the "max_sessions" field in the mutation.
+func (m *AuthClientMutation) MaxSessions() (r int, exists bool) {
+	v := m.max_sessions
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.roles[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldMaxSessions returns the old "max_sessions" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldMaxSessions(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxSessions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxSessions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxSessions: %w", err)
 	}
+	return oldValue.MaxSessions, nil
 }
 
-// ClearRoles clears the "roles" edge to the Role entity.
-func (m *AuthClientMutation) ClearRoles() {
-	m.clearedroles = true
+// AddMaxSessions adds i to the "max_sessions" field.
+func (m *AuthClientMutation) AddMaxSessions(i int) {
+	if m.addmax_sessions != nil {
+		*m.addmax_sessions += i
+	} else {
+		m.addmax_sessions = &i
+	}
 }
 
-// RolesCleared reports if the "roles" edge to the Role entity was cleared.
-func (m *AuthClientMutation) RolesCleared() bool {
-	return m.clearedroles
+// AddedMaxSessions returns the value that was added to the "max_sessions" field in this mutation.
+func (m *AuthClientMutation) AddedMaxSessions() (r int, exists bool) {
+	v := m.addmax_sessions
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
-func (m *AuthClientMutation) RemoveRoleIDs(ids ...int64) {
-	if m.removedroles == nil {
-		m.removedroles = make(map[int64]struct{})
+// ResetMaxSessions resets all changes to the "max_sessions" field.
+func (m *AuthClientMutation) ResetMaxSessions() {
+	m.max_sessions = nil
+	m.addmax_sessions = nil
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (m *AuthClientMutation) SetSigningMethod(i int) {
+	m.signing_method = &i
+	m.addsigning_method = nil
+}
+
+// SigningMethod returns the value of the "signing_method" field in the mutation.
+func (m *AuthClientMutation) SigningMethod() (r int, exists bool) {
+	v := m.signing_method
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		delete(m.roles, ids[i])
-		m.removedroles[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldSigningMethod returns the old "signing_method" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldSigningMethod(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigningMethod is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigningMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigningMethod: %w", err)
+	}
+	return oldValue.SigningMethod, nil
 }
 
-// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
-func (m *AuthClientMutation) RemovedRolesIDs() (ids []int64) {
-	for id := range m.removedroles {
-		ids = append(ids, id)
+// AddSigningMethod adds i to the "signing_method" field.
+func (m *AuthClientMutation) AddSigningMethod(i int) {
+	if m.addsigning_method != nil {
+		*m.addsigning_method += i
+	} else {
+		m.addsigning_method = &i
 	}
-	return
 }
 
-// RolesIDs returns the "roles" edge IDs in the mutation.
-func (m *AuthClientMutation) RolesIDs() (ids []int64) {
-	for id := range m.roles {
-		ids = append(ids, id)
+// AddedSigningMethod returns the value that was added to the "signing_method" field in this mutation.
+func (m *AuthClientMutation) AddedSigningMethod() (r int, exists bool) {
+	v := m.addsigning_method
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetRoles resets all changes to the "roles" edge.
-func (m *AuthClientMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
-	m.removedroles = nil
+// ResetSigningMethod resets all changes to the "signing_method" field.
+func (m *AuthClientMutation) ResetSigningMethod() {
+	m.signing_method = nil
+	m.addsigning_method = nil
 }
 
-// Where appends a list predicates to the AuthClientMutation builder.
-func (m *AuthClientMutation) Where(ps ...predicate.AuthClient) {
-	m.predicates = append(m.predicates, ps...)
+// SetMfaRequired sets the "mfa_required" field.
+func (m *AuthClientMutation) SetMfaRequired(b bool) {
+	m.mfa_required = &b
 }
 
-// WhereP appends storage-level predicates to the AuthClientMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AuthClientMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AuthClient, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// MfaRequired returns the value of the "mfa_required" field in the mutation.
+func (m *AuthClientMutation) MfaRequired() (r bool, exists bool) {
+	v := m.mfa_required
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *AuthClientMutation) Op() Op {
-	return m.op
+// OldMfaRequired returns the old "mfa_required" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldMfaRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMfaRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMfaRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMfaRequired: %w", err)
+	}
+	return oldValue.MfaRequired, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *AuthClientMutation) SetOp(op Op) {
-	m.op = op
+// ResetMfaRequired resets all changes to the "mfa_required" field.
+func (m *AuthClientMutation) ResetMfaRequired() {
+	m.mfa_required = nil
 }
 
-// Type returns the node type of this mutation (AuthClient).
-func (m *AuthClientMutation) Type() string {
-	return m.typ
+// SetPasswordMinLength sets the "password_min_length" field.
+func (m *AuthClientMutation) SetPasswordMinLength(i int) {
+	m.password_min_length = &i
+	m.addpassword_min_length = nil
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AuthClientMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.created_at != nil {
-		fields = append(fields, authclient.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, authclient.FieldUpdatedAt)
-	}
-	if m.client_type != nil {
-		fields = append(fields, authclient.FieldClientType)
+// PasswordMinLength returns the value of the "password_min_length" field in the mutation.
+func (m *AuthClientMutation) PasswordMinLength() (r int, exists bool) {
+	v := m.password_min_length
+	if v == nil {
+		return
 	}
-	if m.merchant_id != nil {
-		fields = append(fields, authclient.FieldMerchantID)
+	return *v, true
+}
+
+// OldPasswordMinLength returns the old "password_min_length" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldPasswordMinLength(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordMinLength is only allowed on UpdateOne operations")
 	}
-	if m.secret != nil {
-		fields = append(fields, authclient.FieldSecret)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordMinLength requires an ID field in the mutation")
 	}
-	if m.active != nil {
-		fields = append(fields, authclient.FieldActive)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordMinLength: %w", err)
 	}
-	if m.token_expire_secs != nil {
-		fields = append(fields, authclient.FieldTokenExpireSecs)
+	return oldValue.PasswordMinLength, nil
+}
+
+// AddPasswordMinLength adds i to the "password_min_length" field.
+func (m *AuthClientMutation) AddPasswordMinLength(i int) {
+	if m.addpassword_min_length != nil {
+		*m.addpassword_min_length += i
+	} else {
+		m.addpassword_min_length = &i
 	}
-	if m.login_failed_times != nil {
-		fields = append(fields, authclient.FieldLoginFailedTimes)
+}
+
+// AddedPasswordMinLength returns the value that was added to the "password_min_length" field in this mutation.
+func (m *AuthClientMutation) AddedPasswordMinLength() (r int, exists bool) {
+	v := m.addpassword_min_length
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *AuthClientMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case authclient.FieldCreatedAt:
-		return m.CreatedAt()
-	case authclient.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case authclient.FieldClientType:
-		return m.ClientType()
-	case authclient.FieldMerchantID:
-		return m.MerchantID()
-	case authclient.FieldSecret:
-		return m.Secret()
-	case authclient.FieldActive:
-		return m.Active()
-	case authclient.FieldTokenExpireSecs:
-		return m.TokenExpireSecs()
-	case authclient.FieldLoginFailedTimes:
-		return m.LoginFailedTimes()
+// ResetPasswordMinLength resets all changes to the "password_min_length" field.
+func (m *AuthClientMutation) ResetPasswordMinLength() {
+	m.password_min_length = nil
+	m.addpassword_min_length = nil
+}
+
+// SetPasswordCharClasses sets the "password_char_classes" field.
+func (m *AuthClientMutation) SetPasswordCharClasses(ecc []enum.PasswordCharClass) {
+	m.password_char_classes = &ecc
+	m.appendpassword_char_classes = nil
+}
+
+// PasswordCharClasses returns the value of the "password_char_classes" field in the mutation.
+func (m *AuthClientMutation) PasswordCharClasses() (r []enum.PasswordCharClass, exists bool) {
+	v := m.password_char_classes
+	if v == nil {
+		return
 	}
-	return nil, false
+	return *v, true
 }
 
-// OldField returns the old value of the field from the database. An error is
+// OldPasswordCharClasses returns the old "password_char_classes" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldPasswordCharClasses(ctx context.Context) (v []enum.PasswordCharClass, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordCharClasses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordCharClasses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordCharClasses: %w", err)
+	}
+	return oldValue.PasswordCharClasses, nil
+}
+
+// AppendPasswordCharClasses adds ecc to the "password_char_classes" field.
+func (m *AuthClientMutation) AppendPasswordCharClasses(ecc []enum.PasswordCharClass) {
+	m.appendpassword_char_classes = append(m.appendpassword_char_classes, ecc...)
+}
+
+// AppendedPasswordCharClasses returns the list of values that were appended to the "password_char_classes" field in this mutation.
+func (m *AuthClientMutation) AppendedPasswordCharClasses() ([]enum.PasswordCharClass, bool) {
+	if len(m.appendpassword_char_classes) == 0 {
+		return nil, false
+	}
+	return m.appendpassword_char_classes, true
+}
+
+// ClearPasswordCharClasses clears the value of the "password_char_classes" field.
+func (m *AuthClientMutation) ClearPasswordCharClasses() {
+	m.password_char_classes = nil
+	m.appendpassword_char_classes = nil
+	m.clearedFields[authclient.FieldPasswordCharClasses] = struct{}{}
+}
+
+// PasswordCharClassesCleared returns if the "password_char_classes" field was cleared in this mutation.
+func (m *AuthClientMutation) PasswordCharClassesCleared() bool {
+	_, ok := m.clearedFields[authclient.FieldPasswordCharClasses]
+	return ok
+}
+
+// ResetPasswordCharClasses resets all changes to the "password_char_classes" field.
+func (m *AuthClientMutation) ResetPasswordCharClasses() {
+	m.password_char_classes = nil
+	m.appendpassword_char_classes = nil
+	delete(m.clearedFields, authclient.FieldPasswordCharClasses)
+}
+
+// SetPasswordHistoryCount sets the "password_history_count" field.
+func (m *AuthClientMutation) SetPasswordHistoryCount(i int) {
+	m.password_history_count = &i
+	m.addpassword_history_count = nil
+}
+
+// PasswordHistoryCount returns the value of the "password_history_count" field in the mutation.
+func (m *AuthClientMutation) PasswordHistoryCount() (r int, exists bool) {
+	v := m.password_history_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHistoryCount returns the old "password_history_count" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldPasswordHistoryCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHistoryCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHistoryCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHistoryCount: %w", err)
+	}
+	return oldValue.PasswordHistoryCount, nil
+}
+
+// AddPasswordHistoryCount adds i to the "password_history_count" field.
+func (m *AuthClientMutation) AddPasswordHistoryCount(i int) {
+	if m.addpassword_history_count != nil {
+		*m.addpassword_history_count += i
+	} else {
+		m.addpassword_history_count = &i
+	}
+}
+
+// AddedPasswordHistoryCount returns the value that was added to the "password_history_count" field in this mutation.
+func (m *AuthClientMutation) AddedPasswordHistoryCount() (r int, exists bool) {
+	v := m.addpassword_history_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPasswordHistoryCount resets all changes to the "password_history_count" field.
+func (m *AuthClientMutation) ResetPasswordHistoryCount() {
+	m.password_history_count = nil
+	m.addpassword_history_count = nil
+}
+
+// SetPasswordMaxAgeSecs sets the "password_max_age_secs" field.
+func (m *AuthClientMutation) SetPasswordMaxAgeSecs(i int) {
+	m.password_max_age_secs = &i
+	m.addpassword_max_age_secs = nil
+}
+
+// PasswordMaxAgeSecs returns the value of the "password_max_age_secs" field in the mutation.
+func (m *AuthClientMutation) PasswordMaxAgeSecs() (r int, exists bool) {
+	v := m.password_max_age_secs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordMaxAgeSecs returns the old "password_max_age_secs" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldPasswordMaxAgeSecs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordMaxAgeSecs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordMaxAgeSecs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordMaxAgeSecs: %w", err)
+	}
+	return oldValue.PasswordMaxAgeSecs, nil
+}
+
+// AddPasswordMaxAgeSecs adds i to the "password_max_age_secs" field.
+func (m *AuthClientMutation) AddPasswordMaxAgeSecs(i int) {
+	if m.addpassword_max_age_secs != nil {
+		*m.addpassword_max_age_secs += i
+	} else {
+		m.addpassword_max_age_secs = &i
+	}
+}
+
+// AddedPasswordMaxAgeSecs returns the value that was added to the "password_max_age_secs" field in this mutation.
+func (m *AuthClientMutation) AddedPasswordMaxAgeSecs() (r int, exists bool) {
+	v := m.addpassword_max_age_secs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPasswordMaxAgeSecs resets all changes to the "password_max_age_secs" field.
+func (m *AuthClientMutation) ResetPasswordMaxAgeSecs() {
+	m.password_max_age_secs = nil
+	m.addpassword_max_age_secs = nil
+}
+
+// SetBannedPasswords sets the "banned_passwords" field.
+func (m *AuthClientMutation) SetBannedPasswords(s []string) {
+	m.banned_passwords = &s
+	m.appendbanned_passwords = nil
+}
+
+// BannedPasswords returns the value of the "banned_passwords" field in the mutation.
+func (m *AuthClientMutation) BannedPasswords() (r []string, exists bool) {
+	v := m.banned_passwords
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBannedPasswords returns the old "banned_passwords" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldBannedPasswords(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBannedPasswords is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBannedPasswords requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBannedPasswords: %w", err)
+	}
+	return oldValue.BannedPasswords, nil
+}
+
+// AppendBannedPasswords adds s to the "banned_passwords" field.
+func (m *AuthClientMutation) AppendBannedPasswords(s []string) {
+	m.appendbanned_passwords = append(m.appendbanned_passwords, s...)
+}
+
+// AppendedBannedPasswords returns the list of values that were appended to the "banned_passwords" field in this mutation.
+func (m *AuthClientMutation) AppendedBannedPasswords() ([]string, bool) {
+	if len(m.appendbanned_passwords) == 0 {
+		return nil, false
+	}
+	return m.appendbanned_passwords, true
+}
+
+// ClearBannedPasswords clears the value of the "banned_passwords" field.
+func (m *AuthClientMutation) ClearBannedPasswords() {
+	m.banned_passwords = nil
+	m.appendbanned_passwords = nil
+	m.clearedFields[authclient.FieldBannedPasswords] = struct{}{}
+}
+
+// BannedPasswordsCleared returns if the "banned_passwords" field was cleared in this mutation.
+func (m *AuthClientMutation) BannedPasswordsCleared() bool {
+	_, ok := m.clearedFields[authclient.FieldBannedPasswords]
+	return ok
+}
+
+// ResetBannedPasswords resets all changes to the "banned_passwords" field.
+func (m *AuthClientMutation) ResetBannedPasswords() {
+	m.banned_passwords = nil
+	m.appendbanned_passwords = nil
+	delete(m.clearedFields, authclient.FieldBannedPasswords)
+}
+
+// SetLockoutSecs sets the "lockout_secs" field.
+func (m *AuthClientMutation) SetLockoutSecs(i int) {
+	m.lockout_secs = &i
+	m.addlockout_secs = nil
+}
+
+// LockoutSecs returns the value of the "lockout_secs" field in the mutation.
+func (m *AuthClientMutation) LockoutSecs() (r int, exists bool) {
+	v := m.lockout_secs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockoutSecs returns the old "lockout_secs" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldLockoutSecs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockoutSecs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockoutSecs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockoutSecs: %w", err)
+	}
+	return oldValue.LockoutSecs, nil
+}
+
+// AddLockoutSecs adds i to the "lockout_secs" field.
+func (m *AuthClientMutation) AddLockoutSecs(i int) {
+	if m.addlockout_secs != nil {
+		*m.addlockout_secs += i
+	} else {
+		m.addlockout_secs = &i
+	}
+}
+
+// AddedLockoutSecs returns the value that was added to the "lockout_secs" field in this mutation.
+func (m *AuthClientMutation) AddedLockoutSecs() (r int, exists bool) {
+	v := m.addlockout_secs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLockoutSecs resets all changes to the "lockout_secs" field.
+func (m *AuthClientMutation) ResetLockoutSecs() {
+	m.lockout_secs = nil
+	m.addlockout_secs = nil
+}
+
+// SetMaxLockoutSecs sets the "max_lockout_secs" field.
+func (m *AuthClientMutation) SetMaxLockoutSecs(i int) {
+	m.max_lockout_secs = &i
+	m.addmax_lockout_secs = nil
+}
+
+// MaxLockoutSecs returns the value of the "max_lockout_secs" field in the mutation.
+func (m *AuthClientMutation) MaxLockoutSecs() (r int, exists bool) {
+	v := m.max_lockout_secs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxLockoutSecs returns the old "max_lockout_secs" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldMaxLockoutSecs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxLockoutSecs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxLockoutSecs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxLockoutSecs: %w", err)
+	}
+	return oldValue.MaxLockoutSecs, nil
+}
+
+// AddMaxLockoutSecs adds i to the "max_lockout_secs" field.
+func (m *AuthClientMutation) AddMaxLockoutSecs(i int) {
+	if m.addmax_lockout_secs != nil {
+		*m.addmax_lockout_secs += i
+	} else {
+		m.addmax_lockout_secs = &i
+	}
+}
+
+// AddedMaxLockoutSecs returns the value that was added to the "max_lockout_secs" field in this mutation.
+func (m *AuthClientMutation) AddedMaxLockoutSecs() (r int, exists bool) {
+	v := m.addmax_lockout_secs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxLockoutSecs resets all changes to the "max_lockout_secs" field.
+func (m *AuthClientMutation) ResetMaxLockoutSecs() {
+	m.max_lockout_secs = nil
+	m.addmax_lockout_secs = nil
+}
+
+// SetRiskChallengeScore sets the "risk_challenge_score" field.
+func (m *AuthClientMutation) SetRiskChallengeScore(i int) {
+	m.risk_challenge_score = &i
+	m.addrisk_challenge_score = nil
+}
+
+// RiskChallengeScore returns the value of the "risk_challenge_score" field in the mutation.
+func (m *AuthClientMutation) RiskChallengeScore() (r int, exists bool) {
+	v := m.risk_challenge_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRiskChallengeScore returns the old "risk_challenge_score" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldRiskChallengeScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRiskChallengeScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRiskChallengeScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRiskChallengeScore: %w", err)
+	}
+	return oldValue.RiskChallengeScore, nil
+}
+
+// AddRiskChallengeScore adds i to the "risk_challenge_score" field.
+func (m *AuthClientMutation) AddRiskChallengeScore(i int) {
+	if m.addrisk_challenge_score != nil {
+		*m.addrisk_challenge_score += i
+	} else {
+		m.addrisk_challenge_score = &i
+	}
+}
+
+// AddedRiskChallengeScore returns the value that was added to the "risk_challenge_score" field in this mutation.
+func (m *AuthClientMutation) AddedRiskChallengeScore() (r int, exists bool) {
+	v := m.addrisk_challenge_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRiskChallengeScore resets all changes to the "risk_challenge_score" field.
+func (m *AuthClientMutation) ResetRiskChallengeScore() {
+	m.risk_challenge_score = nil
+	m.addrisk_challenge_score = nil
+}
+
+// SetRiskDenyScore sets the "risk_deny_score" field.
+func (m *AuthClientMutation) SetRiskDenyScore(i int) {
+	m.risk_deny_score = &i
+	m.addrisk_deny_score = nil
+}
+
+// RiskDenyScore returns the value of the "risk_deny_score" field in the mutation.
+func (m *AuthClientMutation) RiskDenyScore() (r int, exists bool) {
+	v := m.risk_deny_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRiskDenyScore returns the old "risk_deny_score" field's value of the AuthClient entity.
+// If the AuthClient object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuthClientMutation) OldRiskDenyScore(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRiskDenyScore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRiskDenyScore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRiskDenyScore: %w", err)
+	}
+	return oldValue.RiskDenyScore, nil
+}
+
+// AddRiskDenyScore adds i to the "risk_deny_score" field.
+func (m *AuthClientMutation) AddRiskDenyScore(i int) {
+	if m.addrisk_deny_score != nil {
+		*m.addrisk_deny_score += i
+	} else {
+		m.addrisk_deny_score = &i
+	}
+}
+
+// AddedRiskDenyScore returns the value that was added to the "risk_deny_score" field in this mutation.
+func (m *AuthClientMutation) AddedRiskDenyScore() (r int, exists bool) {
+	v := m.addrisk_deny_score
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRiskDenyScore resets all changes to the "risk_deny_score" field.
+func (m *AuthClientMutation) ResetRiskDenyScore() {
+	m.risk_deny_score = nil
+	m.addrisk_deny_score = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *AuthClientMutation) AddUserIDs(ids ...int64) {
+	if m.users == nil {
+		m.users = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *AuthClientMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *AuthClientMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *AuthClientMutation) RemoveUserIDs(ids ...int64) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *AuthClientMutation) RemovedUsersIDs() (ids []int64) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *AuthClientMutation) UsersIDs() (ids []int64) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *AuthClientMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddRoleIDs adds the "roles" edge to the Role entity by ids.
+func (m *AuthClientMutation) AddRoleIDs(ids ...int64) {
+	if m.roles == nil {
+		m.roles = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *AuthClientMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the Role entity was cleared.
+func (m *AuthClientMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RemoveRoleIDs removes the "roles" edge to the Role entity by IDs.
+func (m *AuthClientMutation) RemoveRoleIDs(ids ...int64) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoles returns the removed IDs of the "roles" edge to the Role entity.
+func (m *AuthClientMutation) RemovedRolesIDs() (ids []int64) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *AuthClientMutation) RolesIDs() (ids []int64) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *AuthClientMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
+}
+
+// Where appends a list predicates to the AuthClientMutation builder.
+func (m *AuthClientMutation) Where(ps ...predicate.AuthClient) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuthClientMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuthClientMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuthClient, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuthClientMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuthClientMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuthClient).
+func (m *AuthClientMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuthClientMutation) Fields() []string {
+	fields := make([]string, 0, 22)
+	if m.created_at != nil {
+		fields = append(fields, authclient.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, authclient.FieldUpdatedAt)
+	}
+	if m.client_type != nil {
+		fields = append(fields, authclient.FieldClientType)
+	}
+	if m.merchant_id != nil {
+		fields = append(fields, authclient.FieldMerchantID)
+	}
+	if m.secret != nil {
+		fields = append(fields, authclient.FieldSecret)
+	}
+	if m.active != nil {
+		fields = append(fields, authclient.FieldActive)
+	}
+	if m.token_expire_secs != nil {
+		fields = append(fields, authclient.FieldTokenExpireSecs)
+	}
+	if m.login_failed_times != nil {
+		fields = append(fields, authclient.FieldLoginFailedTimes)
+	}
+	if m.refresh_token_expire_secs != nil {
+		fields = append(fields, authclient.FieldRefreshTokenExpireSecs)
+	}
+	if m.session_policy != nil {
+		fields = append(fields, authclient.FieldSessionPolicy)
+	}
+	if m.max_sessions != nil {
+		fields = append(fields, authclient.FieldMaxSessions)
+	}
+	if m.signing_method != nil {
+		fields = append(fields, authclient.FieldSigningMethod)
+	}
+	if m.mfa_required != nil {
+		fields = append(fields, authclient.FieldMfaRequired)
+	}
+	if m.password_min_length != nil {
+		fields = append(fields, authclient.FieldPasswordMinLength)
+	}
+	if m.password_char_classes != nil {
+		fields = append(fields, authclient.FieldPasswordCharClasses)
+	}
+	if m.password_history_count != nil {
+		fields = append(fields, authclient.FieldPasswordHistoryCount)
+	}
+	if m.password_max_age_secs != nil {
+		fields = append(fields, authclient.FieldPasswordMaxAgeSecs)
+	}
+	if m.banned_passwords != nil {
+		fields = append(fields, authclient.FieldBannedPasswords)
+	}
+	if m.lockout_secs != nil {
+		fields = append(fields, authclient.FieldLockoutSecs)
+	}
+	if m.max_lockout_secs != nil {
+		fields = append(fields, authclient.FieldMaxLockoutSecs)
+	}
+	if m.risk_challenge_score != nil {
+		fields = append(fields, authclient.FieldRiskChallengeScore)
+	}
+	if m.risk_deny_score != nil {
+		fields = append(fields, authclient.FieldRiskDenyScore)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AuthClientMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case authclient.FieldCreatedAt:
+		return m.CreatedAt()
+	case authclient.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case authclient.FieldClientType:
+		return m.ClientType()
+	case authclient.FieldMerchantID:
+		return m.MerchantID()
+	case authclient.FieldSecret:
+		return m.Secret()
+	case authclient.FieldActive:
+		return m.Active()
+	case authclient.FieldTokenExpireSecs:
+		return m.TokenExpireSecs()
+	case authclient.FieldLoginFailedTimes:
+		return m.LoginFailedTimes()
+	case authclient.FieldRefreshTokenExpireSecs:
+		return m.RefreshTokenExpireSecs()
+	case authclient.FieldSessionPolicy:
+		return m.SessionPolicy()
+	case authclient.FieldMaxSessions:
+		return m.MaxSessions()
+	case authclient.FieldSigningMethod:
+		return m.SigningMethod()
+	case authclient.FieldMfaRequired:
+		return m.MfaRequired()
+	case authclient.FieldPasswordMinLength:
+		return m.PasswordMinLength()
+	case authclient.FieldPasswordCharClasses:
+		return m.PasswordCharClasses()
+	case authclient.FieldPasswordHistoryCount:
+		return m.PasswordHistoryCount()
+	case authclient.FieldPasswordMaxAgeSecs:
+		return m.PasswordMaxAgeSecs()
+	case authclient.FieldBannedPasswords:
+		return m.BannedPasswords()
+	case authclient.FieldLockoutSecs:
+		return m.LockoutSecs()
+	case authclient.FieldMaxLockoutSecs:
+		return m.MaxLockoutSecs()
+	case authclient.FieldRiskChallengeScore:
+		return m.RiskChallengeScore()
+	case authclient.FieldRiskDenyScore:
+		return m.RiskDenyScore()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AuthClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case authclient.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case authclient.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case authclient.FieldClientType:
+		return m.OldClientType(ctx)
+	case authclient.FieldMerchantID:
+		return m.OldMerchantID(ctx)
+	case authclient.FieldSecret:
+		return m.OldSecret(ctx)
+	case authclient.FieldActive:
+		return m.OldActive(ctx)
+	case authclient.FieldTokenExpireSecs:
+		return m.OldTokenExpireSecs(ctx)
+	case authclient.FieldLoginFailedTimes:
+		return m.OldLoginFailedTimes(ctx)
+	case authclient.FieldRefreshTokenExpireSecs:
+		return m.OldRefreshTokenExpireSecs(ctx)
+	case authclient.FieldSessionPolicy:
+		return m.OldSessionPolicy(ctx)
+	case authclient.FieldMaxSessions:
+		return m.OldMaxSessions(ctx)
+	case authclient.FieldSigningMethod:
+		return m.OldSigningMethod(ctx)
+	case authclient.FieldMfaRequired:
+		return m.OldMfaRequired(ctx)
+	case authclient.FieldPasswordMinLength:
+		return m.OldPasswordMinLength(ctx)
+	case authclient.FieldPasswordCharClasses:
+		return m.OldPasswordCharClasses(ctx)
+	case authclient.FieldPasswordHistoryCount:
+		return m.OldPasswordHistoryCount(ctx)
+	case authclient.FieldPasswordMaxAgeSecs:
+		return m.OldPasswordMaxAgeSecs(ctx)
+	case authclient.FieldBannedPasswords:
+		return m.OldBannedPasswords(ctx)
+	case authclient.FieldLockoutSecs:
+		return m.OldLockoutSecs(ctx)
+	case authclient.FieldMaxLockoutSecs:
+		return m.OldMaxLockoutSecs(ctx)
+	case authclient.FieldRiskChallengeScore:
+		return m.OldRiskChallengeScore(ctx)
+	case authclient.FieldRiskDenyScore:
+		return m.OldRiskDenyScore(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuthClient field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthClientMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case authclient.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case authclient.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case authclient.FieldClientType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientType(v)
+		return nil
+	case authclient.FieldMerchantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchantID(v)
+		return nil
+	case authclient.FieldSecret:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecret(v)
+		return nil
+	case authclient.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case authclient.FieldTokenExpireSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTokenExpireSecs(v)
+		return nil
+	case authclient.FieldLoginFailedTimes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLoginFailedTimes(v)
+		return nil
+	case authclient.FieldRefreshTokenExpireSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefreshTokenExpireSecs(v)
+		return nil
+	case authclient.FieldSessionPolicy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionPolicy(v)
+		return nil
+	case authclient.FieldMaxSessions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxSessions(v)
+		return nil
+	case authclient.FieldSigningMethod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSigningMethod(v)
+		return nil
+	case authclient.FieldMfaRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMfaRequired(v)
+		return nil
+	case authclient.FieldPasswordMinLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordMinLength(v)
+		return nil
+	case authclient.FieldPasswordCharClasses:
+		v, ok := value.([]enum.PasswordCharClass)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordCharClasses(v)
+		return nil
+	case authclient.FieldPasswordHistoryCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHistoryCount(v)
+		return nil
+	case authclient.FieldPasswordMaxAgeSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordMaxAgeSecs(v)
+		return nil
+	case authclient.FieldBannedPasswords:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBannedPasswords(v)
+		return nil
+	case authclient.FieldLockoutSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockoutSecs(v)
+		return nil
+	case authclient.FieldMaxLockoutSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxLockoutSecs(v)
+		return nil
+	case authclient.FieldRiskChallengeScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRiskChallengeScore(v)
+		return nil
+	case authclient.FieldRiskDenyScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRiskDenyScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthClient field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AuthClientMutation) AddedFields() []string {
+	var fields []string
+	if m.addclient_type != nil {
+		fields = append(fields, authclient.FieldClientType)
+	}
+	if m.addmerchant_id != nil {
+		fields = append(fields, authclient.FieldMerchantID)
+	}
+	if m.addtoken_expire_secs != nil {
+		fields = append(fields, authclient.FieldTokenExpireSecs)
+	}
+	if m.addlogin_failed_times != nil {
+		fields = append(fields, authclient.FieldLoginFailedTimes)
+	}
+	if m.addrefresh_token_expire_secs != nil {
+		fields = append(fields, authclient.FieldRefreshTokenExpireSecs)
+	}
+	if m.addsession_policy != nil {
+		fields = append(fields, authclient.FieldSessionPolicy)
+	}
+	if m.addmax_sessions != nil {
+		fields = append(fields, authclient.FieldMaxSessions)
+	}
+	if m.addsigning_method != nil {
+		fields = append(fields, authclient.FieldSigningMethod)
+	}
+	if m.addpassword_min_length != nil {
+		fields = append(fields, authclient.FieldPasswordMinLength)
+	}
+	if m.addpassword_history_count != nil {
+		fields = append(fields, authclient.FieldPasswordHistoryCount)
+	}
+	if m.addpassword_max_age_secs != nil {
+		fields = append(fields, authclient.FieldPasswordMaxAgeSecs)
+	}
+	if m.addlockout_secs != nil {
+		fields = append(fields, authclient.FieldLockoutSecs)
+	}
+	if m.addmax_lockout_secs != nil {
+		fields = append(fields, authclient.FieldMaxLockoutSecs)
+	}
+	if m.addrisk_challenge_score != nil {
+		fields = append(fields, authclient.FieldRiskChallengeScore)
+	}
+	if m.addrisk_deny_score != nil {
+		fields = append(fields, authclient.FieldRiskDenyScore)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuthClientMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case authclient.FieldClientType:
+		return m.AddedClientType()
+	case authclient.FieldMerchantID:
+		return m.AddedMerchantID()
+	case authclient.FieldTokenExpireSecs:
+		return m.AddedTokenExpireSecs()
+	case authclient.FieldLoginFailedTimes:
+		return m.AddedLoginFailedTimes()
+	case authclient.FieldRefreshTokenExpireSecs:
+		return m.AddedRefreshTokenExpireSecs()
+	case authclient.FieldSessionPolicy:
+		return m.AddedSessionPolicy()
+	case authclient.FieldMaxSessions:
+		return m.AddedMaxSessions()
+	case authclient.FieldSigningMethod:
+		return m.AddedSigningMethod()
+	case authclient.FieldPasswordMinLength:
+		return m.AddedPasswordMinLength()
+	case authclient.FieldPasswordHistoryCount:
+		return m.AddedPasswordHistoryCount()
+	case authclient.FieldPasswordMaxAgeSecs:
+		return m.AddedPasswordMaxAgeSecs()
+	case authclient.FieldLockoutSecs:
+		return m.AddedLockoutSecs()
+	case authclient.FieldMaxLockoutSecs:
+		return m.AddedMaxLockoutSecs()
+	case authclient.FieldRiskChallengeScore:
+		return m.AddedRiskChallengeScore()
+	case authclient.FieldRiskDenyScore:
+		return m.AddedRiskDenyScore()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuthClientMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case authclient.FieldClientType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClientType(v)
+		return nil
+	case authclient.FieldMerchantID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMerchantID(v)
+		return nil
+	case authclient.FieldTokenExpireSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTokenExpireSecs(v)
+		return nil
+	case authclient.FieldLoginFailedTimes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLoginFailedTimes(v)
+		return nil
+	case authclient.FieldRefreshTokenExpireSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRefreshTokenExpireSecs(v)
+		return nil
+	case authclient.FieldSessionPolicy:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSessionPolicy(v)
+		return nil
+	case authclient.FieldMaxSessions:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxSessions(v)
+		return nil
+	case authclient.FieldSigningMethod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSigningMethod(v)
+		return nil
+	case authclient.FieldPasswordMinLength:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPasswordMinLength(v)
+		return nil
+	case authclient.FieldPasswordHistoryCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPasswordHistoryCount(v)
+		return nil
+	case authclient.FieldPasswordMaxAgeSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPasswordMaxAgeSecs(v)
+		return nil
+	case authclient.FieldLockoutSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLockoutSecs(v)
+		return nil
+	case authclient.FieldMaxLockoutSecs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxLockoutSecs(v)
+		return nil
+	case authclient.FieldRiskChallengeScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRiskChallengeScore(v)
+		return nil
+	case authclient.FieldRiskDenyScore:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRiskDenyScore(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AuthClient numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuthClientMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(authclient.FieldPasswordCharClasses) {
+		fields = append(fields, authclient.FieldPasswordCharClasses)
+	}
+	if m.FieldCleared(authclient.FieldBannedPasswords) {
+		fields = append(fields, authclient.FieldBannedPasswords)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuthClientMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuthClientMutation) ClearField(name string) error {
+	switch name {
+	case authclient.FieldPasswordCharClasses:
+		m.ClearPasswordCharClasses()
+		return nil
+	case authclient.FieldBannedPasswords:
+		m.ClearBannedPasswords()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthClient nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuthClientMutation) ResetField(name string) error {
+	switch name {
+	case authclient.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case authclient.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case authclient.FieldClientType:
+		m.ResetClientType()
+		return nil
+	case authclient.FieldMerchantID:
+		m.ResetMerchantID()
+		return nil
+	case authclient.FieldSecret:
+		m.ResetSecret()
+		return nil
+	case authclient.FieldActive:
+		m.ResetActive()
+		return nil
+	case authclient.FieldTokenExpireSecs:
+		m.ResetTokenExpireSecs()
+		return nil
+	case authclient.FieldLoginFailedTimes:
+		m.ResetLoginFailedTimes()
+		return nil
+	case authclient.FieldRefreshTokenExpireSecs:
+		m.ResetRefreshTokenExpireSecs()
+		return nil
+	case authclient.FieldSessionPolicy:
+		m.ResetSessionPolicy()
+		return nil
+	case authclient.FieldMaxSessions:
+		m.ResetMaxSessions()
+		return nil
+	case authclient.FieldSigningMethod:
+		m.ResetSigningMethod()
+		return nil
+	case authclient.FieldMfaRequired:
+		m.ResetMfaRequired()
+		return nil
+	case authclient.FieldPasswordMinLength:
+		m.ResetPasswordMinLength()
+		return nil
+	case authclient.FieldPasswordCharClasses:
+		m.ResetPasswordCharClasses()
+		return nil
+	case authclient.FieldPasswordHistoryCount:
+		m.ResetPasswordHistoryCount()
+		return nil
+	case authclient.FieldPasswordMaxAgeSecs:
+		m.ResetPasswordMaxAgeSecs()
+		return nil
+	case authclient.FieldBannedPasswords:
+		m.ResetBannedPasswords()
+		return nil
+	case authclient.FieldLockoutSecs:
+		m.ResetLockoutSecs()
+		return nil
+	case authclient.FieldMaxLockoutSecs:
+		m.ResetMaxLockoutSecs()
+		return nil
+	case authclient.FieldRiskChallengeScore:
+		m.ResetRiskChallengeScore()
+		return nil
+	case authclient.FieldRiskDenyScore:
+		m.ResetRiskDenyScore()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthClient field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuthClientMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, authclient.EdgeUsers)
+	}
+	if m.roles != nil {
+		edges = append(edges, authclient.EdgeRoles)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuthClientMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case authclient.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case authclient.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuthClientMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedusers != nil {
+		edges = append(edges, authclient.EdgeUsers)
+	}
+	if m.removedroles != nil {
+		edges = append(edges, authclient.EdgeRoles)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuthClientMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case authclient.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case authclient.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuthClientMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, authclient.EdgeUsers)
+	}
+	if m.clearedroles {
+		edges = append(edges, authclient.EdgeRoles)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuthClientMutation) EdgeCleared(name string) bool {
+	switch name {
+	case authclient.EdgeUsers:
+		return m.clearedusers
+	case authclient.EdgeRoles:
+		return m.clearedroles
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuthClientMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuthClient unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuthClientMutation) ResetEdge(name string) error {
+	switch name {
+	case authclient.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case authclient.EdgeRoles:
+		m.ResetRoles()
+		return nil
+	}
+	return fmt.Errorf("unknown AuthClient edge %s", name)
+}
+
+// LoginRecordMutation represents an operation that mutates the LoginRecord nodes in the graph.
+type LoginRecordMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	updated_at    *time.Time
+	browser       *string
+	browser_ver   *string
+	ip            *string
+	os            *string
+	platform      *string
+	country       *string
+	country_code  *string
+	city          *string
+	asp           *string
+	is_mobile     *bool
+	is_success    *bool
+	err_message   *string
+	err_code      *int
+	adderr_code   *int
+	latitude      *float64
+	addlatitude   *float64
+	longitude     *float64
+	addlongitude  *float64
+	clearedFields map[string]struct{}
+	users         *int64
+	clearedusers  bool
+	done          bool
+	oldValue      func(context.Context) (*LoginRecord, error)
+	predicates    []predicate.LoginRecord
+}
+
+var _ ent.Mutation = (*LoginRecordMutation)(nil)
+
+// loginrecordOption allows management of the mutation configuration using functional options.
+type loginrecordOption func(*LoginRecordMutation)
+
+// newLoginRecordMutation creates new mutation for the LoginRecord entity.
+func newLoginRecordMutation(c config, op Op, opts ...loginrecordOption) *LoginRecordMutation {
+	m := &LoginRecordMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeLoginRecord,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withLoginRecordID sets the ID field of the mutation.
+func withLoginRecordID(id int64) loginrecordOption {
+	return func(m *LoginRecordMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *LoginRecord
+		)
+		m.oldValue = func(ctx context.Context) (*LoginRecord, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().LoginRecord.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withLoginRecord sets the old LoginRecord of the mutation.
+func withLoginRecord(node *LoginRecord) loginrecordOption {
+	return func(m *LoginRecordMutation) {
+		m.oldValue = func(context.Context) (*LoginRecord, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m LoginRecordMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m LoginRecordMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of LoginRecord entities.
+func (m *LoginRecordMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *LoginRecordMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *LoginRecordMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().LoginRecord.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *LoginRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *LoginRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *LoginRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *LoginRecordMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *LoginRecordMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *LoginRecordMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetBrowser sets the "browser" field.
+func (m *LoginRecordMutation) SetBrowser(s string) {
+	m.browser = &s
+}
+
+// Browser returns the value of the "browser" field in the mutation.
+func (m *LoginRecordMutation) Browser() (r string, exists bool) {
+	v := m.browser
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrowser returns the old "browser" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldBrowser(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrowser is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrowser requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrowser: %w", err)
+	}
+	return oldValue.Browser, nil
+}
+
+// ResetBrowser resets all changes to the "browser" field.
+func (m *LoginRecordMutation) ResetBrowser() {
+	m.browser = nil
+}
+
+// SetBrowserVer sets the "browser_ver" field.
+func (m *LoginRecordMutation) SetBrowserVer(s string) {
+	m.browser_ver = &s
+}
+
+// BrowserVer returns the value of the "browser_ver" field in the mutation.
+func (m *LoginRecordMutation) BrowserVer() (r string, exists bool) {
+	v := m.browser_ver
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrowserVer returns the old "browser_ver" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldBrowserVer(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrowserVer is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrowserVer requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrowserVer: %w", err)
+	}
+	return oldValue.BrowserVer, nil
+}
+
+// ResetBrowserVer resets all changes to the "browser_ver" field.
+func (m *LoginRecordMutation) ResetBrowserVer() {
+	m.browser_ver = nil
+}
+
+// SetIP sets the "ip" field.
+func (m *LoginRecordMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *LoginRecordMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *LoginRecordMutation) ResetIP() {
+	m.ip = nil
+}
+
+// SetOs sets the "os" field.
+func (m *LoginRecordMutation) SetOs(s string) {
+	m.os = &s
+}
+
+// Os returns the value of the "os" field in the mutation.
+func (m *LoginRecordMutation) Os() (r string, exists bool) {
+	v := m.os
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOs returns the old "os" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldOs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOs: %w", err)
+	}
+	return oldValue.Os, nil
+}
+
+// ResetOs resets all changes to the "os" field.
+func (m *LoginRecordMutation) ResetOs() {
+	m.os = nil
+}
+
+// SetPlatform sets the "platform" field.
+func (m *LoginRecordMutation) SetPlatform(s string) {
+	m.platform = &s
+}
+
+// Platform returns the value of the "platform" field in the mutation.
+func (m *LoginRecordMutation) Platform() (r string, exists bool) {
+	v := m.platform
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlatform returns the old "platform" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldPlatform(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlatform requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+	}
+	return oldValue.Platform, nil
+}
+
+// ResetPlatform resets all changes to the "platform" field.
+func (m *LoginRecordMutation) ResetPlatform() {
+	m.platform = nil
+}
+
+// SetCountry sets the "country" field.
+func (m *LoginRecordMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *LoginRecordMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *LoginRecordMutation) ResetCountry() {
+	m.country = nil
+}
+
+// SetCountryCode sets the "country_code" field.
+func (m *LoginRecordMutation) SetCountryCode(s string) {
+	m.country_code = &s
+}
+
+// CountryCode returns the value of the "country_code" field in the mutation.
+func (m *LoginRecordMutation) CountryCode() (r string, exists bool) {
+	v := m.country_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountryCode returns the old "country_code" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldCountryCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountryCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountryCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountryCode: %w", err)
+	}
+	return oldValue.CountryCode, nil
+}
+
+// ResetCountryCode resets all changes to the "country_code" field.
+func (m *LoginRecordMutation) ResetCountryCode() {
+	m.country_code = nil
+}
+
+// SetCity sets the "city" field.
+func (m *LoginRecordMutation) SetCity(s string) {
+	m.city = &s
+}
+
+// City returns the value of the "city" field in the mutation.
+func (m *LoginRecordMutation) City() (r string, exists bool) {
+	v := m.city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCity returns the old "city" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldCity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
+	}
+	return oldValue.City, nil
+}
+
+// ResetCity resets all changes to the "city" field.
+func (m *LoginRecordMutation) ResetCity() {
+	m.city = nil
+}
+
+// SetAsp sets the "asp" field.
+func (m *LoginRecordMutation) SetAsp(s string) {
+	m.asp = &s
+}
+
+// Asp returns the value of the "asp" field in the mutation.
+func (m *LoginRecordMutation) Asp() (r string, exists bool) {
+	v := m.asp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAsp returns the old "asp" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldAsp(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAsp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAsp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAsp: %w", err)
+	}
+	return oldValue.Asp, nil
+}
+
+// ResetAsp resets all changes to the "asp" field.
+func (m *LoginRecordMutation) ResetAsp() {
+	m.asp = nil
+}
+
+// SetIsMobile sets the "is_mobile" field.
+func (m *LoginRecordMutation) SetIsMobile(b bool) {
+	m.is_mobile = &b
+}
+
+// IsMobile returns the value of the "is_mobile" field in the mutation.
+func (m *LoginRecordMutation) IsMobile() (r bool, exists bool) {
+	v := m.is_mobile
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsMobile returns the old "is_mobile" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldIsMobile(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsMobile is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsMobile requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsMobile: %w", err)
+	}
+	return oldValue.IsMobile, nil
+}
+
+// ResetIsMobile resets all changes to the "is_mobile" field.
+func (m *LoginRecordMutation) ResetIsMobile() {
+	m.is_mobile = nil
+}
+
+// SetIsSuccess sets the "is_success" field.
+func (m *LoginRecordMutation) SetIsSuccess(b bool) {
+	m.is_success = &b
+}
+
+// IsSuccess returns the value of the "is_success" field in the mutation.
+func (m *LoginRecordMutation) IsSuccess() (r bool, exists bool) {
+	v := m.is_success
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSuccess returns the old "is_success" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldIsSuccess(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSuccess is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSuccess requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSuccess: %w", err)
+	}
+	return oldValue.IsSuccess, nil
+}
+
+// ResetIsSuccess resets all changes to the "is_success" field.
+func (m *LoginRecordMutation) ResetIsSuccess() {
+	m.is_success = nil
+}
+
+// SetErrMessage sets the "err_message" field.
+func (m *LoginRecordMutation) SetErrMessage(s string) {
+	m.err_message = &s
+}
+
+// ErrMessage returns the value of the "err_message" field in the mutation.
+func (m *LoginRecordMutation) ErrMessage() (r string, exists bool) {
+	v := m.err_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrMessage returns the old "err_message" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldErrMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrMessage: %w", err)
+	}
+	return oldValue.ErrMessage, nil
+}
+
+// ResetErrMessage resets all changes to the "err_message" field.
+func (m *LoginRecordMutation) ResetErrMessage() {
+	m.err_message = nil
+}
+
+// SetErrCode sets the "err_code" field.
+func (m *LoginRecordMutation) SetErrCode(i int) {
+	m.err_code = &i
+	m.adderr_code = nil
+}
+
+// ErrCode returns the value of the "err_code" field in the mutation.
+func (m *LoginRecordMutation) ErrCode() (r int, exists bool) {
+	v := m.err_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrCode returns the old "err_code" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldErrCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrCode: %w", err)
+	}
+	return oldValue.ErrCode, nil
+}
+
+// AddErrCode adds i to the "err_code" field.
+func (m *LoginRecordMutation) AddErrCode(i int) {
+	if m.adderr_code != nil {
+		*m.adderr_code += i
+	} else {
+		m.adderr_code = &i
+	}
+}
+
+// AddedErrCode returns the value that was added to the "err_code" field in this mutation.
+func (m *LoginRecordMutation) AddedErrCode() (r int, exists bool) {
+	v := m.adderr_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetErrCode resets all changes to the "err_code" field.
+func (m *LoginRecordMutation) ResetErrCode() {
+	m.err_code = nil
+	m.adderr_code = nil
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *LoginRecordMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *LoginRecordMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldLatitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (m *LoginRecordMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
+}
+
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *LoginRecordMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *LoginRecordMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *LoginRecordMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *LoginRecordMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the LoginRecord entity.
+// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *LoginRecordMutation) OldLongitude(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (m *LoginRecordMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
+}
+
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *LoginRecordMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *LoginRecordMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+}
+
+// SetUsersID sets the "users" edge to the User entity by id.
+func (m *LoginRecordMutation) SetUsersID(id int64) {
+	m.users = &id
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *LoginRecordMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *LoginRecordMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// UsersID returns the "users" edge ID in the mutation.
+func (m *LoginRecordMutation) UsersID() (id int64, exists bool) {
+	if m.users != nil {
+		return *m.users, true
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UsersID instead. It exists only for internal usage by the builders.
+func (m *LoginRecordMutation) UsersIDs() (ids []int64) {
+	if id := m.users; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *LoginRecordMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+}
+
+// Where appends a list predicates to the LoginRecordMutation builder.
+func (m *LoginRecordMutation) Where(ps ...predicate.LoginRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the LoginRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *LoginRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.LoginRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *LoginRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *LoginRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (LoginRecord).
+func (m *LoginRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *LoginRecordMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.created_at != nil {
+		fields = append(fields, loginrecord.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, loginrecord.FieldUpdatedAt)
+	}
+	if m.browser != nil {
+		fields = append(fields, loginrecord.FieldBrowser)
+	}
+	if m.browser_ver != nil {
+		fields = append(fields, loginrecord.FieldBrowserVer)
+	}
+	if m.ip != nil {
+		fields = append(fields, loginrecord.FieldIP)
+	}
+	if m.os != nil {
+		fields = append(fields, loginrecord.FieldOs)
+	}
+	if m.platform != nil {
+		fields = append(fields, loginrecord.FieldPlatform)
+	}
+	if m.country != nil {
+		fields = append(fields, loginrecord.FieldCountry)
+	}
+	if m.country_code != nil {
+		fields = append(fields, loginrecord.FieldCountryCode)
+	}
+	if m.city != nil {
+		fields = append(fields, loginrecord.FieldCity)
+	}
+	if m.asp != nil {
+		fields = append(fields, loginrecord.FieldAsp)
+	}
+	if m.is_mobile != nil {
+		fields = append(fields, loginrecord.FieldIsMobile)
+	}
+	if m.is_success != nil {
+		fields = append(fields, loginrecord.FieldIsSuccess)
+	}
+	if m.err_message != nil {
+		fields = append(fields, loginrecord.FieldErrMessage)
+	}
+	if m.err_code != nil {
+		fields = append(fields, loginrecord.FieldErrCode)
+	}
+	if m.latitude != nil {
+		fields = append(fields, loginrecord.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, loginrecord.FieldLongitude)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *LoginRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case loginrecord.FieldCreatedAt:
+		return m.CreatedAt()
+	case loginrecord.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case loginrecord.FieldBrowser:
+		return m.Browser()
+	case loginrecord.FieldBrowserVer:
+		return m.BrowserVer()
+	case loginrecord.FieldIP:
+		return m.IP()
+	case loginrecord.FieldOs:
+		return m.Os()
+	case loginrecord.FieldPlatform:
+		return m.Platform()
+	case loginrecord.FieldCountry:
+		return m.Country()
+	case loginrecord.FieldCountryCode:
+		return m.CountryCode()
+	case loginrecord.FieldCity:
+		return m.City()
+	case loginrecord.FieldAsp:
+		return m.Asp()
+	case loginrecord.FieldIsMobile:
+		return m.IsMobile()
+	case loginrecord.FieldIsSuccess:
+		return m.IsSuccess()
+	case loginrecord.FieldErrMessage:
+		return m.ErrMessage()
+	case loginrecord.FieldErrCode:
+		return m.ErrCode()
+	case loginrecord.FieldLatitude:
+		return m.Latitude()
+	case loginrecord.FieldLongitude:
+		return m.Longitude()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *LoginRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case loginrecord.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case loginrecord.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case loginrecord.FieldBrowser:
+		return m.OldBrowser(ctx)
+	case loginrecord.FieldBrowserVer:
+		return m.OldBrowserVer(ctx)
+	case loginrecord.FieldIP:
+		return m.OldIP(ctx)
+	case loginrecord.FieldOs:
+		return m.OldOs(ctx)
+	case loginrecord.FieldPlatform:
+		return m.OldPlatform(ctx)
+	case loginrecord.FieldCountry:
+		return m.OldCountry(ctx)
+	case loginrecord.FieldCountryCode:
+		return m.OldCountryCode(ctx)
+	case loginrecord.FieldCity:
+		return m.OldCity(ctx)
+	case loginrecord.FieldAsp:
+		return m.OldAsp(ctx)
+	case loginrecord.FieldIsMobile:
+		return m.OldIsMobile(ctx)
+	case loginrecord.FieldIsSuccess:
+		return m.OldIsSuccess(ctx)
+	case loginrecord.FieldErrMessage:
+		return m.OldErrMessage(ctx)
+	case loginrecord.FieldErrCode:
+		return m.OldErrCode(ctx)
+	case loginrecord.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case loginrecord.FieldLongitude:
+		return m.OldLongitude(ctx)
+	}
+	return nil, fmt.Errorf("unknown LoginRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case loginrecord.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case loginrecord.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case loginrecord.FieldBrowser:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrowser(v)
+		return nil
+	case loginrecord.FieldBrowserVer:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrowserVer(v)
+		return nil
+	case loginrecord.FieldIP:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case loginrecord.FieldOs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOs(v)
+		return nil
+	case loginrecord.FieldPlatform:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlatform(v)
+		return nil
+	case loginrecord.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case loginrecord.FieldCountryCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountryCode(v)
+		return nil
+	case loginrecord.FieldCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCity(v)
+		return nil
+	case loginrecord.FieldAsp:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAsp(v)
+		return nil
+	case loginrecord.FieldIsMobile:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsMobile(v)
+		return nil
+	case loginrecord.FieldIsSuccess:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSuccess(v)
+		return nil
+	case loginrecord.FieldErrMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrMessage(v)
+		return nil
+	case loginrecord.FieldErrCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrCode(v)
+		return nil
+	case loginrecord.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case loginrecord.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *LoginRecordMutation) AddedFields() []string {
+	var fields []string
+	if m.adderr_code != nil {
+		fields = append(fields, loginrecord.FieldErrCode)
+	}
+	if m.addlatitude != nil {
+		fields = append(fields, loginrecord.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, loginrecord.FieldLongitude)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *LoginRecordMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case loginrecord.FieldErrCode:
+		return m.AddedErrCode()
+	case loginrecord.FieldLatitude:
+		return m.AddedLatitude()
+	case loginrecord.FieldLongitude:
+		return m.AddedLongitude()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *LoginRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case loginrecord.FieldErrCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddErrCode(v)
+		return nil
+	case loginrecord.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case loginrecord.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	}
+	return fmt.Errorf("unknown LoginRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *LoginRecordMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *LoginRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *LoginRecordMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown LoginRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *LoginRecordMutation) ResetField(name string) error {
+	switch name {
+	case loginrecord.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case loginrecord.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case loginrecord.FieldBrowser:
+		m.ResetBrowser()
+		return nil
+	case loginrecord.FieldBrowserVer:
+		m.ResetBrowserVer()
+		return nil
+	case loginrecord.FieldIP:
+		m.ResetIP()
+		return nil
+	case loginrecord.FieldOs:
+		m.ResetOs()
+		return nil
+	case loginrecord.FieldPlatform:
+		m.ResetPlatform()
+		return nil
+	case loginrecord.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case loginrecord.FieldCountryCode:
+		m.ResetCountryCode()
+		return nil
+	case loginrecord.FieldCity:
+		m.ResetCity()
+		return nil
+	case loginrecord.FieldAsp:
+		m.ResetAsp()
+		return nil
+	case loginrecord.FieldIsMobile:
+		m.ResetIsMobile()
+		return nil
+	case loginrecord.FieldIsSuccess:
+		m.ResetIsSuccess()
+		return nil
+	case loginrecord.FieldErrMessage:
+		m.ResetErrMessage()
+		return nil
+	case loginrecord.FieldErrCode:
+		m.ResetErrCode()
+		return nil
+	case loginrecord.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case loginrecord.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *LoginRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.users != nil {
+		edges = append(edges, loginrecord.EdgeUsers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *LoginRecordMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case loginrecord.EdgeUsers:
+		if id := m.users; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *LoginRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *LoginRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *LoginRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedusers {
+		edges = append(edges, loginrecord.EdgeUsers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *LoginRecordMutation) EdgeCleared(name string) bool {
+	switch name {
+	case loginrecord.EdgeUsers:
+		return m.clearedusers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *LoginRecordMutation) ClearEdge(name string) error {
+	switch name {
+	case loginrecord.EdgeUsers:
+		m.ClearUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *LoginRecordMutation) ResetEdge(name string) error {
+	switch name {
+	case loginrecord.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	}
+	return fmt.Errorf("unknown LoginRecord edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	created_at       *time.Time
+	updated_at       *time.Time
+	event_id         *string
+	aggregate_type   *string
+	aggregate_id     *string
+	event_type       *string
+	event_version    *int
+	addevent_version *int
+	payload          *[]byte
+	published_at     *time.Time
+	attempts         *int
+	addattempts      *int
+	locked_until     *time.Time
+	last_error       *string
+	parked_at        *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*OutboxEvent, error)
+	predicates       []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id int) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OutboxEventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OutboxEventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OutboxEventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *OutboxEventMutation) SetEventID(s string) {
+	m.event_id = &s
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *OutboxEventMutation) EventID() (r string, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldEventID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *OutboxEventMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetAggregateType sets the "aggregate_type" field.
+func (m *OutboxEventMutation) SetAggregateType(s string) {
+	m.aggregate_type = &s
+}
+
+// AggregateType returns the value of the "aggregate_type" field in the mutation.
+func (m *OutboxEventMutation) AggregateType() (r string, exists bool) {
+	v := m.aggregate_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateType returns the old "aggregate_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAggregateType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateType: %w", err)
+	}
+	return oldValue.AggregateType, nil
+}
+
+// ResetAggregateType resets all changes to the "aggregate_type" field.
+func (m *OutboxEventMutation) ResetAggregateType() {
+	m.aggregate_type = nil
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (m *OutboxEventMutation) SetAggregateID(s string) {
+	m.aggregate_id = &s
+}
+
+// AggregateID returns the value of the "aggregate_id" field in the mutation.
+func (m *OutboxEventMutation) AggregateID() (r string, exists bool) {
+	v := m.aggregate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateID returns the old "aggregate_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAggregateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateID: %w", err)
+	}
+	return oldValue.AggregateID, nil
+}
+
+// ResetAggregateID resets all changes to the "aggregate_id" field.
+func (m *OutboxEventMutation) ResetAggregateID() {
+	m.aggregate_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *OutboxEventMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *OutboxEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *OutboxEventMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetEventVersion sets the "event_version" field.
+func (m *OutboxEventMutation) SetEventVersion(i int) {
+	m.event_version = &i
+	m.addevent_version = nil
+}
+
+// EventVersion returns the value of the "event_version" field in the mutation.
+func (m *OutboxEventMutation) EventVersion() (r int, exists bool) {
+	v := m.event_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventVersion returns the old "event_version" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldEventVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventVersion: %w", err)
+	}
+	return oldValue.EventVersion, nil
+}
+
+// AddEventVersion adds i to the "event_version" field.
+func (m *OutboxEventMutation) AddEventVersion(i int) {
+	if m.addevent_version != nil {
+		*m.addevent_version += i
+	} else {
+		m.addevent_version = &i
+	}
+}
+
+// AddedEventVersion returns the value that was added to the "event_version" field in this mutation.
+func (m *OutboxEventMutation) AddedEventVersion() (r int, exists bool) {
+	v := m.addevent_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventVersion resets all changes to the "event_version" field.
+func (m *OutboxEventMutation) ResetEventVersion() {
+	m.event_version = nil
+	m.addevent_version = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *OutboxEventMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *OutboxEventMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *OutboxEventMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[outboxevent.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *OutboxEventMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, outboxevent.FieldPublishedAt)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *OutboxEventMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *OutboxEventMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *OutboxEventMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[outboxevent.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *OutboxEventMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *OutboxEventMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, outboxevent.FieldLockedUntil)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+}
+
+// SetParkedAt sets the "parked_at" field.
+func (m *OutboxEventMutation) SetParkedAt(t time.Time) {
+	m.parked_at = &t
+}
+
+// ParkedAt returns the value of the "parked_at" field in the mutation.
+func (m *OutboxEventMutation) ParkedAt() (r time.Time, exists bool) {
+	v := m.parked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParkedAt returns the old "parked_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldParkedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParkedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParkedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParkedAt: %w", err)
+	}
+	return oldValue.ParkedAt, nil
+}
+
+// ClearParkedAt clears the value of the "parked_at" field.
+func (m *OutboxEventMutation) ClearParkedAt() {
+	m.parked_at = nil
+	m.clearedFields[outboxevent.FieldParkedAt] = struct{}{}
+}
+
+// ParkedAtCleared returns if the "parked_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) ParkedAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldParkedAt]
+	return ok
+}
+
+// ResetParkedAt resets all changes to the "parked_at" field.
+func (m *OutboxEventMutation) ResetParkedAt() {
+	m.parked_at = nil
+	delete(m.clearedFields, outboxevent.FieldParkedAt)
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, outboxevent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, outboxevent.FieldUpdatedAt)
+	}
+	if m.event_id != nil {
+		fields = append(fields, outboxevent.FieldEventID)
+	}
+	if m.aggregate_type != nil {
+		fields = append(fields, outboxevent.FieldAggregateType)
+	}
+	if m.aggregate_id != nil {
+		fields = append(fields, outboxevent.FieldAggregateID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, outboxevent.FieldEventType)
+	}
+	if m.event_version != nil {
+		fields = append(fields, outboxevent.FieldEventVersion)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.published_at != nil {
+		fields = append(fields, outboxevent.FieldPublishedAt)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, outboxevent.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.parked_at != nil {
+		fields = append(fields, outboxevent.FieldParkedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case outboxevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case outboxevent.FieldEventID:
+		return m.EventID()
+	case outboxevent.FieldAggregateType:
+		return m.AggregateType()
+	case outboxevent.FieldAggregateID:
+		return m.AggregateID()
+	case outboxevent.FieldEventType:
+		return m.EventType()
+	case outboxevent.FieldEventVersion:
+		return m.EventVersion()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldPublishedAt:
+		return m.PublishedAt()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldLockedUntil:
+		return m.LockedUntil()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	case outboxevent.FieldParkedAt:
+		return m.ParkedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case outboxevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case outboxevent.FieldEventID:
+		return m.OldEventID(ctx)
+	case outboxevent.FieldAggregateType:
+		return m.OldAggregateType(ctx)
+	case outboxevent.FieldAggregateID:
+		return m.OldAggregateID(ctx)
+	case outboxevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case outboxevent.FieldEventVersion:
+		return m.OldEventVersion(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxevent.FieldParkedAt:
+		return m.OldParkedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case outboxevent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case outboxevent.FieldEventID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case outboxevent.FieldAggregateType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateType(v)
+		return nil
+	case outboxevent.FieldAggregateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateID(v)
+		return nil
+	case outboxevent.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case outboxevent.FieldEventVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventVersion(v)
+		return nil
+	case outboxevent.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case outboxevent.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case outboxevent.FieldParkedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParkedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addevent_version != nil {
+		fields = append(fields, outboxevent.FieldEventVersion)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldEventVersion:
+		return m.AddedEventVersion()
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldEventVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventVersion(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldPublishedAt) {
+		fields = append(fields, outboxevent.FieldPublishedAt)
+	}
+	if m.FieldCleared(outboxevent.FieldLockedUntil) {
+		fields = append(fields, outboxevent.FieldLockedUntil)
+	}
+	if m.FieldCleared(outboxevent.FieldParkedAt) {
+		fields = append(fields, outboxevent.FieldParkedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	case outboxevent.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case outboxevent.FieldParkedAt:
+		m.ClearParkedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case outboxevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case outboxevent.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case outboxevent.FieldAggregateType:
+		m.ResetAggregateType()
+		return nil
+	case outboxevent.FieldAggregateID:
+		m.ResetAggregateID()
+		return nil
+	case outboxevent.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case outboxevent.FieldEventVersion:
+		m.ResetEventVersion()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case outboxevent.FieldParkedAt:
+		m.ResetParkedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// PasswordHistoryMutation represents an operation that mutates the PasswordHistory nodes in the graph.
+type PasswordHistoryMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	updated_at    *time.Time
+	user_id       *int64
+	adduser_id    *int64
+	password      *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PasswordHistory, error)
+	predicates    []predicate.PasswordHistory
+}
+
+var _ ent.Mutation = (*PasswordHistoryMutation)(nil)
+
+// passwordhistoryOption allows management of the mutation configuration using functional options.
+type passwordhistoryOption func(*PasswordHistoryMutation)
+
+// newPasswordHistoryMutation creates new mutation for the PasswordHistory entity.
+func newPasswordHistoryMutation(c config, op Op, opts ...passwordhistoryOption) *PasswordHistoryMutation {
+	m := &PasswordHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePasswordHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPasswordHistoryID sets the ID field of the mutation.
+func withPasswordHistoryID(id int64) passwordhistoryOption {
+	return func(m *PasswordHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PasswordHistory
+		)
+		m.oldValue = func(ctx context.Context) (*PasswordHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PasswordHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPasswordHistory sets the old PasswordHistory of the mutation.
+func withPasswordHistory(node *PasswordHistory) passwordhistoryOption {
+	return func(m *PasswordHistoryMutation) {
+		m.oldValue = func(context.Context) (*PasswordHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PasswordHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PasswordHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PasswordHistory entities.
+func (m *PasswordHistoryMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PasswordHistoryMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PasswordHistoryMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PasswordHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PasswordHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PasswordHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PasswordHistory entity.
+// If the PasswordHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PasswordHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PasswordHistoryMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PasswordHistoryMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PasswordHistory entity.
+// If the PasswordHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordHistoryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PasswordHistoryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PasswordHistoryMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PasswordHistoryMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PasswordHistory entity.
+// If the PasswordHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordHistoryMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *PasswordHistoryMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *PasswordHistoryMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PasswordHistoryMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *PasswordHistoryMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *PasswordHistoryMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the PasswordHistory entity.
+// If the PasswordHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PasswordHistoryMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *PasswordHistoryMutation) ResetPassword() {
+	m.password = nil
+}
+
+// Where appends a list predicates to the PasswordHistoryMutation builder.
+func (m *PasswordHistoryMutation) Where(ps ...predicate.PasswordHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PasswordHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PasswordHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PasswordHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PasswordHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PasswordHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PasswordHistory).
+func (m *PasswordHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PasswordHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.created_at != nil {
+		fields = append(fields, passwordhistory.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, passwordhistory.FieldUpdatedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, passwordhistory.FieldUserID)
+	}
+	if m.password != nil {
+		fields = append(fields, passwordhistory.FieldPassword)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PasswordHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case passwordhistory.FieldCreatedAt:
+		return m.CreatedAt()
+	case passwordhistory.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case passwordhistory.FieldUserID:
+		return m.UserID()
+	case passwordhistory.FieldPassword:
+		return m.Password()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PasswordHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case passwordhistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case passwordhistory.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case passwordhistory.FieldUserID:
+		return m.OldUserID(ctx)
+	case passwordhistory.FieldPassword:
+		return m.OldPassword(ctx)
+	}
+	return nil, fmt.Errorf("unknown PasswordHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case passwordhistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case passwordhistory.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case passwordhistory.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case passwordhistory.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PasswordHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, passwordhistory.FieldUserID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PasswordHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case passwordhistory.FieldUserID:
+		return m.AddedUserID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PasswordHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case passwordhistory.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PasswordHistoryMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PasswordHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PasswordHistoryMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PasswordHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PasswordHistoryMutation) ResetField(name string) error {
+	switch name {
+	case passwordhistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case passwordhistory.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case passwordhistory.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case passwordhistory.FieldPassword:
+		m.ResetPassword()
+		return nil
+	}
+	return fmt.Errorf("unknown PasswordHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PasswordHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PasswordHistoryMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PasswordHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PasswordHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PasswordHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PasswordHistoryMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PasswordHistoryMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PasswordHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PasswordHistoryMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PasswordHistory edge %s", name)
+}
+
+// RoleMutation represents an operation that mutates the Role nodes in the graph.
+type RoleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int64
+	created_at          *time.Time
+	updated_at          *time.Time
+	name                *string
+	permissions         *[]enum.Permission
+	appendpermissions   []enum.Permission
+	is_system           *bool
+	client_type         *int
+	addclient_type      *int
+	clearedFields       map[string]struct{}
+	users               map[int64]struct{}
+	removedusers        map[int64]struct{}
+	clearedusers        bool
+	auth_clients        map[int64]struct{}
+	removedauth_clients map[int64]struct{}
+	clearedauth_clients bool
+	done                bool
+	oldValue            func(context.Context) (*Role, error)
+	predicates          []predicate.Role
+}
+
+var _ ent.Mutation = (*RoleMutation)(nil)
+
+// roleOption allows management of the mutation configuration using functional options.
+type roleOption func(*RoleMutation)
+
+// newRoleMutation creates new mutation for the Role entity.
+func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
+	m := &RoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRoleID sets the ID field of the mutation.
+func withRoleID(id int64) roleOption {
+	return func(m *RoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Role
+		)
+		m.oldValue = func(ctx context.Context) (*Role, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Role.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRole sets the old Role of the mutation.
+func withRole(node *Role) roleOption {
+	return func(m *RoleMutation) {
+		m.oldValue = func(context.Context) (*Role, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Role entities.
+func (m *RoleMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RoleMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RoleMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetName sets the "name" field.
+func (m *RoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *RoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *RoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetPermissions sets the "permissions" field.
+func (m *RoleMutation) SetPermissions(e []enum.Permission) {
+	m.permissions = &e
+	m.appendpermissions = nil
+}
+
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *RoleMutation) Permissions() (r []enum.Permission, exists bool) {
+	v := m.permissions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissions returns the old "permissions" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldPermissions(ctx context.Context) (v []enum.Permission, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
+	}
+	return oldValue.Permissions, nil
+}
+
+// AppendPermissions adds e to the "permissions" field.
+func (m *RoleMutation) AppendPermissions(e []enum.Permission) {
+	m.appendpermissions = append(m.appendpermissions, e...)
+}
+
+// AppendedPermissions returns the list of values that were appended to the "permissions" field in this mutation.
+func (m *RoleMutation) AppendedPermissions() ([]enum.Permission, bool) {
+	if len(m.appendpermissions) == 0 {
+		return nil, false
+	}
+	return m.appendpermissions, true
+}
+
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *RoleMutation) ResetPermissions() {
+	m.permissions = nil
+	m.appendpermissions = nil
+}
+
+// SetIsSystem sets the "is_system" field.
+func (m *RoleMutation) SetIsSystem(b bool) {
+	m.is_system = &b
+}
+
+// IsSystem returns the value of the "is_system" field in the mutation.
+func (m *RoleMutation) IsSystem() (r bool, exists bool) {
+	v := m.is_system
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsSystem returns the old "is_system" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+	}
+	return oldValue.IsSystem, nil
+}
+
+// ResetIsSystem resets all changes to the "is_system" field.
+func (m *RoleMutation) ResetIsSystem() {
+	m.is_system = nil
+}
+
+// SetClientType sets the "client_type" field.
+func (m *RoleMutation) SetClientType(i int) {
+	m.client_type = &i
+	m.addclient_type = nil
+}
+
+// ClientType returns the value of the "client_type" field in the mutation.
+func (m *RoleMutation) ClientType() (r int, exists bool) {
+	v := m.client_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientType returns the old "client_type" field's value of the Role entity.
+// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RoleMutation) OldClientType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientType: %w", err)
+	}
+	return oldValue.ClientType, nil
+}
+
+// AddClientType adds i to the "client_type" field.
+func (m *RoleMutation) AddClientType(i int) {
+	if m.addclient_type != nil {
+		*m.addclient_type += i
+	} else {
+		m.addclient_type = &i
+	}
+}
+
+// AddedClientType returns the value that was added to the "client_type" field in this mutation.
+func (m *RoleMutation) AddedClientType() (r int, exists bool) {
+	v := m.addclient_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetClientType resets all changes to the "client_type" field.
+func (m *RoleMutation) ResetClientType() {
+	m.client_type = nil
+	m.addclient_type = nil
+}
+
+// AddUserIDs adds the "users" edge to the User entity by ids.
+func (m *RoleMutation) AddUserIDs(ids ...int64) {
+	if m.users == nil {
+		m.users = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.users[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUsers clears the "users" edge to the User entity.
+func (m *RoleMutation) ClearUsers() {
+	m.clearedusers = true
+}
+
+// UsersCleared reports if the "users" edge to the User entity was cleared.
+func (m *RoleMutation) UsersCleared() bool {
+	return m.clearedusers
+}
+
+// RemoveUserIDs removes the "users" edge to the User entity by IDs.
+func (m *RoleMutation) RemoveUserIDs(ids ...int64) {
+	if m.removedusers == nil {
+		m.removedusers = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.users, ids[i])
+		m.removedusers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
+func (m *RoleMutation) RemovedUsersIDs() (ids []int64) {
+	for id := range m.removedusers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UsersIDs returns the "users" edge IDs in the mutation.
+func (m *RoleMutation) UsersIDs() (ids []int64) {
+	for id := range m.users {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUsers resets all changes to the "users" edge.
+func (m *RoleMutation) ResetUsers() {
+	m.users = nil
+	m.clearedusers = false
+	m.removedusers = nil
+}
+
+// AddAuthClientIDs adds the "auth_clients" edge to the AuthClient entity by ids.
+func (m *RoleMutation) AddAuthClientIDs(ids ...int64) {
+	if m.auth_clients == nil {
+		m.auth_clients = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.auth_clients[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAuthClients clears the "auth_clients" edge to the AuthClient entity.
+func (m *RoleMutation) ClearAuthClients() {
+	m.clearedauth_clients = true
+}
+
+// AuthClientsCleared reports if the "auth_clients" edge to the AuthClient entity was cleared.
+func (m *RoleMutation) AuthClientsCleared() bool {
+	return m.clearedauth_clients
+}
+
+// RemoveAuthClientIDs removes the "auth_clients" edge to the AuthClient entity by IDs.
+func (m *RoleMutation) RemoveAuthClientIDs(ids ...int64) {
+	if m.removedauth_clients == nil {
+		m.removedauth_clients = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.auth_clients, ids[i])
+		m.removedauth_clients[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAuthClients returns the removed IDs of the "auth_clients" edge to the AuthClient entity.
+func (m *RoleMutation) RemovedAuthClientsIDs() (ids []int64) {
+	for id := range m.removedauth_clients {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AuthClientsIDs returns the "auth_clients" edge IDs in the mutation.
+func (m *RoleMutation) AuthClientsIDs() (ids []int64) {
+	for id := range m.auth_clients {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAuthClients resets all changes to the "auth_clients" edge.
+func (m *RoleMutation) ResetAuthClients() {
+	m.auth_clients = nil
+	m.clearedauth_clients = false
+	m.removedauth_clients = nil
+}
+
+// Where appends a list predicates to the RoleMutation builder.
+func (m *RoleMutation) Where(ps ...predicate.Role) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Role, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Role).
+func (m *RoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RoleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, role.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, role.FieldUpdatedAt)
+	}
+	if m.name != nil {
+		fields = append(fields, role.FieldName)
+	}
+	if m.permissions != nil {
+		fields = append(fields, role.FieldPermissions)
+	}
+	if m.is_system != nil {
+		fields = append(fields, role.FieldIsSystem)
+	}
+	if m.client_type != nil {
+		fields = append(fields, role.FieldClientType)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldCreatedAt:
+		return m.CreatedAt()
+	case role.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case role.FieldName:
+		return m.Name()
+	case role.FieldPermissions:
+		return m.Permissions()
+	case role.FieldIsSystem:
+		return m.IsSystem()
+	case role.FieldClientType:
+		return m.ClientType()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case role.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case role.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case role.FieldName:
+		return m.OldName(ctx)
+	case role.FieldPermissions:
+		return m.OldPermissions(ctx)
+	case role.FieldIsSystem:
+		return m.OldIsSystem(ctx)
+	case role.FieldClientType:
+		return m.OldClientType(ctx)
+	}
+	return nil, fmt.Errorf("unknown Role field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case role.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case role.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case role.FieldPermissions:
+		v, ok := value.([]enum.Permission)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissions(v)
+		return nil
+	case role.FieldIsSystem:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsSystem(v)
+		return nil
+	case role.FieldClientType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RoleMutation) AddedFields() []string {
+	var fields []string
+	if m.addclient_type != nil {
+		fields = append(fields, role.FieldClientType)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case role.FieldClientType:
+		return m.AddedClientType()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case role.FieldClientType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClientType(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Role numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RoleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RoleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Role nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RoleMutation) ResetField(name string) error {
+	switch name {
+	case role.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case role.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case role.FieldName:
+		m.ResetName()
+		return nil
+	case role.FieldPermissions:
+		m.ResetPermissions()
+		return nil
+	case role.FieldIsSystem:
+		m.ResetIsSystem()
+		return nil
+	case role.FieldClientType:
+		m.ResetClientType()
+		return nil
+	}
+	return fmt.Errorf("unknown Role field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.users != nil {
+		edges = append(edges, role.EdgeUsers)
+	}
+	if m.auth_clients != nil {
+		edges = append(edges, role.EdgeAuthClients)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.users))
+		for id := range m.users {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeAuthClients:
+		ids := make([]ent.Value, 0, len(m.auth_clients))
+		for id := range m.auth_clients {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedusers != nil {
+		edges = append(edges, role.EdgeUsers)
+	}
+	if m.removedauth_clients != nil {
+		edges = append(edges, role.EdgeAuthClients)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case role.EdgeUsers:
+		ids := make([]ent.Value, 0, len(m.removedusers))
+		for id := range m.removedusers {
+			ids = append(ids, id)
+		}
+		return ids
+	case role.EdgeAuthClients:
+		ids := make([]ent.Value, 0, len(m.removedauth_clients))
+		for id := range m.removedauth_clients {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedusers {
+		edges = append(edges, role.EdgeUsers)
+	}
+	if m.clearedauth_clients {
+		edges = append(edges, role.EdgeAuthClients)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case role.EdgeUsers:
+		return m.clearedusers
+	case role.EdgeAuthClients:
+		return m.clearedauth_clients
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RoleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Role unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RoleMutation) ResetEdge(name string) error {
+	switch name {
+	case role.EdgeUsers:
+		m.ResetUsers()
+		return nil
+	case role.EdgeAuthClients:
+		m.ResetAuthClients()
+		return nil
+	}
+	return fmt.Errorf("unknown Role edge %s", name)
+}
+
+// SigningKeyMutation represents an operation that mutates the SigningKey nodes in the graph.
+type SigningKeyMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int64
+	created_at        *time.Time
+	updated_at        *time.Time
+	kid               *string
+	signing_method    *int
+	addsigning_method *int
+	private_key       *string
+	public_key        *string
+	active            *bool
+	rotated_at        *time.Time
+	expire_at         *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*SigningKey, error)
+	predicates        []predicate.SigningKey
+}
+
+var _ ent.Mutation = (*SigningKeyMutation)(nil)
+
+// signingkeyOption allows management of the mutation configuration using functional options.
+type signingkeyOption func(*SigningKeyMutation)
+
+// newSigningKeyMutation creates new mutation for the SigningKey entity.
+func newSigningKeyMutation(c config, op Op, opts ...signingkeyOption) *SigningKeyMutation {
+	m := &SigningKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSigningKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSigningKeyID sets the ID field of the mutation.
+func withSigningKeyID(id int64) signingkeyOption {
+	return func(m *SigningKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SigningKey
+		)
+		m.oldValue = func(ctx context.Context) (*SigningKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SigningKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSigningKey sets the old SigningKey of the mutation.
+func withSigningKey(node *SigningKey) signingkeyOption {
+	return func(m *SigningKeyMutation) {
+		m.oldValue = func(context.Context) (*SigningKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SigningKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SigningKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SigningKey entities.
+func (m *SigningKeyMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SigningKeyMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SigningKeyMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SigningKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SigningKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SigningKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SigningKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *SigningKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *SigningKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *SigningKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetKid sets the "kid" field.
+func (m *SigningKeyMutation) SetKid(s string) {
+	m.kid = &s
+}
+
+// Kid returns the value of the "kid" field in the mutation.
+func (m *SigningKeyMutation) Kid() (r string, exists bool) {
+	v := m.kid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKid returns the old "kid" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldKid(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKid: %w", err)
+	}
+	return oldValue.Kid, nil
+}
+
+// ResetKid resets all changes to the "kid" field.
+func (m *SigningKeyMutation) ResetKid() {
+	m.kid = nil
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (m *SigningKeyMutation) SetSigningMethod(i int) {
+	m.signing_method = &i
+	m.addsigning_method = nil
+}
+
+// SigningMethod returns the value of the "signing_method" field in the mutation.
+func (m *SigningKeyMutation) SigningMethod() (r int, exists bool) {
+	v := m.signing_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSigningMethod returns the old "signing_method" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldSigningMethod(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSigningMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSigningMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSigningMethod: %w", err)
+	}
+	return oldValue.SigningMethod, nil
+}
+
+// AddSigningMethod adds i to the "signing_method" field.
+func (m *SigningKeyMutation) AddSigningMethod(i int) {
+	if m.addsigning_method != nil {
+		*m.addsigning_method += i
+	} else {
+		m.addsigning_method = &i
+	}
+}
+
+// AddedSigningMethod returns the value that was added to the "signing_method" field in this mutation.
+func (m *SigningKeyMutation) AddedSigningMethod() (r int, exists bool) {
+	v := m.addsigning_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetSigningMethod resets all changes to the "signing_method" field.
+func (m *SigningKeyMutation) ResetSigningMethod() {
+	m.signing_method = nil
+	m.addsigning_method = nil
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (m *SigningKeyMutation) SetPrivateKey(s string) {
+	m.private_key = &s
+}
+
+// PrivateKey returns the value of the "private_key" field in the mutation.
+func (m *SigningKeyMutation) PrivateKey() (r string, exists bool) {
+	v := m.private_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrivateKey returns the old "private_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldPrivateKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrivateKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrivateKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrivateKey: %w", err)
+	}
+	return oldValue.PrivateKey, nil
+}
+
+// ResetPrivateKey resets all changes to the "private_key" field.
+func (m *SigningKeyMutation) ResetPrivateKey() {
+	m.private_key = nil
+}
+
+// SetPublicKey sets the "public_key" field.
+func (m *SigningKeyMutation) SetPublicKey(s string) {
+	m.public_key = &s
+}
+
+// PublicKey returns the value of the "public_key" field in the mutation.
+func (m *SigningKeyMutation) PublicKey() (r string, exists bool) {
+	v := m.public_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicKey returns the old "public_key" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldPublicKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicKey: %w", err)
+	}
+	return oldValue.PublicKey, nil
+}
+
+// ResetPublicKey resets all changes to the "public_key" field.
+func (m *SigningKeyMutation) ResetPublicKey() {
+	m.public_key = nil
+}
+
+// SetActive sets the "active" field.
+func (m *SigningKeyMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *SigningKeyMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *SigningKeyMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (m *SigningKeyMutation) SetRotatedAt(t time.Time) {
+	m.rotated_at = &t
+}
+
+// RotatedAt returns the value of the "rotated_at" field in the mutation.
+func (m *SigningKeyMutation) RotatedAt() (r time.Time, exists bool) {
+	v := m.rotated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRotatedAt returns the old "rotated_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldRotatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRotatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRotatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRotatedAt: %w", err)
+	}
+	return oldValue.RotatedAt, nil
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (m *SigningKeyMutation) ClearRotatedAt() {
+	m.rotated_at = nil
+	m.clearedFields[signingkey.FieldRotatedAt] = struct{}{}
+}
+
+// RotatedAtCleared returns if the "rotated_at" field was cleared in this mutation.
+func (m *SigningKeyMutation) RotatedAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldRotatedAt]
+	return ok
+}
+
+// ResetRotatedAt resets all changes to the "rotated_at" field.
+func (m *SigningKeyMutation) ResetRotatedAt() {
+	m.rotated_at = nil
+	delete(m.clearedFields, signingkey.FieldRotatedAt)
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (m *SigningKeyMutation) SetExpireAt(t time.Time) {
+	m.expire_at = &t
+}
+
+// ExpireAt returns the value of the "expire_at" field in the mutation.
+func (m *SigningKeyMutation) ExpireAt() (r time.Time, exists bool) {
+	v := m.expire_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpireAt returns the old "expire_at" field's value of the SigningKey entity.
+// If the SigningKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SigningKeyMutation) OldExpireAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpireAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpireAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpireAt: %w", err)
+	}
+	return oldValue.ExpireAt, nil
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (m *SigningKeyMutation) ClearExpireAt() {
+	m.expire_at = nil
+	m.clearedFields[signingkey.FieldExpireAt] = struct{}{}
+}
+
+// ExpireAtCleared returns if the "expire_at" field was cleared in this mutation.
+func (m *SigningKeyMutation) ExpireAtCleared() bool {
+	_, ok := m.clearedFields[signingkey.FieldExpireAt]
+	return ok
+}
+
+// ResetExpireAt resets all changes to the "expire_at" field.
+func (m *SigningKeyMutation) ResetExpireAt() {
+	m.expire_at = nil
+	delete(m.clearedFields, signingkey.FieldExpireAt)
+}
+
+// Where appends a list predicates to the SigningKeyMutation builder.
+func (m *SigningKeyMutation) Where(ps ...predicate.SigningKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SigningKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SigningKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SigningKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SigningKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SigningKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SigningKey).
+func (m *SigningKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SigningKeyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, signingkey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, signingkey.FieldUpdatedAt)
+	}
+	if m.kid != nil {
+		fields = append(fields, signingkey.FieldKid)
+	}
+	if m.signing_method != nil {
+		fields = append(fields, signingkey.FieldSigningMethod)
+	}
+	if m.private_key != nil {
+		fields = append(fields, signingkey.FieldPrivateKey)
+	}
+	if m.public_key != nil {
+		fields = append(fields, signingkey.FieldPublicKey)
+	}
+	if m.active != nil {
+		fields = append(fields, signingkey.FieldActive)
+	}
+	if m.rotated_at != nil {
+		fields = append(fields, signingkey.FieldRotatedAt)
+	}
+	if m.expire_at != nil {
+		fields = append(fields, signingkey.FieldExpireAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SigningKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case signingkey.FieldCreatedAt:
+		return m.CreatedAt()
+	case signingkey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case signingkey.FieldKid:
+		return m.Kid()
+	case signingkey.FieldSigningMethod:
+		return m.SigningMethod()
+	case signingkey.FieldPrivateKey:
+		return m.PrivateKey()
+	case signingkey.FieldPublicKey:
+		return m.PublicKey()
+	case signingkey.FieldActive:
+		return m.Active()
+	case signingkey.FieldRotatedAt:
+		return m.RotatedAt()
+	case signingkey.FieldExpireAt:
+		return m.ExpireAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AuthClientMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SigningKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case authclient.FieldCreatedAt:
+	case signingkey.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case authclient.FieldUpdatedAt:
+	case signingkey.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case authclient.FieldClientType:
-		return m.OldClientType(ctx)
-	case authclient.FieldMerchantID:
-		return m.OldMerchantID(ctx)
-	case authclient.FieldSecret:
-		return m.OldSecret(ctx)
-	case authclient.FieldActive:
+	case signingkey.FieldKid:
+		return m.OldKid(ctx)
+	case signingkey.FieldSigningMethod:
+		return m.OldSigningMethod(ctx)
+	case signingkey.FieldPrivateKey:
+		return m.OldPrivateKey(ctx)
+	case signingkey.FieldPublicKey:
+		return m.OldPublicKey(ctx)
+	case signingkey.FieldActive:
 		return m.OldActive(ctx)
-	case authclient.FieldTokenExpireSecs:
-		return m.OldTokenExpireSecs(ctx)
-	case authclient.FieldLoginFailedTimes:
-		return m.OldLoginFailedTimes(ctx)
+	case signingkey.FieldRotatedAt:
+		return m.OldRotatedAt(ctx)
+	case signingkey.FieldExpireAt:
+		return m.OldExpireAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown AuthClient field %s", name)
+	return nil, fmt.Errorf("unknown SigningKey field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthClientMutation) SetField(name string, value ent.Value) error {
+func (m *SigningKeyMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case authclient.FieldCreatedAt:
+	case signingkey.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case authclient.FieldUpdatedAt:
+	case signingkey.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case authclient.FieldClientType:
+	case signingkey.FieldKid:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKid(v)
+		return nil
+	case signingkey.FieldSigningMethod:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientType(v)
+		m.SetSigningMethod(v)
 		return nil
-	case authclient.FieldMerchantID:
-		v, ok := value.(int64)
+	case signingkey.FieldPrivateKey:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMerchantID(v)
+		m.SetPrivateKey(v)
 		return nil
-	case authclient.FieldSecret:
+	case signingkey.FieldPublicKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSecret(v)
+		m.SetPublicKey(v)
 		return nil
-	case authclient.FieldActive:
+	case signingkey.FieldActive:
 		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetActive(v)
 		return nil
-	case authclient.FieldTokenExpireSecs:
-		v, ok := value.(int)
+	case signingkey.FieldRotatedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTokenExpireSecs(v)
+		m.SetRotatedAt(v)
 		return nil
-	case authclient.FieldLoginFailedTimes:
-		v, ok := value.(int)
+	case signingkey.FieldExpireAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLoginFailedTimes(v)
+		m.SetExpireAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AuthClient field %s", name)
+	return fmt.Errorf("unknown SigningKey field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AuthClientMutation) AddedFields() []string {
+func (m *SigningKeyMutation) AddedFields() []string {
 	var fields []string
-	if m.addclient_type != nil {
-		fields = append(fields, authclient.FieldClientType)
-	}
-	if m.addmerchant_id != nil {
-		fields = append(fields, authclient.FieldMerchantID)
-	}
-	if m.addtoken_expire_secs != nil {
-		fields = append(fields, authclient.FieldTokenExpireSecs)
-	}
-	if m.addlogin_failed_times != nil {
-		fields = append(fields, authclient.FieldLoginFailedTimes)
+	if m.addsigning_method != nil {
+		fields = append(fields, signingkey.FieldSigningMethod)
 	}
 	return fields
 }
@@ -843,16 +6789,10 @@ func (m *AuthClientMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AuthClientMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SigningKeyMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case authclient.FieldClientType:
-		return m.AddedClientType()
-	case authclient.FieldMerchantID:
-		return m.AddedMerchantID()
-	case authclient.FieldTokenExpireSecs:
-		return m.AddedTokenExpireSecs()
-	case authclient.FieldLoginFailedTimes:
-		return m.AddedLoginFailedTimes()
+	case signingkey.FieldSigningMethod:
+		return m.AddedSigningMethod()
 	}
 	return nil, false
 }
@@ -860,885 +6800,1392 @@ func (m *AuthClientMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AuthClientMutation) AddField(name string, value ent.Value) error {
+func (m *SigningKeyMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case authclient.FieldClientType:
+	case signingkey.FieldSigningMethod:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddClientType(v)
+		m.AddSigningMethod(v)
 		return nil
-	case authclient.FieldMerchantID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddMerchantID(v)
+	}
+	return fmt.Errorf("unknown SigningKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SigningKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(signingkey.FieldRotatedAt) {
+		fields = append(fields, signingkey.FieldRotatedAt)
+	}
+	if m.FieldCleared(signingkey.FieldExpireAt) {
+		fields = append(fields, signingkey.FieldExpireAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SigningKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SigningKeyMutation) ClearField(name string) error {
+	switch name {
+	case signingkey.FieldRotatedAt:
+		m.ClearRotatedAt()
 		return nil
-	case authclient.FieldTokenExpireSecs:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTokenExpireSecs(v)
+	case signingkey.FieldExpireAt:
+		m.ClearExpireAt()
 		return nil
-	case authclient.FieldLoginFailedTimes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
+	}
+	return fmt.Errorf("unknown SigningKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SigningKeyMutation) ResetField(name string) error {
+	switch name {
+	case signingkey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case signingkey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case signingkey.FieldKid:
+		m.ResetKid()
+		return nil
+	case signingkey.FieldSigningMethod:
+		m.ResetSigningMethod()
+		return nil
+	case signingkey.FieldPrivateKey:
+		m.ResetPrivateKey()
+		return nil
+	case signingkey.FieldPublicKey:
+		m.ResetPublicKey()
+		return nil
+	case signingkey.FieldActive:
+		m.ResetActive()
+		return nil
+	case signingkey.FieldRotatedAt:
+		m.ResetRotatedAt()
+		return nil
+	case signingkey.FieldExpireAt:
+		m.ResetExpireAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SigningKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SigningKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SigningKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SigningKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SigningKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SigningKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SigningKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SigningKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SigningKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown SigningKey edge %s", name)
+}
+
+// TokenRevocationMutation represents an operation that mutates the TokenRevocation nodes in the graph.
+type TokenRevocationMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int64
+	created_at       *time.Time
+	updated_at       *time.Time
+	client_id        *int64
+	addclient_id     *int64
+	user_id          *int64
+	adduser_id       *int64
+	scope            *string
+	reason           *string
+	operator_id      *int64
+	addoperator_id   *int64
+	revoked_count    *int
+	addrevoked_count *int
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*TokenRevocation, error)
+	predicates       []predicate.TokenRevocation
+}
+
+var _ ent.Mutation = (*TokenRevocationMutation)(nil)
+
+// tokenrevocationOption allows management of the mutation configuration using functional options.
+type tokenrevocationOption func(*TokenRevocationMutation)
+
+// newTokenRevocationMutation creates new mutation for the TokenRevocation entity.
+func newTokenRevocationMutation(c config, op Op, opts ...tokenrevocationOption) *TokenRevocationMutation {
+	m := &TokenRevocationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenRevocation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenRevocationID sets the ID field of the mutation.
+func withTokenRevocationID(id int64) tokenrevocationOption {
+	return func(m *TokenRevocationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TokenRevocation
+		)
+		m.oldValue = func(ctx context.Context) (*TokenRevocation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TokenRevocation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTokenRevocation sets the old TokenRevocation of the mutation.
+func withTokenRevocation(node *TokenRevocation) tokenrevocationOption {
+	return func(m *TokenRevocationMutation) {
+		m.oldValue = func(context.Context) (*TokenRevocation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenRevocationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenRevocationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TokenRevocation entities.
+func (m *TokenRevocationMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenRevocationMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenRevocationMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
 		}
-		m.AddLoginFailedTimes(v)
-		return nil
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TokenRevocation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return fmt.Errorf("unknown AuthClient numeric field %s", name)
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *AuthClientMutation) ClearedFields() []string {
-	return nil
+// SetCreatedAt sets the "created_at" field.
+func (m *TokenRevocationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *AuthClientMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TokenRevocationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *AuthClientMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown AuthClient nullable field %s", name)
+// OldCreatedAt returns the old "created_at" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *AuthClientMutation) ResetField(name string) error {
-	switch name {
-	case authclient.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case authclient.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case authclient.FieldClientType:
-		m.ResetClientType()
-		return nil
-	case authclient.FieldMerchantID:
-		m.ResetMerchantID()
-		return nil
-	case authclient.FieldSecret:
-		m.ResetSecret()
-		return nil
-	case authclient.FieldActive:
-		m.ResetActive()
-		return nil
-	case authclient.FieldTokenExpireSecs:
-		m.ResetTokenExpireSecs()
-		return nil
-	case authclient.FieldLoginFailedTimes:
-		m.ResetLoginFailedTimes()
-		return nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TokenRevocationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TokenRevocationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TokenRevocationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown AuthClient field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AuthClientMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, authclient.EdgeUsers)
+// OldUpdatedAt returns the old "updated_at" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.roles != nil {
-		edges = append(edges, authclient.EdgeRoles)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *AuthClientMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case authclient.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
-		}
-		return ids
-	case authclient.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.roles))
-		for id := range m.roles {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TokenRevocationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *TokenRevocationMutation) SetClientID(i int64) {
+	m.client_id = &i
+	m.addclient_id = nil
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *TokenRevocationMutation) ClientID() (r int64, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AuthClientMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedusers != nil {
-		edges = append(edges, authclient.EdgeUsers)
+// OldClientID returns the old "client_id" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldClientID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
-	if m.removedroles != nil {
-		edges = append(edges, authclient.EdgeRoles)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *AuthClientMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case authclient.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case authclient.EdgeRoles:
-		ids := make([]ent.Value, 0, len(m.removedroles))
-		for id := range m.removedroles {
-			ids = append(ids, id)
-		}
-		return ids
+// AddClientID adds i to the "client_id" field.
+func (m *TokenRevocationMutation) AddClientID(i int64) {
+	if m.addclient_id != nil {
+		*m.addclient_id += i
+	} else {
+		m.addclient_id = &i
 	}
-	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AuthClientMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, authclient.EdgeUsers)
-	}
-	if m.clearedroles {
-		edges = append(edges, authclient.EdgeRoles)
+// AddedClientID returns the value that was added to the "client_id" field in this mutation.
+func (m *TokenRevocationMutation) AddedClientID() (r int64, exists bool) {
+	v := m.addclient_id
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *AuthClientMutation) EdgeCleared(name string) bool {
-	switch name {
-	case authclient.EdgeUsers:
-		return m.clearedusers
-	case authclient.EdgeRoles:
-		return m.clearedroles
+// ResetClientID resets all changes to the "client_id" field.
+func (m *TokenRevocationMutation) ResetClientID() {
+	m.client_id = nil
+	m.addclient_id = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TokenRevocationMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TokenRevocationMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
 	}
-	return false
+	return *v, true
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *AuthClientMutation) ClearEdge(name string) error {
-	switch name {
+// OldUserID returns the old "user_id" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldUserID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown AuthClient unique edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *AuthClientMutation) ResetEdge(name string) error {
-	switch name {
-	case authclient.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	case authclient.EdgeRoles:
-		m.ResetRoles()
-		return nil
+// AddUserID adds i to the "user_id" field.
+func (m *TokenRevocationMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
 	}
-	return fmt.Errorf("unknown AuthClient edge %s", name)
 }
 
-// LoginRecordMutation represents an operation that mutates the LoginRecord nodes in the graph.
-type LoginRecordMutation struct {
-	config
-	op            Op
-	typ           string
-	id            *int64
-	created_at    *time.Time
-	updated_at    *time.Time
-	browser       *string
-	browser_ver   *string
-	ip            *string
-	os            *string
-	platform      *string
-	country       *string
-	country_code  *string
-	city          *string
-	asp           *string
-	is_mobile     *bool
-	is_success    *bool
-	err_message   *string
-	clearedFields map[string]struct{}
-	users         *int64
-	clearedusers  bool
-	done          bool
-	oldValue      func(context.Context) (*LoginRecord, error)
-	predicates    []predicate.LoginRecord
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *TokenRevocationMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *TokenRevocationMutation) ClearUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	m.clearedFields[tokenrevocation.FieldUserID] = struct{}{}
 }
 
-var _ ent.Mutation = (*LoginRecordMutation)(nil)
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *TokenRevocationMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[tokenrevocation.FieldUserID]
+	return ok
+}
 
-// loginrecordOption allows management of the mutation configuration using functional options.
-type loginrecordOption func(*LoginRecordMutation)
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TokenRevocationMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+	delete(m.clearedFields, tokenrevocation.FieldUserID)
+}
 
-// newLoginRecordMutation creates new mutation for the LoginRecord entity.
-func newLoginRecordMutation(c config, op Op, opts ...loginrecordOption) *LoginRecordMutation {
-	m := &LoginRecordMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeLoginRecord,
-		clearedFields: make(map[string]struct{}),
+// SetScope sets the "scope" field.
+func (m *TokenRevocationMutation) SetScope(s string) {
+	m.scope = &s
+}
+
+// Scope returns the value of the "scope" field in the mutation.
+func (m *TokenRevocationMutation) Scope() (r string, exists bool) {
+	v := m.scope
+	if v == nil {
+		return
 	}
-	for _, opt := range opts {
-		opt(m)
+	return *v, true
+}
+
+// OldScope returns the old "scope" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldScope(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldScope is only allowed on UpdateOne operations")
 	}
-	return m
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldScope requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldScope: %w", err)
+	}
+	return oldValue.Scope, nil
 }
 
-// withLoginRecordID sets the ID field of the mutation.
-func withLoginRecordID(id int64) loginrecordOption {
-	return func(m *LoginRecordMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *LoginRecord
-		)
-		m.oldValue = func(ctx context.Context) (*LoginRecord, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().LoginRecord.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetScope resets all changes to the "scope" field.
+func (m *TokenRevocationMutation) ResetScope() {
+	m.scope = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *TokenRevocationMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *TokenRevocationMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withLoginRecord sets the old LoginRecord of the mutation.
-func withLoginRecord(node *LoginRecord) loginrecordOption {
-	return func(m *LoginRecordMutation) {
-		m.oldValue = func(context.Context) (*LoginRecord, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldReason returns the old "reason" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m LoginRecordMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetReason resets all changes to the "reason" field.
+func (m *TokenRevocationMutation) ResetReason() {
+	m.reason = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m LoginRecordMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// SetOperatorID sets the "operator_id" field.
+func (m *TokenRevocationMutation) SetOperatorID(i int64) {
+	m.operator_id = &i
+	m.addoperator_id = nil
+}
+
+// OperatorID returns the value of the "operator_id" field in the mutation.
+func (m *TokenRevocationMutation) OperatorID() (r int64, exists bool) {
+	v := m.operator_id
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of LoginRecord entities.
-func (m *LoginRecordMutation) SetID(id int64) {
-	m.id = &id
+// OldOperatorID returns the old "operator_id" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenRevocationMutation) OldOperatorID(ctx context.Context) (v *int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorID: %w", err)
+	}
+	return oldValue.OperatorID, nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *LoginRecordMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
-		return
+// AddOperatorID adds i to the "operator_id" field.
+func (m *TokenRevocationMutation) AddOperatorID(i int64) {
+	if m.addoperator_id != nil {
+		*m.addoperator_id += i
+	} else {
+		m.addoperator_id = &i
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *LoginRecordMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().LoginRecord.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// AddedOperatorID returns the value that was added to the "operator_id" field in this mutation.
+func (m *TokenRevocationMutation) AddedOperatorID() (r int64, exists bool) {
+	v := m.addoperator_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *LoginRecordMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ClearOperatorID clears the value of the "operator_id" field.
+func (m *TokenRevocationMutation) ClearOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
+	m.clearedFields[tokenrevocation.FieldOperatorID] = struct{}{}
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *LoginRecordMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// OperatorIDCleared returns if the "operator_id" field was cleared in this mutation.
+func (m *TokenRevocationMutation) OperatorIDCleared() bool {
+	_, ok := m.clearedFields[tokenrevocation.FieldOperatorID]
+	return ok
+}
+
+// ResetOperatorID resets all changes to the "operator_id" field.
+func (m *TokenRevocationMutation) ResetOperatorID() {
+	m.operator_id = nil
+	m.addoperator_id = nil
+	delete(m.clearedFields, tokenrevocation.FieldOperatorID)
+}
+
+// SetRevokedCount sets the "revoked_count" field.
+func (m *TokenRevocationMutation) SetRevokedCount(i int) {
+	m.revoked_count = &i
+	m.addrevoked_count = nil
+}
+
+// RevokedCount returns the value of the "revoked_count" field in the mutation.
+func (m *TokenRevocationMutation) RevokedCount() (r int, exists bool) {
+	v := m.revoked_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedCount returns the old "revoked_count" field's value of the TokenRevocation entity.
+// If the TokenRevocation object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TokenRevocationMutation) OldRevokedCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRevokedCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedCount: %w", err)
+	}
+	return oldValue.RevokedCount, nil
+}
+
+// AddRevokedCount adds i to the "revoked_count" field.
+func (m *TokenRevocationMutation) AddRevokedCount(i int) {
+	if m.addrevoked_count != nil {
+		*m.addrevoked_count += i
+	} else {
+		m.addrevoked_count = &i
+	}
+}
+
+// AddedRevokedCount returns the value that was added to the "revoked_count" field in this mutation.
+func (m *TokenRevocationMutation) AddedRevokedCount() (r int, exists bool) {
+	v := m.addrevoked_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevokedCount resets all changes to the "revoked_count" field.
+func (m *TokenRevocationMutation) ResetRevokedCount() {
+	m.revoked_count = nil
+	m.addrevoked_count = nil
+}
+
+// Where appends a list predicates to the TokenRevocationMutation builder.
+func (m *TokenRevocationMutation) Where(ps ...predicate.TokenRevocation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TokenRevocationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenRevocationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenRevocation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TokenRevocationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TokenRevocationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TokenRevocation).
+func (m *TokenRevocationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenRevocationMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.created_at != nil {
+		fields = append(fields, tokenrevocation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, tokenrevocation.FieldUpdatedAt)
+	}
+	if m.client_id != nil {
+		fields = append(fields, tokenrevocation.FieldClientID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, tokenrevocation.FieldUserID)
+	}
+	if m.scope != nil {
+		fields = append(fields, tokenrevocation.FieldScope)
+	}
+	if m.reason != nil {
+		fields = append(fields, tokenrevocation.FieldReason)
+	}
+	if m.operator_id != nil {
+		fields = append(fields, tokenrevocation.FieldOperatorID)
+	}
+	if m.revoked_count != nil {
+		fields = append(fields, tokenrevocation.FieldRevokedCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenRevocationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tokenrevocation.FieldCreatedAt:
+		return m.CreatedAt()
+	case tokenrevocation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case tokenrevocation.FieldClientID:
+		return m.ClientID()
+	case tokenrevocation.FieldUserID:
+		return m.UserID()
+	case tokenrevocation.FieldScope:
+		return m.Scope()
+	case tokenrevocation.FieldReason:
+		return m.Reason()
+	case tokenrevocation.FieldOperatorID:
+		return m.OperatorID()
+	case tokenrevocation.FieldRevokedCount:
+		return m.RevokedCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenRevocationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tokenrevocation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case tokenrevocation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case tokenrevocation.FieldClientID:
+		return m.OldClientID(ctx)
+	case tokenrevocation.FieldUserID:
+		return m.OldUserID(ctx)
+	case tokenrevocation.FieldScope:
+		return m.OldScope(ctx)
+	case tokenrevocation.FieldReason:
+		return m.OldReason(ctx)
+	case tokenrevocation.FieldOperatorID:
+		return m.OldOperatorID(ctx)
+	case tokenrevocation.FieldRevokedCount:
+		return m.OldRevokedCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown TokenRevocation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenRevocationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tokenrevocation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case tokenrevocation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case tokenrevocation.FieldClientID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case tokenrevocation.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case tokenrevocation.FieldScope:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScope(v)
+		return nil
+	case tokenrevocation.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case tokenrevocation.FieldOperatorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperatorID(v)
+		return nil
+	case tokenrevocation.FieldRevokedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedCount(v)
+		return nil
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *LoginRecordMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetUpdatedAt sets the "updated_at" field.
-func (m *LoginRecordMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+	return fmt.Errorf("unknown TokenRevocation field %s", name)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *LoginRecordMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenRevocationMutation) AddedFields() []string {
+	var fields []string
+	if m.addclient_id != nil {
+		fields = append(fields, tokenrevocation.FieldClientID)
 	}
-	return *v, true
-}
-
-// OldUpdatedAt returns the old "updated_at" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	if m.adduser_id != nil {
+		fields = append(fields, tokenrevocation.FieldUserID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	if m.addoperator_id != nil {
+		fields = append(fields, tokenrevocation.FieldOperatorID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	if m.addrevoked_count != nil {
+		fields = append(fields, tokenrevocation.FieldRevokedCount)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *LoginRecordMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return fields
 }
 
-// SetBrowser sets the "browser" field.
-func (m *LoginRecordMutation) SetBrowser(s string) {
-	m.browser = &s
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenRevocationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tokenrevocation.FieldClientID:
+		return m.AddedClientID()
+	case tokenrevocation.FieldUserID:
+		return m.AddedUserID()
+	case tokenrevocation.FieldOperatorID:
+		return m.AddedOperatorID()
+	case tokenrevocation.FieldRevokedCount:
+		return m.AddedRevokedCount()
+	}
+	return nil, false
 }
 
-// Browser returns the value of the "browser" field in the mutation.
-func (m *LoginRecordMutation) Browser() (r string, exists bool) {
-	v := m.browser
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenRevocationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tokenrevocation.FieldClientID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClientID(v)
+		return nil
+	case tokenrevocation.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case tokenrevocation.FieldOperatorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOperatorID(v)
+		return nil
+	case tokenrevocation.FieldRevokedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevokedCount(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TokenRevocation numeric field %s", name)
 }
 
-// OldBrowser returns the old "browser" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldBrowser(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBrowser is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBrowser requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenRevocationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tokenrevocation.FieldUserID) {
+		fields = append(fields, tokenrevocation.FieldUserID)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBrowser: %w", err)
+	if m.FieldCleared(tokenrevocation.FieldOperatorID) {
+		fields = append(fields, tokenrevocation.FieldOperatorID)
 	}
-	return oldValue.Browser, nil
-}
-
-// ResetBrowser resets all changes to the "browser" field.
-func (m *LoginRecordMutation) ResetBrowser() {
-	m.browser = nil
+	return fields
 }
 
-// SetBrowserVer sets the "browser_ver" field.
-func (m *LoginRecordMutation) SetBrowserVer(s string) {
-	m.browser_ver = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenRevocationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// BrowserVer returns the value of the "browser_ver" field in the mutation.
-func (m *LoginRecordMutation) BrowserVer() (r string, exists bool) {
-	v := m.browser_ver
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenRevocationMutation) ClearField(name string) error {
+	switch name {
+	case tokenrevocation.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case tokenrevocation.FieldOperatorID:
+		m.ClearOperatorID()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TokenRevocation nullable field %s", name)
 }
 
-// OldBrowserVer returns the old "browser_ver" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldBrowserVer(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBrowserVer is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBrowserVer requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBrowserVer: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenRevocationMutation) ResetField(name string) error {
+	switch name {
+	case tokenrevocation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case tokenrevocation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case tokenrevocation.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case tokenrevocation.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case tokenrevocation.FieldScope:
+		m.ResetScope()
+		return nil
+	case tokenrevocation.FieldReason:
+		m.ResetReason()
+		return nil
+	case tokenrevocation.FieldOperatorID:
+		m.ResetOperatorID()
+		return nil
+	case tokenrevocation.FieldRevokedCount:
+		m.ResetRevokedCount()
+		return nil
 	}
-	return oldValue.BrowserVer, nil
+	return fmt.Errorf("unknown TokenRevocation field %s", name)
 }
 
-// ResetBrowserVer resets all changes to the "browser_ver" field.
-func (m *LoginRecordMutation) ResetBrowserVer() {
-	m.browser_ver = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenRevocationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetIP sets the "ip" field.
-func (m *LoginRecordMutation) SetIP(s string) {
-	m.ip = &s
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenRevocationMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// IP returns the value of the "ip" field in the mutation.
-func (m *LoginRecordMutation) IP() (r string, exists bool) {
-	v := m.ip
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenRevocationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldIP returns the old "ip" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldIP(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIP is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIP requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIP: %w", err)
-	}
-	return oldValue.IP, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenRevocationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetIP resets all changes to the "ip" field.
-func (m *LoginRecordMutation) ResetIP() {
-	m.ip = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenRevocationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetOs sets the "os" field.
-func (m *LoginRecordMutation) SetOs(s string) {
-	m.os = &s
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenRevocationMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// Os returns the value of the "os" field in the mutation.
-func (m *LoginRecordMutation) Os() (r string, exists bool) {
-	v := m.os
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenRevocationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TokenRevocation unique edge %s", name)
 }
 
-// OldOs returns the old "os" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldOs(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOs is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOs requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOs: %w", err)
-	}
-	return oldValue.Os, nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenRevocationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TokenRevocation edge %s", name)
 }
 
-// ResetOs resets all changes to the "os" field.
-func (m *LoginRecordMutation) ResetOs() {
-	m.os = nil
+// TrustedDeviceMutation represents an operation that mutates the TrustedDevice nodes in the graph.
+type TrustedDeviceMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int64
+	created_at    *time.Time
+	updated_at    *time.Time
+	user_id       *int64
+	adduser_id    *int64
+	browser       *string
+	city          *string
+	country_code  *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*TrustedDevice, error)
+	predicates    []predicate.TrustedDevice
 }
 
-// SetPlatform sets the "platform" field.
-func (m *LoginRecordMutation) SetPlatform(s string) {
-	m.platform = &s
+var _ ent.Mutation = (*TrustedDeviceMutation)(nil)
+
+// trusteddeviceOption allows management of the mutation configuration using functional options.
+type trusteddeviceOption func(*TrustedDeviceMutation)
+
+// newTrustedDeviceMutation creates new mutation for the TrustedDevice entity.
+func newTrustedDeviceMutation(c config, op Op, opts ...trusteddeviceOption) *TrustedDeviceMutation {
+	m := &TrustedDeviceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTrustedDevice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Platform returns the value of the "platform" field in the mutation.
-func (m *LoginRecordMutation) Platform() (r string, exists bool) {
-	v := m.platform
-	if v == nil {
-		return
+// withTrustedDeviceID sets the ID field of the mutation.
+func withTrustedDeviceID(id int64) trusteddeviceOption {
+	return func(m *TrustedDeviceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TrustedDevice
+		)
+		m.oldValue = func(ctx context.Context) (*TrustedDevice, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TrustedDevice.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldPlatform returns the old "platform" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldPlatform(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPlatform is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPlatform requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPlatform: %w", err)
+// withTrustedDevice sets the old TrustedDevice of the mutation.
+func withTrustedDevice(node *TrustedDevice) trusteddeviceOption {
+	return func(m *TrustedDeviceMutation) {
+		m.oldValue = func(context.Context) (*TrustedDevice, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.Platform, nil
 }
 
-// ResetPlatform resets all changes to the "platform" field.
-func (m *LoginRecordMutation) ResetPlatform() {
-	m.platform = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TrustedDeviceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// SetCountry sets the "country" field.
-func (m *LoginRecordMutation) SetCountry(s string) {
-	m.country = &s
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TrustedDeviceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Country returns the value of the "country" field in the mutation.
-func (m *LoginRecordMutation) Country() (r string, exists bool) {
-	v := m.country
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TrustedDevice entities.
+func (m *TrustedDeviceMutation) SetID(id int64) {
+	m.id = &id
 }
 
-// OldCountry returns the old "country" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldCountry(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCountry requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TrustedDeviceMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.Country, nil
+	return *m.id, true
 }
 
-// ResetCountry resets all changes to the "country" field.
-func (m *LoginRecordMutation) ResetCountry() {
-	m.country = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TrustedDeviceMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TrustedDevice.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCountryCode sets the "country_code" field.
-func (m *LoginRecordMutation) SetCountryCode(s string) {
-	m.country_code = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *TrustedDeviceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// CountryCode returns the value of the "country_code" field in the mutation.
-func (m *LoginRecordMutation) CountryCode() (r string, exists bool) {
-	v := m.country_code
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TrustedDeviceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCountryCode returns the old "country_code" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the TrustedDevice entity.
+// If the TrustedDevice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldCountryCode(ctx context.Context) (v string, err error) {
+func (m *TrustedDeviceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCountryCode is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCountryCode requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCountryCode: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.CountryCode, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetCountryCode resets all changes to the "country_code" field.
-func (m *LoginRecordMutation) ResetCountryCode() {
-	m.country_code = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TrustedDeviceMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetCity sets the "city" field.
-func (m *LoginRecordMutation) SetCity(s string) {
-	m.city = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TrustedDeviceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// City returns the value of the "city" field in the mutation.
-func (m *LoginRecordMutation) City() (r string, exists bool) {
-	v := m.city
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TrustedDeviceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCity returns the old "city" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the TrustedDevice entity.
+// If the TrustedDevice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldCity(ctx context.Context) (v string, err error) {
+func (m *TrustedDeviceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCity is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCity requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCity: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.City, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetCity resets all changes to the "city" field.
-func (m *LoginRecordMutation) ResetCity() {
-	m.city = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TrustedDeviceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetAsp sets the "asp" field.
-func (m *LoginRecordMutation) SetAsp(s string) {
-	m.asp = &s
+// SetUserID sets the "user_id" field.
+func (m *TrustedDeviceMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Asp returns the value of the "asp" field in the mutation.
-func (m *LoginRecordMutation) Asp() (r string, exists bool) {
-	v := m.asp
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TrustedDeviceMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAsp returns the old "asp" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the TrustedDevice entity.
+// If the TrustedDevice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldAsp(ctx context.Context) (v string, err error) {
+func (m *TrustedDeviceMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAsp is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAsp requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAsp: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Asp, nil
-}
-
-// ResetAsp resets all changes to the "asp" field.
-func (m *LoginRecordMutation) ResetAsp() {
-	m.asp = nil
+	return oldValue.UserID, nil
 }
 
-// SetIsMobile sets the "is_mobile" field.
-func (m *LoginRecordMutation) SetIsMobile(b bool) {
-	m.is_mobile = &b
+// AddUserID adds i to the "user_id" field.
+func (m *TrustedDeviceMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// IsMobile returns the value of the "is_mobile" field in the mutation.
-func (m *LoginRecordMutation) IsMobile() (r bool, exists bool) {
-	v := m.is_mobile
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *TrustedDeviceMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsMobile returns the old "is_mobile" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldIsMobile(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsMobile is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsMobile requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsMobile: %w", err)
-	}
-	return oldValue.IsMobile, nil
-}
-
-// ResetIsMobile resets all changes to the "is_mobile" field.
-func (m *LoginRecordMutation) ResetIsMobile() {
-	m.is_mobile = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TrustedDeviceMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetIsSuccess sets the "is_success" field.
-func (m *LoginRecordMutation) SetIsSuccess(b bool) {
-	m.is_success = &b
+// SetBrowser sets the "browser" field.
+func (m *TrustedDeviceMutation) SetBrowser(s string) {
+	m.browser = &s
 }
 
-// IsSuccess returns the value of the "is_success" field in the mutation.
-func (m *LoginRecordMutation) IsSuccess() (r bool, exists bool) {
-	v := m.is_success
+// Browser returns the value of the "browser" field in the mutation.
+func (m *TrustedDeviceMutation) Browser() (r string, exists bool) {
+	v := m.browser
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSuccess returns the old "is_success" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldBrowser returns the old "browser" field's value of the TrustedDevice entity.
+// If the TrustedDevice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldIsSuccess(ctx context.Context) (v bool, err error) {
+func (m *TrustedDeviceMutation) OldBrowser(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSuccess is only allowed on UpdateOne operations")
+		return v, errors.New("OldBrowser is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSuccess requires an ID field in the mutation")
+		return v, errors.New("OldBrowser requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSuccess: %w", err)
+		return v, fmt.Errorf("querying old value for OldBrowser: %w", err)
 	}
-	return oldValue.IsSuccess, nil
+	return oldValue.Browser, nil
 }
 
-// ResetIsSuccess resets all changes to the "is_success" field.
-func (m *LoginRecordMutation) ResetIsSuccess() {
-	m.is_success = nil
+// ResetBrowser resets all changes to the "browser" field.
+func (m *TrustedDeviceMutation) ResetBrowser() {
+	m.browser = nil
 }
 
-// SetErrMessage sets the "err_message" field.
-func (m *LoginRecordMutation) SetErrMessage(s string) {
-	m.err_message = &s
+// SetCity sets the "city" field.
+func (m *TrustedDeviceMutation) SetCity(s string) {
+	m.city = &s
 }
 
-// ErrMessage returns the value of the "err_message" field in the mutation.
-func (m *LoginRecordMutation) ErrMessage() (r string, exists bool) {
-	v := m.err_message
+// City returns the value of the "city" field in the mutation.
+func (m *TrustedDeviceMutation) City() (r string, exists bool) {
+	v := m.city
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldErrMessage returns the old "err_message" field's value of the LoginRecord entity.
-// If the LoginRecord object wasn't provided to the builder, the object is fetched from the database.
+// OldCity returns the old "city" field's value of the TrustedDevice entity.
+// If the TrustedDevice object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *LoginRecordMutation) OldErrMessage(ctx context.Context) (v string, err error) {
+func (m *TrustedDeviceMutation) OldCity(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrMessage is only allowed on UpdateOne operations")
+		return v, errors.New("OldCity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrMessage requires an ID field in the mutation")
+		return v, errors.New("OldCity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrMessage: %w", err)
+		return v, fmt.Errorf("querying old value for OldCity: %w", err)
 	}
-	return oldValue.ErrMessage, nil
-}
-
-// ResetErrMessage resets all changes to the "err_message" field.
-func (m *LoginRecordMutation) ResetErrMessage() {
-	m.err_message = nil
-}
-
-// SetUsersID sets the "users" edge to the User entity by id.
-func (m *LoginRecordMutation) SetUsersID(id int64) {
-	m.users = &id
+	return oldValue.City, nil
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *LoginRecordMutation) ClearUsers() {
-	m.clearedusers = true
+// ResetCity resets all changes to the "city" field.
+func (m *TrustedDeviceMutation) ResetCity() {
+	m.city = nil
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *LoginRecordMutation) UsersCleared() bool {
-	return m.clearedusers
+// SetCountryCode sets the "country_code" field.
+func (m *TrustedDeviceMutation) SetCountryCode(s string) {
+	m.country_code = &s
 }
 
-// UsersID returns the "users" edge ID in the mutation.
-func (m *LoginRecordMutation) UsersID() (id int64, exists bool) {
-	if m.users != nil {
-		return *m.users, true
+// CountryCode returns the value of the "country_code" field in the mutation.
+func (m *TrustedDeviceMutation) CountryCode() (r string, exists bool) {
+	v := m.country_code
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UsersID instead. It exists only for internal usage by the builders.
-func (m *LoginRecordMutation) UsersIDs() (ids []int64) {
-	if id := m.users; id != nil {
-		ids = append(ids, *id)
+// OldCountryCode returns the old "country_code" field's value of the TrustedDevice entity.
+// If the TrustedDevice object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TrustedDeviceMutation) OldCountryCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountryCode is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountryCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountryCode: %w", err)
+	}
+	return oldValue.CountryCode, nil
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *LoginRecordMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
+// ResetCountryCode resets all changes to the "country_code" field.
+func (m *TrustedDeviceMutation) ResetCountryCode() {
+	m.country_code = nil
 }
 
-// Where appends a list predicates to the LoginRecordMutation builder.
-func (m *LoginRecordMutation) Where(ps ...predicate.LoginRecord) {
+// Where appends a list predicates to the TrustedDeviceMutation builder.
+func (m *TrustedDeviceMutation) Where(ps ...predicate.TrustedDevice) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the LoginRecordMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TrustedDeviceMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *LoginRecordMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.LoginRecord, len(ps))
+func (m *TrustedDeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TrustedDevice, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1746,66 +8193,42 @@ func (m *LoginRecordMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *LoginRecordMutation) Op() Op {
+func (m *TrustedDeviceMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *LoginRecordMutation) SetOp(op Op) {
+func (m *TrustedDeviceMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (LoginRecord).
-func (m *LoginRecordMutation) Type() string {
+// Type returns the node type of this mutation (TrustedDevice).
+func (m *TrustedDeviceMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *LoginRecordMutation) Fields() []string {
-	fields := make([]string, 0, 14)
+func (m *TrustedDeviceMutation) Fields() []string {
+	fields := make([]string, 0, 6)
 	if m.created_at != nil {
-		fields = append(fields, loginrecord.FieldCreatedAt)
+		fields = append(fields, trusteddevice.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, loginrecord.FieldUpdatedAt)
-	}
-	if m.browser != nil {
-		fields = append(fields, loginrecord.FieldBrowser)
-	}
-	if m.browser_ver != nil {
-		fields = append(fields, loginrecord.FieldBrowserVer)
-	}
-	if m.ip != nil {
-		fields = append(fields, loginrecord.FieldIP)
-	}
-	if m.os != nil {
-		fields = append(fields, loginrecord.FieldOs)
-	}
-	if m.platform != nil {
-		fields = append(fields, loginrecord.FieldPlatform)
+		fields = append(fields, trusteddevice.FieldUpdatedAt)
 	}
-	if m.country != nil {
-		fields = append(fields, loginrecord.FieldCountry)
+	if m.user_id != nil {
+		fields = append(fields, trusteddevice.FieldUserID)
 	}
-	if m.country_code != nil {
-		fields = append(fields, loginrecord.FieldCountryCode)
+	if m.browser != nil {
+		fields = append(fields, trusteddevice.FieldBrowser)
 	}
 	if m.city != nil {
-		fields = append(fields, loginrecord.FieldCity)
-	}
-	if m.asp != nil {
-		fields = append(fields, loginrecord.FieldAsp)
-	}
-	if m.is_mobile != nil {
-		fields = append(fields, loginrecord.FieldIsMobile)
+		fields = append(fields, trusteddevice.FieldCity)
 	}
-	if m.is_success != nil {
-		fields = append(fields, loginrecord.FieldIsSuccess)
-	}
-	if m.err_message != nil {
-		fields = append(fields, loginrecord.FieldErrMessage)
+	if m.country_code != nil {
+		fields = append(fields, trusteddevice.FieldCountryCode)
 	}
 	return fields
 }
@@ -1813,36 +8236,20 @@ func (m *LoginRecordMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *LoginRecordMutation) Field(name string) (ent.Value, bool) {
+func (m *TrustedDeviceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case loginrecord.FieldCreatedAt:
+	case trusteddevice.FieldCreatedAt:
 		return m.CreatedAt()
-	case loginrecord.FieldUpdatedAt:
+	case trusteddevice.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case loginrecord.FieldBrowser:
+	case trusteddevice.FieldUserID:
+		return m.UserID()
+	case trusteddevice.FieldBrowser:
 		return m.Browser()
-	case loginrecord.FieldBrowserVer:
-		return m.BrowserVer()
-	case loginrecord.FieldIP:
-		return m.IP()
-	case loginrecord.FieldOs:
-		return m.Os()
-	case loginrecord.FieldPlatform:
-		return m.Platform()
-	case loginrecord.FieldCountry:
-		return m.Country()
-	case loginrecord.FieldCountryCode:
-		return m.CountryCode()
-	case loginrecord.FieldCity:
+	case trusteddevice.FieldCity:
 		return m.City()
-	case loginrecord.FieldAsp:
-		return m.Asp()
-	case loginrecord.FieldIsMobile:
-		return m.IsMobile()
-	case loginrecord.FieldIsSuccess:
-		return m.IsSuccess()
-	case loginrecord.FieldErrMessage:
-		return m.ErrMessage()
+	case trusteddevice.FieldCountryCode:
+		return m.CountryCode()
 	}
 	return nil, false
 }
@@ -1850,349 +8257,247 @@ func (m *LoginRecordMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *LoginRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TrustedDeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case loginrecord.FieldCreatedAt:
+	case trusteddevice.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case loginrecord.FieldUpdatedAt:
+	case trusteddevice.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case loginrecord.FieldBrowser:
+	case trusteddevice.FieldUserID:
+		return m.OldUserID(ctx)
+	case trusteddevice.FieldBrowser:
 		return m.OldBrowser(ctx)
-	case loginrecord.FieldBrowserVer:
-		return m.OldBrowserVer(ctx)
-	case loginrecord.FieldIP:
-		return m.OldIP(ctx)
-	case loginrecord.FieldOs:
-		return m.OldOs(ctx)
-	case loginrecord.FieldPlatform:
-		return m.OldPlatform(ctx)
-	case loginrecord.FieldCountry:
-		return m.OldCountry(ctx)
-	case loginrecord.FieldCountryCode:
-		return m.OldCountryCode(ctx)
-	case loginrecord.FieldCity:
+	case trusteddevice.FieldCity:
 		return m.OldCity(ctx)
-	case loginrecord.FieldAsp:
-		return m.OldAsp(ctx)
-	case loginrecord.FieldIsMobile:
-		return m.OldIsMobile(ctx)
-	case loginrecord.FieldIsSuccess:
-		return m.OldIsSuccess(ctx)
-	case loginrecord.FieldErrMessage:
-		return m.OldErrMessage(ctx)
+	case trusteddevice.FieldCountryCode:
+		return m.OldCountryCode(ctx)
 	}
-	return nil, fmt.Errorf("unknown LoginRecord field %s", name)
+	return nil, fmt.Errorf("unknown TrustedDevice field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LoginRecordMutation) SetField(name string, value ent.Value) error {
+func (m *TrustedDeviceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case loginrecord.FieldCreatedAt:
+	case trusteddevice.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case loginrecord.FieldUpdatedAt:
+	case trusteddevice.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case loginrecord.FieldBrowser:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBrowser(v)
-		return nil
-	case loginrecord.FieldBrowserVer:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBrowserVer(v)
-		return nil
-	case loginrecord.FieldIP:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIP(v)
-		return nil
-	case loginrecord.FieldOs:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOs(v)
-		return nil
-	case loginrecord.FieldPlatform:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPlatform(v)
-		return nil
-	case loginrecord.FieldCountry:
-		v, ok := value.(string)
+	case trusteddevice.FieldUserID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCountry(v)
+		m.SetUserID(v)
 		return nil
-	case loginrecord.FieldCountryCode:
+	case trusteddevice.FieldBrowser:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCountryCode(v)
+		m.SetBrowser(v)
 		return nil
-	case loginrecord.FieldCity:
+	case trusteddevice.FieldCity:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCity(v)
 		return nil
-	case loginrecord.FieldAsp:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAsp(v)
-		return nil
-	case loginrecord.FieldIsMobile:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsMobile(v)
-		return nil
-	case loginrecord.FieldIsSuccess:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsSuccess(v)
-		return nil
-	case loginrecord.FieldErrMessage:
+	case trusteddevice.FieldCountryCode:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetErrMessage(v)
+		m.SetCountryCode(v)
 		return nil
 	}
-	return fmt.Errorf("unknown LoginRecord field %s", name)
+	return fmt.Errorf("unknown TrustedDevice field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *LoginRecordMutation) AddedFields() []string {
-	return nil
+func (m *TrustedDeviceMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, trusteddevice.FieldUserID)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *LoginRecordMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TrustedDeviceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case trusteddevice.FieldUserID:
+		return m.AddedUserID()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *LoginRecordMutation) AddField(name string, value ent.Value) error {
+func (m *TrustedDeviceMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case trusteddevice.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
 	}
-	return fmt.Errorf("unknown LoginRecord numeric field %s", name)
+	return fmt.Errorf("unknown TrustedDevice numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *LoginRecordMutation) ClearedFields() []string {
+func (m *TrustedDeviceMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *LoginRecordMutation) FieldCleared(name string) bool {
+func (m *TrustedDeviceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *LoginRecordMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown LoginRecord nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *LoginRecordMutation) ResetField(name string) error {
-	switch name {
-	case loginrecord.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case loginrecord.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case loginrecord.FieldBrowser:
-		m.ResetBrowser()
-		return nil
-	case loginrecord.FieldBrowserVer:
-		m.ResetBrowserVer()
-		return nil
-	case loginrecord.FieldIP:
-		m.ResetIP()
-		return nil
-	case loginrecord.FieldOs:
-		m.ResetOs()
+func (m *TrustedDeviceMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown TrustedDevice nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TrustedDeviceMutation) ResetField(name string) error {
+	switch name {
+	case trusteddevice.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case loginrecord.FieldPlatform:
-		m.ResetPlatform()
+	case trusteddevice.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
-	case loginrecord.FieldCountry:
-		m.ResetCountry()
+	case trusteddevice.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case loginrecord.FieldCountryCode:
-		m.ResetCountryCode()
+	case trusteddevice.FieldBrowser:
+		m.ResetBrowser()
 		return nil
-	case loginrecord.FieldCity:
+	case trusteddevice.FieldCity:
 		m.ResetCity()
 		return nil
-	case loginrecord.FieldAsp:
-		m.ResetAsp()
-		return nil
-	case loginrecord.FieldIsMobile:
-		m.ResetIsMobile()
-		return nil
-	case loginrecord.FieldIsSuccess:
-		m.ResetIsSuccess()
-		return nil
-	case loginrecord.FieldErrMessage:
-		m.ResetErrMessage()
+	case trusteddevice.FieldCountryCode:
+		m.ResetCountryCode()
 		return nil
 	}
-	return fmt.Errorf("unknown LoginRecord field %s", name)
+	return fmt.Errorf("unknown TrustedDevice field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *LoginRecordMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.users != nil {
-		edges = append(edges, loginrecord.EdgeUsers)
-	}
+func (m *TrustedDeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *LoginRecordMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case loginrecord.EdgeUsers:
-		if id := m.users; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *TrustedDeviceMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *LoginRecordMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *TrustedDeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *LoginRecordMutation) RemovedIDs(name string) []ent.Value {
+func (m *TrustedDeviceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *LoginRecordMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedusers {
-		edges = append(edges, loginrecord.EdgeUsers)
-	}
+func (m *TrustedDeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *LoginRecordMutation) EdgeCleared(name string) bool {
-	switch name {
-	case loginrecord.EdgeUsers:
-		return m.clearedusers
-	}
+func (m *TrustedDeviceMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *LoginRecordMutation) ClearEdge(name string) error {
-	switch name {
-	case loginrecord.EdgeUsers:
-		m.ClearUsers()
-		return nil
-	}
-	return fmt.Errorf("unknown LoginRecord unique edge %s", name)
+func (m *TrustedDeviceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TrustedDevice unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *LoginRecordMutation) ResetEdge(name string) error {
-	switch name {
-	case loginrecord.EdgeUsers:
-		m.ResetUsers()
-		return nil
-	}
-	return fmt.Errorf("unknown LoginRecord edge %s", name)
+func (m *TrustedDeviceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TrustedDevice edge %s", name)
 }
 
-// RoleMutation represents an operation that mutates the Role nodes in the graph.
-type RoleMutation struct {
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int64
-	created_at          *time.Time
-	updated_at          *time.Time
-	name                *string
-	permissions         *[]enum.Permission
-	appendpermissions   []enum.Permission
-	is_system           *bool
-	client_type         *int
-	addclient_type      *int
-	clearedFields       map[string]struct{}
-	users               map[int64]struct{}
-	removedusers        map[int64]struct{}
-	clearedusers        bool
-	auth_clients        map[int64]struct{}
-	removedauth_clients map[int64]struct{}
-	clearedauth_clients bool
-	done                bool
-	oldValue            func(context.Context) (*Role, error)
-	predicates          []predicate.Role
+	op                     Op
+	typ                    string
+	id                     *int64
+	created_at             *time.Time
+	updated_at             *time.Time
+	account                *string
+	password               *string
+	password_fail_times    *int
+	addpassword_fail_times *int
+	status                 *int
+	addstatus              *int
+	password_changed_at    *time.Time
+	locked_until           *time.Time
+	lock_count             *int
+	addlock_count          *int
+	clearedFields          map[string]struct{}
+	auth_clients           *int64
+	clearedauth_clients    bool
+	login_records          map[int64]struct{}
+	removedlogin_records   map[int64]struct{}
+	clearedlogin_records   bool
+	roles                  *int64
+	clearedroles           bool
+	done                   bool
+	oldValue               func(context.Context) (*User, error)
+	predicates             []predicate.User
 }
 
-var _ ent.Mutation = (*RoleMutation)(nil)
+var _ ent.Mutation = (*UserMutation)(nil)
 
-// roleOption allows management of the mutation configuration using functional options.
-type roleOption func(*RoleMutation)
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
 
-// newRoleMutation creates new mutation for the Role entity.
-func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
-	m := &RoleMutation{
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeRole,
+		typ:           TypeUser,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2201,20 +8506,20 @@ func newRoleMutation(c config, op Op, opts ...roleOption) *RoleMutation {
 	return m
 }
 
-// withRoleID sets the ID field of the mutation.
-func withRoleID(id int64) roleOption {
-	return func(m *RoleMutation) {
+// withUserID sets the ID field of the mutation.
+func withUserID(id int64) userOption {
+	return func(m *UserMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Role
+			value *User
 		)
-		m.oldValue = func(ctx context.Context) (*Role, error) {
+		m.oldValue = func(ctx context.Context) (*User, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Role.Get(ctx, id)
+					value, err = m.Client().User.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2223,10 +8528,10 @@ func withRoleID(id int64) roleOption {
 	}
 }
 
-// withRole sets the old Role of the mutation.
-func withRole(node *Role) roleOption {
-	return func(m *RoleMutation) {
-		m.oldValue = func(context.Context) (*Role, error) {
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2235,7 +8540,7 @@ func withRole(node *Role) roleOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m RoleMutation) Client() *Client {
+func (m UserMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2243,7 +8548,7 @@ func (m RoleMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m RoleMutation) Tx() (*Tx, error) {
+func (m UserMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2253,407 +8558,590 @@ func (m RoleMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Role entities.
-func (m *RoleMutation) SetID(id int64) {
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id int64) {
 	m.id = &id
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *RoleMutation) ID() (id int64, exists bool) {
-	if m.id == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *UserMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *UserMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *RoleMutation) IDs(ctx context.Context) ([]int64, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int64{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Role.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldAccount returns the old "account" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *RoleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetAccount resets all changes to the "account" field.
+func (m *UserMutation) ResetAccount() {
+	m.account = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *RoleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPassword requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Password, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *RoleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *RoleMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetPasswordFailTimes sets the "password_fail_times" field.
+func (m *UserMutation) SetPasswordFailTimes(i int) {
+	m.password_fail_times = &i
+	m.addpassword_fail_times = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *RoleMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// PasswordFailTimes returns the value of the "password_fail_times" field in the mutation.
+func (m *UserMutation) PasswordFailTimes() (r int, exists bool) {
+	v := m.password_fail_times
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordFailTimes returns the old "password_fail_times" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserMutation) OldPasswordFailTimes(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordFailTimes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldPasswordFailTimes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordFailTimes: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.PasswordFailTimes, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *RoleMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// AddPasswordFailTimes adds i to the "password_fail_times" field.
+func (m *UserMutation) AddPasswordFailTimes(i int) {
+	if m.addpassword_fail_times != nil {
+		*m.addpassword_fail_times += i
+	} else {
+		m.addpassword_fail_times = &i
+	}
 }
 
-// SetName sets the "name" field.
-func (m *RoleMutation) SetName(s string) {
-	m.name = &s
+// AddedPasswordFailTimes returns the value that was added to the "password_fail_times" field in this mutation.
+func (m *UserMutation) AddedPasswordFailTimes() (r int, exists bool) {
+	v := m.addpassword_fail_times
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *RoleMutation) Name() (r string, exists bool) {
-	v := m.name
+// ResetPasswordFailTimes resets all changes to the "password_fail_times" field.
+func (m *UserMutation) ResetPasswordFailTimes() {
+	m.password_fail_times = nil
+	m.addpassword_fail_times = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *UserMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *UserMutation) Status() (r int, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *UserMutation) OldStatus(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Status, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *RoleMutation) ResetName() {
-	m.name = nil
+// AddStatus adds i to the "status" field.
+func (m *UserMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
 }
 
-// SetPermissions sets the "permissions" field.
-func (m *RoleMutation) SetPermissions(e []enum.Permission) {
-	m.permissions = &e
-	m.appendpermissions = nil
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *UserMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Permissions returns the value of the "permissions" field in the mutation.
-func (m *RoleMutation) Permissions() (r []enum.Permission, exists bool) {
-	v := m.permissions
+// ResetStatus resets all changes to the "status" field.
+func (m *UserMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (m *UserMutation) SetPasswordChangedAt(t time.Time) {
+	m.password_changed_at = &t
+}
+
+// PasswordChangedAt returns the value of the "password_changed_at" field in the mutation.
+func (m *UserMutation) PasswordChangedAt() (r time.Time, exists bool) {
+	v := m.password_changed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPermissions returns the old "permissions" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldPasswordChangedAt returns the old "password_changed_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldPermissions(ctx context.Context) (v []enum.Permission, err error) {
+func (m *UserMutation) OldPasswordChangedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
+		return v, errors.New("OldPasswordChangedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPermissions requires an ID field in the mutation")
+		return v, errors.New("OldPasswordChangedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
+		return v, fmt.Errorf("querying old value for OldPasswordChangedAt: %w", err)
 	}
-	return oldValue.Permissions, nil
+	return oldValue.PasswordChangedAt, nil
 }
 
-// AppendPermissions adds e to the "permissions" field.
-func (m *RoleMutation) AppendPermissions(e []enum.Permission) {
-	m.appendpermissions = append(m.appendpermissions, e...)
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (m *UserMutation) ClearPasswordChangedAt() {
+	m.password_changed_at = nil
+	m.clearedFields[user.FieldPasswordChangedAt] = struct{}{}
 }
 
-// AppendedPermissions returns the list of values that were appended to the "permissions" field in this mutation.
-func (m *RoleMutation) AppendedPermissions() ([]enum.Permission, bool) {
-	if len(m.appendpermissions) == 0 {
-		return nil, false
-	}
-	return m.appendpermissions, true
+// PasswordChangedAtCleared returns if the "password_changed_at" field was cleared in this mutation.
+func (m *UserMutation) PasswordChangedAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordChangedAt]
+	return ok
 }
 
-// ResetPermissions resets all changes to the "permissions" field.
-func (m *RoleMutation) ResetPermissions() {
-	m.permissions = nil
-	m.appendpermissions = nil
+// ResetPasswordChangedAt resets all changes to the "password_changed_at" field.
+func (m *UserMutation) ResetPasswordChangedAt() {
+	m.password_changed_at = nil
+	delete(m.clearedFields, user.FieldPasswordChangedAt)
 }
 
-// SetIsSystem sets the "is_system" field.
-func (m *RoleMutation) SetIsSystem(b bool) {
-	m.is_system = &b
+// SetLockedUntil sets the "locked_until" field.
+func (m *UserMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
 }
 
-// IsSystem returns the value of the "is_system" field in the mutation.
-func (m *RoleMutation) IsSystem() (r bool, exists bool) {
-	v := m.is_system
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *UserMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsSystem returns the old "is_system" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldLockedUntil returns the old "locked_until" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldIsSystem(ctx context.Context) (v bool, err error) {
+func (m *UserMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsSystem is only allowed on UpdateOne operations")
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsSystem requires an ID field in the mutation")
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsSystem: %w", err)
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
 	}
-	return oldValue.IsSystem, nil
+	return oldValue.LockedUntil, nil
 }
 
-// ResetIsSystem resets all changes to the "is_system" field.
-func (m *RoleMutation) ResetIsSystem() {
-	m.is_system = nil
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *UserMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[user.FieldLockedUntil] = struct{}{}
 }
 
-// SetClientType sets the "client_type" field.
-func (m *RoleMutation) SetClientType(i int) {
-	m.client_type = &i
-	m.addclient_type = nil
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *UserMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[user.FieldLockedUntil]
+	return ok
 }
 
-// ClientType returns the value of the "client_type" field in the mutation.
-func (m *RoleMutation) ClientType() (r int, exists bool) {
-	v := m.client_type
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *UserMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, user.FieldLockedUntil)
+}
+
+// SetLockCount sets the "lock_count" field.
+func (m *UserMutation) SetLockCount(i int) {
+	m.lock_count = &i
+	m.addlock_count = nil
+}
+
+// LockCount returns the value of the "lock_count" field in the mutation.
+func (m *UserMutation) LockCount() (r int, exists bool) {
+	v := m.lock_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientType returns the old "client_type" field's value of the Role entity.
-// If the Role object wasn't provided to the builder, the object is fetched from the database.
+// OldLockCount returns the old "lock_count" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *RoleMutation) OldClientType(ctx context.Context) (v int, err error) {
+func (m *UserMutation) OldLockCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientType is only allowed on UpdateOne operations")
+		return v, errors.New("OldLockCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientType requires an ID field in the mutation")
+		return v, errors.New("OldLockCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientType: %w", err)
+		return v, fmt.Errorf("querying old value for OldLockCount: %w", err)
 	}
-	return oldValue.ClientType, nil
+	return oldValue.LockCount, nil
 }
 
-// AddClientType adds i to the "client_type" field.
-func (m *RoleMutation) AddClientType(i int) {
-	if m.addclient_type != nil {
-		*m.addclient_type += i
+// AddLockCount adds i to the "lock_count" field.
+func (m *UserMutation) AddLockCount(i int) {
+	if m.addlock_count != nil {
+		*m.addlock_count += i
 	} else {
-		m.addclient_type = &i
+		m.addlock_count = &i
 	}
 }
 
-// AddedClientType returns the value that was added to the "client_type" field in this mutation.
-func (m *RoleMutation) AddedClientType() (r int, exists bool) {
-	v := m.addclient_type
+// AddedLockCount returns the value that was added to the "lock_count" field in this mutation.
+func (m *UserMutation) AddedLockCount() (r int, exists bool) {
+	v := m.addlock_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetClientType resets all changes to the "client_type" field.
-func (m *RoleMutation) ResetClientType() {
-	m.client_type = nil
-	m.addclient_type = nil
-}
-
-// AddUserIDs adds the "users" edge to the User entity by ids.
-func (m *RoleMutation) AddUserIDs(ids ...int64) {
-	if m.users == nil {
-		m.users = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.users[ids[i]] = struct{}{}
-	}
+// ResetLockCount resets all changes to the "lock_count" field.
+func (m *UserMutation) ResetLockCount() {
+	m.lock_count = nil
+	m.addlock_count = nil
 }
 
-// ClearUsers clears the "users" edge to the User entity.
-func (m *RoleMutation) ClearUsers() {
-	m.clearedusers = true
+// SetAuthClientsID sets the "auth_clients" edge to the AuthClient entity by id.
+func (m *UserMutation) SetAuthClientsID(id int64) {
+	m.auth_clients = &id
 }
 
-// UsersCleared reports if the "users" edge to the User entity was cleared.
-func (m *RoleMutation) UsersCleared() bool {
-	return m.clearedusers
+// ClearAuthClients clears the "auth_clients" edge to the AuthClient entity.
+func (m *UserMutation) ClearAuthClients() {
+	m.clearedauth_clients = true
 }
 
-// RemoveUserIDs removes the "users" edge to the User entity by IDs.
-func (m *RoleMutation) RemoveUserIDs(ids ...int64) {
-	if m.removedusers == nil {
-		m.removedusers = make(map[int64]struct{})
-	}
-	for i := range ids {
-		delete(m.users, ids[i])
-		m.removedusers[ids[i]] = struct{}{}
-	}
+// AuthClientsCleared reports if the "auth_clients" edge to the AuthClient entity was cleared.
+func (m *UserMutation) AuthClientsCleared() bool {
+	return m.clearedauth_clients
 }
 
-// RemovedUsers returns the removed IDs of the "users" edge to the User entity.
-func (m *RoleMutation) RemovedUsersIDs() (ids []int64) {
-	for id := range m.removedusers {
-		ids = append(ids, id)
+// AuthClientsID returns the "auth_clients" edge ID in the mutation.
+func (m *UserMutation) AuthClientsID() (id int64, exists bool) {
+	if m.auth_clients != nil {
+		return *m.auth_clients, true
 	}
 	return
 }
 
-// UsersIDs returns the "users" edge IDs in the mutation.
-func (m *RoleMutation) UsersIDs() (ids []int64) {
-	for id := range m.users {
-		ids = append(ids, id)
+// AuthClientsIDs returns the "auth_clients" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AuthClientsID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) AuthClientsIDs() (ids []int64) {
+	if id := m.auth_clients; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUsers resets all changes to the "users" edge.
-func (m *RoleMutation) ResetUsers() {
-	m.users = nil
-	m.clearedusers = false
-	m.removedusers = nil
+// ResetAuthClients resets all changes to the "auth_clients" edge.
+func (m *UserMutation) ResetAuthClients() {
+	m.auth_clients = nil
+	m.clearedauth_clients = false
 }
 
-// AddAuthClientIDs adds the "auth_clients" edge to the AuthClient entity by ids.
-func (m *RoleMutation) AddAuthClientIDs(ids ...int64) {
-	if m.auth_clients == nil {
-		m.auth_clients = make(map[int64]struct{})
+// AddLoginRecordIDs adds the "login_records" edge to the LoginRecord entity by ids.
+func (m *UserMutation) AddLoginRecordIDs(ids ...int64) {
+	if m.login_records == nil {
+		m.login_records = make(map[int64]struct{})
 	}
 	for i := range ids {
-		m.auth_clients[ids[i]] = struct{}{}
+		m.login_records[ids[i]] = struct{}{}
 	}
 }
 
-// ClearAuthClients clears the "auth_clients" edge to the AuthClient entity.
-func (m *RoleMutation) ClearAuthClients() {
-	m.clearedauth_clients = true
+// ClearLoginRecords clears the "login_records" edge to the LoginRecord entity.
+func (m *UserMutation) ClearLoginRecords() {
+	m.clearedlogin_records = true
 }
 
-// AuthClientsCleared reports if the "auth_clients" edge to the AuthClient entity was cleared.
-func (m *RoleMutation) AuthClientsCleared() bool {
-	return m.clearedauth_clients
+// LoginRecordsCleared reports if the "login_records" edge to the LoginRecord entity was cleared.
+func (m *UserMutation) LoginRecordsCleared() bool {
+	return m.clearedlogin_records
 }
 
-// RemoveAuthClientIDs removes the "auth_clients" edge to the AuthClient entity by IDs.
-func (m *RoleMutation) RemoveAuthClientIDs(ids ...int64) {
-	if m.removedauth_clients == nil {
-		m.removedauth_clients = make(map[int64]struct{})
+// RemoveLoginRecordIDs removes the "login_records" edge to the LoginRecord entity by IDs.
+func (m *UserMutation) RemoveLoginRecordIDs(ids ...int64) {
+	if m.removedlogin_records == nil {
+		m.removedlogin_records = make(map[int64]struct{})
 	}
 	for i := range ids {
-		delete(m.auth_clients, ids[i])
-		m.removedauth_clients[ids[i]] = struct{}{}
+		delete(m.login_records, ids[i])
+		m.removedlogin_records[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedAuthClients returns the removed IDs of the "auth_clients" edge to the AuthClient entity.
-func (m *RoleMutation) RemovedAuthClientsIDs() (ids []int64) {
-	for id := range m.removedauth_clients {
+// RemovedLoginRecords returns the removed IDs of the "login_records" edge to the LoginRecord entity.
+func (m *UserMutation) RemovedLoginRecordsIDs() (ids []int64) {
+	for id := range m.removedlogin_records {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// AuthClientsIDs returns the "auth_clients" edge IDs in the mutation.
-func (m *RoleMutation) AuthClientsIDs() (ids []int64) {
-	for id := range m.auth_clients {
+// LoginRecordsIDs returns the "login_records" edge IDs in the mutation.
+func (m *UserMutation) LoginRecordsIDs() (ids []int64) {
+	for id := range m.login_records {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetAuthClients resets all changes to the "auth_clients" edge.
-func (m *RoleMutation) ResetAuthClients() {
-	m.auth_clients = nil
-	m.clearedauth_clients = false
-	m.removedauth_clients = nil
+// ResetLoginRecords resets all changes to the "login_records" edge.
+func (m *UserMutation) ResetLoginRecords() {
+	m.login_records = nil
+	m.clearedlogin_records = false
+	m.removedlogin_records = nil
 }
 
-// Where appends a list predicates to the RoleMutation builder.
-func (m *RoleMutation) Where(ps ...predicate.Role) {
+// SetRolesID sets the "roles" edge to the Role entity by id.
+func (m *UserMutation) SetRolesID(id int64) {
+	m.roles = &id
+}
+
+// ClearRoles clears the "roles" edge to the Role entity.
+func (m *UserMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the Role entity was cleared.
+func (m *UserMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RolesID returns the "roles" edge ID in the mutation.
+func (m *UserMutation) RolesID() (id int64, exists bool) {
+	if m.roles != nil {
+		return *m.roles, true
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RolesID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) RolesIDs() (ids []int64) {
+	if id := m.roles; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *UserMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the RoleMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Role, len(ps))
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2661,42 +9149,51 @@ func (m *RoleMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *RoleMutation) Op() Op {
+func (m *UserMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *RoleMutation) SetOp(op Op) {
+func (m *UserMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Role).
-func (m *RoleMutation) Type() string {
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *RoleMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_at != nil {
-		fields = append(fields, role.FieldCreatedAt)
+		fields = append(fields, user.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, role.FieldUpdatedAt)
+		fields = append(fields, user.FieldUpdatedAt)
 	}
-	if m.name != nil {
-		fields = append(fields, role.FieldName)
+	if m.account != nil {
+		fields = append(fields, user.FieldAccount)
 	}
-	if m.permissions != nil {
-		fields = append(fields, role.FieldPermissions)
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
 	}
-	if m.is_system != nil {
-		fields = append(fields, role.FieldIsSystem)
+	if m.password_fail_times != nil {
+		fields = append(fields, user.FieldPasswordFailTimes)
 	}
-	if m.client_type != nil {
-		fields = append(fields, role.FieldClientType)
+	if m.status != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.password_changed_at != nil {
+		fields = append(fields, user.FieldPasswordChangedAt)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, user.FieldLockedUntil)
+	}
+	if m.lock_count != nil {
+		fields = append(fields, user.FieldLockCount)
 	}
 	return fields
 }
@@ -2704,20 +9201,26 @@ func (m *RoleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *RoleMutation) Field(name string) (ent.Value, bool) {
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		return m.CreatedAt()
-	case role.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case role.FieldName:
-		return m.Name()
-	case role.FieldPermissions:
-		return m.Permissions()
-	case role.FieldIsSystem:
-		return m.IsSystem()
-	case role.FieldClientType:
-		return m.ClientType()
+	case user.FieldAccount:
+		return m.Account()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldPasswordFailTimes:
+		return m.PasswordFailTimes()
+	case user.FieldStatus:
+		return m.Status()
+	case user.FieldPasswordChangedAt:
+		return m.PasswordChangedAt()
+	case user.FieldLockedUntil:
+		return m.LockedUntil()
+	case user.FieldLockCount:
+		return m.LockCount()
 	}
 	return nil, false
 }
@@ -2725,81 +9228,114 @@ func (m *RoleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *RoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case role.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case role.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case role.FieldName:
-		return m.OldName(ctx)
-	case role.FieldPermissions:
-		return m.OldPermissions(ctx)
-	case role.FieldIsSystem:
-		return m.OldIsSystem(ctx)
-	case role.FieldClientType:
-		return m.OldClientType(ctx)
+	case user.FieldAccount:
+		return m.OldAccount(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldPasswordFailTimes:
+		return m.OldPasswordFailTimes(ctx)
+	case user.FieldStatus:
+		return m.OldStatus(ctx)
+	case user.FieldPasswordChangedAt:
+		return m.OldPasswordChangedAt(ctx)
+	case user.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case user.FieldLockCount:
+		return m.OldLockCount(ctx)
 	}
-	return nil, fmt.Errorf("unknown Role field %s", name)
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) SetField(name string, value ent.Value) error {
+func (m *UserMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case role.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case role.FieldName:
+	case user.FieldAccount:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetAccount(v)
 		return nil
-	case role.FieldPermissions:
-		v, ok := value.([]enum.Permission)
+	case user.FieldPassword:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPermissions(v)
+		m.SetPassword(v)
 		return nil
-	case role.FieldIsSystem:
-		v, ok := value.(bool)
+	case user.FieldPasswordFailTimes:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsSystem(v)
+		m.SetPasswordFailTimes(v)
 		return nil
-	case role.FieldClientType:
+	case user.FieldStatus:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientType(v)
+		m.SetStatus(v)
+		return nil
+	case user.FieldPasswordChangedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordChangedAt(v)
+		return nil
+	case user.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case user.FieldLockCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *RoleMutation) AddedFields() []string {
+func (m *UserMutation) AddedFields() []string {
 	var fields []string
-	if m.addclient_type != nil {
-		fields = append(fields, role.FieldClientType)
+	if m.addpassword_fail_times != nil {
+		fields = append(fields, user.FieldPasswordFailTimes)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, user.FieldStatus)
+	}
+	if m.addlock_count != nil {
+		fields = append(fields, user.FieldLockCount)
 	}
 	return fields
 }
@@ -2807,10 +9343,14 @@ func (m *RoleMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case role.FieldClientType:
-		return m.AddedClientType()
+	case user.FieldPasswordFailTimes:
+		return m.AddedPasswordFailTimes()
+	case user.FieldStatus:
+		return m.AddedStatus()
+	case user.FieldLockCount:
+		return m.AddedLockCount()
 	}
 	return nil, false
 }
@@ -2818,121 +9358,155 @@ func (m *RoleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *RoleMutation) AddField(name string, value ent.Value) error {
+func (m *UserMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case role.FieldClientType:
+	case user.FieldPasswordFailTimes:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddClientType(v)
+		m.AddPasswordFailTimes(v)
+		return nil
+	case user.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case user.FieldLockCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLockCount(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Role numeric field %s", name)
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *RoleMutation) ClearedFields() []string {
-	return nil
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldPasswordChangedAt) {
+		fields = append(fields, user.FieldPasswordChangedAt)
+	}
+	if m.FieldCleared(user.FieldLockedUntil) {
+		fields = append(fields, user.FieldLockedUntil)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *RoleMutation) FieldCleared(name string) bool {
+func (m *UserMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *RoleMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Role nullable field %s", name)
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldPasswordChangedAt:
+		m.ClearPasswordChangedAt()
+		return nil
+	case user.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *RoleMutation) ResetField(name string) error {
+func (m *UserMutation) ResetField(name string) error {
 	switch name {
-	case role.FieldCreatedAt:
+	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case role.FieldUpdatedAt:
+	case user.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case role.FieldName:
-		m.ResetName()
+	case user.FieldAccount:
+		m.ResetAccount()
 		return nil
-	case role.FieldPermissions:
-		m.ResetPermissions()
+	case user.FieldPassword:
+		m.ResetPassword()
 		return nil
-	case role.FieldIsSystem:
-		m.ResetIsSystem()
+	case user.FieldPasswordFailTimes:
+		m.ResetPasswordFailTimes()
 		return nil
-	case role.FieldClientType:
-		m.ResetClientType()
+	case user.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case user.FieldPasswordChangedAt:
+		m.ResetPasswordChangedAt()
+		return nil
+	case user.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case user.FieldLockCount:
+		m.ResetLockCount()
 		return nil
 	}
-	return fmt.Errorf("unknown Role field %s", name)
+	return fmt.Errorf("unknown User field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *RoleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.users != nil {
-		edges = append(edges, role.EdgeUsers)
-	}
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.auth_clients != nil {
-		edges = append(edges, role.EdgeAuthClients)
+		edges = append(edges, user.EdgeAuthClients)
+	}
+	if m.login_records != nil {
+		edges = append(edges, user.EdgeLoginRecords)
+	}
+	if m.roles != nil {
+		edges = append(edges, user.EdgeRoles)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *RoleMutation) AddedIDs(name string) []ent.Value {
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.users))
-		for id := range m.users {
-			ids = append(ids, id)
+	case user.EdgeAuthClients:
+		if id := m.auth_clients; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case role.EdgeAuthClients:
-		ids := make([]ent.Value, 0, len(m.auth_clients))
-		for id := range m.auth_clients {
+	case user.EdgeLoginRecords:
+		ids := make([]ent.Value, 0, len(m.login_records))
+		for id := range m.login_records {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeRoles:
+		if id := m.roles; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *RoleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedusers != nil {
-		edges = append(edges, role.EdgeUsers)
-	}
-	if m.removedauth_clients != nil {
-		edges = append(edges, role.EdgeAuthClients)
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedlogin_records != nil {
+		edges = append(edges, user.EdgeLoginRecords)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case role.EdgeUsers:
-		ids := make([]ent.Value, 0, len(m.removedusers))
-		for id := range m.removedusers {
-			ids = append(ids, id)
-		}
-		return ids
-	case role.EdgeAuthClients:
-		ids := make([]ent.Value, 0, len(m.removedauth_clients))
-		for id := range m.removedauth_clients {
+	case user.EdgeLoginRecords:
+		ids := make([]ent.Value, 0, len(m.removedlogin_records))
+		for id := range m.removedlogin_records {
 			ids = append(ids, id)
 		}
 		return ids
@@ -2941,89 +9515,97 @@ func (m *RoleMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *RoleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedusers {
-		edges = append(edges, role.EdgeUsers)
-	}
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.clearedauth_clients {
-		edges = append(edges, role.EdgeAuthClients)
+		edges = append(edges, user.EdgeAuthClients)
+	}
+	if m.clearedlogin_records {
+		edges = append(edges, user.EdgeLoginRecords)
+	}
+	if m.clearedroles {
+		edges = append(edges, user.EdgeRoles)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *RoleMutation) EdgeCleared(name string) bool {
+func (m *UserMutation) EdgeCleared(name string) bool {
 	switch name {
-	case role.EdgeUsers:
-		return m.clearedusers
-	case role.EdgeAuthClients:
+	case user.EdgeAuthClients:
 		return m.clearedauth_clients
+	case user.EdgeLoginRecords:
+		return m.clearedlogin_records
+	case user.EdgeRoles:
+		return m.clearedroles
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *RoleMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Role unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *RoleMutation) ResetEdge(name string) error {
+func (m *UserMutation) ClearEdge(name string) error {
 	switch name {
-	case role.EdgeUsers:
-		m.ResetUsers()
+	case user.EdgeAuthClients:
+		m.ClearAuthClients()
 		return nil
-	case role.EdgeAuthClients:
-		m.ResetAuthClients()
+	case user.EdgeRoles:
+		m.ClearRoles()
 		return nil
 	}
-	return fmt.Errorf("unknown Role edge %s", name)
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
-	config
-	op                     Op
-	typ                    string
-	id                     *int64
-	created_at             *time.Time
-	updated_at             *time.Time
-	account                *string
-	password               *string
-	password_fail_times    *int
-	addpassword_fail_times *int
-	status                 *int
-	addstatus              *int
-	clearedFields          map[string]struct{}
-	auth_clients           *int64
-	clearedauth_clients    bool
-	login_records          map[int64]struct{}
-	removedlogin_records   map[int64]struct{}
-	clearedlogin_records   bool
-	roles                  *int64
-	clearedroles           bool
-	done                   bool
-	oldValue               func(context.Context) (*User, error)
-	predicates             []predicate.User
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeAuthClients:
+		m.ResetAuthClients()
+		return nil
+	case user.EdgeLoginRecords:
+		m.ResetLoginRecords()
+		return nil
+	case user.EdgeRoles:
+		m.ResetRoles()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// UserTotpMutation represents an operation that mutates the UserTotp nodes in the graph.
+type UserTotpMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int64
+	created_at           *time.Time
+	updated_at           *time.Time
+	user_id              *int64
+	adduser_id           *int64
+	secret               *string
+	enabled              *bool
+	recovery_codes       *[]string
+	appendrecovery_codes []string
+	enabled_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*UserTotp, error)
+	predicates           []predicate.UserTotp
+}
+
+var _ ent.Mutation = (*UserTotpMutation)(nil)
+
+// usertotpOption allows management of the mutation configuration using functional options.
+type usertotpOption func(*UserTotpMutation)
+
+// newUserTotpMutation creates new mutation for the UserTotp entity.
+func newUserTotpMutation(c config, op Op, opts ...usertotpOption) *UserTotpMutation {
+	m := &UserTotpMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeUserTotp,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3032,20 +9614,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id int64) userOption {
-	return func(m *UserMutation) {
+// withUserTotpID sets the ID field of the mutation.
+func withUserTotpID(id int64) usertotpOption {
+	return func(m *UserTotpMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *UserTotp
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*UserTotp, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().UserTotp.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3054,10 +9636,10 @@ func withUserID(id int64) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withUserTotp sets the old UserTotp of the mutation.
+func withUserTotp(node *UserTotp) usertotpOption {
+	return func(m *UserTotpMutation) {
+		m.oldValue = func(context.Context) (*UserTotp, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3066,7 +9648,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m UserTotpMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3074,7 +9656,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m UserTotpMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3084,14 +9666,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id int64) {
+// operation is only accepted on creation of UserTotp entities.
+func (m *UserTotpMutation) SetID(id int64) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id int64, exists bool) {
+func (m *UserTotpMutation) ID() (id int64, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3102,7 +9684,7 @@ func (m *UserMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *UserTotpMutation) IDs(ctx context.Context) ([]int64, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3111,19 +9693,19 @@ func (m *UserMutation) IDs(ctx context.Context) ([]int64, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().UserTotp.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *UserTotpMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *UserTotpMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3131,10 +9713,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the UserTotp entity.
+// If the UserTotp object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserTotpMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3149,17 +9731,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *UserTotpMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *UserTotpMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *UserTotpMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3167,10 +9749,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the UserTotp entity.
+// If the UserTotp object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *UserTotpMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3185,335 +9767,261 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *UserTotpMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// SetAccount sets the "account" field.
-func (m *UserMutation) SetAccount(s string) {
-	m.account = &s
+// SetUserID sets the "user_id" field.
+func (m *UserTotpMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
 }
 
-// Account returns the value of the "account" field in the mutation.
-func (m *UserMutation) Account() (r string, exists bool) {
-	v := m.account
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserTotpMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAccount returns the old "account" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the UserTotp entity.
+// If the UserTotp object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAccount(ctx context.Context) (v string, err error) {
+func (m *UserTotpMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAccount requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Account, nil
+	return oldValue.UserID, nil
 }
 
-// ResetAccount resets all changes to the "account" field.
-func (m *UserMutation) ResetAccount() {
-	m.account = nil
-}
-
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+// AddUserID adds i to the "user_id" field.
+func (m *UserTotpMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *UserTotpMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
-	}
-	return oldValue.Password, nil
-}
-
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserTotpMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
 }
 
-// SetPasswordFailTimes sets the "password_fail_times" field.
-func (m *UserMutation) SetPasswordFailTimes(i int) {
-	m.password_fail_times = &i
-	m.addpassword_fail_times = nil
+// SetSecret sets the "secret" field.
+func (m *UserTotpMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// PasswordFailTimes returns the value of the "password_fail_times" field in the mutation.
-func (m *UserMutation) PasswordFailTimes() (r int, exists bool) {
-	v := m.password_fail_times
+// Secret returns the value of the "secret" field in the mutation.
+func (m *UserTotpMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordFailTimes returns the old "password_fail_times" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the UserTotp entity.
+// If the UserTotp object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordFailTimes(ctx context.Context) (v int, err error) {
+func (m *UserTotpMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordFailTimes is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordFailTimes requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordFailTimes: %w", err)
-	}
-	return oldValue.PasswordFailTimes, nil
-}
-
-// AddPasswordFailTimes adds i to the "password_fail_times" field.
-func (m *UserMutation) AddPasswordFailTimes(i int) {
-	if m.addpassword_fail_times != nil {
-		*m.addpassword_fail_times += i
-	} else {
-		m.addpassword_fail_times = &i
-	}
-}
-
-// AddedPasswordFailTimes returns the value that was added to the "password_fail_times" field in this mutation.
-func (m *UserMutation) AddedPasswordFailTimes() (r int, exists bool) {
-	v := m.addpassword_fail_times
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return *v, true
+	return oldValue.Secret, nil
 }
 
-// ResetPasswordFailTimes resets all changes to the "password_fail_times" field.
-func (m *UserMutation) ResetPasswordFailTimes() {
-	m.password_fail_times = nil
-	m.addpassword_fail_times = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *UserTotpMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *UserMutation) SetStatus(i int) {
-	m.status = &i
-	m.addstatus = nil
+// SetEnabled sets the "enabled" field.
+func (m *UserTotpMutation) SetEnabled(b bool) {
+	m.enabled = &b
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *UserMutation) Status() (r int, exists bool) {
-	v := m.status
+// Enabled returns the value of the "enabled" field in the mutation.
+func (m *UserTotpMutation) Enabled() (r bool, exists bool) {
+	v := m.enabled
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEnabled returns the old "enabled" field's value of the UserTotp entity.
+// If the UserTotp object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldStatus(ctx context.Context) (v int, err error) {
+func (m *UserTotpMutation) OldEnabled(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldEnabled is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldEnabled requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldEnabled: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.Enabled, nil
 }
 
-// AddStatus adds i to the "status" field.
-func (m *UserMutation) AddStatus(i int) {
-	if m.addstatus != nil {
-		*m.addstatus += i
-	} else {
-		m.addstatus = &i
-	}
+// ResetEnabled resets all changes to the "enabled" field.
+func (m *UserTotpMutation) ResetEnabled() {
+	m.enabled = nil
 }
 
-// AddedStatus returns the value that was added to the "status" field in this mutation.
-func (m *UserMutation) AddedStatus() (r int, exists bool) {
-	v := m.addstatus
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (m *UserTotpMutation) SetRecoveryCodes(s []string) {
+	m.recovery_codes = &s
+	m.appendrecovery_codes = nil
+}
+
+// RecoveryCodes returns the value of the "recovery_codes" field in the mutation.
+func (m *UserTotpMutation) RecoveryCodes() (r []string, exists bool) {
+	v := m.recovery_codes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *UserMutation) ResetStatus() {
-	m.status = nil
-	m.addstatus = nil
-}
-
-// SetAuthClientsID sets the "auth_clients" edge to the AuthClient entity by id.
-func (m *UserMutation) SetAuthClientsID(id int64) {
-	m.auth_clients = &id
-}
-
-// ClearAuthClients clears the "auth_clients" edge to the AuthClient entity.
-func (m *UserMutation) ClearAuthClients() {
-	m.clearedauth_clients = true
-}
-
-// AuthClientsCleared reports if the "auth_clients" edge to the AuthClient entity was cleared.
-func (m *UserMutation) AuthClientsCleared() bool {
-	return m.clearedauth_clients
-}
-
-// AuthClientsID returns the "auth_clients" edge ID in the mutation.
-func (m *UserMutation) AuthClientsID() (id int64, exists bool) {
-	if m.auth_clients != nil {
-		return *m.auth_clients, true
-	}
-	return
-}
-
-// AuthClientsIDs returns the "auth_clients" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// AuthClientsID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) AuthClientsIDs() (ids []int64) {
-	if id := m.auth_clients; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetAuthClients resets all changes to the "auth_clients" edge.
-func (m *UserMutation) ResetAuthClients() {
-	m.auth_clients = nil
-	m.clearedauth_clients = false
-}
-
-// AddLoginRecordIDs adds the "login_records" edge to the LoginRecord entity by ids.
-func (m *UserMutation) AddLoginRecordIDs(ids ...int64) {
-	if m.login_records == nil {
-		m.login_records = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.login_records[ids[i]] = struct{}{}
+// OldRecoveryCodes returns the old "recovery_codes" field's value of the UserTotp entity.
+// If the UserTotp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserTotpMutation) OldRecoveryCodes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecoveryCodes is only allowed on UpdateOne operations")
 	}
-}
-
-// ClearLoginRecords clears the "login_records" edge to the LoginRecord entity.
-func (m *UserMutation) ClearLoginRecords() {
-	m.clearedlogin_records = true
-}
-
-// LoginRecordsCleared reports if the "login_records" edge to the LoginRecord entity was cleared.
-func (m *UserMutation) LoginRecordsCleared() bool {
-	return m.clearedlogin_records
-}
-
-// RemoveLoginRecordIDs removes the "login_records" edge to the LoginRecord entity by IDs.
-func (m *UserMutation) RemoveLoginRecordIDs(ids ...int64) {
-	if m.removedlogin_records == nil {
-		m.removedlogin_records = make(map[int64]struct{})
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecoveryCodes requires an ID field in the mutation")
 	}
-	for i := range ids {
-		delete(m.login_records, ids[i])
-		m.removedlogin_records[ids[i]] = struct{}{}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecoveryCodes: %w", err)
 	}
+	return oldValue.RecoveryCodes, nil
 }
 
-// RemovedLoginRecords returns the removed IDs of the "login_records" edge to the LoginRecord entity.
-func (m *UserMutation) RemovedLoginRecordsIDs() (ids []int64) {
-	for id := range m.removedlogin_records {
-		ids = append(ids, id)
-	}
-	return
+// AppendRecoveryCodes adds s to the "recovery_codes" field.
+func (m *UserTotpMutation) AppendRecoveryCodes(s []string) {
+	m.appendrecovery_codes = append(m.appendrecovery_codes, s...)
 }
-
-// LoginRecordsIDs returns the "login_records" edge IDs in the mutation.
-func (m *UserMutation) LoginRecordsIDs() (ids []int64) {
-	for id := range m.login_records {
-		ids = append(ids, id)
+
+// AppendedRecoveryCodes returns the list of values that were appended to the "recovery_codes" field in this mutation.
+func (m *UserTotpMutation) AppendedRecoveryCodes() ([]string, bool) {
+	if len(m.appendrecovery_codes) == 0 {
+		return nil, false
 	}
-	return
+	return m.appendrecovery_codes, true
 }
 
-// ResetLoginRecords resets all changes to the "login_records" edge.
-func (m *UserMutation) ResetLoginRecords() {
-	m.login_records = nil
-	m.clearedlogin_records = false
-	m.removedlogin_records = nil
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (m *UserTotpMutation) ClearRecoveryCodes() {
+	m.recovery_codes = nil
+	m.appendrecovery_codes = nil
+	m.clearedFields[usertotp.FieldRecoveryCodes] = struct{}{}
 }
 
-// SetRolesID sets the "roles" edge to the Role entity by id.
-func (m *UserMutation) SetRolesID(id int64) {
-	m.roles = &id
+// RecoveryCodesCleared returns if the "recovery_codes" field was cleared in this mutation.
+func (m *UserTotpMutation) RecoveryCodesCleared() bool {
+	_, ok := m.clearedFields[usertotp.FieldRecoveryCodes]
+	return ok
 }
 
-// ClearRoles clears the "roles" edge to the Role entity.
-func (m *UserMutation) ClearRoles() {
-	m.clearedroles = true
+// ResetRecoveryCodes resets all changes to the "recovery_codes" field.
+func (m *UserTotpMutation) ResetRecoveryCodes() {
+	m.recovery_codes = nil
+	m.appendrecovery_codes = nil
+	delete(m.clearedFields, usertotp.FieldRecoveryCodes)
 }
 
-// RolesCleared reports if the "roles" edge to the Role entity was cleared.
-func (m *UserMutation) RolesCleared() bool {
-	return m.clearedroles
+// SetEnabledAt sets the "enabled_at" field.
+func (m *UserTotpMutation) SetEnabledAt(t time.Time) {
+	m.enabled_at = &t
 }
 
-// RolesID returns the "roles" edge ID in the mutation.
-func (m *UserMutation) RolesID() (id int64, exists bool) {
-	if m.roles != nil {
-		return *m.roles, true
+// EnabledAt returns the value of the "enabled_at" field in the mutation.
+func (m *UserTotpMutation) EnabledAt() (r time.Time, exists bool) {
+	v := m.enabled_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// RolesIDs returns the "roles" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// RolesID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) RolesIDs() (ids []int64) {
-	if id := m.roles; id != nil {
-		ids = append(ids, *id)
+// OldEnabledAt returns the old "enabled_at" field's value of the UserTotp entity.
+// If the UserTotp object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserTotpMutation) OldEnabledAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEnabledAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEnabledAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEnabledAt: %w", err)
+	}
+	return oldValue.EnabledAt, nil
 }
 
-// ResetRoles resets all changes to the "roles" edge.
-func (m *UserMutation) ResetRoles() {
-	m.roles = nil
-	m.clearedroles = false
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (m *UserTotpMutation) ClearEnabledAt() {
+	m.enabled_at = nil
+	m.clearedFields[usertotp.FieldEnabledAt] = struct{}{}
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// EnabledAtCleared returns if the "enabled_at" field was cleared in this mutation.
+func (m *UserTotpMutation) EnabledAtCleared() bool {
+	_, ok := m.clearedFields[usertotp.FieldEnabledAt]
+	return ok
+}
+
+// ResetEnabledAt resets all changes to the "enabled_at" field.
+func (m *UserTotpMutation) ResetEnabledAt() {
+	m.enabled_at = nil
+	delete(m.clearedFields, usertotp.FieldEnabledAt)
+}
+
+// Where appends a list predicates to the UserTotpMutation builder.
+func (m *UserTotpMutation) Where(ps ...predicate.UserTotp) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the UserTotpMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *UserTotpMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserTotp, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3521,42 +10029,45 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *UserTotpMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
+func (m *UserTotpMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
+// Type returns the node type of this mutation (UserTotp).
+func (m *UserTotpMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *UserTotpMutation) Fields() []string {
+	fields := make([]string, 0, 7)
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, usertotp.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, usertotp.FieldUpdatedAt)
 	}
-	if m.account != nil {
-		fields = append(fields, user.FieldAccount)
+	if m.user_id != nil {
+		fields = append(fields, usertotp.FieldUserID)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.secret != nil {
+		fields = append(fields, usertotp.FieldSecret)
 	}
-	if m.password_fail_times != nil {
-		fields = append(fields, user.FieldPasswordFailTimes)
+	if m.enabled != nil {
+		fields = append(fields, usertotp.FieldEnabled)
 	}
-	if m.status != nil {
-		fields = append(fields, user.FieldStatus)
+	if m.recovery_codes != nil {
+		fields = append(fields, usertotp.FieldRecoveryCodes)
+	}
+	if m.enabled_at != nil {
+		fields = append(fields, usertotp.FieldEnabledAt)
 	}
 	return fields
 }
@@ -3564,20 +10075,22 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *UserTotpMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldCreatedAt:
+	case usertotp.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case usertotp.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case user.FieldAccount:
-		return m.Account()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldPasswordFailTimes:
-		return m.PasswordFailTimes()
-	case user.FieldStatus:
-		return m.Status()
+	case usertotp.FieldUserID:
+		return m.UserID()
+	case usertotp.FieldSecret:
+		return m.Secret()
+	case usertotp.FieldEnabled:
+		return m.Enabled()
+	case usertotp.FieldRecoveryCodes:
+		return m.RecoveryCodes()
+	case usertotp.FieldEnabledAt:
+		return m.EnabledAt()
 	}
 	return nil, false
 }
@@ -3585,84 +10098,90 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserTotpMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldCreatedAt:
+	case usertotp.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case usertotp.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case user.FieldAccount:
-		return m.OldAccount(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldPasswordFailTimes:
-		return m.OldPasswordFailTimes(ctx)
-	case user.FieldStatus:
-		return m.OldStatus(ctx)
+	case usertotp.FieldUserID:
+		return m.OldUserID(ctx)
+	case usertotp.FieldSecret:
+		return m.OldSecret(ctx)
+	case usertotp.FieldEnabled:
+		return m.OldEnabled(ctx)
+	case usertotp.FieldRecoveryCodes:
+		return m.OldRecoveryCodes(ctx)
+	case usertotp.FieldEnabledAt:
+		return m.OldEnabledAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown UserTotp field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *UserTotpMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case usertotp.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case usertotp.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case user.FieldAccount:
-		v, ok := value.(string)
+	case usertotp.FieldUserID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAccount(v)
+		m.SetUserID(v)
 		return nil
-	case user.FieldPassword:
+	case usertotp.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetSecret(v)
 		return nil
-	case user.FieldPasswordFailTimes:
-		v, ok := value.(int)
+	case usertotp.FieldEnabled:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPasswordFailTimes(v)
+		m.SetEnabled(v)
 		return nil
-	case user.FieldStatus:
-		v, ok := value.(int)
+	case usertotp.FieldRecoveryCodes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetRecoveryCodes(v)
+		return nil
+	case usertotp.FieldEnabledAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEnabledAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserTotp field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *UserTotpMutation) AddedFields() []string {
 	var fields []string
-	if m.addpassword_fail_times != nil {
-		fields = append(fields, user.FieldPasswordFailTimes)
-	}
-	if m.addstatus != nil {
-		fields = append(fields, user.FieldStatus)
+	if m.adduser_id != nil {
+		fields = append(fields, usertotp.FieldUserID)
 	}
 	return fields
 }
@@ -3670,12 +10189,10 @@ func (m *UserMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *UserTotpMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldPasswordFailTimes:
-		return m.AddedPasswordFailTimes()
-	case user.FieldStatus:
-		return m.AddedStatus()
+	case usertotp.FieldUserID:
+		return m.AddedUserID()
 	}
 	return nil, false
 }
@@ -3683,187 +10200,126 @@ func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *UserTotpMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldPasswordFailTimes:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddPasswordFailTimes(v)
-		return nil
-	case user.FieldStatus:
-		v, ok := value.(int)
+	case usertotp.FieldUserID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddStatus(v)
+		m.AddUserID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown UserTotp numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
-	return nil
+func (m *UserTotpMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(usertotp.FieldRecoveryCodes) {
+		fields = append(fields, usertotp.FieldRecoveryCodes)
+	}
+	if m.FieldCleared(usertotp.FieldEnabledAt) {
+		fields = append(fields, usertotp.FieldEnabledAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *UserTotpMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *UserTotpMutation) ClearField(name string) error {
+	switch name {
+	case usertotp.FieldRecoveryCodes:
+		m.ClearRecoveryCodes()
+		return nil
+	case usertotp.FieldEnabledAt:
+		m.ClearEnabledAt()
+		return nil
+	}
+	return fmt.Errorf("unknown UserTotp nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *UserTotpMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldCreatedAt:
+	case usertotp.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case usertotp.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case user.FieldAccount:
-		m.ResetAccount()
+	case usertotp.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case usertotp.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case user.FieldPasswordFailTimes:
-		m.ResetPasswordFailTimes()
+	case usertotp.FieldEnabled:
+		m.ResetEnabled()
 		return nil
-	case user.FieldStatus:
-		m.ResetStatus()
+	case usertotp.FieldRecoveryCodes:
+		m.ResetRecoveryCodes()
+		return nil
+	case usertotp.FieldEnabledAt:
+		m.ResetEnabledAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown UserTotp field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.auth_clients != nil {
-		edges = append(edges, user.EdgeAuthClients)
-	}
-	if m.login_records != nil {
-		edges = append(edges, user.EdgeLoginRecords)
-	}
-	if m.roles != nil {
-		edges = append(edges, user.EdgeRoles)
-	}
+func (m *UserTotpMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeAuthClients:
-		if id := m.auth_clients; id != nil {
-			return []ent.Value{*id}
-		}
-	case user.EdgeLoginRecords:
-		ids := make([]ent.Value, 0, len(m.login_records))
-		for id := range m.login_records {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeRoles:
-		if id := m.roles; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *UserTotpMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedlogin_records != nil {
-		edges = append(edges, user.EdgeLoginRecords)
-	}
+func (m *UserTotpMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeLoginRecords:
-		ids := make([]ent.Value, 0, len(m.removedlogin_records))
-		for id := range m.removedlogin_records {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *UserTotpMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedauth_clients {
-		edges = append(edges, user.EdgeAuthClients)
-	}
-	if m.clearedlogin_records {
-		edges = append(edges, user.EdgeLoginRecords)
-	}
-	if m.clearedroles {
-		edges = append(edges, user.EdgeRoles)
-	}
+func (m *UserTotpMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeAuthClients:
-		return m.clearedauth_clients
-	case user.EdgeLoginRecords:
-		return m.clearedlogin_records
-	case user.EdgeRoles:
-		return m.clearedroles
-	}
+func (m *UserTotpMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	switch name {
-	case user.EdgeAuthClients:
-		m.ClearAuthClients()
-		return nil
-	case user.EdgeRoles:
-		m.ClearRoles()
-		return nil
-	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+func (m *UserTotpMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown UserTotp unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	switch name {
-	case user.EdgeAuthClients:
-		m.ResetAuthClients()
-		return nil
-	case user.EdgeLoginRecords:
-		m.ResetLoginRecords()
-		return nil
-	case user.EdgeRoles:
-		m.ResetRoles()
-		return nil
-	}
-	return fmt.Errorf("unknown User edge %s", name)
+func (m *UserTotpMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown UserTotp edge %s", name)
 }
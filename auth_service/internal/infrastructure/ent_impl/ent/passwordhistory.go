@@ -0,0 +1,138 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/passwordhistory"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// PasswordHistory is the model entity for the PasswordHistory schema.
+type PasswordHistory struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Password holds the value of the "password" field.
+	Password     string `json:"-"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PasswordHistory) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case passwordhistory.FieldID, passwordhistory.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case passwordhistory.FieldPassword:
+			values[i] = new(sql.NullString)
+		case passwordhistory.FieldCreatedAt, passwordhistory.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PasswordHistory fields.
+func (ph *PasswordHistory) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case passwordhistory.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ph.ID = int64(value.Int64)
+		case passwordhistory.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ph.CreatedAt = value.Time
+			}
+		case passwordhistory.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ph.UpdatedAt = value.Time
+			}
+		case passwordhistory.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ph.UserID = value.Int64
+			}
+		case passwordhistory.FieldPassword:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password", values[i])
+			} else if value.Valid {
+				ph.Password = value.String
+			}
+		default:
+			ph.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PasswordHistory.
+// This includes values selected through modifiers, order, etc.
+func (ph *PasswordHistory) Value(name string) (ent.Value, error) {
+	return ph.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PasswordHistory.
+// Note that you need to call PasswordHistory.Unwrap() before calling this method if this PasswordHistory
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ph *PasswordHistory) Update() *PasswordHistoryUpdateOne {
+	return NewPasswordHistoryClient(ph.config).UpdateOne(ph)
+}
+
+// Unwrap unwraps the PasswordHistory entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ph *PasswordHistory) Unwrap() *PasswordHistory {
+	_tx, ok := ph.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PasswordHistory is not a transactional entity")
+	}
+	ph.config.driver = _tx.drv
+	return ph
+}
+
+// String implements the fmt.Stringer.
+func (ph *PasswordHistory) String() string {
+	var builder strings.Builder
+	builder.WriteString("PasswordHistory(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ph.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ph.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ph.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ph.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("password=<sensitive>")
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PasswordHistories is a parsable slice of PasswordHistory.
+type PasswordHistories []*PasswordHistory
@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/passwordhistory"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordHistoryQuery is the builder for querying PasswordHistory entities.
+type PasswordHistoryQuery struct {
+	config
+	ctx        *QueryContext
+	order      []passwordhistory.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PasswordHistory
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PasswordHistoryQuery builder.
+func (phq *PasswordHistoryQuery) Where(ps ...predicate.PasswordHistory) *PasswordHistoryQuery {
+	phq.predicates = append(phq.predicates, ps...)
+	return phq
+}
+
+// Limit the number of records to be returned by this query.
+func (phq *PasswordHistoryQuery) Limit(limit int) *PasswordHistoryQuery {
+	phq.ctx.Limit = &limit
+	return phq
+}
+
+// Offset to start from.
+func (phq *PasswordHistoryQuery) Offset(offset int) *PasswordHistoryQuery {
+	phq.ctx.Offset = &offset
+	return phq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (phq *PasswordHistoryQuery) Unique(unique bool) *PasswordHistoryQuery {
+	phq.ctx.Unique = &unique
+	return phq
+}
+
+// Order specifies how the records should be ordered.
+func (phq *PasswordHistoryQuery) Order(o ...passwordhistory.OrderOption) *PasswordHistoryQuery {
+	phq.order = append(phq.order, o...)
+	return phq
+}
+
+// First returns the first PasswordHistory entity from the query.
+// Returns a *NotFoundError when no PasswordHistory was found.
+func (phq *PasswordHistoryQuery) First(ctx context.Context) (*PasswordHistory, error) {
+	nodes, err := phq.Limit(1).All(setContextOp(ctx, phq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{passwordhistory.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (phq *PasswordHistoryQuery) FirstX(ctx context.Context) *PasswordHistory {
+	node, err := phq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PasswordHistory ID from the query.
+// Returns a *NotFoundError when no PasswordHistory ID was found.
+func (phq *PasswordHistoryQuery) FirstID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = phq.Limit(1).IDs(setContextOp(ctx, phq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{passwordhistory.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (phq *PasswordHistoryQuery) FirstIDX(ctx context.Context) int64 {
+	id, err := phq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PasswordHistory entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PasswordHistory entity is found.
+// Returns a *NotFoundError when no PasswordHistory entities are found.
+func (phq *PasswordHistoryQuery) Only(ctx context.Context) (*PasswordHistory, error) {
+	nodes, err := phq.Limit(2).All(setContextOp(ctx, phq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{passwordhistory.Label}
+	default:
+		return nil, &NotSingularError{passwordhistory.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (phq *PasswordHistoryQuery) OnlyX(ctx context.Context) *PasswordHistory {
+	node, err := phq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PasswordHistory ID in the query.
+// Returns a *NotSingularError when more than one PasswordHistory ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (phq *PasswordHistoryQuery) OnlyID(ctx context.Context) (id int64, err error) {
+	var ids []int64
+	if ids, err = phq.Limit(2).IDs(setContextOp(ctx, phq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{passwordhistory.Label}
+	default:
+		err = &NotSingularError{passwordhistory.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (phq *PasswordHistoryQuery) OnlyIDX(ctx context.Context) int64 {
+	id, err := phq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PasswordHistories.
+func (phq *PasswordHistoryQuery) All(ctx context.Context) ([]*PasswordHistory, error) {
+	ctx = setContextOp(ctx, phq.ctx, ent.OpQueryAll)
+	if err := phq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PasswordHistory, *PasswordHistoryQuery]()
+	return withInterceptors[[]*PasswordHistory](ctx, phq, qr, phq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (phq *PasswordHistoryQuery) AllX(ctx context.Context) []*PasswordHistory {
+	nodes, err := phq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PasswordHistory IDs.
+func (phq *PasswordHistoryQuery) IDs(ctx context.Context) (ids []int64, err error) {
+	if phq.ctx.Unique == nil && phq.path != nil {
+		phq.Unique(true)
+	}
+	ctx = setContextOp(ctx, phq.ctx, ent.OpQueryIDs)
+	if err = phq.Select(passwordhistory.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (phq *PasswordHistoryQuery) IDsX(ctx context.Context) []int64 {
+	ids, err := phq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (phq *PasswordHistoryQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, phq.ctx, ent.OpQueryCount)
+	if err := phq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, phq, querierCount[*PasswordHistoryQuery](), phq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (phq *PasswordHistoryQuery) CountX(ctx context.Context) int {
+	count, err := phq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (phq *PasswordHistoryQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, phq.ctx, ent.OpQueryExist)
+	switch _, err := phq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (phq *PasswordHistoryQuery) ExistX(ctx context.Context) bool {
+	exist, err := phq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PasswordHistoryQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (phq *PasswordHistoryQuery) Clone() *PasswordHistoryQuery {
+	if phq == nil {
+		return nil
+	}
+	return &PasswordHistoryQuery{
+		config:     phq.config,
+		ctx:        phq.ctx.Clone(),
+		order:      append([]passwordhistory.OrderOption{}, phq.order...),
+		inters:     append([]Interceptor{}, phq.inters...),
+		predicates: append([]predicate.PasswordHistory{}, phq.predicates...),
+		// clone intermediate query.
+		sql:  phq.sql.Clone(),
+		path: phq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PasswordHistory.Query().
+//		GroupBy(passwordhistory.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (phq *PasswordHistoryQuery) GroupBy(field string, fields ...string) *PasswordHistoryGroupBy {
+	phq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PasswordHistoryGroupBy{build: phq}
+	grbuild.flds = &phq.ctx.Fields
+	grbuild.label = passwordhistory.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.PasswordHistory.Query().
+//		Select(passwordhistory.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (phq *PasswordHistoryQuery) Select(fields ...string) *PasswordHistorySelect {
+	phq.ctx.Fields = append(phq.ctx.Fields, fields...)
+	sbuild := &PasswordHistorySelect{PasswordHistoryQuery: phq}
+	sbuild.label = passwordhistory.Label
+	sbuild.flds, sbuild.scan = &phq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PasswordHistorySelect configured with the given aggregations.
+func (phq *PasswordHistoryQuery) Aggregate(fns ...AggregateFunc) *PasswordHistorySelect {
+	return phq.Select().Aggregate(fns...)
+}
+
+func (phq *PasswordHistoryQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range phq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, phq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range phq.ctx.Fields {
+		if !passwordhistory.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if phq.path != nil {
+		prev, err := phq.path(ctx)
+		if err != nil {
+			return err
+		}
+		phq.sql = prev
+	}
+	return nil
+}
+
+func (phq *PasswordHistoryQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PasswordHistory, error) {
+	var (
+		nodes = []*PasswordHistory{}
+		_spec = phq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PasswordHistory).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PasswordHistory{config: phq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, phq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (phq *PasswordHistoryQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := phq.querySpec()
+	_spec.Node.Columns = phq.ctx.Fields
+	if len(phq.ctx.Fields) > 0 {
+		_spec.Unique = phq.ctx.Unique != nil && *phq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, phq.driver, _spec)
+}
+
+func (phq *PasswordHistoryQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(passwordhistory.Table, passwordhistory.Columns, sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt64))
+	_spec.From = phq.sql
+	if unique := phq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if phq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := phq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordhistory.FieldID)
+		for i := range fields {
+			if fields[i] != passwordhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := phq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := phq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := phq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := phq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (phq *PasswordHistoryQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(phq.driver.Dialect())
+	t1 := builder.Table(passwordhistory.Table)
+	columns := phq.ctx.Fields
+	if len(columns) == 0 {
+		columns = passwordhistory.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if phq.sql != nil {
+		selector = phq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if phq.ctx.Unique != nil && *phq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range phq.predicates {
+		p(selector)
+	}
+	for _, p := range phq.order {
+		p(selector)
+	}
+	if offset := phq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := phq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PasswordHistoryGroupBy is the group-by builder for PasswordHistory entities.
+type PasswordHistoryGroupBy struct {
+	selector
+	build *PasswordHistoryQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (phgb *PasswordHistoryGroupBy) Aggregate(fns ...AggregateFunc) *PasswordHistoryGroupBy {
+	phgb.fns = append(phgb.fns, fns...)
+	return phgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (phgb *PasswordHistoryGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, phgb.build.ctx, ent.OpQueryGroupBy)
+	if err := phgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PasswordHistoryQuery, *PasswordHistoryGroupBy](ctx, phgb.build, phgb, phgb.build.inters, v)
+}
+
+func (phgb *PasswordHistoryGroupBy) sqlScan(ctx context.Context, root *PasswordHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(phgb.fns))
+	for _, fn := range phgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*phgb.flds)+len(phgb.fns))
+		for _, f := range *phgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*phgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := phgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PasswordHistorySelect is the builder for selecting fields of PasswordHistory entities.
+type PasswordHistorySelect struct {
+	*PasswordHistoryQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (phs *PasswordHistorySelect) Aggregate(fns ...AggregateFunc) *PasswordHistorySelect {
+	phs.fns = append(phs.fns, fns...)
+	return phs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (phs *PasswordHistorySelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, phs.ctx, ent.OpQuerySelect)
+	if err := phs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PasswordHistoryQuery, *PasswordHistorySelect](ctx, phs.PasswordHistoryQuery, phs, phs.inters, v)
+}
+
+func (phs *PasswordHistorySelect) sqlScan(ctx context.Context, root *PasswordHistoryQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(phs.fns))
+	for _, fn := range phs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*phs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := phs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,300 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/passwordhistory"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PasswordHistoryUpdate is the builder for updating PasswordHistory entities.
+type PasswordHistoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PasswordHistoryMutation
+}
+
+// Where appends a list predicates to the PasswordHistoryUpdate builder.
+func (phu *PasswordHistoryUpdate) Where(ps ...predicate.PasswordHistory) *PasswordHistoryUpdate {
+	phu.mutation.Where(ps...)
+	return phu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (phu *PasswordHistoryUpdate) SetUpdatedAt(t time.Time) *PasswordHistoryUpdate {
+	phu.mutation.SetUpdatedAt(t)
+	return phu
+}
+
+// SetUserID sets the "user_id" field.
+func (phu *PasswordHistoryUpdate) SetUserID(i int64) *PasswordHistoryUpdate {
+	phu.mutation.ResetUserID()
+	phu.mutation.SetUserID(i)
+	return phu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (phu *PasswordHistoryUpdate) SetNillableUserID(i *int64) *PasswordHistoryUpdate {
+	if i != nil {
+		phu.SetUserID(*i)
+	}
+	return phu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (phu *PasswordHistoryUpdate) AddUserID(i int64) *PasswordHistoryUpdate {
+	phu.mutation.AddUserID(i)
+	return phu
+}
+
+// SetPassword sets the "password" field.
+func (phu *PasswordHistoryUpdate) SetPassword(s string) *PasswordHistoryUpdate {
+	phu.mutation.SetPassword(s)
+	return phu
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (phu *PasswordHistoryUpdate) SetNillablePassword(s *string) *PasswordHistoryUpdate {
+	if s != nil {
+		phu.SetPassword(*s)
+	}
+	return phu
+}
+
+// Mutation returns the PasswordHistoryMutation object of the builder.
+func (phu *PasswordHistoryUpdate) Mutation() *PasswordHistoryMutation {
+	return phu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (phu *PasswordHistoryUpdate) Save(ctx context.Context) (int, error) {
+	phu.defaults()
+	return withHooks(ctx, phu.sqlSave, phu.mutation, phu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phu *PasswordHistoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := phu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (phu *PasswordHistoryUpdate) Exec(ctx context.Context) error {
+	_, err := phu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phu *PasswordHistoryUpdate) ExecX(ctx context.Context) {
+	if err := phu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (phu *PasswordHistoryUpdate) defaults() {
+	if _, ok := phu.mutation.UpdatedAt(); !ok {
+		v := passwordhistory.UpdateDefaultUpdatedAt()
+		phu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (phu *PasswordHistoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(passwordhistory.Table, passwordhistory.Columns, sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt64))
+	if ps := phu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := phu.mutation.UpdatedAt(); ok {
+		_spec.SetField(passwordhistory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := phu.mutation.UserID(); ok {
+		_spec.SetField(passwordhistory.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := phu.mutation.AddedUserID(); ok {
+		_spec.AddField(passwordhistory.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := phu.mutation.Password(); ok {
+		_spec.SetField(passwordhistory.FieldPassword, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, phu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	phu.mutation.done = true
+	return n, nil
+}
+
+// PasswordHistoryUpdateOne is the builder for updating a single PasswordHistory entity.
+type PasswordHistoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PasswordHistoryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (phuo *PasswordHistoryUpdateOne) SetUpdatedAt(t time.Time) *PasswordHistoryUpdateOne {
+	phuo.mutation.SetUpdatedAt(t)
+	return phuo
+}
+
+// SetUserID sets the "user_id" field.
+func (phuo *PasswordHistoryUpdateOne) SetUserID(i int64) *PasswordHistoryUpdateOne {
+	phuo.mutation.ResetUserID()
+	phuo.mutation.SetUserID(i)
+	return phuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (phuo *PasswordHistoryUpdateOne) SetNillableUserID(i *int64) *PasswordHistoryUpdateOne {
+	if i != nil {
+		phuo.SetUserID(*i)
+	}
+	return phuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (phuo *PasswordHistoryUpdateOne) AddUserID(i int64) *PasswordHistoryUpdateOne {
+	phuo.mutation.AddUserID(i)
+	return phuo
+}
+
+// SetPassword sets the "password" field.
+func (phuo *PasswordHistoryUpdateOne) SetPassword(s string) *PasswordHistoryUpdateOne {
+	phuo.mutation.SetPassword(s)
+	return phuo
+}
+
+// SetNillablePassword sets the "password" field if the given value is not nil.
+func (phuo *PasswordHistoryUpdateOne) SetNillablePassword(s *string) *PasswordHistoryUpdateOne {
+	if s != nil {
+		phuo.SetPassword(*s)
+	}
+	return phuo
+}
+
+// Mutation returns the PasswordHistoryMutation object of the builder.
+func (phuo *PasswordHistoryUpdateOne) Mutation() *PasswordHistoryMutation {
+	return phuo.mutation
+}
+
+// Where appends a list predicates to the PasswordHistoryUpdate builder.
+func (phuo *PasswordHistoryUpdateOne) Where(ps ...predicate.PasswordHistory) *PasswordHistoryUpdateOne {
+	phuo.mutation.Where(ps...)
+	return phuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (phuo *PasswordHistoryUpdateOne) Select(field string, fields ...string) *PasswordHistoryUpdateOne {
+	phuo.fields = append([]string{field}, fields...)
+	return phuo
+}
+
+// Save executes the query and returns the updated PasswordHistory entity.
+func (phuo *PasswordHistoryUpdateOne) Save(ctx context.Context) (*PasswordHistory, error) {
+	phuo.defaults()
+	return withHooks(ctx, phuo.sqlSave, phuo.mutation, phuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (phuo *PasswordHistoryUpdateOne) SaveX(ctx context.Context) *PasswordHistory {
+	node, err := phuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (phuo *PasswordHistoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := phuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (phuo *PasswordHistoryUpdateOne) ExecX(ctx context.Context) {
+	if err := phuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (phuo *PasswordHistoryUpdateOne) defaults() {
+	if _, ok := phuo.mutation.UpdatedAt(); !ok {
+		v := passwordhistory.UpdateDefaultUpdatedAt()
+		phuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (phuo *PasswordHistoryUpdateOne) sqlSave(ctx context.Context) (_node *PasswordHistory, err error) {
+	_spec := sqlgraph.NewUpdateSpec(passwordhistory.Table, passwordhistory.Columns, sqlgraph.NewFieldSpec(passwordhistory.FieldID, field.TypeInt64))
+	id, ok := phuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PasswordHistory.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := phuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, passwordhistory.FieldID)
+		for _, f := range fields {
+			if !passwordhistory.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != passwordhistory.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := phuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := phuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(passwordhistory.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := phuo.mutation.UserID(); ok {
+		_spec.SetField(passwordhistory.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := phuo.mutation.AddedUserID(); ok {
+		_spec.AddField(passwordhistory.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := phuo.mutation.Password(); ok {
+		_spec.SetField(passwordhistory.FieldPassword, field.TypeString, value)
+	}
+	_node = &PasswordHistory{config: phuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, phuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{passwordhistory.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	phuo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,26 @@ type AuthClient func(*sql.Selector)
 // LoginRecord is the predicate function for loginrecord builders.
 type LoginRecord func(*sql.Selector)
 
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// PasswordHistory is the predicate function for passwordhistory builders.
+type PasswordHistory func(*sql.Selector)
+
 // Role is the predicate function for role builders.
 type Role func(*sql.Selector)
 
+// SigningKey is the predicate function for signingkey builders.
+type SigningKey func(*sql.Selector)
+
+// TokenRevocation is the predicate function for tokenrevocation builders.
+type TokenRevocation func(*sql.Selector)
+
+// TrustedDevice is the predicate function for trusteddevice builders.
+type TrustedDevice func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// UserTotp is the predicate function for usertotp builders.
+type UserTotp func(*sql.Selector)
@@ -5,9 +5,15 @@ package ent
 import (
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/authclient"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/loginrecord"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/outboxevent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/passwordhistory"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/role"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/schema"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/signingkey"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/user"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
 	"time"
 )
 
@@ -30,6 +36,54 @@ func init() {
 	authclient.DefaultUpdatedAt = authclientDescUpdatedAt.Default.(func() time.Time)
 	// authclient.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	authclient.UpdateDefaultUpdatedAt = authclientDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// authclientDescRefreshTokenExpireSecs is the schema descriptor for refresh_token_expire_secs field.
+	authclientDescRefreshTokenExpireSecs := authclientFields[7].Descriptor()
+	// authclient.DefaultRefreshTokenExpireSecs holds the default value on creation for the refresh_token_expire_secs field.
+	authclient.DefaultRefreshTokenExpireSecs = authclientDescRefreshTokenExpireSecs.Default.(int)
+	// authclientDescSessionPolicy is the schema descriptor for session_policy field.
+	authclientDescSessionPolicy := authclientFields[8].Descriptor()
+	// authclient.DefaultSessionPolicy holds the default value on creation for the session_policy field.
+	authclient.DefaultSessionPolicy = authclientDescSessionPolicy.Default.(int)
+	// authclientDescMaxSessions is the schema descriptor for max_sessions field.
+	authclientDescMaxSessions := authclientFields[9].Descriptor()
+	// authclient.DefaultMaxSessions holds the default value on creation for the max_sessions field.
+	authclient.DefaultMaxSessions = authclientDescMaxSessions.Default.(int)
+	// authclientDescSigningMethod is the schema descriptor for signing_method field.
+	authclientDescSigningMethod := authclientFields[10].Descriptor()
+	// authclient.DefaultSigningMethod holds the default value on creation for the signing_method field.
+	authclient.DefaultSigningMethod = authclientDescSigningMethod.Default.(int)
+	// authclientDescMfaRequired is the schema descriptor for mfa_required field.
+	authclientDescMfaRequired := authclientFields[11].Descriptor()
+	// authclient.DefaultMfaRequired holds the default value on creation for the mfa_required field.
+	authclient.DefaultMfaRequired = authclientDescMfaRequired.Default.(bool)
+	// authclientDescPasswordMinLength is the schema descriptor for password_min_length field.
+	authclientDescPasswordMinLength := authclientFields[12].Descriptor()
+	// authclient.DefaultPasswordMinLength holds the default value on creation for the password_min_length field.
+	authclient.DefaultPasswordMinLength = authclientDescPasswordMinLength.Default.(int)
+	// authclientDescPasswordHistoryCount is the schema descriptor for password_history_count field.
+	authclientDescPasswordHistoryCount := authclientFields[14].Descriptor()
+	// authclient.DefaultPasswordHistoryCount holds the default value on creation for the password_history_count field.
+	authclient.DefaultPasswordHistoryCount = authclientDescPasswordHistoryCount.Default.(int)
+	// authclientDescPasswordMaxAgeSecs is the schema descriptor for password_max_age_secs field.
+	authclientDescPasswordMaxAgeSecs := authclientFields[15].Descriptor()
+	// authclient.DefaultPasswordMaxAgeSecs holds the default value on creation for the password_max_age_secs field.
+	authclient.DefaultPasswordMaxAgeSecs = authclientDescPasswordMaxAgeSecs.Default.(int)
+	// authclientDescLockoutSecs is the schema descriptor for lockout_secs field.
+	authclientDescLockoutSecs := authclientFields[17].Descriptor()
+	// authclient.DefaultLockoutSecs holds the default value on creation for the lockout_secs field.
+	authclient.DefaultLockoutSecs = authclientDescLockoutSecs.Default.(int)
+	// authclientDescMaxLockoutSecs is the schema descriptor for max_lockout_secs field.
+	authclientDescMaxLockoutSecs := authclientFields[18].Descriptor()
+	// authclient.DefaultMaxLockoutSecs holds the default value on creation for the max_lockout_secs field.
+	authclient.DefaultMaxLockoutSecs = authclientDescMaxLockoutSecs.Default.(int)
+	// authclientDescRiskChallengeScore is the schema descriptor for risk_challenge_score field.
+	authclientDescRiskChallengeScore := authclientFields[19].Descriptor()
+	// authclient.DefaultRiskChallengeScore holds the default value on creation for the risk_challenge_score field.
+	authclient.DefaultRiskChallengeScore = authclientDescRiskChallengeScore.Default.(int)
+	// authclientDescRiskDenyScore is the schema descriptor for risk_deny_score field.
+	authclientDescRiskDenyScore := authclientFields[20].Descriptor()
+	// authclient.DefaultRiskDenyScore holds the default value on creation for the risk_deny_score field.
+	authclient.DefaultRiskDenyScore = authclientDescRiskDenyScore.Default.(int)
 	loginrecordMixin := schema.LoginRecord{}.Mixin()
 	loginrecordMixinFields0 := loginrecordMixin[0].Fields()
 	_ = loginrecordMixinFields0
@@ -45,6 +99,56 @@ func init() {
 	loginrecord.DefaultUpdatedAt = loginrecordDescUpdatedAt.Default.(func() time.Time)
 	// loginrecord.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	loginrecord.UpdateDefaultUpdatedAt = loginrecordDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// loginrecordDescErrCode is the schema descriptor for err_code field.
+	loginrecordDescErrCode := loginrecordFields[13].Descriptor()
+	// loginrecord.DefaultErrCode holds the default value on creation for the err_code field.
+	loginrecord.DefaultErrCode = loginrecordDescErrCode.Default.(int)
+	// loginrecordDescLatitude is the schema descriptor for latitude field.
+	loginrecordDescLatitude := loginrecordFields[14].Descriptor()
+	// loginrecord.DefaultLatitude holds the default value on creation for the latitude field.
+	loginrecord.DefaultLatitude = loginrecordDescLatitude.Default.(float64)
+	// loginrecordDescLongitude is the schema descriptor for longitude field.
+	loginrecordDescLongitude := loginrecordFields[15].Descriptor()
+	// loginrecord.DefaultLongitude holds the default value on creation for the longitude field.
+	loginrecord.DefaultLongitude = loginrecordDescLongitude.Default.(float64)
+	outboxeventMixin := schema.OutboxEvent{}.Mixin()
+	outboxeventMixinFields0 := outboxeventMixin[0].Fields()
+	_ = outboxeventMixinFields0
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventMixinFields0[0].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescUpdatedAt is the schema descriptor for updated_at field.
+	outboxeventDescUpdatedAt := outboxeventMixinFields0[1].Descriptor()
+	// outboxevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	outboxevent.DefaultUpdatedAt = outboxeventDescUpdatedAt.Default.(func() time.Time)
+	// outboxevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	outboxevent.UpdateDefaultUpdatedAt = outboxeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescLastError is the schema descriptor for last_error field.
+	outboxeventDescLastError := outboxeventFields[9].Descriptor()
+	// outboxevent.DefaultLastError holds the default value on creation for the last_error field.
+	outboxevent.DefaultLastError = outboxeventDescLastError.Default.(string)
+	passwordhistoryMixin := schema.PasswordHistory{}.Mixin()
+	passwordhistoryMixinFields0 := passwordhistoryMixin[0].Fields()
+	_ = passwordhistoryMixinFields0
+	passwordhistoryFields := schema.PasswordHistory{}.Fields()
+	_ = passwordhistoryFields
+	// passwordhistoryDescCreatedAt is the schema descriptor for created_at field.
+	passwordhistoryDescCreatedAt := passwordhistoryMixinFields0[0].Descriptor()
+	// passwordhistory.DefaultCreatedAt holds the default value on creation for the created_at field.
+	passwordhistory.DefaultCreatedAt = passwordhistoryDescCreatedAt.Default.(func() time.Time)
+	// passwordhistoryDescUpdatedAt is the schema descriptor for updated_at field.
+	passwordhistoryDescUpdatedAt := passwordhistoryMixinFields0[1].Descriptor()
+	// passwordhistory.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	passwordhistory.DefaultUpdatedAt = passwordhistoryDescUpdatedAt.Default.(func() time.Time)
+	// passwordhistory.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	passwordhistory.UpdateDefaultUpdatedAt = passwordhistoryDescUpdatedAt.UpdateDefault.(func() time.Time)
 	roleMixin := schema.Role{}.Mixin()
 	roleMixinFields0 := roleMixin[0].Fields()
 	_ = roleMixinFields0
@@ -64,6 +168,55 @@ func init() {
 	roleDescIsSystem := roleFields[3].Descriptor()
 	// role.DefaultIsSystem holds the default value on creation for the is_system field.
 	role.DefaultIsSystem = roleDescIsSystem.Default.(bool)
+	signingkeyMixin := schema.SigningKey{}.Mixin()
+	signingkeyMixinFields0 := signingkeyMixin[0].Fields()
+	_ = signingkeyMixinFields0
+	signingkeyFields := schema.SigningKey{}.Fields()
+	_ = signingkeyFields
+	// signingkeyDescCreatedAt is the schema descriptor for created_at field.
+	signingkeyDescCreatedAt := signingkeyMixinFields0[0].Descriptor()
+	// signingkey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	signingkey.DefaultCreatedAt = signingkeyDescCreatedAt.Default.(func() time.Time)
+	// signingkeyDescUpdatedAt is the schema descriptor for updated_at field.
+	signingkeyDescUpdatedAt := signingkeyMixinFields0[1].Descriptor()
+	// signingkey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	signingkey.DefaultUpdatedAt = signingkeyDescUpdatedAt.Default.(func() time.Time)
+	// signingkey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	signingkey.UpdateDefaultUpdatedAt = signingkeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	tokenrevocationMixin := schema.TokenRevocation{}.Mixin()
+	tokenrevocationMixinFields0 := tokenrevocationMixin[0].Fields()
+	_ = tokenrevocationMixinFields0
+	tokenrevocationFields := schema.TokenRevocation{}.Fields()
+	_ = tokenrevocationFields
+	// tokenrevocationDescCreatedAt is the schema descriptor for created_at field.
+	tokenrevocationDescCreatedAt := tokenrevocationMixinFields0[0].Descriptor()
+	// tokenrevocation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tokenrevocation.DefaultCreatedAt = tokenrevocationDescCreatedAt.Default.(func() time.Time)
+	// tokenrevocationDescUpdatedAt is the schema descriptor for updated_at field.
+	tokenrevocationDescUpdatedAt := tokenrevocationMixinFields0[1].Descriptor()
+	// tokenrevocation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	tokenrevocation.DefaultUpdatedAt = tokenrevocationDescUpdatedAt.Default.(func() time.Time)
+	// tokenrevocation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	tokenrevocation.UpdateDefaultUpdatedAt = tokenrevocationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// tokenrevocationDescReason is the schema descriptor for reason field.
+	tokenrevocationDescReason := tokenrevocationFields[4].Descriptor()
+	// tokenrevocation.DefaultReason holds the default value on creation for the reason field.
+	tokenrevocation.DefaultReason = tokenrevocationDescReason.Default.(string)
+	trusteddeviceMixin := schema.TrustedDevice{}.Mixin()
+	trusteddeviceMixinFields0 := trusteddeviceMixin[0].Fields()
+	_ = trusteddeviceMixinFields0
+	trusteddeviceFields := schema.TrustedDevice{}.Fields()
+	_ = trusteddeviceFields
+	// trusteddeviceDescCreatedAt is the schema descriptor for created_at field.
+	trusteddeviceDescCreatedAt := trusteddeviceMixinFields0[0].Descriptor()
+	// trusteddevice.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trusteddevice.DefaultCreatedAt = trusteddeviceDescCreatedAt.Default.(func() time.Time)
+	// trusteddeviceDescUpdatedAt is the schema descriptor for updated_at field.
+	trusteddeviceDescUpdatedAt := trusteddeviceMixinFields0[1].Descriptor()
+	// trusteddevice.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	trusteddevice.DefaultUpdatedAt = trusteddeviceDescUpdatedAt.Default.(func() time.Time)
+	// trusteddevice.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	trusteddevice.UpdateDefaultUpdatedAt = trusteddeviceDescUpdatedAt.UpdateDefault.(func() time.Time)
 	userMixin := schema.User{}.Mixin()
 	userMixinFields0 := userMixin[0].Fields()
 	_ = userMixinFields0
@@ -79,4 +232,27 @@ func init() {
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
 	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescLockCount is the schema descriptor for lock_count field.
+	userDescLockCount := userFields[7].Descriptor()
+	// user.DefaultLockCount holds the default value on creation for the lock_count field.
+	user.DefaultLockCount = userDescLockCount.Default.(int)
+	usertotpMixin := schema.UserTotp{}.Mixin()
+	usertotpMixinFields0 := usertotpMixin[0].Fields()
+	_ = usertotpMixinFields0
+	usertotpFields := schema.UserTotp{}.Fields()
+	_ = usertotpFields
+	// usertotpDescCreatedAt is the schema descriptor for created_at field.
+	usertotpDescCreatedAt := usertotpMixinFields0[0].Descriptor()
+	// usertotp.DefaultCreatedAt holds the default value on creation for the created_at field.
+	usertotp.DefaultCreatedAt = usertotpDescCreatedAt.Default.(func() time.Time)
+	// usertotpDescUpdatedAt is the schema descriptor for updated_at field.
+	usertotpDescUpdatedAt := usertotpMixinFields0[1].Descriptor()
+	// usertotp.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	usertotp.DefaultUpdatedAt = usertotpDescUpdatedAt.Default.(func() time.Time)
+	// usertotp.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	usertotp.UpdateDefaultUpdatedAt = usertotpDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// usertotpDescEnabled is the schema descriptor for enabled field.
+	usertotpDescEnabled := usertotpFields[3].Descriptor()
+	// usertotp.DefaultEnabled holds the default value on creation for the enabled field.
+	usertotp.DefaultEnabled = usertotpDescEnabled.Default.(bool)
 }
@@ -1,6 +1,8 @@
 package schema
 
 import (
+	"go_micro_service_api/pkg/enum"
+
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -28,6 +30,20 @@ func (AuthClient) Fields() []ent.Field {
 		field.Bool("active"),
 		field.Int("token_expire_secs"),
 		field.Int("login_failed_times"),
+		field.Int("refresh_token_expire_secs").Default(1209600),
+		field.Int("session_policy").Default(1),
+		field.Int("max_sessions").Default(1),
+		field.Int("signing_method").Default(1),
+		field.Bool("mfa_required").Default(false),
+		field.Int("password_min_length").Default(0),
+		field.JSON("password_char_classes", []enum.PasswordCharClass{}).Optional(),
+		field.Int("password_history_count").Default(0),
+		field.Int("password_max_age_secs").Default(0),
+		field.JSON("banned_passwords", []string{}).Optional(),
+		field.Int("lockout_secs").Default(0),
+		field.Int("max_lockout_secs").Default(0),
+		field.Int("risk_challenge_score").Default(0),
+		field.Int("risk_deny_score").Default(0),
 	}
 }
 
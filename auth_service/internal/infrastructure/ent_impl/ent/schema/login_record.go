@@ -34,6 +34,9 @@ func (LoginRecord) Fields() []ent.Field {
 		field.Bool("is_mobile"),
 		field.Bool("is_success"),
 		field.String("err_message"),
+		field.Int("err_code").Default(0), // cus_err code of the failed login, 0 when the login succeeds
+		field.Float("latitude").Default(0),
+		field.Float("longitude").Default(0),
 	}
 }
 
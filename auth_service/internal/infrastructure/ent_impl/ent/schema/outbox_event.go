@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxEvent holds the schema definition for the OutboxEvent entity.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+// Mixin of the OutboxEvent.
+func (OutboxEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the OutboxEvent.
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("event_id").Unique().Immutable().Comment("The consumers dedupe the events by it"),
+		field.String("aggregate_type").Immutable(),
+		field.String("aggregate_id").Immutable(),
+		field.String("event_type").Immutable().Comment("The routing key of the event"),
+		field.Int("event_version").Immutable().Comment("The version of the payload schema of the event type"),
+		field.Bytes("payload").Immutable(),
+		field.Time("published_at").Optional().Nillable(),
+		field.Int("attempts").Default(0).Comment("The failed publishing attempts"),
+		field.Time("locked_until").Optional().Nillable().Comment("Claimed by a relay or waiting for the retry until then"),
+		field.String("last_error").Default(""),
+		field.Time("parked_at").Optional().Nillable().Comment("Not retried after the max attempts, the later events of the aggregate wait until it's resolved"),
+	}
+}
+
+// Edges of the OutboxEvent.
+func (OutboxEvent) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the OutboxEvent.
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		// The relay lists the pending events only
+		index.Fields("id").Annotations(entsql.IndexWhere("published_at IS NULL")),
+		// The relay finds the earlier pending events of the aggregate which hold it
+		index.Fields("aggregate_type", "aggregate_id", "id").Annotations(entsql.IndexWhere("published_at IS NULL")),
+	}
+}
+
+func (OutboxEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("The domain events written in the transactions of the changes, published by the outbox relay"),
+	}
+}
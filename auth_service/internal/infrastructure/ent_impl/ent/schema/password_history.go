@@ -0,0 +1,40 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PasswordHistory holds the schema definition for the PasswordHistory entity.
+type PasswordHistory struct {
+	ent.Schema
+}
+
+// Mixin of the PasswordHistory.
+func (PasswordHistory) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the PasswordHistory.
+func (PasswordHistory) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("user_id"),
+		field.String("password").Sensitive(), // Hashed password
+	}
+}
+
+// Edges of the PasswordHistory.
+func (PasswordHistory) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the PasswordHistory.
+func (PasswordHistory) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
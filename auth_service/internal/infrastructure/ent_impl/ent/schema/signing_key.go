@@ -0,0 +1,48 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// SigningKey holds the schema definition for the SigningKey entity.
+type SigningKey struct {
+	ent.Schema
+}
+
+// Mixin of the SigningKey.
+func (SigningKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the SigningKey.
+func (SigningKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("kid").Unique().Immutable(),
+		field.Int("signing_method").Immutable(),
+		field.Text("private_key").Sensitive().Immutable(),
+		field.Text("public_key").Immutable(),
+		field.Bool("active"),
+		field.Time("rotated_at").Optional().Nillable(),
+		field.Time("expire_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the SigningKey.
+func (SigningKey) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the SigningKey.
+func (SigningKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("signing_method", "active"),
+		// Only one key of a signing method is active, even if the keys are rotated by several instances at the same time
+		index.Fields("signing_method").Unique().Annotations(entsql.IndexWhere("active")),
+	}
+}
@@ -0,0 +1,45 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TokenRevocation holds the schema definition for the TokenRevocation entity.
+type TokenRevocation struct {
+	ent.Schema
+}
+
+// Mixin of the TokenRevocation.
+func (TokenRevocation) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the TokenRevocation.
+func (TokenRevocation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("client_id"),
+		field.Int64("user_id").Optional().Nillable(),
+		field.String("scope"),
+		field.String("reason").Default(""),
+		field.Int64("operator_id").Optional().Nillable(),
+		field.Int("revoked_count"),
+	}
+}
+
+// Edges of the TokenRevocation.
+func (TokenRevocation) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TokenRevocation.
+func (TokenRevocation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,43 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TrustedDevice holds the schema definition for the TrustedDevice entity.
+// A login from a trusted browser and city is not challenged as an unusual login.
+type TrustedDevice struct {
+	ent.Schema
+}
+
+// Mixin of the TrustedDevice.
+func (TrustedDevice) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the TrustedDevice.
+func (TrustedDevice) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("user_id"),
+		field.String("browser"),
+		field.String("city"),
+		field.String("country_code"),
+	}
+}
+
+// Edges of the TrustedDevice.
+func (TrustedDevice) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the TrustedDevice.
+func (TrustedDevice) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "browser", "city").Unique(),
+	}
+}
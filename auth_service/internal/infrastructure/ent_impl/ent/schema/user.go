@@ -26,6 +26,9 @@ func (User) Fields() []ent.Field {
 		field.String("password"),
 		field.Int("password_fail_times"),
 		field.Int("status"),
+		field.Time("password_changed_at").Optional().Nillable(),
+		field.Time("locked_until").Optional().Nillable(),
+		field.Int("lock_count").Default(0),
 	}
 }
 
@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// UserTotp holds the schema definition for the UserTotp entity.
+type UserTotp struct {
+	ent.Schema
+}
+
+// Mixin of the UserTotp.
+func (UserTotp) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the UserTotp.
+func (UserTotp) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.Int64("user_id").Unique(),
+		field.String("secret").Sensitive(),
+		field.Bool("enabled").Default(false),
+		field.JSON("recovery_codes", []string{}).Optional(), // Hashed recovery codes, a code is removed once it's used
+		field.Time("enabled_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the UserTotp.
+func (UserTotp) Edges() []ent.Edge {
+	return nil
+}
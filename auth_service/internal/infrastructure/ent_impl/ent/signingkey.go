@@ -0,0 +1,201 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/signingkey"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// SigningKey is the model entity for the SigningKey schema.
+type SigningKey struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Kid holds the value of the "kid" field.
+	Kid string `json:"kid,omitempty"`
+	// SigningMethod holds the value of the "signing_method" field.
+	SigningMethod int `json:"signing_method,omitempty"`
+	// PrivateKey holds the value of the "private_key" field.
+	PrivateKey string `json:"-"`
+	// PublicKey holds the value of the "public_key" field.
+	PublicKey string `json:"public_key,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// RotatedAt holds the value of the "rotated_at" field.
+	RotatedAt *time.Time `json:"rotated_at,omitempty"`
+	// ExpireAt holds the value of the "expire_at" field.
+	ExpireAt     *time.Time `json:"expire_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*SigningKey) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldActive:
+			values[i] = new(sql.NullBool)
+		case signingkey.FieldID, signingkey.FieldSigningMethod:
+			values[i] = new(sql.NullInt64)
+		case signingkey.FieldKid, signingkey.FieldPrivateKey, signingkey.FieldPublicKey:
+			values[i] = new(sql.NullString)
+		case signingkey.FieldCreatedAt, signingkey.FieldUpdatedAt, signingkey.FieldRotatedAt, signingkey.FieldExpireAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the SigningKey fields.
+func (sk *SigningKey) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case signingkey.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			sk.ID = int64(value.Int64)
+		case signingkey.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sk.CreatedAt = value.Time
+			}
+		case signingkey.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				sk.UpdatedAt = value.Time
+			}
+		case signingkey.FieldKid:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kid", values[i])
+			} else if value.Valid {
+				sk.Kid = value.String
+			}
+		case signingkey.FieldSigningMethod:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field signing_method", values[i])
+			} else if value.Valid {
+				sk.SigningMethod = int(value.Int64)
+			}
+		case signingkey.FieldPrivateKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field private_key", values[i])
+			} else if value.Valid {
+				sk.PrivateKey = value.String
+			}
+		case signingkey.FieldPublicKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_key", values[i])
+			} else if value.Valid {
+				sk.PublicKey = value.String
+			}
+		case signingkey.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				sk.Active = value.Bool
+			}
+		case signingkey.FieldRotatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field rotated_at", values[i])
+			} else if value.Valid {
+				sk.RotatedAt = new(time.Time)
+				*sk.RotatedAt = value.Time
+			}
+		case signingkey.FieldExpireAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expire_at", values[i])
+			} else if value.Valid {
+				sk.ExpireAt = new(time.Time)
+				*sk.ExpireAt = value.Time
+			}
+		default:
+			sk.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the SigningKey.
+// This includes values selected through modifiers, order, etc.
+func (sk *SigningKey) Value(name string) (ent.Value, error) {
+	return sk.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this SigningKey.
+// Note that you need to call SigningKey.Unwrap() before calling this method if this SigningKey
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sk *SigningKey) Update() *SigningKeyUpdateOne {
+	return NewSigningKeyClient(sk.config).UpdateOne(sk)
+}
+
+// Unwrap unwraps the SigningKey entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sk *SigningKey) Unwrap() *SigningKey {
+	_tx, ok := sk.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: SigningKey is not a transactional entity")
+	}
+	sk.config.driver = _tx.drv
+	return sk
+}
+
+// String implements the fmt.Stringer.
+func (sk *SigningKey) String() string {
+	var builder strings.Builder
+	builder.WriteString("SigningKey(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sk.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(sk.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(sk.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("kid=")
+	builder.WriteString(sk.Kid)
+	builder.WriteString(", ")
+	builder.WriteString("signing_method=")
+	builder.WriteString(fmt.Sprintf("%v", sk.SigningMethod))
+	builder.WriteString(", ")
+	builder.WriteString("private_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("public_key=")
+	builder.WriteString(sk.PublicKey)
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", sk.Active))
+	builder.WriteString(", ")
+	if v := sk.RotatedAt; v != nil {
+		builder.WriteString("rotated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := sk.ExpireAt; v != nil {
+		builder.WriteString("expire_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SigningKeys is a parsable slice of SigningKey.
+type SigningKeys []*SigningKey
@@ -0,0 +1,819 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/signingkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKeyCreate is the builder for creating a SigningKey entity.
+type SigningKeyCreate struct {
+	config
+	mutation *SigningKeyMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (skc *SigningKeyCreate) SetCreatedAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetCreatedAt(t)
+	return skc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableCreatedAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetCreatedAt(*t)
+	}
+	return skc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (skc *SigningKeyCreate) SetUpdatedAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetUpdatedAt(t)
+	return skc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableUpdatedAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetUpdatedAt(*t)
+	}
+	return skc
+}
+
+// SetKid sets the "kid" field.
+func (skc *SigningKeyCreate) SetKid(s string) *SigningKeyCreate {
+	skc.mutation.SetKid(s)
+	return skc
+}
+
+// SetSigningMethod sets the "signing_method" field.
+func (skc *SigningKeyCreate) SetSigningMethod(i int) *SigningKeyCreate {
+	skc.mutation.SetSigningMethod(i)
+	return skc
+}
+
+// SetPrivateKey sets the "private_key" field.
+func (skc *SigningKeyCreate) SetPrivateKey(s string) *SigningKeyCreate {
+	skc.mutation.SetPrivateKey(s)
+	return skc
+}
+
+// SetPublicKey sets the "public_key" field.
+func (skc *SigningKeyCreate) SetPublicKey(s string) *SigningKeyCreate {
+	skc.mutation.SetPublicKey(s)
+	return skc
+}
+
+// SetActive sets the "active" field.
+func (skc *SigningKeyCreate) SetActive(b bool) *SigningKeyCreate {
+	skc.mutation.SetActive(b)
+	return skc
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (skc *SigningKeyCreate) SetRotatedAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetRotatedAt(t)
+	return skc
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableRotatedAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetRotatedAt(*t)
+	}
+	return skc
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (skc *SigningKeyCreate) SetExpireAt(t time.Time) *SigningKeyCreate {
+	skc.mutation.SetExpireAt(t)
+	return skc
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (skc *SigningKeyCreate) SetNillableExpireAt(t *time.Time) *SigningKeyCreate {
+	if t != nil {
+		skc.SetExpireAt(*t)
+	}
+	return skc
+}
+
+// SetID sets the "id" field.
+func (skc *SigningKeyCreate) SetID(i int64) *SigningKeyCreate {
+	skc.mutation.SetID(i)
+	return skc
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skc *SigningKeyCreate) Mutation() *SigningKeyMutation {
+	return skc.mutation
+}
+
+// Save creates the SigningKey in the database.
+func (skc *SigningKeyCreate) Save(ctx context.Context) (*SigningKey, error) {
+	skc.defaults()
+	return withHooks(ctx, skc.sqlSave, skc.mutation, skc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (skc *SigningKeyCreate) SaveX(ctx context.Context) *SigningKey {
+	v, err := skc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skc *SigningKeyCreate) Exec(ctx context.Context) error {
+	_, err := skc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skc *SigningKeyCreate) ExecX(ctx context.Context) {
+	if err := skc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skc *SigningKeyCreate) defaults() {
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		v := signingkey.DefaultCreatedAt()
+		skc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := skc.mutation.UpdatedAt(); !ok {
+		v := signingkey.DefaultUpdatedAt()
+		skc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (skc *SigningKeyCreate) check() error {
+	if _, ok := skc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SigningKey.created_at"`)}
+	}
+	if _, ok := skc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "SigningKey.updated_at"`)}
+	}
+	if _, ok := skc.mutation.Kid(); !ok {
+		return &ValidationError{Name: "kid", err: errors.New(`ent: missing required field "SigningKey.kid"`)}
+	}
+	if _, ok := skc.mutation.SigningMethod(); !ok {
+		return &ValidationError{Name: "signing_method", err: errors.New(`ent: missing required field "SigningKey.signing_method"`)}
+	}
+	if _, ok := skc.mutation.PrivateKey(); !ok {
+		return &ValidationError{Name: "private_key", err: errors.New(`ent: missing required field "SigningKey.private_key"`)}
+	}
+	if _, ok := skc.mutation.PublicKey(); !ok {
+		return &ValidationError{Name: "public_key", err: errors.New(`ent: missing required field "SigningKey.public_key"`)}
+	}
+	if _, ok := skc.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "SigningKey.active"`)}
+	}
+	return nil
+}
+
+func (skc *SigningKeyCreate) sqlSave(ctx context.Context) (*SigningKey, error) {
+	if err := skc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := skc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, skc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	skc.mutation.id = &_node.ID
+	skc.mutation.done = true
+	return _node, nil
+}
+
+func (skc *SigningKeyCreate) createSpec() (*SigningKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &SigningKey{config: skc.config}
+		_spec = sqlgraph.NewCreateSpec(signingkey.Table, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = skc.conflict
+	if id, ok := skc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := skc.mutation.CreatedAt(); ok {
+		_spec.SetField(signingkey.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := skc.mutation.UpdatedAt(); ok {
+		_spec.SetField(signingkey.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := skc.mutation.Kid(); ok {
+		_spec.SetField(signingkey.FieldKid, field.TypeString, value)
+		_node.Kid = value
+	}
+	if value, ok := skc.mutation.SigningMethod(); ok {
+		_spec.SetField(signingkey.FieldSigningMethod, field.TypeInt, value)
+		_node.SigningMethod = value
+	}
+	if value, ok := skc.mutation.PrivateKey(); ok {
+		_spec.SetField(signingkey.FieldPrivateKey, field.TypeString, value)
+		_node.PrivateKey = value
+	}
+	if value, ok := skc.mutation.PublicKey(); ok {
+		_spec.SetField(signingkey.FieldPublicKey, field.TypeString, value)
+		_node.PublicKey = value
+	}
+	if value, ok := skc.mutation.Active(); ok {
+		_spec.SetField(signingkey.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := skc.mutation.RotatedAt(); ok {
+		_spec.SetField(signingkey.FieldRotatedAt, field.TypeTime, value)
+		_node.RotatedAt = &value
+	}
+	if value, ok := skc.mutation.ExpireAt(); ok {
+		_spec.SetField(signingkey.FieldExpireAt, field.TypeTime, value)
+		_node.ExpireAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SigningKey.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SigningKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (skc *SigningKeyCreate) OnConflict(opts ...sql.ConflictOption) *SigningKeyUpsertOne {
+	skc.conflict = opts
+	return &SigningKeyUpsertOne{
+		create: skc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (skc *SigningKeyCreate) OnConflictColumns(columns ...string) *SigningKeyUpsertOne {
+	skc.conflict = append(skc.conflict, sql.ConflictColumns(columns...))
+	return &SigningKeyUpsertOne{
+		create: skc,
+	}
+}
+
+type (
+	// SigningKeyUpsertOne is the builder for "upsert"-ing
+	//  one SigningKey node.
+	SigningKeyUpsertOne struct {
+		create *SigningKeyCreate
+	}
+
+	// SigningKeyUpsert is the "OnConflict" setter.
+	SigningKeyUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SigningKeyUpsert) SetUpdatedAt(v time.Time) *SigningKeyUpsert {
+	u.Set(signingkey.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateUpdatedAt() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldUpdatedAt)
+	return u
+}
+
+// SetActive sets the "active" field.
+func (u *SigningKeyUpsert) SetActive(v bool) *SigningKeyUpsert {
+	u.Set(signingkey.FieldActive, v)
+	return u
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateActive() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldActive)
+	return u
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *SigningKeyUpsert) SetRotatedAt(v time.Time) *SigningKeyUpsert {
+	u.Set(signingkey.FieldRotatedAt, v)
+	return u
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateRotatedAt() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldRotatedAt)
+	return u
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *SigningKeyUpsert) ClearRotatedAt() *SigningKeyUpsert {
+	u.SetNull(signingkey.FieldRotatedAt)
+	return u
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *SigningKeyUpsert) SetExpireAt(v time.Time) *SigningKeyUpsert {
+	u.Set(signingkey.FieldExpireAt, v)
+	return u
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *SigningKeyUpsert) UpdateExpireAt() *SigningKeyUpsert {
+	u.SetExcluded(signingkey.FieldExpireAt)
+	return u
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *SigningKeyUpsert) ClearExpireAt() *SigningKeyUpsert {
+	u.SetNull(signingkey.FieldExpireAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(signingkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SigningKeyUpsertOne) UpdateNewValues() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(signingkey.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(signingkey.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.Kid(); exists {
+			s.SetIgnore(signingkey.FieldKid)
+		}
+		if _, exists := u.create.mutation.SigningMethod(); exists {
+			s.SetIgnore(signingkey.FieldSigningMethod)
+		}
+		if _, exists := u.create.mutation.PrivateKey(); exists {
+			s.SetIgnore(signingkey.FieldPrivateKey)
+		}
+		if _, exists := u.create.mutation.PublicKey(); exists {
+			s.SetIgnore(signingkey.FieldPublicKey)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *SigningKeyUpsertOne) Ignore() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SigningKeyUpsertOne) DoNothing() *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SigningKeyCreate.OnConflict
+// documentation for more info.
+func (u *SigningKeyUpsertOne) Update(set func(*SigningKeyUpsert)) *SigningKeyUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SigningKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SigningKeyUpsertOne) SetUpdatedAt(v time.Time) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateUpdatedAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *SigningKeyUpsertOne) SetActive(v bool) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateActive() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *SigningKeyUpsertOne) SetRotatedAt(v time.Time) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetRotatedAt(v)
+	})
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateRotatedAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateRotatedAt()
+	})
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *SigningKeyUpsertOne) ClearRotatedAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearRotatedAt()
+	})
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *SigningKeyUpsertOne) SetExpireAt(v time.Time) *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetExpireAt(v)
+	})
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertOne) UpdateExpireAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateExpireAt()
+	})
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *SigningKeyUpsertOne) ClearExpireAt() *SigningKeyUpsertOne {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearExpireAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SigningKeyUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SigningKeyCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SigningKeyUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *SigningKeyUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *SigningKeyUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// SigningKeyCreateBulk is the builder for creating many SigningKey entities in bulk.
+type SigningKeyCreateBulk struct {
+	config
+	err      error
+	builders []*SigningKeyCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the SigningKey entities in the database.
+func (skcb *SigningKeyCreateBulk) Save(ctx context.Context) ([]*SigningKey, error) {
+	if skcb.err != nil {
+		return nil, skcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(skcb.builders))
+	nodes := make([]*SigningKey, len(skcb.builders))
+	mutators := make([]Mutator, len(skcb.builders))
+	for i := range skcb.builders {
+		func(i int, root context.Context) {
+			builder := skcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SigningKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, skcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = skcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, skcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, skcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) SaveX(ctx context.Context) []*SigningKey {
+	v, err := skcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (skcb *SigningKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := skcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skcb *SigningKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := skcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.SigningKey.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.SigningKeyUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (skcb *SigningKeyCreateBulk) OnConflict(opts ...sql.ConflictOption) *SigningKeyUpsertBulk {
+	skcb.conflict = opts
+	return &SigningKeyUpsertBulk{
+		create: skcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (skcb *SigningKeyCreateBulk) OnConflictColumns(columns ...string) *SigningKeyUpsertBulk {
+	skcb.conflict = append(skcb.conflict, sql.ConflictColumns(columns...))
+	return &SigningKeyUpsertBulk{
+		create: skcb,
+	}
+}
+
+// SigningKeyUpsertBulk is the builder for "upsert"-ing
+// a bulk of SigningKey nodes.
+type SigningKeyUpsertBulk struct {
+	create *SigningKeyCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(signingkey.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *SigningKeyUpsertBulk) UpdateNewValues() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(signingkey.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(signingkey.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.Kid(); exists {
+				s.SetIgnore(signingkey.FieldKid)
+			}
+			if _, exists := b.mutation.SigningMethod(); exists {
+				s.SetIgnore(signingkey.FieldSigningMethod)
+			}
+			if _, exists := b.mutation.PrivateKey(); exists {
+				s.SetIgnore(signingkey.FieldPrivateKey)
+			}
+			if _, exists := b.mutation.PublicKey(); exists {
+				s.SetIgnore(signingkey.FieldPublicKey)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.SigningKey.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *SigningKeyUpsertBulk) Ignore() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *SigningKeyUpsertBulk) DoNothing() *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the SigningKeyCreateBulk.OnConflict
+// documentation for more info.
+func (u *SigningKeyUpsertBulk) Update(set func(*SigningKeyUpsert)) *SigningKeyUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&SigningKeyUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *SigningKeyUpsertBulk) SetUpdatedAt(v time.Time) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateUpdatedAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetActive sets the "active" field.
+func (u *SigningKeyUpsertBulk) SetActive(v bool) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetActive(v)
+	})
+}
+
+// UpdateActive sets the "active" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateActive() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateActive()
+	})
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (u *SigningKeyUpsertBulk) SetRotatedAt(v time.Time) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetRotatedAt(v)
+	})
+}
+
+// UpdateRotatedAt sets the "rotated_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateRotatedAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateRotatedAt()
+	})
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (u *SigningKeyUpsertBulk) ClearRotatedAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearRotatedAt()
+	})
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (u *SigningKeyUpsertBulk) SetExpireAt(v time.Time) *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.SetExpireAt(v)
+	})
+}
+
+// UpdateExpireAt sets the "expire_at" field to the value that was provided on create.
+func (u *SigningKeyUpsertBulk) UpdateExpireAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.UpdateExpireAt()
+	})
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (u *SigningKeyUpsertBulk) ClearExpireAt() *SigningKeyUpsertBulk {
+	return u.Update(func(s *SigningKeyUpsert) {
+		s.ClearExpireAt()
+	})
+}
+
+// Exec executes the query.
+func (u *SigningKeyUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the SigningKeyCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for SigningKeyCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *SigningKeyUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,350 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/signingkey"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// SigningKeyUpdate is the builder for updating SigningKey entities.
+type SigningKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (sku *SigningKeyUpdate) Where(ps ...predicate.SigningKey) *SigningKeyUpdate {
+	sku.mutation.Where(ps...)
+	return sku
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sku *SigningKeyUpdate) SetUpdatedAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetUpdatedAt(t)
+	return sku
+}
+
+// SetActive sets the "active" field.
+func (sku *SigningKeyUpdate) SetActive(b bool) *SigningKeyUpdate {
+	sku.mutation.SetActive(b)
+	return sku
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableActive(b *bool) *SigningKeyUpdate {
+	if b != nil {
+		sku.SetActive(*b)
+	}
+	return sku
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (sku *SigningKeyUpdate) SetRotatedAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetRotatedAt(t)
+	return sku
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableRotatedAt(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetRotatedAt(*t)
+	}
+	return sku
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (sku *SigningKeyUpdate) ClearRotatedAt() *SigningKeyUpdate {
+	sku.mutation.ClearRotatedAt()
+	return sku
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (sku *SigningKeyUpdate) SetExpireAt(t time.Time) *SigningKeyUpdate {
+	sku.mutation.SetExpireAt(t)
+	return sku
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (sku *SigningKeyUpdate) SetNillableExpireAt(t *time.Time) *SigningKeyUpdate {
+	if t != nil {
+		sku.SetExpireAt(*t)
+	}
+	return sku
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (sku *SigningKeyUpdate) ClearExpireAt() *SigningKeyUpdate {
+	sku.mutation.ClearExpireAt()
+	return sku
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (sku *SigningKeyUpdate) Mutation() *SigningKeyMutation {
+	return sku.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (sku *SigningKeyUpdate) Save(ctx context.Context) (int, error) {
+	sku.defaults()
+	return withHooks(ctx, sku.sqlSave, sku.mutation, sku.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (sku *SigningKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := sku.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (sku *SigningKeyUpdate) Exec(ctx context.Context) error {
+	_, err := sku.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sku *SigningKeyUpdate) ExecX(ctx context.Context) {
+	if err := sku.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sku *SigningKeyUpdate) defaults() {
+	if _, ok := sku.mutation.UpdatedAt(); !ok {
+		v := signingkey.UpdateDefaultUpdatedAt()
+		sku.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (sku *SigningKeyUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeInt64))
+	if ps := sku.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := sku.mutation.UpdatedAt(); ok {
+		_spec.SetField(signingkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := sku.mutation.Active(); ok {
+		_spec.SetField(signingkey.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := sku.mutation.RotatedAt(); ok {
+		_spec.SetField(signingkey.FieldRotatedAt, field.TypeTime, value)
+	}
+	if sku.mutation.RotatedAtCleared() {
+		_spec.ClearField(signingkey.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := sku.mutation.ExpireAt(); ok {
+		_spec.SetField(signingkey.FieldExpireAt, field.TypeTime, value)
+	}
+	if sku.mutation.ExpireAtCleared() {
+		_spec.ClearField(signingkey.FieldExpireAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, sku.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	sku.mutation.done = true
+	return n, nil
+}
+
+// SigningKeyUpdateOne is the builder for updating a single SigningKey entity.
+type SigningKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SigningKeyMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (skuo *SigningKeyUpdateOne) SetUpdatedAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetUpdatedAt(t)
+	return skuo
+}
+
+// SetActive sets the "active" field.
+func (skuo *SigningKeyUpdateOne) SetActive(b bool) *SigningKeyUpdateOne {
+	skuo.mutation.SetActive(b)
+	return skuo
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableActive(b *bool) *SigningKeyUpdateOne {
+	if b != nil {
+		skuo.SetActive(*b)
+	}
+	return skuo
+}
+
+// SetRotatedAt sets the "rotated_at" field.
+func (skuo *SigningKeyUpdateOne) SetRotatedAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetRotatedAt(t)
+	return skuo
+}
+
+// SetNillableRotatedAt sets the "rotated_at" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableRotatedAt(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetRotatedAt(*t)
+	}
+	return skuo
+}
+
+// ClearRotatedAt clears the value of the "rotated_at" field.
+func (skuo *SigningKeyUpdateOne) ClearRotatedAt() *SigningKeyUpdateOne {
+	skuo.mutation.ClearRotatedAt()
+	return skuo
+}
+
+// SetExpireAt sets the "expire_at" field.
+func (skuo *SigningKeyUpdateOne) SetExpireAt(t time.Time) *SigningKeyUpdateOne {
+	skuo.mutation.SetExpireAt(t)
+	return skuo
+}
+
+// SetNillableExpireAt sets the "expire_at" field if the given value is not nil.
+func (skuo *SigningKeyUpdateOne) SetNillableExpireAt(t *time.Time) *SigningKeyUpdateOne {
+	if t != nil {
+		skuo.SetExpireAt(*t)
+	}
+	return skuo
+}
+
+// ClearExpireAt clears the value of the "expire_at" field.
+func (skuo *SigningKeyUpdateOne) ClearExpireAt() *SigningKeyUpdateOne {
+	skuo.mutation.ClearExpireAt()
+	return skuo
+}
+
+// Mutation returns the SigningKeyMutation object of the builder.
+func (skuo *SigningKeyUpdateOne) Mutation() *SigningKeyMutation {
+	return skuo.mutation
+}
+
+// Where appends a list predicates to the SigningKeyUpdate builder.
+func (skuo *SigningKeyUpdateOne) Where(ps ...predicate.SigningKey) *SigningKeyUpdateOne {
+	skuo.mutation.Where(ps...)
+	return skuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (skuo *SigningKeyUpdateOne) Select(field string, fields ...string) *SigningKeyUpdateOne {
+	skuo.fields = append([]string{field}, fields...)
+	return skuo
+}
+
+// Save executes the query and returns the updated SigningKey entity.
+func (skuo *SigningKeyUpdateOne) Save(ctx context.Context) (*SigningKey, error) {
+	skuo.defaults()
+	return withHooks(ctx, skuo.sqlSave, skuo.mutation, skuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) SaveX(ctx context.Context) *SigningKey {
+	node, err := skuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (skuo *SigningKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := skuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (skuo *SigningKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := skuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (skuo *SigningKeyUpdateOne) defaults() {
+	if _, ok := skuo.mutation.UpdatedAt(); !ok {
+		v := signingkey.UpdateDefaultUpdatedAt()
+		skuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (skuo *SigningKeyUpdateOne) sqlSave(ctx context.Context) (_node *SigningKey, err error) {
+	_spec := sqlgraph.NewUpdateSpec(signingkey.Table, signingkey.Columns, sqlgraph.NewFieldSpec(signingkey.FieldID, field.TypeInt64))
+	id, ok := skuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "SigningKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := skuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, signingkey.FieldID)
+		for _, f := range fields {
+			if !signingkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != signingkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := skuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := skuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(signingkey.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := skuo.mutation.Active(); ok {
+		_spec.SetField(signingkey.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := skuo.mutation.RotatedAt(); ok {
+		_spec.SetField(signingkey.FieldRotatedAt, field.TypeTime, value)
+	}
+	if skuo.mutation.RotatedAtCleared() {
+		_spec.ClearField(signingkey.FieldRotatedAt, field.TypeTime)
+	}
+	if value, ok := skuo.mutation.ExpireAt(); ok {
+		_spec.SetField(signingkey.FieldExpireAt, field.TypeTime, value)
+	}
+	if skuo.mutation.ExpireAtCleared() {
+		_spec.ClearField(signingkey.FieldExpireAt, field.TypeTime)
+	}
+	_node = &SigningKey{config: skuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, skuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{signingkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	skuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,189 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TokenRevocation is the model entity for the TokenRevocation schema.
+type TokenRevocation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID int64 `json:"client_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *int64 `json:"user_id,omitempty"`
+	// Scope holds the value of the "scope" field.
+	Scope string `json:"scope,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// OperatorID holds the value of the "operator_id" field.
+	OperatorID *int64 `json:"operator_id,omitempty"`
+	// RevokedCount holds the value of the "revoked_count" field.
+	RevokedCount int `json:"revoked_count,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TokenRevocation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case tokenrevocation.FieldID, tokenrevocation.FieldClientID, tokenrevocation.FieldUserID, tokenrevocation.FieldOperatorID, tokenrevocation.FieldRevokedCount:
+			values[i] = new(sql.NullInt64)
+		case tokenrevocation.FieldScope, tokenrevocation.FieldReason:
+			values[i] = new(sql.NullString)
+		case tokenrevocation.FieldCreatedAt, tokenrevocation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TokenRevocation fields.
+func (tr *TokenRevocation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case tokenrevocation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tr.ID = int64(value.Int64)
+		case tokenrevocation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tr.CreatedAt = value.Time
+			}
+		case tokenrevocation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tr.UpdatedAt = value.Time
+			}
+		case tokenrevocation.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				tr.ClientID = value.Int64
+			}
+		case tokenrevocation.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				tr.UserID = new(int64)
+				*tr.UserID = value.Int64
+			}
+		case tokenrevocation.FieldScope:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field scope", values[i])
+			} else if value.Valid {
+				tr.Scope = value.String
+			}
+		case tokenrevocation.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				tr.Reason = value.String
+			}
+		case tokenrevocation.FieldOperatorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value.Valid {
+				tr.OperatorID = new(int64)
+				*tr.OperatorID = value.Int64
+			}
+		case tokenrevocation.FieldRevokedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revoked_count", values[i])
+			} else if value.Valid {
+				tr.RevokedCount = int(value.Int64)
+			}
+		default:
+			tr.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TokenRevocation.
+// This includes values selected through modifiers, order, etc.
+func (tr *TokenRevocation) Value(name string) (ent.Value, error) {
+	return tr.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TokenRevocation.
+// Note that you need to call TokenRevocation.Unwrap() before calling this method if this TokenRevocation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tr *TokenRevocation) Update() *TokenRevocationUpdateOne {
+	return NewTokenRevocationClient(tr.config).UpdateOne(tr)
+}
+
+// Unwrap unwraps the TokenRevocation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tr *TokenRevocation) Unwrap() *TokenRevocation {
+	_tx, ok := tr.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TokenRevocation is not a transactional entity")
+	}
+	tr.config.driver = _tx.drv
+	return tr
+}
+
+// String implements the fmt.Stringer.
+func (tr *TokenRevocation) String() string {
+	var builder strings.Builder
+	builder.WriteString("TokenRevocation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tr.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(tr.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tr.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", tr.ClientID))
+	builder.WriteString(", ")
+	if v := tr.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("scope=")
+	builder.WriteString(tr.Scope)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(tr.Reason)
+	builder.WriteString(", ")
+	if v := tr.OperatorID; v != nil {
+		builder.WriteString("operator_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("revoked_count=")
+	builder.WriteString(fmt.Sprintf("%v", tr.RevokedCount))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TokenRevocations is a parsable slice of TokenRevocation.
+type TokenRevocations []*TokenRevocation
@@ -0,0 +1,500 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenrevocation
+
+import (
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldClientID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldUserID, v))
+}
+
+// Scope applies equality check predicate on the "scope" field. It's identical to ScopeEQ.
+func Scope(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldScope, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldReason, v))
+}
+
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// RevokedCount applies equality check predicate on the "revoked_count" field. It's identical to RevokedCountEQ.
+func RevokedCount(v int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldRevokedCount, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldClientID, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotNull(FieldUserID))
+}
+
+// ScopeEQ applies the EQ predicate on the "scope" field.
+func ScopeEQ(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldScope, v))
+}
+
+// ScopeNEQ applies the NEQ predicate on the "scope" field.
+func ScopeNEQ(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldScope, v))
+}
+
+// ScopeIn applies the In predicate on the "scope" field.
+func ScopeIn(vs ...string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldScope, vs...))
+}
+
+// ScopeNotIn applies the NotIn predicate on the "scope" field.
+func ScopeNotIn(vs ...string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldScope, vs...))
+}
+
+// ScopeGT applies the GT predicate on the "scope" field.
+func ScopeGT(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldScope, v))
+}
+
+// ScopeGTE applies the GTE predicate on the "scope" field.
+func ScopeGTE(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldScope, v))
+}
+
+// ScopeLT applies the LT predicate on the "scope" field.
+func ScopeLT(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldScope, v))
+}
+
+// ScopeLTE applies the LTE predicate on the "scope" field.
+func ScopeLTE(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldScope, v))
+}
+
+// ScopeContains applies the Contains predicate on the "scope" field.
+func ScopeContains(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldContains(FieldScope, v))
+}
+
+// ScopeHasPrefix applies the HasPrefix predicate on the "scope" field.
+func ScopeHasPrefix(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldHasPrefix(FieldScope, v))
+}
+
+// ScopeHasSuffix applies the HasSuffix predicate on the "scope" field.
+func ScopeHasSuffix(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldHasSuffix(FieldScope, v))
+}
+
+// ScopeEqualFold applies the EqualFold predicate on the "scope" field.
+func ScopeEqualFold(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEqualFold(FieldScope, v))
+}
+
+// ScopeContainsFold applies the ContainsFold predicate on the "scope" field.
+func ScopeContainsFold(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldContainsFold(FieldScope, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldContainsFold(FieldReason, v))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v int64) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorIDIsNil applies the IsNil predicate on the "operator_id" field.
+func OperatorIDIsNil() predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIsNull(FieldOperatorID))
+}
+
+// OperatorIDNotNil applies the NotNil predicate on the "operator_id" field.
+func OperatorIDNotNil() predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotNull(FieldOperatorID))
+}
+
+// RevokedCountEQ applies the EQ predicate on the "revoked_count" field.
+func RevokedCountEQ(v int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldEQ(FieldRevokedCount, v))
+}
+
+// RevokedCountNEQ applies the NEQ predicate on the "revoked_count" field.
+func RevokedCountNEQ(v int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNEQ(FieldRevokedCount, v))
+}
+
+// RevokedCountIn applies the In predicate on the "revoked_count" field.
+func RevokedCountIn(vs ...int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldIn(FieldRevokedCount, vs...))
+}
+
+// RevokedCountNotIn applies the NotIn predicate on the "revoked_count" field.
+func RevokedCountNotIn(vs ...int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldNotIn(FieldRevokedCount, vs...))
+}
+
+// RevokedCountGT applies the GT predicate on the "revoked_count" field.
+func RevokedCountGT(v int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGT(FieldRevokedCount, v))
+}
+
+// RevokedCountGTE applies the GTE predicate on the "revoked_count" field.
+func RevokedCountGTE(v int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldGTE(FieldRevokedCount, v))
+}
+
+// RevokedCountLT applies the LT predicate on the "revoked_count" field.
+func RevokedCountLT(v int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLT(FieldRevokedCount, v))
+}
+
+// RevokedCountLTE applies the LTE predicate on the "revoked_count" field.
+func RevokedCountLTE(v int) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.FieldLTE(FieldRevokedCount, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TokenRevocation) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TokenRevocation) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TokenRevocation) predicate.TokenRevocation {
+	return predicate.TokenRevocation(sql.NotPredicates(p))
+}
@@ -0,0 +1,994 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TokenRevocationCreate is the builder for creating a TokenRevocation entity.
+type TokenRevocationCreate struct {
+	config
+	mutation *TokenRevocationMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (trc *TokenRevocationCreate) SetCreatedAt(t time.Time) *TokenRevocationCreate {
+	trc.mutation.SetCreatedAt(t)
+	return trc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (trc *TokenRevocationCreate) SetNillableCreatedAt(t *time.Time) *TokenRevocationCreate {
+	if t != nil {
+		trc.SetCreatedAt(*t)
+	}
+	return trc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (trc *TokenRevocationCreate) SetUpdatedAt(t time.Time) *TokenRevocationCreate {
+	trc.mutation.SetUpdatedAt(t)
+	return trc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (trc *TokenRevocationCreate) SetNillableUpdatedAt(t *time.Time) *TokenRevocationCreate {
+	if t != nil {
+		trc.SetUpdatedAt(*t)
+	}
+	return trc
+}
+
+// SetClientID sets the "client_id" field.
+func (trc *TokenRevocationCreate) SetClientID(i int64) *TokenRevocationCreate {
+	trc.mutation.SetClientID(i)
+	return trc
+}
+
+// SetUserID sets the "user_id" field.
+func (trc *TokenRevocationCreate) SetUserID(i int64) *TokenRevocationCreate {
+	trc.mutation.SetUserID(i)
+	return trc
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (trc *TokenRevocationCreate) SetNillableUserID(i *int64) *TokenRevocationCreate {
+	if i != nil {
+		trc.SetUserID(*i)
+	}
+	return trc
+}
+
+// SetScope sets the "scope" field.
+func (trc *TokenRevocationCreate) SetScope(s string) *TokenRevocationCreate {
+	trc.mutation.SetScope(s)
+	return trc
+}
+
+// SetReason sets the "reason" field.
+func (trc *TokenRevocationCreate) SetReason(s string) *TokenRevocationCreate {
+	trc.mutation.SetReason(s)
+	return trc
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (trc *TokenRevocationCreate) SetNillableReason(s *string) *TokenRevocationCreate {
+	if s != nil {
+		trc.SetReason(*s)
+	}
+	return trc
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (trc *TokenRevocationCreate) SetOperatorID(i int64) *TokenRevocationCreate {
+	trc.mutation.SetOperatorID(i)
+	return trc
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (trc *TokenRevocationCreate) SetNillableOperatorID(i *int64) *TokenRevocationCreate {
+	if i != nil {
+		trc.SetOperatorID(*i)
+	}
+	return trc
+}
+
+// SetRevokedCount sets the "revoked_count" field.
+func (trc *TokenRevocationCreate) SetRevokedCount(i int) *TokenRevocationCreate {
+	trc.mutation.SetRevokedCount(i)
+	return trc
+}
+
+// SetID sets the "id" field.
+func (trc *TokenRevocationCreate) SetID(i int64) *TokenRevocationCreate {
+	trc.mutation.SetID(i)
+	return trc
+}
+
+// Mutation returns the TokenRevocationMutation object of the builder.
+func (trc *TokenRevocationCreate) Mutation() *TokenRevocationMutation {
+	return trc.mutation
+}
+
+// Save creates the TokenRevocation in the database.
+func (trc *TokenRevocationCreate) Save(ctx context.Context) (*TokenRevocation, error) {
+	trc.defaults()
+	return withHooks(ctx, trc.sqlSave, trc.mutation, trc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (trc *TokenRevocationCreate) SaveX(ctx context.Context) *TokenRevocation {
+	v, err := trc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trc *TokenRevocationCreate) Exec(ctx context.Context) error {
+	_, err := trc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trc *TokenRevocationCreate) ExecX(ctx context.Context) {
+	if err := trc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (trc *TokenRevocationCreate) defaults() {
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		v := tokenrevocation.DefaultCreatedAt()
+		trc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		v := tokenrevocation.DefaultUpdatedAt()
+		trc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := trc.mutation.Reason(); !ok {
+		v := tokenrevocation.DefaultReason
+		trc.mutation.SetReason(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (trc *TokenRevocationCreate) check() error {
+	if _, ok := trc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TokenRevocation.created_at"`)}
+	}
+	if _, ok := trc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TokenRevocation.updated_at"`)}
+	}
+	if _, ok := trc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "TokenRevocation.client_id"`)}
+	}
+	if _, ok := trc.mutation.Scope(); !ok {
+		return &ValidationError{Name: "scope", err: errors.New(`ent: missing required field "TokenRevocation.scope"`)}
+	}
+	if _, ok := trc.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "TokenRevocation.reason"`)}
+	}
+	if _, ok := trc.mutation.RevokedCount(); !ok {
+		return &ValidationError{Name: "revoked_count", err: errors.New(`ent: missing required field "TokenRevocation.revoked_count"`)}
+	}
+	return nil
+}
+
+func (trc *TokenRevocationCreate) sqlSave(ctx context.Context) (*TokenRevocation, error) {
+	if err := trc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := trc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, trc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	trc.mutation.id = &_node.ID
+	trc.mutation.done = true
+	return _node, nil
+}
+
+func (trc *TokenRevocationCreate) createSpec() (*TokenRevocation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TokenRevocation{config: trc.config}
+		_spec = sqlgraph.NewCreateSpec(tokenrevocation.Table, sqlgraph.NewFieldSpec(tokenrevocation.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = trc.conflict
+	if id, ok := trc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := trc.mutation.CreatedAt(); ok {
+		_spec.SetField(tokenrevocation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := trc.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenrevocation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := trc.mutation.ClientID(); ok {
+		_spec.SetField(tokenrevocation.FieldClientID, field.TypeInt64, value)
+		_node.ClientID = value
+	}
+	if value, ok := trc.mutation.UserID(); ok {
+		_spec.SetField(tokenrevocation.FieldUserID, field.TypeInt64, value)
+		_node.UserID = &value
+	}
+	if value, ok := trc.mutation.Scope(); ok {
+		_spec.SetField(tokenrevocation.FieldScope, field.TypeString, value)
+		_node.Scope = value
+	}
+	if value, ok := trc.mutation.Reason(); ok {
+		_spec.SetField(tokenrevocation.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := trc.mutation.OperatorID(); ok {
+		_spec.SetField(tokenrevocation.FieldOperatorID, field.TypeInt64, value)
+		_node.OperatorID = &value
+	}
+	if value, ok := trc.mutation.RevokedCount(); ok {
+		_spec.SetField(tokenrevocation.FieldRevokedCount, field.TypeInt, value)
+		_node.RevokedCount = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenRevocation.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenRevocationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (trc *TokenRevocationCreate) OnConflict(opts ...sql.ConflictOption) *TokenRevocationUpsertOne {
+	trc.conflict = opts
+	return &TokenRevocationUpsertOne{
+		create: trc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trc *TokenRevocationCreate) OnConflictColumns(columns ...string) *TokenRevocationUpsertOne {
+	trc.conflict = append(trc.conflict, sql.ConflictColumns(columns...))
+	return &TokenRevocationUpsertOne{
+		create: trc,
+	}
+}
+
+type (
+	// TokenRevocationUpsertOne is the builder for "upsert"-ing
+	//  one TokenRevocation node.
+	TokenRevocationUpsertOne struct {
+		create *TokenRevocationCreate
+	}
+
+	// TokenRevocationUpsert is the "OnConflict" setter.
+	TokenRevocationUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenRevocationUpsert) SetUpdatedAt(v time.Time) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateUpdatedAt() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldUpdatedAt)
+	return u
+}
+
+// SetClientID sets the "client_id" field.
+func (u *TokenRevocationUpsert) SetClientID(v int64) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldClientID, v)
+	return u
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateClientID() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldClientID)
+	return u
+}
+
+// AddClientID adds v to the "client_id" field.
+func (u *TokenRevocationUpsert) AddClientID(v int64) *TokenRevocationUpsert {
+	u.Add(tokenrevocation.FieldClientID, v)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TokenRevocationUpsert) SetUserID(v int64) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateUserID() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *TokenRevocationUpsert) AddUserID(v int64) *TokenRevocationUpsert {
+	u.Add(tokenrevocation.FieldUserID, v)
+	return u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *TokenRevocationUpsert) ClearUserID() *TokenRevocationUpsert {
+	u.SetNull(tokenrevocation.FieldUserID)
+	return u
+}
+
+// SetScope sets the "scope" field.
+func (u *TokenRevocationUpsert) SetScope(v string) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldScope, v)
+	return u
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateScope() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldScope)
+	return u
+}
+
+// SetReason sets the "reason" field.
+func (u *TokenRevocationUpsert) SetReason(v string) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldReason, v)
+	return u
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateReason() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldReason)
+	return u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *TokenRevocationUpsert) SetOperatorID(v int64) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldOperatorID, v)
+	return u
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateOperatorID() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldOperatorID)
+	return u
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *TokenRevocationUpsert) AddOperatorID(v int64) *TokenRevocationUpsert {
+	u.Add(tokenrevocation.FieldOperatorID, v)
+	return u
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (u *TokenRevocationUpsert) ClearOperatorID() *TokenRevocationUpsert {
+	u.SetNull(tokenrevocation.FieldOperatorID)
+	return u
+}
+
+// SetRevokedCount sets the "revoked_count" field.
+func (u *TokenRevocationUpsert) SetRevokedCount(v int) *TokenRevocationUpsert {
+	u.Set(tokenrevocation.FieldRevokedCount, v)
+	return u
+}
+
+// UpdateRevokedCount sets the "revoked_count" field to the value that was provided on create.
+func (u *TokenRevocationUpsert) UpdateRevokedCount() *TokenRevocationUpsert {
+	u.SetExcluded(tokenrevocation.FieldRevokedCount)
+	return u
+}
+
+// AddRevokedCount adds v to the "revoked_count" field.
+func (u *TokenRevocationUpsert) AddRevokedCount(v int) *TokenRevocationUpsert {
+	u.Add(tokenrevocation.FieldRevokedCount, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenrevocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenRevocationUpsertOne) UpdateNewValues() *TokenRevocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(tokenrevocation.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(tokenrevocation.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TokenRevocationUpsertOne) Ignore() *TokenRevocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenRevocationUpsertOne) DoNothing() *TokenRevocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenRevocationCreate.OnConflict
+// documentation for more info.
+func (u *TokenRevocationUpsertOne) Update(set func(*TokenRevocationUpsert)) *TokenRevocationUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenRevocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenRevocationUpsertOne) SetUpdatedAt(v time.Time) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateUpdatedAt() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *TokenRevocationUpsertOne) SetClientID(v int64) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// AddClientID adds v to the "client_id" field.
+func (u *TokenRevocationUpsertOne) AddClientID(v int64) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.AddClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateClientID() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TokenRevocationUpsertOne) SetUserID(v int64) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *TokenRevocationUpsertOne) AddUserID(v int64) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateUserID() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *TokenRevocationUpsertOne) ClearUserID() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *TokenRevocationUpsertOne) SetScope(v string) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateScope() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *TokenRevocationUpsertOne) SetReason(v string) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateReason() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *TokenRevocationUpsertOne) SetOperatorID(v int64) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *TokenRevocationUpsertOne) AddOperatorID(v int64) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.AddOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateOperatorID() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (u *TokenRevocationUpsertOne) ClearOperatorID() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.ClearOperatorID()
+	})
+}
+
+// SetRevokedCount sets the "revoked_count" field.
+func (u *TokenRevocationUpsertOne) SetRevokedCount(v int) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetRevokedCount(v)
+	})
+}
+
+// AddRevokedCount adds v to the "revoked_count" field.
+func (u *TokenRevocationUpsertOne) AddRevokedCount(v int) *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.AddRevokedCount(v)
+	})
+}
+
+// UpdateRevokedCount sets the "revoked_count" field to the value that was provided on create.
+func (u *TokenRevocationUpsertOne) UpdateRevokedCount() *TokenRevocationUpsertOne {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateRevokedCount()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenRevocationUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenRevocationCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenRevocationUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TokenRevocationUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TokenRevocationUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TokenRevocationCreateBulk is the builder for creating many TokenRevocation entities in bulk.
+type TokenRevocationCreateBulk struct {
+	config
+	err      error
+	builders []*TokenRevocationCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TokenRevocation entities in the database.
+func (trcb *TokenRevocationCreateBulk) Save(ctx context.Context) ([]*TokenRevocation, error) {
+	if trcb.err != nil {
+		return nil, trcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(trcb.builders))
+	nodes := make([]*TokenRevocation, len(trcb.builders))
+	mutators := make([]Mutator, len(trcb.builders))
+	for i := range trcb.builders {
+		func(i int, root context.Context) {
+			builder := trcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TokenRevocationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, trcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = trcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, trcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, trcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (trcb *TokenRevocationCreateBulk) SaveX(ctx context.Context) []*TokenRevocation {
+	v, err := trcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (trcb *TokenRevocationCreateBulk) Exec(ctx context.Context) error {
+	_, err := trcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trcb *TokenRevocationCreateBulk) ExecX(ctx context.Context) {
+	if err := trcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TokenRevocation.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TokenRevocationUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (trcb *TokenRevocationCreateBulk) OnConflict(opts ...sql.ConflictOption) *TokenRevocationUpsertBulk {
+	trcb.conflict = opts
+	return &TokenRevocationUpsertBulk{
+		create: trcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (trcb *TokenRevocationCreateBulk) OnConflictColumns(columns ...string) *TokenRevocationUpsertBulk {
+	trcb.conflict = append(trcb.conflict, sql.ConflictColumns(columns...))
+	return &TokenRevocationUpsertBulk{
+		create: trcb,
+	}
+}
+
+// TokenRevocationUpsertBulk is the builder for "upsert"-ing
+// a bulk of TokenRevocation nodes.
+type TokenRevocationUpsertBulk struct {
+	create *TokenRevocationCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(tokenrevocation.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TokenRevocationUpsertBulk) UpdateNewValues() *TokenRevocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(tokenrevocation.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(tokenrevocation.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TokenRevocation.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TokenRevocationUpsertBulk) Ignore() *TokenRevocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TokenRevocationUpsertBulk) DoNothing() *TokenRevocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TokenRevocationCreateBulk.OnConflict
+// documentation for more info.
+func (u *TokenRevocationUpsertBulk) Update(set func(*TokenRevocationUpsert)) *TokenRevocationUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TokenRevocationUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TokenRevocationUpsertBulk) SetUpdatedAt(v time.Time) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateUpdatedAt() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetClientID sets the "client_id" field.
+func (u *TokenRevocationUpsertBulk) SetClientID(v int64) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetClientID(v)
+	})
+}
+
+// AddClientID adds v to the "client_id" field.
+func (u *TokenRevocationUpsertBulk) AddClientID(v int64) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.AddClientID(v)
+	})
+}
+
+// UpdateClientID sets the "client_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateClientID() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateClientID()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TokenRevocationUpsertBulk) SetUserID(v int64) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *TokenRevocationUpsertBulk) AddUserID(v int64) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateUserID() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (u *TokenRevocationUpsertBulk) ClearUserID() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.ClearUserID()
+	})
+}
+
+// SetScope sets the "scope" field.
+func (u *TokenRevocationUpsertBulk) SetScope(v string) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetScope(v)
+	})
+}
+
+// UpdateScope sets the "scope" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateScope() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateScope()
+	})
+}
+
+// SetReason sets the "reason" field.
+func (u *TokenRevocationUpsertBulk) SetReason(v string) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetReason(v)
+	})
+}
+
+// UpdateReason sets the "reason" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateReason() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateReason()
+	})
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (u *TokenRevocationUpsertBulk) SetOperatorID(v int64) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetOperatorID(v)
+	})
+}
+
+// AddOperatorID adds v to the "operator_id" field.
+func (u *TokenRevocationUpsertBulk) AddOperatorID(v int64) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.AddOperatorID(v)
+	})
+}
+
+// UpdateOperatorID sets the "operator_id" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateOperatorID() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateOperatorID()
+	})
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (u *TokenRevocationUpsertBulk) ClearOperatorID() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.ClearOperatorID()
+	})
+}
+
+// SetRevokedCount sets the "revoked_count" field.
+func (u *TokenRevocationUpsertBulk) SetRevokedCount(v int) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.SetRevokedCount(v)
+	})
+}
+
+// AddRevokedCount adds v to the "revoked_count" field.
+func (u *TokenRevocationUpsertBulk) AddRevokedCount(v int) *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.AddRevokedCount(v)
+	})
+}
+
+// UpdateRevokedCount sets the "revoked_count" field to the value that was provided on create.
+func (u *TokenRevocationUpsertBulk) UpdateRevokedCount() *TokenRevocationUpsertBulk {
+	return u.Update(func(s *TokenRevocationUpsert) {
+		s.UpdateRevokedCount()
+	})
+}
+
+// Exec executes the query.
+func (u *TokenRevocationUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TokenRevocationCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TokenRevocationCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TokenRevocationUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
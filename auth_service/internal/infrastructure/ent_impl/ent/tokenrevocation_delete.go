@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TokenRevocationDelete is the builder for deleting a TokenRevocation entity.
+type TokenRevocationDelete struct {
+	config
+	hooks    []Hook
+	mutation *TokenRevocationMutation
+}
+
+// Where appends a list predicates to the TokenRevocationDelete builder.
+func (trd *TokenRevocationDelete) Where(ps ...predicate.TokenRevocation) *TokenRevocationDelete {
+	trd.mutation.Where(ps...)
+	return trd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (trd *TokenRevocationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, trd.sqlExec, trd.mutation, trd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trd *TokenRevocationDelete) ExecX(ctx context.Context) int {
+	n, err := trd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (trd *TokenRevocationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(tokenrevocation.Table, sqlgraph.NewFieldSpec(tokenrevocation.FieldID, field.TypeInt64))
+	if ps := trd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, trd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	trd.mutation.done = true
+	return affected, err
+}
+
+// TokenRevocationDeleteOne is the builder for deleting a single TokenRevocation entity.
+type TokenRevocationDeleteOne struct {
+	trd *TokenRevocationDelete
+}
+
+// Where appends a list predicates to the TokenRevocationDelete builder.
+func (trdo *TokenRevocationDeleteOne) Where(ps ...predicate.TokenRevocation) *TokenRevocationDeleteOne {
+	trdo.trd.mutation.Where(ps...)
+	return trdo
+}
+
+// Exec executes the deletion query.
+func (trdo *TokenRevocationDeleteOne) Exec(ctx context.Context) error {
+	n, err := trdo.trd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{tokenrevocation.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (trdo *TokenRevocationDeleteOne) ExecX(ctx context.Context) {
+	if err := trdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,532 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TokenRevocationUpdate is the builder for updating TokenRevocation entities.
+type TokenRevocationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TokenRevocationMutation
+}
+
+// Where appends a list predicates to the TokenRevocationUpdate builder.
+func (tru *TokenRevocationUpdate) Where(ps ...predicate.TokenRevocation) *TokenRevocationUpdate {
+	tru.mutation.Where(ps...)
+	return tru
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tru *TokenRevocationUpdate) SetUpdatedAt(t time.Time) *TokenRevocationUpdate {
+	tru.mutation.SetUpdatedAt(t)
+	return tru
+}
+
+// SetClientID sets the "client_id" field.
+func (tru *TokenRevocationUpdate) SetClientID(i int64) *TokenRevocationUpdate {
+	tru.mutation.ResetClientID()
+	tru.mutation.SetClientID(i)
+	return tru
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (tru *TokenRevocationUpdate) SetNillableClientID(i *int64) *TokenRevocationUpdate {
+	if i != nil {
+		tru.SetClientID(*i)
+	}
+	return tru
+}
+
+// AddClientID adds i to the "client_id" field.
+func (tru *TokenRevocationUpdate) AddClientID(i int64) *TokenRevocationUpdate {
+	tru.mutation.AddClientID(i)
+	return tru
+}
+
+// SetUserID sets the "user_id" field.
+func (tru *TokenRevocationUpdate) SetUserID(i int64) *TokenRevocationUpdate {
+	tru.mutation.ResetUserID()
+	tru.mutation.SetUserID(i)
+	return tru
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tru *TokenRevocationUpdate) SetNillableUserID(i *int64) *TokenRevocationUpdate {
+	if i != nil {
+		tru.SetUserID(*i)
+	}
+	return tru
+}
+
+// AddUserID adds i to the "user_id" field.
+func (tru *TokenRevocationUpdate) AddUserID(i int64) *TokenRevocationUpdate {
+	tru.mutation.AddUserID(i)
+	return tru
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (tru *TokenRevocationUpdate) ClearUserID() *TokenRevocationUpdate {
+	tru.mutation.ClearUserID()
+	return tru
+}
+
+// SetScope sets the "scope" field.
+func (tru *TokenRevocationUpdate) SetScope(s string) *TokenRevocationUpdate {
+	tru.mutation.SetScope(s)
+	return tru
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (tru *TokenRevocationUpdate) SetNillableScope(s *string) *TokenRevocationUpdate {
+	if s != nil {
+		tru.SetScope(*s)
+	}
+	return tru
+}
+
+// SetReason sets the "reason" field.
+func (tru *TokenRevocationUpdate) SetReason(s string) *TokenRevocationUpdate {
+	tru.mutation.SetReason(s)
+	return tru
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (tru *TokenRevocationUpdate) SetNillableReason(s *string) *TokenRevocationUpdate {
+	if s != nil {
+		tru.SetReason(*s)
+	}
+	return tru
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (tru *TokenRevocationUpdate) SetOperatorID(i int64) *TokenRevocationUpdate {
+	tru.mutation.ResetOperatorID()
+	tru.mutation.SetOperatorID(i)
+	return tru
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (tru *TokenRevocationUpdate) SetNillableOperatorID(i *int64) *TokenRevocationUpdate {
+	if i != nil {
+		tru.SetOperatorID(*i)
+	}
+	return tru
+}
+
+// AddOperatorID adds i to the "operator_id" field.
+func (tru *TokenRevocationUpdate) AddOperatorID(i int64) *TokenRevocationUpdate {
+	tru.mutation.AddOperatorID(i)
+	return tru
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (tru *TokenRevocationUpdate) ClearOperatorID() *TokenRevocationUpdate {
+	tru.mutation.ClearOperatorID()
+	return tru
+}
+
+// SetRevokedCount sets the "revoked_count" field.
+func (tru *TokenRevocationUpdate) SetRevokedCount(i int) *TokenRevocationUpdate {
+	tru.mutation.ResetRevokedCount()
+	tru.mutation.SetRevokedCount(i)
+	return tru
+}
+
+// SetNillableRevokedCount sets the "revoked_count" field if the given value is not nil.
+func (tru *TokenRevocationUpdate) SetNillableRevokedCount(i *int) *TokenRevocationUpdate {
+	if i != nil {
+		tru.SetRevokedCount(*i)
+	}
+	return tru
+}
+
+// AddRevokedCount adds i to the "revoked_count" field.
+func (tru *TokenRevocationUpdate) AddRevokedCount(i int) *TokenRevocationUpdate {
+	tru.mutation.AddRevokedCount(i)
+	return tru
+}
+
+// Mutation returns the TokenRevocationMutation object of the builder.
+func (tru *TokenRevocationUpdate) Mutation() *TokenRevocationMutation {
+	return tru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tru *TokenRevocationUpdate) Save(ctx context.Context) (int, error) {
+	tru.defaults()
+	return withHooks(ctx, tru.sqlSave, tru.mutation, tru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tru *TokenRevocationUpdate) SaveX(ctx context.Context) int {
+	affected, err := tru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tru *TokenRevocationUpdate) Exec(ctx context.Context) error {
+	_, err := tru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tru *TokenRevocationUpdate) ExecX(ctx context.Context) {
+	if err := tru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tru *TokenRevocationUpdate) defaults() {
+	if _, ok := tru.mutation.UpdatedAt(); !ok {
+		v := tokenrevocation.UpdateDefaultUpdatedAt()
+		tru.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (tru *TokenRevocationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tokenrevocation.Table, tokenrevocation.Columns, sqlgraph.NewFieldSpec(tokenrevocation.FieldID, field.TypeInt64))
+	if ps := tru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tru.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenrevocation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tru.mutation.ClientID(); ok {
+		_spec.SetField(tokenrevocation.FieldClientID, field.TypeInt64, value)
+	}
+	if value, ok := tru.mutation.AddedClientID(); ok {
+		_spec.AddField(tokenrevocation.FieldClientID, field.TypeInt64, value)
+	}
+	if value, ok := tru.mutation.UserID(); ok {
+		_spec.SetField(tokenrevocation.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := tru.mutation.AddedUserID(); ok {
+		_spec.AddField(tokenrevocation.FieldUserID, field.TypeInt64, value)
+	}
+	if tru.mutation.UserIDCleared() {
+		_spec.ClearField(tokenrevocation.FieldUserID, field.TypeInt64)
+	}
+	if value, ok := tru.mutation.Scope(); ok {
+		_spec.SetField(tokenrevocation.FieldScope, field.TypeString, value)
+	}
+	if value, ok := tru.mutation.Reason(); ok {
+		_spec.SetField(tokenrevocation.FieldReason, field.TypeString, value)
+	}
+	if value, ok := tru.mutation.OperatorID(); ok {
+		_spec.SetField(tokenrevocation.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := tru.mutation.AddedOperatorID(); ok {
+		_spec.AddField(tokenrevocation.FieldOperatorID, field.TypeInt64, value)
+	}
+	if tru.mutation.OperatorIDCleared() {
+		_spec.ClearField(tokenrevocation.FieldOperatorID, field.TypeInt64)
+	}
+	if value, ok := tru.mutation.RevokedCount(); ok {
+		_spec.SetField(tokenrevocation.FieldRevokedCount, field.TypeInt, value)
+	}
+	if value, ok := tru.mutation.AddedRevokedCount(); ok {
+		_spec.AddField(tokenrevocation.FieldRevokedCount, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenrevocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tru.mutation.done = true
+	return n, nil
+}
+
+// TokenRevocationUpdateOne is the builder for updating a single TokenRevocation entity.
+type TokenRevocationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TokenRevocationMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (truo *TokenRevocationUpdateOne) SetUpdatedAt(t time.Time) *TokenRevocationUpdateOne {
+	truo.mutation.SetUpdatedAt(t)
+	return truo
+}
+
+// SetClientID sets the "client_id" field.
+func (truo *TokenRevocationUpdateOne) SetClientID(i int64) *TokenRevocationUpdateOne {
+	truo.mutation.ResetClientID()
+	truo.mutation.SetClientID(i)
+	return truo
+}
+
+// SetNillableClientID sets the "client_id" field if the given value is not nil.
+func (truo *TokenRevocationUpdateOne) SetNillableClientID(i *int64) *TokenRevocationUpdateOne {
+	if i != nil {
+		truo.SetClientID(*i)
+	}
+	return truo
+}
+
+// AddClientID adds i to the "client_id" field.
+func (truo *TokenRevocationUpdateOne) AddClientID(i int64) *TokenRevocationUpdateOne {
+	truo.mutation.AddClientID(i)
+	return truo
+}
+
+// SetUserID sets the "user_id" field.
+func (truo *TokenRevocationUpdateOne) SetUserID(i int64) *TokenRevocationUpdateOne {
+	truo.mutation.ResetUserID()
+	truo.mutation.SetUserID(i)
+	return truo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (truo *TokenRevocationUpdateOne) SetNillableUserID(i *int64) *TokenRevocationUpdateOne {
+	if i != nil {
+		truo.SetUserID(*i)
+	}
+	return truo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (truo *TokenRevocationUpdateOne) AddUserID(i int64) *TokenRevocationUpdateOne {
+	truo.mutation.AddUserID(i)
+	return truo
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (truo *TokenRevocationUpdateOne) ClearUserID() *TokenRevocationUpdateOne {
+	truo.mutation.ClearUserID()
+	return truo
+}
+
+// SetScope sets the "scope" field.
+func (truo *TokenRevocationUpdateOne) SetScope(s string) *TokenRevocationUpdateOne {
+	truo.mutation.SetScope(s)
+	return truo
+}
+
+// SetNillableScope sets the "scope" field if the given value is not nil.
+func (truo *TokenRevocationUpdateOne) SetNillableScope(s *string) *TokenRevocationUpdateOne {
+	if s != nil {
+		truo.SetScope(*s)
+	}
+	return truo
+}
+
+// SetReason sets the "reason" field.
+func (truo *TokenRevocationUpdateOne) SetReason(s string) *TokenRevocationUpdateOne {
+	truo.mutation.SetReason(s)
+	return truo
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (truo *TokenRevocationUpdateOne) SetNillableReason(s *string) *TokenRevocationUpdateOne {
+	if s != nil {
+		truo.SetReason(*s)
+	}
+	return truo
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (truo *TokenRevocationUpdateOne) SetOperatorID(i int64) *TokenRevocationUpdateOne {
+	truo.mutation.ResetOperatorID()
+	truo.mutation.SetOperatorID(i)
+	return truo
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (truo *TokenRevocationUpdateOne) SetNillableOperatorID(i *int64) *TokenRevocationUpdateOne {
+	if i != nil {
+		truo.SetOperatorID(*i)
+	}
+	return truo
+}
+
+// AddOperatorID adds i to the "operator_id" field.
+func (truo *TokenRevocationUpdateOne) AddOperatorID(i int64) *TokenRevocationUpdateOne {
+	truo.mutation.AddOperatorID(i)
+	return truo
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (truo *TokenRevocationUpdateOne) ClearOperatorID() *TokenRevocationUpdateOne {
+	truo.mutation.ClearOperatorID()
+	return truo
+}
+
+// SetRevokedCount sets the "revoked_count" field.
+func (truo *TokenRevocationUpdateOne) SetRevokedCount(i int) *TokenRevocationUpdateOne {
+	truo.mutation.ResetRevokedCount()
+	truo.mutation.SetRevokedCount(i)
+	return truo
+}
+
+// SetNillableRevokedCount sets the "revoked_count" field if the given value is not nil.
+func (truo *TokenRevocationUpdateOne) SetNillableRevokedCount(i *int) *TokenRevocationUpdateOne {
+	if i != nil {
+		truo.SetRevokedCount(*i)
+	}
+	return truo
+}
+
+// AddRevokedCount adds i to the "revoked_count" field.
+func (truo *TokenRevocationUpdateOne) AddRevokedCount(i int) *TokenRevocationUpdateOne {
+	truo.mutation.AddRevokedCount(i)
+	return truo
+}
+
+// Mutation returns the TokenRevocationMutation object of the builder.
+func (truo *TokenRevocationUpdateOne) Mutation() *TokenRevocationMutation {
+	return truo.mutation
+}
+
+// Where appends a list predicates to the TokenRevocationUpdate builder.
+func (truo *TokenRevocationUpdateOne) Where(ps ...predicate.TokenRevocation) *TokenRevocationUpdateOne {
+	truo.mutation.Where(ps...)
+	return truo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (truo *TokenRevocationUpdateOne) Select(field string, fields ...string) *TokenRevocationUpdateOne {
+	truo.fields = append([]string{field}, fields...)
+	return truo
+}
+
+// Save executes the query and returns the updated TokenRevocation entity.
+func (truo *TokenRevocationUpdateOne) Save(ctx context.Context) (*TokenRevocation, error) {
+	truo.defaults()
+	return withHooks(ctx, truo.sqlSave, truo.mutation, truo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (truo *TokenRevocationUpdateOne) SaveX(ctx context.Context) *TokenRevocation {
+	node, err := truo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (truo *TokenRevocationUpdateOne) Exec(ctx context.Context) error {
+	_, err := truo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (truo *TokenRevocationUpdateOne) ExecX(ctx context.Context) {
+	if err := truo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (truo *TokenRevocationUpdateOne) defaults() {
+	if _, ok := truo.mutation.UpdatedAt(); !ok {
+		v := tokenrevocation.UpdateDefaultUpdatedAt()
+		truo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (truo *TokenRevocationUpdateOne) sqlSave(ctx context.Context) (_node *TokenRevocation, err error) {
+	_spec := sqlgraph.NewUpdateSpec(tokenrevocation.Table, tokenrevocation.Columns, sqlgraph.NewFieldSpec(tokenrevocation.FieldID, field.TypeInt64))
+	id, ok := truo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TokenRevocation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := truo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenrevocation.FieldID)
+		for _, f := range fields {
+			if !tokenrevocation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tokenrevocation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := truo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := truo.mutation.UpdatedAt(); ok {
+		_spec.SetField(tokenrevocation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := truo.mutation.ClientID(); ok {
+		_spec.SetField(tokenrevocation.FieldClientID, field.TypeInt64, value)
+	}
+	if value, ok := truo.mutation.AddedClientID(); ok {
+		_spec.AddField(tokenrevocation.FieldClientID, field.TypeInt64, value)
+	}
+	if value, ok := truo.mutation.UserID(); ok {
+		_spec.SetField(tokenrevocation.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := truo.mutation.AddedUserID(); ok {
+		_spec.AddField(tokenrevocation.FieldUserID, field.TypeInt64, value)
+	}
+	if truo.mutation.UserIDCleared() {
+		_spec.ClearField(tokenrevocation.FieldUserID, field.TypeInt64)
+	}
+	if value, ok := truo.mutation.Scope(); ok {
+		_spec.SetField(tokenrevocation.FieldScope, field.TypeString, value)
+	}
+	if value, ok := truo.mutation.Reason(); ok {
+		_spec.SetField(tokenrevocation.FieldReason, field.TypeString, value)
+	}
+	if value, ok := truo.mutation.OperatorID(); ok {
+		_spec.SetField(tokenrevocation.FieldOperatorID, field.TypeInt64, value)
+	}
+	if value, ok := truo.mutation.AddedOperatorID(); ok {
+		_spec.AddField(tokenrevocation.FieldOperatorID, field.TypeInt64, value)
+	}
+	if truo.mutation.OperatorIDCleared() {
+		_spec.ClearField(tokenrevocation.FieldOperatorID, field.TypeInt64)
+	}
+	if value, ok := truo.mutation.RevokedCount(); ok {
+		_spec.SetField(tokenrevocation.FieldRevokedCount, field.TypeInt, value)
+	}
+	if value, ok := truo.mutation.AddedRevokedCount(); ok {
+		_spec.AddField(tokenrevocation.FieldRevokedCount, field.TypeInt, value)
+	}
+	_node = &TokenRevocation{config: truo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, truo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenrevocation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	truo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,161 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// TrustedDevice is the model entity for the TrustedDevice schema.
+type TrustedDevice struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Browser holds the value of the "browser" field.
+	Browser string `json:"browser,omitempty"`
+	// City holds the value of the "city" field.
+	City string `json:"city,omitempty"`
+	// CountryCode holds the value of the "country_code" field.
+	CountryCode  string `json:"country_code,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TrustedDevice) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trusteddevice.FieldID, trusteddevice.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case trusteddevice.FieldBrowser, trusteddevice.FieldCity, trusteddevice.FieldCountryCode:
+			values[i] = new(sql.NullString)
+		case trusteddevice.FieldCreatedAt, trusteddevice.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TrustedDevice fields.
+func (td *TrustedDevice) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trusteddevice.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			td.ID = int64(value.Int64)
+		case trusteddevice.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				td.CreatedAt = value.Time
+			}
+		case trusteddevice.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				td.UpdatedAt = value.Time
+			}
+		case trusteddevice.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				td.UserID = value.Int64
+			}
+		case trusteddevice.FieldBrowser:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field browser", values[i])
+			} else if value.Valid {
+				td.Browser = value.String
+			}
+		case trusteddevice.FieldCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field city", values[i])
+			} else if value.Valid {
+				td.City = value.String
+			}
+		case trusteddevice.FieldCountryCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country_code", values[i])
+			} else if value.Valid {
+				td.CountryCode = value.String
+			}
+		default:
+			td.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TrustedDevice.
+// This includes values selected through modifiers, order, etc.
+func (td *TrustedDevice) Value(name string) (ent.Value, error) {
+	return td.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TrustedDevice.
+// Note that you need to call TrustedDevice.Unwrap() before calling this method if this TrustedDevice
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (td *TrustedDevice) Update() *TrustedDeviceUpdateOne {
+	return NewTrustedDeviceClient(td.config).UpdateOne(td)
+}
+
+// Unwrap unwraps the TrustedDevice entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (td *TrustedDevice) Unwrap() *TrustedDevice {
+	_tx, ok := td.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TrustedDevice is not a transactional entity")
+	}
+	td.config.driver = _tx.drv
+	return td
+}
+
+// String implements the fmt.Stringer.
+func (td *TrustedDevice) String() string {
+	var builder strings.Builder
+	builder.WriteString("TrustedDevice(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", td.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(td.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(td.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", td.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("browser=")
+	builder.WriteString(td.Browser)
+	builder.WriteString(", ")
+	builder.WriteString("city=")
+	builder.WriteString(td.City)
+	builder.WriteString(", ")
+	builder.WriteString("country_code=")
+	builder.WriteString(td.CountryCode)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TrustedDevices is a parsable slice of TrustedDevice.
+type TrustedDevices []*TrustedDevice
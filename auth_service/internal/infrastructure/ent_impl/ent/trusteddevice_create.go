@@ -0,0 +1,766 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TrustedDeviceCreate is the builder for creating a TrustedDevice entity.
+type TrustedDeviceCreate struct {
+	config
+	mutation *TrustedDeviceMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tdc *TrustedDeviceCreate) SetCreatedAt(t time.Time) *TrustedDeviceCreate {
+	tdc.mutation.SetCreatedAt(t)
+	return tdc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tdc *TrustedDeviceCreate) SetNillableCreatedAt(t *time.Time) *TrustedDeviceCreate {
+	if t != nil {
+		tdc.SetCreatedAt(*t)
+	}
+	return tdc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tdc *TrustedDeviceCreate) SetUpdatedAt(t time.Time) *TrustedDeviceCreate {
+	tdc.mutation.SetUpdatedAt(t)
+	return tdc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tdc *TrustedDeviceCreate) SetNillableUpdatedAt(t *time.Time) *TrustedDeviceCreate {
+	if t != nil {
+		tdc.SetUpdatedAt(*t)
+	}
+	return tdc
+}
+
+// SetUserID sets the "user_id" field.
+func (tdc *TrustedDeviceCreate) SetUserID(i int64) *TrustedDeviceCreate {
+	tdc.mutation.SetUserID(i)
+	return tdc
+}
+
+// SetBrowser sets the "browser" field.
+func (tdc *TrustedDeviceCreate) SetBrowser(s string) *TrustedDeviceCreate {
+	tdc.mutation.SetBrowser(s)
+	return tdc
+}
+
+// SetCity sets the "city" field.
+func (tdc *TrustedDeviceCreate) SetCity(s string) *TrustedDeviceCreate {
+	tdc.mutation.SetCity(s)
+	return tdc
+}
+
+// SetCountryCode sets the "country_code" field.
+func (tdc *TrustedDeviceCreate) SetCountryCode(s string) *TrustedDeviceCreate {
+	tdc.mutation.SetCountryCode(s)
+	return tdc
+}
+
+// SetID sets the "id" field.
+func (tdc *TrustedDeviceCreate) SetID(i int64) *TrustedDeviceCreate {
+	tdc.mutation.SetID(i)
+	return tdc
+}
+
+// Mutation returns the TrustedDeviceMutation object of the builder.
+func (tdc *TrustedDeviceCreate) Mutation() *TrustedDeviceMutation {
+	return tdc.mutation
+}
+
+// Save creates the TrustedDevice in the database.
+func (tdc *TrustedDeviceCreate) Save(ctx context.Context) (*TrustedDevice, error) {
+	tdc.defaults()
+	return withHooks(ctx, tdc.sqlSave, tdc.mutation, tdc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tdc *TrustedDeviceCreate) SaveX(ctx context.Context) *TrustedDevice {
+	v, err := tdc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tdc *TrustedDeviceCreate) Exec(ctx context.Context) error {
+	_, err := tdc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdc *TrustedDeviceCreate) ExecX(ctx context.Context) {
+	if err := tdc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tdc *TrustedDeviceCreate) defaults() {
+	if _, ok := tdc.mutation.CreatedAt(); !ok {
+		v := trusteddevice.DefaultCreatedAt()
+		tdc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tdc.mutation.UpdatedAt(); !ok {
+		v := trusteddevice.DefaultUpdatedAt()
+		tdc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tdc *TrustedDeviceCreate) check() error {
+	if _, ok := tdc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TrustedDevice.created_at"`)}
+	}
+	if _, ok := tdc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TrustedDevice.updated_at"`)}
+	}
+	if _, ok := tdc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TrustedDevice.user_id"`)}
+	}
+	if _, ok := tdc.mutation.Browser(); !ok {
+		return &ValidationError{Name: "browser", err: errors.New(`ent: missing required field "TrustedDevice.browser"`)}
+	}
+	if _, ok := tdc.mutation.City(); !ok {
+		return &ValidationError{Name: "city", err: errors.New(`ent: missing required field "TrustedDevice.city"`)}
+	}
+	if _, ok := tdc.mutation.CountryCode(); !ok {
+		return &ValidationError{Name: "country_code", err: errors.New(`ent: missing required field "TrustedDevice.country_code"`)}
+	}
+	return nil
+}
+
+func (tdc *TrustedDeviceCreate) sqlSave(ctx context.Context) (*TrustedDevice, error) {
+	if err := tdc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tdc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tdc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	tdc.mutation.id = &_node.ID
+	tdc.mutation.done = true
+	return _node, nil
+}
+
+func (tdc *TrustedDeviceCreate) createSpec() (*TrustedDevice, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TrustedDevice{config: tdc.config}
+		_spec = sqlgraph.NewCreateSpec(trusteddevice.Table, sqlgraph.NewFieldSpec(trusteddevice.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = tdc.conflict
+	if id, ok := tdc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := tdc.mutation.CreatedAt(); ok {
+		_spec.SetField(trusteddevice.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tdc.mutation.UpdatedAt(); ok {
+		_spec.SetField(trusteddevice.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := tdc.mutation.UserID(); ok {
+		_spec.SetField(trusteddevice.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := tdc.mutation.Browser(); ok {
+		_spec.SetField(trusteddevice.FieldBrowser, field.TypeString, value)
+		_node.Browser = value
+	}
+	if value, ok := tdc.mutation.City(); ok {
+		_spec.SetField(trusteddevice.FieldCity, field.TypeString, value)
+		_node.City = value
+	}
+	if value, ok := tdc.mutation.CountryCode(); ok {
+		_spec.SetField(trusteddevice.FieldCountryCode, field.TypeString, value)
+		_node.CountryCode = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TrustedDevice.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TrustedDeviceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tdc *TrustedDeviceCreate) OnConflict(opts ...sql.ConflictOption) *TrustedDeviceUpsertOne {
+	tdc.conflict = opts
+	return &TrustedDeviceUpsertOne{
+		create: tdc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TrustedDevice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tdc *TrustedDeviceCreate) OnConflictColumns(columns ...string) *TrustedDeviceUpsertOne {
+	tdc.conflict = append(tdc.conflict, sql.ConflictColumns(columns...))
+	return &TrustedDeviceUpsertOne{
+		create: tdc,
+	}
+}
+
+type (
+	// TrustedDeviceUpsertOne is the builder for "upsert"-ing
+	//  one TrustedDevice node.
+	TrustedDeviceUpsertOne struct {
+		create *TrustedDeviceCreate
+	}
+
+	// TrustedDeviceUpsert is the "OnConflict" setter.
+	TrustedDeviceUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TrustedDeviceUpsert) SetUpdatedAt(v time.Time) *TrustedDeviceUpsert {
+	u.Set(trusteddevice.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TrustedDeviceUpsert) UpdateUpdatedAt() *TrustedDeviceUpsert {
+	u.SetExcluded(trusteddevice.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TrustedDeviceUpsert) SetUserID(v int64) *TrustedDeviceUpsert {
+	u.Set(trusteddevice.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TrustedDeviceUpsert) UpdateUserID() *TrustedDeviceUpsert {
+	u.SetExcluded(trusteddevice.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *TrustedDeviceUpsert) AddUserID(v int64) *TrustedDeviceUpsert {
+	u.Add(trusteddevice.FieldUserID, v)
+	return u
+}
+
+// SetBrowser sets the "browser" field.
+func (u *TrustedDeviceUpsert) SetBrowser(v string) *TrustedDeviceUpsert {
+	u.Set(trusteddevice.FieldBrowser, v)
+	return u
+}
+
+// UpdateBrowser sets the "browser" field to the value that was provided on create.
+func (u *TrustedDeviceUpsert) UpdateBrowser() *TrustedDeviceUpsert {
+	u.SetExcluded(trusteddevice.FieldBrowser)
+	return u
+}
+
+// SetCity sets the "city" field.
+func (u *TrustedDeviceUpsert) SetCity(v string) *TrustedDeviceUpsert {
+	u.Set(trusteddevice.FieldCity, v)
+	return u
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *TrustedDeviceUpsert) UpdateCity() *TrustedDeviceUpsert {
+	u.SetExcluded(trusteddevice.FieldCity)
+	return u
+}
+
+// SetCountryCode sets the "country_code" field.
+func (u *TrustedDeviceUpsert) SetCountryCode(v string) *TrustedDeviceUpsert {
+	u.Set(trusteddevice.FieldCountryCode, v)
+	return u
+}
+
+// UpdateCountryCode sets the "country_code" field to the value that was provided on create.
+func (u *TrustedDeviceUpsert) UpdateCountryCode() *TrustedDeviceUpsert {
+	u.SetExcluded(trusteddevice.FieldCountryCode)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.TrustedDevice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(trusteddevice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TrustedDeviceUpsertOne) UpdateNewValues() *TrustedDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(trusteddevice.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(trusteddevice.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TrustedDevice.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TrustedDeviceUpsertOne) Ignore() *TrustedDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TrustedDeviceUpsertOne) DoNothing() *TrustedDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TrustedDeviceCreate.OnConflict
+// documentation for more info.
+func (u *TrustedDeviceUpsertOne) Update(set func(*TrustedDeviceUpsert)) *TrustedDeviceUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TrustedDeviceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TrustedDeviceUpsertOne) SetUpdatedAt(v time.Time) *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertOne) UpdateUpdatedAt() *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TrustedDeviceUpsertOne) SetUserID(v int64) *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *TrustedDeviceUpsertOne) AddUserID(v int64) *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertOne) UpdateUserID() *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetBrowser sets the "browser" field.
+func (u *TrustedDeviceUpsertOne) SetBrowser(v string) *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetBrowser(v)
+	})
+}
+
+// UpdateBrowser sets the "browser" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertOne) UpdateBrowser() *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateBrowser()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *TrustedDeviceUpsertOne) SetCity(v string) *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertOne) UpdateCity() *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetCountryCode sets the "country_code" field.
+func (u *TrustedDeviceUpsertOne) SetCountryCode(v string) *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetCountryCode(v)
+	})
+}
+
+// UpdateCountryCode sets the "country_code" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertOne) UpdateCountryCode() *TrustedDeviceUpsertOne {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateCountryCode()
+	})
+}
+
+// Exec executes the query.
+func (u *TrustedDeviceUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TrustedDeviceCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TrustedDeviceUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TrustedDeviceUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TrustedDeviceUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// TrustedDeviceCreateBulk is the builder for creating many TrustedDevice entities in bulk.
+type TrustedDeviceCreateBulk struct {
+	config
+	err      error
+	builders []*TrustedDeviceCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the TrustedDevice entities in the database.
+func (tdcb *TrustedDeviceCreateBulk) Save(ctx context.Context) ([]*TrustedDevice, error) {
+	if tdcb.err != nil {
+		return nil, tdcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tdcb.builders))
+	nodes := make([]*TrustedDevice, len(tdcb.builders))
+	mutators := make([]Mutator, len(tdcb.builders))
+	for i := range tdcb.builders {
+		func(i int, root context.Context) {
+			builder := tdcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TrustedDeviceMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tdcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tdcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tdcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tdcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tdcb *TrustedDeviceCreateBulk) SaveX(ctx context.Context) []*TrustedDevice {
+	v, err := tdcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tdcb *TrustedDeviceCreateBulk) Exec(ctx context.Context) error {
+	_, err := tdcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdcb *TrustedDeviceCreateBulk) ExecX(ctx context.Context) {
+	if err := tdcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.TrustedDevice.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TrustedDeviceUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tdcb *TrustedDeviceCreateBulk) OnConflict(opts ...sql.ConflictOption) *TrustedDeviceUpsertBulk {
+	tdcb.conflict = opts
+	return &TrustedDeviceUpsertBulk{
+		create: tdcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.TrustedDevice.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tdcb *TrustedDeviceCreateBulk) OnConflictColumns(columns ...string) *TrustedDeviceUpsertBulk {
+	tdcb.conflict = append(tdcb.conflict, sql.ConflictColumns(columns...))
+	return &TrustedDeviceUpsertBulk{
+		create: tdcb,
+	}
+}
+
+// TrustedDeviceUpsertBulk is the builder for "upsert"-ing
+// a bulk of TrustedDevice nodes.
+type TrustedDeviceUpsertBulk struct {
+	create *TrustedDeviceCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.TrustedDevice.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(trusteddevice.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TrustedDeviceUpsertBulk) UpdateNewValues() *TrustedDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(trusteddevice.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(trusteddevice.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.TrustedDevice.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TrustedDeviceUpsertBulk) Ignore() *TrustedDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TrustedDeviceUpsertBulk) DoNothing() *TrustedDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TrustedDeviceCreateBulk.OnConflict
+// documentation for more info.
+func (u *TrustedDeviceUpsertBulk) Update(set func(*TrustedDeviceUpsert)) *TrustedDeviceUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TrustedDeviceUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *TrustedDeviceUpsertBulk) SetUpdatedAt(v time.Time) *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertBulk) UpdateUpdatedAt() *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *TrustedDeviceUpsertBulk) SetUserID(v int64) *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *TrustedDeviceUpsertBulk) AddUserID(v int64) *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertBulk) UpdateUserID() *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetBrowser sets the "browser" field.
+func (u *TrustedDeviceUpsertBulk) SetBrowser(v string) *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetBrowser(v)
+	})
+}
+
+// UpdateBrowser sets the "browser" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertBulk) UpdateBrowser() *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateBrowser()
+	})
+}
+
+// SetCity sets the "city" field.
+func (u *TrustedDeviceUpsertBulk) SetCity(v string) *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetCity(v)
+	})
+}
+
+// UpdateCity sets the "city" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertBulk) UpdateCity() *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateCity()
+	})
+}
+
+// SetCountryCode sets the "country_code" field.
+func (u *TrustedDeviceUpsertBulk) SetCountryCode(v string) *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.SetCountryCode(v)
+	})
+}
+
+// UpdateCountryCode sets the "country_code" field to the value that was provided on create.
+func (u *TrustedDeviceUpsertBulk) UpdateCountryCode() *TrustedDeviceUpsertBulk {
+	return u.Update(func(s *TrustedDeviceUpsert) {
+		s.UpdateCountryCode()
+	})
+}
+
+// Exec executes the query.
+func (u *TrustedDeviceUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TrustedDeviceCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TrustedDeviceCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TrustedDeviceUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
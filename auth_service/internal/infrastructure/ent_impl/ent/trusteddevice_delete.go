@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TrustedDeviceDelete is the builder for deleting a TrustedDevice entity.
+type TrustedDeviceDelete struct {
+	config
+	hooks    []Hook
+	mutation *TrustedDeviceMutation
+}
+
+// Where appends a list predicates to the TrustedDeviceDelete builder.
+func (tdd *TrustedDeviceDelete) Where(ps ...predicate.TrustedDevice) *TrustedDeviceDelete {
+	tdd.mutation.Where(ps...)
+	return tdd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tdd *TrustedDeviceDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tdd.sqlExec, tdd.mutation, tdd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdd *TrustedDeviceDelete) ExecX(ctx context.Context) int {
+	n, err := tdd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tdd *TrustedDeviceDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(trusteddevice.Table, sqlgraph.NewFieldSpec(trusteddevice.FieldID, field.TypeInt64))
+	if ps := tdd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tdd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tdd.mutation.done = true
+	return affected, err
+}
+
+// TrustedDeviceDeleteOne is the builder for deleting a single TrustedDevice entity.
+type TrustedDeviceDeleteOne struct {
+	tdd *TrustedDeviceDelete
+}
+
+// Where appends a list predicates to the TrustedDeviceDelete builder.
+func (tddo *TrustedDeviceDeleteOne) Where(ps ...predicate.TrustedDevice) *TrustedDeviceDeleteOne {
+	tddo.tdd.mutation.Where(ps...)
+	return tddo
+}
+
+// Exec executes the deletion query.
+func (tddo *TrustedDeviceDeleteOne) Exec(ctx context.Context) error {
+	n, err := tddo.tdd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{trusteddevice.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tddo *TrustedDeviceDeleteOne) ExecX(ctx context.Context) {
+	if err := tddo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,368 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// TrustedDeviceUpdate is the builder for updating TrustedDevice entities.
+type TrustedDeviceUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TrustedDeviceMutation
+}
+
+// Where appends a list predicates to the TrustedDeviceUpdate builder.
+func (tdu *TrustedDeviceUpdate) Where(ps ...predicate.TrustedDevice) *TrustedDeviceUpdate {
+	tdu.mutation.Where(ps...)
+	return tdu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tdu *TrustedDeviceUpdate) SetUpdatedAt(t time.Time) *TrustedDeviceUpdate {
+	tdu.mutation.SetUpdatedAt(t)
+	return tdu
+}
+
+// SetUserID sets the "user_id" field.
+func (tdu *TrustedDeviceUpdate) SetUserID(i int64) *TrustedDeviceUpdate {
+	tdu.mutation.ResetUserID()
+	tdu.mutation.SetUserID(i)
+	return tdu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tdu *TrustedDeviceUpdate) SetNillableUserID(i *int64) *TrustedDeviceUpdate {
+	if i != nil {
+		tdu.SetUserID(*i)
+	}
+	return tdu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (tdu *TrustedDeviceUpdate) AddUserID(i int64) *TrustedDeviceUpdate {
+	tdu.mutation.AddUserID(i)
+	return tdu
+}
+
+// SetBrowser sets the "browser" field.
+func (tdu *TrustedDeviceUpdate) SetBrowser(s string) *TrustedDeviceUpdate {
+	tdu.mutation.SetBrowser(s)
+	return tdu
+}
+
+// SetNillableBrowser sets the "browser" field if the given value is not nil.
+func (tdu *TrustedDeviceUpdate) SetNillableBrowser(s *string) *TrustedDeviceUpdate {
+	if s != nil {
+		tdu.SetBrowser(*s)
+	}
+	return tdu
+}
+
+// SetCity sets the "city" field.
+func (tdu *TrustedDeviceUpdate) SetCity(s string) *TrustedDeviceUpdate {
+	tdu.mutation.SetCity(s)
+	return tdu
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (tdu *TrustedDeviceUpdate) SetNillableCity(s *string) *TrustedDeviceUpdate {
+	if s != nil {
+		tdu.SetCity(*s)
+	}
+	return tdu
+}
+
+// SetCountryCode sets the "country_code" field.
+func (tdu *TrustedDeviceUpdate) SetCountryCode(s string) *TrustedDeviceUpdate {
+	tdu.mutation.SetCountryCode(s)
+	return tdu
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (tdu *TrustedDeviceUpdate) SetNillableCountryCode(s *string) *TrustedDeviceUpdate {
+	if s != nil {
+		tdu.SetCountryCode(*s)
+	}
+	return tdu
+}
+
+// Mutation returns the TrustedDeviceMutation object of the builder.
+func (tdu *TrustedDeviceUpdate) Mutation() *TrustedDeviceMutation {
+	return tdu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tdu *TrustedDeviceUpdate) Save(ctx context.Context) (int, error) {
+	tdu.defaults()
+	return withHooks(ctx, tdu.sqlSave, tdu.mutation, tdu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tdu *TrustedDeviceUpdate) SaveX(ctx context.Context) int {
+	affected, err := tdu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tdu *TrustedDeviceUpdate) Exec(ctx context.Context) error {
+	_, err := tdu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tdu *TrustedDeviceUpdate) ExecX(ctx context.Context) {
+	if err := tdu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tdu *TrustedDeviceUpdate) defaults() {
+	if _, ok := tdu.mutation.UpdatedAt(); !ok {
+		v := trusteddevice.UpdateDefaultUpdatedAt()
+		tdu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (tdu *TrustedDeviceUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(trusteddevice.Table, trusteddevice.Columns, sqlgraph.NewFieldSpec(trusteddevice.FieldID, field.TypeInt64))
+	if ps := tdu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tdu.mutation.UpdatedAt(); ok {
+		_spec.SetField(trusteddevice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tdu.mutation.UserID(); ok {
+		_spec.SetField(trusteddevice.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := tdu.mutation.AddedUserID(); ok {
+		_spec.AddField(trusteddevice.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := tdu.mutation.Browser(); ok {
+		_spec.SetField(trusteddevice.FieldBrowser, field.TypeString, value)
+	}
+	if value, ok := tdu.mutation.City(); ok {
+		_spec.SetField(trusteddevice.FieldCity, field.TypeString, value)
+	}
+	if value, ok := tdu.mutation.CountryCode(); ok {
+		_spec.SetField(trusteddevice.FieldCountryCode, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tdu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trusteddevice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tdu.mutation.done = true
+	return n, nil
+}
+
+// TrustedDeviceUpdateOne is the builder for updating a single TrustedDevice entity.
+type TrustedDeviceUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TrustedDeviceMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tduo *TrustedDeviceUpdateOne) SetUpdatedAt(t time.Time) *TrustedDeviceUpdateOne {
+	tduo.mutation.SetUpdatedAt(t)
+	return tduo
+}
+
+// SetUserID sets the "user_id" field.
+func (tduo *TrustedDeviceUpdateOne) SetUserID(i int64) *TrustedDeviceUpdateOne {
+	tduo.mutation.ResetUserID()
+	tduo.mutation.SetUserID(i)
+	return tduo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tduo *TrustedDeviceUpdateOne) SetNillableUserID(i *int64) *TrustedDeviceUpdateOne {
+	if i != nil {
+		tduo.SetUserID(*i)
+	}
+	return tduo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (tduo *TrustedDeviceUpdateOne) AddUserID(i int64) *TrustedDeviceUpdateOne {
+	tduo.mutation.AddUserID(i)
+	return tduo
+}
+
+// SetBrowser sets the "browser" field.
+func (tduo *TrustedDeviceUpdateOne) SetBrowser(s string) *TrustedDeviceUpdateOne {
+	tduo.mutation.SetBrowser(s)
+	return tduo
+}
+
+// SetNillableBrowser sets the "browser" field if the given value is not nil.
+func (tduo *TrustedDeviceUpdateOne) SetNillableBrowser(s *string) *TrustedDeviceUpdateOne {
+	if s != nil {
+		tduo.SetBrowser(*s)
+	}
+	return tduo
+}
+
+// SetCity sets the "city" field.
+func (tduo *TrustedDeviceUpdateOne) SetCity(s string) *TrustedDeviceUpdateOne {
+	tduo.mutation.SetCity(s)
+	return tduo
+}
+
+// SetNillableCity sets the "city" field if the given value is not nil.
+func (tduo *TrustedDeviceUpdateOne) SetNillableCity(s *string) *TrustedDeviceUpdateOne {
+	if s != nil {
+		tduo.SetCity(*s)
+	}
+	return tduo
+}
+
+// SetCountryCode sets the "country_code" field.
+func (tduo *TrustedDeviceUpdateOne) SetCountryCode(s string) *TrustedDeviceUpdateOne {
+	tduo.mutation.SetCountryCode(s)
+	return tduo
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (tduo *TrustedDeviceUpdateOne) SetNillableCountryCode(s *string) *TrustedDeviceUpdateOne {
+	if s != nil {
+		tduo.SetCountryCode(*s)
+	}
+	return tduo
+}
+
+// Mutation returns the TrustedDeviceMutation object of the builder.
+func (tduo *TrustedDeviceUpdateOne) Mutation() *TrustedDeviceMutation {
+	return tduo.mutation
+}
+
+// Where appends a list predicates to the TrustedDeviceUpdate builder.
+func (tduo *TrustedDeviceUpdateOne) Where(ps ...predicate.TrustedDevice) *TrustedDeviceUpdateOne {
+	tduo.mutation.Where(ps...)
+	return tduo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tduo *TrustedDeviceUpdateOne) Select(field string, fields ...string) *TrustedDeviceUpdateOne {
+	tduo.fields = append([]string{field}, fields...)
+	return tduo
+}
+
+// Save executes the query and returns the updated TrustedDevice entity.
+func (tduo *TrustedDeviceUpdateOne) Save(ctx context.Context) (*TrustedDevice, error) {
+	tduo.defaults()
+	return withHooks(ctx, tduo.sqlSave, tduo.mutation, tduo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tduo *TrustedDeviceUpdateOne) SaveX(ctx context.Context) *TrustedDevice {
+	node, err := tduo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tduo *TrustedDeviceUpdateOne) Exec(ctx context.Context) error {
+	_, err := tduo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tduo *TrustedDeviceUpdateOne) ExecX(ctx context.Context) {
+	if err := tduo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tduo *TrustedDeviceUpdateOne) defaults() {
+	if _, ok := tduo.mutation.UpdatedAt(); !ok {
+		v := trusteddevice.UpdateDefaultUpdatedAt()
+		tduo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (tduo *TrustedDeviceUpdateOne) sqlSave(ctx context.Context) (_node *TrustedDevice, err error) {
+	_spec := sqlgraph.NewUpdateSpec(trusteddevice.Table, trusteddevice.Columns, sqlgraph.NewFieldSpec(trusteddevice.FieldID, field.TypeInt64))
+	id, ok := tduo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TrustedDevice.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tduo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trusteddevice.FieldID)
+		for _, f := range fields {
+			if !trusteddevice.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trusteddevice.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tduo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tduo.mutation.UpdatedAt(); ok {
+		_spec.SetField(trusteddevice.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := tduo.mutation.UserID(); ok {
+		_spec.SetField(trusteddevice.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := tduo.mutation.AddedUserID(); ok {
+		_spec.AddField(trusteddevice.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := tduo.mutation.Browser(); ok {
+		_spec.SetField(trusteddevice.FieldBrowser, field.TypeString, value)
+	}
+	if value, ok := tduo.mutation.City(); ok {
+		_spec.SetField(trusteddevice.FieldCity, field.TypeString, value)
+	}
+	if value, ok := tduo.mutation.CountryCode(); ok {
+		_spec.SetField(trusteddevice.FieldCountryCode, field.TypeString, value)
+	}
+	_node = &TrustedDevice{config: tduo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tduo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trusteddevice.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tduo.mutation.done = true
+	return _node, nil
+}
@@ -18,10 +18,22 @@ type Tx struct {
 	AuthClient *AuthClientClient
 	// LoginRecord is the client for interacting with the LoginRecord builders.
 	LoginRecord *LoginRecordClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// PasswordHistory is the client for interacting with the PasswordHistory builders.
+	PasswordHistory *PasswordHistoryClient
 	// Role is the client for interacting with the Role builders.
 	Role *RoleClient
+	// SigningKey is the client for interacting with the SigningKey builders.
+	SigningKey *SigningKeyClient
+	// TokenRevocation is the client for interacting with the TokenRevocation builders.
+	TokenRevocation *TokenRevocationClient
+	// TrustedDevice is the client for interacting with the TrustedDevice builders.
+	TrustedDevice *TrustedDeviceClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// UserTotp is the client for interacting with the UserTotp builders.
+	UserTotp *UserTotpClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,8 +167,14 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.AuthClient = NewAuthClientClient(tx.config)
 	tx.LoginRecord = NewLoginRecordClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
+	tx.PasswordHistory = NewPasswordHistoryClient(tx.config)
 	tx.Role = NewRoleClient(tx.config)
+	tx.SigningKey = NewSigningKeyClient(tx.config)
+	tx.TokenRevocation = NewTokenRevocationClient(tx.config)
+	tx.TrustedDevice = NewTrustedDeviceClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.UserTotp = NewUserTotpClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
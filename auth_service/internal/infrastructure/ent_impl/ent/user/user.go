@@ -26,6 +26,12 @@ const (
 	FieldPasswordFailTimes = "password_fail_times"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldPasswordChangedAt holds the string denoting the password_changed_at field in the database.
+	FieldPasswordChangedAt = "password_changed_at"
+	// FieldLockedUntil holds the string denoting the locked_until field in the database.
+	FieldLockedUntil = "locked_until"
+	// FieldLockCount holds the string denoting the lock_count field in the database.
+	FieldLockCount = "lock_count"
 	// EdgeAuthClients holds the string denoting the auth_clients edge name in mutations.
 	EdgeAuthClients = "auth_clients"
 	// EdgeLoginRecords holds the string denoting the login_records edge name in mutations.
@@ -66,6 +72,9 @@ var Columns = []string{
 	FieldPassword,
 	FieldPasswordFailTimes,
 	FieldStatus,
+	FieldPasswordChangedAt,
+	FieldLockedUntil,
+	FieldLockCount,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "users"
@@ -97,6 +106,8 @@ var (
 	DefaultUpdatedAt func() time.Time
 	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
 	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultLockCount holds the default value on creation for the "lock_count" field.
+	DefaultLockCount int
 )
 
 // OrderOption defines the ordering options for the User queries.
@@ -137,6 +148,21 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByPasswordChangedAt orders the results by the password_changed_at field.
+func ByPasswordChangedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPasswordChangedAt, opts...).ToFunc()
+}
+
+// ByLockedUntil orders the results by the locked_until field.
+func ByLockedUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockedUntil, opts...).ToFunc()
+}
+
+// ByLockCount orders the results by the lock_count field.
+func ByLockCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLockCount, opts...).ToFunc()
+}
+
 // ByAuthClientsField orders the results by auth_clients field.
 func ByAuthClientsField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
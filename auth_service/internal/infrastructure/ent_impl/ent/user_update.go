@@ -107,6 +107,67 @@ func (uu *UserUpdate) AddStatus(i int) *UserUpdate {
 	return uu
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uu *UserUpdate) SetPasswordChangedAt(t time.Time) *UserUpdate {
+	uu.mutation.SetPasswordChangedAt(t)
+	return uu
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uu *UserUpdate) SetNillablePasswordChangedAt(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetPasswordChangedAt(*t)
+	}
+	return uu
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (uu *UserUpdate) ClearPasswordChangedAt() *UserUpdate {
+	uu.mutation.ClearPasswordChangedAt()
+	return uu
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uu *UserUpdate) SetLockedUntil(t time.Time) *UserUpdate {
+	uu.mutation.SetLockedUntil(t)
+	return uu
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLockedUntil(t *time.Time) *UserUpdate {
+	if t != nil {
+		uu.SetLockedUntil(*t)
+	}
+	return uu
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (uu *UserUpdate) ClearLockedUntil() *UserUpdate {
+	uu.mutation.ClearLockedUntil()
+	return uu
+}
+
+// SetLockCount sets the "lock_count" field.
+func (uu *UserUpdate) SetLockCount(i int) *UserUpdate {
+	uu.mutation.ResetLockCount()
+	uu.mutation.SetLockCount(i)
+	return uu
+}
+
+// SetNillableLockCount sets the "lock_count" field if the given value is not nil.
+func (uu *UserUpdate) SetNillableLockCount(i *int) *UserUpdate {
+	if i != nil {
+		uu.SetLockCount(*i)
+	}
+	return uu
+}
+
+// AddLockCount adds i to the "lock_count" field.
+func (uu *UserUpdate) AddLockCount(i int) *UserUpdate {
+	uu.mutation.AddLockCount(i)
+	return uu
+}
+
 // SetAuthClientsID sets the "auth_clients" edge to the AuthClient entity by ID.
 func (uu *UserUpdate) SetAuthClientsID(id int64) *UserUpdate {
 	uu.mutation.SetAuthClientsID(id)
@@ -264,6 +325,24 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := uu.mutation.AddedStatus(); ok {
 		_spec.AddField(user.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := uu.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if uu.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(user.FieldPasswordChangedAt, field.TypeTime)
+	}
+	if value, ok := uu.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if uu.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := uu.mutation.LockCount(); ok {
+		_spec.SetField(user.FieldLockCount, field.TypeInt, value)
+	}
+	if value, ok := uu.mutation.AddedLockCount(); ok {
+		_spec.AddField(user.FieldLockCount, field.TypeInt, value)
+	}
 	if uu.mutation.AuthClientsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -463,6 +542,67 @@ func (uuo *UserUpdateOne) AddStatus(i int) *UserUpdateOne {
 	return uuo
 }
 
+// SetPasswordChangedAt sets the "password_changed_at" field.
+func (uuo *UserUpdateOne) SetPasswordChangedAt(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetPasswordChangedAt(t)
+	return uuo
+}
+
+// SetNillablePasswordChangedAt sets the "password_changed_at" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillablePasswordChangedAt(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetPasswordChangedAt(*t)
+	}
+	return uuo
+}
+
+// ClearPasswordChangedAt clears the value of the "password_changed_at" field.
+func (uuo *UserUpdateOne) ClearPasswordChangedAt() *UserUpdateOne {
+	uuo.mutation.ClearPasswordChangedAt()
+	return uuo
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (uuo *UserUpdateOne) SetLockedUntil(t time.Time) *UserUpdateOne {
+	uuo.mutation.SetLockedUntil(t)
+	return uuo
+}
+
+// SetNillableLockedUntil sets the "locked_until" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLockedUntil(t *time.Time) *UserUpdateOne {
+	if t != nil {
+		uuo.SetLockedUntil(*t)
+	}
+	return uuo
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (uuo *UserUpdateOne) ClearLockedUntil() *UserUpdateOne {
+	uuo.mutation.ClearLockedUntil()
+	return uuo
+}
+
+// SetLockCount sets the "lock_count" field.
+func (uuo *UserUpdateOne) SetLockCount(i int) *UserUpdateOne {
+	uuo.mutation.ResetLockCount()
+	uuo.mutation.SetLockCount(i)
+	return uuo
+}
+
+// SetNillableLockCount sets the "lock_count" field if the given value is not nil.
+func (uuo *UserUpdateOne) SetNillableLockCount(i *int) *UserUpdateOne {
+	if i != nil {
+		uuo.SetLockCount(*i)
+	}
+	return uuo
+}
+
+// AddLockCount adds i to the "lock_count" field.
+func (uuo *UserUpdateOne) AddLockCount(i int) *UserUpdateOne {
+	uuo.mutation.AddLockCount(i)
+	return uuo
+}
+
 // SetAuthClientsID sets the "auth_clients" edge to the AuthClient entity by ID.
 func (uuo *UserUpdateOne) SetAuthClientsID(id int64) *UserUpdateOne {
 	uuo.mutation.SetAuthClientsID(id)
@@ -650,6 +790,24 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if value, ok := uuo.mutation.AddedStatus(); ok {
 		_spec.AddField(user.FieldStatus, field.TypeInt, value)
 	}
+	if value, ok := uuo.mutation.PasswordChangedAt(); ok {
+		_spec.SetField(user.FieldPasswordChangedAt, field.TypeTime, value)
+	}
+	if uuo.mutation.PasswordChangedAtCleared() {
+		_spec.ClearField(user.FieldPasswordChangedAt, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.LockedUntil(); ok {
+		_spec.SetField(user.FieldLockedUntil, field.TypeTime, value)
+	}
+	if uuo.mutation.LockedUntilCleared() {
+		_spec.ClearField(user.FieldLockedUntil, field.TypeTime)
+	}
+	if value, ok := uuo.mutation.LockCount(); ok {
+		_spec.SetField(user.FieldLockCount, field.TypeInt, value)
+	}
+	if value, ok := uuo.mutation.AddedLockCount(); ok {
+		_spec.AddField(user.FieldLockCount, field.TypeInt, value)
+	}
 	if uuo.mutation.AuthClientsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
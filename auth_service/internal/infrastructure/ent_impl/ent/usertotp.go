@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// UserTotp is the model entity for the UserTotp schema.
+type UserTotp struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Secret holds the value of the "secret" field.
+	Secret string `json:"-"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// RecoveryCodes holds the value of the "recovery_codes" field.
+	RecoveryCodes []string `json:"recovery_codes,omitempty"`
+	// EnabledAt holds the value of the "enabled_at" field.
+	EnabledAt    *time.Time `json:"enabled_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*UserTotp) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case usertotp.FieldRecoveryCodes:
+			values[i] = new([]byte)
+		case usertotp.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case usertotp.FieldID, usertotp.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case usertotp.FieldSecret:
+			values[i] = new(sql.NullString)
+		case usertotp.FieldCreatedAt, usertotp.FieldUpdatedAt, usertotp.FieldEnabledAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the UserTotp fields.
+func (ut *UserTotp) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case usertotp.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ut.ID = int64(value.Int64)
+		case usertotp.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ut.CreatedAt = value.Time
+			}
+		case usertotp.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ut.UpdatedAt = value.Time
+			}
+		case usertotp.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ut.UserID = value.Int64
+			}
+		case usertotp.FieldSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field secret", values[i])
+			} else if value.Valid {
+				ut.Secret = value.String
+			}
+		case usertotp.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				ut.Enabled = value.Bool
+			}
+		case usertotp.FieldRecoveryCodes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field recovery_codes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ut.RecoveryCodes); err != nil {
+					return fmt.Errorf("unmarshal field recovery_codes: %w", err)
+				}
+			}
+		case usertotp.FieldEnabledAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled_at", values[i])
+			} else if value.Valid {
+				ut.EnabledAt = new(time.Time)
+				*ut.EnabledAt = value.Time
+			}
+		default:
+			ut.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the UserTotp.
+// This includes values selected through modifiers, order, etc.
+func (ut *UserTotp) Value(name string) (ent.Value, error) {
+	return ut.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this UserTotp.
+// Note that you need to call UserTotp.Unwrap() before calling this method if this UserTotp
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ut *UserTotp) Update() *UserTotpUpdateOne {
+	return NewUserTotpClient(ut.config).UpdateOne(ut)
+}
+
+// Unwrap unwraps the UserTotp entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ut *UserTotp) Unwrap() *UserTotp {
+	_tx, ok := ut.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: UserTotp is not a transactional entity")
+	}
+	ut.config.driver = _tx.drv
+	return ut
+}
+
+// String implements the fmt.Stringer.
+func (ut *UserTotp) String() string {
+	var builder strings.Builder
+	builder.WriteString("UserTotp(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ut.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ut.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ut.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ut.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", ut.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("recovery_codes=")
+	builder.WriteString(fmt.Sprintf("%v", ut.RecoveryCodes))
+	builder.WriteString(", ")
+	if v := ut.EnabledAt; v != nil {
+		builder.WriteString("enabled_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// UserTotps is a parsable slice of UserTotp.
+type UserTotps []*UserTotp
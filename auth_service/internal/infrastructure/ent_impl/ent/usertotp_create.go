@@ -0,0 +1,873 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserTotpCreate is the builder for creating a UserTotp entity.
+type UserTotpCreate struct {
+	config
+	mutation *UserTotpMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (utc *UserTotpCreate) SetCreatedAt(t time.Time) *UserTotpCreate {
+	utc.mutation.SetCreatedAt(t)
+	return utc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableCreatedAt(t *time.Time) *UserTotpCreate {
+	if t != nil {
+		utc.SetCreatedAt(*t)
+	}
+	return utc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utc *UserTotpCreate) SetUpdatedAt(t time.Time) *UserTotpCreate {
+	utc.mutation.SetUpdatedAt(t)
+	return utc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableUpdatedAt(t *time.Time) *UserTotpCreate {
+	if t != nil {
+		utc.SetUpdatedAt(*t)
+	}
+	return utc
+}
+
+// SetUserID sets the "user_id" field.
+func (utc *UserTotpCreate) SetUserID(i int64) *UserTotpCreate {
+	utc.mutation.SetUserID(i)
+	return utc
+}
+
+// SetSecret sets the "secret" field.
+func (utc *UserTotpCreate) SetSecret(s string) *UserTotpCreate {
+	utc.mutation.SetSecret(s)
+	return utc
+}
+
+// SetEnabled sets the "enabled" field.
+func (utc *UserTotpCreate) SetEnabled(b bool) *UserTotpCreate {
+	utc.mutation.SetEnabled(b)
+	return utc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableEnabled(b *bool) *UserTotpCreate {
+	if b != nil {
+		utc.SetEnabled(*b)
+	}
+	return utc
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (utc *UserTotpCreate) SetRecoveryCodes(s []string) *UserTotpCreate {
+	utc.mutation.SetRecoveryCodes(s)
+	return utc
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (utc *UserTotpCreate) SetEnabledAt(t time.Time) *UserTotpCreate {
+	utc.mutation.SetEnabledAt(t)
+	return utc
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (utc *UserTotpCreate) SetNillableEnabledAt(t *time.Time) *UserTotpCreate {
+	if t != nil {
+		utc.SetEnabledAt(*t)
+	}
+	return utc
+}
+
+// SetID sets the "id" field.
+func (utc *UserTotpCreate) SetID(i int64) *UserTotpCreate {
+	utc.mutation.SetID(i)
+	return utc
+}
+
+// Mutation returns the UserTotpMutation object of the builder.
+func (utc *UserTotpCreate) Mutation() *UserTotpMutation {
+	return utc.mutation
+}
+
+// Save creates the UserTotp in the database.
+func (utc *UserTotpCreate) Save(ctx context.Context) (*UserTotp, error) {
+	utc.defaults()
+	return withHooks(ctx, utc.sqlSave, utc.mutation, utc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (utc *UserTotpCreate) SaveX(ctx context.Context) *UserTotp {
+	v, err := utc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (utc *UserTotpCreate) Exec(ctx context.Context) error {
+	_, err := utc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utc *UserTotpCreate) ExecX(ctx context.Context) {
+	if err := utc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utc *UserTotpCreate) defaults() {
+	if _, ok := utc.mutation.CreatedAt(); !ok {
+		v := usertotp.DefaultCreatedAt()
+		utc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := utc.mutation.UpdatedAt(); !ok {
+		v := usertotp.DefaultUpdatedAt()
+		utc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := utc.mutation.Enabled(); !ok {
+		v := usertotp.DefaultEnabled
+		utc.mutation.SetEnabled(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (utc *UserTotpCreate) check() error {
+	if _, ok := utc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "UserTotp.created_at"`)}
+	}
+	if _, ok := utc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "UserTotp.updated_at"`)}
+	}
+	if _, ok := utc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "UserTotp.user_id"`)}
+	}
+	if _, ok := utc.mutation.Secret(); !ok {
+		return &ValidationError{Name: "secret", err: errors.New(`ent: missing required field "UserTotp.secret"`)}
+	}
+	if _, ok := utc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "UserTotp.enabled"`)}
+	}
+	return nil
+}
+
+func (utc *UserTotpCreate) sqlSave(ctx context.Context) (*UserTotp, error) {
+	if err := utc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := utc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, utc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	utc.mutation.id = &_node.ID
+	utc.mutation.done = true
+	return _node, nil
+}
+
+func (utc *UserTotpCreate) createSpec() (*UserTotp, *sqlgraph.CreateSpec) {
+	var (
+		_node = &UserTotp{config: utc.config}
+		_spec = sqlgraph.NewCreateSpec(usertotp.Table, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeInt64))
+	)
+	_spec.OnConflict = utc.conflict
+	if id, ok := utc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := utc.mutation.CreatedAt(); ok {
+		_spec.SetField(usertotp.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := utc.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := utc.mutation.UserID(); ok {
+		_spec.SetField(usertotp.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := utc.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+		_node.Secret = value
+	}
+	if value, ok := utc.mutation.Enabled(); ok {
+		_spec.SetField(usertotp.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := utc.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertotp.FieldRecoveryCodes, field.TypeJSON, value)
+		_node.RecoveryCodes = value
+	}
+	if value, ok := utc.mutation.EnabledAt(); ok {
+		_spec.SetField(usertotp.FieldEnabledAt, field.TypeTime, value)
+		_node.EnabledAt = &value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserTotp.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserTotpUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (utc *UserTotpCreate) OnConflict(opts ...sql.ConflictOption) *UserTotpUpsertOne {
+	utc.conflict = opts
+	return &UserTotpUpsertOne{
+		create: utc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (utc *UserTotpCreate) OnConflictColumns(columns ...string) *UserTotpUpsertOne {
+	utc.conflict = append(utc.conflict, sql.ConflictColumns(columns...))
+	return &UserTotpUpsertOne{
+		create: utc,
+	}
+}
+
+type (
+	// UserTotpUpsertOne is the builder for "upsert"-ing
+	//  one UserTotp node.
+	UserTotpUpsertOne struct {
+		create *UserTotpCreate
+	}
+
+	// UserTotpUpsert is the "OnConflict" setter.
+	UserTotpUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTotpUpsert) SetUpdatedAt(v time.Time) *UserTotpUpsert {
+	u.Set(usertotp.FieldUpdatedAt, v)
+	return u
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateUpdatedAt() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldUpdatedAt)
+	return u
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTotpUpsert) SetUserID(v int64) *UserTotpUpsert {
+	u.Set(usertotp.FieldUserID, v)
+	return u
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateUserID() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldUserID)
+	return u
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserTotpUpsert) AddUserID(v int64) *UserTotpUpsert {
+	u.Add(usertotp.FieldUserID, v)
+	return u
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTotpUpsert) SetSecret(v string) *UserTotpUpsert {
+	u.Set(usertotp.FieldSecret, v)
+	return u
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateSecret() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldSecret)
+	return u
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *UserTotpUpsert) SetEnabled(v bool) *UserTotpUpsert {
+	u.Set(usertotp.FieldEnabled, v)
+	return u
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateEnabled() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldEnabled)
+	return u
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (u *UserTotpUpsert) SetRecoveryCodes(v []string) *UserTotpUpsert {
+	u.Set(usertotp.FieldRecoveryCodes, v)
+	return u
+}
+
+// UpdateRecoveryCodes sets the "recovery_codes" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateRecoveryCodes() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldRecoveryCodes)
+	return u
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (u *UserTotpUpsert) ClearRecoveryCodes() *UserTotpUpsert {
+	u.SetNull(usertotp.FieldRecoveryCodes)
+	return u
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (u *UserTotpUpsert) SetEnabledAt(v time.Time) *UserTotpUpsert {
+	u.Set(usertotp.FieldEnabledAt, v)
+	return u
+}
+
+// UpdateEnabledAt sets the "enabled_at" field to the value that was provided on create.
+func (u *UserTotpUpsert) UpdateEnabledAt() *UserTotpUpsert {
+	u.SetExcluded(usertotp.FieldEnabledAt)
+	return u
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (u *UserTotpUpsert) ClearEnabledAt() *UserTotpUpsert {
+	u.SetNull(usertotp.FieldEnabledAt)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usertotp.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserTotpUpsertOne) UpdateNewValues() *UserTotpUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(usertotp.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(usertotp.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *UserTotpUpsertOne) Ignore() *UserTotpUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserTotpUpsertOne) DoNothing() *UserTotpUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserTotpCreate.OnConflict
+// documentation for more info.
+func (u *UserTotpUpsertOne) Update(set func(*UserTotpUpsert)) *UserTotpUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserTotpUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTotpUpsertOne) SetUpdatedAt(v time.Time) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateUpdatedAt() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTotpUpsertOne) SetUserID(v int64) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserTotpUpsertOne) AddUserID(v int64) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateUserID() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTotpUpsertOne) SetSecret(v string) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateSecret() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *UserTotpUpsertOne) SetEnabled(v bool) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateEnabled() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (u *UserTotpUpsertOne) SetRecoveryCodes(v []string) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetRecoveryCodes(v)
+	})
+}
+
+// UpdateRecoveryCodes sets the "recovery_codes" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateRecoveryCodes() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateRecoveryCodes()
+	})
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (u *UserTotpUpsertOne) ClearRecoveryCodes() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.ClearRecoveryCodes()
+	})
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (u *UserTotpUpsertOne) SetEnabledAt(v time.Time) *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetEnabledAt(v)
+	})
+}
+
+// UpdateEnabledAt sets the "enabled_at" field to the value that was provided on create.
+func (u *UserTotpUpsertOne) UpdateEnabledAt() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateEnabledAt()
+	})
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (u *UserTotpUpsertOne) ClearEnabledAt() *UserTotpUpsertOne {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.ClearEnabledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserTotpUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserTotpCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserTotpUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *UserTotpUpsertOne) ID(ctx context.Context) (id int64, err error) {
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *UserTotpUpsertOne) IDX(ctx context.Context) int64 {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// UserTotpCreateBulk is the builder for creating many UserTotp entities in bulk.
+type UserTotpCreateBulk struct {
+	config
+	err      error
+	builders []*UserTotpCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the UserTotp entities in the database.
+func (utcb *UserTotpCreateBulk) Save(ctx context.Context) ([]*UserTotp, error) {
+	if utcb.err != nil {
+		return nil, utcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(utcb.builders))
+	nodes := make([]*UserTotp, len(utcb.builders))
+	mutators := make([]Mutator, len(utcb.builders))
+	for i := range utcb.builders {
+		func(i int, root context.Context) {
+			builder := utcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*UserTotpMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, utcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = utcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, utcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, utcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utcb *UserTotpCreateBulk) SaveX(ctx context.Context) []*UserTotp {
+	v, err := utcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (utcb *UserTotpCreateBulk) Exec(ctx context.Context) error {
+	_, err := utcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utcb *UserTotpCreateBulk) ExecX(ctx context.Context) {
+	if err := utcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.UserTotp.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.UserTotpUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (utcb *UserTotpCreateBulk) OnConflict(opts ...sql.ConflictOption) *UserTotpUpsertBulk {
+	utcb.conflict = opts
+	return &UserTotpUpsertBulk{
+		create: utcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (utcb *UserTotpCreateBulk) OnConflictColumns(columns ...string) *UserTotpUpsertBulk {
+	utcb.conflict = append(utcb.conflict, sql.ConflictColumns(columns...))
+	return &UserTotpUpsertBulk{
+		create: utcb,
+	}
+}
+
+// UserTotpUpsertBulk is the builder for "upsert"-ing
+// a bulk of UserTotp nodes.
+type UserTotpUpsertBulk struct {
+	create *UserTotpCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(usertotp.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *UserTotpUpsertBulk) UpdateNewValues() *UserTotpUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(usertotp.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(usertotp.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.UserTotp.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *UserTotpUpsertBulk) Ignore() *UserTotpUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *UserTotpUpsertBulk) DoNothing() *UserTotpUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the UserTotpCreateBulk.OnConflict
+// documentation for more info.
+func (u *UserTotpUpsertBulk) Update(set func(*UserTotpUpsert)) *UserTotpUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&UserTotpUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (u *UserTotpUpsertBulk) SetUpdatedAt(v time.Time) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetUpdatedAt(v)
+	})
+}
+
+// UpdateUpdatedAt sets the "updated_at" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateUpdatedAt() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateUpdatedAt()
+	})
+}
+
+// SetUserID sets the "user_id" field.
+func (u *UserTotpUpsertBulk) SetUserID(v int64) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetUserID(v)
+	})
+}
+
+// AddUserID adds v to the "user_id" field.
+func (u *UserTotpUpsertBulk) AddUserID(v int64) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.AddUserID(v)
+	})
+}
+
+// UpdateUserID sets the "user_id" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateUserID() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateUserID()
+	})
+}
+
+// SetSecret sets the "secret" field.
+func (u *UserTotpUpsertBulk) SetSecret(v string) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetSecret(v)
+	})
+}
+
+// UpdateSecret sets the "secret" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateSecret() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateSecret()
+	})
+}
+
+// SetEnabled sets the "enabled" field.
+func (u *UserTotpUpsertBulk) SetEnabled(v bool) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetEnabled(v)
+	})
+}
+
+// UpdateEnabled sets the "enabled" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateEnabled() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateEnabled()
+	})
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (u *UserTotpUpsertBulk) SetRecoveryCodes(v []string) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetRecoveryCodes(v)
+	})
+}
+
+// UpdateRecoveryCodes sets the "recovery_codes" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateRecoveryCodes() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateRecoveryCodes()
+	})
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (u *UserTotpUpsertBulk) ClearRecoveryCodes() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.ClearRecoveryCodes()
+	})
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (u *UserTotpUpsertBulk) SetEnabledAt(v time.Time) *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.SetEnabledAt(v)
+	})
+}
+
+// UpdateEnabledAt sets the "enabled_at" field to the value that was provided on create.
+func (u *UserTotpUpsertBulk) UpdateEnabledAt() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.UpdateEnabledAt()
+	})
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (u *UserTotpUpsertBulk) ClearEnabledAt() *UserTotpUpsertBulk {
+	return u.Update(func(s *UserTotpUpsert) {
+		s.ClearEnabledAt()
+	})
+}
+
+// Exec executes the query.
+func (u *UserTotpUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the UserTotpCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for UserTotpCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *UserTotpUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// UserTotpDelete is the builder for deleting a UserTotp entity.
+type UserTotpDelete struct {
+	config
+	hooks    []Hook
+	mutation *UserTotpMutation
+}
+
+// Where appends a list predicates to the UserTotpDelete builder.
+func (utd *UserTotpDelete) Where(ps ...predicate.UserTotp) *UserTotpDelete {
+	utd.mutation.Where(ps...)
+	return utd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (utd *UserTotpDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, utd.sqlExec, utd.mutation, utd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utd *UserTotpDelete) ExecX(ctx context.Context) int {
+	n, err := utd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (utd *UserTotpDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(usertotp.Table, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeInt64))
+	if ps := utd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, utd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	utd.mutation.done = true
+	return affected, err
+}
+
+// UserTotpDeleteOne is the builder for deleting a single UserTotp entity.
+type UserTotpDeleteOne struct {
+	utd *UserTotpDelete
+}
+
+// Where appends a list predicates to the UserTotpDelete builder.
+func (utdo *UserTotpDeleteOne) Where(ps ...predicate.UserTotp) *UserTotpDeleteOne {
+	utdo.utd.mutation.Where(ps...)
+	return utdo
+}
+
+// Exec executes the deletion query.
+func (utdo *UserTotpDeleteOne) Exec(ctx context.Context) error {
+	n, err := utdo.utd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{usertotp.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utdo *UserTotpDeleteOne) ExecX(ctx context.Context) {
+	if err := utdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,445 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// UserTotpUpdate is the builder for updating UserTotp entities.
+type UserTotpUpdate struct {
+	config
+	hooks    []Hook
+	mutation *UserTotpMutation
+}
+
+// Where appends a list predicates to the UserTotpUpdate builder.
+func (utu *UserTotpUpdate) Where(ps ...predicate.UserTotp) *UserTotpUpdate {
+	utu.mutation.Where(ps...)
+	return utu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utu *UserTotpUpdate) SetUpdatedAt(t time.Time) *UserTotpUpdate {
+	utu.mutation.SetUpdatedAt(t)
+	return utu
+}
+
+// SetUserID sets the "user_id" field.
+func (utu *UserTotpUpdate) SetUserID(i int64) *UserTotpUpdate {
+	utu.mutation.ResetUserID()
+	utu.mutation.SetUserID(i)
+	return utu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (utu *UserTotpUpdate) SetNillableUserID(i *int64) *UserTotpUpdate {
+	if i != nil {
+		utu.SetUserID(*i)
+	}
+	return utu
+}
+
+// AddUserID adds i to the "user_id" field.
+func (utu *UserTotpUpdate) AddUserID(i int64) *UserTotpUpdate {
+	utu.mutation.AddUserID(i)
+	return utu
+}
+
+// SetSecret sets the "secret" field.
+func (utu *UserTotpUpdate) SetSecret(s string) *UserTotpUpdate {
+	utu.mutation.SetSecret(s)
+	return utu
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (utu *UserTotpUpdate) SetNillableSecret(s *string) *UserTotpUpdate {
+	if s != nil {
+		utu.SetSecret(*s)
+	}
+	return utu
+}
+
+// SetEnabled sets the "enabled" field.
+func (utu *UserTotpUpdate) SetEnabled(b bool) *UserTotpUpdate {
+	utu.mutation.SetEnabled(b)
+	return utu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (utu *UserTotpUpdate) SetNillableEnabled(b *bool) *UserTotpUpdate {
+	if b != nil {
+		utu.SetEnabled(*b)
+	}
+	return utu
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (utu *UserTotpUpdate) SetRecoveryCodes(s []string) *UserTotpUpdate {
+	utu.mutation.SetRecoveryCodes(s)
+	return utu
+}
+
+// AppendRecoveryCodes appends s to the "recovery_codes" field.
+func (utu *UserTotpUpdate) AppendRecoveryCodes(s []string) *UserTotpUpdate {
+	utu.mutation.AppendRecoveryCodes(s)
+	return utu
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (utu *UserTotpUpdate) ClearRecoveryCodes() *UserTotpUpdate {
+	utu.mutation.ClearRecoveryCodes()
+	return utu
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (utu *UserTotpUpdate) SetEnabledAt(t time.Time) *UserTotpUpdate {
+	utu.mutation.SetEnabledAt(t)
+	return utu
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (utu *UserTotpUpdate) SetNillableEnabledAt(t *time.Time) *UserTotpUpdate {
+	if t != nil {
+		utu.SetEnabledAt(*t)
+	}
+	return utu
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (utu *UserTotpUpdate) ClearEnabledAt() *UserTotpUpdate {
+	utu.mutation.ClearEnabledAt()
+	return utu
+}
+
+// Mutation returns the UserTotpMutation object of the builder.
+func (utu *UserTotpUpdate) Mutation() *UserTotpMutation {
+	return utu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (utu *UserTotpUpdate) Save(ctx context.Context) (int, error) {
+	utu.defaults()
+	return withHooks(ctx, utu.sqlSave, utu.mutation, utu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utu *UserTotpUpdate) SaveX(ctx context.Context) int {
+	affected, err := utu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (utu *UserTotpUpdate) Exec(ctx context.Context) error {
+	_, err := utu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utu *UserTotpUpdate) ExecX(ctx context.Context) {
+	if err := utu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utu *UserTotpUpdate) defaults() {
+	if _, ok := utu.mutation.UpdatedAt(); !ok {
+		v := usertotp.UpdateDefaultUpdatedAt()
+		utu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (utu *UserTotpUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usertotp.Table, usertotp.Columns, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeInt64))
+	if ps := utu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utu.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := utu.mutation.UserID(); ok {
+		_spec.SetField(usertotp.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := utu.mutation.AddedUserID(); ok {
+		_spec.AddField(usertotp.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := utu.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := utu.mutation.Enabled(); ok {
+		_spec.SetField(usertotp.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := utu.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertotp.FieldRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := utu.mutation.AppendedRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usertotp.FieldRecoveryCodes, value)
+		})
+	}
+	if utu.mutation.RecoveryCodesCleared() {
+		_spec.ClearField(usertotp.FieldRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := utu.mutation.EnabledAt(); ok {
+		_spec.SetField(usertotp.FieldEnabledAt, field.TypeTime, value)
+	}
+	if utu.mutation.EnabledAtCleared() {
+		_spec.ClearField(usertotp.FieldEnabledAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, utu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertotp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	utu.mutation.done = true
+	return n, nil
+}
+
+// UserTotpUpdateOne is the builder for updating a single UserTotp entity.
+type UserTotpUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *UserTotpMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (utuo *UserTotpUpdateOne) SetUpdatedAt(t time.Time) *UserTotpUpdateOne {
+	utuo.mutation.SetUpdatedAt(t)
+	return utuo
+}
+
+// SetUserID sets the "user_id" field.
+func (utuo *UserTotpUpdateOne) SetUserID(i int64) *UserTotpUpdateOne {
+	utuo.mutation.ResetUserID()
+	utuo.mutation.SetUserID(i)
+	return utuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (utuo *UserTotpUpdateOne) SetNillableUserID(i *int64) *UserTotpUpdateOne {
+	if i != nil {
+		utuo.SetUserID(*i)
+	}
+	return utuo
+}
+
+// AddUserID adds i to the "user_id" field.
+func (utuo *UserTotpUpdateOne) AddUserID(i int64) *UserTotpUpdateOne {
+	utuo.mutation.AddUserID(i)
+	return utuo
+}
+
+// SetSecret sets the "secret" field.
+func (utuo *UserTotpUpdateOne) SetSecret(s string) *UserTotpUpdateOne {
+	utuo.mutation.SetSecret(s)
+	return utuo
+}
+
+// SetNillableSecret sets the "secret" field if the given value is not nil.
+func (utuo *UserTotpUpdateOne) SetNillableSecret(s *string) *UserTotpUpdateOne {
+	if s != nil {
+		utuo.SetSecret(*s)
+	}
+	return utuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (utuo *UserTotpUpdateOne) SetEnabled(b bool) *UserTotpUpdateOne {
+	utuo.mutation.SetEnabled(b)
+	return utuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (utuo *UserTotpUpdateOne) SetNillableEnabled(b *bool) *UserTotpUpdateOne {
+	if b != nil {
+		utuo.SetEnabled(*b)
+	}
+	return utuo
+}
+
+// SetRecoveryCodes sets the "recovery_codes" field.
+func (utuo *UserTotpUpdateOne) SetRecoveryCodes(s []string) *UserTotpUpdateOne {
+	utuo.mutation.SetRecoveryCodes(s)
+	return utuo
+}
+
+// AppendRecoveryCodes appends s to the "recovery_codes" field.
+func (utuo *UserTotpUpdateOne) AppendRecoveryCodes(s []string) *UserTotpUpdateOne {
+	utuo.mutation.AppendRecoveryCodes(s)
+	return utuo
+}
+
+// ClearRecoveryCodes clears the value of the "recovery_codes" field.
+func (utuo *UserTotpUpdateOne) ClearRecoveryCodes() *UserTotpUpdateOne {
+	utuo.mutation.ClearRecoveryCodes()
+	return utuo
+}
+
+// SetEnabledAt sets the "enabled_at" field.
+func (utuo *UserTotpUpdateOne) SetEnabledAt(t time.Time) *UserTotpUpdateOne {
+	utuo.mutation.SetEnabledAt(t)
+	return utuo
+}
+
+// SetNillableEnabledAt sets the "enabled_at" field if the given value is not nil.
+func (utuo *UserTotpUpdateOne) SetNillableEnabledAt(t *time.Time) *UserTotpUpdateOne {
+	if t != nil {
+		utuo.SetEnabledAt(*t)
+	}
+	return utuo
+}
+
+// ClearEnabledAt clears the value of the "enabled_at" field.
+func (utuo *UserTotpUpdateOne) ClearEnabledAt() *UserTotpUpdateOne {
+	utuo.mutation.ClearEnabledAt()
+	return utuo
+}
+
+// Mutation returns the UserTotpMutation object of the builder.
+func (utuo *UserTotpUpdateOne) Mutation() *UserTotpMutation {
+	return utuo.mutation
+}
+
+// Where appends a list predicates to the UserTotpUpdate builder.
+func (utuo *UserTotpUpdateOne) Where(ps ...predicate.UserTotp) *UserTotpUpdateOne {
+	utuo.mutation.Where(ps...)
+	return utuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (utuo *UserTotpUpdateOne) Select(field string, fields ...string) *UserTotpUpdateOne {
+	utuo.fields = append([]string{field}, fields...)
+	return utuo
+}
+
+// Save executes the query and returns the updated UserTotp entity.
+func (utuo *UserTotpUpdateOne) Save(ctx context.Context) (*UserTotp, error) {
+	utuo.defaults()
+	return withHooks(ctx, utuo.sqlSave, utuo.mutation, utuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (utuo *UserTotpUpdateOne) SaveX(ctx context.Context) *UserTotp {
+	node, err := utuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (utuo *UserTotpUpdateOne) Exec(ctx context.Context) error {
+	_, err := utuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (utuo *UserTotpUpdateOne) ExecX(ctx context.Context) {
+	if err := utuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (utuo *UserTotpUpdateOne) defaults() {
+	if _, ok := utuo.mutation.UpdatedAt(); !ok {
+		v := usertotp.UpdateDefaultUpdatedAt()
+		utuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (utuo *UserTotpUpdateOne) sqlSave(ctx context.Context) (_node *UserTotp, err error) {
+	_spec := sqlgraph.NewUpdateSpec(usertotp.Table, usertotp.Columns, sqlgraph.NewFieldSpec(usertotp.FieldID, field.TypeInt64))
+	id, ok := utuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "UserTotp.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := utuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, usertotp.FieldID)
+		for _, f := range fields {
+			if !usertotp.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != usertotp.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := utuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := utuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(usertotp.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := utuo.mutation.UserID(); ok {
+		_spec.SetField(usertotp.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := utuo.mutation.AddedUserID(); ok {
+		_spec.AddField(usertotp.FieldUserID, field.TypeInt64, value)
+	}
+	if value, ok := utuo.mutation.Secret(); ok {
+		_spec.SetField(usertotp.FieldSecret, field.TypeString, value)
+	}
+	if value, ok := utuo.mutation.Enabled(); ok {
+		_spec.SetField(usertotp.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := utuo.mutation.RecoveryCodes(); ok {
+		_spec.SetField(usertotp.FieldRecoveryCodes, field.TypeJSON, value)
+	}
+	if value, ok := utuo.mutation.AppendedRecoveryCodes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, usertotp.FieldRecoveryCodes, value)
+		})
+	}
+	if utuo.mutation.RecoveryCodesCleared() {
+		_spec.ClearField(usertotp.FieldRecoveryCodes, field.TypeJSON)
+	}
+	if value, ok := utuo.mutation.EnabledAt(); ok {
+		_spec.SetField(usertotp.FieldEnabledAt, field.TypeTime, value)
+	}
+	if utuo.mutation.EnabledAtCleared() {
+		_spec.ClearField(usertotp.FieldEnabledAt, field.TypeTime)
+	}
+	_node = &UserTotp{config: utuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, utuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{usertotp.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	utuo.mutation.done = true
+	return _node, nil
+}
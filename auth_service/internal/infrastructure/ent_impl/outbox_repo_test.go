@@ -0,0 +1,97 @@
+package ent_impl
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/infrastructure/db_impl"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/migrate"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/outbox"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newMemoryOutboxRepoImpl(t *testing.T) *OutboxRepoImpl {
+	client, err := ent.Open("sqlite3", "file:outbox?mode=memory&_fk=1")
+	require.Nil(t, err)
+
+	// Run migration
+	err = client.Schema.Create(context.Background(), migrate.WithDropIndex(true), migrate.WithDropColumn(true))
+	require.Nil(t, err)
+
+	return NewOutboxRepoImpl(db_impl.NewEntDb(client))
+}
+
+func TestListPendingOutboxEvents(t *testing.T) {
+	repo := newMemoryOutboxRepoImpl(t)
+	ctx := context.Background()
+	now := time.Now().UTC()
+
+	// inTx runs the changes of the relay in a transaction
+	inTx := func(t *testing.T, fn func(ctx context.Context) *cus_err.CusError) {
+		ctx, cusErr := repo.db.Begin(ctx)
+		require.Nil(t, cusErr)
+		require.Nil(t, fn(ctx))
+		_, cusErr = repo.db.Commit(ctx)
+		require.Nil(t, cusErr)
+	}
+
+	// The first rows are leased by another relay, waiting for the retry or parked
+	inTx(t, func(ctx context.Context) *cus_err.CusError {
+		return repo.AddEvents(ctx,
+			&outbox.Event{AggregateType: "user", AggregateId: "1", Type: "user.created", Version: 1},
+			&outbox.Event{AggregateType: "user", AggregateId: "1", Type: "user.locked", Version: 1},
+			&outbox.Event{AggregateType: "user", AggregateId: "2", Type: "user.created", Version: 1},
+			&outbox.Event{AggregateType: "user", AggregateId: "2", Type: "user.locked", Version: 1},
+			&outbox.Event{AggregateType: "client", AggregateId: "1", Type: "client.created", Version: 1},
+			&outbox.Event{AggregateType: "client", AggregateId: "1", Type: "client.deactivated", Version: 1},
+			&outbox.Event{AggregateType: "user", AggregateId: "3", Type: "user.created", Version: 1},
+			&outbox.Event{AggregateType: "user", AggregateId: "4", Type: "user.created", Version: 1},
+		)
+	})
+	messages, cusErr := repo.ListPending(ctx, now, 100)
+	require.Nil(t, cusErr)
+	require.Len(t, messages, 8)
+
+	inTx(t, func(ctx context.Context) *cus_err.CusError {
+		ok, cusErr := repo.Claim(ctx, messages[0].Id, now, now.Add(time.Minute))
+		if cusErr != nil {
+			return cusErr
+		}
+		require.True(t, ok)
+		if cusErr = repo.Retry(ctx, messages[2].Id, now.Add(time.Minute), "broker is unavailable"); cusErr != nil {
+			return cusErr
+		}
+		return repo.Park(ctx, messages[4].Id, now, "broker is unavailable")
+	})
+
+	t.Run("Skip the blocked aggregates", func(t *testing.T) {
+		pending, cusErr := repo.ListPending(ctx, now, 2)
+		require.Nil(t, cusErr)
+		require.Len(t, pending, 2)
+		assert.Equal(t, "3", pending[0].AggregateId)
+		assert.Equal(t, "4", pending[1].AggregateId)
+	})
+
+	t.Run("The parked event isn't claimed", func(t *testing.T) {
+		inTx(t, func(ctx context.Context) *cus_err.CusError {
+			ok, cusErr := repo.Claim(ctx, messages[4].Id, now, now.Add(time.Minute))
+			assert.False(t, ok)
+			return cusErr
+		})
+	})
+
+	t.Run("The aggregates are released after the lease and the retry time", func(t *testing.T) {
+		pending, cusErr := repo.ListPending(ctx, now.Add(time.Minute), 100)
+		require.Nil(t, cusErr)
+		ids := make([]int64, 0, len(pending))
+		for _, m := range pending {
+			ids = append(ids, m.Id)
+		}
+		assert.Equal(t, []int64{messages[0].Id, messages[1].Id, messages[2].Id, messages[3].Id, messages[6].Id, messages[7].Id}, ids)
+		assert.Equal(t, 1, pending[2].Attempts)
+	})
+}
@@ -0,0 +1,258 @@
+package ent_impl
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/entity"
+	"go_micro_service_api/auth_service/internal/domain/repository"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/signingkey"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
+const (
+	SigningKeyPrefix = "auth_signing_key"
+)
+
+type SigningKeyRepoImpl struct {
+	db    db.Database
+	cache db.Cache
+}
+
+var _ repository.SigningKeyRepo = (*SigningKeyRepoImpl)(nil)
+
+// NewSigningKeyRepoImpl creates a new instance of SigningKeyRepoImpl
+func NewSigningKeyRepoImpl(db db.Database, cache db.Cache) *SigningKeyRepoImpl {
+	return &SigningKeyRepoImpl{
+		db:    db,
+		cache: cache,
+	}
+}
+
+func (s *SigningKeyRepoImpl) Create(ctx context.Context, key *entity.SigningKey) (*entity.SigningKey, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get Client with transaction
+	tx, ok := s.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		cusErr := cus_err.New(cus_err.InternalServerError, "transaction not found in context", nil)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Create signing key
+	entKey, err := tx.SigningKey.Create().
+		SetKid(key.Kid).
+		SetSigningMethod(key.SigningMethod.Id).
+		SetPrivateKey(key.PrivateKey).
+		SetPublicKey(key.PublicKey).
+		SetActive(key.Active).
+		SetNillableRotatedAt(key.RotatedAt).
+		SetNillableExpireAt(key.ExpireAt).
+		Save(ctx)
+	if err != nil {
+		// Either the kid or an active key of the signing method exists
+		if ent.IsConstraintError(err) {
+			cusErr := cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("signing key %s or an active key of %s already exists", key.Kid, key.SigningMethod.Alg), err)
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to create signing key", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return s.toEntity(ctx, entKey)
+}
+
+// Retire stops the active key signing, it fails with Conflict if the key is already retired by another rotation.
+func (s *SigningKeyRepoImpl) Retire(ctx context.Context, key *entity.SigningKey) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get Client with transaction
+	tx, ok := s.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		cusErr := cus_err.New(cus_err.InternalServerError, "transaction not found in context", nil)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	// Only the state of the key can be updated, the key pair is immutable.
+	// The concurrent rotation waits for the row lock and then finds the key inactive.
+	n, err := tx.SigningKey.Update().
+		Where(
+			signingkey.ID(key.Id),
+			signingkey.Active(true),
+		).
+		SetActive(false).
+		SetNillableRotatedAt(key.RotatedAt).
+		SetNillableExpireAt(key.ExpireAt).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to retire signing key", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+	if n == 0 {
+		cusErr := cus_err.New(cus_err.Conflict, fmt.Sprintf("signing key %s is already retired", key.Kid), nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	// Delete cache, it is going to be cached again when it is found
+	cusErr := s.cache.Delete(ctx, fmt.Sprintf("%s:%s", SigningKeyPrefix, key.Kid))
+	if cusErr != nil && cusErr.Code().Int() != cus_err.ResourceNotFound { // Ignore if key not found
+		return cusErr
+	}
+
+	return nil
+}
+
+// FindActive finds the keys which sign new tokens of the signing method, ordered by the latest first.
+// There is at most one active key of a signing method, it is guarded by a partial unique index.
+func (s *SigningKeyRepoImpl) FindActive(ctx context.Context, signingMethod enum.SigningMethod) ([]*entity.SigningKey, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	entKeys, err := s.client(ctx).SigningKey.Query().
+		Where(
+			signingkey.SigningMethod(signingMethod.Id),
+			signingkey.Active(true),
+		).
+		Order(ent.Desc(signingkey.FieldCreatedAt), ent.Desc(signingkey.FieldID)).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to find signing keys", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	keys := make([]*entity.SigningKey, 0, len(entKeys))
+	for _, entKey := range entKeys {
+		key, cusErr := s.toEntity(ctx, entKey)
+		if cusErr != nil {
+			return nil, cusErr
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// FindByKid finds the key by kid to verify tokens,
+// the private key is not returned because the key is cached.
+func (s *SigningKeyRepoImpl) FindByKid(ctx context.Context, kid string) (*entity.SigningKey, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Fetch signing key from cache
+	key := fmt.Sprintf("%s:%s", SigningKeyPrefix, kid)
+	signingKey := &entity.SigningKey{}
+	cusErr := s.cache.GetObject(ctx, key, signingKey)
+	if cusErr == nil {
+		return signingKey, nil
+	}
+
+	// Find signing key
+	entKey, err := s.client(ctx).SigningKey.Query().
+		Where(signingkey.Kid(kid)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			cusErr := cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("signing key %s not found", kid), err)
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to find signing key", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	signingKey, cusErr = s.toEntity(ctx, entKey)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+	signingKey.PrivateKey = ""
+
+	// Save signing key in cache
+	cusErr = s.cache.SetObject(ctx, key, signingKey, 0)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return signingKey, nil
+}
+
+// FindUnexpired finds the keys which can still verify tokens, the private keys are not returned.
+func (s *SigningKeyRepoImpl) FindUnexpired(ctx context.Context) ([]*entity.SigningKey, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	entKeys, err := s.client(ctx).SigningKey.Query().
+		Where(
+			signingkey.Or(
+				signingkey.ExpireAtIsNil(),
+				signingkey.ExpireAtGT(time.Now()),
+			),
+		).
+		Order(ent.Asc(signingkey.FieldCreatedAt), ent.Asc(signingkey.FieldID)).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to find signing keys", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	keys := make([]*entity.SigningKey, 0, len(entKeys))
+	for _, entKey := range entKeys {
+		key, cusErr := s.toEntity(ctx, entKey)
+		if cusErr != nil {
+			return nil, cusErr
+		}
+		key.PrivateKey = ""
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// client returns the ent client with transaction if exists
+func (s *SigningKeyRepoImpl) client(ctx context.Context) *ent.Client {
+	tx, ok := s.db.GetTx(ctx).(*ent.Tx)
+	if ok {
+		return tx.Client()
+	}
+	return s.db.GetConn(ctx).(*ent.Client)
+}
+
+func (s *SigningKeyRepoImpl) toEntity(ctx context.Context, entKey *ent.SigningKey) (*entity.SigningKey, *cus_err.CusError) {
+	signingMethod, cusErr := enum.SigningMethodFromId(entKey.SigningMethod)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return &entity.SigningKey{
+		Id:            entKey.ID,
+		Kid:           entKey.Kid,
+		SigningMethod: signingMethod,
+		PrivateKey:    entKey.PrivateKey,
+		PublicKey:     entKey.PublicKey,
+		Active:        entKey.Active,
+		RotatedAt:     entKey.RotatedAt,
+		ExpireAt:      entKey.ExpireAt,
+		CreateAt:      entKey.CreatedAt,
+	}, nil
+}
@@ -5,9 +5,16 @@ import (
 	"go_micro_service_api/auth_service/internal/domain/aggregate"
 	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/repository"
+	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/authclient"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/loginrecord"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/passwordhistory"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/tokenrevocation"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/trusteddevice"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/user"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/usertotp"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/db"
@@ -67,9 +74,13 @@ func (u *UserRepoImpl) Find(ctx context.Context, id int64) (*aggregate.User, *cu
 	// Map to aggregate.User
 	user := &aggregate.User{
 		Id:                entUser.ID,
+		Account:           entUser.Account,
 		Password:          entUser.Password,
 		PasswordFailTimes: entUser.PasswordFailTimes,
 		Status:            status,
+		PasswordChangedAt: entUser.PasswordChangedAt,
+		LockedUntil:       entUser.LockedUntil,
+		LockCount:         entUser.LockCount,
 	}
 	setUserLoader(u.db, user)
 
@@ -111,6 +122,9 @@ func (u *UserRepoImpl) Create(ctx context.Context, clientId int64, user *aggrega
 		SetPassword(user.Password).
 		SetPasswordFailTimes(user.PasswordFailTimes).
 		SetStatus(user.Status.Int()).
+		SetNillablePasswordChangedAt(user.PasswordChangedAt).
+		SetNillableLockedUntil(user.LockedUntil).
+		SetLockCount(user.LockCount).
 		SetAuthClientsID(clientId).
 		Save(ctx)
 	if ent.IsConstraintError(err) && strings.Contains(err.Error(), "duplicate") {
@@ -138,6 +152,9 @@ func (u *UserRepoImpl) Create(ctx context.Context, clientId int64, user *aggrega
 		Password:          entUser.Password,
 		PasswordFailTimes: entUser.PasswordFailTimes,
 		Status:            status,
+		PasswordChangedAt: entUser.PasswordChangedAt,
+		LockedUntil:       entUser.LockedUntil,
+		LockCount:         entUser.LockCount,
 	}
 	setUserLoader(u.db, newUser)
 
@@ -165,11 +182,19 @@ func (u *UserRepoImpl) Update(ctx context.Context, user *aggregate.User) (*aggre
 	}
 
 	// Update user
-	entUser, err := tx.User.UpdateOneID(user.Id).
+	update := tx.User.UpdateOneID(user.Id).
+		SetAccount(user.Account).
 		SetPassword(user.Password).
 		SetPasswordFailTimes(user.PasswordFailTimes).
 		SetStatus(user.Status.Int()).
-		Save(ctx)
+		SetNillablePasswordChangedAt(user.PasswordChangedAt).
+		SetLockCount(user.LockCount)
+	if user.LockedUntil != nil {
+		update.SetLockedUntil(*user.LockedUntil)
+	} else {
+		update.ClearLockedUntil()
+	}
+	entUser, err := update.Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			cusErr := cus_err.New(cus_err.ResourceNotFound, "user not found", err)
@@ -195,12 +220,53 @@ func (u *UserRepoImpl) Update(ctx context.Context, user *aggregate.User) (*aggre
 		Password:          entUser.Password,
 		PasswordFailTimes: entUser.PasswordFailTimes,
 		Status:            status,
+		PasswordChangedAt: entUser.PasswordChangedAt,
+		LockedUntil:       entUser.LockedUntil,
+		LockCount:         entUser.LockCount,
 	}
 	setUserLoader(u.db, updatedUser)
 
 	return updatedUser, nil
 }
 
+// AddLoginRecord adds the login record of the user, the record of an unknown user is added with user id 0
+// Delete removes the user with the password histories, the trusted devices, the totp and the token revocations of the user
+func (u *UserRepoImpl) Delete(ctx context.Context, userId int64) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get Tx from context
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		err := cus_err.New(cus_err.InternalServerError, "get tx from context failed")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	// The records only refer to the user by the id, so they are deleted first
+	_, err := tx.PasswordHistory.Delete().Where(passwordhistory.UserID(userId)).Exec(ctx)
+	if err == nil {
+		_, err = tx.TrustedDevice.Delete().Where(trusteddevice.UserID(userId)).Exec(ctx)
+	}
+	if err == nil {
+		_, err = tx.UserTotp.Delete().Where(usertotp.UserID(userId)).Exec(ctx)
+	}
+	if err == nil {
+		_, err = tx.TokenRevocation.Delete().Where(tokenrevocation.UserID(userId)).Exec(ctx)
+	}
+	if err == nil {
+		err = tx.User.DeleteOneID(userId).Exec(ctx)
+	}
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "delete user failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
 func (u *UserRepoImpl) AddLoginRecord(ctx context.Context, userId int64, loginRecord *entity.LoginRecord) (*entity.LoginRecord, *cus_err.CusError) {
 	// Start trace
 	ctx, span := cus_otel.StartTrace(ctx)
@@ -215,7 +281,11 @@ func (u *UserRepoImpl) AddLoginRecord(ctx context.Context, userId int64, loginRe
 	}
 
 	// Create login record
-	entLoginRecord, err := tx.LoginRecord.Create().
+	create := tx.LoginRecord.Create()
+	if userId != 0 {
+		create.SetUsersID(userId)
+	}
+	entLoginRecord, err := create.
 		SetBrowser(loginRecord.Browser).
 		SetBrowserVer(loginRecord.BrowserVer).
 		SetIP(loginRecord.Ip).
@@ -227,8 +297,10 @@ func (u *UserRepoImpl) AddLoginRecord(ctx context.Context, userId int64, loginRe
 		SetAsp(loginRecord.Asp).
 		SetIsMobile(loginRecord.IsMobile).
 		SetIsSuccess(loginRecord.IsSuccess).
+		SetErrCode(loginRecord.ErrCode).
 		SetErrMessage(loginRecord.ErrMessage).
-		SetUsersID(userId).
+		SetLatitude(loginRecord.Latitude).
+		SetLongitude(loginRecord.Longitude).
 		Save(ctx)
 	if err != nil {
 		cusErr := cus_err.New(cus_err.InternalServerError, "create login record failed", err)
@@ -237,22 +309,10 @@ func (u *UserRepoImpl) AddLoginRecord(ctx context.Context, userId int64, loginRe
 	}
 
 	// Map to entity.LoginRecord
-	return &entity.LoginRecord{
-		Id:          entLoginRecord.ID,
-		Browser:     entLoginRecord.Browser,
-		BrowserVer:  entLoginRecord.BrowserVer,
-		Ip:          entLoginRecord.IP,
-		Os:          entLoginRecord.Os,
-		Platform:    entLoginRecord.Platform,
-		Country:     entLoginRecord.Country,
-		CountryCode: entLoginRecord.CountryCode,
-		City:        entLoginRecord.City,
-		Asp:         entLoginRecord.Asp,
-		IsMobile:    entLoginRecord.IsMobile,
-		IsSuccess:   entLoginRecord.IsSuccess,
-		ErrMessage:  entLoginRecord.ErrMessage,
-		CreateAt:    entLoginRecord.CreatedAt,
-	}, nil
+	record := toLoginRecord(entLoginRecord)
+	record.UserId = userId
+
+	return record, nil
 }
 
 func (u *UserRepoImpl) BindRole(ctx context.Context, userId int64, roleId int64) (*aggregate.User, *cus_err.CusError) {
@@ -298,6 +358,9 @@ func (u *UserRepoImpl) BindRole(ctx context.Context, userId int64, roleId int64)
 		Password:          entUser.Password,
 		PasswordFailTimes: entUser.PasswordFailTimes,
 		Status:            status,
+		PasswordChangedAt: entUser.PasswordChangedAt,
+		LockedUntil:       entUser.LockedUntil,
+		LockCount:         entUser.LockCount,
 	}
 	setUserLoader(u.db, user)
 
@@ -449,14 +512,32 @@ func setUserLoader(db db.Database, domainUser *aggregate.User) {
 			cus_otel.Error(ctx, cusErr.Error())
 			return nil, cusErr
 		}
+		sessionPolicy, cusErr := enum.SessionPolicyFromInt(entClient.SessionPolicy)
+		if cusErr != nil {
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		signingMethod, cusErr := enum.SigningMethodFromId(entClient.SigningMethod)
+		if cusErr != nil {
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
 		domainClient := &aggregate.Client{
-			Id:               entClient.ID,
-			MerchantId:       entClient.MerchantID,
-			ClientType:       clientType,
-			Secret:           entClient.Secret,
-			Active:           entClient.Active,
-			TokenExpireSecs:  entClient.TokenExpireSecs,
-			LoginFailedTimes: entClient.LoginFailedTimes,
+			Id:                     entClient.ID,
+			MerchantId:             entClient.MerchantID,
+			ClientType:             clientType,
+			Secret:                 entClient.Secret,
+			Active:                 entClient.Active,
+			TokenExpireSecs:        entClient.TokenExpireSecs,
+			LoginFailedTimes:       entClient.LoginFailedTimes,
+			RefreshTokenExpireSecs: entClient.RefreshTokenExpireSecs,
+			SessionPolicy:          sessionPolicy,
+			MaxSessions:            entClient.MaxSessions,
+			SigningMethod:          signingMethod,
+			MfaRequired:            entClient.MfaRequired,
+			PasswordPolicy:         toPasswordPolicy(entClient),
+			LockoutPolicy:          toLockoutPolicy(entClient),
+			RiskPolicy:             toRiskPolicy(entClient),
 		}
 		setClientLoader(db, domainClient)
 		return domainClient, nil
@@ -479,7 +560,7 @@ func (u *UserRepoImpl) CheckAccountExistence(ctx context.Context, account string
 	return exist, nil
 }
 
-func (u *UserRepoImpl) GetLastLoginRecord(ctx context.Context, userId int64) (*entity.LoginRecord, *cus_err.CusError) {
+func (u *UserRepoImpl) GetLastSuccessfulLoginRecord(ctx context.Context, userId int64) (*entity.LoginRecord, *cus_err.CusError) {
 	// Start trace
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
@@ -517,6 +598,511 @@ func (u *UserRepoImpl) GetLastLoginRecord(ctx context.Context, userId int64) (*e
 	}
 
 	// Map to entity.LoginRecord
+	return toLoginRecord(entLoginRecord), nil
+}
+
+func (u *UserRepoImpl) FindUserIdsByClient(ctx context.Context, clientId int64) ([]int64, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction if exists
+	var client *ent.Client
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if ok {
+		client = tx.Client()
+	} else {
+		client = u.db.GetConn(ctx).(*ent.Client)
+	}
+
+	// Find ids of the users belonging to the client
+	ids, err := client.User.Query().
+		Where(user.HasAuthClientsWith(authclient.ID(clientId))).
+		IDs(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "find user ids failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return ids, nil
+}
+
+// SearchUsers finds the users matching the search after the cursor, up to the limit of the search
+func (u *UserRepoImpl) SearchUsers(ctx context.Context, search vo.UserSearch) ([]*vo.UserSummary, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction if exists
+	var client *ent.Client
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if ok {
+		client = tx.Client()
+	} else {
+		client = u.db.GetConn(ctx).(*ent.Client)
+	}
+
+	query := client.User.Query()
+	if search.UserIds != nil {
+		query = query.Where(user.IDIn(search.UserIds...))
+	}
+	if search.AccountPrefix != "" {
+		query = query.Where(user.AccountHasPrefix(search.AccountPrefix))
+	}
+	if search.Status != nil {
+		query = query.Where(user.Status(search.Status.Int()))
+	}
+	if search.MerchantId != nil {
+		query = query.Where(user.HasAuthClientsWith(authclient.MerchantID(*search.MerchantId)))
+	}
+	if search.ClientId != nil {
+		query = query.Where(user.HasAuthClientsWith(authclient.ID(*search.ClientId)))
+	}
+	if search.CreatedFrom != nil {
+		query = query.Where(user.CreatedAtGTE(*search.CreatedFrom))
+	}
+	if search.CreatedTo != nil {
+		query = query.Where(user.CreatedAtLT(*search.CreatedTo))
+	}
+	if search.Cursor != nil {
+		query = query.Where(userAfterCursor(search.Cursor))
+	}
+
+	order := ent.Desc
+	if search.Ascending {
+		order = ent.Asc
+	}
+	switch search.Sort {
+	case vo.UserSortAccount:
+		query = query.Order(order(user.FieldAccount), order(user.FieldID))
+	case vo.UserSortId:
+		query = query.Order(order(user.FieldID))
+	default:
+		query = query.Order(order(user.FieldCreatedAt), order(user.FieldID))
+	}
+
+	entUsers, err := query.
+		WithAuthClients().
+		WithRoles().
+		Limit(search.Limit).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "search users failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	users := make([]*vo.UserSummary, 0, len(entUsers))
+	for _, entUser := range entUsers {
+		summary := &vo.UserSummary{
+			Id:        entUser.ID,
+			Account:   entUser.Account,
+			Status:    enum.UserStatus(entUser.Status),
+			CreatedAt: entUser.CreatedAt,
+		}
+		if entUser.Edges.Roles != nil {
+			summary.RoleId = entUser.Edges.Roles.ID
+			summary.RoleName = entUser.Edges.Roles.Name
+		}
+		if entUser.Edges.AuthClients != nil {
+			summary.ClientId = entUser.Edges.AuthClients.ID
+			summary.MerchantId = entUser.Edges.AuthClients.MerchantID
+		}
+		users = append(users, summary)
+	}
+
+	return users, nil
+}
+
+// userAfterCursor matches the users sorted after the cursor, the id breaks the ties of the sort column
+func userAfterCursor(cursor *vo.UserCursor) predicate.User {
+	if cursor.Ascending {
+		switch cursor.Sort {
+		case vo.UserSortAccount:
+			return user.Or(user.AccountGT(cursor.Account), user.And(user.Account(cursor.Account), user.IDGT(cursor.Id)))
+		case vo.UserSortId:
+			return user.IDGT(cursor.Id)
+		default:
+			return user.Or(user.CreatedAtGT(cursor.CreatedAt), user.And(user.CreatedAt(cursor.CreatedAt), user.IDGT(cursor.Id)))
+		}
+	}
+
+	switch cursor.Sort {
+	case vo.UserSortAccount:
+		return user.Or(user.AccountLT(cursor.Account), user.And(user.Account(cursor.Account), user.IDLT(cursor.Id)))
+	case vo.UserSortId:
+		return user.IDLT(cursor.Id)
+	default:
+		return user.Or(user.CreatedAtLT(cursor.CreatedAt), user.And(user.CreatedAt(cursor.CreatedAt), user.IDLT(cursor.Id)))
+	}
+}
+
+func (u *UserRepoImpl) AddTokenRevocation(ctx context.Context, revocation *entity.TokenRevocation) (*entity.TokenRevocation, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get Tx from context
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		err := cus_err.New(cus_err.InternalServerError, "get tx from context failed")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Create token revocation
+	entRevocation, err := tx.TokenRevocation.Create().
+		SetClientID(revocation.ClientId).
+		SetNillableUserID(revocation.UserId).
+		SetScope(string(revocation.Scope)).
+		SetReason(revocation.Reason).
+		SetNillableOperatorID(revocation.OperatorId).
+		SetRevokedCount(revocation.RevokedCount).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "create token revocation failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Map to entity.TokenRevocation
+	return &entity.TokenRevocation{
+		Id:           entRevocation.ID,
+		ClientId:     entRevocation.ClientID,
+		UserId:       entRevocation.UserID,
+		Scope:        entity.RevocationScope(entRevocation.Scope),
+		Reason:       entRevocation.Reason,
+		OperatorId:   entRevocation.OperatorID,
+		RevokedCount: entRevocation.RevokedCount,
+		CreateAt:     entRevocation.CreatedAt,
+	}, nil
+}
+
+func (u *UserRepoImpl) FindTotp(ctx context.Context, userId int64) (*entity.UserTotp, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction if exists
+	var client *ent.Client
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if ok {
+		client = tx.Client()
+	} else {
+		client = u.db.GetConn(ctx).(*ent.Client)
+	}
+
+	// Find totp
+	entTotp, err := client.UserTotp.Query().
+		Where(usertotp.UserID(userId)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			cusErr := cus_err.New(cus_err.ResourceNotFound, "totp not found", err)
+			cus_otel.Warn(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "find totp failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toUserTotp(entTotp), nil
+}
+
+// SaveTotp creates the totp of the user or replaces the existing one
+func (u *UserRepoImpl) SaveTotp(ctx context.Context, totp *entity.UserTotp) (*entity.UserTotp, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get Tx from context
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		err := cus_err.New(cus_err.InternalServerError, "get tx from context failed")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Replace the existing totp of the user
+	existing, err := tx.UserTotp.Query().Where(usertotp.UserID(totp.UserId)).Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		cusErr := cus_err.New(cus_err.InternalServerError, "find totp failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	var entTotp *ent.UserTotp
+	if existing != nil {
+		update := tx.UserTotp.UpdateOne(existing).
+			SetSecret(totp.Secret).
+			SetEnabled(totp.Enabled).
+			SetRecoveryCodes(totp.RecoveryCodes)
+		if totp.EnabledAt != nil {
+			update.SetEnabledAt(*totp.EnabledAt)
+		} else {
+			update.ClearEnabledAt()
+		}
+		entTotp, err = update.Save(ctx)
+	} else {
+		entTotp, err = tx.UserTotp.Create().
+			SetUserID(totp.UserId).
+			SetSecret(totp.Secret).
+			SetEnabled(totp.Enabled).
+			SetRecoveryCodes(totp.RecoveryCodes).
+			SetNillableEnabledAt(totp.EnabledAt).
+			Save(ctx)
+	}
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "save totp failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toUserTotp(entTotp), nil
+}
+
+// AddPasswordHistory records the hashed password which is set to the user
+func (u *UserRepoImpl) AddPasswordHistory(ctx context.Context, userId int64, hashedPassword string) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get Tx from context
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		err := cus_err.New(cus_err.InternalServerError, "get tx from context failed")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	_, err := tx.PasswordHistory.Create().
+		SetUserID(userId).
+		SetPassword(hashedPassword).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "add password history failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+// FindPasswordHistory finds the last hashed passwords of the user, latest first
+func (u *UserRepoImpl) FindPasswordHistory(ctx context.Context, userId int64, limit int) ([]string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction if exists
+	var client *ent.Client
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if ok {
+		client = tx.Client()
+	} else {
+		client = u.db.GetConn(ctx).(*ent.Client)
+	}
+
+	passwords, err := client.PasswordHistory.Query().
+		Where(passwordhistory.UserID(userId)).
+		Order(ent.Desc(passwordhistory.FieldCreatedAt), ent.Desc(passwordhistory.FieldID)).
+		Limit(limit).
+		Select(passwordhistory.FieldPassword).
+		Strings(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "find password history failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return passwords, nil
+}
+
+func toUserTotp(entTotp *ent.UserTotp) *entity.UserTotp {
+	return &entity.UserTotp{
+		Id:            entTotp.ID,
+		UserId:        entTotp.UserID,
+		Secret:        entTotp.Secret,
+		Enabled:       entTotp.Enabled,
+		RecoveryCodes: entTotp.RecoveryCodes,
+		EnabledAt:     entTotp.EnabledAt,
+	}
+}
+
+// AddTrustedDevice remembers the browser and the city of the device, it's ignored if the device is already trusted
+func (u *UserRepoImpl) AddTrustedDevice(ctx context.Context, userId int64, device vo.Device) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get Tx from context
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		err := cus_err.New(cus_err.InternalServerError, "get tx from context failed")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	err := tx.TrustedDevice.Create().
+		SetUserID(userId).
+		SetBrowser(device.Browser).
+		SetCity(device.City).
+		SetCountryCode(device.CountryCode).
+		OnConflictColumns(trusteddevice.FieldUserID, trusteddevice.FieldBrowser, trusteddevice.FieldCity).
+		UpdateNewValues().
+		Exec(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "add trusted device failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+// IsTrustedDevice checks the browser and the city of the device are trusted by the user
+func (u *UserRepoImpl) IsTrustedDevice(ctx context.Context, userId int64, device vo.Device) (bool, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction if exists
+	var client *ent.Client
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if ok {
+		client = tx.Client()
+	} else {
+		client = u.db.GetConn(ctx).(*ent.Client)
+	}
+
+	exist, err := client.TrustedDevice.Query().
+		Where(
+			trusteddevice.UserID(userId),
+			trusteddevice.Browser(device.Browser),
+			trusteddevice.City(device.City),
+		).
+		Exist(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "find trusted device failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return false, cusErr
+	}
+
+	return exist, nil
+}
+
+// FindRecentLoginRecords finds the last login records of the user, both successful and failed, latest first
+func (u *UserRepoImpl) FindRecentLoginRecords(ctx context.Context, userId int64, limit int) ([]*entity.LoginRecord, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction if exists
+	var client *ent.Client
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if ok {
+		client = tx.Client()
+	} else {
+		client = u.db.GetConn(ctx).(*ent.Client)
+	}
+
+	entLoginRecords, err := client.LoginRecord.Query().
+		Where(loginrecord.HasUsersWith(user.ID(userId))).
+		Order(ent.Desc(loginrecord.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "find login records failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	records := make([]*entity.LoginRecord, 0, len(entLoginRecords))
+	for _, entLoginRecord := range entLoginRecords {
+		records = append(records, toLoginRecord(entLoginRecord))
+	}
+
+	return records, nil
+}
+
+// FindLoginRecords finds a page of the login records matching the filter, ordered from the latest
+func (u *UserRepoImpl) FindLoginRecords(ctx context.Context, filter vo.LoginRecordFilter, pagination vo.Pagination) (*vo.LoginRecordPage, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction if exists
+	var client *ent.Client
+	tx, ok := u.db.GetTx(ctx).(*ent.Tx)
+	if ok {
+		client = tx.Client()
+	} else {
+		client = u.db.GetConn(ctx).(*ent.Client)
+	}
+
+	query := client.LoginRecord.Query()
+	if filter.UserId != nil {
+		query = query.Where(loginrecord.HasUsersWith(user.ID(*filter.UserId)))
+	}
+	if filter.MerchantId != nil {
+		query = query.Where(loginrecord.HasUsersWith(user.HasAuthClientsWith(authclient.MerchantID(*filter.MerchantId))))
+	}
+	if filter.StartTime != nil {
+		query = query.Where(loginrecord.CreatedAtGTE(*filter.StartTime))
+	}
+	if filter.EndTime != nil {
+		query = query.Where(loginrecord.CreatedAtLT(*filter.EndTime))
+	}
+	if filter.IsSuccess != nil {
+		query = query.Where(loginrecord.IsSuccess(*filter.IsSuccess))
+	}
+	if filter.Ip != "" {
+		query = query.Where(loginrecord.IPEQ(filter.Ip))
+	}
+	if filter.CountryCode != "" {
+		query = query.Where(loginrecord.CountryCodeEqualFold(filter.CountryCode))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "count login records failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	pagination = pagination.Normalize()
+	entLoginRecords, err := query.
+		WithUsers().
+		Order(ent.Desc(loginrecord.FieldCreatedAt), ent.Desc(loginrecord.FieldID)).
+		Offset(pagination.Offset()).
+		Limit(pagination.PageSize).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "find login records failed", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	records := make([]*entity.LoginRecord, 0, len(entLoginRecords))
+	for _, entLoginRecord := range entLoginRecords {
+		record := toLoginRecord(entLoginRecord)
+		if entLoginRecord.Edges.Users != nil {
+			record.UserId = entLoginRecord.Edges.Users.ID
+		}
+		records = append(records, record)
+	}
+
+	return &vo.LoginRecordPage{
+		Records:    records,
+		Total:      total,
+		Pagination: pagination,
+	}, nil
+}
+
+// toLoginRecord maps the login record
+func toLoginRecord(entLoginRecord *ent.LoginRecord) *entity.LoginRecord {
 	return &entity.LoginRecord{
 		Id:          entLoginRecord.ID,
 		Browser:     entLoginRecord.Browser,
@@ -528,9 +1114,12 @@ func (u *UserRepoImpl) GetLastLoginRecord(ctx context.Context, userId int64) (*e
 		CountryCode: entLoginRecord.CountryCode,
 		City:        entLoginRecord.City,
 		Asp:         entLoginRecord.Asp,
+		Latitude:    entLoginRecord.Latitude,
+		Longitude:   entLoginRecord.Longitude,
 		IsMobile:    entLoginRecord.IsMobile,
 		IsSuccess:   entLoginRecord.IsSuccess,
+		ErrCode:     entLoginRecord.ErrCode,
 		ErrMessage:  entLoginRecord.ErrMessage,
 		CreateAt:    entLoginRecord.CreatedAt,
-	}, nil
+	}
 }
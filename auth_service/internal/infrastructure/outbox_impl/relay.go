@@ -0,0 +1,60 @@
+package outbox_impl
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/config"
+	rabbitmq "go_micro_service_api/pkg/broker/rabbitmq_pool"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/outbox"
+
+	"go.uber.org/fx"
+)
+
+const (
+	// EventExchange is the topic exchange of the auth events, the event types are the routing keys
+	EventExchange = "auth.events"
+	// EventSource is the source of the auth events in the envelopes
+	EventSource = "auth_service"
+)
+
+// NewBroker connects to rabbitmq and declares the exchange of the events, the connection is closed when the application stops
+func NewBroker(lc fx.Lifecycle) (rabbitmq.Broker, error) {
+	cfg := config.GetConfig()
+
+	mapping := &rabbitmq.BrokerMapping{
+		Exchanges: []rabbitmq.ExchangeOpt{
+			{Name: EventExchange, Kind: "topic", Durable: true},
+		},
+	}
+
+	broker, err := rabbitmq.NewBroker(
+		cfg.RabbitMQUser,
+		cfg.RabbitMQPass,
+		cfg.RabbitMQHost,
+		cfg.RabbitMQPort,
+		rabbitmq.WithMapping(mapping),
+	)
+	if err != nil {
+		// Return the error explicitly, a nil *cus_err.CusError isn't a nil error
+		return nil, err
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			if err := broker.Close(); err != nil {
+				return err
+			}
+			return nil
+		},
+	})
+
+	return broker, nil
+}
+
+// NewRelay publishes the events in the outbox to the event exchange
+func NewRelay(store outbox.Store, db db.Database, broker rabbitmq.Broker) *outbox.Relay {
+	return outbox.NewRelay(store, db, broker, outbox.RelayOpt{
+		Source:   EventSource,
+		Exchange: EventExchange,
+	})
+}
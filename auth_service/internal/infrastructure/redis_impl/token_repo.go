@@ -0,0 +1,565 @@
+package redis_impl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/repository"
+	"go_micro_service_api/auth_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_crypto"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"sort"
+	"time"
+)
+
+const (
+	RefreshTokenPrefix     = "refresh_token"
+	RefreshTokenUsedPrefix = "refresh_token_used"
+	SessionPrefix          = "sessions"
+	SessionExpirePrefix    = "sessions_expire"
+	SessionActivePrefix    = "sessions_active"
+	ChallengePrefix        = "mfa_challenge"
+	ChallengeAttemptPrefix = "mfa_challenge_attempts"
+	TotpStepPrefix         = "totp_step"
+	PasswordResetPrefix    = "password_reset"
+	PendingLoginPrefix     = "pending_login"
+)
+
+// The sessions of a user are kept in a hash with two sorted sets ordering them by the expire time and the last active time,
+// the scripts change them together. KEYS are the keys of sessionKeys.
+const (
+	// removeSessionsLua removes the sessions in the table ids
+	removeSessionsLua = `
+local function removeSessions(ids)
+	for _, id in ipairs(ids) do
+		redis.call('HDEL', KEYS[1], id)
+		redis.call('ZREM', KEYS[2], id)
+		redis.call('ZREM', KEYS[3], id)
+	end
+end
+`
+	// saveSessionLua saves the session, the keys live as long as the latest session.
+	// ARGV: id, session, expire at and last active at in unix milli
+	saveSessionLua = `
+local function saveSession()
+	redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+	redis.call('ZADD', KEYS[2], ARGV[3], ARGV[1])
+	redis.call('ZADD', KEYS[3], ARGV[4], ARGV[1])
+	local latest = redis.call('ZRANGE', KEYS[2], -1, -1, 'WITHSCORES')
+	for i = 1, 3 do
+		redis.call('PEXPIREAT', KEYS[i], string.format('%d', tonumber(latest[2])))
+	end
+end
+`
+	// createSessionScript removes the expired sessions, evicts the least recently active sessions or rejects the new one at the limit.
+	// ARGV: id, session, expire at, last active at, now, max sessions (0 is no limit), evict (1 or 0)
+	createSessionScript = removeSessionsLua + saveSessionLua + `
+removeSessions(redis.call('ZRANGEBYSCORE', KEYS[2], '-inf', ARGV[5]))
+local evicted = {}
+local maxSessions = tonumber(ARGV[6])
+if maxSessions > 0 then
+	local active = redis.call('ZCARD', KEYS[2])
+	local over = active - maxSessions + 1
+	if over > 0 then
+		if ARGV[7] ~= '1' then
+			return {0, active}
+		end
+		evicted = redis.call('ZRANGE', KEYS[3], 0, over - 1)
+		removeSessions(evicted)
+	end
+end
+saveSession()
+return {1, unpack(evicted)}
+`
+	// updateSessionScript saves the session only if it's not expired or deleted.
+	// ARGV: id, session, expire at, last active at, now
+	updateSessionScript = saveSessionLua + `
+local expireAt = redis.call('ZSCORE', KEYS[2], ARGV[1])
+if not expireAt or tonumber(expireAt) <= tonumber(ARGV[5]) then
+	return nil
+end
+saveSession()
+return 1
+`
+	// deleteSessionScript deletes the session, it returns 0 if it doesn't exist.
+	// ARGV: id
+	deleteSessionScript = `
+redis.call('ZREM', KEYS[2], ARGV[1])
+redis.call('ZREM', KEYS[3], ARGV[1])
+return redis.call('HDEL', KEYS[1], ARGV[1])
+`
+)
+
+const (
+	// failChallengeScript counts the wrong code of the challenge, the counter lives as long as the challenge.
+	// Both are deleted at the max attempts. It returns nil if the challenge doesn't exist.
+	// KEYS: challenge, attempts. ARGV: max attempts
+	failChallengeScript = `
+local ttl = redis.call('PTTL', KEYS[1])
+if ttl == -2 then
+	return nil
+end
+local attempts = redis.call('INCR', KEYS[2])
+if ttl > 0 then
+	redis.call('PEXPIRE', KEYS[2], ttl)
+end
+if attempts >= tonumber(ARGV[1]) then
+	redis.call('DEL', KEYS[1], KEYS[2])
+end
+return attempts
+`
+	// saveTotpStepScript saves the time step only if it's after the saved one, it returns 0 otherwise.
+	// ARGV: step, expiration in milli
+	saveTotpStepScript = `
+local last = redis.call('GET', KEYS[1])
+if last and tonumber(last) >= tonumber(ARGV[1]) then
+	return 0
+end
+redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+return 1
+`
+)
+
+type TokenRepoImpl struct {
+	cache  db.Cache
+	crypto cus_crypto.CusCrypto
+}
+
+var _ repository.TokenRepo = (*TokenRepoImpl)(nil)
+
+// NewTokenRepoImpl creates a new instance of TokenRepoImpl
+func NewTokenRepoImpl(cache db.Cache) *TokenRepoImpl {
+	return &TokenRepoImpl{
+		cache:  cache,
+		crypto: cus_crypto.New(),
+	}
+}
+
+// SaveRefreshToken stores the refresh token
+func (t *TokenRepoImpl) SaveRefreshToken(ctx context.Context, refreshToken *vo.RefreshToken) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	expiration := time.Duration(refreshToken.ExpireSecs) * time.Second
+
+	// Save refresh token
+	return t.cache.SetObject(ctx, t.refreshTokenKey(ctx, refreshToken.Token), refreshToken, expiration)
+}
+
+// FindRefreshToken finds the refresh token, ResourceNotFound is returned if it is expired or unknown
+func (t *TokenRepoImpl) FindRefreshToken(ctx context.Context, token string) (*vo.RefreshToken, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	refreshToken := &vo.RefreshToken{}
+	err := t.cache.GetObject(ctx, t.refreshTokenKey(ctx, token), refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken.Token = token
+
+	return refreshToken, nil
+}
+
+// ConsumeRefreshToken marks the refresh token as used.
+// It returns false if the token was already used before, which means the token is reused.
+func (t *TokenRepoImpl) ConsumeRefreshToken(ctx context.Context, refreshToken *vo.RefreshToken) (bool, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Incr is atomic, only the first caller gets 1
+	key := fmt.Sprintf("%s:%s", RefreshTokenUsedPrefix, t.hash(ctx, refreshToken.Token))
+	count, err := t.cache.Incr(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	if count > 1 {
+		return false, nil
+	}
+
+	// Keep the used mark as long as the token could be presented
+	err = t.cache.Set(ctx, key, "1", time.Duration(refreshToken.ExpireSecs)*time.Second)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CreateSession adds the session of the user within the limit, it returns the ids of the evicted sessions.
+// SessionLimit is returned if the limit is reached and the sessions can't be evicted.
+// The check and the insertion are atomic, so the concurrent logins can't exceed the limit.
+func (t *TokenRepoImpl) CreateSession(ctx context.Context, session *vo.Session, limit vo.SessionLimit) ([]string, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	value, err := json.Marshal(session)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "Failed to marshal session", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	evict := 0
+	if limit.Evict {
+		evict = 1
+	}
+	res, cusErr := t.cache.Eval(ctx, createSessionScript, t.sessionKeys(session.UserId),
+		session.Id,
+		value,
+		session.ExpireAt.UnixMilli(),
+		session.LastActiveAt.UnixMilli(),
+		time.Now().UnixMilli(),
+		limit.MaxSessions,
+		evict,
+	)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	// The reply is {1, evicted ids...} when it's created, {0, active sessions} when it's rejected
+	reply, ok := res.([]any)
+	if !ok || len(reply) == 0 {
+		cusErr = cus_err.New(cus_err.InternalServerError, fmt.Sprintf("Unexpected reply of creating session: %v", res))
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+	if reply[0] == int64(0) {
+		cusErr = cus_err.New(cus_err.SessionLimit, fmt.Sprintf("User id: %v reached the session limit", session.UserId)).
+			WithData(map[string]interface{}{
+				"activeSessions": reply[1],
+				"maxSessions":    limit.MaxSessions,
+			})
+		cus_otel.Warn(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	evicted := make([]string, 0, len(reply)-1)
+	for _, id := range reply[1:] {
+		evicted = append(evicted, fmt.Sprint(id))
+	}
+
+	return evicted, nil
+}
+
+// UpdateSession renews the session of the user, ResourceNotFound is returned if it is expired or revoked,
+// so a revoked session never comes back
+func (t *TokenRepoImpl) UpdateSession(ctx context.Context, session *vo.Session) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	value, err := json.Marshal(session)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "Failed to marshal session", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	_, cusErr := t.cache.Eval(ctx, updateSessionScript, t.sessionKeys(session.UserId),
+		session.Id,
+		value,
+		session.ExpireAt.UnixMilli(),
+		session.LastActiveAt.UnixMilli(),
+		time.Now().UnixMilli(),
+	)
+	if cusErr != nil {
+		if cusErr.Code().Int() == cus_err.ResourceNotFound {
+			cusErr = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("Session %s of user %d not found", session.Id, session.UserId))
+			cus_otel.Warn(ctx, cusErr.Error())
+		}
+		return cusErr
+	}
+
+	return nil
+}
+
+// FindSession finds the session of the user, ResourceNotFound is returned if it is expired or unknown
+func (t *TokenRepoImpl) FindSession(ctx context.Context, userId int64, sessionId string) (*vo.Session, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	sessions, err := t.FindSessions(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, session := range sessions {
+		if session.Id == sessionId {
+			return session, nil
+		}
+	}
+
+	err = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("Session %s of user %d not found", sessionId, userId))
+	cus_otel.Warn(ctx, err.Error())
+	return nil, err
+}
+
+// FindSessions finds the sessions which are not expired of the user, ordered by creation time
+func (t *TokenRepoImpl) FindSessions(ctx context.Context, userId int64) ([]*vo.Session, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, cusErr := t.cache.Eval(ctx, "return redis.call('HVALS', KEYS[1])", t.sessionKeys(userId)[:1])
+	if cusErr != nil {
+		return nil, cusErr
+	}
+	values, _ := res.([]any)
+
+	// Filter out the expired sessions
+	now := time.Now()
+	sessions := make([]*vo.Session, 0, len(values))
+	for _, value := range values {
+		session := &vo.Session{}
+		if err := json.Unmarshal([]byte(fmt.Sprint(value)), session); err != nil {
+			cusErr = cus_err.New(cus_err.InternalServerError, "Failed to unmarshal session", err)
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		if !session.IsExpired(now) {
+			sessions = append(sessions, session)
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].CreateAt.Before(sessions[j].CreateAt)
+	})
+
+	return sessions, nil
+}
+
+// DeleteSession deletes the session of the user, ResourceNotFound is returned if it doesn't exist
+func (t *TokenRepoImpl) DeleteSession(ctx context.Context, userId int64, sessionId string) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, cusErr := t.cache.Eval(ctx, deleteSessionScript, t.sessionKeys(userId), sessionId)
+	if cusErr != nil {
+		return cusErr
+	}
+	if res == int64(0) {
+		cusErr = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("Session %s of user %d not found", sessionId, userId))
+		cus_otel.Warn(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+// SaveChallenge creates or updates the second factor challenge
+func (t *TokenRepoImpl) SaveChallenge(ctx context.Context, challenge *vo.SecondFactorChallenge) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	expiration := time.Duration(challenge.ExpireSecs) * time.Second
+
+	// Save challenge
+	return t.cache.SetObject(ctx, t.challengeKey(ctx, challenge.Token), challenge, expiration)
+}
+
+// FindChallenge finds the second factor challenge, ResourceNotFound is returned if it is expired or unknown
+func (t *TokenRepoImpl) FindChallenge(ctx context.Context, token string) (*vo.SecondFactorChallenge, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	challenge := &vo.SecondFactorChallenge{}
+	err := t.cache.GetObject(ctx, t.challengeKey(ctx, token), challenge)
+	if err != nil {
+		return nil, err
+	}
+	challenge.Token = token
+
+	return challenge, nil
+}
+
+// DeleteChallenge deletes the second factor challenge, ResourceNotFound is returned if it doesn't exist
+func (t *TokenRepoImpl) DeleteChallenge(ctx context.Context, token string) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Only the request which deletes the challenge can use it, so the attempts are deleted apart
+	err := t.cache.Delete(ctx, t.challengeKey(ctx, token))
+	if err != nil {
+		return err
+	}
+	err = t.cache.Delete(ctx, t.challengeAttemptKey(ctx, token))
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound { // Ignore if there is no wrong code
+		return err
+	}
+
+	return nil
+}
+
+// FailChallenge counts the wrong code of the challenge atomically and returns the attempts,
+// the challenge is deleted at the max attempts. ResourceNotFound is returned if it is expired or unknown
+func (t *TokenRepoImpl) FailChallenge(ctx context.Context, token string, maxAttempts int) (int, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	keys := []string{t.challengeKey(ctx, token), t.challengeAttemptKey(ctx, token)}
+	res, cusErr := t.cache.Eval(ctx, failChallengeScript, keys, maxAttempts)
+	if cusErr != nil {
+		return 0, cusErr
+	}
+
+	attempts, ok := res.(int64)
+	if !ok {
+		cusErr = cus_err.New(cus_err.InternalServerError, fmt.Sprintf("unexpected reply of the challenge attempts: %v", res))
+		cus_otel.Error(ctx, cusErr.Error())
+		return 0, cusErr
+	}
+
+	return int(attempts), nil
+}
+
+// SaveTotpStep saves the last accepted TOTP time step of the user for the expiration.
+// It returns false if the step is not after the saved one, which means the code is replayed.
+func (t *TokenRepoImpl) SaveTotpStep(ctx context.Context, userId int64, step int64, expiration time.Duration) (bool, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	key := fmt.Sprintf("%s:%d", TotpStepPrefix, userId)
+	res, cusErr := t.cache.Eval(ctx, saveTotpStepScript, []string{key}, step, expiration.Milliseconds())
+	if cusErr != nil {
+		return false, cusErr
+	}
+
+	return res == int64(1), nil
+}
+
+// SavePasswordResetToken stores the password reset token
+func (t *TokenRepoImpl) SavePasswordResetToken(ctx context.Context, resetToken *vo.PasswordResetToken) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	expiration := time.Duration(resetToken.ExpireSecs) * time.Second
+
+	// Save password reset token
+	return t.cache.SetObject(ctx, t.passwordResetKey(ctx, resetToken.Token), resetToken, expiration)
+}
+
+// ConsumePasswordResetToken finds and deletes the password reset token,
+// ResourceNotFound is returned if it is expired, unknown or already consumed
+func (t *TokenRepoImpl) ConsumePasswordResetToken(ctx context.Context, token string) (*vo.PasswordResetToken, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	key := t.passwordResetKey(ctx, token)
+	resetToken := &vo.PasswordResetToken{}
+	err := t.cache.GetObject(ctx, key, resetToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the request which deletes the token can use it
+	err = t.cache.Delete(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	resetToken.Token = token
+
+	return resetToken, nil
+}
+
+// SavePendingLogin stores the pending login
+func (t *TokenRepoImpl) SavePendingLogin(ctx context.Context, pendingLogin *vo.PendingLogin) *cus_err.CusError {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	expiration := time.Duration(pendingLogin.ExpireSecs) * time.Second
+
+	// Save pending login
+	return t.cache.SetObject(ctx, t.pendingLoginKey(ctx, pendingLogin.Token), pendingLogin, expiration)
+}
+
+// FindPendingLogin finds the pending login, ResourceNotFound is returned if it is expired, unknown or already consumed
+func (t *TokenRepoImpl) FindPendingLogin(ctx context.Context, token string) (*vo.PendingLogin, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	pendingLogin := &vo.PendingLogin{}
+	err := t.cache.GetObject(ctx, t.pendingLoginKey(ctx, token), pendingLogin)
+	if err != nil {
+		return nil, err
+	}
+	pendingLogin.Token = token
+
+	return pendingLogin, nil
+}
+
+// ConsumePendingLogin finds and deletes the pending login,
+// ResourceNotFound is returned if it is expired, unknown or already consumed
+func (t *TokenRepoImpl) ConsumePendingLogin(ctx context.Context, token string) (*vo.PendingLogin, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	key := t.pendingLoginKey(ctx, token)
+	pendingLogin := &vo.PendingLogin{}
+	err := t.cache.GetObject(ctx, key, pendingLogin)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only the request which deletes the pending login can complete it
+	err = t.cache.Delete(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	pendingLogin.Token = token
+
+	return pendingLogin, nil
+}
+
+func (t *TokenRepoImpl) refreshTokenKey(ctx context.Context, token string) string {
+	return fmt.Sprintf("%s:%s", RefreshTokenPrefix, t.hash(ctx, token))
+}
+
+func (t *TokenRepoImpl) challengeKey(ctx context.Context, token string) string {
+	return fmt.Sprintf("%s:%s", ChallengePrefix, t.hash(ctx, token))
+}
+
+func (t *TokenRepoImpl) challengeAttemptKey(ctx context.Context, token string) string {
+	return fmt.Sprintf("%s:%s", ChallengeAttemptPrefix, t.hash(ctx, token))
+}
+
+func (t *TokenRepoImpl) passwordResetKey(ctx context.Context, token string) string {
+	return fmt.Sprintf("%s:%s", PasswordResetPrefix, t.hash(ctx, token))
+}
+
+func (t *TokenRepoImpl) pendingLoginKey(ctx context.Context, token string) string {
+	return fmt.Sprintf("%s:%s", PendingLoginPrefix, t.hash(ctx, token))
+}
+
+// sessionKeys are the keys of the sessions of the user:
+// the hash of the sessions by id, the expire time of each session and the last active time of each session
+func (t *TokenRepoImpl) sessionKeys(userId int64) []string {
+	return []string{
+		fmt.Sprintf("%s:%d", SessionPrefix, userId),
+		fmt.Sprintf("%s:%d", SessionExpirePrefix, userId),
+		fmt.Sprintf("%s:%d", SessionActivePrefix, userId),
+	}
+}
+
+// hash hashes the token, so a leaked cache doesn't leak usable refresh tokens
+func (t *TokenRepoImpl) hash(ctx context.Context, token string) string {
+	return t.crypto.EncodeHex(ctx, t.crypto.HashSHA256(ctx, token))
+}
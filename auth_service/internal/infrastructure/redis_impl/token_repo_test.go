@@ -0,0 +1,191 @@
+package redis_impl
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_err"
+	redis_cache "go_micro_service_api/pkg/db/redis"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTokenRepo(t *testing.T) *TokenRepoImpl {
+	mr, err := miniredis.Run()
+	require.Nil(t, err)
+	t.Cleanup(mr.Close)
+
+	return NewTokenRepoImpl(redis_cache.NewRedisCache(redis.NewClient(&redis.Options{Addr: mr.Addr()})))
+}
+
+func newSession(userId int64, id string, lastActiveAt time.Time, ttl time.Duration) *vo.Session {
+	return &vo.Session{
+		Id:           id,
+		UserId:       userId,
+		CreateAt:     lastActiveAt,
+		LastActiveAt: lastActiveAt,
+		ExpireAt:     time.Now().Add(ttl),
+	}
+}
+
+func TestSessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Concurrent sessions don't exceed the limit", func(t *testing.T) {
+		tokenRepo := setupTokenRepo(t)
+		limit := vo.SessionLimit{MaxSessions: 2}
+
+		var wg sync.WaitGroup
+		errs := make([]*cus_err.CusError, 10)
+		for i := range errs {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				_, errs[i] = tokenRepo.CreateSession(ctx, newSession(1, fmt.Sprintf("session%d", i), time.Now(), time.Hour), limit)
+			}(i)
+		}
+		wg.Wait()
+
+		created := 0
+		for _, err := range errs {
+			if err == nil {
+				created++
+				continue
+			}
+			assert.Equal(t, cus_err.SessionLimit, err.Code().Int())
+		}
+		assert.Equal(t, 2, created)
+
+		sessions, err := tokenRepo.FindSessions(ctx, 1)
+		require.Nil(t, err)
+		assert.Len(t, sessions, 2)
+	})
+
+	t.Run("Evict the least recently active sessions", func(t *testing.T) {
+		tokenRepo := setupTokenRepo(t)
+		limit := vo.SessionLimit{MaxSessions: 2, Evict: true}
+		now := time.Now()
+
+		_, err := tokenRepo.CreateSession(ctx, newSession(1, "old", now.Add(-time.Hour), time.Hour), limit)
+		require.Nil(t, err)
+		_, err = tokenRepo.CreateSession(ctx, newSession(1, "recent", now.Add(-time.Minute), time.Hour), limit)
+		require.Nil(t, err)
+
+		evicted, err := tokenRepo.CreateSession(ctx, newSession(1, "new", now, time.Hour), limit)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"old"}, evicted)
+
+		sessions, err := tokenRepo.FindSessions(ctx, 1)
+		require.Nil(t, err)
+		require.Len(t, sessions, 2)
+		assert.Equal(t, "recent", sessions[0].Id)
+		assert.Equal(t, "new", sessions[1].Id)
+	})
+
+	t.Run("The expired sessions don't count", func(t *testing.T) {
+		tokenRepo := setupTokenRepo(t)
+		limit := vo.SessionLimit{MaxSessions: 1}
+
+		_, err := tokenRepo.CreateSession(ctx, newSession(1, "expired", time.Now(), -time.Second), vo.SessionLimit{})
+		require.Nil(t, err)
+
+		evicted, err := tokenRepo.CreateSession(ctx, newSession(1, "new", time.Now(), time.Hour), limit)
+		require.Nil(t, err)
+		assert.Empty(t, evicted)
+	})
+
+	t.Run("The deleted session isn't renewed", func(t *testing.T) {
+		tokenRepo := setupTokenRepo(t)
+		session := newSession(1, "session", time.Now(), time.Hour)
+
+		_, err := tokenRepo.CreateSession(ctx, session, vo.SessionLimit{})
+		require.Nil(t, err)
+		err = tokenRepo.DeleteSession(ctx, 1, session.Id)
+		require.Nil(t, err)
+
+		session.LastActiveAt = time.Now()
+		err = tokenRepo.UpdateSession(ctx, session)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+
+		_, err = tokenRepo.FindSession(ctx, 1, session.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+
+		err = tokenRepo.DeleteSession(ctx, 1, session.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+}
+
+func TestChallengeAttempts(t *testing.T) {
+	ctx := context.Background()
+	tokenRepo := setupTokenRepo(t)
+	maxAttempts := 5
+
+	challenge := &vo.SecondFactorChallenge{Token: "token", UserId: 1, ExpireSecs: 300}
+	err := tokenRepo.SaveChallenge(ctx, challenge)
+	require.Nil(t, err)
+
+	// The concurrent wrong codes are all counted
+	var wg sync.WaitGroup
+	attempts := make([]int, maxAttempts-1)
+	for i := range attempts {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			var err *cus_err.CusError
+			attempts[i], err = tokenRepo.FailChallenge(ctx, challenge.Token, maxAttempts)
+			assert.Nil(t, err)
+		}(i)
+	}
+	wg.Wait()
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, attempts)
+
+	_, err = tokenRepo.FindChallenge(ctx, challenge.Token)
+	require.Nil(t, err)
+
+	// The challenge is dropped at the max attempts
+	n, err := tokenRepo.FailChallenge(ctx, challenge.Token, maxAttempts)
+	require.Nil(t, err)
+	assert.Equal(t, maxAttempts, n)
+
+	_, err = tokenRepo.FindChallenge(ctx, challenge.Token)
+	require.NotNil(t, err)
+	assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+
+	_, err = tokenRepo.FailChallenge(ctx, challenge.Token, maxAttempts)
+	require.NotNil(t, err)
+	assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+}
+
+func TestTotpStep(t *testing.T) {
+	ctx := context.Background()
+	tokenRepo := setupTokenRepo(t)
+
+	ok, err := tokenRepo.SaveTotpStep(ctx, 1, 100, time.Minute)
+	require.Nil(t, err)
+	assert.True(t, ok)
+
+	// The same step and the earlier steps are replays
+	for _, step := range []int64{100, 99} {
+		ok, err = tokenRepo.SaveTotpStep(ctx, 1, step, time.Minute)
+		require.Nil(t, err)
+		assert.False(t, ok)
+	}
+
+	ok, err = tokenRepo.SaveTotpStep(ctx, 1, 101, time.Minute)
+	require.Nil(t, err)
+	assert.True(t, ok)
+
+	// The steps are kept per user
+	ok, err = tokenRepo.SaveTotpStep(ctx, 2, 100, time.Minute)
+	require.Nil(t, err)
+	assert.True(t, ok)
+}
@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"context"
+	"go_micro_service_api/auth_service/internal/application"
+	"go_micro_service_api/auth_service/internal/config"
+	"go_micro_service_api/pkg/cus_otel"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// keyRotationCheckInterval is how often the signing keys are checked for rotation
+const keyRotationCheckInterval = time.Hour
+
+// NewKeyRotationJob rotates the signing keys on start, so the asymmetric clients can sign tokens,
+// and then checks the keys periodically until the application stops.
+func NewKeyRotationJob(lc fx.Lifecycle, keyService *application.KeyService) {
+	// Get config
+	cfg := config.GetConfig()
+	interval := time.Duration(cfg.SigningKey.RotationIntervalSecs) * time.Second
+	retention := time.Duration(cfg.SigningKey.RetentionSecs) * time.Second
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			// Make sure there are active keys before serving
+			err := keyService.RotateDueKeys(startCtx, interval, retention)
+			if err != nil {
+				cus_otel.Error(startCtx, "failed to rotate signing keys", cus_otel.NewField("error", err))
+				return err
+			}
+
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(keyRotationCheckInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						err := keyService.RotateDueKeys(ctx, interval, retention)
+						if err != nil {
+							cus_otel.Error(ctx, "failed to rotate signing keys", cus_otel.NewField("error", err))
+						}
+					}
+				}
+			}()
+
+			cus_otel.Info(startCtx, "signing key rotation job started")
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+
+			cus_otel.Info(stopCtx, "signing key rotation job stopped")
+			return nil
+		},
+	})
+}
@@ -0,0 +1,41 @@
+package scheduler
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/outbox"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// outboxRelayInterval is how often the outbox is checked for the pending events
+const outboxRelayInterval = 500 * time.Millisecond
+
+// NewOutboxRelayJob publishes the events in the outbox until the application stops
+func NewOutboxRelayJob(lc fx.Lifecycle, relay *outbox.Relay) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			go func() {
+				defer close(done)
+				relay.Run(ctx, outboxRelayInterval)
+			}()
+
+			cus_otel.Info(startCtx, "outbox relay job started")
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+
+			cus_otel.Info(stopCtx, "outbox relay job stopped")
+			return nil
+		},
+	})
+}
@@ -2,23 +2,82 @@ package token_helper
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"go_micro_service_api/auth_service/internal/config"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
+	"maps"
+	"math/big"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-type JwtToken struct{}
+const (
+	// _kidHeader is the header of the key id
+	_kidHeader = "kid"
+	// _rsaKeyBits is the size of the generated RSA keys
+	_rsaKeyBits = 2048
+)
+
+type JwtToken struct {
+	issuer   string        // Issuer is stamped in the tokens and required when validating, it's disabled if empty
+	audience string        // Audience is stamped in the tokens and required when validating, it's disabled if empty
+	leeway   time.Duration // Leeway is the clock skew allowed when validating exp, nbf and iat
+}
+
+type JwtTokenOption func(*JwtToken)
+
+func WithIssuer(issuer string) JwtTokenOption {
+	return func(j *JwtToken) {
+		j.issuer = issuer
+	}
+}
+
+func WithAudience(audience string) JwtTokenOption {
+	return func(j *JwtToken) {
+		j.audience = audience
+	}
+}
+
+func WithLeeway(leeway time.Duration) JwtTokenOption {
+	return func(j *JwtToken) {
+		j.leeway = leeway
+	}
+}
+
+func NewJwtToken(opts ...JwtTokenOption) *JwtToken {
+	j := &JwtToken{}
+	for _, opt := range opts {
+		opt(j)
+	}
+	return j
+}
 
-func NewJwtToken() *JwtToken {
-	return &JwtToken{}
+// NewJwtTokenFromConfig creates the JwtToken with the issuer, audience and leeway of the config.
+func NewJwtTokenFromConfig() *JwtToken {
+	// Get config
+	cfg := config.GetConfig()
+
+	return NewJwtToken(
+		WithIssuer(cfg.Token.Issuer),
+		WithAudience(cfg.Token.Audience),
+		WithLeeway(time.Duration(cfg.Token.LeewaySecs)*time.Second),
+	)
 }
 
 var _ TokenHelper = (*JwtToken)(nil)
 
 func (j *JwtToken) Create(ctx context.Context, secret string, claims map[string]interface{}) (string, *cus_err.CusError) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims(claims))
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, j.stampClaims(claims))
 	tokenString, err := token.SignedString([]byte(secret))
 	if err != nil {
 		cus_err := cus_err.New(cus_err.InternalServerError, "Failed to create token", err)
@@ -37,12 +96,10 @@ func (j *JwtToken) Validate(ctx context.Context, tokenString string, secret stri
 		}
 
 		return []byte(secret), nil
-	})
+	}, j.parserOptions()...)
 
 	if err != nil {
-		cusErr := cus_err.New(cus_err.Unauthorized, "Jwt token is invalid", err)
-		cus_otel.Error(ctx, cusErr.Message())
-		return nil, cusErr
+		return nil, j.parseError(ctx, err)
 	}
 
 	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
@@ -72,3 +129,259 @@ func (j *JwtToken) GetPayload(ctx context.Context, tokenString string) (map[stri
 
 	return nil, cusErr
 }
+
+func (j *JwtToken) CreateWithKey(ctx context.Context, key SigningKey, claims map[string]any) (string, *cus_err.CusError) {
+	method, cusErr := j.signingMethod(ctx, key.Alg)
+	if cusErr != nil {
+		return "", cusErr
+	}
+
+	privateKey, cusErr := j.parsePrivateKey(ctx, key)
+	if cusErr != nil {
+		return "", cusErr
+	}
+
+	token := jwt.NewWithClaims(method, j.stampClaims(claims))
+	token.Header[_kidHeader] = key.Kid
+	tokenString, err := token.SignedString(privateKey)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "Failed to create token", err)
+		cus_otel.Error(ctx, cusErr.Message())
+		return "", cusErr
+	}
+	return tokenString, nil
+}
+
+func (j *JwtToken) ValidateWithKey(ctx context.Context, tokenString string, key SigningKey) (map[string]any, *cus_err.CusError) {
+	publicKey, cusErr := j.parsePublicKey(ctx, key)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	// Only the algorithm of the key is accepted, so a token can't choose how it is verified
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		if kid, _ := token.Header[_kidHeader].(string); kid != key.Kid {
+			cusErr := cus_err.New(cus_err.Unauthorized, fmt.Sprintf("Unexpected kid: %v", token.Header[_kidHeader]))
+			cus_otel.Error(ctx, cusErr.Message())
+			return nil, cusErr
+		}
+
+		return publicKey, nil
+	}, append(j.parserOptions(), jwt.WithValidMethods([]string{key.Alg}))...)
+
+	if err != nil {
+		return nil, j.parseError(ctx, err)
+	}
+
+	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
+		return claims, nil
+	}
+
+	cusErr = cus_err.New(cus_err.Unauthorized, "Invalid token claims")
+	cus_otel.Error(ctx, cusErr.Message())
+
+	return nil, cusErr
+}
+
+func (j *JwtToken) GetKeyId(ctx context.Context, tokenString string) (string, *cus_err.CusError) {
+	token, _, err := new(jwt.Parser).ParseUnverified(tokenString, jwt.MapClaims{})
+	if err != nil {
+		cusErr := cus_err.New(cus_err.Unauthorized, "Jwt token is invalid", err)
+		cus_otel.Error(ctx, cusErr.Message())
+		return "", cusErr
+	}
+
+	kid, ok := token.Header[_kidHeader].(string)
+	if !ok || kid == "" {
+		cusErr := cus_err.New(cus_err.Unauthorized, "Missing kid in token header")
+		cus_otel.Error(ctx, cusErr.Message())
+		return "", cusErr
+	}
+
+	return kid, nil
+}
+
+func (j *JwtToken) GenerateKey(ctx context.Context, kid string, alg string) (*SigningKey, *cus_err.CusError) {
+	var (
+		privateKey any
+		publicKey  any
+		err        error
+	)
+	switch alg {
+	case AlgRS256:
+		var key *rsa.PrivateKey
+		key, err = rsa.GenerateKey(rand.Reader, _rsaKeyBits)
+		if err == nil {
+			privateKey, publicKey = key, &key.PublicKey
+		}
+	case AlgES256:
+		var key *ecdsa.PrivateKey
+		key, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err == nil {
+			privateKey, publicKey = key, &key.PublicKey
+		}
+	default:
+		cusErr := cus_err.New(cus_err.InternalServerError, fmt.Sprintf("Unsupported signing algorithm: %s", alg))
+		cus_otel.Error(ctx, cusErr.Message())
+		return nil, cusErr
+	}
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "Failed to generate signing key", err)
+		cus_otel.Error(ctx, cusErr.Message())
+		return nil, cusErr
+	}
+
+	// Encode keys to PEM
+	privateDer, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "Failed to encode private key", err)
+		cus_otel.Error(ctx, cusErr.Message())
+		return nil, cusErr
+	}
+	publicDer, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "Failed to encode public key", err)
+		cus_otel.Error(ctx, cusErr.Message())
+		return nil, cusErr
+	}
+
+	return &SigningKey{
+		Kid:        kid,
+		Alg:        alg,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDer})),
+		PublicKey:  string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDer})),
+	}, nil
+}
+
+func (j *JwtToken) ToJwk(ctx context.Context, key SigningKey) (*Jwk, *cus_err.CusError) {
+	publicKey, cusErr := j.parsePublicKey(ctx, key)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	jwk := &Jwk{
+		Kid: key.Kid,
+		Use: "sig",
+		Alg: key.Alg,
+	}
+	encode := base64.RawURLEncoding.EncodeToString
+	switch pub := publicKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encode(pub.N.Bytes())
+		jwk.E = encode(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		// Coordinates are padded to the curve size
+		size := (pub.Curve.Params().BitSize + 7) / 8
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = encode(pub.X.FillBytes(make([]byte, size)))
+		jwk.Y = encode(pub.Y.FillBytes(make([]byte, size)))
+	}
+
+	return jwk, nil
+}
+
+// stampClaims copies the claims with the issuer and audience of the helper, unless the claims have them already
+func (j *JwtToken) stampClaims(claims map[string]any) jwt.MapClaims {
+	stamped := jwt.MapClaims(maps.Clone(claims))
+	if stamped == nil {
+		stamped = jwt.MapClaims{}
+	}
+	if _, ok := stamped["iss"]; !ok && j.issuer != "" {
+		stamped["iss"] = j.issuer
+	}
+	if _, ok := stamped["aud"]; !ok && j.audience != "" {
+		stamped["aud"] = []string{j.audience}
+	}
+	return stamped
+}
+
+// parserOptions returns the options which validate the registered claims,
+// the exp is required so a token can't live longer than its cache
+func (j *JwtToken) parserOptions() []jwt.ParserOption {
+	opts := []jwt.ParserOption{
+		jwt.WithLeeway(j.leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+	}
+	if j.issuer != "" {
+		opts = append(opts, jwt.WithIssuer(j.issuer))
+	}
+	if j.audience != "" {
+		opts = append(opts, jwt.WithAudience(j.audience))
+	}
+	return opts
+}
+
+// parseError converts the error of parsing a token to cus_err
+func (j *JwtToken) parseError(ctx context.Context, err error) *cus_err.CusError {
+	var cusErr *cus_err.CusError
+	if errors.Is(err, jwt.ErrTokenExpired) {
+		cusErr = cus_err.New(cus_err.TokenExpired, "Jwt token is expired", err)
+	} else {
+		cusErr = cus_err.New(cus_err.Unauthorized, "Jwt token is invalid", err)
+	}
+	cus_otel.Error(ctx, cusErr.Message())
+	return cusErr
+}
+
+// signingMethod maps the algorithm to the jwt signing method
+func (j *JwtToken) signingMethod(ctx context.Context, alg string) (jwt.SigningMethod, *cus_err.CusError) {
+	switch alg {
+	case AlgRS256:
+		return jwt.SigningMethodRS256, nil
+	case AlgES256:
+		return jwt.SigningMethodES256, nil
+	}
+
+	cusErr := cus_err.New(cus_err.InternalServerError, fmt.Sprintf("Unsupported signing algorithm: %s", alg))
+	cus_otel.Error(ctx, cusErr.Message())
+	return nil, cusErr
+}
+
+// parsePrivateKey parses the PEM encoded private key of the signing key
+func (j *JwtToken) parsePrivateKey(ctx context.Context, key SigningKey) (any, *cus_err.CusError) {
+	var (
+		privateKey any
+		err        error
+	)
+	switch key.Alg {
+	case AlgRS256:
+		privateKey, err = jwt.ParseRSAPrivateKeyFromPEM([]byte(key.PrivateKey))
+	case AlgES256:
+		privateKey, err = jwt.ParseECPrivateKeyFromPEM([]byte(key.PrivateKey))
+	default:
+		err = fmt.Errorf("unsupported signing algorithm: %s", key.Alg)
+	}
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, fmt.Sprintf("Invalid private key of kid: %s", key.Kid), err)
+		cus_otel.Error(ctx, cusErr.Message())
+		return nil, cusErr
+	}
+
+	return privateKey, nil
+}
+
+// parsePublicKey parses the PEM encoded public key of the signing key
+func (j *JwtToken) parsePublicKey(ctx context.Context, key SigningKey) (any, *cus_err.CusError) {
+	var (
+		publicKey any
+		err       error
+	)
+	switch key.Alg {
+	case AlgRS256:
+		publicKey, err = jwt.ParseRSAPublicKeyFromPEM([]byte(key.PublicKey))
+	case AlgES256:
+		publicKey, err = jwt.ParseECPublicKeyFromPEM([]byte(key.PublicKey))
+	default:
+		err = fmt.Errorf("unsupported signing algorithm: %s", key.Alg)
+	}
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, fmt.Sprintf("Invalid public key of kid: %s", key.Kid), err)
+		cus_otel.Error(ctx, cusErr.Message())
+		return nil, cusErr
+	}
+
+	return publicKey, nil
+}
@@ -2,7 +2,9 @@ package token_helper
 
 import (
 	"context"
+	"go_micro_service_api/pkg/cus_err"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -36,6 +38,7 @@ func TestValidateToken(t *testing.T) {
 		"role":  "admin",
 		"name":  "test",
 		"iat":   123456,
+		"exp":   time.Now().Add(time.Hour).Unix(),
 	}
 
 	token, err := jwtToken.Create(ctx, secret, claims)
@@ -90,3 +93,167 @@ func TestGetPayload(t *testing.T) {
 		assert.Empty(t, payload)
 	})
 }
+
+func TestCreateWithKey(t *testing.T) {
+	jwtToken := NewJwtToken()
+	ctx := context.Background()
+
+	claims := map[string]any{
+		"cid": 123456,
+		"iat": 123456,
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+
+	for _, alg := range []string{AlgRS256, AlgES256} {
+		t.Run(alg, func(t *testing.T) {
+			key, err := jwtToken.GenerateKey(ctx, "kid-"+alg, alg)
+			assert.Nil(t, err)
+			assert.NotEmpty(t, key.PrivateKey)
+			assert.NotEmpty(t, key.PublicKey)
+
+			token, err := jwtToken.CreateWithKey(ctx, *key, claims)
+			assert.Nil(t, err)
+			assert.NotEmpty(t, token)
+
+			// The kid is in the header
+			kid, err := jwtToken.GetKeyId(ctx, token)
+			assert.Nil(t, err)
+			assert.Equal(t, key.Kid, kid)
+
+			// Only the public key is needed to verify
+			payload, err := jwtToken.ValidateWithKey(ctx, token, SigningKey{Kid: key.Kid, Alg: alg, PublicKey: key.PublicKey})
+			assert.Nil(t, err)
+			assert.Equal(t, claims["cid"], int(payload["cid"].(float64)))
+
+			// Another key can't verify the token
+			other, err := jwtToken.GenerateKey(ctx, key.Kid, alg)
+			assert.Nil(t, err)
+			_, err = jwtToken.ValidateWithKey(ctx, token, *other)
+			assert.NotNil(t, err)
+
+			// A HS256 token can't be verified by the key
+			hsToken, err := jwtToken.Create(ctx, "secret", claims)
+			assert.Nil(t, err)
+			_, err = jwtToken.ValidateWithKey(ctx, hsToken, *key)
+			assert.NotNil(t, err)
+
+			// The asymmetric token can't be verified by a secret
+			_, err = jwtToken.Validate(ctx, token, "secret")
+			assert.NotNil(t, err)
+
+			jwk, err := jwtToken.ToJwk(ctx, *key)
+			assert.Nil(t, err)
+			assert.Equal(t, key.Kid, jwk.Kid)
+			assert.Equal(t, alg, jwk.Alg)
+			assert.Equal(t, "sig", jwk.Use)
+		})
+	}
+
+	t.Run("Token without kid", func(t *testing.T) {
+		token, err := jwtToken.Create(ctx, "secret", claims)
+		assert.Nil(t, err)
+
+		_, err = jwtToken.GetKeyId(ctx, token)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Unsupported algorithm", func(t *testing.T) {
+		_, err := jwtToken.GenerateKey(ctx, "kid", "HS256")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestRegisteredClaims(t *testing.T) {
+	jwtToken := NewJwtToken(
+		WithIssuer("issuer"),
+		WithAudience("audience"),
+		WithLeeway(time.Minute),
+	)
+	ctx := context.Background()
+	secret := "secret"
+	now := time.Now()
+
+	t.Run("Stamp issuer and audience", func(t *testing.T) {
+		token, err := jwtToken.Create(ctx, secret, map[string]any{
+			"iat": now.Unix(),
+			"exp": now.Add(time.Hour).Unix(),
+		})
+		assert.Nil(t, err)
+
+		payload, err := jwtToken.Validate(ctx, token, secret)
+		assert.Nil(t, err)
+		assert.Equal(t, "issuer", payload["iss"])
+		assert.Equal(t, []any{"audience"}, payload["aud"])
+	})
+
+	t.Run("Expired token", func(t *testing.T) {
+		token, err := jwtToken.Create(ctx, secret, map[string]any{
+			"iat": now.Add(-time.Hour).Unix(),
+			"exp": now.Add(-time.Minute * 2).Unix(),
+		})
+		assert.Nil(t, err)
+
+		_, err = jwtToken.Validate(ctx, token, secret)
+		assert.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+
+	t.Run("Expired token within leeway", func(t *testing.T) {
+		token, err := jwtToken.Create(ctx, secret, map[string]any{
+			"iat": now.Add(-time.Hour).Unix(),
+			"exp": now.Add(-time.Second * 30).Unix(),
+		})
+		assert.Nil(t, err)
+
+		_, err = jwtToken.Validate(ctx, token, secret)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Token without exp", func(t *testing.T) {
+		token, err := jwtToken.Create(ctx, secret, map[string]any{
+			"iat": now.Unix(),
+		})
+		assert.Nil(t, err)
+
+		_, err = jwtToken.Validate(ctx, token, secret)
+		assert.NotNil(t, err)
+		assert.Equal(t, cus_err.Unauthorized, err.Code().Int())
+	})
+
+	t.Run("Token not valid yet", func(t *testing.T) {
+		token, err := jwtToken.Create(ctx, secret, map[string]any{
+			"iat": now.Unix(),
+			"nbf": now.Add(time.Minute * 2).Unix(),
+			"exp": now.Add(time.Hour).Unix(),
+		})
+		assert.Nil(t, err)
+
+		_, err = jwtToken.Validate(ctx, token, secret)
+		assert.NotNil(t, err)
+		assert.Equal(t, cus_err.Unauthorized, err.Code().Int())
+	})
+
+	t.Run("Token of another issuer", func(t *testing.T) {
+		token, err := jwtToken.Create(ctx, secret, map[string]any{
+			"iat": now.Unix(),
+			"exp": now.Add(time.Hour).Unix(),
+			"iss": "another",
+		})
+		assert.Nil(t, err)
+
+		_, err = jwtToken.Validate(ctx, token, secret)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Token of another audience", func(t *testing.T) {
+		token, err := jwtToken.Create(ctx, secret, map[string]any{
+			"iat": now.Unix(),
+			"exp": now.Add(time.Hour).Unix(),
+			"aud": "another",
+		})
+		assert.Nil(t, err)
+
+		_, err = jwtToken.Validate(ctx, token, secret)
+		assert.NotNil(t, err)
+	})
+}
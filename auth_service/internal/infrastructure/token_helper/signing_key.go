@@ -0,0 +1,27 @@
+package token_helper
+
+const (
+	AlgRS256 = "RS256"
+	AlgES256 = "ES256"
+)
+
+// SigningKey is an asymmetric key pair, the keys are PEM encoded
+type SigningKey struct {
+	Kid        string
+	Alg        string
+	PrivateKey string // PrivateKey could be empty when the key is only used to verify
+	PublicKey  string
+}
+
+// Jwk is the public part of a signing key in JSON Web Key format (RFC 7517)
+type Jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`   // RSA modulus
+	E   string `json:"e,omitempty"`   // RSA exponent
+	Crv string `json:"crv,omitempty"` // EC curve
+	X   string `json:"x,omitempty"`   // EC x coordinate
+	Y   string `json:"y,omitempty"`   // EC y coordinate
+}
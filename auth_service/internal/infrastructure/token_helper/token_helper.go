@@ -6,7 +6,16 @@ import (
 )
 
 type TokenHelper interface {
+	// Create, Validate sign and verify the token with the client secret (HS256)
 	Create(ctx context.Context, secret string, claims map[string]any) (string, *cus_err.CusError)
 	Validate(ctx context.Context, token string, secret string) (map[string]any, *cus_err.CusError)
 	GetPayload(ctx context.Context, token string) (map[string]any, *cus_err.CusError)
+
+	// CreateWithKey, ValidateWithKey sign and verify the token with an asymmetric key pair (RS256/ES256),
+	// the kid of the key is put in the token header
+	CreateWithKey(ctx context.Context, key SigningKey, claims map[string]any) (string, *cus_err.CusError)
+	ValidateWithKey(ctx context.Context, token string, key SigningKey) (map[string]any, *cus_err.CusError)
+	GetKeyId(ctx context.Context, token string) (string, *cus_err.CusError)
+	GenerateKey(ctx context.Context, kid string, alg string) (*SigningKey, *cus_err.CusError)
+	ToJwk(ctx context.Context, key SigningKey) (*Jwk, *cus_err.CusError)
 }
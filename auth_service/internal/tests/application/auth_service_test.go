@@ -7,6 +7,9 @@ import (
 	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/loginrecord"
+	entUser "go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/user"
+	"go_micro_service_api/auth_service/internal/infrastructure/redis_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/token_helper"
 	"go_micro_service_api/auth_service/internal/tests"
 	"go_micro_service_api/pkg/cus_crypto"
@@ -28,13 +31,15 @@ func setupAuthApplication() (authApp *application.AuthService, db db.Database, c
 	cache = redis_cache.NewRedisCache(redis)
 	clientRepo := ent_impl.NewClientRepoImpl(db, cache)
 	userRepo := ent_impl.NewUserRepoImpl(db)
+	tokenRepo := redis_impl.NewTokenRepoImpl(cache)
 	tokenHelper := token_helper.NewJwtToken()
 
-	authService := domainService.NewAuthService(clientRepo, userRepo, cache, tokenHelper)
-	clientService := domainService.NewClientService(clientRepo)
-	userService := domainService.NewUserService(clientRepo, userRepo)
+	keyService := domainService.NewKeyService(ent_impl.NewSigningKeyRepoImpl(db, cache), tokenHelper)
+	authService := domainService.NewAuthService(clientRepo, userRepo, tokenRepo, ent_impl.NewOutboxRepoImpl(db), keyService, domainService.NewRiskEngine(userRepo), cache, tokenHelper)
+	clientService := domainService.NewClientService(clientRepo, ent_impl.NewOutboxRepoImpl(db))
+	userService := domainService.NewUserService(clientRepo, userRepo, tokenRepo, ent_impl.NewOutboxRepoImpl(db))
 	reqAnalyzer := req_analyzer.NewReqAnalyzer()
-	authApp = application.NewAuthService(authService, clientService, userService, db, reqAnalyzer)
+	authApp = application.NewAuthService(authService, clientService, userService, keyService, db, reqAnalyzer)
 
 	return authApp, db, cache, closeFunc
 }
@@ -212,6 +217,51 @@ func TestLogin(t *testing.T) {
 		assert.Nil(t, res)
 		assert.Equal(t, cus_err.WrongPassword, cusErr.Code().Int())
 	})
+
+	t.Run("Every attempt is recorded", func(t *testing.T) {
+		// Login with an unknown user, the attempt is rolled back and recorded without the user
+		cToken, err := authApp.ClientAuth(ctx, &auth.ClientAuthRequest{
+			ClientId: clientInfo.Id,
+		})
+		require.Nil(t, err)
+
+		_, err = authApp.Login(ctx, &auth.LoginRequest{
+			UserId:      99999,
+			AccessToken: cToken.AccessToken,
+			Password:    "password",
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.(*cus_err.CusError).Code().Int())
+
+		entClient := db.GetConn(ctx).(*ent.Client)
+		records, e := entClient.LoginRecord.Query().
+			Where(loginrecord.HasUsersWith(entUser.ID(userInfo.Id))).
+			Order(ent.Asc(loginrecord.FieldID)).
+			All(ctx)
+		require.Nil(t, e)
+		require.Len(t, records, 3)
+
+		// The successful login
+		assert.True(t, records[0].IsSuccess)
+		assert.Equal(t, 0, records[0].ErrCode)
+		assert.Empty(t, records[0].ErrMessage)
+
+		// The login without cToken is rolled back, but it's still recorded
+		assert.False(t, records[1].IsSuccess)
+		assert.NotEqual(t, 0, records[1].ErrCode)
+
+		// The login with wrong password is recorded with the password fail times
+		assert.False(t, records[2].IsSuccess)
+		assert.Equal(t, cus_err.AccountPasswordError, records[2].ErrCode)
+		assert.Equal(t, "Invalid password", records[2].ErrMessage)
+
+		unknownRecords, e := entClient.LoginRecord.Query().
+			Where(loginrecord.Not(loginrecord.HasUsers())).
+			All(ctx)
+		require.Nil(t, e)
+		require.Len(t, unknownRecords, 1)
+		assert.Equal(t, cus_err.ResourceNotFound, unknownRecords[0].ErrCode)
+	})
 }
 
 func TestValidToken(t *testing.T) {
@@ -27,7 +27,7 @@ func setupClientService() (clientApp *application.ClientService, db db.Database,
 	redis, closeFunc := tests.NewMemoryRedis()
 	cache = redis_cache.NewRedisCache(redis)
 	clientRepo := ent_impl.NewClientRepoImpl(db, cache)
-	clientService := service.NewClientService(clientRepo)
+	clientService := service.NewClientService(clientRepo, ent_impl.NewOutboxRepoImpl(db))
 	clientApp = application.NewClientService(clientService, db)
 	return clientApp, db, cache, closeFunc
 }
@@ -299,7 +299,7 @@ func TestCreateRole(t *testing.T) {
 		req := &auth.CreateRoleRequest{
 			ClientId: 12345,
 			RoleName: "test",
-			PermIds:  []int64{101, 102},
+			PermIds:  []int64{101, 999},
 		}
 
 		// Create the same role again
@@ -395,7 +395,7 @@ func TestUpdateRole(t *testing.T) {
 			ClientId: 12345,
 			RoleId:   123,
 			RoleName: "test2",
-			PermIds:  []int64{101, 102},
+			PermIds:  []int64{101, 999},
 		}
 
 		_, e := clientApp.UpdateRole(ctx, req)
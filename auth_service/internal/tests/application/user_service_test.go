@@ -4,7 +4,10 @@ import (
 	"context"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/auth_service/internal/infrastructure/redis_impl"
+	"go_micro_service_api/auth_service/internal/infrastructure/token_helper"
 	"go_micro_service_api/auth_service/internal/tests"
+	"go_micro_service_api/pkg/cus_crypto"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/db"
 	redis_cache "go_micro_service_api/pkg/db/redis"
@@ -25,9 +28,13 @@ func setupUserApplication() (userApp *application.UserService, db db.Database, c
 	cache = redis_cache.NewRedisCache(redis)
 	clientRepo := ent_impl.NewClientRepoImpl(db, cache)
 	userRepo := ent_impl.NewUserRepoImpl(db)
+	tokenRepo := redis_impl.NewTokenRepoImpl(cache)
+	tokenHelper := token_helper.NewJwtToken()
 
-	userService := domainService.NewUserService(clientRepo, userRepo)
-	userApp = application.NewUserService(userService, db)
+	keyService := domainService.NewKeyService(ent_impl.NewSigningKeyRepoImpl(db, cache), tokenHelper)
+	authService := domainService.NewAuthService(clientRepo, userRepo, tokenRepo, ent_impl.NewOutboxRepoImpl(db), keyService, domainService.NewRiskEngine(userRepo), cache, tokenHelper)
+	userService := domainService.NewUserService(clientRepo, userRepo, tokenRepo, ent_impl.NewOutboxRepoImpl(db))
+	userApp = application.NewUserService(userService, authService, db)
 	return userApp, db, cache, closeFunc
 }
 func TestCreateUser(t *testing.T) {
@@ -175,6 +182,7 @@ func TestUpdateUser(t *testing.T) {
 		SetStatus(userInfo.Status.Int()).
 		SetPasswordFailTimes(0).
 		SetRolesID(1).
+		SetAuthClientsID(clientInfo.Id).
 		Save(ctx)
 	require.Nil(t, e)
 	require.NotNil(t, user)
@@ -197,13 +205,13 @@ func TestUpdateUser(t *testing.T) {
 
 		// Find the user
 		tx, ok := db.GetConn(ctx).(*ent.Client)
-		expectedPwd := "FKiLnS9SxVtfvPnF2cEYdQ=="
 		require.True(t, ok)
 		entUser, e := tx.User.Get(ctx, req.Id)
 		require.Nil(t, e)
 		require.NotNil(t, entUser)
 		require.Equal(t, req.Account, entUser.Account)
-		require.Equal(t, expectedPwd, entUser.Password)
+		crypto := cus_crypto.New()
+		require.True(t, crypto.CompareHashAndPassword(ctx, entUser.Password, req.Password))
 	})
 
 	t.Run("Update user with invalid status", func(t *testing.T) {
@@ -222,3 +230,103 @@ func TestUpdateUser(t *testing.T) {
 		require.Equal(t, cus_err.AccountPasswordError, cusErr.Code().Int())
 	})
 }
+
+func TestResetPassword(t *testing.T) {
+	userApp, db, _, closeFunc := setupUserApplication()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create a client
+	_, e := tx.AuthClient.Create().
+		SetID(12345).
+		SetMerchantID(11111).
+		SetClientType(enum.ClientType.Frontend.Id).
+		SetLoginFailedTimes(3).
+		SetTokenExpireSecs(3600).
+		SetActive(true).
+		SetSecret("secret").
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Create a locked user
+	_, e = tx.User.Create().
+		SetID(123456).
+		SetAccount("test").
+		SetPassword("oldPassword").
+		SetStatus(enum.UserStatusType.Locked.Int()).
+		SetPasswordFailTimes(3).
+		SetRolesID(1).
+		SetAuthClientsID(12345).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	t.Run("Reset password", func(t *testing.T) {
+		token, err := userApp.CreatePasswordResetToken(ctx, &auth.CreatePasswordResetTokenRequest{
+			UserId: 123456,
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, token.ResetToken)
+		require.Equal(t, int64(domainService.PasswordResetTokenExpireSecs), token.ExpireSecs)
+
+		res, err := userApp.ResetPassword(ctx, &auth.ResetPasswordRequest{
+			ResetToken: token.ResetToken,
+			Password:   "newPassword1",
+		})
+		require.Nil(t, err)
+		require.NotNil(t, res)
+
+		// The user is unlocked with the new password
+		conn, ok := db.GetConn(ctx).(*ent.Client)
+		require.True(t, ok)
+		user, e := conn.User.Get(ctx, 123456)
+		require.Nil(t, e)
+		crypto := cus_crypto.New()
+		require.True(t, crypto.CompareHashAndPassword(ctx, user.Password, "newPassword1"))
+		require.Equal(t, 0, user.PasswordFailTimes)
+		require.Equal(t, enum.UserStatusType.Active.Int(), user.Status)
+
+		// The sessions of the user are revoked
+		revocations, e := conn.TokenRevocation.Query().All(ctx)
+		require.Nil(t, e)
+		require.Len(t, revocations, 1)
+		require.Equal(t, "Password reset", revocations[0].Reason)
+
+		// The token can only be used once
+		_, err = userApp.ResetPassword(ctx, &auth.ResetPasswordRequest{
+			ResetToken: token.ResetToken,
+			Password:   "newPassword2",
+		})
+		require.NotNil(t, err)
+		require.Equal(t, cus_err.TokenExpired, err.(*cus_err.CusError).Code().Int())
+	})
+
+	t.Run("Reset password with unknown token", func(t *testing.T) {
+		_, err := userApp.ResetPassword(ctx, &auth.ResetPasswordRequest{
+			ResetToken: "unknown",
+			Password:   "newPassword1",
+		})
+		require.NotNil(t, err)
+		require.Equal(t, cus_err.TokenExpired, err.(*cus_err.CusError).Code().Int())
+	})
+
+	t.Run("Create token for unknown user", func(t *testing.T) {
+		_, err := userApp.CreatePasswordResetToken(ctx, &auth.CreatePasswordResetTokenRequest{
+			UserId: 999,
+		})
+		require.NotNil(t, err)
+		require.Equal(t, cus_err.ResourceNotFound, err.(*cus_err.CusError).Code().Int())
+	})
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 	"go_micro_service_api/auth_service/internal/domain/aggregate"
+	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/repository"
 	"go_micro_service_api/auth_service/internal/domain/service"
 	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/auth_service/internal/infrastructure/redis_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/token_helper"
 	"go_micro_service_api/auth_service/internal/tests"
 	"go_micro_service_api/pkg/cus_crypto"
@@ -17,6 +19,7 @@ import (
 	redis_cache "go_micro_service_api/pkg/db/redis"
 	"go_micro_service_api/pkg/enum"
 	"testing"
+	"time"
 
 	"github.com/jinzhu/copier"
 	_ "github.com/mattn/go-sqlite3"
@@ -31,9 +34,11 @@ func setupAuthService() (authService *service.AuthService, clientRepo repository
 	userRepo := ent_impl.NewUserRepoImpl(db)
 	cache = redis_cache.NewRedisCache(redis)
 	clientRepo = ent_impl.NewClientRepoImpl(db, cache)
+	tokenRepo := redis_impl.NewTokenRepoImpl(cache)
 	tokenHelper := token_helper.NewJwtToken()
+	keyService := service.NewKeyService(ent_impl.NewSigningKeyRepoImpl(db, cache), tokenHelper)
 
-	return service.NewAuthService(clientRepo, userRepo, cache, tokenHelper), clientRepo, db, cache, closeFunc
+	return service.NewAuthService(clientRepo, userRepo, tokenRepo, ent_impl.NewOutboxRepoImpl(db), keyService, service.NewRiskEngine(userRepo), cache, tokenHelper), clientRepo, db, cache, closeFunc
 }
 
 func TestCreateClientToken(t *testing.T) {
@@ -95,6 +100,13 @@ func TestCreateClientToken(t *testing.T) {
 		cacheToken, err := cache.Get(ctx, key)
 		assert.Nil(t, err)
 		assert.Equal(t, token.Token, cacheToken)
+
+		// The token expires by itself as the cache
+		payload, err := authService.GetTokenPayload(ctx, token.Token)
+		assert.Nil(t, err)
+		assert.Equal(t, payload.IssueAt+int64(clientInfo.TokenExpireSecs), payload.ExpireAt)
+		assert.Equal(t, payload.IssueAt, payload.NotBefore)
+		assert.NotEmpty(t, payload.TokenId)
 	})
 
 	t.Run("Create Client Token With no exists client", func(t *testing.T) {
@@ -305,12 +317,15 @@ func TestLogin(t *testing.T) {
 			require.Nil(t, rollbackErr)
 		}()
 
-		token, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false)
+		token, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
 		assert.Nil(t, err)
 		assert.NotNil(t, token)
 
-		// Check if the token is in the cache
-		key := fmt.Sprintf("%s:%d", service.TokenPrefix, user.Id)
+		// Check if the token is in the cache of the session
+		payload, err := authService.GetTokenPayload(ctx, token.Token)
+		require.Nil(t, err)
+		require.NotNil(t, payload.SessionId)
+		key := fmt.Sprintf("%s:%d:%s", service.TokenPrefix, user.Id, *payload.SessionId)
 		cacheToken, err := cache.Get(ctx, key)
 		assert.Nil(t, err)
 		assert.Equal(t, token.Token, cacheToken)
@@ -330,18 +345,18 @@ func TestLogin(t *testing.T) {
 		require.Nil(t, err)
 
 		// First time
-		token, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false)
+		token, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
 		assert.Nil(t, err)
 		assert.NotNil(t, token)
 		assert.NotEmpty(t, token)
 
 		// Use the same cToken to login, should not success
-		empty, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false)
+		empty, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
 		assert.Empty(t, empty)
 		assert.NotNil(t, err)
 
 		// Use the new token to login ,should success
-		token, err = authService.Login(ctx, token.Token, user.Id, user.Password, false)
+		token, err = authService.Login(ctx, token.Token, user.Id, user.Password, false, vo.Device{})
 		assert.Nil(t, err)
 		assert.NotNil(t, token)
 		assert.NotEmpty(t, token)
@@ -360,22 +375,1894 @@ func TestLogin(t *testing.T) {
 		cToken, err := authService.CreateClientToken(ctx, clientInfo.Id)
 		require.Nil(t, err)
 
-		token, err := authService.Login(ctx, cToken.Token, user.Id, "wrongpassword", false)
+		token, err := authService.Login(ctx, cToken.Token, user.Id, "wrongpassword", false, vo.Device{})
 		assert.Empty(t, token)
 		assert.NotNil(t, err)
 		assert.Equal(t, cus_err.WrongPassword, err.Code().Int())
 
 		// Decrease the login failed times
 		for i := 0; i < 5; i++ {
-			token, err := authService.Login(ctx, cToken.Token, user.Id, "wrongpassword", false)
+			token, err := authService.Login(ctx, cToken.Token, user.Id, "wrongpassword", false, vo.Device{})
 			assert.Empty(t, token)
 			assert.NotNil(t, err)
 		}
 
 		// The account should be locked
-		token, err = authService.Login(ctx, cToken.Token, user.Id, user.Password, false)
+		token, err = authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
 		assert.Empty(t, token)
 		assert.NotNil(t, err)
 		assert.Equal(t, cus_err.AccountLocked, err.Code().Int())
 	})
 }
+
+func TestRefreshToken(t *testing.T) {
+	authService, _, db, cache, closeFunc := setupAuthService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	clientInfo := vo.ClientInfo{
+		Id:               123456789,
+		MerchantId:       111111111,
+		ClientType:       enum.ClientType.Frontend,
+		Active:           true,
+		TokenExpireSecs:  3600,
+		LoginFailedTimes: 5,
+	}
+
+	user := &aggregate.User{
+		Id:       123456789,
+		Account:  "account",
+		Password: "password",
+		Status:   enum.UserStatusType.Active,
+	}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the client
+	_, e := tx.AuthClient.Create().
+		SetID(clientInfo.Id).
+		SetMerchantID(clientInfo.MerchantId).
+		SetClientType(clientInfo.ClientType.Id).
+		SetLoginFailedTimes(clientInfo.LoginFailedTimes).
+		SetTokenExpireSecs(clientInfo.TokenExpireSecs).
+		SetRefreshTokenExpireSecs(7200).
+		SetActive(clientInfo.Active).
+		SetSecret("secret").
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Create a user
+	crypto := cus_crypto.New()
+	pwd, err := crypto.HashPassword(ctx, user.Password)
+	require.Nil(t, err)
+
+	_, e = tx.User.Create().
+		SetID(user.Id).
+		SetAccount(user.Account).
+		SetPassword(pwd).
+		SetPasswordFailTimes(0).
+		SetStatus(enum.UserStatusType.Active.Int()).
+		SetRolesID(1).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	// login returns the first refresh token of a new family
+	login := func(t *testing.T) *vo.LoginTokenList {
+		cToken, err := authService.CreateClientToken(ctx, clientInfo.Id)
+		require.Nil(t, err)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+
+		token, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
+		require.Nil(t, err)
+		require.NotEmpty(t, token.RefreshToken)
+		assert.Equal(t, 7200, token.RefreshTokenExpireSecs)
+		return token
+	}
+
+	t.Run("Refresh token rotation", func(t *testing.T) {
+		loginToken := login(t)
+
+		refreshed, err := authService.RefreshToken(ctx, loginToken.RefreshToken)
+		require.Nil(t, err)
+		assert.NotEmpty(t, refreshed.Token)
+		assert.NotEqual(t, loginToken.RefreshToken, refreshed.RefreshToken)
+		assert.Equal(t, clientInfo.TokenExpireSecs, refreshed.TokenExpireSecs)
+
+		// New access token is valid
+		payload, err := authService.ValidateToken(ctx, refreshed.Token)
+		require.Nil(t, err)
+		assert.Equal(t, user.Id, *payload.UserId)
+
+		// Rotated refresh token can be used again
+		_, err = authService.RefreshToken(ctx, refreshed.RefreshToken)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Reuse of rotated refresh token revokes the family", func(t *testing.T) {
+		loginToken := login(t)
+
+		refreshed, err := authService.RefreshToken(ctx, loginToken.RefreshToken)
+		require.Nil(t, err)
+
+		// Reuse the rotated refresh token
+		_, err = authService.RefreshToken(ctx, loginToken.RefreshToken)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.Unauthorized, err.Code().Int())
+
+		// The latest refresh token of the family is revoked
+		_, err = authService.RefreshToken(ctx, refreshed.RefreshToken)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+		// The access token is revoked as well
+		_, err = authService.ValidateToken(ctx, refreshed.Token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+
+	t.Run("New login invalidates the previous family", func(t *testing.T) {
+		first := login(t)
+		login(t)
+
+		_, err := authService.RefreshToken(ctx, first.RefreshToken)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+
+	t.Run("Unknown refresh token", func(t *testing.T) {
+		_, err := authService.RefreshToken(ctx, "unknown")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+
+	t.Run("Reuse of rotated refresh token revokes every family of the user", func(t *testing.T) {
+		// Allow the user to login on several devices
+		_, e := db.GetConn(ctx).(*ent.Client).AuthClient.UpdateOneID(clientInfo.Id).
+			SetSessionPolicy(enum.SessionPolicyType.Unlimited.Int()).
+			Save(ctx)
+		require.Nil(t, e)
+		err := cache.Delete(ctx, fmt.Sprintf("%s:%d", ent_impl.ClientInfoPrefix, clientInfo.Id))
+		require.Nil(t, err)
+
+		stolen := login(t)
+		other := login(t)
+
+		_, err = authService.RefreshToken(ctx, stolen.RefreshToken)
+		require.Nil(t, err)
+		_, err = authService.RefreshToken(ctx, stolen.RefreshToken)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.Unauthorized, err.Code().Int())
+
+		// The family on the other device is revoked as well
+		_, err = authService.RefreshToken(ctx, other.RefreshToken)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+		_, err = authService.ValidateToken(ctx, other.Token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+}
+
+func TestLogout(t *testing.T) {
+	authService, _, db, _, closeFunc := setupAuthService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	clientInfo := vo.ClientInfo{
+		Id:               123456789,
+		MerchantId:       111111111,
+		ClientType:       enum.ClientType.Frontend,
+		Active:           true,
+		TokenExpireSecs:  3600,
+		LoginFailedTimes: 5,
+	}
+
+	user := &aggregate.User{
+		Id:       123456789,
+		Account:  "account",
+		Password: "password",
+		Status:   enum.UserStatusType.Active,
+	}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the client
+	_, e := tx.AuthClient.Create().
+		SetID(clientInfo.Id).
+		SetMerchantID(clientInfo.MerchantId).
+		SetClientType(clientInfo.ClientType.Id).
+		SetLoginFailedTimes(clientInfo.LoginFailedTimes).
+		SetTokenExpireSecs(clientInfo.TokenExpireSecs).
+		SetActive(clientInfo.Active).
+		SetSecret("secret").
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Create a user
+	crypto := cus_crypto.New()
+	pwd, err := crypto.HashPassword(ctx, user.Password)
+	require.Nil(t, err)
+
+	_, e = tx.User.Create().
+		SetID(user.Id).
+		SetAccount(user.Account).
+		SetPassword(pwd).
+		SetPasswordFailTimes(0).
+		SetStatus(enum.UserStatusType.Active.Int()).
+		SetAuthClientsID(clientInfo.Id).
+		SetRolesID(1).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	t.Run("Logout user", func(t *testing.T) {
+		cToken, err := authService.CreateClientToken(ctx, clientInfo.Id)
+		require.Nil(t, err)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		token, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
+		require.Nil(t, err)
+
+		revocation, err := authService.Logout(ctx, token.Token)
+		require.Nil(t, err)
+		assert.Equal(t, clientInfo.Id, revocation.ClientId)
+		assert.Equal(t, user.Id, *revocation.UserId)
+		assert.Equal(t, entity.RevocationScopeLogout, revocation.Scope)
+
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		// The access token is revoked
+		_, err = authService.ValidateToken(ctx, token.Token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+		// The refresh token is revoked
+		_, err = authService.RefreshToken(ctx, token.RefreshToken)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+
+	t.Run("Logout client token", func(t *testing.T) {
+		cToken, err := authService.CreateClientToken(ctx, clientInfo.Id)
+		require.Nil(t, err)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		revocation, err := authService.Logout(ctx, cToken.Token)
+		require.Nil(t, err)
+		assert.Nil(t, revocation.UserId)
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		_, err = authService.ValidateToken(ctx, cToken.Token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+
+	t.Run("Logout with invalid token", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer db.Rollback(ctx)
+
+		_, err = authService.Logout(ctx, "invalid")
+		require.NotNil(t, err)
+	})
+}
+
+func TestRevokeUserTokens(t *testing.T) {
+	authService, _, db, _, closeFunc := setupAuthService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	clientInfo := vo.ClientInfo{
+		Id:               123456789,
+		MerchantId:       111111111,
+		ClientType:       enum.ClientType.Frontend,
+		Active:           true,
+		TokenExpireSecs:  3600,
+		LoginFailedTimes: 5,
+	}
+
+	users := []*aggregate.User{
+		{Id: 1001, Account: "account1", Password: "password"},
+		{Id: 1002, Account: "account2", Password: "password"},
+	}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the clients
+	for _, id := range []int64{clientInfo.Id, 987654321} {
+		_, e := tx.AuthClient.Create().
+			SetID(id).
+			SetMerchantID(clientInfo.MerchantId).
+			SetClientType(clientInfo.ClientType.Id).
+			SetLoginFailedTimes(clientInfo.LoginFailedTimes).
+			SetTokenExpireSecs(clientInfo.TokenExpireSecs).
+			SetActive(clientInfo.Active).
+			SetSecret("secret").
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Create the users
+	crypto := cus_crypto.New()
+	for _, user := range users {
+		pwd, err := crypto.HashPassword(ctx, user.Password)
+		require.Nil(t, err)
+
+		_, e := tx.User.Create().
+			SetID(user.Id).
+			SetAccount(user.Account).
+			SetPassword(pwd).
+			SetPasswordFailTimes(0).
+			SetStatus(enum.UserStatusType.Active.Int()).
+			SetAuthClientsID(clientInfo.Id).
+			SetRolesID(1).
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	// login all users and returns the tokens
+	loginAll := func(t *testing.T) []*vo.LoginTokenList {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+
+		tokens := make([]*vo.LoginTokenList, 0, len(users))
+		for _, user := range users {
+			cToken, err := authService.CreateClientToken(ctx, clientInfo.Id)
+			require.Nil(t, err)
+
+			token, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
+			require.Nil(t, err)
+			tokens = append(tokens, token)
+		}
+		return tokens
+	}
+
+	t.Run("Revoke one user", func(t *testing.T) {
+		tokens := loginAll(t)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		operatorId := int64(1)
+		revocation, err := authService.RevokeUserTokens(ctx, clientInfo.Id, &users[0].Id, "test", &operatorId)
+		require.Nil(t, err)
+		assert.Equal(t, entity.RevocationScopeUser, revocation.Scope)
+		assert.Equal(t, 1, revocation.RevokedCount)
+		assert.Equal(t, "test", revocation.Reason)
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		_, err = authService.ValidateToken(ctx, tokens[0].Token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+		// The other user is still logged in
+		_, err = authService.ValidateToken(ctx, tokens[1].Token)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Revoke every user of the client", func(t *testing.T) {
+		tokens := loginAll(t)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		revocation, err := authService.RevokeUserTokens(ctx, clientInfo.Id, nil, "secret leaked", nil)
+		require.Nil(t, err)
+		assert.Equal(t, entity.RevocationScopeClient, revocation.Scope)
+		assert.Equal(t, len(users), revocation.RevokedCount)
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		for _, token := range tokens {
+			_, err = authService.ValidateToken(ctx, token.Token)
+			require.NotNil(t, err)
+			assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+			_, err = authService.RefreshToken(ctx, token.RefreshToken)
+			require.NotNil(t, err)
+			assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+		}
+	})
+
+	t.Run("User not in client", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer db.Rollback(ctx)
+
+		_, err = authService.RevokeUserTokens(ctx, 987654321, &users[0].Id, "", nil)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+}
+
+func TestSessions(t *testing.T) {
+	authService, _, db, _, closeFunc := setupAuthService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	user := &aggregate.User{
+		Id:       123456789,
+		Account:  "account",
+		Password: "password",
+		Status:   enum.UserStatusType.Active,
+	}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the client, it allows 2 sessions at the same time
+	clientId := int64(123456789)
+	_, e := tx.AuthClient.Create().
+		SetID(clientId).
+		SetMerchantID(111111111).
+		SetClientType(enum.ClientType.Frontend.Id).
+		SetLoginFailedTimes(5).
+		SetTokenExpireSecs(3600).
+		SetActive(true).
+		SetSecret("secret").
+		SetSessionPolicy(enum.SessionPolicyType.Limited.Int()).
+		SetMaxSessions(2).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Create a user
+	crypto := cus_crypto.New()
+	pwd, err := crypto.HashPassword(ctx, user.Password)
+	require.Nil(t, err)
+
+	_, e = tx.User.Create().
+		SetID(user.Id).
+		SetAccount(user.Account).
+		SetPassword(pwd).
+		SetPasswordFailTimes(0).
+		SetStatus(enum.UserStatusType.Active.Int()).
+		SetAuthClientsID(clientId).
+		SetRolesID(1).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	// login logs in on the given device
+	login := func(t *testing.T, forceLogin bool, device vo.Device) (*vo.LoginTokenList, *cus_err.CusError) {
+		cToken, err := authService.CreateClientToken(ctx, clientId)
+		require.Nil(t, err)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+
+		return authService.Login(ctx, cToken.Token, user.Id, user.Password, forceLogin, device)
+	}
+
+	// revokeAll revokes every session of the user between the sub tests
+	revokeAll := func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		_, err = authService.RevokeUserTokens(ctx, clientId, &user.Id, "", nil)
+		require.Nil(t, err)
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+	}
+
+	desktop := vo.Device{Ip: "1.1.1.1", Browser: "Chrome", Os: "Windows"}
+	phone := vo.Device{Ip: "2.2.2.2", Browser: "Safari", Os: "iOS", IsMobile: true}
+	tablet := vo.Device{Ip: "3.3.3.3", Browser: "Safari", Os: "iPadOS", IsMobile: true}
+
+	t.Run("Concurrent sessions", func(t *testing.T) {
+		defer revokeAll(t)
+
+		desktopToken, err := login(t, false, desktop)
+		require.Nil(t, err)
+		phoneToken, err := login(t, false, phone)
+		require.Nil(t, err)
+
+		// Both sessions are valid
+		desktopPayload, err := authService.ValidateToken(ctx, desktopToken.Token)
+		require.Nil(t, err)
+		phonePayload, err := authService.ValidateToken(ctx, phoneToken.Token)
+		require.Nil(t, err)
+		assert.NotEqual(t, *desktopPayload.SessionId, *phonePayload.SessionId)
+
+		sessions, err := authService.ListSessions(ctx, user.Id)
+		require.Nil(t, err)
+		require.Len(t, sessions, 2)
+		assert.Equal(t, *desktopPayload.SessionId, sessions[0].Id)
+		assert.Equal(t, desktop, sessions[0].Device)
+		assert.Equal(t, phone, sessions[1].Device)
+	})
+
+	t.Run("Session limit", func(t *testing.T) {
+		defer revokeAll(t)
+
+		desktopToken, err := login(t, false, desktop)
+		require.Nil(t, err)
+		phoneToken, err := login(t, false, phone)
+		require.Nil(t, err)
+
+		// The limit is reached
+		_, err = login(t, false, tablet)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.SessionLimit, err.Code().Int())
+
+		// Force login replaces the least recently active session
+		_, err = login(t, true, tablet)
+		require.Nil(t, err)
+
+		_, err = authService.ValidateToken(ctx, desktopToken.Token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+		_, err = authService.RefreshToken(ctx, desktopToken.RefreshToken)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+		_, err = authService.ValidateToken(ctx, phoneToken.Token)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Refresh keeps the session", func(t *testing.T) {
+		defer revokeAll(t)
+
+		desktopToken, err := login(t, false, desktop)
+		require.Nil(t, err)
+
+		refreshed, err := authService.RefreshToken(ctx, desktopToken.RefreshToken)
+		require.Nil(t, err)
+
+		before, err := authService.GetTokenPayload(ctx, desktopToken.Token)
+		require.Nil(t, err)
+		after, err := authService.ValidateToken(ctx, refreshed.Token)
+		require.Nil(t, err)
+		assert.Equal(t, *before.SessionId, *after.SessionId)
+
+		sessions, err := authService.ListSessions(ctx, user.Id)
+		require.Nil(t, err)
+		assert.Len(t, sessions, 1)
+	})
+
+	t.Run("Revoke other session", func(t *testing.T) {
+		defer revokeAll(t)
+
+		desktopToken, err := login(t, false, desktop)
+		require.Nil(t, err)
+		phoneToken, err := login(t, false, phone)
+		require.Nil(t, err)
+
+		phonePayload, err := authService.ValidateToken(ctx, phoneToken.Token)
+		require.Nil(t, err)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		revocation, err := authService.RevokeSession(ctx, clientId, user.Id, *phonePayload.SessionId)
+		require.Nil(t, err)
+		assert.Equal(t, entity.RevocationScopeSession, revocation.Scope)
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		_, err = authService.ValidateToken(ctx, phoneToken.Token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+		_, err = authService.ValidateToken(ctx, desktopToken.Token)
+		assert.Nil(t, err)
+
+		// Unknown session
+		ctx, err = db.Begin(ctx)
+		require.Nil(t, err)
+		defer db.Rollback(ctx)
+		_, err = authService.RevokeSession(ctx, clientId, user.Id, "unknown")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+
+	t.Run("Logout keeps the other sessions", func(t *testing.T) {
+		defer revokeAll(t)
+
+		desktopToken, err := login(t, false, desktop)
+		require.Nil(t, err)
+		phoneToken, err := login(t, false, phone)
+		require.Nil(t, err)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		_, err = authService.Logout(ctx, phoneToken.Token)
+		require.Nil(t, err)
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		_, err = authService.ValidateToken(ctx, phoneToken.Token)
+		require.NotNil(t, err)
+		_, err = authService.ValidateToken(ctx, desktopToken.Token)
+		assert.Nil(t, err)
+	})
+}
+
+func TestAsymmetricSigning(t *testing.T) {
+	authService, _, db, cache, closeFunc := setupAuthService()
+	defer closeFunc()
+
+	ctx := context.Background()
+	tokenHelper := token_helper.NewJwtToken()
+	signingKeyRepo := ent_impl.NewSigningKeyRepoImpl(db, cache)
+	keyService := service.NewKeyService(signingKeyRepo, tokenHelper)
+
+	user := &aggregate.User{
+		Id:       123456789,
+		Account:  "account",
+		Password: "password",
+		Status:   enum.UserStatusType.Active,
+	}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the clients signed by RS256 and ES256
+	rsClientId := int64(123456789)
+	esClientId := int64(987654321)
+	for clientId, signingMethod := range map[int64]enum.SigningMethod{
+		rsClientId: enum.SigningMethodType.RS256,
+		esClientId: enum.SigningMethodType.ES256,
+	} {
+		_, e := tx.AuthClient.Create().
+			SetID(clientId).
+			SetMerchantID(111111111).
+			SetClientType(enum.ClientType.Frontend.Id).
+			SetLoginFailedTimes(5).
+			SetTokenExpireSecs(3600).
+			SetActive(true).
+			SetSecret("secret").
+			SetSigningMethod(signingMethod.Id).
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Create a user of the RS256 client
+	crypto := cus_crypto.New()
+	pwd, err := crypto.HashPassword(ctx, user.Password)
+	require.Nil(t, err)
+
+	_, e := tx.User.Create().
+		SetID(user.Id).
+		SetAccount(user.Account).
+		SetPassword(pwd).
+		SetPasswordFailTimes(0).
+		SetStatus(enum.UserStatusType.Active.Int()).
+		SetAuthClientsID(rsClientId).
+		SetRolesID(1).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	// rotate rotates the due keys
+	rotate := func(t *testing.T, interval time.Duration) []*entity.SigningKey {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		keys := make([]*entity.SigningKey, 0)
+		for _, signingMethod := range enum.AsymmetricSigningMethods {
+			key, err := keyService.RotateDueKey(ctx, signingMethod, interval, time.Hour)
+			require.Nil(t, err)
+			if key != nil {
+				keys = append(keys, key)
+			}
+		}
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+		return keys
+	}
+
+	t.Run("No signing key yet", func(t *testing.T) {
+		_, err := authService.CreateClientToken(ctx, rsClientId)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InternalServerError, err.Code().Int())
+	})
+
+	var firstKeys []*entity.SigningKey
+	t.Run("Bootstrap the keys", func(t *testing.T) {
+		firstKeys = rotate(t, time.Hour)
+		require.Len(t, firstKeys, len(enum.AsymmetricSigningMethods))
+
+		// The keys are not due yet
+		keys := rotate(t, time.Hour)
+		assert.Empty(t, keys)
+	})
+
+	var rsToken string
+	t.Run("Sign with the active key", func(t *testing.T) {
+		for _, clientId := range []int64{rsClientId, esClientId} {
+			cToken, err := authService.CreateClientToken(ctx, clientId)
+			require.Nil(t, err)
+
+			payload, err := authService.ValidateToken(ctx, cToken.Token)
+			require.Nil(t, err)
+			assert.Equal(t, clientId, payload.ClientId)
+		}
+
+		cToken, err := authService.CreateClientToken(ctx, rsClientId)
+		require.Nil(t, err)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		tokens, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
+		require.Nil(t, err)
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+		rsToken = tokens.Token
+
+		payload, err := authService.ValidateToken(ctx, rsToken)
+		require.Nil(t, err)
+		assert.Equal(t, user.Id, *payload.UserId)
+
+		kid, err := tokenHelper.GetKeyId(ctx, rsToken)
+		require.Nil(t, err)
+		assert.Contains(t, []string{firstKeys[0].Kid, firstKeys[1].Kid}, kid)
+	})
+
+	t.Run("Old keys stay valid after rotation", func(t *testing.T) {
+		secondKeys := rotate(t, 0)
+		require.Len(t, secondKeys, len(enum.AsymmetricSigningMethods))
+
+		// The token signed by the retired key is still valid
+		_, err := authService.ValidateToken(ctx, rsToken)
+		require.Nil(t, err)
+
+		// New tokens are signed by the new key
+		cToken, err := authService.CreateClientToken(ctx, rsClientId)
+		require.Nil(t, err)
+		oldKid, err := tokenHelper.GetKeyId(ctx, rsToken)
+		require.Nil(t, err)
+		newKid, err := tokenHelper.GetKeyId(ctx, cToken.Token)
+		require.Nil(t, err)
+		assert.NotEqual(t, oldKid, newKid)
+
+		// The JWKS contains both the retired and the active keys
+		jwks, err := keyService.GetJwks(ctx)
+		require.Nil(t, err)
+		kids := make([]string, 0, len(jwks))
+		for _, jwk := range jwks {
+			kids = append(kids, jwk.Kid)
+		}
+		assert.Len(t, kids, 4)
+		assert.Contains(t, kids, oldKid)
+		assert.Contains(t, kids, newKid)
+	})
+
+	t.Run("Concurrent rotation doesn't create another active key", func(t *testing.T) {
+		signingMethod := enum.SigningMethodType.RS256
+
+		// Another instance found the same active key before this rotation
+		staleKeys, err := signingKeyRepo.FindActive(ctx, signingMethod)
+		require.Nil(t, err)
+		require.Len(t, staleKeys, 1)
+
+		rotated := rotate(t, 0)
+		require.Len(t, rotated, len(enum.AsymmetricSigningMethods))
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+
+		// The stale key is already retired
+		now := time.Now()
+		staleKeys[0].RotatedAt = &now
+		staleKeys[0].ExpireAt = &now
+		err = signingKeyRepo.Retire(ctx, staleKeys[0])
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.Conflict, err.Code().Int())
+
+		// A second active key is rejected
+		_, err = signingKeyRepo.Create(ctx, &entity.SigningKey{
+			Kid:           "RS256-concurrent",
+			SigningMethod: signingMethod,
+			PrivateKey:    "private",
+			PublicKey:     "public",
+			Active:        true,
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceIsExist, err.Code().Int())
+
+		ctx, err = db.Rollback(ctx)
+		require.Nil(t, err)
+
+		activeKeys, err := signingKeyRepo.FindActive(ctx, signingMethod)
+		require.Nil(t, err)
+		require.Len(t, activeKeys, 1)
+		assert.Equal(t, rotated[0].Kid, activeKeys[0].Kid)
+	})
+}
+
+func TestSecondFactor(t *testing.T) {
+	authService, _, db, _, closeFunc := setupAuthService()
+	defer closeFunc()
+
+	ctx := context.Background()
+	crypto := cus_crypto.New()
+
+	user := &aggregate.User{
+		Id:       123456789,
+		Account:  "account",
+		Password: "password",
+		Status:   enum.UserStatusType.Active,
+	}
+	backendUser := &aggregate.User{
+		Id:       987654321,
+		Account:  "backend",
+		Password: "password",
+		Status:   enum.UserStatusType.Active,
+	}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the frontend client and the backend client which requires two-factor authentication
+	clientId := int64(123456789)
+	backendClientId := int64(987654321)
+	for _, c := range []struct {
+		id          int64
+		clientType  enum.Client
+		mfaRequired bool
+	}{
+		{clientId, enum.ClientType.Frontend, false},
+		{backendClientId, enum.ClientType.Backend, true},
+	} {
+		_, e := tx.AuthClient.Create().
+			SetID(c.id).
+			SetMerchantID(111111111).
+			SetClientType(c.clientType.Id).
+			SetLoginFailedTimes(5).
+			SetTokenExpireSecs(3600).
+			SetActive(true).
+			SetSecret("secret").
+			SetMfaRequired(c.mfaRequired).
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Create the users
+	pwd, err := crypto.HashPassword(ctx, user.Password)
+	require.Nil(t, err)
+	for u, cid := range map[*aggregate.User]int64{user: clientId, backendUser: backendClientId} {
+		_, e := tx.User.Create().
+			SetID(u.Id).
+			SetAccount(u.Account).
+			SetPassword(pwd).
+			SetPasswordFailTimes(0).
+			SetStatus(enum.UserStatusType.Active.Int()).
+			SetAuthClientsID(cid).
+			SetRolesID(1).
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	// inTx runs the function in a transaction, it's committed only when there is no error
+	inTx := func(t *testing.T, f func(ctx context.Context) *cus_err.CusError) *cus_err.CusError {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		err = f(ctx)
+		if err != nil {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+			return err
+		}
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+		return nil
+	}
+
+	// login logs in and returns the challenge data
+	login := func(t *testing.T, clientId int64, u *aggregate.User) map[string]interface{} {
+		cToken, err := authService.CreateClientToken(ctx, clientId)
+		require.Nil(t, err)
+
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := authService.Login(ctx, cToken.Token, u.Id, u.Password, false, vo.Device{})
+			return err
+		})
+		require.NotNil(t, err)
+		require.Equal(t, cus_err.SecondFactorRequired, err.Code().Int())
+
+		data, ok := err.Data().(map[string]interface{})
+		require.True(t, ok)
+		return data
+	}
+
+	// verify verifies the challenge with the code
+	verify := func(t *testing.T, challengeToken string, code string) (*vo.LoginTokenList, []string, *cus_err.CusError) {
+		var (
+			tokens        *vo.LoginTokenList
+			recoveryCodes []string
+		)
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			var err *cus_err.CusError
+			tokens, recoveryCodes, err = authService.VerifySecondFactor(ctx, challengeToken, code)
+			return err
+		})
+		return tokens, recoveryCodes, err
+	}
+
+	var (
+		secret        string
+		recoveryCodes []string
+	)
+	t.Run("Enroll TOTP", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			var (
+				uri string
+				err *cus_err.CusError
+			)
+			secret, uri, err = authService.EnrollTotp(ctx, user.Id)
+			assert.Contains(t, uri, "otpauth://totp/")
+			assert.Contains(t, uri, user.Account)
+			return err
+		})
+		require.Nil(t, err)
+		require.NotEmpty(t, secret)
+
+		// The pending secret doesn't require the second factor yet
+		cToken, err := authService.CreateClientToken(ctx, clientId)
+		require.Nil(t, err)
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := authService.Login(ctx, cToken.Token, user.Id, user.Password, false, vo.Device{})
+			return err
+		})
+		require.Nil(t, err)
+
+		// Confirm with a wrong code
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := authService.ConfirmTotp(ctx, user.Id, "000000x")
+			return err
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidVerificationCode, err.Code().Int())
+
+		// Confirm with the first code
+		code, err := crypto.GenerateTotpCode(ctx, secret, time.Now())
+		require.Nil(t, err)
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			var err *cus_err.CusError
+			recoveryCodes, err = authService.ConfirmTotp(ctx, user.Id, code)
+			return err
+		})
+		require.Nil(t, err)
+		assert.Len(t, recoveryCodes, service.RecoveryCodeCount)
+
+		// It can't be enrolled again
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, _, err := authService.EnrollTotp(ctx, user.Id)
+			return err
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceIsExist, err.Code().Int())
+	})
+
+	t.Run("Login with TOTP", func(t *testing.T) {
+		data := login(t, clientId, user)
+		assert.Equal(t, true, data["enrolled"])
+		challengeToken := data["challengeToken"].(string)
+
+		// Wrong code
+		_, _, err := verify(t, challengeToken, "123456x")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidVerificationCode, err.Code().Int())
+
+		// The code confirming the authenticator is used, so use the code of the next time step
+		codeTime := time.Now().Add(cus_crypto.TotpPeriod)
+		code, err := crypto.GenerateTotpCode(ctx, secret, codeTime)
+		require.Nil(t, err)
+		tokens, codes, err := verify(t, challengeToken, code)
+		require.Nil(t, err)
+		assert.Empty(t, codes)
+
+		payload, err := authService.ValidateToken(ctx, tokens.Token)
+		require.Nil(t, err)
+		assert.Equal(t, user.Id, *payload.UserId)
+
+		// The challenge can be used only once
+		_, _, err = verify(t, challengeToken, code)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+		// The code can't be replayed by another login, neither can the earlier codes
+		for _, stepTime := range []time.Time{codeTime, codeTime.Add(-cus_crypto.TotpPeriod)} {
+			code, err := crypto.GenerateTotpCode(ctx, secret, stepTime)
+			require.Nil(t, err)
+			data := login(t, clientId, user)
+			_, _, err = verify(t, data["challengeToken"].(string), code)
+			require.NotNil(t, err)
+			assert.Equal(t, cus_err.InvalidVerificationCode, err.Code().Int())
+		}
+	})
+
+	t.Run("Login with recovery code", func(t *testing.T) {
+		data := login(t, clientId, user)
+		tokens, _, err := verify(t, data["challengeToken"].(string), recoveryCodes[0])
+		require.Nil(t, err)
+		assert.NotEmpty(t, tokens.Token)
+
+		// The recovery code is used
+		data = login(t, clientId, user)
+		_, _, err = verify(t, data["challengeToken"].(string), recoveryCodes[0])
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidVerificationCode, err.Code().Int())
+	})
+
+	t.Run("Too many attempts", func(t *testing.T) {
+		data := login(t, clientId, user)
+		challengeToken := data["challengeToken"].(string)
+		for i := 1; i <= service.SecondFactorMaxAttempts; i++ {
+			_, _, err := verify(t, challengeToken, "wrong")
+			require.NotNil(t, err)
+			assert.Equal(t, cus_err.InvalidVerificationCode, err.Code().Int())
+		}
+
+		// The challenge is dropped
+		code, err := crypto.GenerateTotpCode(ctx, secret, time.Now())
+		require.Nil(t, err)
+		_, _, err = verify(t, challengeToken, code)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+
+	t.Run("Mandatory for backend client", func(t *testing.T) {
+		// The user without authenticator enrolls during the login
+		data := login(t, backendClientId, backendUser)
+		assert.Equal(t, false, data["enrolled"])
+		pendingSecret := data["secret"].(string)
+		assert.Contains(t, data["otpauthUri"], backendUser.Account)
+
+		code, err := crypto.GenerateTotpCode(ctx, pendingSecret, time.Now())
+		require.Nil(t, err)
+		tokens, codes, err := verify(t, data["challengeToken"].(string), code)
+		require.Nil(t, err)
+		assert.NotEmpty(t, tokens.Token)
+		assert.Len(t, codes, service.RecoveryCodeCount)
+
+		// The next login uses the enrolled authenticator
+		data = login(t, backendClientId, backendUser)
+		assert.Equal(t, true, data["enrolled"])
+	})
+}
+
+func TestLockout(t *testing.T) {
+	authService, clientRepo, db, cache, closeFunc := setupAuthService()
+	defer closeFunc()
+	userService := service.NewUserService(clientRepo, ent_impl.NewUserRepoImpl(db), redis_impl.NewTokenRepoImpl(cache), ent_impl.NewOutboxRepoImpl(db))
+
+	ctx := context.Background()
+
+	clientId := int64(123456789)
+	user := &aggregate.User{
+		Id:       123456789,
+		Account:  "account",
+		Password: "password",
+	}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the client, the lockout is doubled on every repeated lockout up to 100 seconds
+	_, e := tx.AuthClient.Create().
+		SetID(clientId).
+		SetMerchantID(111111111).
+		SetClientType(enum.ClientType.Frontend.Id).
+		SetLoginFailedTimes(2).
+		SetTokenExpireSecs(3600).
+		SetActive(true).
+		SetSecret("secret").
+		SetLockoutSecs(60).
+		SetMaxLockoutSecs(100).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Create a user
+	crypto := cus_crypto.New()
+	pwd, err := crypto.HashPassword(ctx, user.Password)
+	require.Nil(t, err)
+
+	_, e = tx.User.Create().
+		SetID(user.Id).
+		SetAccount(user.Account).
+		SetPassword(pwd).
+		SetPasswordFailTimes(0).
+		SetStatus(enum.UserStatusType.Active.Int()).
+		SetRolesID(1).
+		SetAuthClientsID(clientId).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	login := func(t *testing.T, password string) (*vo.LoginTokenList, *cus_err.CusError) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+
+		cToken, err := authService.CreateClientToken(ctx, clientId)
+		require.Nil(t, err)
+
+		return authService.Login(ctx, cToken.Token, user.Id, password, false, vo.Device{})
+	}
+
+	// lockUser fails the login until the user is locked and returns the lock expiry
+	lockUser := func(t *testing.T) time.Time {
+		_, err := login(t, "wrongpassword")
+		require.NotNil(t, err)
+
+		_, err = login(t, "wrongpassword")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.AccountPasswordError, err.Code().Int())
+		data, ok := err.Data().(map[string]interface{})
+		require.True(t, ok)
+		lockedUntil, ok := data["lockedUntil"].(int64)
+		require.True(t, ok)
+
+		return time.Unix(lockedUntil, 0)
+	}
+
+	// expireLock moves the lock expiry of the user to the past
+	expireLock := func(t *testing.T) {
+		_, e := db.GetConn(ctx).(*ent.Client).User.UpdateOneID(user.Id).
+			SetLockedUntil(time.Now().Add(-time.Second)).
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	t.Run("Locked user can't login before the lock expires", func(t *testing.T) {
+		lockedUntil := lockUser(t)
+		assert.WithinDuration(t, time.Now().Add(60*time.Second), lockedUntil, 2*time.Second)
+
+		_, err := login(t, user.Password)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.AccountLocked, err.Code().Int())
+		data, ok := err.Data().(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, lockedUntil.Unix(), data["lockedUntil"])
+		assert.InDelta(t, 60, data["remainingSecs"], 2)
+	})
+
+	t.Run("Repeated lockout is longer and capped", func(t *testing.T) {
+		expireLock(t)
+
+		// Doubled to 120 seconds and capped to 100 seconds
+		lockedUntil := lockUser(t)
+		assert.WithinDuration(t, time.Now().Add(100*time.Second), lockedUntil, 2*time.Second)
+	})
+
+	t.Run("Expired lock is lifted on login", func(t *testing.T) {
+		expireLock(t)
+
+		token, err := login(t, user.Password)
+		require.Nil(t, err)
+		assert.NotEmpty(t, token.Token)
+
+		// The escalation starts over after a successful login
+		found, err := userService.GetUser(ctx, user.Id)
+		require.Nil(t, err)
+		assert.Equal(t, enum.UserStatusType.Active, found.Status)
+		assert.Equal(t, 0, found.LockCount)
+		assert.Nil(t, found.LockedUntil)
+	})
+
+	t.Run("Unlock user", func(t *testing.T) {
+		lockUser(t)
+
+		// Begin a transaction
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+
+		// The user doesn't belong to the other client
+		_, err = userService.UnlockUser(ctx, 987654321, user.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+
+		unlocked, err := userService.UnlockUser(ctx, clientId, user.Id)
+		require.Nil(t, err)
+		assert.Equal(t, enum.UserStatusType.Active, unlocked.Status)
+		assert.Nil(t, unlocked.LockedUntil)
+
+		// The user is not locked anymore
+		_, err = userService.UnlockUser(ctx, clientId, user.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		_, err = login(t, user.Password)
+		assert.Nil(t, err)
+	})
+}
+
+func TestUnusualLogin(t *testing.T) {
+	authService, _, db, _, closeFunc := setupAuthService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	clientId := int64(123456789)
+	user := &aggregate.User{
+		Id:       123456789,
+		Account:  "account",
+		Password: "password",
+	}
+	usualDevice := vo.Device{Browser: "Chrome", City: "Taipei", CountryCode: "TW"}
+	unusualDevice := vo.Device{Browser: "Firefox", City: "Tokyo", CountryCode: "JP"}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the client
+	_, e := tx.AuthClient.Create().
+		SetID(clientId).
+		SetMerchantID(111111111).
+		SetClientType(enum.ClientType.Frontend.Id).
+		SetLoginFailedTimes(5).
+		SetTokenExpireSecs(3600).
+		SetActive(true).
+		SetSecret("secret").
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Create a user
+	crypto := cus_crypto.New()
+	pwd, err := crypto.HashPassword(ctx, user.Password)
+	require.Nil(t, err)
+
+	_, e = tx.User.Create().
+		SetID(user.Id).
+		SetAccount(user.Account).
+		SetPassword(pwd).
+		SetPasswordFailTimes(0).
+		SetStatus(enum.UserStatusType.Active.Int()).
+		SetRolesID(1).
+		SetAuthClientsID(clientId).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// The user logged in from the usual device before
+	_, err = authService.AddLoginRecord(ctx, user.Id, &entity.LoginRecord{
+		Browser:     usualDevice.Browser,
+		City:        usualDevice.City,
+		CountryCode: usualDevice.CountryCode,
+		IsSuccess:   true,
+	})
+	require.Nil(t, err)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	login := func(t *testing.T, device vo.Device) (*vo.LoginTokenList, *cus_err.CusError) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+
+		cToken, err := authService.CreateClientToken(ctx, clientId)
+		require.Nil(t, err)
+
+		return authService.Login(ctx, cToken.Token, user.Id, user.Password, false, device)
+	}
+
+	// holdLogin logs in from the unusual device and returns the pending login token
+	holdLogin := func(t *testing.T) string {
+		token, err := login(t, unusualDevice)
+		assert.Nil(t, token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.UnusualLogin, err.Code().Int())
+		data, ok := err.Data().(map[string]interface{})
+		require.True(t, ok)
+		pendingLoginToken, ok := data["pendingLoginToken"].(string)
+		require.True(t, ok)
+		require.NotEmpty(t, pendingLoginToken)
+
+		return pendingLoginToken
+	}
+
+	completeLogin := func(t *testing.T, pendingLoginToken string, userId int64) (*vo.LoginTokenList, *cus_err.CusError) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+
+		return authService.CompleteUnusualLogin(ctx, pendingLoginToken, userId)
+	}
+
+	t.Run("Usual device is not held back", func(t *testing.T) {
+		token, err := login(t, usualDevice)
+		require.Nil(t, err)
+		assert.NotEmpty(t, token.Token)
+	})
+
+	t.Run("Pending login of another user", func(t *testing.T) {
+		pendingLoginToken := holdLogin(t)
+
+		_, err := completeLogin(t, pendingLoginToken, 987654321)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+
+		// The pending login is consumed
+		_, err = completeLogin(t, pendingLoginToken, user.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+	})
+
+	t.Run("Complete the unusual login", func(t *testing.T) {
+		pendingLoginToken := holdLogin(t)
+
+		// The user of the pending login is found before the verification, it isn't consumed
+		pendingLogin, err := authService.FindPendingLogin(ctx, pendingLoginToken)
+		require.Nil(t, err)
+		assert.Equal(t, user.Id, pendingLogin.UserId)
+
+		token, err := completeLogin(t, pendingLoginToken, pendingLogin.UserId)
+		require.Nil(t, err)
+		assert.NotEmpty(t, token.Token)
+		assert.NotEmpty(t, token.RefreshToken)
+
+		// The pending login can be used only once
+		_, err = completeLogin(t, pendingLoginToken, user.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+		_, err = authService.FindPendingLogin(ctx, pendingLoginToken)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+		// The device is trusted now, even after the user is back to the usual device
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		_, err = authService.AddLoginRecord(ctx, user.Id, &entity.LoginRecord{
+			Browser:     usualDevice.Browser,
+			City:        usualDevice.City,
+			CountryCode: usualDevice.CountryCode,
+			IsSuccess:   true,
+		})
+		require.Nil(t, err)
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		token, err = login(t, unusualDevice)
+		require.Nil(t, err)
+		assert.NotEmpty(t, token.Token)
+	})
+}
+
+func TestRiskSignals(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 11, 14, 22, 0, 0, 0, time.UTC)
+
+	signals := map[string]service.RiskSignal{}
+	for _, signal := range service.DefaultRiskSignals() {
+		signals[signal.Name()] = signal
+	}
+
+	taipei := &entity.LoginRecord{
+		Browser:     "Chrome",
+		Os:          "Windows 10",
+		CountryCode: "TW",
+		City:        "Taipei",
+		Latitude:    25.03,
+		Longitude:   121.56,
+		IsSuccess:   true,
+		CreateAt:    now.Add(-time.Hour),
+	}
+	device := vo.Device{
+		Browser:     "Chrome",
+		Os:          "Windows 10",
+		CountryCode: "TW",
+		City:        "Taipei",
+		Latitude:    25.03,
+		Longitude:   121.56,
+		Asp:         "Chunghwa Telecom",
+	}
+
+	tests := []struct {
+		name   string
+		signal string
+		input  func() *service.RiskInput
+		match  bool
+	}{
+		{
+			name:   "New country",
+			signal: service.RiskSignalNewCountry,
+			input: func() *service.RiskInput {
+				d := device
+				d.CountryCode = "JP"
+				return &service.RiskInput{Device: d, Now: now, Records: []*entity.LoginRecord{taipei}}
+			},
+			match: true,
+		},
+		{
+			name:   "New country of a trusted device",
+			signal: service.RiskSignalNewCountry,
+			input: func() *service.RiskInput {
+				d := device
+				d.CountryCode = "JP"
+				return &service.RiskInput{Device: d, Now: now, Trusted: true, Records: []*entity.LoginRecord{taipei}}
+			},
+			match: false,
+		},
+		{
+			name:   "Impossible travel",
+			signal: service.RiskSignalImpossibleTravel,
+			input: func() *service.RiskInput {
+				d := device
+				d.Latitude, d.Longitude = 51.51, -0.13 // London
+				return &service.RiskInput{Device: d, Now: now, Records: []*entity.LoginRecord{taipei}}
+			},
+			match: true,
+		},
+		{
+			name:   "Possible travel",
+			signal: service.RiskSignalImpossibleTravel,
+			input: func() *service.RiskInput {
+				d := device
+				d.Latitude, d.Longitude = 51.51, -0.13 // London
+				return &service.RiskInput{Device: d, Now: now.Add(24 * time.Hour), Records: []*entity.LoginRecord{taipei}}
+			},
+			match: false,
+		},
+		{
+			name:   "New device",
+			signal: service.RiskSignalNewDevice,
+			input: func() *service.RiskInput {
+				d := device
+				d.Browser = "Firefox"
+				return &service.RiskInput{Device: d, Now: now, Records: []*entity.LoginRecord{taipei}}
+			},
+			match: true,
+		},
+		{
+			name:   "Known device",
+			signal: service.RiskSignalNewDevice,
+			input: func() *service.RiskInput {
+				return &service.RiskInput{Device: device, Now: now, Records: []*entity.LoginRecord{taipei}}
+			},
+			match: false,
+		},
+		{
+			name:   "Failure burst",
+			signal: service.RiskSignalFailureBurst,
+			input: func() *service.RiskInput {
+				records := []*entity.LoginRecord{}
+				for i := 1; i <= 3; i++ {
+					records = append(records, &entity.LoginRecord{CreateAt: now.Add(-time.Duration(i) * time.Minute)})
+				}
+				return &service.RiskInput{Device: device, Now: now, Records: records}
+			},
+			match: true,
+		},
+		{
+			name:   "Old failures",
+			signal: service.RiskSignalFailureBurst,
+			input: func() *service.RiskInput {
+				records := []*entity.LoginRecord{}
+				for i := 1; i <= 3; i++ {
+					records = append(records, &entity.LoginRecord{CreateAt: now.Add(-time.Duration(i) * time.Hour)})
+				}
+				return &service.RiskInput{Device: device, Now: now, Records: records}
+			},
+			match: false,
+		},
+		{
+			name:   "Bad isp",
+			signal: service.RiskSignalBadIsp,
+			input: func() *service.RiskInput {
+				d := device
+				d.Asp = "DigitalOcean, LLC"
+				return &service.RiskInput{Device: d, Now: now}
+			},
+			match: true,
+		},
+		{
+			name:   "Usual isp",
+			signal: service.RiskSignalBadIsp,
+			input: func() *service.RiskInput {
+				return &service.RiskInput{Device: device, Now: now}
+			},
+			match: false,
+		},
+		{
+			name:   "Bot user agent",
+			signal: service.RiskSignalBotUserAgent,
+			input: func() *service.RiskInput {
+				d := device
+				d.IsBot = true
+				return &service.RiskInput{Device: d, Now: now}
+			},
+			match: true,
+		},
+		{
+			name:   "Unusual hour",
+			signal: service.RiskSignalUnusualHour,
+			input: func() *service.RiskInput {
+				records := []*entity.LoginRecord{}
+				for i := 1; i <= 5; i++ {
+					records = append(records, &entity.LoginRecord{IsSuccess: true, CreateAt: now.AddDate(0, 0, -i).Add(-12 * time.Hour)})
+				}
+				return &service.RiskInput{Device: device, Now: now, Records: records}
+			},
+			match: true,
+		},
+		{
+			name:   "Usual hour",
+			signal: service.RiskSignalUnusualHour,
+			input: func() *service.RiskInput {
+				records := []*entity.LoginRecord{}
+				for i := 1; i <= 5; i++ {
+					records = append(records, &entity.LoginRecord{IsSuccess: true, CreateAt: now.AddDate(0, 0, -i).Add(-time.Hour)})
+				}
+				return &service.RiskInput{Device: device, Now: now, Records: records}
+			},
+			match: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signal, ok := signals[tt.signal]
+			require.True(t, ok)
+			assert.Equal(t, tt.match, signal.Match(ctx, tt.input()))
+		})
+	}
+}
+
+func TestRiskPolicy(t *testing.T) {
+	t.Run("Default challenge score and never deny", func(t *testing.T) {
+		policy := vo.RiskPolicy{}
+		assert.Equal(t, vo.RiskDecisionAllow, policy.Decide(vo.DefaultRiskChallengeScore-1))
+		assert.Equal(t, vo.RiskDecisionChallenge, policy.Decide(vo.DefaultRiskChallengeScore))
+		assert.Equal(t, vo.RiskDecisionChallenge, policy.Decide(1000))
+	})
+
+	t.Run("Client thresholds", func(t *testing.T) {
+		policy := vo.RiskPolicy{ChallengeScore: 20, DenyScore: 60}
+		assert.Equal(t, vo.RiskDecisionAllow, policy.Decide(19))
+		assert.Equal(t, vo.RiskDecisionChallenge, policy.Decide(20))
+		assert.Equal(t, vo.RiskDecisionDeny, policy.Decide(60))
+	})
+}
+
+func TestOAuthLogin(t *testing.T) {
+	authService, _, db, _, closeFunc := setupAuthService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	clientId := int64(123456789)
+	otherClientId := int64(987654321)
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the clients
+	for _, id := range []int64{clientId, otherClientId} {
+		_, e := tx.AuthClient.Create().
+			SetID(id).
+			SetMerchantID(111111111).
+			SetClientType(enum.ClientType.Frontend.Id).
+			SetLoginFailedTimes(3).
+			SetTokenExpireSecs(3600).
+			SetActive(true).
+			SetSecret("secret").
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Create the users, the social user has no password, the user of the other client is locked
+	users := []struct {
+		id       int64
+		clientId int64
+		status   enum.UserStatus
+	}{
+		{id: 1, clientId: clientId, status: enum.UserStatusType.Active},
+		{id: 2, clientId: clientId, status: enum.UserStatusType.Locked},
+		{id: 3, clientId: otherClientId, status: enum.UserStatusType.Active},
+	}
+	for _, u := range users {
+		_, e := tx.User.Create().
+			SetID(u.id).
+			SetAccount(fmt.Sprintf("user%d", u.id)).
+			SetPassword("").
+			SetPasswordFailTimes(0).
+			SetStatus(u.status.Int()).
+			SetRolesID(1).
+			SetAuthClientsID(u.clientId).
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	oauthLogin := func(t *testing.T, userId int64) (*vo.LoginTokenList, *cus_err.CusError) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+
+		cToken, err := authService.CreateClientToken(ctx, clientId)
+		require.Nil(t, err)
+
+		return authService.OAuthLogin(ctx, cToken.Token, userId, false, vo.Device{})
+	}
+
+	t.Run("Login without password", func(t *testing.T) {
+		tokens, err := oauthLogin(t, 1)
+		require.Nil(t, err)
+		require.NotNil(t, tokens)
+
+		payload, err := authService.ValidateToken(ctx, tokens.Token)
+		require.Nil(t, err)
+		require.NotNil(t, payload.UserId)
+		assert.Equal(t, int64(1), *payload.UserId)
+		assert.NotEmpty(t, tokens.RefreshToken)
+	})
+
+	t.Run("Locked user can't login", func(t *testing.T) {
+		_, err := oauthLogin(t, 2)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.AccountLocked, err.Code().Int())
+	})
+
+	t.Run("User of another client can't login", func(t *testing.T) {
+		_, err := oauthLogin(t, 3)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+}
+
+func TestPromoteKycVerifiedUser(t *testing.T) {
+	authService, clientRepo, db, cache, closeFunc := setupAuthService()
+	defer closeFunc()
+	userService := service.NewUserService(clientRepo, ent_impl.NewUserRepoImpl(db), redis_impl.NewTokenRepoImpl(cache), ent_impl.NewOutboxRepoImpl(db))
+
+	ctx := context.Background()
+
+	frontendClientId := int64(123456789)
+	backendClientId := int64(987654321)
+	player := &aggregate.User{Id: 1, Account: "player", Password: "password", Status: enum.UserStatusType.Active}
+	operator := &aggregate.User{Id: 2, Account: "operator", Password: "password", Status: enum.UserStatusType.Active}
+
+	// Begin a transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the clients with their system roles
+	clients := []struct {
+		id         int64
+		clientType enum.Client
+		roleIds    []int64
+	}{
+		{
+			id:         frontendClientId,
+			clientType: enum.ClientType.Frontend,
+			roleIds:    []int64{entity.FrontendRoles.Guest.Id, entity.FrontendRoles.Player.Id, entity.FrontendRoles.KycVerifiedPlayer.Id},
+		},
+		{
+			id:         backendClientId,
+			clientType: enum.ClientType.Backend,
+			roleIds:    []int64{entity.BackendRoles.Admin.Id},
+		},
+	}
+	for _, c := range clients {
+		_, e := tx.AuthClient.Create().
+			SetID(c.id).
+			SetMerchantID(111111111).
+			SetClientType(c.clientType.Id).
+			SetLoginFailedTimes(5).
+			SetTokenExpireSecs(3600).
+			SetRefreshTokenExpireSecs(7200).
+			SetActive(true).
+			SetSecret("secret").
+			AddRoleIDs(c.roleIds...).
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Create the users
+	crypto := cus_crypto.New()
+	pwd, err := crypto.HashPassword(ctx, player.Password)
+	require.Nil(t, err)
+
+	_, e := tx.User.Create().
+		SetID(player.Id).
+		SetAccount(player.Account).
+		SetPassword(pwd).
+		SetPasswordFailTimes(0).
+		SetStatus(enum.UserStatusType.Active.Int()).
+		SetRolesID(entity.FrontendRoles.Player.Id).
+		SetAuthClientsID(frontendClientId).
+		Save(ctx)
+	require.Nil(t, e)
+
+	_, e = tx.User.Create().
+		SetID(operator.Id).
+		SetAccount(operator.Account).
+		SetPassword(pwd).
+		SetPasswordFailTimes(0).
+		SetStatus(enum.UserStatusType.Active.Int()).
+		SetRolesID(entity.BackendRoles.Admin.Id).
+		SetAuthClientsID(backendClientId).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	promote := func(t *testing.T, userId int64) *cus_err.CusError {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+
+		_, err = userService.PromoteKycVerifiedUser(ctx, userId)
+		if err == nil {
+			err = authService.ExpireAccessTokens(ctx, userId)
+		}
+		if err != nil {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+			return err
+		}
+
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+		return nil
+	}
+
+	login := func(t *testing.T) *vo.LoginTokenList {
+		cToken, err := authService.CreateClientToken(ctx, frontendClientId)
+		require.Nil(t, err)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+
+		token, err := authService.Login(ctx, cToken.Token, player.Id, player.Password, false, vo.Device{})
+		require.Nil(t, err)
+		return token
+	}
+
+	t.Run("The refreshed token carries the new role", func(t *testing.T) {
+		token := login(t)
+		payload, err := authService.ValidateToken(ctx, token.Token)
+		require.Nil(t, err)
+		assert.Equal(t, entity.FrontendRoles.Player.Id, *payload.RoleId)
+
+		err = promote(t, player.Id)
+		require.Nil(t, err)
+
+		// The old token is expired, the session is kept
+		_, err = authService.ValidateToken(ctx, token.Token)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.TokenExpired, err.Code().Int())
+
+		refreshed, err := authService.RefreshToken(ctx, token.RefreshToken)
+		require.Nil(t, err)
+		payload, err = authService.ValidateToken(ctx, refreshed.Token)
+		require.Nil(t, err)
+		assert.Equal(t, entity.FrontendRoles.KycVerifiedPlayer.Id, *payload.RoleId)
+	})
+
+	t.Run("Promote the verified user again", func(t *testing.T) {
+		err := promote(t, player.Id)
+		assert.Nil(t, err)
+	})
+
+	t.Run("The client has no KYC verified role", func(t *testing.T) {
+		err := promote(t, operator.Id)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+
+	t.Run("User not found", func(t *testing.T) {
+		err := promote(t, 9999)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+}
@@ -8,6 +8,7 @@ import (
 	"go_micro_service_api/auth_service/internal/domain/service"
 	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
 	"go_micro_service_api/auth_service/internal/tests"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/db"
@@ -26,7 +27,7 @@ func setupClientService() (clientService *service.ClientService, db db.Database,
 	cache = redis_cache.NewRedisCache(redis)
 	clientRepo := ent_impl.NewClientRepoImpl(db, cache)
 
-	return service.NewClientService(clientRepo), db, cache, closeFunc
+	return service.NewClientService(clientRepo, ent_impl.NewOutboxRepoImpl(db)), db, cache, closeFunc
 }
 
 func TestCreateClient(t *testing.T) {
@@ -61,6 +62,10 @@ func TestCreateClient(t *testing.T) {
 		assert.Equal(t, true, created.Active)
 		assert.Equal(t, 3600, created.TokenExpireSecs)
 		assert.Equal(t, 5, created.LoginFailedTimes)
+		assert.Equal(t, service.DefaultRefreshTokenExpireSecs, created.RefreshTokenExpireSecs)
+		assert.Equal(t, enum.SessionPolicyType.Single, created.SessionPolicy)
+		assert.Equal(t, enum.SigningMethodType.HS256, created.SigningMethod)
+		assert.Equal(t, 1, created.MaxSessions)
 
 		roles, err := created.Roles(ctx)
 		assert.Nil(t, err)
@@ -103,6 +108,37 @@ func TestCreateClient(t *testing.T) {
 		assert.Equal(t, err.Code().Int(), cus_err.AccountPasswordError)
 	})
 
+	t.Run("Create Frontend Client Requiring Two-factor Authentication", func(t *testing.T) {
+		client := vo.ClientInfo{
+			Id:               123456789,
+			MerchantId:       111111111,
+			ClientType:       enum.ClientType.Frontend,
+			Active:           true,
+			TokenExpireSecs:  3600,
+			LoginFailedTimes: 5,
+			MfaRequired:      true,
+		}
+
+		// Begin a transaction
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+		}()
+
+		created, err := clientService.CreateClient(ctx, client)
+		assert.Nil(t, created)
+		assert.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+
+		// Backend clients can require it
+		client.ClientType = enum.ClientType.Backend
+		created, err = clientService.CreateClient(ctx, client)
+		assert.Nil(t, err)
+		assert.True(t, created.MfaRequired)
+	})
+
 	t.Run("Create Client Without TokenExpireSecs", func(t *testing.T) {
 		client := vo.ClientInfo{
 			Id:               123456789,
@@ -266,6 +302,37 @@ func TestUpdateClient(t *testing.T) {
 		assert.Equal(t, updated.Active, cacheClient.Active)
 		assert.Equal(t, updated.TokenExpireSecs, cacheClient.TokenExpireSecs)
 		assert.Equal(t, updated.LoginFailedTimes, cacheClient.LoginFailedTimes)
+
+		// The active client isn't announced
+		exist, e := db.GetTx(ctx).(*ent.Tx).OutboxEvent.Query().Exist(ctx)
+		require.Nil(t, e)
+		assert.False(t, exist)
+	})
+
+	t.Run("Deactivate Client", func(t *testing.T) {
+		// Begin a transaction
+		ctx, err = db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+		}()
+
+		copyClientInfo := &vo.ClientInfo{}
+		_ = copier.CopyWithOption(copyClientInfo, clientInfo, copier.Option{IgnoreEmpty: true})
+		copyClientInfo.Active = false
+
+		updated, err := clientService.UpdateClient(ctx, *copyClientInfo)
+		require.Nil(t, err)
+		assert.False(t, updated.Active)
+
+		// The event is added in the transaction of the update
+		event, e := db.GetTx(ctx).(*ent.Tx).OutboxEvent.Query().Only(ctx)
+		require.Nil(t, e)
+		assert.Equal(t, vo.ClientAggregate, event.AggregateType)
+		assert.Equal(t, "123456789", event.AggregateID)
+		assert.Equal(t, vo.ClientDeactivatedEvent, event.EventType)
+		assert.JSONEq(t, `{"clientId":123456789,"merchantId":111111111}`, string(event.Payload))
 	})
 
 	t.Run("Update Client Without TokenExpireSecs", func(t *testing.T) {
@@ -2,10 +2,14 @@ package tests_domain
 
 import (
 	"context"
+	"fmt"
+	"go_micro_service_api/auth_service/internal/domain/entity"
 	"go_micro_service_api/auth_service/internal/domain/service"
 	"go_micro_service_api/auth_service/internal/domain/vo"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl/ent/outboxevent"
+	"go_micro_service_api/auth_service/internal/infrastructure/redis_impl"
 	"go_micro_service_api/auth_service/internal/tests"
 	"go_micro_service_api/pkg/cus_crypto"
 	"go_micro_service_api/pkg/cus_err"
@@ -13,6 +17,7 @@ import (
 	redis_cache "go_micro_service_api/pkg/db/redis"
 	"go_micro_service_api/pkg/enum"
 	"testing"
+	"time"
 
 	"github.com/jinzhu/copier"
 	"github.com/stretchr/testify/assert"
@@ -25,8 +30,9 @@ func setupUserService() (userService *service.UserService, db db.Database, cache
 	cache = redis_cache.NewRedisCache(redis)
 	clientRepo := ent_impl.NewClientRepoImpl(db, cache)
 	userRepo := ent_impl.NewUserRepoImpl(db)
+	tokenRepo := redis_impl.NewTokenRepoImpl(cache)
 
-	return service.NewUserService(clientRepo, userRepo), db, cache, closeFunc
+	return service.NewUserService(clientRepo, userRepo, tokenRepo, ent_impl.NewOutboxRepoImpl(db)), db, cache, closeFunc
 }
 
 func TestCreateUser(t *testing.T) {
@@ -249,7 +255,9 @@ func TestUpdateUser(t *testing.T) {
 		assert.Equal(t, copyUserInfo.Id, user.Id)
 		assert.Equal(t, copyUserInfo.Account, user.Account)
 		assert.Equal(t, copyUserInfo.Status, user.Status)
-		assert.Equal(t, "FKiLnS9SxVtfvPnF2cEYdQ==", user.Password)
+		crypto := cus_crypto.New()
+		assert.True(t, crypto.CompareHashAndPassword(ctx, user.Password, copyUserInfo.Password))
+		assert.NotNil(t, user.PasswordChangedAt)
 		assert.Equal(t, 0, user.PasswordFailTimes)
 	})
 
@@ -404,3 +412,633 @@ func TestGetUser(t *testing.T) {
 		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
 	})
 }
+
+func TestPasswordPolicy(t *testing.T) {
+	userService, db, _, closeFunc := setupUserService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	// Begin the transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create the client with a password policy
+	_, e := tx.AuthClient.Create().
+		SetID(12345).
+		SetMerchantID(11111).
+		SetClientType(enum.ClientType.Frontend.Id).
+		SetLoginFailedTimes(3).
+		SetTokenExpireSecs(3600).
+		SetActive(true).
+		SetSecret("secret").
+		SetPasswordMinLength(8).
+		SetPasswordCharClasses([]enum.PasswordCharClass{enum.PasswordCharClassType.Upper, enum.PasswordCharClassType.Digit}).
+		SetPasswordHistoryCount(2).
+		SetBannedPasswords([]string{"Password1"}).
+		Save(ctx)
+	require.Nil(t, e)
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	userInfo := vo.UserInfo{
+		Id:       1,
+		Account:  "test",
+		Password: "Welcome01",
+		Status:   enum.UserStatusType.Active,
+	}
+
+	t.Run("Create user with a weak password", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer db.Rollback(ctx)
+
+		weakUserInfo := userInfo
+		weakUserInfo.Password = "short"
+
+		_, err = userService.CreateUser(ctx, 12345, weakUserInfo)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.PasswordPolicyViolation, err.Code().Int())
+		data, ok := err.Data().(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []string{vo.PasswordRuleMinLength, vo.PasswordRuleCharClasses}, data["failedRules"])
+		assert.Equal(t, 8, data["minLength"])
+		assert.Equal(t, []enum.PasswordCharClass{enum.PasswordCharClassType.Upper, enum.PasswordCharClassType.Digit}, data["missingCharClasses"])
+	})
+
+	t.Run("Create user with a banned password", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer db.Rollback(ctx)
+
+		bannedUserInfo := userInfo
+		bannedUserInfo.Password = "PASSWORD1"
+
+		_, err = userService.CreateUser(ctx, 12345, bannedUserInfo)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.PasswordPolicyViolation, err.Code().Int())
+		data, ok := err.Data().(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []string{vo.PasswordRuleBanned}, data["failedRules"])
+	})
+
+	// Create the user with a valid password
+	ctx, err = db.Begin(ctx)
+	require.Nil(t, err)
+	user, err := userService.CreateUser(ctx, 12345, userInfo)
+	require.Nil(t, err)
+	require.NotNil(t, user.PasswordChangedAt)
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	t.Run("Change password", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer db.Rollback(ctx)
+
+		// The current password must be confirmed
+		_, err = userService.ChangePassword(ctx, user.Id, "Wrong0000", "Welcome02")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.AccountPasswordError, err.Code().Int())
+
+		// The current password can't be reused
+		_, err = userService.ChangePassword(ctx, user.Id, "Welcome01", "Welcome01")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.PasswordPolicyViolation, err.Code().Int())
+		data, ok := err.Data().(map[string]interface{})
+		require.True(t, ok)
+		assert.Equal(t, []string{vo.PasswordRuleHistory}, data["failedRules"])
+
+		changed, err := userService.ChangePassword(ctx, user.Id, "Welcome01", "Welcome02")
+		require.Nil(t, err)
+		crypto := cus_crypto.New()
+		assert.True(t, crypto.CompareHashAndPassword(ctx, changed.Password, "Welcome02"))
+
+		changed, err = userService.ChangePassword(ctx, user.Id, "Welcome02", "Welcome03")
+		require.Nil(t, err)
+
+		// Only the last 2 passwords are remembered
+		_, err = userService.ChangePassword(ctx, user.Id, "Welcome03", "Welcome02")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.PasswordPolicyViolation, err.Code().Int())
+
+		_, err = userService.ChangePassword(ctx, user.Id, "Welcome03", "Welcome01")
+		require.Nil(t, err)
+	})
+}
+
+func TestPasswordPolicyExpiry(t *testing.T) {
+	policy := vo.PasswordPolicy{MaxAgeSecs: 3600}
+	now := time.Now()
+	changedAt := now.Add(-2 * time.Hour)
+	recentChangedAt := now.Add(-time.Minute)
+
+	assert.True(t, policy.IsExpired(&changedAt, now))
+	assert.False(t, policy.IsExpired(&recentChangedAt, now))
+	assert.False(t, policy.IsExpired(nil, now))
+	assert.False(t, vo.PasswordPolicy{}.IsExpired(&changedAt, now))
+}
+
+func TestListLoginRecords(t *testing.T) {
+	userService, db, _, closeFunc := setupUserService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	// Begin the transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create a frontend client and a backend client of the merchant, and a frontend client of another merchant
+	clients := []vo.ClientInfo{
+		{Id: 12345, MerchantId: 11111, ClientType: enum.ClientType.Frontend},
+		{Id: 12346, MerchantId: 11111, ClientType: enum.ClientType.Backend},
+		{Id: 22345, MerchantId: 22222, ClientType: enum.ClientType.Frontend},
+	}
+	for _, clientInfo := range clients {
+		_, e := tx.AuthClient.Create().
+			SetID(clientInfo.Id).
+			SetMerchantID(clientInfo.MerchantId).
+			SetClientType(clientInfo.ClientType.Id).
+			SetLoginFailedTimes(3).
+			SetTokenExpireSecs(3600).
+			SetActive(true).
+			SetSecret("secret").
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Create a player of each frontend client
+	users := []struct {
+		clientId int64
+		userId   int64
+	}{
+		{clientId: 12345, userId: 1},
+		{clientId: 12345, userId: 2},
+		{clientId: 22345, userId: 3},
+	}
+	for _, u := range users {
+		_, err = userService.CreateUser(ctx, u.clientId, vo.UserInfo{
+			Id:       u.userId,
+			Account:  fmt.Sprintf("player%d", u.userId),
+			Password: "password",
+			Status:   enum.UserStatusType.Active,
+		})
+		require.Nil(t, err)
+	}
+
+	// Create the login records, user 1 logs in once an hour from taiwan and fails the last time from japan
+	now := time.Now().Truncate(time.Second)
+	newRecord := func(userId int64, ip string, countryCode string, isSuccess bool, createAt time.Time) *ent.LoginRecordCreate {
+		return tx.LoginRecord.Create().
+			SetUsersID(userId).
+			SetBrowser("Chrome").
+			SetBrowserVer("130.0").
+			SetIP(ip).
+			SetOs("Windows").
+			SetPlatform("Windows").
+			SetCountry(countryCode).
+			SetCountryCode(countryCode).
+			SetCity("").
+			SetAsp("").
+			SetIsMobile(false).
+			SetIsSuccess(isSuccess).
+			SetErrMessage("").
+			SetCreatedAt(createAt)
+	}
+	for i := 1; i <= 4; i++ {
+		_, e := newRecord(1, "1.1.1.1", "TW", true, now.Add(-time.Duration(6-i)*time.Hour)).Save(ctx)
+		require.Nil(t, e)
+	}
+	_, e := newRecord(1, "2.2.2.2", "JP", false, now.Add(-time.Hour)).
+		SetErrMessage("Account or password is incorrect").
+		Save(ctx)
+	require.Nil(t, e)
+	for _, userId := range []int64{2, 3} {
+		_, e = newRecord(userId, "3.3.3.3", "TW", true, now).Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	adminRoleId := entity.BackendRoles.Admin.Id
+	playerRoleId := entity.FrontendRoles.Player.Id
+
+	t.Run("List the records of the user from the latest", func(t *testing.T) {
+		page, err := userService.ListUserLoginRecords(ctx, 1, vo.LoginRecordFilter{}, vo.Pagination{Page: 1, PageSize: 2})
+		require.Nil(t, err)
+		assert.Equal(t, 5, page.Total)
+		assert.Equal(t, 2, page.Pagination.PageSize)
+		require.Len(t, page.Records, 2)
+		assert.Equal(t, "JP", page.Records[0].CountryCode)
+		assert.Equal(t, int64(1), page.Records[0].UserId)
+		assert.True(t, page.Records[0].CreateAt.After(page.Records[1].CreateAt))
+
+		// The last page has the rest
+		page, err = userService.ListUserLoginRecords(ctx, 1, vo.LoginRecordFilter{}, vo.Pagination{Page: 3, PageSize: 2})
+		require.Nil(t, err)
+		assert.Len(t, page.Records, 1)
+	})
+
+	t.Run("List the records with the default page", func(t *testing.T) {
+		page, err := userService.ListUserLoginRecords(ctx, 1, vo.LoginRecordFilter{}, vo.Pagination{})
+		require.Nil(t, err)
+		assert.Equal(t, 1, page.Pagination.Page)
+		assert.Equal(t, vo.DefaultPageSize, page.Pagination.PageSize)
+		assert.Len(t, page.Records, 5)
+	})
+
+	t.Run("Filter the records", func(t *testing.T) {
+		isSuccess := false
+		page, err := userService.ListUserLoginRecords(ctx, 1, vo.LoginRecordFilter{IsSuccess: &isSuccess}, vo.Pagination{})
+		require.Nil(t, err)
+		require.Len(t, page.Records, 1)
+		assert.Equal(t, "Account or password is incorrect", page.Records[0].ErrMessage)
+
+		page, err = userService.ListUserLoginRecords(ctx, 1, vo.LoginRecordFilter{Ip: "1.1.1.1", CountryCode: "tw"}, vo.Pagination{})
+		require.Nil(t, err)
+		assert.Equal(t, 4, page.Total)
+
+		startTime := now.Add(-3 * time.Hour)
+		endTime := now.Add(-time.Hour)
+		page, err = userService.ListUserLoginRecords(ctx, 1, vo.LoginRecordFilter{StartTime: &startTime, EndTime: &endTime}, vo.Pagination{})
+		require.Nil(t, err)
+		assert.Equal(t, 2, page.Total)
+	})
+
+	t.Run("The user filter can't be overridden by the player", func(t *testing.T) {
+		otherUserId := int64(2)
+		page, err := userService.ListUserLoginRecords(ctx, 1, vo.LoginRecordFilter{UserId: &otherUserId}, vo.Pagination{})
+		require.Nil(t, err)
+		assert.Equal(t, 5, page.Total)
+	})
+
+	t.Run("Invalid time range", func(t *testing.T) {
+		startTime := now
+		endTime := now.Add(-time.Hour)
+		_, err := userService.ListUserLoginRecords(ctx, 1, vo.LoginRecordFilter{StartTime: &startTime, EndTime: &endTime}, vo.Pagination{})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+
+	t.Run("List the records of the merchant", func(t *testing.T) {
+		operator := &vo.TokenPayload{MerchantId: 11111, ClientId: 12346, RoleId: &adminRoleId}
+		page, err := userService.ListMerchantLoginRecords(ctx, operator, vo.LoginRecordFilter{}, vo.Pagination{})
+		require.Nil(t, err)
+		assert.Equal(t, 6, page.Total)
+		for _, record := range page.Records {
+			assert.NotEqual(t, int64(3), record.UserId)
+		}
+
+		// Only the records of the user
+		userId := int64(2)
+		page, err = userService.ListMerchantLoginRecords(ctx, operator, vo.LoginRecordFilter{UserId: &userId}, vo.Pagination{})
+		require.Nil(t, err)
+		assert.Equal(t, 1, page.Total)
+
+		// The user of another merchant isn't found
+		userId = 3
+		page, err = userService.ListMerchantLoginRecords(ctx, operator, vo.LoginRecordFilter{UserId: &userId}, vo.Pagination{})
+		require.Nil(t, err)
+		assert.Equal(t, 0, page.Total)
+	})
+
+	t.Run("Only the backend users can list the records of the merchant", func(t *testing.T) {
+		operator := &vo.TokenPayload{MerchantId: 11111, ClientId: 12345, RoleId: &playerRoleId}
+		_, err := userService.ListMerchantLoginRecords(ctx, operator, vo.LoginRecordFilter{}, vo.Pagination{})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.NoPermission, err.Code().Int())
+
+		operator = &vo.TokenPayload{MerchantId: 11111, ClientId: 12346, RoleId: &playerRoleId}
+		_, err = userService.ListMerchantLoginRecords(ctx, operator, vo.LoginRecordFilter{}, vo.Pagination{})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.NoPermission, err.Code().Int())
+	})
+}
+
+func TestSearchUsers(t *testing.T) {
+	userService, db, _, closeFunc := setupUserService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	// Begin the transaction
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	// Get the transaction
+	tx, ok := db.GetTx(ctx).(*ent.Tx)
+	require.True(t, ok)
+
+	// Create a frontend client of each merchant
+	clients := []vo.ClientInfo{
+		{Id: 12345, MerchantId: 11111, ClientType: enum.ClientType.Frontend},
+		{Id: 22345, MerchantId: 22222, ClientType: enum.ClientType.Frontend},
+	}
+	for _, clientInfo := range clients {
+		_, e := tx.AuthClient.Create().
+			SetID(clientInfo.Id).
+			SetMerchantID(clientInfo.MerchantId).
+			SetClientType(clientInfo.ClientType.Id).
+			SetLoginFailedTimes(3).
+			SetTokenExpireSecs(3600).
+			SetActive(true).
+			SetSecret("secret").
+			Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Players 1 to 5 of the merchant 11111 register once a day, player 6 of the merchant 22222 registers today
+	now := time.Now().UTC().Truncate(time.Second)
+	users := []struct {
+		clientId int64
+		userId   int64
+		account  string
+		status   enum.UserStatus
+	}{
+		{clientId: 12345, userId: 1, account: "alice", status: enum.UserStatusType.Active},
+		{clientId: 12345, userId: 2, account: "bob", status: enum.UserStatusType.Active},
+		{clientId: 12345, userId: 3, account: "alex", status: enum.UserStatusType.Locked},
+		{clientId: 12345, userId: 4, account: "carol", status: enum.UserStatusType.Active},
+		{clientId: 12345, userId: 5, account: "alan", status: enum.UserStatusType.Active},
+		{clientId: 22345, userId: 6, account: "albert", status: enum.UserStatusType.Active},
+	}
+	for _, u := range users {
+		_, err = userService.CreateUser(ctx, u.clientId, vo.UserInfo{
+			Id:       u.userId,
+			Account:  u.account,
+			Password: "password",
+			Status:   u.status,
+		})
+		require.Nil(t, err)
+
+		// The created time is immutable in the schema
+		createdAt := now
+		if u.clientId == 12345 {
+			createdAt = now.AddDate(0, 0, int(u.userId)-6)
+		}
+		_, e := tx.ExecContext(ctx, "UPDATE users SET created_at = ? WHERE id = ?", createdAt, u.userId)
+		require.Nil(t, e)
+		_, e = tx.User.UpdateOneID(u.userId).SetRolesID(entity.FrontendRoles.Player.Id).Save(ctx)
+		require.Nil(t, e)
+	}
+
+	// Commit the transaction
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	merchantId := int64(11111)
+	ids := func(page *vo.UserSearchPage) []int64 {
+		res := make([]int64, 0, len(page.Users))
+		for _, user := range page.Users {
+			res = append(res, user.Id)
+		}
+		return res
+	}
+
+	t.Run("Page through the users of the merchant from the latest", func(t *testing.T) {
+		page, err := userService.SearchUsers(ctx, vo.UserSearch{MerchantId: &merchantId, Limit: 2})
+		require.Nil(t, err)
+		assert.Equal(t, []int64{5, 4}, ids(page))
+		require.NotNil(t, page.NextCursor)
+
+		user := page.Users[0]
+		assert.Equal(t, "alan", user.Account)
+		assert.Equal(t, enum.UserStatusType.Active, user.Status)
+		assert.Equal(t, entity.FrontendRoles.Player.Id, user.RoleId)
+		assert.Equal(t, entity.FrontendRoles.Player.Name, user.RoleName)
+		assert.Equal(t, int64(12345), user.ClientId)
+		assert.Equal(t, merchantId, user.MerchantId)
+
+		page, err = userService.SearchUsers(ctx, vo.UserSearch{MerchantId: &merchantId, Limit: 2, Cursor: page.NextCursor})
+		require.Nil(t, err)
+		assert.Equal(t, []int64{3, 2}, ids(page))
+
+		// The cursor survives the encoding
+		cursor, err := vo.DecodeUserCursor(page.NextCursor.Encode())
+		require.Nil(t, err)
+		page, err = userService.SearchUsers(ctx, vo.UserSearch{MerchantId: &merchantId, Limit: 2, Cursor: cursor})
+		require.Nil(t, err)
+		assert.Equal(t, []int64{1}, ids(page))
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("Sort by the account", func(t *testing.T) {
+		search := vo.UserSearch{AccountPrefix: "al", Sort: vo.UserSortAccount, Ascending: true, Limit: 3}
+		page, err := userService.SearchUsers(ctx, search)
+		require.Nil(t, err)
+		assert.Equal(t, []int64{5, 6, 3}, ids(page))
+
+		search.Cursor = page.NextCursor
+		page, err = userService.SearchUsers(ctx, search)
+		require.Nil(t, err)
+		assert.Equal(t, []int64{1}, ids(page))
+		assert.Nil(t, page.NextCursor)
+	})
+
+	t.Run("Filter the users", func(t *testing.T) {
+		locked := enum.UserStatusType.Locked
+		page, err := userService.SearchUsers(ctx, vo.UserSearch{Status: &locked})
+		require.Nil(t, err)
+		assert.Equal(t, []int64{3}, ids(page))
+
+		clientId := int64(22345)
+		page, err = userService.SearchUsers(ctx, vo.UserSearch{ClientId: &clientId})
+		require.Nil(t, err)
+		assert.Equal(t, []int64{6}, ids(page))
+
+		createdFrom := now.AddDate(0, 0, -4)
+		createdTo := now.AddDate(0, 0, -2)
+		page, err = userService.SearchUsers(ctx, vo.UserSearch{CreatedFrom: &createdFrom, CreatedTo: &createdTo, Sort: vo.UserSortId, Ascending: true})
+		require.Nil(t, err)
+		assert.Equal(t, []int64{2, 3}, ids(page))
+
+		page, err = userService.SearchUsers(ctx, vo.UserSearch{UserIds: []int64{1, 4, 6}, MerchantId: &merchantId})
+		require.Nil(t, err)
+		assert.Equal(t, []int64{4, 1}, ids(page))
+
+		// No user id matches nothing
+		page, err = userService.SearchUsers(ctx, vo.UserSearch{UserIds: []int64{}})
+		require.Nil(t, err)
+		assert.Empty(t, page.Users)
+	})
+
+	t.Run("Invalid search", func(t *testing.T) {
+		page, err := userService.SearchUsers(ctx, vo.UserSearch{Limit: 1})
+		require.Nil(t, err)
+
+		tcs := []struct {
+			name   string
+			search vo.UserSearch
+		}{
+			{name: "Unknown sort", search: vo.UserSearch{Sort: "password"}},
+			{name: "The cursor of another sort", search: vo.UserSearch{Sort: vo.UserSortAccount, Cursor: page.NextCursor}},
+			{name: "The cursor of another order", search: vo.UserSearch{Ascending: true, Cursor: page.NextCursor}},
+			{name: "Invalid created range", search: vo.UserSearch{CreatedFrom: &now, CreatedTo: &now}},
+		}
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := userService.SearchUsers(ctx, tc.search)
+				require.NotNil(t, err)
+				assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+			})
+		}
+
+		_, err = vo.DecodeUserCursor("not a cursor")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+}
+
+func TestDeleteUser(t *testing.T) {
+	userService, db, _, closeFunc := setupUserService()
+	defer closeFunc()
+
+	ctx := context.Background()
+	clientId := int64(12345)
+
+	inTx := func(t *testing.T, f func(ctx context.Context) *cus_err.CusError) *cus_err.CusError {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			if err != nil {
+				_, rollbackErr := db.Rollback(ctx)
+				require.Nil(t, rollbackErr)
+				return
+			}
+			_, commitErr := db.Commit(ctx)
+			require.Nil(t, commitErr)
+		}()
+		err = f(ctx)
+		return err
+	}
+
+	// Create a frontend client, a registering user with a failed login and a user who has logged in
+	err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+		tx := db.GetTx(ctx).(*ent.Tx)
+		_, e := tx.AuthClient.Create().
+			SetID(clientId).
+			SetMerchantID(11111).
+			SetClientType(enum.ClientType.Frontend.Id).
+			SetLoginFailedTimes(3).
+			SetTokenExpireSecs(3600).
+			SetActive(true).
+			SetSecret("secret").
+			Save(ctx)
+		require.Nil(t, e)
+
+		for _, userId := range []int64{1, 2} {
+			_, err := userService.CreateUser(ctx, clientId, vo.UserInfo{
+				Id:       userId,
+				Account:  fmt.Sprintf("player%d", userId),
+				Password: "Password1234",
+				Status:   enum.UserStatusType.Active,
+			})
+			require.Nil(t, err)
+		}
+
+		// Someone tries the registering user with a wrong password
+		_, e = tx.LoginRecord.Create().
+			SetUsersID(1).
+			SetBrowser("Chrome").
+			SetBrowserVer("130.0").
+			SetIP("1.1.1.1").
+			SetOs("Windows").
+			SetPlatform("Windows").
+			SetCountry("TW").
+			SetCountryCode("TW").
+			SetCity("").
+			SetAsp("").
+			SetIsMobile(false).
+			SetIsSuccess(false).
+			SetErrMessage("password is incorrect").
+			Save(ctx)
+		require.Nil(t, e)
+
+		_, e = tx.LoginRecord.Create().
+			SetUsersID(2).
+			SetBrowser("Chrome").
+			SetBrowserVer("130.0").
+			SetIP("1.1.1.1").
+			SetOs("Windows").
+			SetPlatform("Windows").
+			SetCountry("TW").
+			SetCountryCode("TW").
+			SetCity("").
+			SetAsp("").
+			SetIsMobile(false).
+			SetIsSuccess(true).
+			SetErrMessage("").
+			Save(ctx)
+		require.Nil(t, e)
+		return nil
+	})
+	require.Nil(t, err)
+
+	t.Run("The user of another client isn't deleted", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			return userService.DeleteUser(ctx, 54321, 1)
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+
+	t.Run("Delete the registering user with a failed login and free the account", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			return userService.DeleteUser(ctx, clientId, 1)
+		})
+		require.Nil(t, err)
+
+		// The events of the user are added in order
+		events, e := db.GetConn(ctx).(*ent.Client).OutboxEvent.Query().
+			Where(outboxevent.AggregateTypeEQ(vo.UserAggregate), outboxevent.AggregateIDEQ("1")).
+			Order(ent.Asc(outboxevent.FieldID)).
+			All(ctx)
+		require.Nil(t, e)
+		require.Len(t, events, 2)
+		assert.Equal(t, vo.UserCreatedEvent, events[0].EventType)
+		assert.Equal(t, vo.UserDeletedEvent, events[1].EventType)
+
+		_, err = userService.GetUser(ctx, 1)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+
+		// The account can be registered again
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := userService.CreateUser(ctx, clientId, vo.UserInfo{
+				Id:      3,
+				Account: "player1",
+				Status:  enum.UserStatusType.Active,
+			})
+			return err
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("Delete the deleted user again", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			return userService.DeleteUser(ctx, clientId, 1)
+		})
+		require.Nil(t, err)
+	})
+
+	t.Run("The user who has logged in can't be deleted", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			return userService.DeleteUser(ctx, clientId, 2)
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+}
@@ -7,10 +7,13 @@ import (
 	"go_micro_service_api/auth_service/internal/infrastructure/db_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/ent_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/grpc_impl"
+	"go_micro_service_api/auth_service/internal/infrastructure/outbox_impl"
 	"go_micro_service_api/auth_service/internal/infrastructure/redis_impl"
+	"go_micro_service_api/auth_service/internal/infrastructure/scheduler"
 	"go_micro_service_api/auth_service/internal/infrastructure/token_helper"
 	"go_micro_service_api/pkg/db"
 	redis_cache "go_micro_service_api/pkg/db/redis"
+	"go_micro_service_api/pkg/outbox"
 	"go_micro_service_api/pkg/req_analyzer"
 
 	"go.uber.org/fx"
@@ -25,9 +28,12 @@ func main() {
 			application.NewAuthService,
 			application.NewClientService,
 			application.NewUserService,
+			application.NewKeyService,
 			service.NewAuthService,
 			service.NewClientService,
 			service.NewUserService,
+			service.NewKeyService,
+			service.NewRiskEngine,
 			fx.Annotate(
 				ent_impl.NewClientRepoImpl,
 				fx.As(new(repository.ClientRepo)),
@@ -37,7 +43,19 @@ func main() {
 				fx.As(new(repository.UserRepo)),
 			),
 			fx.Annotate(
-				token_helper.NewJwtToken,
+				ent_impl.NewSigningKeyRepoImpl,
+				fx.As(new(repository.SigningKeyRepo)),
+			),
+			fx.Annotate(
+				ent_impl.NewOutboxRepoImpl,
+				fx.As(new(repository.EventRepo), new(outbox.Store)),
+			),
+			fx.Annotate(
+				redis_impl.NewTokenRepoImpl,
+				fx.As(new(repository.TokenRepo)),
+			),
+			fx.Annotate(
+				token_helper.NewJwtTokenFromConfig,
 				fx.As(new(token_helper.TokenHelper)),
 			),
 			fx.Annotate(
@@ -46,8 +64,12 @@ func main() {
 			),
 			redis_impl.NewRedisClient,
 			req_analyzer.NewReqAnalyzer,
+			outbox_impl.NewBroker,
+			outbox_impl.NewRelay,
 		),
 		fx.Invoke(func(server *grpc.Server) {}),
+		fx.Invoke(scheduler.NewKeyRotationJob),
+		fx.Invoke(scheduler.NewOutboxRelayJob),
 	).Run()
 
 }
@@ -0,0 +1,2 @@
+-- Modify "auth_clients" table
+ALTER TABLE "auth_clients" ADD COLUMN "refresh_token_expire_secs" bigint NOT NULL DEFAULT 1209600;
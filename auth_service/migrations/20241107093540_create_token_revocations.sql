@@ -0,0 +1,6 @@
+-- Create "token_revocations" table
+CREATE TABLE "token_revocations" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "client_id" bigint NOT NULL, "user_id" bigint NULL, "scope" character varying NOT NULL, "reason" character varying NOT NULL DEFAULT '', "operator_id" bigint NULL, "revoked_count" bigint NOT NULL, PRIMARY KEY ("id"));
+-- Create index "tokenrevocation_client_id" to table: "token_revocations"
+CREATE INDEX "tokenrevocation_client_id" ON "token_revocations" ("client_id");
+-- Create index "tokenrevocation_user_id" to table: "token_revocations"
+CREATE INDEX "tokenrevocation_user_id" ON "token_revocations" ("user_id");
@@ -0,0 +1,2 @@
+-- Modify "auth_clients" table
+ALTER TABLE "auth_clients" ADD COLUMN "session_policy" bigint NOT NULL DEFAULT 1, ADD COLUMN "max_sessions" bigint NOT NULL DEFAULT 1;
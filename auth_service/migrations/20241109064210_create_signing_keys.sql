@@ -0,0 +1,8 @@
+-- Modify "auth_clients" table
+ALTER TABLE "auth_clients" ADD COLUMN "signing_method" bigint NOT NULL DEFAULT 1;
+-- Create "signing_keys" table
+CREATE TABLE "signing_keys" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "kid" character varying NOT NULL, "signing_method" bigint NOT NULL, "private_key" text NOT NULL, "public_key" text NOT NULL, "active" boolean NOT NULL, "rotated_at" timestamptz NULL, "expire_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "signing_keys_kid_key" to table: "signing_keys"
+CREATE UNIQUE INDEX "signing_keys_kid_key" ON "signing_keys" ("kid");
+-- Create index "signingkey_signing_method_active" to table: "signing_keys"
+CREATE INDEX "signingkey_signing_method_active" ON "signing_keys" ("signing_method", "active");
@@ -0,0 +1,6 @@
+-- Modify "auth_clients" table
+ALTER TABLE "auth_clients" ADD COLUMN "mfa_required" boolean NOT NULL DEFAULT false;
+-- Create "user_totps" table
+CREATE TABLE "user_totps" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, "secret" character varying NOT NULL, "enabled" boolean NOT NULL DEFAULT false, "recovery_codes" jsonb NULL, "enabled_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "user_totps_user_id_key" to table: "user_totps"
+CREATE UNIQUE INDEX "user_totps_user_id_key" ON "user_totps" ("user_id");
@@ -0,0 +1,8 @@
+-- Modify "auth_clients" table
+ALTER TABLE "auth_clients" ADD COLUMN "password_min_length" bigint NOT NULL DEFAULT 0, ADD COLUMN "password_char_classes" jsonb NULL, ADD COLUMN "password_history_count" bigint NOT NULL DEFAULT 0, ADD COLUMN "password_max_age_secs" bigint NOT NULL DEFAULT 0, ADD COLUMN "banned_passwords" jsonb NULL;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "password_changed_at" timestamptz NULL;
+-- Create "password_histories" table
+CREATE TABLE "password_histories" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, "password" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "passwordhistory_user_id" to table: "password_histories"
+CREATE INDEX "passwordhistory_user_id" ON "password_histories" ("user_id");
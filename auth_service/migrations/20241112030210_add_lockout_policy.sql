@@ -0,0 +1,4 @@
+-- Modify "auth_clients" table
+ALTER TABLE "auth_clients" ADD COLUMN "lockout_secs" bigint NOT NULL DEFAULT 0, ADD COLUMN "max_lockout_secs" bigint NOT NULL DEFAULT 0;
+-- Modify "users" table
+ALTER TABLE "users" ADD COLUMN "locked_until" timestamptz NULL, ADD COLUMN "lock_count" bigint NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Create "trusted_devices" table
+CREATE TABLE "trusted_devices" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, "browser" character varying NOT NULL, "city" character varying NOT NULL, "country_code" character varying NOT NULL, PRIMARY KEY ("id"));
+-- Create index "trusteddevice_user_id_browser_city" to table: "trusted_devices"
+CREATE UNIQUE INDEX "trusteddevice_user_id_browser_city" ON "trusted_devices" ("user_id", "browser", "city");
@@ -0,0 +1,4 @@
+-- Modify "auth_clients" table
+ALTER TABLE "auth_clients" ADD COLUMN "risk_challenge_score" bigint NOT NULL DEFAULT 0, ADD COLUMN "risk_deny_score" bigint NOT NULL DEFAULT 0;
+-- Modify "login_records" table
+ALTER TABLE "login_records" ADD COLUMN "latitude" double precision NOT NULL DEFAULT 0, ADD COLUMN "longitude" double precision NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Modify "login_records" table
+ALTER TABLE "login_records" ADD COLUMN "err_code" bigint NOT NULL DEFAULT 0;
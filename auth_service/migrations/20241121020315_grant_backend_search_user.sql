@@ -0,0 +1,2 @@
+-- Grant the backend roles to search the users
+UPDATE "roles" SET "permissions" = '[{"Id": 101, "Name": "BackendSearchUser"}]'::jsonb WHERE "id" IN (101, 102);
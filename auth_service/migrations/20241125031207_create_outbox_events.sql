@@ -0,0 +1,18 @@
+-- Create "outbox_events" table
+CREATE TABLE "outbox_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "event_id" character varying NOT NULL, "aggregate_type" character varying NOT NULL, "aggregate_id" character varying NOT NULL, "event_type" character varying NOT NULL, "event_version" bigint NOT NULL, "payload" bytea NOT NULL, "published_at" timestamptz NULL, "attempts" bigint NOT NULL DEFAULT 0, "locked_until" timestamptz NULL, "last_error" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- Create index "outbox_events_event_id_key" to table: "outbox_events"
+CREATE UNIQUE INDEX "outbox_events_event_id_key" ON "outbox_events" ("event_id");
+-- Create index "outboxevent_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_id" ON "outbox_events" ("id") WHERE (published_at IS NULL);
+-- Set comment to table: "outbox_events"
+COMMENT ON TABLE "outbox_events" IS 'The domain events written in the transactions of the changes, published by the outbox relay';
+-- Set comment to column: "event_id" on table: "outbox_events"
+COMMENT ON COLUMN "outbox_events"."event_id" IS 'The consumers dedupe the events by it';
+-- Set comment to column: "event_type" on table: "outbox_events"
+COMMENT ON COLUMN "outbox_events"."event_type" IS 'The routing key of the event';
+-- Set comment to column: "event_version" on table: "outbox_events"
+COMMENT ON COLUMN "outbox_events"."event_version" IS 'The version of the payload schema of the event type';
+-- Set comment to column: "attempts" on table: "outbox_events"
+COMMENT ON COLUMN "outbox_events"."attempts" IS 'The failed publishing attempts';
+-- Set comment to column: "locked_until" on table: "outbox_events"
+COMMENT ON COLUMN "outbox_events"."locked_until" IS 'Claimed by a relay or waiting for the retry until then';
@@ -0,0 +1,4 @@
+-- Retire the duplicated active keys created by concurrent rotations, the latest one keeps signing
+UPDATE "signing_keys" SET "active" = false, "rotated_at" = now(), "expire_at" = now() + interval '7 days', "updated_at" = now() WHERE "active" AND "id" NOT IN (SELECT DISTINCT ON ("signing_method") "id" FROM "signing_keys" WHERE "active" ORDER BY "signing_method", "created_at" DESC, "id" DESC);
+-- Create index "signingkey_signing_method" to table: "signing_keys"
+CREATE UNIQUE INDEX "signingkey_signing_method" ON "signing_keys" ("signing_method") WHERE active;
@@ -0,0 +1,2 @@
+-- Grant the backend roles to view the login records of the users
+UPDATE "roles" SET "permissions" = '[{"Id": 101, "Name": "BackendSearchUser"}, {"Id": 102, "Name": "BackendViewLoginRecord"}]'::jsonb WHERE "id" IN (101, 102);
@@ -0,0 +1,6 @@
+-- Modify "outbox_events" table
+ALTER TABLE "outbox_events" ADD COLUMN "parked_at" timestamptz NULL;
+-- Create index "outboxevent_aggregate_type_aggregate_id_id" to table: "outbox_events"
+CREATE INDEX "outboxevent_aggregate_type_aggregate_id_id" ON "outbox_events" ("aggregate_type", "aggregate_id", "id") WHERE (published_at IS NULL);
+-- Set comment to column: "parked_at" on table: "outbox_events"
+COMMENT ON COLUMN "outbox_events"."parked_at" IS 'Not retried after the max attempts, the later events of the aggregate wait until it''s resolved';
@@ -83,6 +83,239 @@ const docTemplate = `{
                 }
             }
         },
+        "/v1/auth/jwks": {
+            "get": {
+                "description": "取得驗證 RS256 / ES256 簽名 token 的公鑰，下游服務可以依 token header 的 kid 找到對應公鑰在本地驗證，不需要再呼叫 ValidToken；回應為標準 JWKS 格式，不包在 response.Response 內",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "取得 JWKS",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.JwksResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/auth/refresh": {
+            "post": {
+                "description": "使用登入時取得的 refresh token 換發新的 access token 與 refresh token，refresh token 僅能使用一次，重複使用會導致該用戶所有 token 失效",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "換發token",
+                "parameters": [
+                    {
+                        "description": "Refresh Token Request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.RefreshTokenRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "後台客服搜尋所屬商戶的用戶，需有 BackendSearchUser 權限，以游標分頁，帶入上一頁的 nextCursor 取得下一頁，排序方式需與上一頁相同",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "搜尋用戶",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "帳號前綴",
+                        "name": "account",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "email, 需完全相符",
+                        "name": "email",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "886",
+                        "description": "國家代碼, 需與手機號碼一起搜尋",
+                        "name": "countryCode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "手機號碼, 需完全相符",
+                        "name": "mobileNumber",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            1,
+                            2
+                        ],
+                        "type": "integer",
+                        "description": "用戶狀態 1: 啟用 2: 鎖定",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "客戶端id",
+                        "name": "clientId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "註冊起始時間(unix秒, 包含)",
+                        "name": "createdFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "註冊結束時間(unix秒, 不包含)",
+                        "name": "createdTo",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "createdAt",
+                            "account",
+                            "id"
+                        ],
+                        "type": "string",
+                        "description": "排序欄位, 預設createdAt",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "asc",
+                            "desc"
+                        ],
+                        "type": "string",
+                        "description": "排序方向, 預設desc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "上一頁的nextCursor",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數, 預設20, 最多100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.UserSearchResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "沒有搜尋用戶的權限",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
         "/v1/users/": {
             "post": {
                 "security": [
@@ -102,6 +335,12 @@ const docTemplate = `{
                 ],
                 "summary": "Create User",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The retries with the same key get the result of the first registration",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
                     {
                         "description": "Register Request",
                         "name": "body",
@@ -282,13 +521,25 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "密碼錯誤(4000001), 達到錯誤次數上限時帳號會被鎖定",
                         "schema": {
-                            "$ref": "#/definitions/response.Response"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LoginErrorResponse"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "需要二次驗證(4010007), 以 challengeToken 呼叫 /v1/users/login/secondFactor 完成登入",
                         "schema": {
                             "allOf": [
                                 {
@@ -298,19 +549,31 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.LoginAnomalousResponse"
+                                            "$ref": "#/definitions/response.SecondFactorChallengeResponse"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
+                    "403": {
+                        "description": "登入風險過高被拒絕(4030003)",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
+                    "409": {
+                        "description": "登入裝置數已達上限, 可帶 forceLogin 登出最久未使用的裝置",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -320,29 +583,32 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/verification/": {
+        "/v1/users/login/oauth": {
             "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Verification",
+                "description": "以第三方平台(Google, Meta, Twitter, LINE)的 token 登入，該第三方帳號尚未綁定會員時，會自動註冊新會員並綁定後登入",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Auth"
                 ],
-                "summary": "驗證",
+                "summary": "第三方登入",
                 "parameters": [
                     {
-                        "description": "Verification Request",
+                        "description": "OAuth Login Request",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/request.VerificationRequest"
+                            "$ref": "#/definitions/request.OAuthLoginRequest"
                         }
                     }
                 ],
@@ -358,7 +624,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "data": {
-                                            "$ref": "#/definitions/response.VerificationResponse"
+                                            "$ref": "#/definitions/response.TokenResponse"
                                         }
                                     }
                                 }
@@ -366,13 +632,43 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad Request",
+                        "description": "第三方 token 驗證失敗(4000099)",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "需要二次驗證(4010007), 以 challengeToken 呼叫 /v1/users/login/secondFactor 完成登入",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.SecondFactorChallengeResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "403": {
+                        "description": "登入風險過高被拒絕(4030003)",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "登入裝置數已達上限, 可帶 forceLogin 登出最久未使用的裝置",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "501": {
+                        "description": "尚未支援的第三方平台",
                         "schema": {
                             "$ref": "#/definitions/response.Response"
                         }
@@ -380,26 +676,1082 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/verificationCode/": {
-            "get": {
+        "/v1/users/login/secondFactor": {
+            "post": {
                 "security": [
                     {
                         "Bearer": []
                     }
                 ],
-                "description": "Register Verification",
+                "description": "登入回傳需要二次驗證時，以 challengeToken 與驗證器 App 的驗證碼(或備用碼)完成登入；若登入時才綁定驗證器，成功後會回傳備用碼且僅回傳一次",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "Auth"
                 ],
-                "summary": "申請驗證碼",
+                "summary": "二次驗證登入",
+                "parameters": [
+                    {
+                        "description": "Second Factor Request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.SecondFactorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TokenResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "驗證碼錯誤, 錯誤次數達上限後需重新登入",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LoginErrorResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/logins": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "後台客服查詢所屬商戶玩家的登入紀錄，需有 BackendViewLoginRecord 權限，由新到舊排序，可依玩家、時間區間、登入結果、IP 及國家代碼篩選",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "查詢商戶玩家的登入紀錄",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "玩家id, 未帶時為商戶所有玩家",
+                        "name": "userId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "起始時間(unix秒, 包含)",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "結束時間(unix秒, 不包含)",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "登入結果",
+                        "name": "result",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "登入IP",
+                        "name": "ip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "TW",
+                        "description": "國家代碼",
+                        "name": "countryCode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "頁碼, 從1開始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數, 預設20, 最多100",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LoginRecordListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "403": {
+                        "description": "沒有查詢登入紀錄的權限",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/logout": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "使當前的 access token 與 refresh token 失效，登出後需重新取得客戶端 token 並登入",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "登出",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/kyc": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "查詢當前玩家最近一次送出的KYC審核, 未曾送出時 data 為 null",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "查詢KYC審核結果",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.KycSubmissionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "當前玩家上傳證件並送出KYC審核, 每種證件以其類型作為表單欄位名稱上傳, 至少需要 idCard, passport, driverLicense 其中一份, 可另外附上手持證件的 selfie.\n證件格式為 jpeg, png 或 pdf, 每份最大 5MB. 審核中或已通過時不可再送出, 被駁回後可重新送出.\n審核通過後玩家升級為 KycVerifiedPlayer, 目前的 access token 會失效, 需以 refresh token 換發帶有新角色的 token",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "送出KYC審核",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "身分證",
+                        "name": "idCard",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "護照",
+                        "name": "passport",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "駕照",
+                        "name": "driverLicense",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "手持證件的自拍照",
+                        "name": "selfie",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.KycSubmissionResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "缺少身分證件, 格式或大小不符, 或已通過審核",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "已有審核中的送審資料",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/logins": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "取得當前玩家的登入紀錄，由新到舊排序，可依時間區間、登入結果、IP 及國家代碼篩選",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "取得登入紀錄",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "起始時間(unix秒, 包含)",
+                        "name": "startTime",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "結束時間(unix秒, 不包含)",
+                        "name": "endTime",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "success",
+                            "failed"
+                        ],
+                        "type": "string",
+                        "description": "登入結果",
+                        "name": "result",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "登入IP",
+                        "name": "ip",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "TW",
+                        "description": "國家代碼",
+                        "name": "countryCode",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "頁碼, 從1開始",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "每頁筆數, 預設20, 最多100",
+                        "name": "pageSize",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LoginRecordListResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/oauth": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "將第三方平台(Google, Meta, Twitter, LINE)的帳號綁定到當前玩家，綁定後可用該第三方帳號登入",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "綁定第三方帳號",
+                "parameters": [
+                    {
+                        "description": "Link OAuth Request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.LinkOAuthRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.LinkOAuthResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "第三方 token 驗證失敗(4000099)",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "該第三方帳號已綁定其他玩家, 或已綁定同平台的其他帳號",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "501": {
+                        "description": "尚未支援的第三方平台",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/oauth/{provider}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "解除當前玩家綁定的第三方帳號，不能解除唯一的登入方式",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "解除綁定第三方帳號",
+                "parameters": [
+                    {
+                        "enum": [
+                            "Google",
+                            "Meta",
+                            "Twitter",
+                            "LINE"
+                        ],
+                        "type": "string",
+                        "description": "第三方平台",
+                        "name": "provider",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "不能解除唯一的登入方式",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "尚未綁定該第三方平台",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/password": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "驗證目前密碼後變更密碼，新密碼需符合客戶端的密碼規則，成功後會登出該用戶其他裝置",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "變更密碼",
+                "parameters": [
+                    {
+                        "description": "Change Password Request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "目前密碼錯誤(4000001) 或新密碼不符合密碼規則(4000004), data 中的 failedRules 為未通過的規則",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/profile": {
+            "put": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "變更當前玩家的 email 或手機號碼, 未帶的欄位不變更. 新的 email / 手機號碼需先以 type=updateProfile 申請驗證碼, 並帶入收到的驗證碼, 舊的資料會保留為停用",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "變更聯絡資訊",
+                "parameters": [
+                    {
+                        "description": "Update Profile Request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.UpdateProfileRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.ProfileResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "缺少驗證碼, 驗證碼錯誤(4000002), 或驗證碼不是為新的 email / 手機號碼申請",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "email / 手機號碼已被其他玩家使用",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/sessions": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "取得當前玩家所有已登入的裝置，isCurrent 代表當前 access token 的裝置",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "取得已登入的裝置",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/response.SessionResponse"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/sessions/{sessionId}": {
+            "delete": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "登出當前玩家的指定裝置，該裝置的 access token 與 refresh token 皆會失效",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "登出指定裝置",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID",
+                        "name": "sessionId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/totp": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "產生 TOTP 金鑰與 otpauth URI(可轉為 QR code 給驗證器 App 掃描)，需再以第一組驗證碼確認後才會啟用",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "綁定驗證器",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TotpEnrollResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "已啟用驗證器",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/totp/confirmation": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "以驗證器 App 的第一組驗證碼確認並啟用 TOTP，成功後回傳備用碼且僅回傳一次，之後登入都需要二次驗證",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "啟用驗證器",
+                "parameters": [
+                    {
+                        "description": "Totp Confirm Request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.TotpConfirmRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.TotpConfirmResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "驗證碼錯誤",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "404": {
+                        "description": "尚未綁定驗證器",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "409": {
+                        "description": "已啟用驗證器",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/password/reset": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "以忘記密碼驗證取得的 resetToken 重設密碼，token 僅能使用一次，成功後會解除帳號鎖定並登出該用戶所有裝置",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "重設密碼",
+                "parameters": [
+                    {
+                        "description": "Reset Password Request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "resetToken 已過期或已使用",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/verification/": {
+            "post": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Verification, email 與手機號碼只能擇一且需與申請驗證碼時相同, type 為 forgotPwd 時會回傳一次性的 resetToken 以呼叫 /v1/users/password/reset, type 為 unusualLogin 時需帶登入回傳的 pendingLoginToken 且 email / 手機號碼需為登入用戶的聯絡方式, 驗證後回傳登入的 token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "驗證",
+                "parameters": [
+                    {
+                        "description": "Verification Request",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/request.VerificationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/response.Response"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "data": {
+                                            "$ref": "#/definitions/response.VerificationResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/response.Response"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/verificationCode/": {
+            "get": {
+                "security": [
+                    {
+                        "Bearer": []
+                    }
+                ],
+                "description": "Register Verification, 驗證碼會寄送到 email 或以簡訊傳送到手機號碼, email 與手機號碼只能擇一, 忘記密碼時需帶 type=forgotPwd, 變更 email / 手機號碼時需帶 type=updateProfile 並將驗證碼送到新的 email / 手機號碼, 驗證時的 email / mobile number 需與申請時相同",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "summary": "申請驗證碼",
                 "parameters": [
                     {
                         "enum": [
                             "forgotPwd",
-                            "unusualLogin"
+                            "unusualLogin",
+                            "updateProfile"
                         ],
                         "type": "string",
                         "description": "Type",
@@ -423,6 +1775,13 @@ const docTemplate = `{
                         "description": "Mobile Number",
                         "name": "mobileNumber",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "example": "zh-TW",
+                        "description": "通知的語系, 不支援時使用預設語系",
+                        "name": "Accept-Language",
+                        "in": "header"
                     }
                 ],
                 "responses": {
@@ -461,6 +1820,46 @@ const docTemplate = `{
         }
     },
     "definitions": {
+        "request.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "currentPassword",
+                "newPassword"
+            ],
+            "properties": {
+                "currentPassword": {
+                    "type": "string"
+                },
+                "newPassword": {
+                    "type": "string",
+                    "maxLength": 15,
+                    "minLength": 6
+                }
+            }
+        },
+        "request.LinkOAuthRequest": {
+            "type": "object",
+            "required": [
+                "accessToken",
+                "provider"
+            ],
+            "properties": {
+                "accessToken": {
+                    "description": "Token of the oauth provider",
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "Google",
+                        "Meta",
+                        "Twitter",
+                        "LINE"
+                    ],
+                    "example": "Google"
+                }
+            }
+        },
         "request.LoginRequest": {
             "type": "object",
             "required": [
@@ -479,6 +1878,10 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "forceLogin": {
+                    "description": "Replace the least recently used device when the device limit is reached",
+                    "type": "boolean"
+                },
                 "loginType": {
                     "type": "string",
                     "enum": [
@@ -497,6 +1900,63 @@ const docTemplate = `{
                 }
             }
         },
+        "request.OAuthLoginRequest": {
+            "type": "object",
+            "required": [
+                "accessToken",
+                "provider"
+            ],
+            "properties": {
+                "accessToken": {
+                    "description": "Token of the oauth provider",
+                    "type": "string"
+                },
+                "forceLogin": {
+                    "description": "Replace the least recently used device when the device limit is reached",
+                    "type": "boolean"
+                },
+                "provider": {
+                    "type": "string",
+                    "enum": [
+                        "Google",
+                        "Meta",
+                        "Twitter",
+                        "LINE"
+                    ],
+                    "example": "Google"
+                }
+            }
+        },
+        "request.ProfileVerification": {
+            "type": "object",
+            "required": [
+                "verificationCode",
+                "verificationCodePrefix",
+                "verificationCodeToken"
+            ],
+            "properties": {
+                "verificationCode": {
+                    "type": "string"
+                },
+                "verificationCodePrefix": {
+                    "type": "string"
+                },
+                "verificationCodeToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.RefreshTokenRequest": {
+            "type": "object",
+            "required": [
+                "refreshToken"
+            ],
+            "properties": {
+                "refreshToken": {
+                    "type": "string"
+                }
+            }
+        },
         "request.RegisterRequest": {
             "type": "object",
             "required": [
@@ -507,33 +1967,109 @@ const docTemplate = `{
                 "verificationCodeToken"
             ],
             "properties": {
-                "account": {
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 3
-                },
+                "account": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
+                },
+                "countryCode": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "mobileNumber": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 15,
+                    "minLength": 6
+                },
+                "verificationCode": {
+                    "type": "string"
+                },
+                "verificationCodePrefix": {
+                    "type": "string"
+                },
+                "verificationCodeToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.ResetPasswordRequest": {
+            "type": "object",
+            "required": [
+                "password",
+                "resetToken"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string",
+                    "maxLength": 15,
+                    "minLength": 6
+                },
+                "resetToken": {
+                    "type": "string"
+                }
+            }
+        },
+        "request.SecondFactorRequest": {
+            "type": "object",
+            "required": [
+                "challengeToken",
+                "code"
+            ],
+            "properties": {
+                "challengeToken": {
+                    "description": "The challenge token returned by login",
+                    "type": "string"
+                },
+                "code": {
+                    "description": "TOTP code or recovery code",
+                    "type": "string"
+                }
+            }
+        },
+        "request.TotpConfirmRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "description": "The first TOTP code of the authenticator app",
+                    "type": "string"
+                }
+            }
+        },
+        "request.UpdateProfileRequest": {
+            "type": "object",
+            "properties": {
                 "countryCode": {
                     "type": "string"
                 },
                 "email": {
                     "type": "string"
                 },
-                "mobileNumber": {
-                    "type": "string"
-                },
-                "password": {
-                    "type": "string",
-                    "maxLength": 15,
-                    "minLength": 6
-                },
-                "verificationCode": {
-                    "type": "string"
+                "emailVerification": {
+                    "description": "Required when the email is changed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.ProfileVerification"
+                        }
+                    ]
                 },
-                "verificationCodePrefix": {
+                "mobileNumber": {
                     "type": "string"
                 },
-                "verificationCodeToken": {
-                    "type": "string"
+                "mobileVerification": {
+                    "description": "Required when the mobile number is changed",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/request.ProfileVerification"
+                        }
+                    ]
                 }
             }
         },
@@ -555,6 +2091,10 @@ const docTemplate = `{
                 "mobileNumber": {
                     "type": "string"
                 },
+                "pendingLoginToken": {
+                    "description": "The pending login token returned by the unusual login, required for unusualLogin",
+                    "type": "string"
+                },
                 "type": {
                     "type": "string",
                     "enum": [
@@ -582,6 +2122,103 @@ const docTemplate = `{
                 }
             }
         },
+        "response.Jwk": {
+            "type": "object",
+            "properties": {
+                "alg": {
+                    "description": "RS256 or ES256",
+                    "type": "string"
+                },
+                "crv": {
+                    "description": "EC curve",
+                    "type": "string"
+                },
+                "e": {
+                    "description": "RSA exponent",
+                    "type": "string"
+                },
+                "kid": {
+                    "description": "Same as the kid header of the token",
+                    "type": "string"
+                },
+                "kty": {
+                    "description": "RSA or EC",
+                    "type": "string"
+                },
+                "n": {
+                    "description": "RSA modulus",
+                    "type": "string"
+                },
+                "use": {
+                    "description": "Always sig",
+                    "type": "string"
+                },
+                "x": {
+                    "description": "EC x coordinate",
+                    "type": "string"
+                },
+                "y": {
+                    "description": "EC y coordinate",
+                    "type": "string"
+                }
+            }
+        },
+        "response.JwksResponse": {
+            "type": "object",
+            "properties": {
+                "keys": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.Jwk"
+                    }
+                }
+            }
+        },
+        "response.KycSubmissionResponse": {
+            "type": "object",
+            "properties": {
+                "documents": {
+                    "description": "送出的證件類型",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "passport",
+                        "selfie"
+                    ]
+                },
+                "reviewedAt": {
+                    "description": "審核時間(unix秒), 未審核時為0",
+                    "type": "integer"
+                },
+                "reviewerNote": {
+                    "description": "審核備註, 駁回時為駁回原因",
+                    "type": "string",
+                    "example": "The photo is blurred"
+                },
+                "status": {
+                    "description": "pending: 審核中, approved: 已通過, rejected: 已駁回(可重新送出)",
+                    "type": "string",
+                    "example": "rejected"
+                },
+                "submittedAt": {
+                    "description": "送出時間(unix秒)",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.LinkOAuthResponse": {
+            "type": "object",
+            "properties": {
+                "openID": {
+                    "type": "string"
+                },
+                "provider": {
+                    "type": "string"
+                }
+            }
+        },
         "response.LoginAnomalousResponse": {
             "type": "object",
             "properties": {
@@ -591,8 +2228,110 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "expireSecs": {
+                    "description": "pendingLoginToken 有效秒數",
+                    "type": "integer"
+                },
                 "mobileNumber": {
                     "type": "string"
+                },
+                "pendingLoginToken": {
+                    "description": "驗證時帶入以完成登入",
+                    "type": "string"
+                }
+            }
+        },
+        "response.LoginErrorResponse": {
+            "type": "object",
+            "properties": {
+                "errorCount": {
+                    "description": "已錯誤次數",
+                    "type": "integer"
+                },
+                "lockedUntil": {
+                    "description": "達到錯誤次數上限時帳號的解鎖時間(unix秒), 未設定鎖定時間時需由管理員解鎖",
+                    "type": "integer"
+                },
+                "totalAttempts": {
+                    "description": "總共幾次機會",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.LoginLockedResponse": {
+            "type": "object",
+            "properties": {
+                "lockedUntil": {
+                    "description": "解鎖時間(unix秒)",
+                    "type": "integer"
+                },
+                "remainingSecs": {
+                    "description": "剩餘鎖定秒數, 可用於顯示倒數",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.LoginRecordListResponse": {
+            "type": "object",
+            "properties": {
+                "page": {
+                    "type": "integer"
+                },
+                "pageSize": {
+                    "type": "integer"
+                },
+                "records": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.LoginRecordResponse"
+                    }
+                },
+                "total": {
+                    "description": "The number of the records matching the filter",
+                    "type": "integer"
+                }
+            }
+        },
+        "response.LoginRecordResponse": {
+            "type": "object",
+            "properties": {
+                "browser": {
+                    "type": "string"
+                },
+                "browserVer": {
+                    "type": "string"
+                },
+                "city": {
+                    "type": "string"
+                },
+                "country": {
+                    "type": "string"
+                },
+                "countryCode": {
+                    "type": "string"
+                },
+                "createAt": {
+                    "description": "Unix seconds of the login",
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "isMobile": {
+                    "type": "boolean"
+                },
+                "isSuccess": {
+                    "type": "boolean"
+                },
+                "os": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "userId": {
+                    "description": "Only for the login records of the merchant",
+                    "type": "integer"
                 }
             }
         },
@@ -613,6 +2352,23 @@ const docTemplate = `{
                 }
             }
         },
+        "response.ProfileResponse": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "countryCode": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "mobileNumber": {
+                    "type": "string"
+                }
+            }
+        },
         "response.RegisterResponse": {
             "type": "object",
             "properties": {
@@ -656,11 +2412,167 @@ const docTemplate = `{
                 }
             }
         },
+        "response.SecondFactorChallengeResponse": {
+            "type": "object",
+            "properties": {
+                "challengeToken": {
+                    "description": "Used to complete the login with the code",
+                    "type": "string"
+                },
+                "enrolled": {
+                    "description": "False when the client requires two-factor authentication but the user has no authenticator yet",
+                    "type": "boolean"
+                },
+                "expireSecs": {
+                    "type": "integer"
+                },
+                "otpauthUri": {
+                    "description": "Only returned when the user is not enrolled",
+                    "type": "string"
+                },
+                "secret": {
+                    "description": "Only returned when the user is not enrolled",
+                    "type": "string"
+                }
+            }
+        },
+        "response.SessionResponse": {
+            "type": "object",
+            "properties": {
+                "browser": {
+                    "type": "string"
+                },
+                "browserVer": {
+                    "type": "string"
+                },
+                "createAt": {
+                    "description": "Unix seconds of the login",
+                    "type": "integer"
+                },
+                "ip": {
+                    "type": "string"
+                },
+                "isCurrent": {
+                    "description": "The session of the current access token",
+                    "type": "boolean"
+                },
+                "isMobile": {
+                    "type": "boolean"
+                },
+                "lastActiveAt": {
+                    "description": "Unix seconds of the last refresh",
+                    "type": "integer"
+                },
+                "os": {
+                    "type": "string"
+                },
+                "platform": {
+                    "type": "string"
+                },
+                "sessionId": {
+                    "type": "string"
+                }
+            }
+        },
         "response.TokenResponse": {
             "type": "object",
             "properties": {
                 "accessToken": {
                     "type": "string"
+                },
+                "passwordExpired": {
+                    "description": "PasswordExpired is true when the password is older than the max age of the password policy",
+                    "type": "boolean"
+                },
+                "recoveryCodes": {
+                    "description": "RecoveryCodes is only returned when the user enables TOTP during the login",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "refreshToken": {
+                    "description": "RefreshToken is only returned when a user logs in or refreshes the token",
+                    "type": "string"
+                },
+                "refreshTokenExpireSecs": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.TotpConfirmResponse": {
+            "type": "object",
+            "properties": {
+                "recoveryCodes": {
+                    "description": "Each code can be used once instead of a TOTP code",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "response.TotpEnrollResponse": {
+            "type": "object",
+            "properties": {
+                "otpauthUri": {
+                    "description": "Shown as a QR code for the authenticator apps",
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                }
+            }
+        },
+        "response.UserListItemResponse": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "clientId": {
+                    "type": "integer"
+                },
+                "countryCode": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "description": "Unix seconds of the registration",
+                    "type": "integer"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "mobileNumber": {
+                    "type": "string"
+                },
+                "roleId": {
+                    "type": "integer"
+                },
+                "roleName": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "1: active 2: locked",
+                    "type": "integer"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "response.UserSearchResponse": {
+            "type": "object",
+            "properties": {
+                "nextCursor": {
+                    "description": "Empty on the last page",
+                    "type": "string"
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/response.UserListItemResponse"
+                    }
                 }
             }
         },
@@ -689,8 +2601,23 @@ const docTemplate = `{
                 "email": {
                     "type": "string"
                 },
+                "login": {
+                    "description": "Only returned for unusualLogin, the tokens of the completed login",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/response.TokenResponse"
+                        }
+                    ]
+                },
                 "mobileNumber": {
                     "type": "string"
+                },
+                "resetToken": {
+                    "description": "Only returned for forgotPwd, used to reset the password once",
+                    "type": "string"
+                },
+                "resetTokenExpireSecs": {
+                    "type": "integer"
                 }
             }
         }
@@ -8,6 +8,7 @@ import (
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/pb/gen/auth"
 	"go_micro_service_api/pkg/responder"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -97,9 +98,13 @@ func (a *AuthHandler) ClientAuth(c *gin.Context) {
 // @Security Bearer
 // @Param body body request.LoginRequest true "Login Request"
 // @Success      200  	{object}	response.Response{data=response.LoignPassResponse}
-// @Failure      400  	{object}  	response.Response
-// @Failure      401  	{object}  	response.Response{data=response.LoginAnomalousResponse}
+// @Failure      400  	{object}  	response.Response{data=response.LoginErrorResponse} "密碼錯誤(4000001), 達到錯誤次數上限時帳號會被鎖定"
+// @Failure      401  	{object}  	response.Response{data=response.LoginAnomalousResponse} "異常登入(4010001), 以 unusualLogin 申請驗證碼並驗證後完成登入"
+// @Failure      401  	{object}  	response.Response{data=response.LoginLockedResponse} "帳號被鎖定(4010002), 到期後自動解鎖"
+// @Failure      401  	{object}  	response.Response{data=response.SecondFactorChallengeResponse} "需要二次驗證(4010007), 以 challengeToken 呼叫 /v1/users/login/secondFactor 完成登入"
+// @Failure      403  	{object}  	response.Response "登入風險過高被拒絕(4030003)"
 // @Failure      404  	{object}  	response.Response
+// @Failure      409  	{object}  	response.Response "登入裝置數已達上限, 可帶 forceLogin 登出最久未使用的裝置"
 // @Failure      500  	{object}  	response.Response
 // @Router       /v1/users/login/ [post]
 func (a *AuthHandler) Login(c *gin.Context) {
@@ -154,6 +159,7 @@ func (a *AuthHandler) Login(c *gin.Context) {
 		UserId:      userInfo.UserId,
 		AccessToken: accessToken,
 		Password:    loginRequest.Password,
+		ForceLogin:  loginRequest.ForceLogin,
 	}
 	res, cusErr := a.authGrpc.Login(ctx, loginInfo)
 	if cusErr != nil {
@@ -166,12 +172,19 @@ func (a *AuthHandler) Login(c *gin.Context) {
 			return
 
 		case cus_err.UnusualLogin:
-			// 處理異常登入
-			cusErr.WithData(response.LoginAnomalousResponse{
+			// 處理異常登入, 帶上 pending login token 讓用戶驗證後完成登入
+			anomalous := response.LoginAnomalousResponse{
 				Email:        userInfo.Email,
 				CountryCode:  userInfo.CountryCode,
 				MobileNumber: userInfo.MobileNumber,
-			})
+			}
+			if data, ok := cusErr.Data().(map[string]interface{}); ok {
+				anomalous.PendingLoginToken, _ = data["pendingLoginToken"].(string)
+				if expireSecs, ok := data["expireSecs"].(float64); ok {
+					anomalous.ExpireSecs = int64(expireSecs)
+				}
+			}
+			cusErr.WithData(anomalous)
 			responder.Error(cusErr).WithContext(c)
 			return
 
@@ -183,5 +196,392 @@ func (a *AuthHandler) Login(c *gin.Context) {
 		}
 	}
 
-	responder.Ok(&response.TokenResponse{AccessToken: res.AccessToken}).WithContext(c)
+	responder.Ok(&response.TokenResponse{
+		AccessToken:            res.AccessToken,
+		RefreshToken:           res.RefreshToken,
+		RefreshTokenExpireSecs: res.RefreshTokenExpireSecs,
+		PasswordExpired:        res.PasswordExpired,
+	}).WithContext(c)
+}
+
+// RefreshToken refresh token
+// @Summary      換發token
+// @Description  使用登入時取得的 refresh token 換發新的 access token 與 refresh token，refresh token 僅能使用一次，重複使用會導致該用戶所有 token 失效
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Version      1.0
+// @Param body body request.RefreshTokenRequest true "Refresh Token Request"
+// @Success      200  	{object}	response.Response{data=response.TokenResponse}
+// @Failure      400  	{object}  	response.Response
+// @Failure      401  	{object}  	response.Response
+// @Failure      500  	{object}  	response.Response
+// @Router       /v1/auth/refresh [post]
+func (a *AuthHandler) RefreshToken(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get the refresh token from the body
+	var req request.RefreshTokenRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Call the auth grpc
+	res, cusErr := a.authGrpc.RefreshToken(ctx, req.RefreshToken)
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(&response.TokenResponse{
+		AccessToken:            res.AccessToken,
+		RefreshToken:           res.RefreshToken,
+		RefreshTokenExpireSecs: res.RefreshTokenExpireSecs,
+	}).WithContext(c)
+}
+
+// Logout logout
+// @Summary      登出
+// @Description  使當前的 access token 與 refresh token 失效，登出後需重新取得客戶端 token 並登入
+// @Tags         Auth
+// @Produce      json
+// @Version      1.0
+// @Security Bearer
+// @Success      200  	{object}	response.Response
+// @Failure      401  	{object}  	response.Response
+// @Failure      500  	{object}  	response.Response
+// @Router       /v1/users/logout [post]
+func (a *AuthHandler) Logout(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Call the auth grpc
+	cusErr := a.authGrpc.Logout(ctx, accessToken)
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(nil).WithContext(c)
+}
+
+// ListSessions list sessions
+// @Summary      取得已登入的裝置
+// @Description  取得當前玩家所有已登入的裝置，isCurrent 代表當前 access token 的裝置
+// @Tags         Auth
+// @Produce      json
+// @Version      1.0
+// @Security Bearer
+// @Success      200  	{object}	response.Response{data=[]response.SessionResponse}
+// @Failure      401  	{object}  	response.Response
+// @Failure      500  	{object}  	response.Response
+// @Router       /v1/users/me/sessions [get]
+func (a *AuthHandler) ListSessions(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Call the auth grpc
+	res, cusErr := a.authGrpc.ListSessions(ctx, accessToken)
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	sessions := make([]response.SessionResponse, 0, len(res.Sessions))
+	for _, session := range res.Sessions {
+		sessions = append(sessions, response.SessionResponse{
+			SessionId:    session.SessionId,
+			Ip:           session.Ip,
+			Browser:      session.Browser,
+			BrowserVer:   session.BrowserVer,
+			Os:           session.Os,
+			Platform:     session.Platform,
+			IsMobile:     session.IsMobile,
+			CreateAt:     session.CreateAt,
+			LastActiveAt: session.LastActiveAt,
+			IsCurrent:    session.IsCurrent,
+		})
+	}
+
+	responder.Ok(sessions).WithContext(c)
+}
+
+// RevokeSession revoke session
+// @Summary      登出指定裝置
+// @Description  登出當前玩家的指定裝置，該裝置的 access token 與 refresh token 皆會失效
+// @Tags         Auth
+// @Produce      json
+// @Version      1.0
+// @Security Bearer
+// @Param sessionId path string true "Session ID"
+// @Success      200  	{object}	response.Response
+// @Failure      401  	{object}  	response.Response
+// @Failure      404  	{object}  	response.Response
+// @Failure      500  	{object}  	response.Response
+// @Router       /v1/users/me/sessions/{sessionId} [delete]
+func (a *AuthHandler) RevokeSession(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Call the auth grpc
+	cusErr := a.authGrpc.RevokeSession(ctx, accessToken, c.Param("sessionId"))
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(nil).WithContext(c)
+}
+
+// Jwks get the JSON Web Key Set
+// @Summary      取得 JWKS
+// @Description  取得驗證 RS256 / ES256 簽名 token 的公鑰，下游服務可以依 token header 的 kid 找到對應公鑰在本地驗證，不需要再呼叫 ValidToken；回應為標準 JWKS 格式，不包在 response.Response 內
+// @Tags         Auth
+// @Produce      json
+// @Version      1.0
+// @Success      200  	{object}	response.JwksResponse
+// @Failure      500  	{object}  	response.Response
+// @Router       /v1/auth/jwks [get]
+func (a *AuthHandler) Jwks(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Call the auth grpc
+	res, cusErr := a.authGrpc.GetJwks(ctx)
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	keys := make([]response.Jwk, 0, len(res.Keys))
+	for _, key := range res.Keys {
+		keys = append(keys, response.Jwk{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+			Y:   key.Y,
+		})
+	}
+
+	// The JWKS is served as is, so the standard JWT libraries can consume it
+	c.Header("Cache-Control", "public, max-age=300")
+	c.JSON(http.StatusOK, response.JwksResponse{Keys: keys})
+}
+
+// VerifySecondFactor verify second factor
+// @Summary      二次驗證登入
+// @Description  登入回傳需要二次驗證時，以 challengeToken 與驗證器 App 的驗證碼(或備用碼)完成登入；若登入時才綁定驗證器，成功後會回傳備用碼且僅回傳一次
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Version      1.0
+// @Security Bearer
+// @Param body body request.SecondFactorRequest true "Second Factor Request"
+// @Success      200  	{object}	response.Response{data=response.TokenResponse}
+// @Failure      400  	{object}  	response.Response{data=response.LoginErrorResponse} "驗證碼錯誤, 錯誤次數達上限後需重新登入"
+// @Failure      401  	{object}  	response.Response
+// @Failure      500  	{object}  	response.Response
+// @Router       /v1/users/login/secondFactor [post]
+func (a *AuthHandler) VerifySecondFactor(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get the challenge from the body
+	var req request.SecondFactorRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Call the auth grpc
+	res, cusErr := a.authGrpc.VerifySecondFactor(ctx, &auth.VerifySecondFactorRequest{
+		ChallengeToken: req.ChallengeToken,
+		Code:           req.Code,
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(&response.TokenResponse{
+		AccessToken:            res.AccessToken,
+		RefreshToken:           res.RefreshToken,
+		RefreshTokenExpireSecs: res.RefreshTokenExpireSecs,
+		RecoveryCodes:          res.RecoveryCodes,
+		PasswordExpired:        res.PasswordExpired,
+	}).WithContext(c)
+}
+
+// EnrollTotp enroll totp
+// @Summary      綁定驗證器
+// @Description  產生 TOTP 金鑰與 otpauth URI(可轉為 QR code 給驗證器 App 掃描)，需再以第一組驗證碼確認後才會啟用
+// @Tags         Auth
+// @Produce      json
+// @Version      1.0
+// @Security Bearer
+// @Success      200  	{object}	response.Response{data=response.TotpEnrollResponse}
+// @Failure      401  	{object}  	response.Response
+// @Failure      409  	{object}  	response.Response "已啟用驗證器"
+// @Failure      500  	{object}  	response.Response
+// @Router       /v1/users/me/totp [post]
+func (a *AuthHandler) EnrollTotp(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Call the auth grpc
+	res, cusErr := a.authGrpc.EnrollTotp(ctx, &auth.EnrollTotpRequest{
+		AccessToken: accessToken,
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(&response.TotpEnrollResponse{
+		Secret:     res.Secret,
+		OtpauthUri: res.OtpauthUri,
+	}).WithContext(c)
+}
+
+// ConfirmTotp confirm totp
+// @Summary      啟用驗證器
+// @Description  以驗證器 App 的第一組驗證碼確認並啟用 TOTP，成功後回傳備用碼且僅回傳一次，之後登入都需要二次驗證
+// @Tags         Auth
+// @Accept       json
+// @Produce      json
+// @Version      1.0
+// @Security Bearer
+// @Param body body request.TotpConfirmRequest true "Totp Confirm Request"
+// @Success      200  	{object}	response.Response{data=response.TotpConfirmResponse}
+// @Failure      400  	{object}  	response.Response "驗證碼錯誤"
+// @Failure      401  	{object}  	response.Response
+// @Failure      404  	{object}  	response.Response "尚未綁定驗證器"
+// @Failure      409  	{object}  	response.Response "已啟用驗證器"
+// @Failure      500  	{object}  	response.Response
+// @Router       /v1/users/me/totp/confirmation [post]
+func (a *AuthHandler) ConfirmTotp(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Get the code from the body
+	var req request.TotpConfirmRequest
+	if err := c.ShouldBindBodyWithJSON(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Call the auth grpc
+	res, cusErr := a.authGrpc.ConfirmTotp(ctx, &auth.ConfirmTotpRequest{
+		AccessToken: accessToken,
+		Code:        req.Code,
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(&response.TotpConfirmResponse{
+		RecoveryCodes: res.RecoveryCodes,
+	}).WithContext(c)
 }
@@ -1,6 +1,8 @@
 package v1_handler
 
 import (
+	"errors"
+	"fmt"
 	"go_micro_service_api/frontend_api/internal/infrastructure/grpc_client"
 	auth_middleware "go_micro_service_api/frontend_api/internal/middleware/auth"
 	"go_micro_service_api/frontend_api/internal/model/request"
@@ -12,6 +14,11 @@ import (
 	"go_micro_service_api/pkg/pb/gen/auth"
 	"go_micro_service_api/pkg/pb/gen/user"
 	"go_micro_service_api/pkg/responder"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
@@ -36,6 +43,7 @@ func NewUserHandler(authGrpc *grpc_client.AuthClient, userGrpc *grpc_client.User
 // @Accept json
 // @Produce json
 // @Security Bearer
+// @Param Idempotency-Key header string false "The retries with the same key get the result of the first registration"
 // @Param body body request.RegisterRequest true "Register Request"
 // @Success 200 {object} response.Response{data=response.RegisterResponse}
 // @Failure 400 {object} response.Response{data=response.VerificationErrorResponse}
@@ -63,8 +71,21 @@ func (u *UserHandler) CreateUser(c *gin.Context) {
 		return
 	}
 
-	// verification
-	res, err := u.userGrpc.Verification(ctx, &user.VerificationRequest{
+	// the retries of the client share the idempotency key, every request is a new registration without it
+	userId := u.snowFlakeHelper.NextID()
+	idempotencyKey := c.GetHeader("Idempotency-Key")
+	if idempotencyKey == "" {
+		idempotencyKey = strconv.FormatInt(userId, 10)
+	}
+
+	// verify the code and create the user on auth service and user service,
+	// the auth user is removed when the profile can't be created
+	_, err := u.userGrpc.RegisterUser(ctx, &user.RegisterUserRequest{
+		ClientId:               userInfo.GetClientId(),
+		IdempotencyKey:         idempotencyKey,
+		UserId:                 userId,
+		Account:                request.Account,
+		Password:               request.Password,
 		Email:                  request.Email,
 		CountryCode:            request.CountryCode,
 		MobileNumber:           request.MobileNumber,
@@ -72,38 +93,6 @@ func (u *UserHandler) CreateUser(c *gin.Context) {
 		VerificationCode:       request.VerificationCode,
 		VerificationCodeToken:  request.VerificationCodeToken,
 	})
-
-	// WARN: err should contain user.VerificationErrorResponse
-	if err != nil || !res.GetResult() {
-		responder.Error(err).WithContext(c)
-		return
-	}
-
-	userId := u.snowFlakeHelper.NextID()
-
-	// TODO: XA
-
-	// create user identity on auth service
-	err = u.authGrpc.CreateUser(ctx, &auth.CreateUserRequest{
-		ClientId: userInfo.GetClientId(),
-		Id:       userId,
-		Account:  request.Account,
-		Password: request.Password,
-		Status:   int32(enum.UserStatusType.Active),
-	})
-	if err != nil {
-		responder.Error(err).WithContext(c)
-		return
-	}
-
-	// create user profile on user service
-	_, err = u.userGrpc.CreateProfile(ctx, &user.CreateProfileRequest{
-		Id:           userId,
-		Account:      request.Account,
-		Email:        request.Email,
-		CountryCode:  request.CountryCode,
-		MobileNumber: request.MobileNumber,
-	})
 	if err != nil {
 		responder.Error(err).WithContext(c)
 		return
@@ -172,3 +161,784 @@ func (u *UserHandler) CheckUserExistence(c *gin.Context) {
 		Exists: exists,
 	}).WithContext(c)
 }
+
+// @Summary 重設密碼
+// @Description 以忘記密碼驗證取得的 resetToken 重設密碼，token 僅能使用一次，成功後會解除帳號鎖定並登出該用戶所有裝置
+// @Tags User
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body request.ResetPasswordRequest true "Reset Password Request"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response "resetToken 已過期或已使用"
+// @Router /v1/users/password/reset [post]
+func (u *UserHandler) ResetPassword(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// body validation
+	var req request.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	err := u.authGrpc.ResetPassword(ctx, &auth.ResetPasswordRequest{
+		ResetToken: req.ResetToken,
+		Password:   req.Password,
+	})
+	if err != nil {
+		responder.Error(err).WithContext(c)
+		return
+	}
+
+	responder.Ok(nil).WithContext(c)
+}
+
+// @Summary 變更密碼
+// @Description 驗證目前密碼後變更密碼，新密碼需符合客戶端的密碼規則，成功後會登出該用戶其他裝置
+// @Tags User
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body request.ChangePasswordRequest true "Change Password Request"
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response "目前密碼錯誤(4000001) 或新密碼不符合密碼規則(4000004), data 中的 failedRules 為未通過的規則"
+// @Failure 401 {object} response.Response
+// @Router /v1/users/me/password [put]
+func (u *UserHandler) ChangePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// body validation
+	var req request.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	err := u.authGrpc.ChangePassword(ctx, &auth.ChangePasswordRequest{
+		AccessToken:     accessToken,
+		CurrentPassword: req.CurrentPassword,
+		NewPassword:     req.NewPassword,
+	})
+	if err != nil {
+		responder.Error(err).WithContext(c)
+		return
+	}
+
+	responder.Ok(nil).WithContext(c)
+}
+
+// @Summary 取得登入紀錄
+// @Description 取得當前玩家的登入紀錄，由新到舊排序，可依時間區間、登入結果、IP 及國家代碼篩選
+// @Tags User
+// @Produce json
+// @Security Bearer
+// @Param startTime query int false "起始時間(unix秒, 包含)"
+// @Param endTime query int false "結束時間(unix秒, 不包含)"
+// @Param result query string false "登入結果" Enums(success, failed)
+// @Param ip query string false "登入IP"
+// @Param countryCode query string false "國家代碼" example(TW)
+// @Param page query int false "頁碼, 從1開始"
+// @Param pageSize query int false "每頁筆數, 預設20, 最多100"
+// @Success 200 {object} response.Response{data=response.LoginRecordListResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Router /v1/users/me/logins [get]
+func (u *UserHandler) ListLoginRecords(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// query validation
+	var req request.ListLoginRecordsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	res, err := u.authGrpc.ListLoginRecords(ctx, &auth.ListLoginRecordsRequest{
+		AccessToken: accessToken,
+		Filter:      newLoginRecordFilter(&req),
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		responder.Error(err).WithContext(c)
+		return
+	}
+
+	responder.Ok(newLoginRecordListResponse(res, false)).WithContext(c)
+}
+
+// @Summary 查詢商戶玩家的登入紀錄
+// @Description 後台客服查詢所屬商戶玩家的登入紀錄，需有 BackendViewLoginRecord 權限，由新到舊排序，可依玩家、時間區間、登入結果、IP 及國家代碼篩選
+// @Tags User
+// @Produce json
+// @Security Bearer
+// @Param userId query int false "玩家id, 未帶時為商戶所有玩家"
+// @Param startTime query int false "起始時間(unix秒, 包含)"
+// @Param endTime query int false "結束時間(unix秒, 不包含)"
+// @Param result query string false "登入結果" Enums(success, failed)
+// @Param ip query string false "登入IP"
+// @Param countryCode query string false "國家代碼" example(TW)
+// @Param page query int false "頁碼, 從1開始"
+// @Param pageSize query int false "每頁筆數, 預設20, 最多100"
+// @Success 200 {object} response.Response{data=response.LoginRecordListResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response "沒有查詢登入紀錄的權限"
+// @Router /v1/users/logins [get]
+func (u *UserHandler) ListMerchantLoginRecords(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header, the merchant is the one of the operator
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// query validation
+	var req request.ListMerchantLoginRecordsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	res, err := u.authGrpc.ListMerchantLoginRecords(ctx, &auth.ListMerchantLoginRecordsRequest{
+		AccessToken: accessToken,
+		UserId:      req.UserId,
+		Filter:      newLoginRecordFilter(&req.ListLoginRecordsRequest),
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		responder.Error(err).WithContext(c)
+		return
+	}
+
+	responder.Ok(newLoginRecordListResponse(res, true)).WithContext(c)
+}
+
+// @Summary 搜尋用戶
+// @Description 後台客服搜尋所屬商戶的用戶，需有 BackendSearchUser 權限，以游標分頁，帶入上一頁的 nextCursor 取得下一頁，排序方式需與上一頁相同
+// @Tags User
+// @Produce json
+// @Security Bearer
+// @Param account query string false "帳號前綴"
+// @Param email query string false "email, 需完全相符"
+// @Param countryCode query string false "國家代碼, 需與手機號碼一起搜尋" example(886)
+// @Param mobileNumber query string false "手機號碼, 需完全相符"
+// @Param status query int false "用戶狀態 1: 啟用 2: 鎖定" Enums(1, 2)
+// @Param clientId query int false "客戶端id"
+// @Param createdFrom query int false "註冊起始時間(unix秒, 包含)"
+// @Param createdTo query int false "註冊結束時間(unix秒, 不包含)"
+// @Param sort query string false "排序欄位, 預設createdAt" Enums(createdAt, account, id)
+// @Param order query string false "排序方向, 預設desc" Enums(asc, desc)
+// @Param cursor query string false "上一頁的nextCursor"
+// @Param limit query int false "每頁筆數, 預設20, 最多100"
+// @Success 200 {object} response.Response{data=response.UserSearchResponse}
+// @Failure 400 {object} response.Response
+// @Failure 401 {object} response.Response
+// @Failure 403 {object} response.Response "沒有搜尋用戶的權限"
+// @Router /v1/users [get]
+func (u *UserHandler) SearchUsers(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	userInfo, ok := auth_middleware.GetUserInfo(c)
+	if !ok {
+		cusErr := cus_err.New(cus_err.Unauthorized, "Unauthenticated")
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// query validation
+	var req request.SearchUsersRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// The merchant of the operator can't be overridden by the query
+	res, cusErr := u.userGrpc.SearchUsers(ctx, &user.SearchUsersRequest{
+		AccountPrefix: req.Account,
+		Email:         req.Email,
+		CountryCode:   req.CountryCode,
+		MobileNumber:  req.MobileNumber,
+		Status:        req.Status,
+		MerchantId:    userInfo.GetMerchantId(),
+		ClientId:      req.ClientId,
+		CreatedFrom:   req.CreatedFrom,
+		CreatedTo:     req.CreatedTo,
+		Sort:          req.Sort,
+		Ascending:     req.Order == "asc",
+		Cursor:        req.Cursor,
+		Limit:         req.Limit,
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	users := make([]response.UserListItemResponse, 0, len(res.Users))
+	for _, item := range res.Users {
+		users = append(users, response.UserListItemResponse{
+			UserId:       item.UserId,
+			Account:      item.Account,
+			Email:        item.Email,
+			CountryCode:  item.CountryCode,
+			MobileNumber: item.MobileNumber,
+			Status:       item.Status,
+			RoleId:       item.RoleId,
+			RoleName:     item.RoleName,
+			ClientId:     item.ClientId,
+			CreatedAt:    item.CreatedAt,
+		})
+	}
+
+	responder.Ok(response.UserSearchResponse{
+		Users:      users,
+		NextCursor: res.NextCursor,
+	}).WithContext(c)
+}
+
+// @Summary 第三方登入
+// @Description 以第三方平台(Google, Meta, Twitter, LINE)的 token 登入，該第三方帳號尚未綁定會員時，會自動註冊新會員並綁定後登入
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body request.OAuthLoginRequest true "OAuth Login Request"
+// @Success 200 {object} response.Response{data=response.TokenResponse}
+// @Failure 400 {object} response.Response "第三方 token 驗證失敗(4000099)"
+// @Failure 401 {object} response.Response{data=response.LoginAnomalousResponse} "異常登入(4010001), 以 unusualLogin 申請驗證碼並驗證後完成登入"
+// @Failure 401 {object} response.Response{data=response.LoginLockedResponse} "帳號被鎖定(4010002), 到期後自動解鎖"
+// @Failure 401 {object} response.Response{data=response.SecondFactorChallengeResponse} "需要二次驗證(4010007), 以 challengeToken 呼叫 /v1/users/login/secondFactor 完成登入"
+// @Failure 403 {object} response.Response "登入風險過高被拒絕(4030003)"
+// @Failure 409 {object} response.Response "登入裝置數已達上限, 可帶 forceLogin 登出最久未使用的裝置"
+// @Failure 501 {object} response.Response "尚未支援的第三方平台"
+// @Router /v1/users/login/oauth [post]
+func (u *UserHandler) OAuthLogin(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get access token from Authorization header
+	var accessToken string
+	authHeader := c.GetHeader("Authorization")
+	if authHeader != "" {
+		splitToken := strings.Split(authHeader, "Bearer ")
+		if len(splitToken) == 2 {
+			accessToken = splitToken[1]
+		}
+	}
+	if accessToken == "" {
+		cusErr := cus_err.New(cus_err.MissingAccessToken, "Access token not found in header", nil)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	userInfo, ok := auth_middleware.GetUserInfo(c)
+	if !ok {
+		cusErr := cus_err.New(cus_err.Unauthorized, "Unauthenticated")
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// body validation
+	var req request.OAuthLoginRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// find the user bound to the oauth account
+	identity, err := u.userGrpc.VerifyOAuth(ctx, &user.VerifyOAuthRequest{
+		Provider:    req.Provider,
+		AccessToken: req.AccessToken,
+	})
+	if err != nil {
+		responder.Error(err).WithContext(c)
+		return
+	}
+
+	userId := identity.GetUserId()
+	if userId == 0 {
+		// the oauth account isn't bound yet, register a user without password for it,
+		// the auth user is removed when the profile can't be created with the openID
+		userId = u.snowFlakeHelper.NextID()
+		registered, err := u.userGrpc.RegisterOAuthUser(ctx, &user.RegisterOAuthUserRequest{
+			ClientId:       userInfo.GetClientId(),
+			IdempotencyKey: strconv.FormatInt(userId, 10),
+			UserId:         userId,
+			Account:        strings.ToLower(req.Provider) + strconv.FormatInt(userId, 10),
+			Provider:       req.Provider,
+			AccessToken:    req.AccessToken,
+		})
+		if err != nil {
+			responder.Error(err).WithContext(c)
+			return
+		}
+		userId = registered.GetUserId()
+	}
+
+	res, err := u.authGrpc.OAuthLogin(ctx, &auth.OAuthLoginRequest{
+		UserId:      userId,
+		AccessToken: accessToken,
+		UserAgent:   c.GetHeader("User-Agent"),
+		Ip:          c.ClientIP(),
+		ForceLogin:  req.ForceLogin,
+	})
+	if err != nil {
+		if err.Code().Int() == cus_err.UnusualLogin {
+			// 處理異常登入, 帶上 pending login token 讓用戶驗證後完成登入
+			anomalous := response.LoginAnomalousResponse{}
+			if data, ok := err.Data().(map[string]interface{}); ok {
+				anomalous.PendingLoginToken, _ = data["pendingLoginToken"].(string)
+				if expireSecs, ok := data["expireSecs"].(float64); ok {
+					anomalous.ExpireSecs = int64(expireSecs)
+				}
+			}
+			err.WithData(anomalous)
+		}
+		responder.Error(err).WithContext(c)
+		return
+	}
+
+	responder.Ok(&response.TokenResponse{
+		AccessToken:            res.AccessToken,
+		RefreshToken:           res.RefreshToken,
+		RefreshTokenExpireSecs: res.RefreshTokenExpireSecs,
+		PasswordExpired:        res.PasswordExpired,
+	}).WithContext(c)
+}
+
+// @Summary 綁定第三方帳號
+// @Description 將第三方平台(Google, Meta, Twitter, LINE)的帳號綁定到當前玩家，綁定後可用該第三方帳號登入
+// @Tags User
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body request.LinkOAuthRequest true "Link OAuth Request"
+// @Success 200 {object} response.Response{data=response.LinkOAuthResponse}
+// @Failure 400 {object} response.Response "第三方 token 驗證失敗(4000099)"
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response "該第三方帳號已綁定其他玩家, 或已綁定同平台的其他帳號"
+// @Failure 501 {object} response.Response "尚未支援的第三方平台"
+// @Router /v1/users/me/oauth [post]
+func (u *UserHandler) LinkOAuth(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	userId, cusErr := getCurrentUserId(c)
+	if cusErr != nil {
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// body validation
+	var req request.LinkOAuthRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	res, cusErr := u.userGrpc.LinkOAuth(ctx, &user.LinkOAuthRequest{
+		UserId:      userId,
+		Provider:    req.Provider,
+		AccessToken: req.AccessToken,
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(response.LinkOAuthResponse{
+		Provider: req.Provider,
+		OpenID:   res.GetOpenID(),
+	}).WithContext(c)
+}
+
+// @Summary 解除綁定第三方帳號
+// @Description 解除當前玩家綁定的第三方帳號，不能解除唯一的登入方式
+// @Tags User
+// @Produce json
+// @Security Bearer
+// @Param provider path string true "第三方平台" Enums(Google, Meta, Twitter, LINE)
+// @Success 200 {object} response.Response
+// @Failure 400 {object} response.Response "不能解除唯一的登入方式"
+// @Failure 401 {object} response.Response
+// @Failure 404 {object} response.Response "尚未綁定該第三方平台"
+// @Router /v1/users/me/oauth/{provider} [delete]
+func (u *UserHandler) UnlinkOAuth(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	userId, cusErr := getCurrentUserId(c)
+	if cusErr != nil {
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	cusErr = u.userGrpc.UnlinkOAuth(ctx, &user.UnlinkOAuthRequest{
+		UserId:   userId,
+		Provider: c.Param("provider"),
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(nil).WithContext(c)
+}
+
+// @Summary 變更聯絡資訊
+// @Description 變更當前玩家的 email 或手機號碼, 未帶的欄位不變更. 新的 email / 手機號碼需先以 type=updateProfile 申請驗證碼, 並帶入收到的驗證碼, 舊的資料會保留為停用
+// @Tags User
+// @Accept json
+// @Produce json
+// @Security Bearer
+// @Param body body request.UpdateProfileRequest true "Update Profile Request"
+// @Success 200 {object} response.Response{data=response.ProfileResponse}
+// @Failure 400 {object} response.Response "缺少驗證碼, 驗證碼錯誤(4000002), 或驗證碼不是為新的 email / 手機號碼申請"
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response "email / 手機號碼已被其他玩家使用"
+// @Router /v1/users/me/profile [put]
+func (u *UserHandler) UpdateProfile(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	userId, cusErr := getCurrentUserId(c)
+	if cusErr != nil {
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// body validation
+	var req request.UpdateProfileRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	res, cusErr := u.userGrpc.UpdateProfile(ctx, &user.UpdateProfileRequest{
+		UserId:             userId,
+		Email:              req.Email,
+		EmailVerification:  newProfileVerification(req.EmailVerification),
+		CountryCode:        req.CountryCode,
+		MobileNumber:       req.MobileNumber,
+		MobileVerification: newProfileVerification(req.MobileVerification),
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(&response.ProfileResponse{
+		Account:      res.Account,
+		Email:        res.Email,
+		CountryCode:  res.CountryCode,
+		MobileNumber: res.MobileNumber,
+	}).WithContext(c)
+}
+
+// kycMaxDocumentBytes is the size limit of a KYC document, the same as the user service
+const kycMaxDocumentBytes = 5 << 20
+
+// @Summary 送出KYC審核
+// @Description 當前玩家上傳證件並送出KYC審核, 每種證件以其類型作為表單欄位名稱上傳, 至少需要 idCard, passport, driverLicense 其中一份, 可另外附上手持證件的 selfie.
+// @Description 證件格式為 jpeg, png 或 pdf, 每份最大 5MB. 審核中或已通過時不可再送出, 被駁回後可重新送出.
+// @Description 審核通過後玩家升級為 KycVerifiedPlayer, 目前的 access token 會失效, 需以 refresh token 換發帶有新角色的 token
+// @Tags User
+// @Accept multipart/form-data
+// @Produce json
+// @Security Bearer
+// @Param idCard formData file false "身分證"
+// @Param passport formData file false "護照"
+// @Param driverLicense formData file false "駕照"
+// @Param selfie formData file false "手持證件的自拍照"
+// @Success 200 {object} response.Response{data=response.KycSubmissionResponse}
+// @Failure 400 {object} response.Response "缺少身分證件, 格式或大小不符, 或已通過審核"
+// @Failure 401 {object} response.Response
+// @Failure 409 {object} response.Response "已有審核中的送審資料"
+// @Router /v1/users/me/kyc [post]
+func (u *UserHandler) SubmitKyc(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	userId, cusErr := getCurrentUserId(c)
+	if cusErr != nil {
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	// Read the documents by the form fields of their types
+	documents := make([]*user.KycDocumentUpload, 0)
+	for _, documentType := range []enum.KycDocument{
+		enum.KycDocumentType.IdCard,
+		enum.KycDocumentType.Passport,
+		enum.KycDocumentType.DriverLicense,
+		enum.KycDocumentType.Selfie,
+	} {
+		header, err := c.FormFile(documentType.String)
+		if err != nil {
+			if errors.Is(err, http.ErrMissingFile) {
+				continue
+			}
+			cusErr := cus_err.New(cus_err.InvalidArgument, "Invalid request", err)
+			cus_otel.Warn(ctx, cusErr.Error())
+			responder.Error(cusErr).WithContext(c)
+			return
+		}
+		if header.Size > kycMaxDocumentBytes {
+			cusErr := cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("%s is larger than %d bytes", documentType.String, kycMaxDocumentBytes))
+			cus_otel.Warn(ctx, cusErr.Error())
+			responder.Error(cusErr).WithContext(c)
+			return
+		}
+
+		content, err := readFormFile(header)
+		if err != nil {
+			cusErr := cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("failed to read %s", documentType.String), err)
+			cus_otel.Warn(ctx, cusErr.Error())
+			responder.Error(cusErr).WithContext(c)
+			return
+		}
+
+		// The content type is detected from the content, the header of the client isn't trusted
+		documents = append(documents, &user.KycDocumentUpload{
+			Type:        documentType.String,
+			ContentType: http.DetectContentType(content),
+			Content:     content,
+		})
+	}
+
+	res, cusErr := u.userGrpc.SubmitKyc(ctx, &user.SubmitKycRequest{
+		UserId:    userId,
+		Documents: documents,
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	responder.Ok(newKycSubmissionResponse(res.Submission)).WithContext(c)
+}
+
+// @Summary 查詢KYC審核結果
+// @Description 查詢當前玩家最近一次送出的KYC審核, 未曾送出時 data 為 null
+// @Tags User
+// @Produce json
+// @Security Bearer
+// @Success 200 {object} response.Response{data=response.KycSubmissionResponse}
+// @Failure 401 {object} response.Response
+// @Router /v1/users/me/kyc [get]
+func (u *UserHandler) GetKycSubmission(c *gin.Context) {
+	ctx := c.Request.Context()
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	userId, cusErr := getCurrentUserId(c)
+	if cusErr != nil {
+		cus_otel.Warn(ctx, cusErr.Error())
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+
+	res, cusErr := u.userGrpc.GetKycSubmission(ctx, &user.GetKycSubmissionRequest{
+		UserId: userId,
+	})
+	if cusErr != nil {
+		responder.Error(cusErr).WithContext(c)
+		return
+	}
+	if res.Submission == nil {
+		responder.Ok(nil).WithContext(c)
+		return
+	}
+
+	responder.Ok(newKycSubmissionResponse(res.Submission)).WithContext(c)
+}
+
+// newKycSubmissionResponse converts the submission for the player, the reviewer isn't shown
+// newLoginRecordFilter converts the filter of the query
+func newLoginRecordFilter(req *request.ListLoginRecordsRequest) *auth.LoginRecordFilter {
+	// 0: all, 1: success, 2: failed
+	var result int32
+	switch req.Result {
+	case "success":
+		result = 1
+	case "failed":
+		result = 2
+	}
+
+	return &auth.LoginRecordFilter{
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Result:      result,
+		Ip:          req.Ip,
+		CountryCode: req.CountryCode,
+	}
+}
+
+// newLoginRecordListResponse converts the page of the login records, the user ids are only shown to the backend users
+func newLoginRecordListResponse(res *auth.ListLoginRecordsResponse, withUserId bool) response.LoginRecordListResponse {
+	records := make([]response.LoginRecordResponse, 0, len(res.Records))
+	for _, record := range res.Records {
+		item := response.LoginRecordResponse{
+			Ip:          record.Ip,
+			Browser:     record.Browser,
+			BrowserVer:  record.BrowserVer,
+			Os:          record.Os,
+			Platform:    record.Platform,
+			IsMobile:    record.IsMobile,
+			Country:     record.Country,
+			CountryCode: record.CountryCode,
+			City:        record.City,
+			IsSuccess:   record.IsSuccess,
+			CreateAt:    record.CreateAt,
+		}
+		if withUserId {
+			item.UserId = record.UserId
+		}
+		records = append(records, item)
+	}
+
+	return response.LoginRecordListResponse{
+		Records:  records,
+		Total:    res.Total,
+		Page:     res.Page,
+		PageSize: res.PageSize,
+	}
+}
+
+func newKycSubmissionResponse(submission *user.KycSubmission) *response.KycSubmissionResponse {
+	documents := make([]string, 0, len(submission.Documents))
+	for _, document := range submission.Documents {
+		documents = append(documents, document.Type)
+	}
+
+	return &response.KycSubmissionResponse{
+		Status:       submission.Status,
+		Documents:    documents,
+		ReviewerNote: submission.ReviewerNote,
+		SubmittedAt:  submission.SubmittedAt,
+		ReviewedAt:   submission.ReviewedAt,
+	}
+}
+
+// readFormFile reads the whole uploaded file
+func readFormFile(header *multipart.FileHeader) ([]byte, error) {
+	file, err := header.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	return io.ReadAll(file)
+}
+
+// newProfileVerification converts the verification of the new email or mobile number, nil when it's absent
+func newProfileVerification(verification *request.ProfileVerification) *user.ProfileVerification {
+	if verification == nil {
+		return nil
+	}
+
+	return &user.ProfileVerification{
+		VerificationCodePrefix: verification.VerificationCodePrefix,
+		VerificationCode:       verification.VerificationCode,
+		VerificationCodeToken:  verification.VerificationCodeToken,
+	}
+}
+
+// getCurrentUserId gets the id of the logged in user from the user info set by the auth middleware
+func getCurrentUserId(c *gin.Context) (int64, *cus_err.CusError) {
+	userInfo, ok := auth_middleware.GetUserInfo(c)
+	if !ok {
+		return 0, cus_err.New(cus_err.Unauthorized, "Unauthenticated")
+	}
+
+	userId, ok := userInfo.GetUserId()
+	if !ok {
+		return 0, cus_err.New(cus_err.Unauthorized, "user token is required")
+	}
+
+	return userId, nil
+}
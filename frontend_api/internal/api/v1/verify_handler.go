@@ -7,31 +7,36 @@ import (
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/pb/gen/auth"
 	"go_micro_service_api/pkg/pb/gen/user"
 	"go_micro_service_api/pkg/responder"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 )
 
 type VerifyHandler struct {
+	authGrpc *grpc_client.AuthClient
 	userGrpc *grpc_client.UserClient
 }
 
-func NewVerifyHandler(userGrpc *grpc_client.UserClient) *VerifyHandler {
+func NewVerifyHandler(authGrpc *grpc_client.AuthClient, userGrpc *grpc_client.UserClient) *VerifyHandler {
 	return &VerifyHandler{
+		authGrpc: authGrpc,
 		userGrpc: userGrpc,
 	}
 }
 
 // @Summary 申請驗證碼
-// @Description Register Verification
+// @Description Register Verification, 驗證碼會寄送到 email 或以簡訊傳送到手機號碼, email 與手機號碼只能擇一, 忘記密碼時需帶 type=forgotPwd, 變更 email / 手機號碼時需帶 type=updateProfile 並將驗證碼送到新的 email / 手機號碼, 驗證時的 email / mobile number 需與申請時相同
 // @Tags User
 // @Produce json
 // @Security Bearer
-// @Param type query string false "Type" Enums(forgotPwd,unusualLogin)
+// @Param type query string false "Type" Enums(forgotPwd,unusualLogin,updateProfile)
 // @Param email query string false "Email"
 // @Param countryCode query string false "Country Code"
 // @Param mobileNumber query string false "Mobile Number"
+// @Param Accept-Language header string false "通知的語系, 不支援時使用預設語系" example(zh-TW)
 // @Success 200 {object} response.Response{data=response.RegisterVerificationResponse}
 // @Failure 400 {object} response.Response
 // @Failure 401 {object} response.Response
@@ -55,15 +60,13 @@ func (v *VerifyHandler) RegisterVerification(c *gin.Context) {
 		Email:        request.Email,
 		CountryCode:  request.CountryCode,
 		MobileNumber: request.MobileNumber,
+		Locale:       preferredLocale(c.GetHeader("Accept-Language")),
 	})
 	if err != nil {
 		responder.Error(err).WithContext(c)
 		return
 	}
 
-	// TODO: if failed to send code then rollback
-	// TODO: send verification code
-
 	response := &response.RegisterVerificationResponse{
 		VerificationCodePrefix: res.VerificationCodePrefix,
 		VerificationCodeToken:  res.VerificationCodeToken,
@@ -72,7 +75,7 @@ func (v *VerifyHandler) RegisterVerification(c *gin.Context) {
 }
 
 // @Summary 驗證
-// @Description Verification
+// @Description Verification, email 與手機號碼只能擇一且需與申請驗證碼時相同, type 為 forgotPwd 時會回傳一次性的 resetToken 以呼叫 /v1/users/password/reset, type 為 unusualLogin 時需帶登入回傳的 pendingLoginToken 且 email / 手機號碼需為登入用戶的聯絡方式, 驗證後回傳登入的 token
 // @Tags User
 // @Produce json
 // @Security Bearer
@@ -95,6 +98,29 @@ func (v *VerifyHandler) Verification(c *gin.Context) {
 		return
 	}
 
+	// the unusual login is bound to its user, so the code must be sent to the contact of the user
+	var pendingLoginUserId int64
+	var pendingLoginUser *user.GetProfileResponse
+	if req.Type == enum.VerificationTypes.UnusualLogin.String {
+		pendingLogin, err := v.authGrpc.FindPendingLogin(ctx, req.PendingLoginToken)
+		if err != nil {
+			responder.Error(err).WithContext(c)
+			return
+		}
+		pendingLoginUserId = pendingLogin.UserId
+		pendingLoginUser, err = v.userGrpc.FindProfile(ctx, &user.GetProfileRequest{Id: pendingLoginUserId})
+		if err != nil {
+			responder.Error(err).WithContext(c)
+			return
+		}
+		if !isContactOf(req, pendingLoginUser) {
+			cusErr := cus_err.New(cus_err.InvalidArgument, "Verification is not sent to the contact of the login user")
+			cus_otel.Warn(ctx, cusErr.Error())
+			responder.Error(cusErr).WithContext(c)
+			return
+		}
+	}
+
 	res, err := v.userGrpc.Verification(ctx, &user.VerificationRequest{
 		Type:                   req.Type,
 		Email:                  req.Email,
@@ -110,16 +136,43 @@ func (v *VerifyHandler) Verification(c *gin.Context) {
 		return
 	}
 
+	// complete the held back login if unusual, the device is trusted afterward
+	if req.Type == enum.VerificationTypes.UnusualLogin.String {
+		loginRes, err := v.authGrpc.CompleteUnusualLogin(ctx, &auth.CompleteUnusualLoginRequest{
+			PendingLoginToken: req.PendingLoginToken,
+			UserId:            pendingLoginUserId,
+		})
+		if err != nil {
+			responder.Error(err).WithContext(c)
+			return
+		}
+
+		responder.Ok(&response.VerificationResponse{
+			Account:      pendingLoginUser.Username,
+			Email:        pendingLoginUser.Email,
+			CountryCode:  pendingLoginUser.CountryCode,
+			MobileNumber: pendingLoginUser.MobileNumber,
+			Login: &response.TokenResponse{
+				AccessToken:            loginRes.AccessToken,
+				RefreshToken:           loginRes.RefreshToken,
+				RefreshTokenExpireSecs: loginRes.RefreshTokenExpireSecs,
+				PasswordExpired:        loginRes.PasswordExpired,
+			},
+		}).WithContext(c)
+		return
+	}
+
+	// find the user by the channel the code was sent to, which is the email when it's given
 	getUserInfoReq := request.LoginRequest{
-		Email:        req.Email,
+		LoginType:    enum.LoginTypes.MobileNumber.String,
 		CountryCode:  req.CountryCode,
 		MobileNumber: req.MobileNumber,
 	}
 	if req.Email != "" {
-		getUserInfoReq.LoginType = enum.LoginTypes.Email.String
-	}
-	if req.CountryCode != "" && req.MobileNumber != "" {
-		getUserInfoReq.LoginType = enum.LoginTypes.MobileNumber.String
+		getUserInfoReq = request.LoginRequest{
+			LoginType: enum.LoginTypes.Email.String,
+			Email:     req.Email,
+		}
 	}
 	userInfo, err := v.userGrpc.GetLoginUserInfo(ctx, getUserInfoReq)
 	if err != nil {
@@ -127,18 +180,38 @@ func (v *VerifyHandler) Verification(c *gin.Context) {
 		return
 	}
 
-	response := &response.VerificationResponse{
+	verifyResponse := &response.VerificationResponse{
 		Account:      userInfo.Account,
 		Email:        userInfo.Email,
 		CountryCode:  userInfo.CountryCode,
 		MobileNumber: userInfo.MobileNumber,
 	}
 
-	// login if unusual
-	// TODO: change to notify event enum
-	if req.Type == "unusualLogin" {
+	// issue a reset token if forgot password
+	if req.Type == enum.VerificationTypes.ForgotPwd.String {
+		resetToken, err := v.authGrpc.CreatePasswordResetToken(ctx, userInfo.UserId)
+		if err != nil {
+			responder.Error(err).WithContext(c)
+			return
+		}
+		verifyResponse.ResetToken = resetToken.ResetToken
+		verifyResponse.ResetTokenExpireSecs = resetToken.ExpireSecs
+	}
+
+	responder.Ok(verifyResponse).WithContext(c)
+}
 
+// isContactOf checks the email or the mobile number of the verification is the one of the user
+func isContactOf(req request.VerificationRequest, profile *user.GetProfileResponse) bool {
+	if req.Email != "" {
+		return strings.EqualFold(req.Email, profile.Email)
 	}
+	return req.CountryCode == profile.CountryCode && req.MobileNumber == profile.MobileNumber
+}
 
-	responder.Ok(response).WithContext(c)
+// preferredLocale returns the first locale of the Accept-Language header, e.g. zh-TW of "zh-TW,zh;q=0.9,en;q=0.8"
+func preferredLocale(acceptLanguage string) string {
+	locale, _, _ := strings.Cut(acceptLanguage, ",")
+	locale, _, _ = strings.Cut(locale, ";")
+	return strings.TrimSpace(locale)
 }
@@ -116,13 +116,13 @@ func (a *AuthClient) ValidToken(ctx context.Context, token string) (*authMiddlew
 	// Map the permissions
 	permissions := make([]enum.Permission, 0)
 	if res.Role != nil {
-		for i, pid := range res.Role.PermIds {
+		for _, pid := range res.Role.PermIds {
 			perm, err := enum.PermissionById(pid)
 			if err != nil {
 				cus_otel.Error(ctx, err.Error())
 				return nil, err
 			}
-			permissions[i] = perm
+			permissions = append(permissions, perm)
 		}
 	}
 
@@ -189,6 +189,239 @@ func (a *AuthClient) Login(ctx context.Context, req *auth.LoginRequest) (*auth.A
 	return res, nil
 }
 
+// OAuthLogin logs in the user bound to the verified oauth account, the password isn't checked.
+func (a *AuthClient) OAuthLogin(ctx context.Context, req *auth.OAuthLoginRequest) (*auth.AuthResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Check if the access token is empty
+	if req.AccessToken == "" {
+		err := cus_err.New(cus_err.MissingAccessToken, "missing access token")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	res, grpcErr := a.authGrpcClient.OAuthLogin(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RefreshToken exchanges the refresh token for a new access token and a new refresh token.
+func (a *AuthClient) RefreshToken(ctx context.Context, refreshToken string) (*auth.AuthResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if refreshToken == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "missing refresh token")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	res, grpcErr := a.authGrpcClient.RefreshToken(ctx, &auth.RefreshTokenRequest{
+		RefreshToken: refreshToken,
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Logout revokes the access token and ends the session of the token owner.
+func (a *AuthClient) Logout(ctx context.Context, accessToken string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, grpcErr := a.authGrpcClient.Logout(ctx, &auth.LogoutRequest{
+		AccessToken: accessToken,
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ListSessions lists the logged in devices of the token owner.
+func (a *AuthClient) ListSessions(ctx context.Context, accessToken string) (*auth.ListSessionsResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.authGrpcClient.ListSessions(ctx, &auth.ListSessionsRequest{
+		AccessToken: accessToken,
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// RevokeSession logs out one of the devices of the token owner.
+func (a *AuthClient) RevokeSession(ctx context.Context, accessToken string, sessionId string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, grpcErr := a.authGrpcClient.RevokeSession(ctx, &auth.RevokeSessionRequest{
+		AccessToken: accessToken,
+		SessionId:   sessionId,
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// GetJwks gets the public keys for verifying the tokens signed by RS256 or ES256.
+func (a *AuthClient) GetJwks(ctx context.Context) (*auth.JwksResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.authGrpcClient.GetJwks(ctx, &auth.Empty{})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// VerifySecondFactor completes the login waiting for the second factor.
+func (a *AuthClient) VerifySecondFactor(ctx context.Context, req *auth.VerifySecondFactorRequest) (*auth.AuthResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.authGrpcClient.VerifySecondFactor(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// FindPendingLogin finds the user of the unusual login, the pending login is not consumed.
+func (a *AuthClient) FindPendingLogin(ctx context.Context, pendingLoginToken string) (*auth.FindPendingLoginResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.authGrpcClient.FindPendingLogin(ctx, &auth.FindPendingLoginRequest{
+		PendingLoginToken: pendingLoginToken,
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// CompleteUnusualLogin completes the unusual login of the verified user, the device of the login is trusted.
+func (a *AuthClient) CompleteUnusualLogin(ctx context.Context, req *auth.CompleteUnusualLoginRequest) (*auth.AuthResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.authGrpcClient.CompleteUnusualLogin(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// EnrollTotp generates a TOTP secret of the token owner, it is enabled after ConfirmTotp.
+func (a *AuthClient) EnrollTotp(ctx context.Context, req *auth.EnrollTotpRequest) (*auth.EnrollTotpResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.authGrpcClient.EnrollTotp(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ConfirmTotp enables the TOTP of the token owner with the first code.
+func (a *AuthClient) ConfirmTotp(ctx context.Context, req *auth.ConfirmTotpRequest) (*auth.ConfirmTotpResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.authGrpcClient.ConfirmTotp(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
 // Register registers a new user with the provided registration information and returns an access token.
 func (a *AuthClient) CreateUser(ctx context.Context, req *auth.CreateUserRequest) *cus_err.CusError {
 	ctx, span := cus_otel.StartTrace(ctx)
@@ -209,6 +442,109 @@ func (a *AuthClient) CreateUser(ctx context.Context, req *auth.CreateUserRequest
 	return nil
 }
 
+// CreatePasswordResetToken issues a one-time token to reset the password of the verified user.
+func (a *AuthClient) CreatePasswordResetToken(ctx context.Context, userId int64) (*auth.PasswordResetTokenResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if userId <= 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "user ID is required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	res, grpcErr := a.userGrpcClient.CreatePasswordResetToken(ctx, &auth.CreatePasswordResetTokenRequest{
+		UserId: userId,
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ResetPassword resets the password by the password reset token, every session of the user is logged out.
+func (a *AuthClient) ResetPassword(ctx context.Context, req *auth.ResetPasswordRequest) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, grpcErr := a.userGrpcClient.ResetPassword(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ChangePassword changes the password of the token owner, the other sessions of the user are logged out.
+func (a *AuthClient) ChangePassword(ctx context.Context, req *auth.ChangePasswordRequest) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, grpcErr := a.userGrpcClient.ChangePassword(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// ListLoginRecords lists the login records of the token owner.
+func (a *AuthClient) ListLoginRecords(ctx context.Context, req *auth.ListLoginRecordsRequest) (*auth.ListLoginRecordsResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.userGrpcClient.ListLoginRecords(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListMerchantLoginRecords lists the login records of the users of the token owner's merchant, the owner must be a backend user.
+func (a *AuthClient) ListMerchantLoginRecords(ctx context.Context, req *auth.ListMerchantLoginRecordsRequest) (*auth.ListLoginRecordsResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := a.userGrpcClient.ListMerchantLoginRecords(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
 func (a *AuthClient) CheckAccountExistence(ctx context.Context, account string) (bool, *cus_err.CusError) {
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
@@ -16,9 +16,11 @@ import (
 )
 
 type UserClient struct {
-	conn             *grpc.ClientConn
-	userGrpcClient   user.UserServiceClient
-	verifyGrpcClient user.VerifyServiceClient
+	conn                   *grpc.ClientConn
+	userGrpcClient         user.UserServiceClient
+	verifyGrpcClient       user.VerifyServiceClient
+	kycGrpcClient          user.KycServiceClient
+	registrationGrpcClient user.RegistrationServiceClient
 }
 
 func NewUserClient(cfg *config.Config) (*UserClient, error) {
@@ -36,11 +38,15 @@ func NewUserClient(cfg *config.Config) (*UserClient, error) {
 	}
 	userGrpc := user.NewUserServiceClient(conn)
 	verifyGrpc := user.NewVerifyServiceClient(conn)
+	kycGrpc := user.NewKycServiceClient(conn)
+	registrationGrpc := user.NewRegistrationServiceClient(conn)
 
 	return &UserClient{
-		conn:             conn,
-		userGrpcClient:   userGrpc,
-		verifyGrpcClient: verifyGrpc,
+		conn:                   conn,
+		userGrpcClient:         userGrpc,
+		verifyGrpcClient:       verifyGrpc,
+		kycGrpcClient:          kycGrpc,
+		registrationGrpcClient: registrationGrpc,
 	}, nil
 }
 
@@ -78,6 +84,59 @@ func (a *UserClient) CreateProfile(ctx context.Context, req *user.CreateProfileR
 	return res, nil
 }
 
+// RegisterUser registers the user in the auth service and the user service,
+// the auth user is removed by the user service when the profile can't be created.
+// The retries with the same idempotency key get the result of the first registration.
+func (a *UserClient) RegisterUser(ctx context.Context, req *user.RegisterUserRequest) (*user.RegisterUserResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.UserId <= 0 || req.IdempotencyKey == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "user ID and idempotency key are required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return &user.RegisterUserResponse{}, err
+	}
+
+	res, grpcErr := a.registrationGrpcClient.RegisterUser(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return &user.RegisterUserResponse{}, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return &user.RegisterUserResponse{}, err
+	}
+
+	return res, nil
+}
+
+// RegisterOAuthUser registers the user without password for the oauth account like RegisterUser,
+// the openID is linked when the profile is created and the auth user is removed when it fails.
+func (a *UserClient) RegisterOAuthUser(ctx context.Context, req *user.RegisterOAuthUserRequest) (*user.RegisterUserResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.UserId <= 0 || req.IdempotencyKey == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "user ID and idempotency key are required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return &user.RegisterUserResponse{}, err
+	}
+
+	res, grpcErr := a.registrationGrpcClient.RegisterOAuthUser(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return &user.RegisterUserResponse{}, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return &user.RegisterUserResponse{}, err
+	}
+
+	return res, nil
+}
+
 // FindProfile finds a user profile by the provided user ID.
 func (a *UserClient) FindProfile(ctx context.Context, req *user.GetProfileRequest) (*user.GetProfileResponse, *cus_err.CusError) {
 	ctx, span := cus_otel.StartTrace(ctx)
@@ -249,3 +308,173 @@ func (u *UserClient) GetLoginUserInfo(ctx context.Context, payload request.Login
 	}
 	return res, nil
 }
+
+// VerifyOAuth verifies the token of the oauth provider, and finds the user bound to its openID.
+// The user id is 0 when the openID isn't bound to any user.
+func (u *UserClient) VerifyOAuth(ctx context.Context, req *user.VerifyOAuthRequest) (*user.VerifyOAuthResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, grpcErr := u.userGrpcClient.VerifyOAuth(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// LinkOAuth verifies the token of the oauth provider, and binds its openID to the user.
+func (u *UserClient) LinkOAuth(ctx context.Context, req *user.LinkOAuthRequest) (*user.LinkOAuthResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.UserId <= 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "user ID is required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	res, grpcErr := u.userGrpcClient.LinkOAuth(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// UnlinkOAuth unbinds the openID of the oauth provider from the user.
+func (u *UserClient) UnlinkOAuth(ctx context.Context, req *user.UnlinkOAuthRequest) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.UserId <= 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "user ID is required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	_, grpcErr := u.userGrpcClient.UnlinkOAuth(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// UpdateProfile changes the email or the mobile number of the user with the verification of the new value.
+func (u *UserClient) UpdateProfile(ctx context.Context, req *user.UpdateProfileRequest) (*user.UpdateProfileResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.UserId <= 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "user ID is required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	res, grpcErr := u.userGrpcClient.UpdateProfile(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// SubmitKyc uploads the identity documents of the user for the KYC review.
+func (u *UserClient) SubmitKyc(ctx context.Context, req *user.SubmitKycRequest) (*user.SubmitKycResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.UserId <= 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "user ID is required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	res, grpcErr := u.kycGrpcClient.SubmitKyc(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetKycSubmission gets the latest KYC submission of the user, the submission is nil when the user has never submitted.
+func (u *UserClient) GetKycSubmission(ctx context.Context, req *user.GetKycSubmissionRequest) (*user.GetKycSubmissionResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if req.UserId <= 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "user ID is required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	res, grpcErr := u.kycGrpcClient.GetKycSubmission(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (u *UserClient) SearchUsers(ctx context.Context, req *user.SearchUsersRequest) (*user.SearchUsersResponse, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// The backend users only search the users of their merchant
+	if req.MerchantId <= 0 {
+		err := cus_err.New(cus_err.InvalidArgument, "merchant ID is required", nil)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	res, grpcErr := u.userGrpcClient.SearchUsers(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "can't found the cusErr from grpcErr", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	return res, nil
+}
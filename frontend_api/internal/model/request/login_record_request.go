@@ -0,0 +1,17 @@
+package request
+
+type ListLoginRecordsRequest struct {
+	StartTime   int64  `form:"startTime" binding:"omitempty,min=0" example:"1731024000"`                 // Unix seconds, inclusive
+	EndTime     int64  `form:"endTime" binding:"omitempty,min=0,gtfield=StartTime" example:"1731628800"` // Unix seconds, exclusive
+	Result      string `form:"result" binding:"omitempty,oneof=success failed" example:"failed"`         // Empty for all results
+	Ip          string `form:"ip" binding:"omitempty,ip" example:"1.1.1.1"`
+	CountryCode string `form:"countryCode" binding:"omitempty,len=2" example:"TW"`
+	Page        int32  `form:"page" binding:"omitempty,min=1" example:"1"`
+	PageSize    int32  `form:"pageSize" binding:"omitempty,min=1,max=100" example:"20"`
+}
+
+// ListMerchantLoginRecordsRequest filters the login records of the users of the operator's merchant
+type ListMerchantLoginRecordsRequest struct {
+	UserId int64 `form:"userId" binding:"omitempty,min=1" example:"123456789"` // Empty for all the users of the merchant
+	ListLoginRecordsRequest
+}
@@ -7,4 +7,5 @@ type LoginRequest struct {
 	CountryCode  string `json:"countryCode" binding:"required_without_all=Account Email,required_with=MobileNumber,omitempty,number"`
 	MobileNumber string `json:"mobileNumber" binding:"required_without_all=Account Email,required_with=CountryCode,omitempty,number"`
 	Password     string `json:"password" binding:"required,min=6,max=15,one_alpha"`
+	ForceLogin   bool   `json:"forceLogin"` // Replace the least recently used device when the device limit is reached
 }
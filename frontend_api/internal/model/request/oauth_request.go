@@ -0,0 +1,12 @@
+package request
+
+type OAuthLoginRequest struct {
+	Provider    string `json:"provider" binding:"required,oneof=Google Meta Twitter LINE" example:"Google"`
+	AccessToken string `json:"accessToken" binding:"required"` // Token of the oauth provider
+	ForceLogin  bool   `json:"forceLogin"`                     // Replace the least recently used device when the device limit is reached
+}
+
+type LinkOAuthRequest struct {
+	Provider    string `json:"provider" binding:"required,oneof=Google Meta Twitter LINE" example:"Google"`
+	AccessToken string `json:"accessToken" binding:"required"` // Token of the oauth provider
+}
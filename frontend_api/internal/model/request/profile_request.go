@@ -0,0 +1,17 @@
+package request
+
+// ProfileVerification is the code of the new email or mobile number, requested with type=updateProfile
+type ProfileVerification struct {
+	VerificationCodePrefix string `json:"verificationCodePrefix" binding:"required,alpha,len=3,uppercase"`
+	VerificationCode       string `json:"verificationCode" binding:"required,number,len=6"`
+	VerificationCodeToken  string `json:"verificationCodeToken" binding:"required"`
+}
+
+// UpdateProfileRequest changes the contact of the user, the empty field is left unchanged
+type UpdateProfileRequest struct {
+	Email              string               `json:"email" binding:"required_without=MobileNumber,omitempty,email"`
+	EmailVerification  *ProfileVerification `json:"emailVerification" binding:"omitempty"` // Required when the email is changed
+	CountryCode        string               `json:"countryCode" binding:"required_without=Email,required_with=MobileNumber,omitempty,number"`
+	MobileNumber       string               `json:"mobileNumber" binding:"required_without=Email,required_with=CountryCode,omitempty,number"`
+	MobileVerification *ProfileVerification `json:"mobileVerification" binding:"omitempty"` // Required when the mobile number is changed
+}
@@ -11,6 +11,16 @@ type RegisterRequest struct {
 	VerificationCodeToken  string `json:"verificationCodeToken" binding:"required"`
 }
 
+type ResetPasswordRequest struct {
+	ResetToken string `json:"resetToken" binding:"required"`
+	Password   string `json:"password" binding:"required,min=6,max=15,one_alpha"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword" binding:"required"`
+	NewPassword     string `json:"newPassword" binding:"required,min=6,max=15,one_alpha"`
+}
+
 type CheckUserExistenceRequest struct {
 	Account      string `form:"account" binding:"required_without_all=Email MobileNumber" example:"account"`
 	Email        string `form:"email" binding:"required_without_all=Account MobileNumber,omitempty,email" example:"test@example.com"`
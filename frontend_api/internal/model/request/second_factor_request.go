@@ -0,0 +1,10 @@
+package request
+
+type SecondFactorRequest struct {
+	ChallengeToken string `json:"challengeToken" binding:"required"` // The challenge token returned by login
+	Code           string `json:"code" binding:"required"`           // TOTP code or recovery code
+}
+
+type TotpConfirmRequest struct {
+	Code string `json:"code" binding:"required,len=6,number"` // The first TOTP code of the authenticator app
+}
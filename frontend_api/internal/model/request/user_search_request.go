@@ -0,0 +1,16 @@
+package request
+
+type SearchUsersRequest struct {
+	Account      string `form:"account" binding:"omitempty,max=64" example:"player"` // Prefix of the account
+	Email        string `form:"email" binding:"omitempty,email" example:"player@example.com"`
+	CountryCode  string `form:"countryCode" binding:"omitempty,numeric" example:"886"`
+	MobileNumber string `form:"mobileNumber" binding:"required_with=CountryCode,omitempty,numeric" example:"912345678"`
+	Status       int32  `form:"status" binding:"omitempty,oneof=1 2" example:"1"`                             // 1: active 2: locked, empty for all
+	ClientId     int64  `form:"clientId" binding:"omitempty,min=1" example:"1"`                               // Only the users of the client of the merchant
+	CreatedFrom  int64  `form:"createdFrom" binding:"omitempty,min=0" example:"1731024000"`                   // Unix seconds, inclusive
+	CreatedTo    int64  `form:"createdTo" binding:"omitempty,min=0,gtfield=CreatedFrom" example:"1731628800"` // Unix seconds, exclusive
+	Sort         string `form:"sort" binding:"omitempty,oneof=createdAt account id" example:"createdAt"`
+	Order        string `form:"order" binding:"omitempty,oneof=asc desc" example:"desc"` // desc by default
+	Cursor       string `form:"cursor" example:""`                                       // nextCursor of the previous page
+	Limit        int32  `form:"limit" binding:"omitempty,min=1,max=100" example:"20"`
+}
@@ -1,18 +1,22 @@
 package request
 
+// RegisterVerificationRequest takes either the email or the mobile number, the code is sent to the given one only
 type RegisterVerificationRequest struct {
-	Type         string `form:"type" binding:"omitempty,oneof=forgotPwd unusualLogin"`
-	Email        string `form:"email" binding:"required_without=MobileNumber,omitempty,email"`
-	CountryCode  string `form:"countryCode" binding:"required_without=Email,required_with=MobileNumber,omitempty,number"`
-	MobileNumber string `form:"mobileNumber" binding:"required_without=Email,required_with=CountryCode,omitempty,number"`
+	Type         string `form:"type" binding:"omitempty,oneof=forgotPwd unusualLogin updateProfile"`
+	Email        string `form:"email" binding:"required_without=MobileNumber,excluded_with=MobileNumber,omitempty,email"`
+	CountryCode  string `form:"countryCode" binding:"required_without=Email,required_with=MobileNumber,excluded_with=Email,omitempty,number"`
+	MobileNumber string `form:"mobileNumber" binding:"required_without=Email,required_with=CountryCode,excluded_with=Email,omitempty,number"`
 }
 
+// VerificationRequest takes the same email or mobile number as the RegisterVerificationRequest, never both
 type VerificationRequest struct {
 	Type                   string `json:"type" binding:"required,oneof=forgotPwd unusualLogin"`
-	Email                  string `json:"email" binding:"required_without=MobileNumber,omitempty,email"`
-	CountryCode            string `json:"countryCode" binding:"required_without=Email,required_with=MobileNumber,omitempty,number"`
-	MobileNumber           string `json:"mobileNumber" binding:"required_without=Email,required_with=CountryCode,omitempty,number"`
+	Email                  string `json:"email" binding:"required_without=MobileNumber,excluded_with=MobileNumber,omitempty,email"`
+	CountryCode            string `json:"countryCode" binding:"required_without=Email,required_with=MobileNumber,excluded_with=Email,omitempty,number"`
+	MobileNumber           string `json:"mobileNumber" binding:"required_without=Email,required_with=CountryCode,excluded_with=Email,omitempty,number"`
 	VerificationCodePrefix string `json:"verificationCodePrefix" binding:"required,alpha,len=3,uppercase"`
 	VerificationCode       string `json:"verificationCode" binding:"required,number,len=6"`
 	VerificationCodeToken  string `json:"verificationCodeToken" binding:"required"`
+	// The pending login token returned by the unusual login, required for unusualLogin
+	PendingLoginToken string `json:"pendingLoginToken" binding:"required_if=Type unusualLogin"`
 }
@@ -0,0 +1,19 @@
+package response
+
+// JwksResponse is the JSON Web Key Set for verifying the tokens signed by RS256 or ES256
+type JwksResponse struct {
+	Keys []Jwk `json:"keys"`
+}
+
+// Jwk is a public key of the JSON Web Key Set
+type Jwk struct {
+	Kty string `json:"kty"`           // RSA or EC
+	Kid string `json:"kid"`           // Same as the kid header of the token
+	Use string `json:"use"`           // Always sig
+	Alg string `json:"alg"`           // RS256 or ES256
+	N   string `json:"n,omitempty"`   // RSA modulus
+	E   string `json:"e,omitempty"`   // RSA exponent
+	Crv string `json:"crv,omitempty"` // EC curve
+	X   string `json:"x,omitempty"`   // EC x coordinate
+	Y   string `json:"y,omitempty"`   // EC y coordinate
+}
@@ -0,0 +1,10 @@
+package response
+
+// 玩家最近一次送出的KYC審核
+type KycSubmissionResponse struct {
+	Status       string   `json:"status" example:"rejected"`                   // pending: 審核中, approved: 已通過, rejected: 已駁回(可重新送出)
+	Documents    []string `json:"documents" example:"passport,selfie"`         // 送出的證件類型
+	ReviewerNote string   `json:"reviewerNote" example:"The photo is blurred"` // 審核備註, 駁回時為駁回原因
+	SubmittedAt  int64    `json:"submittedAt"`                                 // 送出時間(unix秒)
+	ReviewedAt   int64    `json:"reviewedAt"`                                  // 審核時間(unix秒), 未審核時為0
+}
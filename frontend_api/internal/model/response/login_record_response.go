@@ -0,0 +1,25 @@
+package response
+
+// LoginRecordResponse is a login attempt of the user
+type LoginRecordResponse struct {
+	UserId      int64  `json:"userId,omitempty"` // Only for the login records of the merchant
+	Ip          string `json:"ip"`
+	Browser     string `json:"browser"`
+	BrowserVer  string `json:"browserVer"`
+	Os          string `json:"os"`
+	Platform    string `json:"platform"`
+	IsMobile    bool   `json:"isMobile"`
+	Country     string `json:"country"`
+	CountryCode string `json:"countryCode"`
+	City        string `json:"city"`
+	IsSuccess   bool   `json:"isSuccess"`
+	CreateAt    int64  `json:"createAt"` // Unix seconds of the login
+}
+
+// LoginRecordListResponse is a page of the login records, ordered from the latest
+type LoginRecordListResponse struct {
+	Records  []LoginRecordResponse `json:"records"`
+	Total    int64                 `json:"total"` // The number of the records matching the filter
+	Page     int32                 `json:"page"`
+	PageSize int32                 `json:"pageSize"`
+}
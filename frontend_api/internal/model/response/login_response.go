@@ -8,10 +8,15 @@ type LoignPassResponse struct {
 }
 
 // 判斷：會員使用從未登入過的瀏覽器，且IP地址變更到不同縣市，兩者缺一不可
+// 通過 unusualLogin 驗證後以 pendingLoginToken 完成登入
 type LoginAnomalousResponse struct {
 	Email        string `json:"email"`
 	CountryCode  string `json:"countryCode"`
 	MobileNumber string `json:"mobileNumber"`
+	// 驗證時帶入以完成登入
+	PendingLoginToken string `json:"pendingLoginToken"`
+	// pendingLoginToken 有效秒數
+	ExpireSecs int64 `json:"expireSecs"`
 }
 
 // if failed
@@ -20,4 +25,14 @@ type LoginErrorResponse struct {
 	ErrorCount int `json:"errorCount"`
 	// 總共幾次機會
 	TotalAttempts int `json:"totalAttempts"`
+	// 達到錯誤次數上限時帳號的解鎖時間(unix秒), 未設定鎖定時間時需由管理員解鎖
+	LockedUntil int64 `json:"lockedUntil,omitempty"`
+}
+
+// 帳號被鎖定, 未設定解鎖時間時需由管理員解鎖
+type LoginLockedResponse struct {
+	// 解鎖時間(unix秒)
+	LockedUntil int64 `json:"lockedUntil,omitempty"`
+	// 剩餘鎖定秒數, 可用於顯示倒數
+	RemainingSecs int64 `json:"remainingSecs,omitempty"`
 }
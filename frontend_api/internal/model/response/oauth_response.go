@@ -0,0 +1,7 @@
+package response
+
+// 綁定成功的第三方帳號
+type LinkOAuthResponse struct {
+	Provider string `json:"provider"`
+	OpenID   string `json:"openID"`
+}
@@ -0,0 +1,9 @@
+package response
+
+// 玩家目前的聯絡資訊
+type ProfileResponse struct {
+	Account      string `json:"account"`
+	Email        string `json:"email"`
+	CountryCode  string `json:"countryCode"`
+	MobileNumber string `json:"mobileNumber"`
+}
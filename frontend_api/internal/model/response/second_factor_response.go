@@ -0,0 +1,19 @@
+package response
+
+// SecondFactorChallengeResponse is the data of the login error when the second factor is required
+type SecondFactorChallengeResponse struct {
+	ChallengeToken string `json:"challengeToken"` // Used to complete the login with the code
+	ExpireSecs     int    `json:"expireSecs"`
+	Enrolled       bool   `json:"enrolled"`             // False when the client requires two-factor authentication but the user has no authenticator yet
+	Secret         string `json:"secret,omitempty"`     // Only returned when the user is not enrolled
+	OtpauthUri     string `json:"otpauthUri,omitempty"` // Only returned when the user is not enrolled
+}
+
+type TotpEnrollResponse struct {
+	Secret     string `json:"secret"`
+	OtpauthUri string `json:"otpauthUri"` // Shown as a QR code for the authenticator apps
+}
+
+type TotpConfirmResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"` // Each code can be used once instead of a TOTP code
+}
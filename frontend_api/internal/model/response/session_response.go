@@ -0,0 +1,15 @@
+package response
+
+// SessionResponse is a logged in device of the user
+type SessionResponse struct {
+	SessionId    string `json:"sessionId"`
+	Ip           string `json:"ip"`
+	Browser      string `json:"browser"`
+	BrowserVer   string `json:"browserVer"`
+	Os           string `json:"os"`
+	Platform     string `json:"platform"`
+	IsMobile     bool   `json:"isMobile"`
+	CreateAt     int64  `json:"createAt"`     // Unix seconds of the login
+	LastActiveAt int64  `json:"lastActiveAt"` // Unix seconds of the last refresh
+	IsCurrent    bool   `json:"isCurrent"`    // The session of the current access token
+}
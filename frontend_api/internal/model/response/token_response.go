@@ -3,4 +3,11 @@ package response
 // TokenResponse is the response for `ClientAuth“ and `Login` related APIs
 type TokenResponse struct {
 	AccessToken string `json:"accessToken"`
+	// RefreshToken is only returned when a user logs in or refreshes the token
+	RefreshToken           string `json:"refreshToken,omitempty"`
+	RefreshTokenExpireSecs int64  `json:"refreshTokenExpireSecs,omitempty"`
+	// RecoveryCodes is only returned when the user enables TOTP during the login
+	RecoveryCodes []string `json:"recoveryCodes,omitempty"`
+	// PasswordExpired is true when the password is older than the max age of the password policy
+	PasswordExpired bool `json:"passwordExpired,omitempty"`
 }
@@ -0,0 +1,21 @@
+package response
+
+// UserListItemResponse is a user found by the customer support
+type UserListItemResponse struct {
+	UserId       int64  `json:"userId"`
+	Account      string `json:"account"`
+	Email        string `json:"email"`
+	CountryCode  string `json:"countryCode"`
+	MobileNumber string `json:"mobileNumber"`
+	Status       int32  `json:"status"` // 1: active 2: locked
+	RoleId       int64  `json:"roleId"`
+	RoleName     string `json:"roleName"`
+	ClientId     int64  `json:"clientId"`
+	CreatedAt    int64  `json:"createdAt"` // Unix seconds of the registration
+}
+
+// UserSearchResponse is a page of the users, pass the nextCursor to get the next page
+type UserSearchResponse struct {
+	Users      []UserListItemResponse `json:"users"`
+	NextCursor string                 `json:"nextCursor"` // Empty on the last page
+}
@@ -11,6 +11,11 @@ type VerificationResponse struct {
 	Email        string `json:"email,omitempty"`
 	CountryCode  string `json:"countryCode,omitempty"`
 	MobileNumber string `json:"mobileNumber,omitempty"`
+	// Only returned for forgotPwd, used to reset the password once
+	ResetToken           string `json:"resetToken,omitempty"`
+	ResetTokenExpireSecs int64  `json:"resetTokenExpireSecs,omitempty"`
+	// Only returned for unusualLogin, the tokens of the completed login
+	Login *TokenResponse `json:"login,omitempty"`
 }
 
 // if verification failed
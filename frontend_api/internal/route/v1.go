@@ -4,6 +4,7 @@ import (
 	v1_handler "go_micro_service_api/frontend_api/internal/api/v1"
 	"go_micro_service_api/frontend_api/internal/config"
 	"go_micro_service_api/frontend_api/internal/middleware/auth"
+	"go_micro_service_api/pkg/enum"
 	"go_micro_service_api/pkg/helper"
 
 	"github.com/gin-gonic/gin"
@@ -70,11 +71,14 @@ func (r *RouteV1) RegisterRoutes(g *gin.Engine) {
 	addSwaggerRouters(g)
 	r.addAuthRoutes(v1)
 	r.addUserRoutes(v1)
+	r.addBackendUserRoutes(v1)
 }
 
 func (r *RouteV1) addAuthRoutes(g *gin.RouterGroup) {
 	auth := g.Group("/auth")
 	auth.GET("", r.authHandler.ClientAuth)
+	auth.POST("/refresh", r.authHandler.RefreshToken)
+	auth.GET("/jwks", r.authHandler.Jwks)
 }
 
 func (r *RouteV1) addUserRoutes(g *gin.RouterGroup) {
@@ -82,6 +86,28 @@ func (r *RouteV1) addUserRoutes(g *gin.RouterGroup) {
 	auth.POST("", r.userHandler.CreateUser)
 	auth.GET("/verificationCode", r.verifyHandler.RegisterVerification)
 	auth.POST("/verification", r.verifyHandler.Verification)
+	auth.POST("/password/reset", r.userHandler.ResetPassword)
 	auth.GET("/existence", r.userHandler.CheckUserExistence)
 	auth.POST("/login", r.authHandler.Login)
+	auth.POST("/login/secondFactor", r.authHandler.VerifySecondFactor)
+	auth.POST("/login/oauth", r.userHandler.OAuthLogin)
+	auth.POST("/logout", r.authHandler.Logout)
+	auth.GET("/me/sessions", r.authHandler.ListSessions)
+	auth.DELETE("/me/sessions/:sessionId", r.authHandler.RevokeSession)
+	auth.POST("/me/totp", r.authHandler.EnrollTotp)
+	auth.POST("/me/totp/confirmation", r.authHandler.ConfirmTotp)
+	auth.PUT("/me/password", r.userHandler.ChangePassword)
+	auth.GET("/me/logins", r.userHandler.ListLoginRecords)
+	auth.POST("/me/oauth", r.userHandler.LinkOAuth)
+	auth.DELETE("/me/oauth/:provider", r.userHandler.UnlinkOAuth)
+	auth.PUT("/me/profile", r.userHandler.UpdateProfile)
+	auth.POST("/me/kyc", r.userHandler.SubmitKyc)
+	auth.GET("/me/kyc", r.userHandler.GetKycSubmission)
+}
+
+// addBackendUserRoutes adds the user routes of the backend, they are guarded by the backend permissions
+func (r *RouteV1) addBackendUserRoutes(g *gin.RouterGroup) {
+	users := g.Group("/users")
+	users.GET("", auth.Guard(auth.WithPerms(enum.PermissionType.SearchUser)), r.userHandler.SearchUsers)
+	users.GET("/logins", auth.Guard(auth.WithPerms(enum.PermissionType.ViewLoginRecord)), r.userHandler.ListMerchantLoginRecords)
 }
@@ -1,5 +1,13 @@
 package tests
 
+import (
+	"go_micro_service_api/frontend_api/internal/model/request"
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
 // func TestRegisterRequest(t *testing.T) {
 // 	validate := validator.New()
 
@@ -57,3 +65,64 @@ package tests
 // 		})
 // 	}
 // }
+
+func TestVerificationRequest(t *testing.T) {
+	newRequest := func(email, countryCode, mobileNumber string) request.VerificationRequest {
+		return request.VerificationRequest{
+			Type:                   "forgotPwd",
+			Email:                  email,
+			CountryCode:            countryCode,
+			MobileNumber:           mobileNumber,
+			VerificationCodePrefix: "HWP",
+			VerificationCode:       "123423",
+			VerificationCodeToken:  "1232321231",
+		}
+	}
+
+	for _, tc := range []struct {
+		name     string
+		req      any
+		expected bool
+	}{
+		{
+			name:     "verify with email",
+			req:      newRequest("test@cus.tw", "", ""),
+			expected: true,
+		},
+		{
+			name:     "verify with mobile number",
+			req:      newRequest("", "886", "912345678"),
+			expected: true,
+		},
+		{
+			name:     "verify with both email and mobile number",
+			req:      newRequest("test@cus.tw", "886", "912345678"),
+			expected: false,
+		},
+		{
+			name:     "verify with email and country code",
+			req:      newRequest("test@cus.tw", "886", ""),
+			expected: false,
+		},
+		{
+			name:     "verify without identifier",
+			req:      newRequest("", "", ""),
+			expected: false,
+		},
+		{
+			name:     "register verification with both email and mobile number",
+			req:      request.RegisterVerificationRequest{Email: "test@cus.tw", CountryCode: "886", MobileNumber: "912345678"},
+			expected: false,
+		},
+		{
+			name:     "register verification with mobile number",
+			req:      request.RegisterVerificationRequest{CountryCode: "886", MobileNumber: "912345678"},
+			expected: true,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := binding.Validator.ValidateStruct(tc.req)
+			assert.Equal(t, tc.expected, err == nil, err)
+		})
+	}
+}
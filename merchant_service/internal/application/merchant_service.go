@@ -0,0 +1,182 @@
+package application
+
+import (
+	"context"
+	"go_micro_service_api/merchant_service/internal/domain/aggregate"
+	"go_micro_service_api/merchant_service/internal/domain/service"
+	"go_micro_service_api/merchant_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/pb/gen/merchant"
+)
+
+type MerchantService struct {
+	merchant.UnimplementedMerchantServiceServer
+	merchantService *service.MerchantService
+	db              db.Database
+}
+
+var _ merchant.MerchantServiceServer = (*MerchantService)(nil)
+
+func NewMerchantService(merchantService *service.MerchantService, db db.Database) *MerchantService {
+	return &MerchantService{
+		merchantService: merchantService,
+		db:              db,
+	}
+}
+
+func (m *MerchantService) CreateMerchant(ctx context.Context, req *merchant.CreateMerchantRequest) (res *merchant.MerchantInfo, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Convert status
+	status, cusErr := enum.MerchantStatusFromInt(int(req.GetStatus()))
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Begin transaction
+	ctx, cusErr = m.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := m.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := m.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Create merchant
+	created, cusErr := m.merchantService.CreateMerchant(ctx, vo.MerchantInfo{
+		Name:        req.GetMerchantName(),
+		FrontDomain: req.GetFrontDomain(),
+		BackDomain:  req.GetBackDomain(),
+		Currencies:  req.GetCurrencies(),
+		Status:      status,
+	})
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return toMerchantInfo(created), nil
+}
+
+func (m *MerchantService) UpdateMerchantStatus(ctx context.Context, req *merchant.UpdateMerchantStatusRequest) (res *merchant.UpdateMerchantStatusResponse, err error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Convert status
+	status, cusErr := enum.MerchantStatusFromInt(int(req.GetStatus()))
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Begin transaction
+	ctx, cusErr = m.db.Begin(ctx)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+	defer func() {
+		// If there is an error, rollback the transaction
+		if err != nil {
+			_, rollbackErr := m.db.Rollback(ctx)
+			if rollbackErr != nil {
+				cus_otel.Error(ctx, rollbackErr.Error())
+				err = rollbackErr
+			}
+			return
+		}
+
+		// Commit the transaction
+		_, commitErr := m.db.Commit(ctx)
+		if commitErr != nil {
+			cus_otel.Error(ctx, commitErr.Error())
+			err = commitErr
+		}
+	}()
+
+	// Update merchant status
+	updated, cusErr := m.merchantService.UpdateMerchantStatus(ctx, req.GetMerchantId(), status)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	return &merchant.UpdateMerchantStatusResponse{
+		Status: merchant.Status(updated.Status.Int()),
+	}, nil
+}
+
+func (m *MerchantService) GetMechant(ctx context.Context, req *merchant.GetMerchantRequest) (*merchant.MerchantInfo, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get merchant
+	found, err := m.merchantService.GetMerchant(ctx, req.GetMerchantId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toMerchantInfo(found), nil
+}
+
+func (m *MerchantService) ValidClient(ctx context.Context, req *merchant.ValidClientRequest) (*merchant.ValidClientResponse, error) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Convert client type
+	var clientType enum.Client
+	switch req.GetClientType() {
+	case merchant.ClientType_FRONT:
+		clientType = enum.ClientType.Frontend
+	case merchant.ClientType_BACK:
+		clientType = enum.ClientType.Backend
+	default:
+		err := cus_err.New(cus_err.InvalidArgument, "invalid client type")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Valid client secret
+	isValid, err := m.merchantService.ValidClient(ctx, req.GetClientId(), clientType, req.GetClientSecret())
+	if err != nil {
+		return nil, err
+	}
+
+	return &merchant.ValidClientResponse{
+		IsValid: isValid,
+	}, nil
+}
+
+func toMerchantInfo(m *aggregate.Merchant) *merchant.MerchantInfo {
+	return &merchant.MerchantInfo{
+		MerchantId:   m.Id,
+		MerchantName: m.Name,
+		FrontDomain:  m.FrontDomain,
+		FrontSecret:  m.FrontSecret,
+		BackDomain:   m.BackDomain,
+		BackSecret:   m.BackSecret,
+		Currencies:   m.Currencies,
+		Status:       merchant.Status(m.Status.Int()),
+	}
+}
@@ -0,0 +1,38 @@
+package aggregate
+
+import (
+	"crypto/subtle"
+	"go_micro_service_api/pkg/enum"
+)
+
+type Merchant struct {
+	Id          int64
+	Name        string
+	FrontDomain string
+	FrontSecret string
+	BackDomain  string
+	BackSecret  string
+	Currencies  []string
+	Status      enum.MerchantStatus
+}
+
+// Secret returns the secret of the given client type, empty if the type is unknown
+func (m *Merchant) Secret(clientType enum.Client) string {
+	switch clientType {
+	case enum.ClientType.Frontend:
+		return m.FrontSecret
+	case enum.ClientType.Backend:
+		return m.BackSecret
+	default:
+		return ""
+	}
+}
+
+// ValidSecret checks the secret against the secret of the given client type in constant time
+func (m *Merchant) ValidSecret(clientType enum.Client, secret string) bool {
+	expected := m.Secret(clientType)
+	if expected == "" || secret == "" {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(secret)) == 1
+}
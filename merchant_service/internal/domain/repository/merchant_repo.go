@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/merchant_service/internal/domain/aggregate"
+	"go_micro_service_api/pkg/cus_err"
+)
+
+type MerchantRepo interface {
+	Create(ctx context.Context, merchant *aggregate.Merchant) (*aggregate.Merchant, *cus_err.CusError)
+	Find(ctx context.Context, id int64) (*aggregate.Merchant, *cus_err.CusError)
+	Update(ctx context.Context, merchant *aggregate.Merchant) (*aggregate.Merchant, *cus_err.CusError)
+}
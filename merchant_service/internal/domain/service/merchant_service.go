@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"go_micro_service_api/merchant_service/internal/domain/aggregate"
+	"go_micro_service_api/merchant_service/internal/domain/repository"
+	"go_micro_service_api/merchant_service/internal/domain/vo"
+	"go_micro_service_api/pkg/cus_crypto"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/helper"
+)
+
+const secretLength = 32
+
+type MerchantService struct {
+	merchantRepo repository.MerchantRepo
+	crypto       cus_crypto.CusCrypto
+	snowflake    *helper.Snowflake
+}
+
+func NewMerchantService(merchantRepo repository.MerchantRepo, snowflake *helper.Snowflake) *MerchantService {
+	return &MerchantService{
+		merchantRepo: merchantRepo,
+		crypto:       cus_crypto.New(),
+		snowflake:    snowflake,
+	}
+}
+
+func (m *MerchantService) CreateMerchant(ctx context.Context, info vo.MerchantInfo) (*aggregate.Merchant, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Validate parameters
+	if info.Name == "" || info.FrontDomain == "" || info.BackDomain == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "merchant name, front domain and back domain are required")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+	if info.FrontDomain == info.BackDomain {
+		err := cus_err.New(cus_err.InvalidArgument, "front domain and back domain must be different")
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	// Generate secrets for the front and back clients
+	frontSecret, err := m.generateSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+	backSecret, err := m.generateSecret(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	merchant := &aggregate.Merchant{
+		Id:          m.snowflake.NextID(),
+		Name:        info.Name,
+		FrontDomain: info.FrontDomain,
+		FrontSecret: frontSecret,
+		BackDomain:  info.BackDomain,
+		BackSecret:  backSecret,
+		Currencies:  info.Currencies,
+		Status:      info.Status,
+	}
+
+	return m.merchantRepo.Create(ctx, merchant)
+}
+
+func (m *MerchantService) UpdateMerchantStatus(ctx context.Context, id int64, status enum.MerchantStatus) (*aggregate.Merchant, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Find merchant
+	merchant, err := m.merchantRepo.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update status
+	merchant.Status = status
+	return m.merchantRepo.Update(ctx, merchant)
+}
+
+func (m *MerchantService) GetMerchant(ctx context.Context, id int64) (*aggregate.Merchant, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	return m.merchantRepo.Find(ctx, id)
+}
+
+// ValidClient checks the secret of the merchant's front or back client.
+// Inactive merchants have no valid clients.
+func (m *MerchantService) ValidClient(ctx context.Context, id int64, clientType enum.Client, secret string) (bool, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Find merchant
+	merchant, err := m.merchantRepo.Find(ctx, id)
+	if err != nil {
+		if err.Code() == cus_err.ResourceNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if merchant.Status == enum.MerchantStatusType.Inactive {
+		cus_otel.Warn(ctx, "merchant is inactive")
+		return false, nil
+	}
+
+	return merchant.ValidSecret(clientType, secret), nil
+}
+
+func (m *MerchantService) generateSecret(ctx context.Context) (string, *cus_err.CusError) {
+	secretByte, err := m.crypto.GenerateRandomSecret(ctx, secretLength)
+	if err != nil {
+		return "", err
+	}
+	return m.crypto.EncodeHex(ctx, secretByte), nil
+}
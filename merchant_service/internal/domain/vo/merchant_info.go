@@ -0,0 +1,11 @@
+package vo
+
+import "go_micro_service_api/pkg/enum"
+
+type MerchantInfo struct {
+	Name        string
+	FrontDomain string
+	BackDomain  string
+	Currencies  []string
+	Status      enum.MerchantStatus
+}
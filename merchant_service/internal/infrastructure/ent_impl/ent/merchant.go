@@ -0,0 +1,197 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl/ent/merchant"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Merchant information, including the domains and secrets of its clients
+type Merchant struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int64 `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Domain of the frontend (player) site
+	FrontDomain string `json:"front_domain,omitempty"`
+	// Secret used by the frontend client
+	FrontSecret string `json:"-"`
+	// Domain of the backend (admin) site
+	BackDomain string `json:"back_domain,omitempty"`
+	// Secret used by the backend client
+	BackSecret string `json:"-"`
+	// Currencies holds the value of the "currencies" field.
+	Currencies []string `json:"currencies,omitempty"`
+	// 0: inactive, 1: active, 2: maintenance
+	Status       int `json:"status,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Merchant) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case merchant.FieldCurrencies:
+			values[i] = new([]byte)
+		case merchant.FieldID, merchant.FieldStatus:
+			values[i] = new(sql.NullInt64)
+		case merchant.FieldName, merchant.FieldFrontDomain, merchant.FieldFrontSecret, merchant.FieldBackDomain, merchant.FieldBackSecret:
+			values[i] = new(sql.NullString)
+		case merchant.FieldCreatedAt, merchant.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Merchant fields.
+func (m *Merchant) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case merchant.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			m.ID = int64(value.Int64)
+		case merchant.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				m.CreatedAt = value.Time
+			}
+		case merchant.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				m.UpdatedAt = value.Time
+			}
+		case merchant.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				m.Name = value.String
+			}
+		case merchant.FieldFrontDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field front_domain", values[i])
+			} else if value.Valid {
+				m.FrontDomain = value.String
+			}
+		case merchant.FieldFrontSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field front_secret", values[i])
+			} else if value.Valid {
+				m.FrontSecret = value.String
+			}
+		case merchant.FieldBackDomain:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field back_domain", values[i])
+			} else if value.Valid {
+				m.BackDomain = value.String
+			}
+		case merchant.FieldBackSecret:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field back_secret", values[i])
+			} else if value.Valid {
+				m.BackSecret = value.String
+			}
+		case merchant.FieldCurrencies:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field currencies", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &m.Currencies); err != nil {
+					return fmt.Errorf("unmarshal field currencies: %w", err)
+				}
+			}
+		case merchant.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				m.Status = int(value.Int64)
+			}
+		default:
+			m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Merchant.
+// This includes values selected through modifiers, order, etc.
+func (m *Merchant) Value(name string) (ent.Value, error) {
+	return m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Merchant.
+// Note that you need to call Merchant.Unwrap() before calling this method if this Merchant
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (m *Merchant) Update() *MerchantUpdateOne {
+	return NewMerchantClient(m.config).UpdateOne(m)
+}
+
+// Unwrap unwraps the Merchant entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (m *Merchant) Unwrap() *Merchant {
+	_tx, ok := m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Merchant is not a transactional entity")
+	}
+	m.config.driver = _tx.drv
+	return m
+}
+
+// String implements the fmt.Stringer.
+func (m *Merchant) String() string {
+	var builder strings.Builder
+	builder.WriteString("Merchant(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", m.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("front_domain=")
+	builder.WriteString(m.FrontDomain)
+	builder.WriteString(", ")
+	builder.WriteString("front_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("back_domain=")
+	builder.WriteString(m.BackDomain)
+	builder.WriteString(", ")
+	builder.WriteString("back_secret=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("currencies=")
+	builder.WriteString(fmt.Sprintf("%v", m.Currencies))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", m.Status))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Merchants is a parsable slice of Merchant.
+type Merchants []*Merchant
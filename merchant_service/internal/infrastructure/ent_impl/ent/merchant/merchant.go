@@ -0,0 +1,121 @@
+// Code generated by ent, DO NOT EDIT.
+
+package merchant
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the merchant type in the database.
+	Label = "merchant"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldFrontDomain holds the string denoting the front_domain field in the database.
+	FieldFrontDomain = "front_domain"
+	// FieldFrontSecret holds the string denoting the front_secret field in the database.
+	FieldFrontSecret = "front_secret"
+	// FieldBackDomain holds the string denoting the back_domain field in the database.
+	FieldBackDomain = "back_domain"
+	// FieldBackSecret holds the string denoting the back_secret field in the database.
+	FieldBackSecret = "back_secret"
+	// FieldCurrencies holds the string denoting the currencies field in the database.
+	FieldCurrencies = "currencies"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// Table holds the table name of the merchant in the database.
+	Table = "merchants"
+)
+
+// Columns holds all SQL columns for merchant fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldName,
+	FieldFrontDomain,
+	FieldFrontSecret,
+	FieldBackDomain,
+	FieldBackSecret,
+	FieldCurrencies,
+	FieldStatus,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus int
+)
+
+// OrderOption defines the ordering options for the Merchant queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByFrontDomain orders the results by the front_domain field.
+func ByFrontDomain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrontDomain, opts...).ToFunc()
+}
+
+// ByFrontSecret orders the results by the front_secret field.
+func ByFrontSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFrontSecret, opts...).ToFunc()
+}
+
+// ByBackDomain orders the results by the back_domain field.
+func ByBackDomain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackDomain, opts...).ToFunc()
+}
+
+// ByBackSecret orders the results by the back_secret field.
+func ByBackSecret(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBackSecret, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package merchant
+
+import (
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int64) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldName, v))
+}
+
+// FrontDomain applies equality check predicate on the "front_domain" field. It's identical to FrontDomainEQ.
+func FrontDomain(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldFrontDomain, v))
+}
+
+// FrontSecret applies equality check predicate on the "front_secret" field. It's identical to FrontSecretEQ.
+func FrontSecret(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldFrontSecret, v))
+}
+
+// BackDomain applies equality check predicate on the "back_domain" field. It's identical to BackDomainEQ.
+func BackDomain(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldBackDomain, v))
+}
+
+// BackSecret applies equality check predicate on the "back_secret" field. It's identical to BackSecretEQ.
+func BackSecret(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldBackSecret, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldStatus, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContainsFold(FieldName, v))
+}
+
+// FrontDomainEQ applies the EQ predicate on the "front_domain" field.
+func FrontDomainEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldFrontDomain, v))
+}
+
+// FrontDomainNEQ applies the NEQ predicate on the "front_domain" field.
+func FrontDomainNEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldFrontDomain, v))
+}
+
+// FrontDomainIn applies the In predicate on the "front_domain" field.
+func FrontDomainIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldFrontDomain, vs...))
+}
+
+// FrontDomainNotIn applies the NotIn predicate on the "front_domain" field.
+func FrontDomainNotIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldFrontDomain, vs...))
+}
+
+// FrontDomainGT applies the GT predicate on the "front_domain" field.
+func FrontDomainGT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldFrontDomain, v))
+}
+
+// FrontDomainGTE applies the GTE predicate on the "front_domain" field.
+func FrontDomainGTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldFrontDomain, v))
+}
+
+// FrontDomainLT applies the LT predicate on the "front_domain" field.
+func FrontDomainLT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldFrontDomain, v))
+}
+
+// FrontDomainLTE applies the LTE predicate on the "front_domain" field.
+func FrontDomainLTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldFrontDomain, v))
+}
+
+// FrontDomainContains applies the Contains predicate on the "front_domain" field.
+func FrontDomainContains(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContains(FieldFrontDomain, v))
+}
+
+// FrontDomainHasPrefix applies the HasPrefix predicate on the "front_domain" field.
+func FrontDomainHasPrefix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasPrefix(FieldFrontDomain, v))
+}
+
+// FrontDomainHasSuffix applies the HasSuffix predicate on the "front_domain" field.
+func FrontDomainHasSuffix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasSuffix(FieldFrontDomain, v))
+}
+
+// FrontDomainEqualFold applies the EqualFold predicate on the "front_domain" field.
+func FrontDomainEqualFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEqualFold(FieldFrontDomain, v))
+}
+
+// FrontDomainContainsFold applies the ContainsFold predicate on the "front_domain" field.
+func FrontDomainContainsFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContainsFold(FieldFrontDomain, v))
+}
+
+// FrontSecretEQ applies the EQ predicate on the "front_secret" field.
+func FrontSecretEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldFrontSecret, v))
+}
+
+// FrontSecretNEQ applies the NEQ predicate on the "front_secret" field.
+func FrontSecretNEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldFrontSecret, v))
+}
+
+// FrontSecretIn applies the In predicate on the "front_secret" field.
+func FrontSecretIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldFrontSecret, vs...))
+}
+
+// FrontSecretNotIn applies the NotIn predicate on the "front_secret" field.
+func FrontSecretNotIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldFrontSecret, vs...))
+}
+
+// FrontSecretGT applies the GT predicate on the "front_secret" field.
+func FrontSecretGT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldFrontSecret, v))
+}
+
+// FrontSecretGTE applies the GTE predicate on the "front_secret" field.
+func FrontSecretGTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldFrontSecret, v))
+}
+
+// FrontSecretLT applies the LT predicate on the "front_secret" field.
+func FrontSecretLT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldFrontSecret, v))
+}
+
+// FrontSecretLTE applies the LTE predicate on the "front_secret" field.
+func FrontSecretLTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldFrontSecret, v))
+}
+
+// FrontSecretContains applies the Contains predicate on the "front_secret" field.
+func FrontSecretContains(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContains(FieldFrontSecret, v))
+}
+
+// FrontSecretHasPrefix applies the HasPrefix predicate on the "front_secret" field.
+func FrontSecretHasPrefix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasPrefix(FieldFrontSecret, v))
+}
+
+// FrontSecretHasSuffix applies the HasSuffix predicate on the "front_secret" field.
+func FrontSecretHasSuffix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasSuffix(FieldFrontSecret, v))
+}
+
+// FrontSecretEqualFold applies the EqualFold predicate on the "front_secret" field.
+func FrontSecretEqualFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEqualFold(FieldFrontSecret, v))
+}
+
+// FrontSecretContainsFold applies the ContainsFold predicate on the "front_secret" field.
+func FrontSecretContainsFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContainsFold(FieldFrontSecret, v))
+}
+
+// BackDomainEQ applies the EQ predicate on the "back_domain" field.
+func BackDomainEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldBackDomain, v))
+}
+
+// BackDomainNEQ applies the NEQ predicate on the "back_domain" field.
+func BackDomainNEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldBackDomain, v))
+}
+
+// BackDomainIn applies the In predicate on the "back_domain" field.
+func BackDomainIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldBackDomain, vs...))
+}
+
+// BackDomainNotIn applies the NotIn predicate on the "back_domain" field.
+func BackDomainNotIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldBackDomain, vs...))
+}
+
+// BackDomainGT applies the GT predicate on the "back_domain" field.
+func BackDomainGT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldBackDomain, v))
+}
+
+// BackDomainGTE applies the GTE predicate on the "back_domain" field.
+func BackDomainGTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldBackDomain, v))
+}
+
+// BackDomainLT applies the LT predicate on the "back_domain" field.
+func BackDomainLT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldBackDomain, v))
+}
+
+// BackDomainLTE applies the LTE predicate on the "back_domain" field.
+func BackDomainLTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldBackDomain, v))
+}
+
+// BackDomainContains applies the Contains predicate on the "back_domain" field.
+func BackDomainContains(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContains(FieldBackDomain, v))
+}
+
+// BackDomainHasPrefix applies the HasPrefix predicate on the "back_domain" field.
+func BackDomainHasPrefix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasPrefix(FieldBackDomain, v))
+}
+
+// BackDomainHasSuffix applies the HasSuffix predicate on the "back_domain" field.
+func BackDomainHasSuffix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasSuffix(FieldBackDomain, v))
+}
+
+// BackDomainEqualFold applies the EqualFold predicate on the "back_domain" field.
+func BackDomainEqualFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEqualFold(FieldBackDomain, v))
+}
+
+// BackDomainContainsFold applies the ContainsFold predicate on the "back_domain" field.
+func BackDomainContainsFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContainsFold(FieldBackDomain, v))
+}
+
+// BackSecretEQ applies the EQ predicate on the "back_secret" field.
+func BackSecretEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldBackSecret, v))
+}
+
+// BackSecretNEQ applies the NEQ predicate on the "back_secret" field.
+func BackSecretNEQ(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldBackSecret, v))
+}
+
+// BackSecretIn applies the In predicate on the "back_secret" field.
+func BackSecretIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldBackSecret, vs...))
+}
+
+// BackSecretNotIn applies the NotIn predicate on the "back_secret" field.
+func BackSecretNotIn(vs ...string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldBackSecret, vs...))
+}
+
+// BackSecretGT applies the GT predicate on the "back_secret" field.
+func BackSecretGT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldBackSecret, v))
+}
+
+// BackSecretGTE applies the GTE predicate on the "back_secret" field.
+func BackSecretGTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldBackSecret, v))
+}
+
+// BackSecretLT applies the LT predicate on the "back_secret" field.
+func BackSecretLT(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldBackSecret, v))
+}
+
+// BackSecretLTE applies the LTE predicate on the "back_secret" field.
+func BackSecretLTE(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldBackSecret, v))
+}
+
+// BackSecretContains applies the Contains predicate on the "back_secret" field.
+func BackSecretContains(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContains(FieldBackSecret, v))
+}
+
+// BackSecretHasPrefix applies the HasPrefix predicate on the "back_secret" field.
+func BackSecretHasPrefix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasPrefix(FieldBackSecret, v))
+}
+
+// BackSecretHasSuffix applies the HasSuffix predicate on the "back_secret" field.
+func BackSecretHasSuffix(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldHasSuffix(FieldBackSecret, v))
+}
+
+// BackSecretEqualFold applies the EqualFold predicate on the "back_secret" field.
+func BackSecretEqualFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEqualFold(FieldBackSecret, v))
+}
+
+// BackSecretContainsFold applies the ContainsFold predicate on the "back_secret" field.
+func BackSecretContainsFold(v string) predicate.Merchant {
+	return predicate.Merchant(sql.FieldContainsFold(FieldBackSecret, v))
+}
+
+// CurrenciesIsNil applies the IsNil predicate on the "currencies" field.
+func CurrenciesIsNil() predicate.Merchant {
+	return predicate.Merchant(sql.FieldIsNull(FieldCurrencies))
+}
+
+// CurrenciesNotNil applies the NotNil predicate on the "currencies" field.
+func CurrenciesNotNil() predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotNull(FieldCurrencies))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.Merchant {
+	return predicate.Merchant(sql.FieldLTE(FieldStatus, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Merchant) predicate.Merchant {
+	return predicate.Merchant(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Merchant) predicate.Merchant {
+	return predicate.Merchant(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Merchant) predicate.Merchant {
+	return predicate.Merchant(sql.NotPredicates(p))
+}
@@ -0,0 +1,344 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl/ent/merchant"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// MerchantCreate is the builder for creating a Merchant entity.
+type MerchantCreate struct {
+	config
+	mutation *MerchantMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (mc *MerchantCreate) SetCreatedAt(t time.Time) *MerchantCreate {
+	mc.mutation.SetCreatedAt(t)
+	return mc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (mc *MerchantCreate) SetNillableCreatedAt(t *time.Time) *MerchantCreate {
+	if t != nil {
+		mc.SetCreatedAt(*t)
+	}
+	return mc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mc *MerchantCreate) SetUpdatedAt(t time.Time) *MerchantCreate {
+	mc.mutation.SetUpdatedAt(t)
+	return mc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (mc *MerchantCreate) SetNillableUpdatedAt(t *time.Time) *MerchantCreate {
+	if t != nil {
+		mc.SetUpdatedAt(*t)
+	}
+	return mc
+}
+
+// SetName sets the "name" field.
+func (mc *MerchantCreate) SetName(s string) *MerchantCreate {
+	mc.mutation.SetName(s)
+	return mc
+}
+
+// SetFrontDomain sets the "front_domain" field.
+func (mc *MerchantCreate) SetFrontDomain(s string) *MerchantCreate {
+	mc.mutation.SetFrontDomain(s)
+	return mc
+}
+
+// SetFrontSecret sets the "front_secret" field.
+func (mc *MerchantCreate) SetFrontSecret(s string) *MerchantCreate {
+	mc.mutation.SetFrontSecret(s)
+	return mc
+}
+
+// SetBackDomain sets the "back_domain" field.
+func (mc *MerchantCreate) SetBackDomain(s string) *MerchantCreate {
+	mc.mutation.SetBackDomain(s)
+	return mc
+}
+
+// SetBackSecret sets the "back_secret" field.
+func (mc *MerchantCreate) SetBackSecret(s string) *MerchantCreate {
+	mc.mutation.SetBackSecret(s)
+	return mc
+}
+
+// SetCurrencies sets the "currencies" field.
+func (mc *MerchantCreate) SetCurrencies(s []string) *MerchantCreate {
+	mc.mutation.SetCurrencies(s)
+	return mc
+}
+
+// SetStatus sets the "status" field.
+func (mc *MerchantCreate) SetStatus(i int) *MerchantCreate {
+	mc.mutation.SetStatus(i)
+	return mc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mc *MerchantCreate) SetNillableStatus(i *int) *MerchantCreate {
+	if i != nil {
+		mc.SetStatus(*i)
+	}
+	return mc
+}
+
+// SetID sets the "id" field.
+func (mc *MerchantCreate) SetID(i int64) *MerchantCreate {
+	mc.mutation.SetID(i)
+	return mc
+}
+
+// Mutation returns the MerchantMutation object of the builder.
+func (mc *MerchantCreate) Mutation() *MerchantMutation {
+	return mc.mutation
+}
+
+// Save creates the Merchant in the database.
+func (mc *MerchantCreate) Save(ctx context.Context) (*Merchant, error) {
+	mc.defaults()
+	return withHooks(ctx, mc.sqlSave, mc.mutation, mc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (mc *MerchantCreate) SaveX(ctx context.Context) *Merchant {
+	v, err := mc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mc *MerchantCreate) Exec(ctx context.Context) error {
+	_, err := mc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mc *MerchantCreate) ExecX(ctx context.Context) {
+	if err := mc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mc *MerchantCreate) defaults() {
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		v := merchant.DefaultCreatedAt()
+		mc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		v := merchant.DefaultUpdatedAt()
+		mc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := mc.mutation.Status(); !ok {
+		v := merchant.DefaultStatus
+		mc.mutation.SetStatus(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mc *MerchantCreate) check() error {
+	if _, ok := mc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Merchant.created_at"`)}
+	}
+	if _, ok := mc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Merchant.updated_at"`)}
+	}
+	if _, ok := mc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Merchant.name"`)}
+	}
+	if v, ok := mc.mutation.Name(); ok {
+		if err := merchant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Merchant.name": %w`, err)}
+		}
+	}
+	if _, ok := mc.mutation.FrontDomain(); !ok {
+		return &ValidationError{Name: "front_domain", err: errors.New(`ent: missing required field "Merchant.front_domain"`)}
+	}
+	if _, ok := mc.mutation.FrontSecret(); !ok {
+		return &ValidationError{Name: "front_secret", err: errors.New(`ent: missing required field "Merchant.front_secret"`)}
+	}
+	if _, ok := mc.mutation.BackDomain(); !ok {
+		return &ValidationError{Name: "back_domain", err: errors.New(`ent: missing required field "Merchant.back_domain"`)}
+	}
+	if _, ok := mc.mutation.BackSecret(); !ok {
+		return &ValidationError{Name: "back_secret", err: errors.New(`ent: missing required field "Merchant.back_secret"`)}
+	}
+	if _, ok := mc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Merchant.status"`)}
+	}
+	return nil
+}
+
+func (mc *MerchantCreate) sqlSave(ctx context.Context) (*Merchant, error) {
+	if err := mc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := mc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, mc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != _node.ID {
+		id := _spec.ID.Value.(int64)
+		_node.ID = int64(id)
+	}
+	mc.mutation.id = &_node.ID
+	mc.mutation.done = true
+	return _node, nil
+}
+
+func (mc *MerchantCreate) createSpec() (*Merchant, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Merchant{config: mc.config}
+		_spec = sqlgraph.NewCreateSpec(merchant.Table, sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeInt64))
+	)
+	if id, ok := mc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = id
+	}
+	if value, ok := mc.mutation.CreatedAt(); ok {
+		_spec.SetField(merchant.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := mc.mutation.UpdatedAt(); ok {
+		_spec.SetField(merchant.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := mc.mutation.Name(); ok {
+		_spec.SetField(merchant.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := mc.mutation.FrontDomain(); ok {
+		_spec.SetField(merchant.FieldFrontDomain, field.TypeString, value)
+		_node.FrontDomain = value
+	}
+	if value, ok := mc.mutation.FrontSecret(); ok {
+		_spec.SetField(merchant.FieldFrontSecret, field.TypeString, value)
+		_node.FrontSecret = value
+	}
+	if value, ok := mc.mutation.BackDomain(); ok {
+		_spec.SetField(merchant.FieldBackDomain, field.TypeString, value)
+		_node.BackDomain = value
+	}
+	if value, ok := mc.mutation.BackSecret(); ok {
+		_spec.SetField(merchant.FieldBackSecret, field.TypeString, value)
+		_node.BackSecret = value
+	}
+	if value, ok := mc.mutation.Currencies(); ok {
+		_spec.SetField(merchant.FieldCurrencies, field.TypeJSON, value)
+		_node.Currencies = value
+	}
+	if value, ok := mc.mutation.Status(); ok {
+		_spec.SetField(merchant.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	return _node, _spec
+}
+
+// MerchantCreateBulk is the builder for creating many Merchant entities in bulk.
+type MerchantCreateBulk struct {
+	config
+	err      error
+	builders []*MerchantCreate
+}
+
+// Save creates the Merchant entities in the database.
+func (mcb *MerchantCreateBulk) Save(ctx context.Context) ([]*Merchant, error) {
+	if mcb.err != nil {
+		return nil, mcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(mcb.builders))
+	nodes := make([]*Merchant, len(mcb.builders))
+	mutators := make([]Mutator, len(mcb.builders))
+	for i := range mcb.builders {
+		func(i int, root context.Context) {
+			builder := mcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*MerchantMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, mcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, mcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil && nodes[i].ID == 0 {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int64(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, mcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mcb *MerchantCreateBulk) SaveX(ctx context.Context) []*Merchant {
+	v, err := mcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (mcb *MerchantCreateBulk) Exec(ctx context.Context) error {
+	_, err := mcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mcb *MerchantCreateBulk) ExecX(ctx context.Context) {
+	if err := mcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,521 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl/ent/merchant"
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// MerchantUpdate is the builder for updating Merchant entities.
+type MerchantUpdate struct {
+	config
+	hooks    []Hook
+	mutation *MerchantMutation
+}
+
+// Where appends a list predicates to the MerchantUpdate builder.
+func (mu *MerchantUpdate) Where(ps ...predicate.Merchant) *MerchantUpdate {
+	mu.mutation.Where(ps...)
+	return mu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (mu *MerchantUpdate) SetUpdatedAt(t time.Time) *MerchantUpdate {
+	mu.mutation.SetUpdatedAt(t)
+	return mu
+}
+
+// SetName sets the "name" field.
+func (mu *MerchantUpdate) SetName(s string) *MerchantUpdate {
+	mu.mutation.SetName(s)
+	return mu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (mu *MerchantUpdate) SetNillableName(s *string) *MerchantUpdate {
+	if s != nil {
+		mu.SetName(*s)
+	}
+	return mu
+}
+
+// SetFrontDomain sets the "front_domain" field.
+func (mu *MerchantUpdate) SetFrontDomain(s string) *MerchantUpdate {
+	mu.mutation.SetFrontDomain(s)
+	return mu
+}
+
+// SetNillableFrontDomain sets the "front_domain" field if the given value is not nil.
+func (mu *MerchantUpdate) SetNillableFrontDomain(s *string) *MerchantUpdate {
+	if s != nil {
+		mu.SetFrontDomain(*s)
+	}
+	return mu
+}
+
+// SetFrontSecret sets the "front_secret" field.
+func (mu *MerchantUpdate) SetFrontSecret(s string) *MerchantUpdate {
+	mu.mutation.SetFrontSecret(s)
+	return mu
+}
+
+// SetNillableFrontSecret sets the "front_secret" field if the given value is not nil.
+func (mu *MerchantUpdate) SetNillableFrontSecret(s *string) *MerchantUpdate {
+	if s != nil {
+		mu.SetFrontSecret(*s)
+	}
+	return mu
+}
+
+// SetBackDomain sets the "back_domain" field.
+func (mu *MerchantUpdate) SetBackDomain(s string) *MerchantUpdate {
+	mu.mutation.SetBackDomain(s)
+	return mu
+}
+
+// SetNillableBackDomain sets the "back_domain" field if the given value is not nil.
+func (mu *MerchantUpdate) SetNillableBackDomain(s *string) *MerchantUpdate {
+	if s != nil {
+		mu.SetBackDomain(*s)
+	}
+	return mu
+}
+
+// SetBackSecret sets the "back_secret" field.
+func (mu *MerchantUpdate) SetBackSecret(s string) *MerchantUpdate {
+	mu.mutation.SetBackSecret(s)
+	return mu
+}
+
+// SetNillableBackSecret sets the "back_secret" field if the given value is not nil.
+func (mu *MerchantUpdate) SetNillableBackSecret(s *string) *MerchantUpdate {
+	if s != nil {
+		mu.SetBackSecret(*s)
+	}
+	return mu
+}
+
+// SetCurrencies sets the "currencies" field.
+func (mu *MerchantUpdate) SetCurrencies(s []string) *MerchantUpdate {
+	mu.mutation.SetCurrencies(s)
+	return mu
+}
+
+// AppendCurrencies appends s to the "currencies" field.
+func (mu *MerchantUpdate) AppendCurrencies(s []string) *MerchantUpdate {
+	mu.mutation.AppendCurrencies(s)
+	return mu
+}
+
+// ClearCurrencies clears the value of the "currencies" field.
+func (mu *MerchantUpdate) ClearCurrencies() *MerchantUpdate {
+	mu.mutation.ClearCurrencies()
+	return mu
+}
+
+// SetStatus sets the "status" field.
+func (mu *MerchantUpdate) SetStatus(i int) *MerchantUpdate {
+	mu.mutation.ResetStatus()
+	mu.mutation.SetStatus(i)
+	return mu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (mu *MerchantUpdate) SetNillableStatus(i *int) *MerchantUpdate {
+	if i != nil {
+		mu.SetStatus(*i)
+	}
+	return mu
+}
+
+// AddStatus adds i to the "status" field.
+func (mu *MerchantUpdate) AddStatus(i int) *MerchantUpdate {
+	mu.mutation.AddStatus(i)
+	return mu
+}
+
+// Mutation returns the MerchantMutation object of the builder.
+func (mu *MerchantUpdate) Mutation() *MerchantMutation {
+	return mu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (mu *MerchantUpdate) Save(ctx context.Context) (int, error) {
+	mu.defaults()
+	return withHooks(ctx, mu.sqlSave, mu.mutation, mu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (mu *MerchantUpdate) SaveX(ctx context.Context) int {
+	affected, err := mu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (mu *MerchantUpdate) Exec(ctx context.Context) error {
+	_, err := mu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (mu *MerchantUpdate) ExecX(ctx context.Context) {
+	if err := mu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (mu *MerchantUpdate) defaults() {
+	if _, ok := mu.mutation.UpdatedAt(); !ok {
+		v := merchant.UpdateDefaultUpdatedAt()
+		mu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (mu *MerchantUpdate) check() error {
+	if v, ok := mu.mutation.Name(); ok {
+		if err := merchant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Merchant.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (mu *MerchantUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := mu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(merchant.Table, merchant.Columns, sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeInt64))
+	if ps := mu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := mu.mutation.UpdatedAt(); ok {
+		_spec.SetField(merchant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := mu.mutation.Name(); ok {
+		_spec.SetField(merchant.FieldName, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.FrontDomain(); ok {
+		_spec.SetField(merchant.FieldFrontDomain, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.FrontSecret(); ok {
+		_spec.SetField(merchant.FieldFrontSecret, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.BackDomain(); ok {
+		_spec.SetField(merchant.FieldBackDomain, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.BackSecret(); ok {
+		_spec.SetField(merchant.FieldBackSecret, field.TypeString, value)
+	}
+	if value, ok := mu.mutation.Currencies(); ok {
+		_spec.SetField(merchant.FieldCurrencies, field.TypeJSON, value)
+	}
+	if value, ok := mu.mutation.AppendedCurrencies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, merchant.FieldCurrencies, value)
+		})
+	}
+	if mu.mutation.CurrenciesCleared() {
+		_spec.ClearField(merchant.FieldCurrencies, field.TypeJSON)
+	}
+	if value, ok := mu.mutation.Status(); ok {
+		_spec.SetField(merchant.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedStatus(); ok {
+		_spec.AddField(merchant.FieldStatus, field.TypeInt, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, mu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{merchant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	mu.mutation.done = true
+	return n, nil
+}
+
+// MerchantUpdateOne is the builder for updating a single Merchant entity.
+type MerchantUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *MerchantMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (muo *MerchantUpdateOne) SetUpdatedAt(t time.Time) *MerchantUpdateOne {
+	muo.mutation.SetUpdatedAt(t)
+	return muo
+}
+
+// SetName sets the "name" field.
+func (muo *MerchantUpdateOne) SetName(s string) *MerchantUpdateOne {
+	muo.mutation.SetName(s)
+	return muo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (muo *MerchantUpdateOne) SetNillableName(s *string) *MerchantUpdateOne {
+	if s != nil {
+		muo.SetName(*s)
+	}
+	return muo
+}
+
+// SetFrontDomain sets the "front_domain" field.
+func (muo *MerchantUpdateOne) SetFrontDomain(s string) *MerchantUpdateOne {
+	muo.mutation.SetFrontDomain(s)
+	return muo
+}
+
+// SetNillableFrontDomain sets the "front_domain" field if the given value is not nil.
+func (muo *MerchantUpdateOne) SetNillableFrontDomain(s *string) *MerchantUpdateOne {
+	if s != nil {
+		muo.SetFrontDomain(*s)
+	}
+	return muo
+}
+
+// SetFrontSecret sets the "front_secret" field.
+func (muo *MerchantUpdateOne) SetFrontSecret(s string) *MerchantUpdateOne {
+	muo.mutation.SetFrontSecret(s)
+	return muo
+}
+
+// SetNillableFrontSecret sets the "front_secret" field if the given value is not nil.
+func (muo *MerchantUpdateOne) SetNillableFrontSecret(s *string) *MerchantUpdateOne {
+	if s != nil {
+		muo.SetFrontSecret(*s)
+	}
+	return muo
+}
+
+// SetBackDomain sets the "back_domain" field.
+func (muo *MerchantUpdateOne) SetBackDomain(s string) *MerchantUpdateOne {
+	muo.mutation.SetBackDomain(s)
+	return muo
+}
+
+// SetNillableBackDomain sets the "back_domain" field if the given value is not nil.
+func (muo *MerchantUpdateOne) SetNillableBackDomain(s *string) *MerchantUpdateOne {
+	if s != nil {
+		muo.SetBackDomain(*s)
+	}
+	return muo
+}
+
+// SetBackSecret sets the "back_secret" field.
+func (muo *MerchantUpdateOne) SetBackSecret(s string) *MerchantUpdateOne {
+	muo.mutation.SetBackSecret(s)
+	return muo
+}
+
+// SetNillableBackSecret sets the "back_secret" field if the given value is not nil.
+func (muo *MerchantUpdateOne) SetNillableBackSecret(s *string) *MerchantUpdateOne {
+	if s != nil {
+		muo.SetBackSecret(*s)
+	}
+	return muo
+}
+
+// SetCurrencies sets the "currencies" field.
+func (muo *MerchantUpdateOne) SetCurrencies(s []string) *MerchantUpdateOne {
+	muo.mutation.SetCurrencies(s)
+	return muo
+}
+
+// AppendCurrencies appends s to the "currencies" field.
+func (muo *MerchantUpdateOne) AppendCurrencies(s []string) *MerchantUpdateOne {
+	muo.mutation.AppendCurrencies(s)
+	return muo
+}
+
+// ClearCurrencies clears the value of the "currencies" field.
+func (muo *MerchantUpdateOne) ClearCurrencies() *MerchantUpdateOne {
+	muo.mutation.ClearCurrencies()
+	return muo
+}
+
+// SetStatus sets the "status" field.
+func (muo *MerchantUpdateOne) SetStatus(i int) *MerchantUpdateOne {
+	muo.mutation.ResetStatus()
+	muo.mutation.SetStatus(i)
+	return muo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (muo *MerchantUpdateOne) SetNillableStatus(i *int) *MerchantUpdateOne {
+	if i != nil {
+		muo.SetStatus(*i)
+	}
+	return muo
+}
+
+// AddStatus adds i to the "status" field.
+func (muo *MerchantUpdateOne) AddStatus(i int) *MerchantUpdateOne {
+	muo.mutation.AddStatus(i)
+	return muo
+}
+
+// Mutation returns the MerchantMutation object of the builder.
+func (muo *MerchantUpdateOne) Mutation() *MerchantMutation {
+	return muo.mutation
+}
+
+// Where appends a list predicates to the MerchantUpdate builder.
+func (muo *MerchantUpdateOne) Where(ps ...predicate.Merchant) *MerchantUpdateOne {
+	muo.mutation.Where(ps...)
+	return muo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (muo *MerchantUpdateOne) Select(field string, fields ...string) *MerchantUpdateOne {
+	muo.fields = append([]string{field}, fields...)
+	return muo
+}
+
+// Save executes the query and returns the updated Merchant entity.
+func (muo *MerchantUpdateOne) Save(ctx context.Context) (*Merchant, error) {
+	muo.defaults()
+	return withHooks(ctx, muo.sqlSave, muo.mutation, muo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (muo *MerchantUpdateOne) SaveX(ctx context.Context) *Merchant {
+	node, err := muo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (muo *MerchantUpdateOne) Exec(ctx context.Context) error {
+	_, err := muo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (muo *MerchantUpdateOne) ExecX(ctx context.Context) {
+	if err := muo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (muo *MerchantUpdateOne) defaults() {
+	if _, ok := muo.mutation.UpdatedAt(); !ok {
+		v := merchant.UpdateDefaultUpdatedAt()
+		muo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (muo *MerchantUpdateOne) check() error {
+	if v, ok := muo.mutation.Name(); ok {
+		if err := merchant.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Merchant.name": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (muo *MerchantUpdateOne) sqlSave(ctx context.Context) (_node *Merchant, err error) {
+	if err := muo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(merchant.Table, merchant.Columns, sqlgraph.NewFieldSpec(merchant.FieldID, field.TypeInt64))
+	id, ok := muo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Merchant.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := muo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, merchant.FieldID)
+		for _, f := range fields {
+			if !merchant.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != merchant.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := muo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := muo.mutation.UpdatedAt(); ok {
+		_spec.SetField(merchant.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := muo.mutation.Name(); ok {
+		_spec.SetField(merchant.FieldName, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.FrontDomain(); ok {
+		_spec.SetField(merchant.FieldFrontDomain, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.FrontSecret(); ok {
+		_spec.SetField(merchant.FieldFrontSecret, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.BackDomain(); ok {
+		_spec.SetField(merchant.FieldBackDomain, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.BackSecret(); ok {
+		_spec.SetField(merchant.FieldBackSecret, field.TypeString, value)
+	}
+	if value, ok := muo.mutation.Currencies(); ok {
+		_spec.SetField(merchant.FieldCurrencies, field.TypeJSON, value)
+	}
+	if value, ok := muo.mutation.AppendedCurrencies(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, merchant.FieldCurrencies, value)
+		})
+	}
+	if muo.mutation.CurrenciesCleared() {
+		_spec.ClearField(merchant.FieldCurrencies, field.TypeJSON)
+	}
+	if value, ok := muo.mutation.Status(); ok {
+		_spec.SetField(merchant.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedStatus(); ok {
+		_spec.AddField(merchant.FieldStatus, field.TypeInt, value)
+	}
+	_node = &Merchant{config: muo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, muo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{merchant.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	muo.mutation.done = true
+	return _node, nil
+}
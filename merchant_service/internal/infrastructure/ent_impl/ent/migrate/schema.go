@@ -0,0 +1,52 @@
+// Code generated by ent, DO NOT EDIT.
+
+package migrate
+
+import (
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/dialect/sql/schema"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// MerchantsColumns holds the columns for the "merchants" table.
+	MerchantsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt64, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "front_domain", Type: field.TypeString, Comment: "Domain of the frontend (player) site"},
+		{Name: "front_secret", Type: field.TypeString, Comment: "Secret used by the frontend client"},
+		{Name: "back_domain", Type: field.TypeString, Comment: "Domain of the backend (admin) site"},
+		{Name: "back_secret", Type: field.TypeString, Comment: "Secret used by the backend client"},
+		{Name: "currencies", Type: field.TypeJSON, Nullable: true},
+		{Name: "status", Type: field.TypeInt, Comment: "0: inactive, 1: active, 2: maintenance", Default: 0},
+	}
+	// MerchantsTable holds the schema information for the "merchants" table.
+	MerchantsTable = &schema.Table{
+		Name:       "merchants",
+		Comment:    "Merchant information, including the domains and secrets of its clients",
+		Columns:    MerchantsColumns,
+		PrimaryKey: []*schema.Column{MerchantsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "merchant_front_domain",
+				Unique:  true,
+				Columns: []*schema.Column{MerchantsColumns[4]},
+			},
+			{
+				Name:    "merchant_back_domain",
+				Unique:  true,
+				Columns: []*schema.Column{MerchantsColumns[6]},
+			},
+		},
+	}
+	// Tables holds all the tables in the schema.
+	Tables = []*schema.Table{
+		MerchantsTable,
+	}
+)
+
+func init() {
+	MerchantsTable.Annotation = &entsql.Annotation{}
+}
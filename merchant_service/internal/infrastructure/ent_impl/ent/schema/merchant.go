@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Merchant holds the schema definition for the Merchant entity.
+type Merchant struct {
+	ent.Schema
+}
+
+// Mixin of the Merchant.
+func (Merchant) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the Merchant.
+func (Merchant) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("id"),
+		field.String("name").NotEmpty(),
+		field.String("front_domain").Comment("Domain of the frontend (player) site"),
+		field.String("front_secret").Sensitive().Comment("Secret used by the frontend client"),
+		field.String("back_domain").Comment("Domain of the backend (admin) site"),
+		field.String("back_secret").Sensitive().Comment("Secret used by the backend client"),
+		field.Strings("currencies").Optional(),
+		field.Int("status").Default(0).Comment("0: inactive, 1: active, 2: maintenance"),
+	}
+}
+
+// Edges of the Merchant.
+func (Merchant) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the Merchant.
+func (Merchant) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("front_domain").Unique(),
+		index.Fields("back_domain").Unique(),
+	}
+}
+
+func (Merchant) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Merchant information, including the domains and secrets of its clients"),
+	}
+}
@@ -0,0 +1,211 @@
+package ent_impl
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/merchant_service/internal/domain/aggregate"
+	"go_micro_service_api/merchant_service/internal/domain/repository"
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
+const (
+	MerchantInfoPrefix = "merchant_info"
+	// MerchantInfoTTL bounds how long a merchant and its secrets can be served from cache after they change
+	MerchantInfoTTL = 10 * time.Minute
+)
+
+type MerchantRepoImpl struct {
+	db    db.Database
+	cache db.Cache
+}
+
+var _ repository.MerchantRepo = (*MerchantRepoImpl)(nil)
+
+// NewMerchantRepoImpl creates a new instance of MerchantRepoImpl
+func NewMerchantRepoImpl(db db.Database, cache db.Cache) *MerchantRepoImpl {
+	return &MerchantRepoImpl{
+		db:    db,
+		cache: cache,
+	}
+}
+
+func (m *MerchantRepoImpl) Create(ctx context.Context, merchant *aggregate.Merchant) (*aggregate.Merchant, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction
+	tx, ok := m.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		cusErr := cus_err.New(cus_err.InternalServerError, "transaction not found in context", nil)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Create merchant
+	entity, err := tx.Merchant.Create().
+		SetID(merchant.Id).
+		SetName(merchant.Name).
+		SetFrontDomain(merchant.FrontDomain).
+		SetFrontSecret(merchant.FrontSecret).
+		SetBackDomain(merchant.BackDomain).
+		SetBackSecret(merchant.BackSecret).
+		SetCurrencies(merchant.Currencies).
+		SetStatus(merchant.Status.Int()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			cusErr := cus_err.New(cus_err.ResourceIsExist, "merchant domain already exists", err)
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to create merchant", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return m.toAggregate(ctx, entity)
+}
+
+func (m *MerchantRepoImpl) Find(ctx context.Context, id int64) (*aggregate.Merchant, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction if exists
+	var client *ent.Client
+	tx, inTx := m.db.GetTx(ctx).(*ent.Tx)
+	if inTx {
+		client = tx.Client()
+	} else {
+		client = m.db.GetConn(ctx).(*ent.Client)
+	}
+
+	// Fetch merchant from cache, skipped in a transaction since the cache may not reflect its changes
+	merchant := &aggregate.Merchant{}
+	key := fmt.Sprintf("%s:%d", MerchantInfoPrefix, id)
+	if !inTx {
+		cusErr := m.cache.GetObject(ctx, key, merchant)
+		if cusErr == nil {
+			return merchant, nil
+		}
+	}
+
+	// Find merchant
+	entity, err := client.Merchant.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			cusErr := cus_err.New(cus_err.ResourceNotFound, "merchant not found", err)
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to find merchant", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	merchant, cusErr := m.toAggregate(ctx, entity)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	// The merchant read in a transaction may be rolled back or changed, only the committed one is cached
+	if inTx {
+		return merchant, nil
+	}
+
+	// Save merchant in cache
+	cusErr = m.cache.SetObject(ctx, key, merchant, MerchantInfoTTL)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return merchant, nil
+}
+
+func (m *MerchantRepoImpl) Update(ctx context.Context, merchant *aggregate.Merchant) (*aggregate.Merchant, *cus_err.CusError) {
+	// Start trace
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	// Get client with transaction
+	tx, ok := m.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		cusErr := cus_err.New(cus_err.InternalServerError, "transaction not found in context", nil)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// Update merchant
+	entity, err := tx.Merchant.UpdateOneID(merchant.Id).
+		SetName(merchant.Name).
+		SetCurrencies(merchant.Currencies).
+		SetStatus(merchant.Status.Int()).
+		Save(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			cusErr := cus_err.New(cus_err.ResourceNotFound, "merchant not found", err)
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to update merchant", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	updated, cusErr := m.toAggregate(ctx, entity)
+	if cusErr != nil {
+		return nil, cusErr
+	}
+
+	// Remove the stale merchant from cache, it will be reloaded on next find
+	key := fmt.Sprintf("%s:%d", MerchantInfoPrefix, updated.Id)
+	cusErr = m.cache.Delete(ctx, key)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	// A find outside the transaction may cache the old row again before the commit, remove it once more after the commit
+	tx.OnCommit(func(next ent.Committer) ent.Committer {
+		return ent.CommitFunc(func(ctx context.Context, tx *ent.Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+
+			// The change is committed, the cached merchant expires with the TTL when it can't be removed
+			if cusErr := m.cache.Delete(ctx, key); cusErr != nil {
+				cus_otel.Error(ctx, cusErr.Error())
+			}
+			return nil
+		})
+	})
+
+	return updated, nil
+}
+
+func (m *MerchantRepoImpl) toAggregate(ctx context.Context, entity *ent.Merchant) (*aggregate.Merchant, *cus_err.CusError) {
+	// Map status to enum
+	status, cusErr := enum.MerchantStatusFromInt(entity.Status)
+	if cusErr != nil {
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return &aggregate.Merchant{
+		Id:          entity.ID,
+		Name:        entity.Name,
+		FrontDomain: entity.FrontDomain,
+		FrontSecret: entity.FrontSecret,
+		BackDomain:  entity.BackDomain,
+		BackSecret:  entity.BackSecret,
+		Currencies:  entity.Currencies,
+		Status:      status,
+	}, nil
+}
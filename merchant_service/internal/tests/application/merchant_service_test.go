@@ -0,0 +1,104 @@
+package application_tests
+
+import (
+	"context"
+	"go_micro_service_api/merchant_service/internal/application"
+	"go_micro_service_api/merchant_service/internal/domain/service"
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl"
+	"go_micro_service_api/merchant_service/internal/tests"
+	"go_micro_service_api/pkg/cus_err"
+	redis_cache "go_micro_service_api/pkg/db/redis"
+	"go_micro_service_api/pkg/helper"
+	"go_micro_service_api/pkg/pb/gen/merchant"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMerchantService() (merchantApp *application.MerchantService, closeFunc func()) {
+	db := tests.NewMemoryDB()
+	redis, closeFunc := tests.NewMemoryRedis()
+	cache := redis_cache.NewRedisCache(redis)
+	merchantRepo := ent_impl.NewMerchantRepoImpl(db, cache)
+	merchantService := service.NewMerchantService(merchantRepo, helper.NewSnowflake(helper.NewMachineID()))
+	merchantApp = application.NewMerchantService(merchantService, db)
+	return merchantApp, closeFunc
+}
+
+func TestMerchantService(t *testing.T) {
+	merchantApp, closeFunc := setupMerchantService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	created, err := merchantApp.CreateMerchant(ctx, &merchant.CreateMerchantRequest{
+		MerchantName: "merchant",
+		FrontDomain:  "www.merchant.com",
+		BackDomain:   "admin.merchant.com",
+		Currencies:   []string{"TWD"},
+		Status:       merchant.Status_ACTIVE,
+	})
+	require.Nil(t, err)
+	require.NotNil(t, created)
+
+	t.Run("GetMechant", func(t *testing.T) {
+		res, err := merchantApp.GetMechant(ctx, &merchant.GetMerchantRequest{MerchantId: created.MerchantId})
+		require.Nil(t, err)
+		assert.Equal(t, created.MerchantName, res.MerchantName)
+		assert.Equal(t, created.FrontSecret, res.FrontSecret)
+		assert.Equal(t, created.BackSecret, res.BackSecret)
+		assert.Equal(t, []string{"TWD"}, res.Currencies)
+		assert.Equal(t, merchant.Status_ACTIVE, res.Status)
+	})
+
+	t.Run("GetMechant not found", func(t *testing.T) {
+		_, err := merchantApp.GetMechant(ctx, &merchant.GetMerchantRequest{MerchantId: 999})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.(*cus_err.CusError).Code().Int())
+	})
+
+	t.Run("ValidClient", func(t *testing.T) {
+		res, err := merchantApp.ValidClient(ctx, &merchant.ValidClientRequest{
+			ClientId:     created.MerchantId,
+			ClientSecret: created.BackSecret,
+			ClientType:   merchant.ClientType_BACK,
+		})
+		require.Nil(t, err)
+		assert.True(t, res.IsValid)
+
+		res, err = merchantApp.ValidClient(ctx, &merchant.ValidClientRequest{
+			ClientId:     created.MerchantId,
+			ClientSecret: created.BackSecret,
+			ClientType:   merchant.ClientType_FRONT,
+		})
+		require.Nil(t, err)
+		assert.False(t, res.IsValid)
+	})
+
+	t.Run("UpdateMerchantStatus", func(t *testing.T) {
+		res, err := merchantApp.UpdateMerchantStatus(ctx, &merchant.UpdateMerchantStatusRequest{
+			MerchantId: created.MerchantId,
+			Status:     merchant.Status_INACTIVE,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, merchant.Status_INACTIVE, res.Status)
+
+		valid, err := merchantApp.ValidClient(ctx, &merchant.ValidClientRequest{
+			ClientId:     created.MerchantId,
+			ClientSecret: created.FrontSecret,
+			ClientType:   merchant.ClientType_FRONT,
+		})
+		require.Nil(t, err)
+		assert.False(t, valid.IsValid)
+	})
+
+	t.Run("UpdateMerchantStatus with invalid status", func(t *testing.T) {
+		_, err := merchantApp.UpdateMerchantStatus(ctx, &merchant.UpdateMerchantStatusRequest{
+			MerchantId: created.MerchantId,
+			Status:     merchant.Status(9),
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.(*cus_err.CusError).Code().Int())
+	})
+}
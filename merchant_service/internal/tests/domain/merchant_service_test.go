@@ -0,0 +1,306 @@
+package tests_domain
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/merchant_service/internal/domain/aggregate"
+	"go_micro_service_api/merchant_service/internal/domain/service"
+	"go_micro_service_api/merchant_service/internal/domain/vo"
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl"
+	"go_micro_service_api/merchant_service/internal/tests"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/db"
+	redis_cache "go_micro_service_api/pkg/db/redis"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/helper"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMerchantService() (merchantService *service.MerchantService, db db.Database, cache db.Cache, closeFunc func()) {
+	db = tests.NewMemoryDB()
+	redis, closeFunc := tests.NewMemoryRedis()
+	cache = redis_cache.NewRedisCache(redis)
+	merchantRepo := ent_impl.NewMerchantRepoImpl(db, cache)
+
+	return service.NewMerchantService(merchantRepo, helper.NewSnowflake(helper.NewMachineID())), db, cache, closeFunc
+}
+
+func createMerchant(t *testing.T, ctx context.Context, merchantService *service.MerchantService, db db.Database, info vo.MerchantInfo) *aggregate.Merchant {
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+
+	created, err := merchantService.CreateMerchant(ctx, info)
+	require.Nil(t, err)
+
+	_, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	return created
+}
+
+func TestCreateMerchant(t *testing.T) {
+	merchantService, db, _, closeFunc := setupMerchantService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	t.Run("Create merchant successfully", func(t *testing.T) {
+		created := createMerchant(t, ctx, merchantService, db, vo.MerchantInfo{
+			Name:        "merchant",
+			FrontDomain: "www.merchant.com",
+			BackDomain:  "admin.merchant.com",
+			Currencies:  []string{"TWD", "USD"},
+			Status:      enum.MerchantStatusType.Active,
+		})
+
+		assert.NotZero(t, created.Id)
+		assert.Equal(t, "merchant", created.Name)
+		assert.Equal(t, "www.merchant.com", created.FrontDomain)
+		assert.Equal(t, "admin.merchant.com", created.BackDomain)
+		assert.Equal(t, []string{"TWD", "USD"}, created.Currencies)
+		assert.Equal(t, enum.MerchantStatusType.Active, created.Status)
+		assert.Len(t, created.FrontSecret, 64)
+		assert.Len(t, created.BackSecret, 64)
+		assert.NotEqual(t, created.FrontSecret, created.BackSecret)
+	})
+
+	t.Run("Create merchant with duplicated domain", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+		}()
+
+		_, err = merchantService.CreateMerchant(ctx, vo.MerchantInfo{
+			Name:        "other merchant",
+			FrontDomain: "www.merchant.com",
+			BackDomain:  "admin.other.com",
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceIsExist, err.Code().Int())
+	})
+
+	t.Run("Create merchant with missing domain", func(t *testing.T) {
+		_, err := merchantService.CreateMerchant(ctx, vo.MerchantInfo{
+			Name:        "merchant",
+			FrontDomain: "www.missing.com",
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+}
+
+func TestUpdateMerchantStatus(t *testing.T) {
+	merchantService, db, cache, closeFunc := setupMerchantService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	created := createMerchant(t, ctx, merchantService, db, vo.MerchantInfo{
+		Name:        "merchant",
+		FrontDomain: "www.update.com",
+		BackDomain:  "admin.update.com",
+		Status:      enum.MerchantStatusType.Active,
+	})
+
+	// Load the merchant into cache
+	_, err := merchantService.GetMerchant(ctx, created.Id)
+	require.Nil(t, err)
+
+	t.Run("Update status successfully", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+
+		updated, err := merchantService.UpdateMerchantStatus(ctx, created.Id, enum.MerchantStatusType.Maintenance)
+		require.Nil(t, err)
+		assert.Equal(t, enum.MerchantStatusType.Maintenance, updated.Status)
+
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		// Stale cache should be removed
+		key := fmt.Sprintf("%s:%d", ent_impl.MerchantInfoPrefix, created.Id)
+		err = cache.GetObject(ctx, key, &aggregate.Merchant{})
+		assert.NotNil(t, err)
+
+		found, err := merchantService.GetMerchant(ctx, created.Id)
+		require.Nil(t, err)
+		assert.Equal(t, enum.MerchantStatusType.Maintenance, found.Status)
+	})
+
+	t.Run("Merchant cached before commit is removed after commit", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+
+		stale, err := merchantService.GetMerchant(ctx, created.Id)
+		require.Nil(t, err)
+
+		_, err = merchantService.UpdateMerchantStatus(ctx, created.Id, enum.MerchantStatusType.Active)
+		require.Nil(t, err)
+
+		// A find outside the transaction caches the old merchant before the commit
+		key := fmt.Sprintf("%s:%d", ent_impl.MerchantInfoPrefix, created.Id)
+		err = cache.SetObject(ctx, key, stale, ent_impl.MerchantInfoTTL)
+		require.Nil(t, err)
+
+		ctx, err = db.Commit(ctx)
+		require.Nil(t, err)
+
+		found, err := merchantService.GetMerchant(ctx, created.Id)
+		require.Nil(t, err)
+		assert.Equal(t, enum.MerchantStatusType.Active, found.Status)
+	})
+
+	t.Run("Update status of unknown merchant", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+		}()
+
+		_, err = merchantService.UpdateMerchantStatus(ctx, 999, enum.MerchantStatusType.Active)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+}
+
+func TestMerchantCache(t *testing.T) {
+	db := tests.NewMemoryDB()
+	redis, closeFunc := tests.NewMemoryRedis()
+	defer closeFunc()
+	merchantService := service.NewMerchantService(ent_impl.NewMerchantRepoImpl(db, redis_cache.NewRedisCache(redis)), helper.NewSnowflake(helper.NewMachineID()))
+
+	ctx := context.Background()
+	created := createMerchant(t, ctx, merchantService, db, vo.MerchantInfo{
+		Name:        "merchant",
+		FrontDomain: "www.cache.com",
+		BackDomain:  "admin.cache.com",
+		Status:      enum.MerchantStatusType.Active,
+	})
+	key := fmt.Sprintf("%s:%d", ent_impl.MerchantInfoPrefix, created.Id)
+
+	t.Run("The merchant read in a transaction isn't cached", func(t *testing.T) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+		}()
+
+		_, err = merchantService.GetMerchant(ctx, created.Id)
+		require.Nil(t, err)
+		assert.Zero(t, redis.Exists(ctx, key).Val())
+	})
+
+	t.Run("The cached merchant isn't read in a transaction", func(t *testing.T) {
+		stale := *created
+		stale.Status = enum.MerchantStatusType.Maintenance
+		err := redis_cache.NewRedisCache(redis).SetObject(ctx, key, &stale, ent_impl.MerchantInfoTTL)
+		require.Nil(t, err)
+		defer redis.Del(ctx, key)
+
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		defer func() {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+		}()
+
+		found, err := merchantService.GetMerchant(ctx, created.Id)
+		require.Nil(t, err)
+		assert.Equal(t, enum.MerchantStatusType.Active, found.Status)
+	})
+
+	t.Run("The cached merchant expires", func(t *testing.T) {
+		_, err := merchantService.GetMerchant(ctx, created.Id)
+		require.Nil(t, err)
+
+		ttl := redis.TTL(ctx, key).Val()
+		assert.Greater(t, ttl, time.Duration(0))
+		assert.LessOrEqual(t, ttl, ent_impl.MerchantInfoTTL)
+	})
+}
+
+func TestValidClient(t *testing.T) {
+	merchantService, db, _, closeFunc := setupMerchantService()
+	defer closeFunc()
+
+	ctx := context.Background()
+
+	active := createMerchant(t, ctx, merchantService, db, vo.MerchantInfo{
+		Name:        "active",
+		FrontDomain: "www.active.com",
+		BackDomain:  "admin.active.com",
+		Status:      enum.MerchantStatusType.Active,
+	})
+	inactive := createMerchant(t, ctx, merchantService, db, vo.MerchantInfo{
+		Name:        "inactive",
+		FrontDomain: "www.inactive.com",
+		BackDomain:  "admin.inactive.com",
+		Status:      enum.MerchantStatusType.Inactive,
+	})
+
+	tcs := []struct {
+		name       string
+		merchantId int64
+		clientType enum.Client
+		secret     string
+		want       bool
+	}{
+		{
+			name:       "Valid front secret",
+			merchantId: active.Id,
+			clientType: enum.ClientType.Frontend,
+			secret:     active.FrontSecret,
+			want:       true,
+		},
+		{
+			name:       "Valid back secret",
+			merchantId: active.Id,
+			clientType: enum.ClientType.Backend,
+			secret:     active.BackSecret,
+			want:       true,
+		},
+		{
+			name:       "Back secret used by front client",
+			merchantId: active.Id,
+			clientType: enum.ClientType.Frontend,
+			secret:     active.BackSecret,
+			want:       false,
+		},
+		{
+			name:       "Empty secret",
+			merchantId: active.Id,
+			clientType: enum.ClientType.Frontend,
+			secret:     "",
+			want:       false,
+		},
+		{
+			name:       "Inactive merchant",
+			merchantId: inactive.Id,
+			clientType: enum.ClientType.Frontend,
+			secret:     inactive.FrontSecret,
+			want:       false,
+		},
+		{
+			name:       "Unknown merchant",
+			merchantId: 999,
+			clientType: enum.ClientType.Frontend,
+			secret:     active.FrontSecret,
+			want:       false,
+		},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			isValid, err := merchantService.ValidClient(ctx, tc.merchantId, tc.clientType, tc.secret)
+			require.Nil(t, err)
+			assert.Equal(t, tc.want, isValid)
+		})
+	}
+}
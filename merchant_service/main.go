@@ -0,0 +1,41 @@
+package main
+
+import (
+	"go_micro_service_api/merchant_service/internal/application"
+	"go_micro_service_api/merchant_service/internal/domain/repository"
+	"go_micro_service_api/merchant_service/internal/domain/service"
+	"go_micro_service_api/merchant_service/internal/infrastructure/db_impl"
+	"go_micro_service_api/merchant_service/internal/infrastructure/ent_impl"
+	"go_micro_service_api/merchant_service/internal/infrastructure/grpc_impl"
+	"go_micro_service_api/merchant_service/internal/infrastructure/redis_impl"
+	"go_micro_service_api/pkg/db"
+	redis_cache "go_micro_service_api/pkg/db/redis"
+	"go_micro_service_api/pkg/helper"
+
+	"go.uber.org/fx"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	fx.New(
+		db_impl.NewEntDbFx(),
+		fx.Provide(
+			grpc_impl.NewGrpcServer,
+			application.NewMerchantService,
+			service.NewMerchantService,
+			fx.Annotate(
+				ent_impl.NewMerchantRepoImpl,
+				fx.As(new(repository.MerchantRepo)),
+			),
+			fx.Annotate(
+				redis_cache.NewRedisCache,
+				fx.As(new(db.Cache)),
+			),
+			redis_impl.NewRedisClient,
+			helper.NewMachineID,
+			helper.NewSnowflake,
+		),
+		fx.Invoke(func(server *grpc.Server) {}),
+	).Run()
+
+}
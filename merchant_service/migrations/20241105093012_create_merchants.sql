@@ -0,0 +1,18 @@
+-- Create "merchants" table
+CREATE TABLE "merchants" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "name" character varying NOT NULL, "front_domain" character varying NOT NULL, "front_secret" character varying NOT NULL, "back_domain" character varying NOT NULL, "back_secret" character varying NOT NULL, "currencies" jsonb NULL, "status" bigint NOT NULL DEFAULT 0, PRIMARY KEY ("id"));
+-- Create index "merchant_front_domain" to table: "merchants"
+CREATE UNIQUE INDEX "merchant_front_domain" ON "merchants" ("front_domain");
+-- Create index "merchant_back_domain" to table: "merchants"
+CREATE UNIQUE INDEX "merchant_back_domain" ON "merchants" ("back_domain");
+-- Set comment to table: "merchants"
+COMMENT ON TABLE "merchants" IS 'Merchant information, including the domains and secrets of its clients';
+-- Set comment to column: "front_domain" on table: "merchants"
+COMMENT ON COLUMN "merchants" ."front_domain" IS 'Domain of the frontend (player) site';
+-- Set comment to column: "front_secret" on table: "merchants"
+COMMENT ON COLUMN "merchants" ."front_secret" IS 'Secret used by the frontend client';
+-- Set comment to column: "back_domain" on table: "merchants"
+COMMENT ON COLUMN "merchants" ."back_domain" IS 'Domain of the backend (admin) site';
+-- Set comment to column: "back_secret" on table: "merchants"
+COMMENT ON COLUMN "merchants" ."back_secret" IS 'Secret used by the backend client';
+-- Set comment to column: "status" on table: "merchants"
+COMMENT ON COLUMN "merchants" ."status" IS '0: inactive, 1: active, 2: maintenance';
@@ -14,14 +14,16 @@ type Broker interface {
 	CreateExchange(exchange string, kind string, durable bool) *cus_err.CusError
 	// Create a queue
 	CreateQueue(queue string, durable bool) *cus_err.CusError
+	CreateQueueWithOpt(opt QueueOpt) *cus_err.CusError
 	// Bind a queue to an exchange
 	BindQueueToExchange(queue string, exchange string, routingKey string) *cus_err.CusError
-	// Publish message to an exchange
+	// Publish message to an exchange, it returns after the broker confirms the message is routed
 	Publish(ctx context.Context, exchange string, routingKey string, durable bool, msg []byte) *cus_err.CusError
 	// Consume message from a queue
 	Consume(ctx context.Context, consumerName string, queueName string) (<-chan amqp.Delivery, *cus_err.CusError)
 	// Ack
 	Ack(msg *amqp.Delivery) *cus_err.CusError
+	Nack(msg *amqp.Delivery, requeue bool) *cus_err.CusError
 	// Delete Exchange
 	DeleteExchange(exchange string) *cus_err.CusError
 	// Delete Queue
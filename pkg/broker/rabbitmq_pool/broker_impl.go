@@ -7,12 +7,15 @@ import (
 	"go_micro_service_api/pkg/cus_err"
 	"net/url"
 	"strconv"
+	"sync"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
 type brokerImpl struct {
-	pool *internal.ChannelPool
+	pool    *internal.ChannelPool
+	mu      sync.Mutex
+	returns map[*amqp.Channel]chan amqp.Return // The channels in the confirm mode and their returned messages
 }
 
 var _ Broker = (*brokerImpl)(nil)
@@ -58,7 +61,8 @@ func NewBroker(user string, pass string, host string, port int, opts ...Option)
 	}
 
 	b := &brokerImpl{
-		pool: pool,
+		pool:    pool,
+		returns: make(map[*amqp.Channel]chan amqp.Return),
 	}
 
 	if cfg.mapping == nil {
@@ -73,7 +77,7 @@ func NewBroker(user string, pass string, host string, port int, opts ...Option)
 	}
 
 	for _, opt := range cfg.mapping.Queues {
-		if err := b.CreateQueue(opt.Name, opt.Durable); err != nil {
+		if err := b.CreateQueueWithOpt(opt); err != nil {
 			return nil, err
 		}
 	}
@@ -121,13 +125,28 @@ func (b *brokerImpl) CreateExchange(exchange, kind string, durable bool) *cus_er
 }
 
 func (b *brokerImpl) CreateQueue(queue string, durable bool) *cus_err.CusError {
+	return b.CreateQueueWithOpt(QueueOpt{Name: queue, Durable: durable})
+}
+
+func (b *brokerImpl) CreateQueueWithOpt(opt QueueOpt) *cus_err.CusError {
 	ch, cusErr := b.getChannel()
 	if cusErr != nil {
 		return cusErr
 	}
 	defer b.pool.Put(ch)
 
-	if _, err := ch.QueueDeclare(queue, durable, false, false, false, nil); err != nil {
+	args := amqp.Table{}
+	if opt.DeadLetterExchange != "" {
+		args["x-dead-letter-exchange"] = opt.DeadLetterExchange
+	}
+	if opt.DeadLetterRoutingKey != "" {
+		args["x-dead-letter-routing-key"] = opt.DeadLetterRoutingKey
+	}
+	if opt.MessageTTL > 0 {
+		args["x-message-ttl"] = int32(opt.MessageTTL)
+	}
+
+	if _, err := ch.QueueDeclare(opt.Name, opt.Durable, false, false, false, args); err != nil {
 		return cus_err.New(cus_err.InternalServerError, "Failed to create queue", err)
 	}
 
@@ -148,12 +167,46 @@ func (b *brokerImpl) BindQueueToExchange(queue string, exchange string, routingK
 	return nil
 }
 
+// confirmMode puts the channel in the confirm mode once, the messages returned to the channel are received from the returned chan
+func (b *brokerImpl) confirmMode(ch *amqp.Channel) (chan amqp.Return, *cus_err.CusError) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if returns, ok := b.returns[ch]; ok {
+		return returns, nil
+	}
+
+	if err := ch.Confirm(false); err != nil {
+		return nil, cus_err.New(cus_err.InternalServerError, "Failed to put channel in confirm mode", err)
+	}
+	returns := ch.NotifyReturn(make(chan amqp.Return, 1))
+	b.returns[ch] = returns
+
+	// Forget the channel when it's closed
+	closed := ch.NotifyClose(make(chan *amqp.Error, 1))
+	go func() {
+		<-closed
+		b.mu.Lock()
+		delete(b.returns, ch)
+		b.mu.Unlock()
+	}()
+
+	return returns, nil
+}
+
+// Publish publishes the message as mandatory and waits for the broker to confirm it,
+// it fails when the broker nacks the message or returns it because no queue is bound for the routing key
 func (b *brokerImpl) Publish(ctx context.Context, exchange string, routingKey string, durable bool, msg []byte) *cus_err.CusError {
 	ch, cusErr := b.getChannel()
 	if cusErr != nil {
 		return cusErr
 	}
-	defer b.pool.Put(ch)
+
+	returns, cusErr := b.confirmMode(ch)
+	if cusErr != nil {
+		ch.Close()
+		return cusErr
+	}
 
 	mode := amqp.Transient
 	if durable {
@@ -166,9 +219,32 @@ func (b *brokerImpl) Publish(ctx context.Context, exchange string, routingKey st
 		Body:         msg,
 	}
 
-	if err := ch.PublishWithContext(ctx, exchange, routingKey, false, false, publishing); err != nil {
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx, exchange, routingKey, true, false, publishing)
+	if err != nil {
+		ch.Close()
 		return cus_err.New(cus_err.InternalServerError, "Failed to publish message", err)
 	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		// The late confirmation or return mustn't be taken for the next publishing of the channel
+		ch.Close()
+		return cus_err.New(cus_err.InternalServerError, "Failed to wait for the confirmation of message", err)
+	}
+	defer b.pool.Put(ch)
+
+	// The broker returns the unroutable message before it acks the message
+	select {
+	case ret, ok := <-returns:
+		if ok {
+			return cus_err.New(cus_err.InternalServerError, fmt.Sprintf("Message is returned: %d %s", ret.ReplyCode, ret.ReplyText), nil)
+		}
+	default:
+	}
+
+	if !acked {
+		return cus_err.New(cus_err.InternalServerError, "Message is nacked by the broker", nil)
+	}
 	return nil
 }
 
@@ -206,6 +282,14 @@ func (b *brokerImpl) Ack(d *amqp.Delivery) *cus_err.CusError {
 	return nil
 }
 
+// Nack rejects the message, it's dead-lettered when it isn't requeued and the queue has a dead letter exchange
+func (b *brokerImpl) Nack(d *amqp.Delivery, requeue bool) *cus_err.CusError {
+	if err := d.Nack(false, requeue); err != nil {
+		return cus_err.New(cus_err.InternalServerError, "Failed to nack message", err)
+	}
+	return nil
+}
+
 // ExchangeDelete removes the named exchange from the server. When an exchange is
 // deleted all queue bindings on the exchange are also deleted.  If this exchange
 // does not exist, the channel will be closed with an error.
@@ -55,6 +55,17 @@ func TestRabbitMQ(t *testing.T) {
 		assert.Nil(t, cusErr)
 	})
 
+	t.Run("TestPublish unroutable message", func(t *testing.T) {
+		ctx := context.Background()
+		cusErr := broker.Publish(ctx, "test", "unbound", false, []byte("test"))
+		require.NotNil(t, cusErr)
+		assert.Equal(t, cus_err.InternalServerError, cusErr.Code().Int())
+
+		// The channel is reused by the next publishing
+		cusErr = broker.Publish(ctx, "test", "test", false, []byte("test"))
+		assert.Nil(t, cusErr)
+	})
+
 	t.Run("TestConsume", func(t *testing.T) {
 		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
 		defer cancel()
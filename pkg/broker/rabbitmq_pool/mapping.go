@@ -9,6 +9,12 @@ type ExchangeOpt struct {
 type QueueOpt struct {
 	Name    string
 	Durable bool
+	// DeadLetterExchange receives the rejected and expired messages, no dead-lettering when it's empty
+	DeadLetterExchange string
+	// DeadLetterRoutingKey replaces the routing key of the dead-lettered messages, keeps it when it's empty
+	DeadLetterRoutingKey string
+	// MessageTTL is how long the messages stay in the queue in milliseconds, no limit when it's 0
+	MessageTTL int
 }
 
 type BindOpt struct {
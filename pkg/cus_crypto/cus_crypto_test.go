@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/hex"
 	"go_micro_service_api/pkg/cus_err"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -129,3 +131,56 @@ func TestHashPassword(t *testing.T) {
 	match := k.CompareHashAndPassword(ctx, hash, data)
 	assert.True(t, match)
 }
+
+func TestTotp(t *testing.T) {
+	k := New()
+	ctx := context.Background()
+
+	t.Run("RFC 6238 test vector", func(t *testing.T) {
+		// The secret is "12345678901234567890" in base32
+		secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+		code, err := k.GenerateTotpCode(ctx, secret, time.Unix(59, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, "287082", code)
+
+		code, err = k.GenerateTotpCode(ctx, secret, time.Unix(1111111109, 0))
+		assert.Nil(t, err)
+		assert.Equal(t, "081804", code)
+	})
+
+	t.Run("Validate code", func(t *testing.T) {
+		secret, err := k.GenerateTotpSecret(ctx)
+		assert.Nil(t, err)
+		assert.NotEmpty(t, secret)
+
+		now := time.Now()
+		code, err := k.GenerateTotpCode(ctx, secret, now)
+		assert.Nil(t, err)
+		step, ok := k.ValidateTotpCode(ctx, secret, code, now)
+		assert.True(t, ok)
+		assert.Equal(t, TotpStep(now), step)
+
+		// The code of the previous time step is still accepted, the step of the code is returned
+		step, ok = k.ValidateTotpCode(ctx, secret, code, now.Add(TotpPeriod))
+		assert.True(t, ok)
+		assert.Equal(t, TotpStep(now), step)
+
+		// The code is expired after the skew
+		_, ok = k.ValidateTotpCode(ctx, secret, code, now.Add(TotpPeriod*3))
+		assert.False(t, ok)
+
+		// Invalid codes
+		_, ok = k.ValidateTotpCode(ctx, secret, "", now)
+		assert.False(t, ok)
+		_, ok = k.ValidateTotpCode(ctx, "invalid secret!", code, now)
+		assert.False(t, ok)
+	})
+
+	t.Run("Otpauth uri", func(t *testing.T) {
+		uri := k.TotpUri(ctx, "issuer", "account", "SECRET")
+		assert.True(t, strings.HasPrefix(uri, "otpauth://totp/issuer:account?"))
+		assert.Contains(t, uri, "secret=SECRET")
+		assert.Contains(t, uri, "issuer=issuer")
+	})
+}
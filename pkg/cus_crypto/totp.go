@@ -0,0 +1,131 @@
+package cus_crypto
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// TotpPeriod is the time step of the TOTP codes (RFC 6238)
+	TotpPeriod = 30 * time.Second
+	// TotpDigits is the number of digits of a TOTP code
+	TotpDigits = 6
+	// totpSecretLength is the number of random bytes of a TOTP secret
+	totpSecretLength = 20
+	// totpSkew is the number of time steps accepted before and after the current one
+	totpSkew = 1
+	// TotpStepWindow is how long a time step is accepted, the used steps have to be remembered as long as it
+	TotpStepWindow = (2*totpSkew + 1) * TotpPeriod
+)
+
+// totpEncoding is the base32 encoding without padding used by the authenticator apps
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTotpSecret generates a base32 encoded secret for TOTP.
+func (k *CusCrypto) GenerateTotpSecret(ctx context.Context) (string, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	secret, cusErr := k.GenerateRandomSecret(ctx, totpSecretLength)
+	if cusErr != nil {
+		return "", cusErr
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TotpUri returns the otpauth URI of the secret, which is scanned by the authenticator apps as a QR code.
+func (k *CusCrypto) TotpUri(ctx context.Context, issuer string, account string, secret string) string {
+	_, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprintf("%d", TotpDigits))
+	query.Set("period", fmt.Sprintf("%d", int(TotpPeriod.Seconds())))
+
+	return (&url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}).String()
+}
+
+// GenerateTotpCode generates the TOTP code of the secret at the given time.
+func (k *CusCrypto) GenerateTotpCode(ctx context.Context, secret string, t time.Time) (string, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimSpace(secret)))
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to decode totp secret", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return "", cusErr
+	}
+
+	return hotp(key, uint64(TotpStep(t))), nil
+}
+
+// TotpStep returns the time step of the given time.
+func TotpStep(t time.Time) int64 {
+	return t.Unix() / int64(TotpPeriod.Seconds())
+}
+
+// ValidateTotpCode checks the code against the secret at the given time and returns the time step of the code,
+// the codes of the adjacent time steps are accepted for the clock skew of the devices.
+// The code of a step is valid until the step is out of the window,
+// the caller has to reject the steps which are not after the last accepted one to prevent replays.
+func (k *CusCrypto) ValidateTotpCode(ctx context.Context, secret string, code string, t time.Time) (int64, bool) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if len(code) != TotpDigits {
+		return 0, false
+	}
+
+	for skew := -totpSkew; skew <= totpSkew; skew++ {
+		stepTime := t.Add(time.Duration(skew) * TotpPeriod)
+		expected, cusErr := k.GenerateTotpCode(ctx, secret, stepTime)
+		if cusErr != nil {
+			return 0, false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return TotpStep(stepTime), true
+		}
+	}
+
+	return 0, false
+}
+
+// hotp generates the HOTP code of the counter (RFC 4226)
+func hotp(key []byte, counter uint64) string {
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < TotpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", TotpDigits, value%mod)
+}
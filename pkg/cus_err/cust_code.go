@@ -22,21 +22,24 @@ const (
 	AccountPasswordError    = 400_0001 // 密碼錯誤
 	InvalidVerificationCode = 400_0002 // 驗證碼錯誤
 	InvalidArgument         = 400_0003
+	PasswordPolicyViolation = 400_0004 // 密碼不符合規則
 	BadRequest              = 400_0098 // 請求錯誤
 	ThirdPartyError         = 400_0099 // 第三方錯誤
 
 	// 401 status code from here
-	Unauthorized       = 401_0000 // 未授權
-	UnusualLogin       = 401_0001 // 登入異常
-	AccountLocked      = 401_0002 // 帳號被鎖定
-	WrongPassword      = 401_0003 // 密碼錯誤
-	TokenExpired       = 401_0004 // Token過期
-	ClientInactive     = 401_0005 // 客戶端未啟用
-	MissingAccessToken = 401_0006
+	Unauthorized         = 401_0000 // 未授權
+	UnusualLogin         = 401_0001 // 登入異常
+	AccountLocked        = 401_0002 // 帳號被鎖定
+	WrongPassword        = 401_0003 // 密碼錯誤
+	TokenExpired         = 401_0004 // Token過期
+	ClientInactive       = 401_0005 // 客戶端未啟用
+	MissingAccessToken   = 401_0006
+	SecondFactorRequired = 401_0007 // 需要二次驗證
 	// 403 status code from here
 	Forbidden    = 403_0000 // 禁止訪問
 	NoPermission = 403_0001 // 沒有權限
 	NoRole       = 403_0002 // 沒有角色
+	LoginDenied  = 403_0003 // 登入風險過高被拒絕
 
 	// 404 status code from here
 	ResponseNotFound = 404_0000 // 沒有Response
@@ -45,6 +48,7 @@ const (
 	// 409 status code from here
 	Conflict        = 409_0000 // 衝突
 	ResourceIsExist = 409_0001 // 資源已存在
+	SessionLimit    = 409_0002 // 登入裝置數已達上限
 
 	// 429 status code from here
 	TooManyRequests = 429_0000 // 請求過多
@@ -16,4 +16,6 @@ type Cache interface {
 	GetHashFields(ctx context.Context, key string, fields ...string) ([]interface{}, *cus_err.CusError)
 	SetHash(ctx context.Context, expiration time.Duration, key string, values ...interface{}) *cus_err.CusError
 	Incr(ctx context.Context, key string) (int64, *cus_err.CusError)
+	// Eval runs the lua script atomically, a nil reply is returned as ResourceNotFound
+	Eval(ctx context.Context, script string, keys []string, args ...any) (any, *cus_err.CusError)
 }
@@ -249,3 +249,27 @@ func (r *RedisCache) Incr(ctx context.Context, key string) (int64, *cus_err.CusE
 
 	return res, nil
 }
+
+// Eval runs the lua script atomically on the keys, the read-modify-write of the keys can't interleave with other clients
+func (r *RedisCache) Eval(ctx context.Context, script string, keys []string, args ...any) (any, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	res, err := r.client.Eval(ctx, script, keys, args...).Result()
+	if err != nil {
+		var errCode cus_err.CusCode
+		if err == redis.Nil {
+			// When the script returns nil return ResourceNotFound error.
+			errCode = cus_err.ResourceNotFound
+		} else {
+			// Otherwise, return InternalServerError error.
+			errCode = cus_err.InternalServerError
+		}
+
+		cusErr := cus_err.New(errCode, "Failed to eval script", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return res, nil
+}
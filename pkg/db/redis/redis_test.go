@@ -578,3 +578,61 @@ func TestRedisCache_Incr(t *testing.T) {
 		})
 	}
 }
+
+func TestRedisCache_Eval(t *testing.T) {
+	client, mock := redismock.NewClientMock()
+	cache := NewRedisCache(client)
+
+	script := "return redis.call('INCRBY', KEYS[1], ARGV[1])"
+
+	tests := []struct {
+		name     string
+		mockFunc func()
+		want     any
+		wantErr  bool
+		errCode  int
+	}{
+		{
+			name: "Successful Eval",
+			mockFunc: func() {
+				mock.ExpectEval(script, []string{"testKey"}, 2).SetVal(int64(2))
+			},
+			want:    int64(2),
+			wantErr: false,
+		},
+		{
+			name: "Nil Reply",
+			mockFunc: func() {
+				mock.ExpectEval(script, []string{"testKey"}, 2).RedisNil()
+			},
+			wantErr: true,
+			errCode: cus_err.ResourceNotFound,
+		},
+		{
+			name: "Unexpected Error",
+			mockFunc: func() {
+				mock.ExpectEval(script, []string{"testKey"}, 2).SetErr(errors.New("unexpected error"))
+			},
+			wantErr: true,
+			errCode: cus_err.InternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockFunc()
+
+			res, err := cache.Eval(context.Background(), script, []string{"testKey"}, 2)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.errCode, err.Code().Int())
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want, res)
+			}
+
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package enum
+
+import "go_micro_service_api/pkg/cus_err"
+
+type AttributeType struct {
+	Id     int
+	String string
+}
+
+// AttributeTypes are the value types of the profile attributes
+var AttributeTypes = struct {
+	String AttributeType
+	Date   AttributeType // ISO 8601 date, e.g. 2000-01-31
+	Enum   AttributeType // One of the options of the attribute
+	Phone  AttributeType // E.164 phone number, e.g. +886912345678
+}{
+	String: AttributeType{
+		Id:     1,
+		String: "string",
+	},
+	Date: AttributeType{
+		Id:     2,
+		String: "date",
+	},
+	Enum: AttributeType{
+		Id:     3,
+		String: "enum",
+	},
+	Phone: AttributeType{
+		Id:     4,
+		String: "phone",
+	},
+}
+
+func AttributeTypeFromId(id int) (AttributeType, *cus_err.CusError) {
+	switch id {
+	case 1:
+		return AttributeTypes.String, nil
+	case 2:
+		return AttributeTypes.Date, nil
+	case 3:
+		return AttributeTypes.Enum, nil
+	case 4:
+		return AttributeTypes.Phone, nil
+	}
+	return AttributeType{}, cus_err.New(cus_err.InvalidArgument, "invalid attribute type")
+}
+
+func AttributeTypeFromString(v string) (AttributeType, *cus_err.CusError) {
+	switch v {
+	case "string":
+		return AttributeTypes.String, nil
+	case "date":
+		return AttributeTypes.Date, nil
+	case "enum":
+		return AttributeTypes.Enum, nil
+	case "phone":
+		return AttributeTypes.Phone, nil
+	}
+	return AttributeType{}, cus_err.New(cus_err.InvalidArgument, "invalid attribute type")
+}
@@ -0,0 +1,65 @@
+package enum
+
+import "go_micro_service_api/pkg/cus_err"
+
+type KycDocument struct {
+	Id       int
+	String   string
+	Identity bool // Proves the identity of the player on its own
+}
+
+// KycDocumentType are the documents uploaded for the KYC review
+var KycDocumentType = struct {
+	IdCard        KycDocument
+	Passport      KycDocument
+	DriverLicense KycDocument
+	Selfie        KycDocument // The player holding the identity document
+}{
+	IdCard: KycDocument{
+		Id:       1,
+		String:   "idCard",
+		Identity: true,
+	},
+	Passport: KycDocument{
+		Id:       2,
+		String:   "passport",
+		Identity: true,
+	},
+	DriverLicense: KycDocument{
+		Id:       3,
+		String:   "driverLicense",
+		Identity: true,
+	},
+	Selfie: KycDocument{
+		Id:     4,
+		String: "selfie",
+	},
+}
+
+func KycDocumentTypeFromId(id int) (KycDocument, *cus_err.CusError) {
+	switch id {
+	case 1:
+		return KycDocumentType.IdCard, nil
+	case 2:
+		return KycDocumentType.Passport, nil
+	case 3:
+		return KycDocumentType.DriverLicense, nil
+	case 4:
+		return KycDocumentType.Selfie, nil
+	}
+	return KycDocument{}, cus_err.New(cus_err.InvalidArgument, "invalid kyc document type")
+}
+
+func KycDocumentTypeFromString(v string) (KycDocument, *cus_err.CusError) {
+	switch v {
+	case "idCard":
+		return KycDocumentType.IdCard, nil
+	case "passport":
+		return KycDocumentType.Passport, nil
+	case "driverLicense":
+		return KycDocumentType.DriverLicense, nil
+	case "selfie":
+		return KycDocumentType.Selfie, nil
+	}
+	return KycDocument{}, cus_err.New(cus_err.InvalidArgument, "invalid kyc document type")
+}
@@ -0,0 +1,52 @@
+package enum
+
+import "go_micro_service_api/pkg/cus_err"
+
+type KycStatus struct {
+	Id     int
+	String string
+}
+
+// KycStatusType is the review state of the KYC submissions
+var KycStatusType = struct {
+	Pending  KycStatus // Waiting for the review
+	Approved KycStatus
+	Rejected KycStatus // The player can submit again
+}{
+	Pending: KycStatus{
+		Id:     1,
+		String: "pending",
+	},
+	Approved: KycStatus{
+		Id:     2,
+		String: "approved",
+	},
+	Rejected: KycStatus{
+		Id:     3,
+		String: "rejected",
+	},
+}
+
+func KycStatusFromId(id int) (KycStatus, *cus_err.CusError) {
+	switch id {
+	case 1:
+		return KycStatusType.Pending, nil
+	case 2:
+		return KycStatusType.Approved, nil
+	case 3:
+		return KycStatusType.Rejected, nil
+	}
+	return KycStatus{}, cus_err.New(cus_err.InvalidArgument, "invalid kyc status")
+}
+
+func KycStatusFromString(v string) (KycStatus, *cus_err.CusError) {
+	switch v {
+	case "pending":
+		return KycStatusType.Pending, nil
+	case "approved":
+		return KycStatusType.Approved, nil
+	case "rejected":
+		return KycStatusType.Rejected, nil
+	}
+	return KycStatus{}, cus_err.New(cus_err.InvalidArgument, "invalid kyc status")
+}
@@ -0,0 +1,34 @@
+package enum
+
+import (
+	"go_micro_service_api/pkg/cus_err"
+)
+
+type MerchantStatus int
+
+var MerchantStatusType = struct {
+	Inactive    MerchantStatus
+	Active      MerchantStatus
+	Maintenance MerchantStatus
+}{
+	Inactive:    0,
+	Active:      1,
+	Maintenance: 2,
+}
+
+func (s MerchantStatus) Int() int {
+	return int(s)
+}
+
+func MerchantStatusFromInt(val int) (MerchantStatus, *cus_err.CusError) {
+	switch val {
+	case int(MerchantStatusType.Inactive):
+		return MerchantStatusType.Inactive, nil
+	case int(MerchantStatusType.Active):
+		return MerchantStatusType.Active, nil
+	case int(MerchantStatusType.Maintenance):
+		return MerchantStatusType.Maintenance, nil
+	default:
+		return 0, cus_err.New(cus_err.InvalidArgument, "invalid merchant status")
+	}
+}
@@ -0,0 +1,43 @@
+package enum
+
+import (
+	"go_micro_service_api/pkg/cus_err"
+)
+
+// PasswordCharClass is a kind of characters which a password policy can require
+type PasswordCharClass string
+
+var PasswordCharClassType = struct {
+	Alpha  PasswordCharClass // Any letter
+	Lower  PasswordCharClass // Lowercase letter
+	Upper  PasswordCharClass // Uppercase letter
+	Digit  PasswordCharClass // Number
+	Symbol PasswordCharClass // Anything else, e.g. punctuation
+}{
+	Alpha:  "alpha",
+	Lower:  "lower",
+	Upper:  "upper",
+	Digit:  "digit",
+	Symbol: "symbol",
+}
+
+func (c PasswordCharClass) String() string {
+	return string(c)
+}
+
+func PasswordCharClassFromString(val string) (PasswordCharClass, *cus_err.CusError) {
+	switch val {
+	case PasswordCharClassType.Alpha.String():
+		return PasswordCharClassType.Alpha, nil
+	case PasswordCharClassType.Lower.String():
+		return PasswordCharClassType.Lower, nil
+	case PasswordCharClassType.Upper.String():
+		return PasswordCharClassType.Upper, nil
+	case PasswordCharClassType.Digit.String():
+		return PasswordCharClassType.Digit, nil
+	case PasswordCharClassType.Symbol.String():
+		return PasswordCharClassType.Symbol, nil
+	default:
+		return "", cus_err.New(cus_err.InvalidArgument, "invalid password char class")
+	}
+}
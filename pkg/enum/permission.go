@@ -11,6 +11,9 @@ var PermissionType = struct {
 	Withdraw Permission
 	Deposit  Permission
 	PlayGame Permission
+
+	SearchUser      Permission
+	ViewLoginRecord Permission
 }{
 	Withdraw: Permission{
 		Id:   1,
@@ -24,6 +27,16 @@ var PermissionType = struct {
 		Id:   3,
 		Name: "PlayerPlayGame",
 	},
+
+	// Backend permission id is start from 101
+	SearchUser: Permission{
+		Id:   101,
+		Name: "BackendSearchUser",
+	},
+	ViewLoginRecord: Permission{
+		Id:   102,
+		Name: "BackendViewLoginRecord",
+	},
 }
 
 func PermissionById(id int64) (Permission, *cus_err.CusError) {
@@ -34,6 +47,10 @@ func PermissionById(id int64) (Permission, *cus_err.CusError) {
 		return PermissionType.Deposit, nil
 	case PermissionType.PlayGame.Id:
 		return PermissionType.PlayGame, nil
+	case PermissionType.SearchUser.Id:
+		return PermissionType.SearchUser, nil
+	case PermissionType.ViewLoginRecord.Id:
+		return PermissionType.ViewLoginRecord, nil
 	default:
 		return Permission{},
 			cus_err.New(cus_err.AccountPasswordError, "invalid permission id")
@@ -0,0 +1,52 @@
+package enum
+
+import "go_micro_service_api/pkg/cus_err"
+
+type PII struct {
+	Id     int
+	String string
+}
+
+// PIIClass is the classification of the personal data kept in the profile attributes
+var PIIClass = struct {
+	None      PII // Not personal, e.g. nickname
+	Personal  PII // Identifies the user, e.g. email, mobile number
+	Sensitive PII // Must be protected further, e.g. birthday, KYC level
+}{
+	None: PII{
+		Id:     1,
+		String: "none",
+	},
+	Personal: PII{
+		Id:     2,
+		String: "personal",
+	},
+	Sensitive: PII{
+		Id:     3,
+		String: "sensitive",
+	},
+}
+
+func PIIClassFromId(id int) (PII, *cus_err.CusError) {
+	switch id {
+	case 1:
+		return PIIClass.None, nil
+	case 2:
+		return PIIClass.Personal, nil
+	case 3:
+		return PIIClass.Sensitive, nil
+	}
+	return PII{}, cus_err.New(cus_err.InvalidArgument, "invalid pii class")
+}
+
+func PIIClassFromString(v string) (PII, *cus_err.CusError) {
+	switch v {
+	case "none":
+		return PIIClass.None, nil
+	case "personal":
+		return PIIClass.Personal, nil
+	case "sensitive":
+		return PIIClass.Sensitive, nil
+	}
+	return PII{}, cus_err.New(cus_err.InvalidArgument, "invalid pii class")
+}
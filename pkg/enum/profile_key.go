@@ -12,6 +12,11 @@ var ProfileKey = struct {
 	CountryCode  Profile
 	MobileNumber Profile
 	Account      Profile
+	// The openIDs of the oauth providers bound to the user
+	GoogleOpenID  Profile
+	MetaOpenID    Profile
+	TwitterOpenID Profile
+	LINEOpenID    Profile
 }{
 	Email: Profile{
 		ID:     1,
@@ -29,6 +34,22 @@ var ProfileKey = struct {
 		ID:     4,
 		String: "Account",
 	},
+	GoogleOpenID: Profile{
+		ID:     5,
+		String: "GoogleOpenID",
+	},
+	MetaOpenID: Profile{
+		ID:     6,
+		String: "MetaOpenID",
+	},
+	TwitterOpenID: Profile{
+		ID:     7,
+		String: "TwitterOpenID",
+	},
+	LINEOpenID: Profile{
+		ID:     8,
+		String: "LINEOpenID",
+	},
 }
 
 func ProfileKeyFromId(id int) (Profile, *cus_err.CusError) {
@@ -41,7 +62,31 @@ func ProfileKeyFromId(id int) (Profile, *cus_err.CusError) {
 		return ProfileKey.MobileNumber, nil
 	case 4:
 		return ProfileKey.Account, nil
+	case 5:
+		return ProfileKey.GoogleOpenID, nil
+	case 6:
+		return ProfileKey.MetaOpenID, nil
+	case 7:
+		return ProfileKey.TwitterOpenID, nil
+	case 8:
+		return ProfileKey.LINEOpenID, nil
 	}
 
 	return Profile{}, cus_err.New(cus_err.InvalidArgument, "invalid profile key")
 }
+
+// OpenIDProfileKey returns the profile key which stores the openID of the oauth provider
+func OpenIDProfileKey(provider Provider) (Profile, *cus_err.CusError) {
+	switch provider {
+	case OAuthProvider.Google:
+		return ProfileKey.GoogleOpenID, nil
+	case OAuthProvider.Meta:
+		return ProfileKey.MetaOpenID, nil
+	case OAuthProvider.Twitter:
+		return ProfileKey.TwitterOpenID, nil
+	case OAuthProvider.LINE:
+		return ProfileKey.LINEOpenID, nil
+	}
+
+	return Profile{}, cus_err.New(cus_err.InvalidArgument, "invalid oauth provider")
+}
@@ -0,0 +1,54 @@
+package enum
+
+import "go_micro_service_api/pkg/cus_err"
+
+type RegistrationStatus struct {
+	Id     int
+	String string
+}
+
+// RegistrationStatusType is the step of the registration saga, the user is created in the auth service and then the profile is created
+var RegistrationStatusType = struct {
+	Started         RegistrationStatus // The auth user may or may not be created
+	AuthUserCreated RegistrationStatus // Waiting for the profile
+	Completed       RegistrationStatus
+	Compensating    RegistrationStatus // Failed, waiting for the auth user to be deleted
+	Compensated     RegistrationStatus // Failed and the auth user is deleted
+}{
+	Started: RegistrationStatus{
+		Id:     1,
+		String: "started",
+	},
+	AuthUserCreated: RegistrationStatus{
+		Id:     2,
+		String: "authUserCreated",
+	},
+	Completed: RegistrationStatus{
+		Id:     3,
+		String: "completed",
+	},
+	Compensating: RegistrationStatus{
+		Id:     4,
+		String: "compensating",
+	},
+	Compensated: RegistrationStatus{
+		Id:     5,
+		String: "compensated",
+	},
+}
+
+func RegistrationStatusFromId(id int) (RegistrationStatus, *cus_err.CusError) {
+	switch id {
+	case 1:
+		return RegistrationStatusType.Started, nil
+	case 2:
+		return RegistrationStatusType.AuthUserCreated, nil
+	case 3:
+		return RegistrationStatusType.Completed, nil
+	case 4:
+		return RegistrationStatusType.Compensating, nil
+	case 5:
+		return RegistrationStatusType.Compensated, nil
+	}
+	return RegistrationStatus{}, cus_err.New(cus_err.InvalidArgument, "invalid registration status")
+}
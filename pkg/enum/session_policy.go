@@ -0,0 +1,35 @@
+package enum
+
+import (
+	"go_micro_service_api/pkg/cus_err"
+)
+
+// SessionPolicy decides how many devices a user can stay logged in at the same time
+type SessionPolicy int
+
+var SessionPolicyType = struct {
+	Single    SessionPolicy // A new login replaces the previous session
+	Limited   SessionPolicy // Up to max sessions, a new login is rejected when full unless it is forced
+	Unlimited SessionPolicy // No limit
+}{
+	Single:    1,
+	Limited:   2,
+	Unlimited: 3,
+}
+
+func (s SessionPolicy) Int() int {
+	return int(s)
+}
+
+func SessionPolicyFromInt(val int) (SessionPolicy, *cus_err.CusError) {
+	switch val {
+	case int(SessionPolicyType.Single):
+		return SessionPolicyType.Single, nil
+	case int(SessionPolicyType.Limited):
+		return SessionPolicyType.Limited, nil
+	case int(SessionPolicyType.Unlimited):
+		return SessionPolicyType.Unlimited, nil
+	default:
+		return 0, cus_err.New(cus_err.InvalidArgument, "invalid session policy")
+	}
+}
@@ -0,0 +1,51 @@
+package enum
+
+import "go_micro_service_api/pkg/cus_err"
+
+// SigningMethod is the algorithm used to sign the tokens of a client
+type SigningMethod struct {
+	Id  int
+	Alg string // Alg is the "alg" header of the JWT
+}
+
+var SigningMethodType = struct {
+	HS256 SigningMethod // Signed by the client secret
+	RS256 SigningMethod // Signed by the rotating RSA keys, verifiable by the JWKS
+	ES256 SigningMethod // Signed by the rotating ECDSA P-256 keys, verifiable by the JWKS
+}{
+	HS256: SigningMethod{
+		Id:  1,
+		Alg: "HS256",
+	},
+	RS256: SigningMethod{
+		Id:  2,
+		Alg: "RS256",
+	},
+	ES256: SigningMethod{
+		Id:  3,
+		Alg: "ES256",
+	},
+}
+
+// AsymmetricSigningMethods are the signing methods using the rotating key pairs
+var AsymmetricSigningMethods = []SigningMethod{
+	SigningMethodType.RS256,
+	SigningMethodType.ES256,
+}
+
+// IsAsymmetric checks the signing method uses the rotating key pairs
+func (s SigningMethod) IsAsymmetric() bool {
+	return s == SigningMethodType.RS256 || s == SigningMethodType.ES256
+}
+
+func SigningMethodFromId(id int) (SigningMethod, *cus_err.CusError) {
+	switch id {
+	case 1:
+		return SigningMethodType.HS256, nil
+	case 2:
+		return SigningMethodType.RS256, nil
+	case 3:
+		return SigningMethodType.ES256, nil
+	}
+	return SigningMethod{}, cus_err.New(cus_err.InvalidArgument, "invalid signing method")
+}
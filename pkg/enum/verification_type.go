@@ -0,0 +1,39 @@
+package enum
+
+import "go_micro_service_api/pkg/cus_err"
+
+type VerificationType struct {
+	Id     int
+	String string
+}
+
+var VerificationTypes = struct {
+	ForgotPwd     VerificationType
+	UnusualLogin  VerificationType
+	UpdateProfile VerificationType
+}{
+	ForgotPwd: VerificationType{
+		Id:     1,
+		String: "forgotPwd",
+	},
+	UnusualLogin: VerificationType{
+		Id:     2,
+		String: "unusualLogin",
+	},
+	UpdateProfile: VerificationType{
+		Id:     3,
+		String: "updateProfile",
+	},
+}
+
+func VerificationTypeFromString(v string) (VerificationType, *cus_err.CusError) {
+	switch v {
+	case "forgotPwd":
+		return VerificationTypes.ForgotPwd, nil
+	case "unusualLogin":
+		return VerificationTypes.UnusualLogin, nil
+	case "updateProfile":
+		return VerificationTypes.UpdateProfile, nil
+	}
+	return VerificationType{}, cus_err.New(cus_err.InvalidArgument, "invalid verification type")
+}
@@ -0,0 +1,98 @@
+package outbox
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"go_micro_service_api/pkg/cus_err"
+	"time"
+)
+
+// SpecVersion is the version of the envelope, it changes only when the fields of the envelope change.
+// The payload has its own version per event type.
+const SpecVersion = 1
+
+// Event is a state change of an aggregate, it's added to the outbox in the transaction of the change
+type Event struct {
+	AggregateType string // e.g. user, client
+	AggregateId   string
+	Type          string // The routing key of the event, e.g. user.created
+	Version       int    // The version of the payload schema of the type
+	Payload       any    // Marshaled as JSON
+}
+
+// Message is an event stored in the outbox, waiting to be published by the relay
+type Message struct {
+	Id            int64 // Increases with the insertion, the events of an aggregate are published in its order
+	EventId       string
+	AggregateType string
+	AggregateId   string
+	EventType     string
+	EventVersion  int
+	Payload       []byte
+	OccurredAt    time.Time
+	Attempts      int
+	LockedUntil   *time.Time // The message is claimed by a relay or waits for the retry until then
+	LastError     string
+}
+
+// NewMessage builds the outbox message of the event with a new event id
+func NewMessage(event *Event, occurredAt time.Time) (*Message, *cus_err.CusError) {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to marshal the event payload", err)
+	}
+
+	b := make([]byte, 16)
+	if _, err = rand.Read(b); err != nil {
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to generate the event id", err)
+	}
+
+	return &Message{
+		EventId:       hex.EncodeToString(b),
+		AggregateType: event.AggregateType,
+		AggregateId:   event.AggregateId,
+		EventType:     event.Type,
+		EventVersion:  event.Version,
+		Payload:       payload,
+		OccurredAt:    occurredAt,
+	}, nil
+}
+
+// Aggregate is the key of the aggregate, the messages of the same key are published in order
+func (m *Message) Aggregate() string {
+	return m.AggregateType + ":" + m.AggregateId
+}
+
+// Envelope is the published body of the events.
+//
+// Delivery is at least once, consumers dedupe the events by Id,
+// and Sequence increases per aggregate so the stale events can be dropped.
+type Envelope struct {
+	SpecVersion   int             `json:"specVersion"`
+	Id            string          `json:"id"`
+	Source        string          `json:"source"` // The service of the event
+	Type          string          `json:"type"`
+	Version       int             `json:"version"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateId   string          `json:"aggregateId"`
+	Sequence      int64           `json:"sequence"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+// NewEnvelope wraps the message of the source
+func NewEnvelope(source string, m *Message) *Envelope {
+	return &Envelope{
+		SpecVersion:   SpecVersion,
+		Id:            m.EventId,
+		Source:        source,
+		Type:          m.EventType,
+		Version:       m.EventVersion,
+		AggregateType: m.AggregateType,
+		AggregateId:   m.AggregateId,
+		Sequence:      m.Id,
+		OccurredAt:    m.OccurredAt,
+		Payload:       m.Payload,
+	}
+}
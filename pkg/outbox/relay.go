@@ -0,0 +1,255 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"time"
+)
+
+// Store is the outbox table of a service, the messages are added by the service in the transactions of the changes
+type Store interface {
+	// ListPending lists the unpublished messages which can be claimed at now from the oldest.
+	// The aggregates are skipped from their first message which is leased, waiting for the retry or parked,
+	// so the blocked aggregates don't use up the limit.
+	ListPending(ctx context.Context, now time.Time, limit int) ([]*Message, *cus_err.CusError)
+	// Claim leases the unpublished message until the time when it isn't leased at now, it returns false when another relay has it
+	Claim(ctx context.Context, id int64, now time.Time, until time.Time) (bool, *cus_err.CusError)
+	// MarkPublished marks the message as published, it's never listed again
+	MarkPublished(ctx context.Context, id int64, publishedAt time.Time) *cus_err.CusError
+	// Retry records the failed attempt of the message, it's claimed again after the retry time
+	Retry(ctx context.Context, id int64, retryAt time.Time, lastError string) *cus_err.CusError
+	// Park records the last failed attempt of the message and stops retrying it, the later messages of its aggregate wait
+	// until it's resolved by hand
+	Park(ctx context.Context, id int64, parkedAt time.Time, lastError string) *cus_err.CusError
+}
+
+// Publisher publishes the body to the exchange, rabbitmq_pool.Broker implements it.
+// It returns nil only after the broker confirms the message, a nacked or unroutable message is an error.
+type Publisher interface {
+	Publish(ctx context.Context, exchange string, routingKey string, durable bool, msg []byte) *cus_err.CusError
+}
+
+// RelayOpt configures the relay, the zero durations and batch size use the defaults
+type RelayOpt struct {
+	Source        string // The service of the events, e.g. auth_service
+	Exchange      string // The topic exchange the events are published to, the event type is the routing key
+	BatchSize     int
+	Lease         time.Duration // How long a relay owns the claimed messages, it must be longer than publishing a batch
+	RetryDelay    time.Duration // The delay of the first retry, it doubles every attempt
+	MaxRetryDelay time.Duration
+	MaxAttempts   int // The message is parked after the attempts fail
+}
+
+// Relay publishes the outbox messages to the broker.
+//
+// A message is marked as published after the broker confirms it, so it may be published again when the relay stops in between.
+// A message which is nacked or returned as unroutable is retried like the other failures.
+// The messages of an aggregate are published in order: a relay stops at the first message of the aggregate which is leased
+// by another relay, waits for a retry or fails, and the later messages of the aggregate wait for it.
+// A message which fails MaxAttempts times is parked, it holds its aggregate without being retried.
+type Relay struct {
+	store     Store
+	db        db.Database
+	publisher Publisher
+	opt       RelayOpt
+	now       func() time.Time
+}
+
+func NewRelay(store Store, db db.Database, publisher Publisher, opt RelayOpt) *Relay {
+	if opt.BatchSize <= 0 {
+		opt.BatchSize = 100
+	}
+	if opt.Lease <= 0 {
+		opt.Lease = time.Minute
+	}
+	if opt.RetryDelay <= 0 {
+		opt.RetryDelay = 5 * time.Second
+	}
+	if opt.MaxRetryDelay < opt.RetryDelay {
+		opt.MaxRetryDelay = 10 * time.Minute
+	}
+	if opt.MaxAttempts <= 0 {
+		opt.MaxAttempts = 20
+	}
+
+	return &Relay{
+		store:     store,
+		db:        db,
+		publisher: publisher,
+		opt:       opt,
+		now:       func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// RelayPending publishes a batch of the pending messages, it returns the number of the published messages
+func (r *Relay) RelayPending(ctx context.Context) (int, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	now := r.now()
+	messages, err := r.store.ListPending(ctx, now, r.opt.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+
+	var claimed [][]*Message
+	err = r.inTx(ctx, func(ctx context.Context) *cus_err.CusError {
+		claimed, err = r.claim(ctx, messages, now)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	published := make([]*Message, 0)
+	failed := make(map[*Message]*cus_err.CusError)
+	for _, aggregate := range claimed {
+		for _, m := range aggregate {
+			if err := r.publish(ctx, m); err != nil {
+				// The rest of the aggregate are released when the lease expires
+				cus_otel.Error(ctx, "failed to publish the outbox message", cus_otel.NewField("messageId", m.Id), cus_otel.NewField("error", err))
+				failed[m] = err
+				break
+			}
+			published = append(published, m)
+		}
+	}
+
+	err = r.inTx(ctx, func(ctx context.Context) *cus_err.CusError {
+		for _, m := range published {
+			if err := r.store.MarkPublished(ctx, m.Id, r.now()); err != nil {
+				return err
+			}
+		}
+		for m, publishErr := range failed {
+			if m.Attempts+1 >= r.opt.MaxAttempts {
+				cus_otel.Error(ctx, "the outbox message is parked", cus_otel.NewField("messageId", m.Id), cus_otel.NewField("attempts", m.Attempts+1))
+				if err := r.store.Park(ctx, m.Id, r.now(), publishErr.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := r.store.Retry(ctx, m.Id, r.now().Add(r.retryDelay(m.Attempts+1)), publishErr.Error()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// The published messages are published again after the lease expires
+		return 0, err
+	}
+
+	return len(published), nil
+}
+
+// Run relays the pending messages every interval until the context is done, the full batches are relayed without waiting
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for ctx.Err() == nil {
+				count, err := r.RelayPending(ctx)
+				if err != nil {
+					cus_otel.Error(ctx, "failed to relay the outbox messages", cus_otel.NewField("error", err))
+					break
+				}
+				if count < r.opt.BatchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// claim leases the leading messages of each aggregate which are free, the claimed messages are grouped by the aggregates in order
+func (r *Relay) claim(ctx context.Context, messages []*Message, now time.Time) ([][]*Message, *cus_err.CusError) {
+	until := now.Add(r.opt.Lease)
+
+	aggregates := make([]string, 0)
+	byAggregate := make(map[string][]*Message)
+	for _, m := range messages {
+		key := m.Aggregate()
+		if _, ok := byAggregate[key]; !ok {
+			aggregates = append(aggregates, key)
+		}
+		byAggregate[key] = append(byAggregate[key], m)
+	}
+
+	claimed := make([][]*Message, 0, len(aggregates))
+	for _, key := range aggregates {
+		group := make([]*Message, 0)
+		for _, m := range byAggregate[key] {
+			if m.LockedUntil != nil && m.LockedUntil.After(now) {
+				break
+			}
+			ok, err := r.store.Claim(ctx, m.Id, now, until)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				break
+			}
+			group = append(group, m)
+		}
+		if len(group) > 0 {
+			claimed = append(claimed, group)
+		}
+	}
+
+	return claimed, nil
+}
+
+func (r *Relay) publish(ctx context.Context, m *Message) *cus_err.CusError {
+	body, err := json.Marshal(NewEnvelope(r.opt.Source, m))
+	if err != nil {
+		return cus_err.New(cus_err.InternalServerError, "failed to marshal the event envelope", err)
+	}
+
+	return r.publisher.Publish(ctx, r.opt.Exchange, m.EventType, true, body)
+}
+
+// retryDelay doubles the delay every attempt up to the max
+func (r *Relay) retryDelay(attempts int) time.Duration {
+	delay := r.opt.RetryDelay
+	for i := 1; i < attempts && delay < r.opt.MaxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, r.opt.MaxRetryDelay)
+}
+
+func (r *Relay) inTx(ctx context.Context, fn func(ctx context.Context) *cus_err.CusError) *cus_err.CusError {
+	ctx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = fn(ctx)
+	if err != nil {
+		_, rollbackErr := r.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return err
+	}
+
+	// Commit the transaction
+	_, err = r.db.Commit(ctx)
+	if err != nil {
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"go_micro_service_api/pkg/cus_err"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeStore keeps the messages in memory, publishedAt is set when a message is published and parkedAt when it's parked
+type fakeStore struct {
+	messages    []*Message
+	publishedAt map[int64]time.Time
+	parkedAt    map[int64]time.Time
+}
+
+func (s *fakeStore) add(t *testing.T, event *Event) *Message {
+	m, err := NewMessage(event, time.Now().UTC())
+	require.Nil(t, err)
+	m.Id = int64(len(s.messages) + 1)
+	s.messages = append(s.messages, m)
+	return m
+}
+
+func (s *fakeStore) ListPending(ctx context.Context, now time.Time, limit int) ([]*Message, *cus_err.CusError) {
+	res := make([]*Message, 0)
+	blocked := make(map[string]bool)
+	for _, m := range s.messages {
+		if _, ok := s.publishedAt[m.Id]; ok {
+			continue
+		}
+		_, parked := s.parkedAt[m.Id]
+		if blocked[m.Aggregate()] || parked || (m.LockedUntil != nil && m.LockedUntil.After(now)) {
+			blocked[m.Aggregate()] = true
+			continue
+		}
+		copied := *m
+		res = append(res, &copied)
+		if len(res) == limit {
+			break
+		}
+	}
+	return res, nil
+}
+
+func (s *fakeStore) Claim(ctx context.Context, id int64, now time.Time, until time.Time) (bool, *cus_err.CusError) {
+	m := s.messages[id-1]
+	if _, ok := s.publishedAt[id]; ok || (m.LockedUntil != nil && m.LockedUntil.After(now)) {
+		return false, nil
+	}
+	m.LockedUntil = &until
+	return true, nil
+}
+
+func (s *fakeStore) MarkPublished(ctx context.Context, id int64, publishedAt time.Time) *cus_err.CusError {
+	if s.publishedAt == nil {
+		s.publishedAt = map[int64]time.Time{}
+	}
+	s.publishedAt[id] = publishedAt
+	return nil
+}
+
+func (s *fakeStore) Retry(ctx context.Context, id int64, retryAt time.Time, lastError string) *cus_err.CusError {
+	m := s.messages[id-1]
+	m.Attempts++
+	m.LockedUntil = &retryAt
+	m.LastError = lastError
+	return nil
+}
+
+func (s *fakeStore) Park(ctx context.Context, id int64, parkedAt time.Time, lastError string) *cus_err.CusError {
+	if s.parkedAt == nil {
+		s.parkedAt = map[int64]time.Time{}
+	}
+	m := s.messages[id-1]
+	m.Attempts++
+	m.LockedUntil = nil
+	m.LastError = lastError
+	s.parkedAt[id] = parkedAt
+	return nil
+}
+
+// fakePublisher records the published envelopes, the event types in fail aren't accepted
+type fakePublisher struct {
+	envelopes []*Envelope
+	fail      map[string]bool
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, exchange string, routingKey string, durable bool, msg []byte) *cus_err.CusError {
+	if p.fail[routingKey] {
+		return cus_err.New(cus_err.InternalServerError, "broker is unavailable")
+	}
+	envelope := &Envelope{}
+	if err := json.Unmarshal(msg, envelope); err != nil {
+		return cus_err.New(cus_err.InternalServerError, "invalid envelope", err)
+	}
+	p.envelopes = append(p.envelopes, envelope)
+	return nil
+}
+
+// fakeDatabase has no transaction, the fake store applies the changes immediately
+type fakeDatabase struct{}
+
+func (fakeDatabase) GetConn(ctx context.Context) any   { return nil }
+func (fakeDatabase) GetTx(ctx context.Context) any     { return nil }
+func (fakeDatabase) GetClient(ctx context.Context) any { return nil }
+func (fakeDatabase) Begin(ctx context.Context) (context.Context, *cus_err.CusError) {
+	return ctx, nil
+}
+func (fakeDatabase) Commit(ctx context.Context) (context.Context, *cus_err.CusError) {
+	return ctx, nil
+}
+func (fakeDatabase) Rollback(ctx context.Context) (context.Context, *cus_err.CusError) {
+	return ctx, nil
+}
+
+func TestRelayPending(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeStore{}
+	publisher := &fakePublisher{fail: map[string]bool{}}
+	relay := NewRelay(store, fakeDatabase{}, publisher, RelayOpt{
+		Source:     "auth_service",
+		Exchange:   "auth.events",
+		Lease:      time.Minute,
+		RetryDelay: 10 * time.Second,
+	})
+	now := time.Now().UTC()
+	relay.now = func() time.Time { return now }
+
+	created := store.add(t, &Event{AggregateType: "user", AggregateId: "1", Type: "user.created", Version: 1, Payload: map[string]any{"account": "alice"}})
+	store.add(t, &Event{AggregateType: "user", AggregateId: "2", Type: "user.created", Version: 1})
+	store.add(t, &Event{AggregateType: "user", AggregateId: "1", Type: "user.locked", Version: 1})
+
+	t.Run("Publish the envelopes in order", func(t *testing.T) {
+		count, err := relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 3, count)
+		require.Len(t, publisher.envelopes, 3)
+
+		envelope := publisher.envelopes[0]
+		assert.Equal(t, SpecVersion, envelope.SpecVersion)
+		assert.Equal(t, created.EventId, envelope.Id)
+		assert.Equal(t, "auth_service", envelope.Source)
+		assert.Equal(t, "user.created", envelope.Type)
+		assert.Equal(t, 1, envelope.Version)
+		assert.Equal(t, "1", envelope.AggregateId)
+		assert.Equal(t, created.Id, envelope.Sequence)
+		assert.JSONEq(t, `{"account":"alice"}`, string(envelope.Payload))
+
+		// The events of the user are in order
+		assert.Equal(t, "user.locked", publisher.envelopes[1].Type)
+		assert.Equal(t, "2", publisher.envelopes[2].AggregateId)
+
+		count, err = relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Zero(t, count)
+	})
+
+	t.Run("The failed event holds the later events of its aggregate", func(t *testing.T) {
+		publisher.envelopes = nil
+		publisher.fail["user.roleChanged"] = true
+		failed := store.add(t, &Event{AggregateType: "user", AggregateId: "1", Type: "user.roleChanged", Version: 1})
+		store.add(t, &Event{AggregateType: "user", AggregateId: "1", Type: "user.deleted", Version: 1})
+		store.add(t, &Event{AggregateType: "client", AggregateId: "1", Type: "client.deactivated", Version: 1})
+
+		count, err := relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, publisher.envelopes, 1)
+		assert.Equal(t, "client.deactivated", publisher.envelopes[0].Type)
+		assert.Equal(t, 1, failed.Attempts)
+		assert.Equal(t, now.Add(10*time.Second), *failed.LockedUntil)
+		assert.Contains(t, failed.LastError, "broker is unavailable")
+
+		// Nothing is published before the retry
+		count, err = relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Zero(t, count)
+
+		// The second attempt fails again and waits longer
+		now = now.Add(time.Minute)
+		_, err = relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 2, failed.Attempts)
+		assert.Equal(t, now.Add(20*time.Second), *failed.LockedUntil)
+
+		publisher.fail["user.roleChanged"] = false
+		now = now.Add(2 * time.Minute)
+		count, err = relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 2, count)
+		require.Len(t, publisher.envelopes, 3)
+		assert.Equal(t, "user.roleChanged", publisher.envelopes[1].Type)
+		assert.Equal(t, "user.deleted", publisher.envelopes[2].Type)
+	})
+
+	t.Run("Skip the aggregate claimed by another relay", func(t *testing.T) {
+		publisher.envelopes = nil
+		first := store.add(t, &Event{AggregateType: "user", AggregateId: "3", Type: "user.created", Version: 1})
+		store.add(t, &Event{AggregateType: "user", AggregateId: "3", Type: "user.locked", Version: 1})
+		until := now.Add(time.Minute)
+		first.LockedUntil = &until
+
+		count, err := relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Zero(t, count)
+
+		// The other relay stops before publishing, the lease expires
+		now = until
+		count, err = relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 2, count)
+
+		sequences := []int64{publisher.envelopes[0].Sequence, publisher.envelopes[1].Sequence}
+		assert.True(t, sort.SliceIsSorted(sequences, func(i, j int) bool { return sequences[i] < sequences[j] }))
+	})
+}
+
+func TestBlockedAggregates(t *testing.T) {
+	ctx := context.Background()
+	store := &fakeStore{}
+	publisher := &fakePublisher{fail: map[string]bool{"client.created": true}}
+	relay := NewRelay(store, fakeDatabase{}, publisher, RelayOpt{
+		Source:      "auth_service",
+		Exchange:    "auth.events",
+		BatchSize:   2,
+		Lease:       time.Minute,
+		RetryDelay:  10 * time.Second,
+		MaxAttempts: 2,
+	})
+	now := time.Now().UTC()
+	relay.now = func() time.Time { return now }
+
+	// The first rows are leased by another relay or keep failing
+	until := now.Add(time.Hour)
+	for i := 0; i < 3; i++ {
+		m := store.add(t, &Event{AggregateType: "user", AggregateId: "1", Type: "user.locked", Version: 1})
+		m.LockedUntil = &until
+	}
+	poison := store.add(t, &Event{AggregateType: "client", AggregateId: "1", Type: "client.created", Version: 1})
+	held := store.add(t, &Event{AggregateType: "client", AggregateId: "1", Type: "client.deactivated", Version: 1})
+	store.add(t, &Event{AggregateType: "user", AggregateId: "2", Type: "user.created", Version: 1})
+	store.add(t, &Event{AggregateType: "user", AggregateId: "3", Type: "user.created", Version: 1})
+
+	t.Run("The blocked aggregates don't use up the batch", func(t *testing.T) {
+		count, err := relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Zero(t, count)
+		assert.Equal(t, 1, poison.Attempts)
+
+		// The failed message waits for the retry, the later aggregates are listed
+		count, err = relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 2, count)
+		require.Len(t, publisher.envelopes, 2)
+		assert.Equal(t, "2", publisher.envelopes[0].AggregateId)
+		assert.Equal(t, "3", publisher.envelopes[1].AggregateId)
+	})
+
+	t.Run("Park the message after the max attempts", func(t *testing.T) {
+		publisher.envelopes = nil
+		now = now.Add(time.Minute)
+		count, err := relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Zero(t, count)
+		assert.Equal(t, 2, poison.Attempts)
+		assert.Contains(t, store.parkedAt, poison.Id)
+		assert.Nil(t, poison.LockedUntil)
+		assert.Contains(t, poison.LastError, "broker is unavailable")
+
+		// The parked message isn't retried and holds the later message of its aggregate
+		store.add(t, &Event{AggregateType: "user", AggregateId: "4", Type: "user.created", Version: 1})
+		now = now.Add(time.Hour)
+		count, err = relay.RelayPending(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 2, count)
+		assert.Equal(t, 2, poison.Attempts)
+		assert.NotContains(t, store.publishedAt, held.Id)
+		for _, envelope := range publisher.envelopes {
+			assert.NotEqual(t, "client", envelope.AggregateType)
+		}
+	})
+}
+
+func TestRetryDelay(t *testing.T) {
+	relay := NewRelay(&fakeStore{}, fakeDatabase{}, &fakePublisher{}, RelayOpt{RetryDelay: time.Second, MaxRetryDelay: 5 * time.Second})
+
+	assert.Equal(t, time.Second, relay.retryDelay(1))
+	assert.Equal(t, 2*time.Second, relay.retryDelay(2))
+	assert.Equal(t, 4*time.Second, relay.retryDelay(3))
+	assert.Equal(t, 5*time.Second, relay.retryDelay(4))
+	assert.Equal(t, 5*time.Second, relay.retryDelay(30))
+}
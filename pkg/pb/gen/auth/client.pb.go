@@ -25,12 +25,20 @@ type CreateClientRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientId         int64 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                           // 客戶端id
-	MerchantId       int64 `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`                     // 客戶端所屬商戶id
-	ClientType       int32 `protobuf:"varint,3,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`                     // 客戶端類型 使用 pkg/enum/client_type 的id作為參數
-	LoginFailedTimes int32 `protobuf:"varint,4,opt,name=login_failed_times,json=loginFailedTimes,proto3" json:"login_failed_times,omitempty"` // 登入失敗次數
-	TokenExpireSecs  int64 `protobuf:"varint,5,opt,name=token_expire_secs,json=tokenExpireSecs,proto3" json:"token_expire_secs,omitempty"`    // token過期時間(秒)
-	IsActive         bool  `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                           // 是否啟用
+	ClientId               int64           `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                                               // 客戶端id
+	MerchantId             int64           `protobuf:"varint,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`                                         // 客戶端所屬商戶id
+	ClientType             int32           `protobuf:"varint,3,opt,name=client_type,json=clientType,proto3" json:"client_type,omitempty"`                                         // 客戶端類型 使用 pkg/enum/client_type 的id作為參數
+	LoginFailedTimes       int32           `protobuf:"varint,4,opt,name=login_failed_times,json=loginFailedTimes,proto3" json:"login_failed_times,omitempty"`                     // 登入失敗次數
+	TokenExpireSecs        int64           `protobuf:"varint,5,opt,name=token_expire_secs,json=tokenExpireSecs,proto3" json:"token_expire_secs,omitempty"`                        // token過期時間(秒)
+	IsActive               bool            `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                                               // 是否啟用
+	RefreshTokenExpireSecs int64           `protobuf:"varint,7,opt,name=refresh_token_expire_secs,json=refreshTokenExpireSecs,proto3" json:"refresh_token_expire_secs,omitempty"` // refresh token過期時間(秒), 未設定時預設14天
+	SessionPolicy          int32           `protobuf:"varint,8,opt,name=session_policy,json=sessionPolicy,proto3" json:"session_policy,omitempty"`                                // 多裝置登入策略 使用 pkg/enum/session_policy 的值作為參數, 未設定時預設單一裝置
+	MaxSessions            int32           `protobuf:"varint,9,opt,name=max_sessions,json=maxSessions,proto3" json:"max_sessions,omitempty"`                                      // 同時登入裝置數上限, 僅限制裝置數策略使用, 未設定時預設1
+	SigningMethod          int32           `protobuf:"varint,10,opt,name=signing_method,json=signingMethod,proto3" json:"signing_method,omitempty"`                               // token簽章方式 使用 pkg/enum/signing_method 的id作為參數, 未設定時預設HS256
+	MfaRequired            bool            `protobuf:"varint,11,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`                                     // 是否強制玩家使用二次驗證(TOTP), 僅後台客戶端可開啟
+	PasswordPolicy         *PasswordPolicy `protobuf:"bytes,12,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`                             // 密碼規則, 未設定時不限制
+	LockoutPolicy          *LockoutPolicy  `protobuf:"bytes,13,opt,name=lockout_policy,json=lockoutPolicy,proto3" json:"lockout_policy,omitempty"`                                // 帳號鎖定規則, 未設定時鎖定至管理員解鎖
+	RiskPolicy             *RiskPolicy     `protobuf:"bytes,14,opt,name=risk_policy,json=riskPolicy,proto3" json:"risk_policy,omitempty"`                                         // 登入風險規則, 未設定時使用預設的驗證分數且不拒絕登入
 }
 
 func (x *CreateClientRequest) Reset() {
@@ -105,15 +113,79 @@ func (x *CreateClientRequest) GetIsActive() bool {
 	return false
 }
 
+func (x *CreateClientRequest) GetRefreshTokenExpireSecs() int64 {
+	if x != nil {
+		return x.RefreshTokenExpireSecs
+	}
+	return 0
+}
+
+func (x *CreateClientRequest) GetSessionPolicy() int32 {
+	if x != nil {
+		return x.SessionPolicy
+	}
+	return 0
+}
+
+func (x *CreateClientRequest) GetMaxSessions() int32 {
+	if x != nil {
+		return x.MaxSessions
+	}
+	return 0
+}
+
+func (x *CreateClientRequest) GetSigningMethod() int32 {
+	if x != nil {
+		return x.SigningMethod
+	}
+	return 0
+}
+
+func (x *CreateClientRequest) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *CreateClientRequest) GetPasswordPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.PasswordPolicy
+	}
+	return nil
+}
+
+func (x *CreateClientRequest) GetLockoutPolicy() *LockoutPolicy {
+	if x != nil {
+		return x.LockoutPolicy
+	}
+	return nil
+}
+
+func (x *CreateClientRequest) GetRiskPolicy() *RiskPolicy {
+	if x != nil {
+		return x.RiskPolicy
+	}
+	return nil
+}
+
 type UpdateClientRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ClientId         int64 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                           // 客戶端id
-	LoginFailedTimes int32 `protobuf:"varint,2,opt,name=login_failed_times,json=loginFailedTimes,proto3" json:"login_failed_times,omitempty"` // 登入失敗次數
-	TokenExpireSecs  int64 `protobuf:"varint,3,opt,name=token_expire_secs,json=tokenExpireSecs,proto3" json:"token_expire_secs,omitempty"`    // token過期時間(秒)
-	IsActive         bool  `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                           // 是否啟用
+	ClientId               int64           `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`                                               // 客戶端id
+	LoginFailedTimes       int32           `protobuf:"varint,2,opt,name=login_failed_times,json=loginFailedTimes,proto3" json:"login_failed_times,omitempty"`                     // 登入失敗次數
+	TokenExpireSecs        int64           `protobuf:"varint,3,opt,name=token_expire_secs,json=tokenExpireSecs,proto3" json:"token_expire_secs,omitempty"`                        // token過期時間(秒)
+	IsActive               bool            `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`                                               // 是否啟用
+	RefreshTokenExpireSecs int64           `protobuf:"varint,5,opt,name=refresh_token_expire_secs,json=refreshTokenExpireSecs,proto3" json:"refresh_token_expire_secs,omitempty"` // refresh token過期時間(秒), 未設定時不變更
+	SessionPolicy          int32           `protobuf:"varint,6,opt,name=session_policy,json=sessionPolicy,proto3" json:"session_policy,omitempty"`                                // 多裝置登入策略 使用 pkg/enum/session_policy 的值作為參數, 未設定時不變更
+	MaxSessions            int32           `protobuf:"varint,7,opt,name=max_sessions,json=maxSessions,proto3" json:"max_sessions,omitempty"`                                      // 同時登入裝置數上限, 未設定時不變更
+	SigningMethod          int32           `protobuf:"varint,8,opt,name=signing_method,json=signingMethod,proto3" json:"signing_method,omitempty"`                                // token簽章方式, 未設定時不變更, 變更後已發出的token將失效
+	MfaRequired            bool            `protobuf:"varint,9,opt,name=mfa_required,json=mfaRequired,proto3" json:"mfa_required,omitempty"`                                      // 是否強制玩家使用二次驗證(TOTP), 僅後台客戶端可開啟
+	PasswordPolicy         *PasswordPolicy `protobuf:"bytes,10,opt,name=password_policy,json=passwordPolicy,proto3" json:"password_policy,omitempty"`                             // 密碼規則, 未設定時不變更
+	LockoutPolicy          *LockoutPolicy  `protobuf:"bytes,11,opt,name=lockout_policy,json=lockoutPolicy,proto3" json:"lockout_policy,omitempty"`                                // 帳號鎖定規則, 未設定時不變更
+	RiskPolicy             *RiskPolicy     `protobuf:"bytes,12,opt,name=risk_policy,json=riskPolicy,proto3" json:"risk_policy,omitempty"`                                         // 登入風險規則, 未設定時不變更
 }
 
 func (x *UpdateClientRequest) Reset() {
@@ -174,6 +246,248 @@ func (x *UpdateClientRequest) GetIsActive() bool {
 	return false
 }
 
+func (x *UpdateClientRequest) GetRefreshTokenExpireSecs() int64 {
+	if x != nil {
+		return x.RefreshTokenExpireSecs
+	}
+	return 0
+}
+
+func (x *UpdateClientRequest) GetSessionPolicy() int32 {
+	if x != nil {
+		return x.SessionPolicy
+	}
+	return 0
+}
+
+func (x *UpdateClientRequest) GetMaxSessions() int32 {
+	if x != nil {
+		return x.MaxSessions
+	}
+	return 0
+}
+
+func (x *UpdateClientRequest) GetSigningMethod() int32 {
+	if x != nil {
+		return x.SigningMethod
+	}
+	return 0
+}
+
+func (x *UpdateClientRequest) GetMfaRequired() bool {
+	if x != nil {
+		return x.MfaRequired
+	}
+	return false
+}
+
+func (x *UpdateClientRequest) GetPasswordPolicy() *PasswordPolicy {
+	if x != nil {
+		return x.PasswordPolicy
+	}
+	return nil
+}
+
+func (x *UpdateClientRequest) GetLockoutPolicy() *LockoutPolicy {
+	if x != nil {
+		return x.LockoutPolicy
+	}
+	return nil
+}
+
+func (x *UpdateClientRequest) GetRiskPolicy() *RiskPolicy {
+	if x != nil {
+		return x.RiskPolicy
+	}
+	return nil
+}
+
+// 密碼規則, 欄位為0或空值時不檢查該規則, 在創建用戶、更新用戶密碼、重設密碼及變更密碼時檢查
+type PasswordPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLength       int32    `protobuf:"varint,1,opt,name=min_length,json=minLength,proto3" json:"min_length,omitempty"`                  // 密碼最小長度
+	CharClasses     []string `protobuf:"bytes,2,rep,name=char_classes,json=charClasses,proto3" json:"char_classes,omitempty"`             // 密碼必須包含的字元類型 使用 pkg/enum/password_char_class 的值作為參數
+	HistoryCount    int32    `protobuf:"varint,3,opt,name=history_count,json=historyCount,proto3" json:"history_count,omitempty"`         // 不可與最近幾次的密碼相同(包含目前密碼)
+	MaxAgeSecs      int64    `protobuf:"varint,4,opt,name=max_age_secs,json=maxAgeSecs,proto3" json:"max_age_secs,omitempty"`             // 密碼有效秒數, 超過後登入會回傳 password_expired 提示變更密碼
+	BannedPasswords []string `protobuf:"bytes,5,rep,name=banned_passwords,json=bannedPasswords,proto3" json:"banned_passwords,omitempty"` // 禁用密碼, 不分大小寫
+}
+
+func (x *PasswordPolicy) Reset() {
+	*x = PasswordPolicy{}
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PasswordPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PasswordPolicy) ProtoMessage() {}
+
+func (x *PasswordPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PasswordPolicy.ProtoReflect.Descriptor instead.
+func (*PasswordPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PasswordPolicy) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetCharClasses() []string {
+	if x != nil {
+		return x.CharClasses
+	}
+	return nil
+}
+
+func (x *PasswordPolicy) GetHistoryCount() int32 {
+	if x != nil {
+		return x.HistoryCount
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetMaxAgeSecs() int64 {
+	if x != nil {
+		return x.MaxAgeSecs
+	}
+	return 0
+}
+
+func (x *PasswordPolicy) GetBannedPasswords() []string {
+	if x != nil {
+		return x.BannedPasswords
+	}
+	return nil
+}
+
+// 帳號鎖定規則, 密碼錯誤次數達到 login_failed_times 時鎖定帳號, 到期後自動解鎖
+type LockoutPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LockoutSecs    int64 `protobuf:"varint,1,opt,name=lockout_secs,json=lockoutSecs,proto3" json:"lockout_secs,omitempty"`            // 第一次鎖定的秒數, 每次重複鎖定加倍, 登入成功後重新計算, 0為鎖定至管理員解鎖
+	MaxLockoutSecs int64 `protobuf:"varint,2,opt,name=max_lockout_secs,json=maxLockoutSecs,proto3" json:"max_lockout_secs,omitempty"` // 鎖定秒數上限, 0為不限制
+}
+
+func (x *LockoutPolicy) Reset() {
+	*x = LockoutPolicy{}
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LockoutPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockoutPolicy) ProtoMessage() {}
+
+func (x *LockoutPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LockoutPolicy.ProtoReflect.Descriptor instead.
+func (*LockoutPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LockoutPolicy) GetLockoutSecs() int64 {
+	if x != nil {
+		return x.LockoutSecs
+	}
+	return 0
+}
+
+func (x *LockoutPolicy) GetMaxLockoutSecs() int64 {
+	if x != nil {
+		return x.MaxLockoutSecs
+	}
+	return 0
+}
+
+// 登入風險規則, 登入時依新國家、不可能的移動距離、新裝置、連續失敗、可疑ISP、機器人及異常時段計算風險分數
+type RiskPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChallengeScore int32 `protobuf:"varint,1,opt,name=challenge_score,json=challengeScore,proto3" json:"challenge_score,omitempty"` // 達到此分數需通過 unusualLogin 驗證才能完成登入, 0為預設50分
+	DenyScore      int32 `protobuf:"varint,2,opt,name=deny_score,json=denyScore,proto3" json:"deny_score,omitempty"`                // 達到此分數拒絕登入, 需大於 challenge_score, 0為不拒絕
+}
+
+func (x *RiskPolicy) Reset() {
+	*x = RiskPolicy{}
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskPolicy) ProtoMessage() {}
+
+func (x *RiskPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskPolicy.ProtoReflect.Descriptor instead.
+func (*RiskPolicy) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RiskPolicy) GetChallengeScore() int32 {
+	if x != nil {
+		return x.ChallengeScore
+	}
+	return 0
+}
+
+func (x *RiskPolicy) GetDenyScore() int32 {
+	if x != nil {
+		return x.DenyScore
+	}
+	return 0
+}
+
 type CreateRoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -186,7 +500,7 @@ type CreateRoleRequest struct {
 
 func (x *CreateRoleRequest) Reset() {
 	*x = CreateRoleRequest{}
-	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[2]
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -198,7 +512,7 @@ func (x *CreateRoleRequest) String() string {
 func (*CreateRoleRequest) ProtoMessage() {}
 
 func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[2]
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -211,7 +525,7 @@ func (x *CreateRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRoleRequest.ProtoReflect.Descriptor instead.
 func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{2}
+	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateRoleRequest) GetClientId() int64 {
@@ -248,7 +562,7 @@ type UpdateRoleRequest struct {
 
 func (x *UpdateRoleRequest) Reset() {
 	*x = UpdateRoleRequest{}
-	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[3]
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -260,7 +574,7 @@ func (x *UpdateRoleRequest) String() string {
 func (*UpdateRoleRequest) ProtoMessage() {}
 
 func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[3]
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,7 +587,7 @@ func (x *UpdateRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateRoleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateRoleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{3}
+	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *UpdateRoleRequest) GetClientId() int64 {
@@ -315,7 +629,7 @@ type DeleteRoleRequest struct {
 
 func (x *DeleteRoleRequest) Reset() {
 	*x = DeleteRoleRequest{}
-	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[4]
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -327,7 +641,7 @@ func (x *DeleteRoleRequest) String() string {
 func (*DeleteRoleRequest) ProtoMessage() {}
 
 func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[4]
+	mi := &file_pkg_pb_protos_auth_client_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +654,7 @@ func (x *DeleteRoleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteRoleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteRoleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{4}
+	return file_pkg_pb_protos_auth_client_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *DeleteRoleRequest) GetClientId() int64 {
@@ -364,7 +678,7 @@ var file_pkg_pb_protos_auth_client_proto_rawDesc = []byte{
 	0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x04, 0x61, 0x75, 0x74, 0x68, 0x1a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x2f, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xeb, 0x01, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe8, 0x04, 0x0a, 0x13, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a,
@@ -379,56 +693,127 @@ var file_pkg_pb_protos_auth_client_proto_rawDesc = []byte{
 	0x63, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45,
 	0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f,
 	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c,
-	0x6f, 0x67, 0x69, 0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61,
-	0x69, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x22, 0x68, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x73,
+	0x65, 0x63, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x72, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x65, 0x63,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x66, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x3a, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f,
+	0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x31, 0x0a, 0x0b, 0x72, 0x69, 0x73, 0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x69, 0x73,
+	0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x22, 0xa6, 0x04, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x67, 0x69,
+	0x6e, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x65,
+	0x63, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x39, 0x0a, 0x19, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x16, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x5f,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x66, 0x61, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x6d, 0x66, 0x61, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3d,
+	0x0a, 0x0f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0e, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x3a, 0x0a,
+	0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x4c, 0x6f, 0x63,
+	0x6b, 0x6f, 0x75, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x6c, 0x6f, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x31, 0x0a, 0x0b, 0x72, 0x69, 0x73,
+	0x6b, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x52, 0x0a, 0x72, 0x69, 0x73, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xc4, 0x01, 0x0a,
+	0x0e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x63, 0x68, 0x61, 0x72, 0x5f, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x65,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67,
+	0x65, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61,
+	0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x62, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x22, 0x5c, 0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f,
+	0x73, 0x65, 0x63, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6c,
+	0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x53, 0x65, 0x63,
+	0x73, 0x22, 0x54, 0x0a, 0x0a, 0x52, 0x69, 0x73, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6c, 0x6c, 0x65,
+	0x6e, 0x67, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6e, 0x79,
+	0x5f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x65,
+	0x6e, 0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x68, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x49, 0x64,
+	0x73, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
 	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x81, 0x01, 0x0a,
-	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x6f, 0x6c, 0x65,
-	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x6f, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65, 0x72, 0x6d, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65, 0x72, 0x6d, 0x49, 0x64, 0x73,
-	0x22, 0x49, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x32, 0x99, 0x02, 0x0a, 0x0d,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x36, 0x0a,
-	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e,
-	0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x31, 0x0a,
-	0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x75,
-	0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x65,
-	0x12, 0x31, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17,
-	0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x52,
-	0x6f, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c,
-	0x65, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74,
-	0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x61, 0x75, 0x74, 0x68,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x6f, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x65,
+	0x72, 0x6d, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x03, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x64,
+	0x32, 0x99, 0x02, 0x0a, 0x0d, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x12, 0x36, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e,
+	0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x36, 0x0a, 0x0c, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x2e, 0x61, 0x75, 0x74,
+	0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x12, 0x31, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
+	0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61, 0x75, 0x74, 0x68,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0a, 0x2e, 0x61,
+	0x75, 0x74, 0x68, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x17, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0b, 0x2e, 0x61, 0x75, 0x74, 0x68, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x07, 0x5a, 0x05,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -443,32 +828,41 @@ func file_pkg_pb_protos_auth_client_proto_rawDescGZIP() []byte {
 	return file_pkg_pb_protos_auth_client_proto_rawDescData
 }
 
-var file_pkg_pb_protos_auth_client_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_pkg_pb_protos_auth_client_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_pkg_pb_protos_auth_client_proto_goTypes = []any{
 	(*CreateClientRequest)(nil), // 0: auth.CreateClientRequest
 	(*UpdateClientRequest)(nil), // 1: auth.UpdateClientRequest
-	(*CreateRoleRequest)(nil),   // 2: auth.CreateRoleRequest
-	(*UpdateRoleRequest)(nil),   // 3: auth.UpdateRoleRequest
-	(*DeleteRoleRequest)(nil),   // 4: auth.DeleteRoleRequest
-	(*Empty)(nil),               // 5: auth.Empty
-	(*Role)(nil),                // 6: auth.Role
+	(*PasswordPolicy)(nil),      // 2: auth.PasswordPolicy
+	(*LockoutPolicy)(nil),       // 3: auth.LockoutPolicy
+	(*RiskPolicy)(nil),          // 4: auth.RiskPolicy
+	(*CreateRoleRequest)(nil),   // 5: auth.CreateRoleRequest
+	(*UpdateRoleRequest)(nil),   // 6: auth.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),   // 7: auth.DeleteRoleRequest
+	(*Empty)(nil),               // 8: auth.Empty
+	(*Role)(nil),                // 9: auth.Role
 }
 var file_pkg_pb_protos_auth_client_proto_depIdxs = []int32{
-	0, // 0: auth.ClientService.CreateClient:input_type -> auth.CreateClientRequest
-	1, // 1: auth.ClientService.UpdateClient:input_type -> auth.UpdateClientRequest
-	2, // 2: auth.ClientService.CreateRole:input_type -> auth.CreateRoleRequest
-	3, // 3: auth.ClientService.UpdateRole:input_type -> auth.UpdateRoleRequest
-	4, // 4: auth.ClientService.DeleteRole:input_type -> auth.DeleteRoleRequest
-	5, // 5: auth.ClientService.CreateClient:output_type -> auth.Empty
-	5, // 6: auth.ClientService.UpdateClient:output_type -> auth.Empty
-	6, // 7: auth.ClientService.CreateRole:output_type -> auth.Role
-	6, // 8: auth.ClientService.UpdateRole:output_type -> auth.Role
-	5, // 9: auth.ClientService.DeleteRole:output_type -> auth.Empty
-	5, // [5:10] is the sub-list for method output_type
-	0, // [0:5] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2,  // 0: auth.CreateClientRequest.password_policy:type_name -> auth.PasswordPolicy
+	3,  // 1: auth.CreateClientRequest.lockout_policy:type_name -> auth.LockoutPolicy
+	4,  // 2: auth.CreateClientRequest.risk_policy:type_name -> auth.RiskPolicy
+	2,  // 3: auth.UpdateClientRequest.password_policy:type_name -> auth.PasswordPolicy
+	3,  // 4: auth.UpdateClientRequest.lockout_policy:type_name -> auth.LockoutPolicy
+	4,  // 5: auth.UpdateClientRequest.risk_policy:type_name -> auth.RiskPolicy
+	0,  // 6: auth.ClientService.CreateClient:input_type -> auth.CreateClientRequest
+	1,  // 7: auth.ClientService.UpdateClient:input_type -> auth.UpdateClientRequest
+	5,  // 8: auth.ClientService.CreateRole:input_type -> auth.CreateRoleRequest
+	6,  // 9: auth.ClientService.UpdateRole:input_type -> auth.UpdateRoleRequest
+	7,  // 10: auth.ClientService.DeleteRole:input_type -> auth.DeleteRoleRequest
+	8,  // 11: auth.ClientService.CreateClient:output_type -> auth.Empty
+	8,  // 12: auth.ClientService.UpdateClient:output_type -> auth.Empty
+	9,  // 13: auth.ClientService.CreateRole:output_type -> auth.Role
+	9,  // 14: auth.ClientService.UpdateRole:output_type -> auth.Role
+	8,  // 15: auth.ClientService.DeleteRole:output_type -> auth.Empty
+	11, // [11:16] is the sub-list for method output_type
+	6,  // [6:11] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_pkg_pb_protos_auth_client_proto_init() }
@@ -483,7 +877,7 @@ func file_pkg_pb_protos_auth_client_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_pb_protos_auth_client_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
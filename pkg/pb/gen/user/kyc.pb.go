@@ -0,0 +1,982 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: pkg/pb/protos/user/kyc.proto
+
+package user
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 上傳的證件, 每份最大 5MB, 最多 3 份
+type KycDocumentUpload struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`               // idCard, passport, driverLicense, selfie, 至少需要一份身分證件
+	ContentType string `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"` // image/jpeg, image/png, application/pdf
+	Content     []byte `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *KycDocumentUpload) Reset() {
+	*x = KycDocumentUpload{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KycDocumentUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycDocumentUpload) ProtoMessage() {}
+
+func (x *KycDocumentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycDocumentUpload.ProtoReflect.Descriptor instead.
+func (*KycDocumentUpload) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KycDocumentUpload) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KycDocumentUpload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *KycDocumentUpload) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type KycDocument struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // idCard, passport, driverLicense, selfie
+	ContentType string `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Size        int32  `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"` // bytes
+}
+
+func (x *KycDocument) Reset() {
+	*x = KycDocument{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KycDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycDocument) ProtoMessage() {}
+
+func (x *KycDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycDocument.ProtoReflect.Descriptor instead.
+func (*KycDocument) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *KycDocument) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *KycDocument) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *KycDocument) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type KycSubmission struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       int64          `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Status       string         `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"` // pending, approved, rejected
+	Documents    []*KycDocument `protobuf:"bytes,4,rep,name=documents,proto3" json:"documents,omitempty"`
+	ReviewerId   int64          `protobuf:"varint,5,opt,name=reviewerId,proto3" json:"reviewerId,omitempty"`    // 審核人員id, 未審核時為0
+	ReviewerNote string         `protobuf:"bytes,6,opt,name=reviewerNote,proto3" json:"reviewerNote,omitempty"` // 審核備註, 駁回時為駁回原因
+	SubmittedAt  int64          `protobuf:"varint,7,opt,name=submittedAt,proto3" json:"submittedAt,omitempty"`  // unix秒
+	ReviewedAt   int64          `protobuf:"varint,8,opt,name=reviewedAt,proto3" json:"reviewedAt,omitempty"`    // unix秒, 未審核時為0
+}
+
+func (x *KycSubmission) Reset() {
+	*x = KycSubmission{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KycSubmission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KycSubmission) ProtoMessage() {}
+
+func (x *KycSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KycSubmission.ProtoReflect.Descriptor instead.
+func (*KycSubmission) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KycSubmission) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetDocuments() []*KycDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *KycSubmission) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetReviewerNote() string {
+	if x != nil {
+		return x.ReviewerNote
+	}
+	return ""
+}
+
+func (x *KycSubmission) GetSubmittedAt() int64 {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return 0
+}
+
+func (x *KycSubmission) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+type SubmitKycRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId    int64                `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Documents []*KycDocumentUpload `protobuf:"bytes,2,rep,name=documents,proto3" json:"documents,omitempty"`
+}
+
+func (x *SubmitKycRequest) Reset() {
+	*x = SubmitKycRequest{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitKycRequest) ProtoMessage() {}
+
+func (x *SubmitKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitKycRequest.ProtoReflect.Descriptor instead.
+func (*SubmitKycRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SubmitKycRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitKycRequest) GetDocuments() []*KycDocumentUpload {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+type SubmitKycResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submission *KycSubmission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+}
+
+func (x *SubmitKycResponse) Reset() {
+	*x = SubmitKycResponse{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitKycResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitKycResponse) ProtoMessage() {}
+
+func (x *SubmitKycResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitKycResponse.ProtoReflect.Descriptor instead.
+func (*SubmitKycResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SubmitKycResponse) GetSubmission() *KycSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type GetKycSubmissionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId int64 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *GetKycSubmissionRequest) Reset() {
+	*x = GetKycSubmissionRequest{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycSubmissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycSubmissionRequest) ProtoMessage() {}
+
+func (x *GetKycSubmissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycSubmissionRequest.ProtoReflect.Descriptor instead.
+func (*GetKycSubmissionRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetKycSubmissionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetKycSubmissionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submission *KycSubmission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"` // 未曾送出時為空
+}
+
+func (x *GetKycSubmissionResponse) Reset() {
+	*x = GetKycSubmissionResponse{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycSubmissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycSubmissionResponse) ProtoMessage() {}
+
+func (x *GetKycSubmissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycSubmissionResponse.ProtoReflect.Descriptor instead.
+func (*GetKycSubmissionResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetKycSubmissionResponse) GetSubmission() *KycSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+type ListKycSubmissionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`      // pending, approved, rejected, 預設為 pending
+	Page     int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`         // 頁碼, 從1開始
+	PageSize int32  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"` // 每頁筆數, 預設20, 最多100
+}
+
+func (x *ListKycSubmissionsRequest) Reset() {
+	*x = ListKycSubmissionsRequest{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKycSubmissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKycSubmissionsRequest) ProtoMessage() {}
+
+func (x *ListKycSubmissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKycSubmissionsRequest.ProtoReflect.Descriptor instead.
+func (*ListKycSubmissionsRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListKycSubmissionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListKycSubmissionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListKycSubmissionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListKycSubmissionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submissions []*KycSubmission `protobuf:"bytes,1,rep,name=submissions,proto3" json:"submissions,omitempty"`
+	Total       int64            `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"` // 符合條件的總筆數
+	Page        int32            `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize    int32            `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+}
+
+func (x *ListKycSubmissionsResponse) Reset() {
+	*x = ListKycSubmissionsResponse{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListKycSubmissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListKycSubmissionsResponse) ProtoMessage() {}
+
+func (x *ListKycSubmissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListKycSubmissionsResponse.ProtoReflect.Descriptor instead.
+func (*ListKycSubmissionsResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListKycSubmissionsResponse) GetSubmissions() []*KycSubmission {
+	if x != nil {
+		return x.Submissions
+	}
+	return nil
+}
+
+func (x *ListKycSubmissionsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListKycSubmissionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListKycSubmissionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetKycDocumentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId int64  `protobuf:"varint,1,opt,name=submissionId,proto3" json:"submissionId,omitempty"`
+	Type         string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"` // idCard, passport, driverLicense, selfie
+}
+
+func (x *GetKycDocumentRequest) Reset() {
+	*x = GetKycDocumentRequest{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycDocumentRequest) ProtoMessage() {}
+
+func (x *GetKycDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetKycDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetKycDocumentRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *GetKycDocumentRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+type GetKycDocumentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Document *KycDocument `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	Content  []byte       `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *GetKycDocumentResponse) Reset() {
+	*x = GetKycDocumentResponse{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetKycDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetKycDocumentResponse) ProtoMessage() {}
+
+func (x *GetKycDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetKycDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetKycDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetKycDocumentResponse) GetDocument() *KycDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+func (x *GetKycDocumentResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type ReviewKycRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubmissionId int64  `protobuf:"varint,1,opt,name=submissionId,proto3" json:"submissionId,omitempty"`
+	ReviewerId   int64  `protobuf:"varint,2,opt,name=reviewerId,proto3" json:"reviewerId,omitempty"` // 審核人員id
+	Approved     bool   `protobuf:"varint,3,opt,name=approved,proto3" json:"approved,omitempty"`
+	Note         string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"` // 審核備註, 駁回時必填
+}
+
+func (x *ReviewKycRequest) Reset() {
+	*x = ReviewKycRequest{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewKycRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKycRequest) ProtoMessage() {}
+
+func (x *ReviewKycRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKycRequest.ProtoReflect.Descriptor instead.
+func (*ReviewKycRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ReviewKycRequest) GetSubmissionId() int64 {
+	if x != nil {
+		return x.SubmissionId
+	}
+	return 0
+}
+
+func (x *ReviewKycRequest) GetReviewerId() int64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ReviewKycRequest) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ReviewKycRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReviewKycResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Submission *KycSubmission `protobuf:"bytes,1,opt,name=submission,proto3" json:"submission,omitempty"`
+}
+
+func (x *ReviewKycResponse) Reset() {
+	*x = ReviewKycResponse{}
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewKycResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewKycResponse) ProtoMessage() {}
+
+func (x *ReviewKycResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_kyc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewKycResponse.ProtoReflect.Descriptor instead.
+func (*ReviewKycResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_kyc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReviewKycResponse) GetSubmission() *KycSubmission {
+	if x != nil {
+		return x.Submission
+	}
+	return nil
+}
+
+var File_pkg_pb_protos_user_kyc_proto protoreflect.FileDescriptor
+
+var file_pkg_pb_protos_user_kyc_proto_rawDesc = []byte{
+	0x0a, 0x1c, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x6b, 0x79, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x22, 0x63, 0x0a, 0x11, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x57, 0x0a, 0x0b, 0x4b, 0x79, 0x63,
+	0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x0d, 0x4b, 0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4b,
+	0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65,
+	0x72, 0x4e, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x62,
+	0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x73, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0a, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x64, 0x41, 0x74, 0x22, 0x61, 0x0a, 0x10, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x09, 0x64, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x48,
+	0x0a, 0x11, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4b,
+	0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4b,
+	0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x4b, 0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x4b, 0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x4b, 0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x79, 0x63, 0x53, 0x75, 0x62,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x0b, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4b, 0x79, 0x63,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x75, 0x62, 0x6d,
+	0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4f, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x61,
+	0x0a, 0x16, 0x47, 0x65, 0x74, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x08, 0x64, 0x6f, 0x63, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x64,
+	0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x79, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x73, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70,
+	0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x48, 0x0a, 0x11, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x33, 0x0a, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4b, 0x79, 0x63, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x32, 0x81, 0x03, 0x0a, 0x0a, 0x4b, 0x79, 0x63, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x79, 0x63,
+	0x12, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x79,
+	0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x51, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4b, 0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74,
+	0x4b, 0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b,
+	0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x79, 0x63, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x79, 0x63, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x4b, 0x79, 0x63, 0x44, 0x6f, 0x63, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x52, 0x65,
+	0x76, 0x69, 0x65, 0x77, 0x4b, 0x79, 0x63, 0x12, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x79, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x4b, 0x79, 0x63,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x75, 0x73, 0x65,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_pb_protos_user_kyc_proto_rawDescOnce sync.Once
+	file_pkg_pb_protos_user_kyc_proto_rawDescData = file_pkg_pb_protos_user_kyc_proto_rawDesc
+)
+
+func file_pkg_pb_protos_user_kyc_proto_rawDescGZIP() []byte {
+	file_pkg_pb_protos_user_kyc_proto_rawDescOnce.Do(func() {
+		file_pkg_pb_protos_user_kyc_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_protos_user_kyc_proto_rawDescData)
+	})
+	return file_pkg_pb_protos_user_kyc_proto_rawDescData
+}
+
+var file_pkg_pb_protos_user_kyc_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_pkg_pb_protos_user_kyc_proto_goTypes = []any{
+	(*KycDocumentUpload)(nil),          // 0: user.KycDocumentUpload
+	(*KycDocument)(nil),                // 1: user.KycDocument
+	(*KycSubmission)(nil),              // 2: user.KycSubmission
+	(*SubmitKycRequest)(nil),           // 3: user.SubmitKycRequest
+	(*SubmitKycResponse)(nil),          // 4: user.SubmitKycResponse
+	(*GetKycSubmissionRequest)(nil),    // 5: user.GetKycSubmissionRequest
+	(*GetKycSubmissionResponse)(nil),   // 6: user.GetKycSubmissionResponse
+	(*ListKycSubmissionsRequest)(nil),  // 7: user.ListKycSubmissionsRequest
+	(*ListKycSubmissionsResponse)(nil), // 8: user.ListKycSubmissionsResponse
+	(*GetKycDocumentRequest)(nil),      // 9: user.GetKycDocumentRequest
+	(*GetKycDocumentResponse)(nil),     // 10: user.GetKycDocumentResponse
+	(*ReviewKycRequest)(nil),           // 11: user.ReviewKycRequest
+	(*ReviewKycResponse)(nil),          // 12: user.ReviewKycResponse
+}
+var file_pkg_pb_protos_user_kyc_proto_depIdxs = []int32{
+	1,  // 0: user.KycSubmission.documents:type_name -> user.KycDocument
+	0,  // 1: user.SubmitKycRequest.documents:type_name -> user.KycDocumentUpload
+	2,  // 2: user.SubmitKycResponse.submission:type_name -> user.KycSubmission
+	2,  // 3: user.GetKycSubmissionResponse.submission:type_name -> user.KycSubmission
+	2,  // 4: user.ListKycSubmissionsResponse.submissions:type_name -> user.KycSubmission
+	1,  // 5: user.GetKycDocumentResponse.document:type_name -> user.KycDocument
+	2,  // 6: user.ReviewKycResponse.submission:type_name -> user.KycSubmission
+	3,  // 7: user.KycService.SubmitKyc:input_type -> user.SubmitKycRequest
+	5,  // 8: user.KycService.GetKycSubmission:input_type -> user.GetKycSubmissionRequest
+	7,  // 9: user.KycService.ListKycSubmissions:input_type -> user.ListKycSubmissionsRequest
+	9,  // 10: user.KycService.GetKycDocument:input_type -> user.GetKycDocumentRequest
+	11, // 11: user.KycService.ReviewKyc:input_type -> user.ReviewKycRequest
+	4,  // 12: user.KycService.SubmitKyc:output_type -> user.SubmitKycResponse
+	6,  // 13: user.KycService.GetKycSubmission:output_type -> user.GetKycSubmissionResponse
+	8,  // 14: user.KycService.ListKycSubmissions:output_type -> user.ListKycSubmissionsResponse
+	10, // 15: user.KycService.GetKycDocument:output_type -> user.GetKycDocumentResponse
+	12, // 16: user.KycService.ReviewKyc:output_type -> user.ReviewKycResponse
+	12, // [12:17] is the sub-list for method output_type
+	7,  // [7:12] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_pkg_pb_protos_user_kyc_proto_init() }
+func file_pkg_pb_protos_user_kyc_proto_init() {
+	if File_pkg_pb_protos_user_kyc_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_pb_protos_user_kyc_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_pb_protos_user_kyc_proto_goTypes,
+		DependencyIndexes: file_pkg_pb_protos_user_kyc_proto_depIdxs,
+		MessageInfos:      file_pkg_pb_protos_user_kyc_proto_msgTypes,
+	}.Build()
+	File_pkg_pb_protos_user_kyc_proto = out.File
+	file_pkg_pb_protos_user_kyc_proto_rawDesc = nil
+	file_pkg_pb_protos_user_kyc_proto_goTypes = nil
+	file_pkg_pb_protos_user_kyc_proto_depIdxs = nil
+}
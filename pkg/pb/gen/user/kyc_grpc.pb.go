@@ -0,0 +1,287 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: pkg/pb/protos/user/kyc.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	KycService_SubmitKyc_FullMethodName          = "/user.KycService/SubmitKyc"
+	KycService_GetKycSubmission_FullMethodName   = "/user.KycService/GetKycSubmission"
+	KycService_ListKycSubmissions_FullMethodName = "/user.KycService/ListKycSubmissions"
+	KycService_GetKycDocument_FullMethodName     = "/user.KycService/GetKycDocument"
+	KycService_ReviewKyc_FullMethodName          = "/user.KycService/ReviewKyc"
+)
+
+// KycServiceClient is the client API for KycService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 玩家的KYC身分驗證, 證件存放於 S3, 審核通過後玩家升級為 KycVerifiedPlayer
+type KycServiceClient interface {
+	// 玩家上傳證件並送出審核, 審核中或已通過時不可再送出
+	SubmitKyc(ctx context.Context, in *SubmitKycRequest, opts ...grpc.CallOption) (*SubmitKycResponse, error)
+	// 玩家查詢最近一次送出的審核結果
+	GetKycSubmission(ctx context.Context, in *GetKycSubmissionRequest, opts ...grpc.CallOption) (*GetKycSubmissionResponse, error)
+	// 後台列出指定狀態的送審資料, 由最早送出的開始
+	ListKycSubmissions(ctx context.Context, in *ListKycSubmissionsRequest, opts ...grpc.CallOption) (*ListKycSubmissionsResponse, error)
+	// 後台下載送審的證件
+	GetKycDocument(ctx context.Context, in *GetKycDocumentRequest, opts ...grpc.CallOption) (*GetKycDocumentResponse, error)
+	// 後台審核送審資料, 駁回時需填寫原因, 通過時由 auth 綁定 KycVerifiedPlayer 角色並讓玩家的 access token 失效
+	ReviewKyc(ctx context.Context, in *ReviewKycRequest, opts ...grpc.CallOption) (*ReviewKycResponse, error)
+}
+
+type kycServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewKycServiceClient(cc grpc.ClientConnInterface) KycServiceClient {
+	return &kycServiceClient{cc}
+}
+
+func (c *kycServiceClient) SubmitKyc(ctx context.Context, in *SubmitKycRequest, opts ...grpc.CallOption) (*SubmitKycResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitKycResponse)
+	err := c.cc.Invoke(ctx, KycService_SubmitKyc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) GetKycSubmission(ctx context.Context, in *GetKycSubmissionRequest, opts ...grpc.CallOption) (*GetKycSubmissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKycSubmissionResponse)
+	err := c.cc.Invoke(ctx, KycService_GetKycSubmission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) ListKycSubmissions(ctx context.Context, in *ListKycSubmissionsRequest, opts ...grpc.CallOption) (*ListKycSubmissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListKycSubmissionsResponse)
+	err := c.cc.Invoke(ctx, KycService_ListKycSubmissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) GetKycDocument(ctx context.Context, in *GetKycDocumentRequest, opts ...grpc.CallOption) (*GetKycDocumentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetKycDocumentResponse)
+	err := c.cc.Invoke(ctx, KycService_GetKycDocument_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *kycServiceClient) ReviewKyc(ctx context.Context, in *ReviewKycRequest, opts ...grpc.CallOption) (*ReviewKycResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewKycResponse)
+	err := c.cc.Invoke(ctx, KycService_ReviewKyc_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// KycServiceServer is the server API for KycService service.
+// All implementations must embed UnimplementedKycServiceServer
+// for forward compatibility.
+//
+// 玩家的KYC身分驗證, 證件存放於 S3, 審核通過後玩家升級為 KycVerifiedPlayer
+type KycServiceServer interface {
+	// 玩家上傳證件並送出審核, 審核中或已通過時不可再送出
+	SubmitKyc(context.Context, *SubmitKycRequest) (*SubmitKycResponse, error)
+	// 玩家查詢最近一次送出的審核結果
+	GetKycSubmission(context.Context, *GetKycSubmissionRequest) (*GetKycSubmissionResponse, error)
+	// 後台列出指定狀態的送審資料, 由最早送出的開始
+	ListKycSubmissions(context.Context, *ListKycSubmissionsRequest) (*ListKycSubmissionsResponse, error)
+	// 後台下載送審的證件
+	GetKycDocument(context.Context, *GetKycDocumentRequest) (*GetKycDocumentResponse, error)
+	// 後台審核送審資料, 駁回時需填寫原因, 通過時由 auth 綁定 KycVerifiedPlayer 角色並讓玩家的 access token 失效
+	ReviewKyc(context.Context, *ReviewKycRequest) (*ReviewKycResponse, error)
+	mustEmbedUnimplementedKycServiceServer()
+}
+
+// UnimplementedKycServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedKycServiceServer struct{}
+
+func (UnimplementedKycServiceServer) SubmitKyc(context.Context, *SubmitKycRequest) (*SubmitKycResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitKyc not implemented")
+}
+func (UnimplementedKycServiceServer) GetKycSubmission(context.Context, *GetKycSubmissionRequest) (*GetKycSubmissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKycSubmission not implemented")
+}
+func (UnimplementedKycServiceServer) ListKycSubmissions(context.Context, *ListKycSubmissionsRequest) (*ListKycSubmissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListKycSubmissions not implemented")
+}
+func (UnimplementedKycServiceServer) GetKycDocument(context.Context, *GetKycDocumentRequest) (*GetKycDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKycDocument not implemented")
+}
+func (UnimplementedKycServiceServer) ReviewKyc(context.Context, *ReviewKycRequest) (*ReviewKycResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReviewKyc not implemented")
+}
+func (UnimplementedKycServiceServer) mustEmbedUnimplementedKycServiceServer() {}
+func (UnimplementedKycServiceServer) testEmbeddedByValue()                    {}
+
+// UnsafeKycServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to KycServiceServer will
+// result in compilation errors.
+type UnsafeKycServiceServer interface {
+	mustEmbedUnimplementedKycServiceServer()
+}
+
+func RegisterKycServiceServer(s grpc.ServiceRegistrar, srv KycServiceServer) {
+	// If the following call pancis, it indicates UnimplementedKycServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&KycService_ServiceDesc, srv)
+}
+
+func _KycService_SubmitKyc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitKycRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).SubmitKyc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_SubmitKyc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).SubmitKyc(ctx, req.(*SubmitKycRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_GetKycSubmission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKycSubmissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).GetKycSubmission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_GetKycSubmission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).GetKycSubmission(ctx, req.(*GetKycSubmissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_ListKycSubmissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListKycSubmissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).ListKycSubmissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_ListKycSubmissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).ListKycSubmissions(ctx, req.(*ListKycSubmissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_GetKycDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKycDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).GetKycDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_GetKycDocument_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).GetKycDocument(ctx, req.(*GetKycDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _KycService_ReviewKyc_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewKycRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(KycServiceServer).ReviewKyc(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: KycService_ReviewKyc_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(KycServiceServer).ReviewKyc(ctx, req.(*ReviewKycRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// KycService_ServiceDesc is the grpc.ServiceDesc for KycService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var KycService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.KycService",
+	HandlerType: (*KycServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SubmitKyc",
+			Handler:    _KycService_SubmitKyc_Handler,
+		},
+		{
+			MethodName: "GetKycSubmission",
+			Handler:    _KycService_GetKycSubmission_Handler,
+		},
+		{
+			MethodName: "ListKycSubmissions",
+			Handler:    _KycService_ListKycSubmissions_Handler,
+		},
+		{
+			MethodName: "GetKycDocument",
+			Handler:    _KycService_GetKycDocument_Handler,
+		},
+		{
+			MethodName: "ReviewKyc",
+			Handler:    _KycService_ReviewKyc_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/pb/protos/user/kyc.proto",
+}
@@ -0,0 +1,756 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.35.1
+// 	protoc        v5.28.2
+// source: pkg/pb/protos/user/profile_attribute.proto
+
+package user
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 屬性的驗證規則, 零值不檢查
+type AttributeRules struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MinLength int32    `protobuf:"varint,1,opt,name=minLength,proto3" json:"minLength,omitempty"`
+	MaxLength int32    `protobuf:"varint,2,opt,name=maxLength,proto3" json:"maxLength,omitempty"`
+	Pattern   string   `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"` // string, phone 的正規表示式
+	Options   []string `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"` // enum 可用的值
+	MinDate   string   `protobuf:"bytes,5,opt,name=minDate,proto3" json:"minDate,omitempty"` // date 的最早日期, e.g. 1900-01-01
+	MaxDate   string   `protobuf:"bytes,6,opt,name=maxDate,proto3" json:"maxDate,omitempty"` // date 的最晚日期
+}
+
+func (x *AttributeRules) Reset() {
+	*x = AttributeRules{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeRules) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeRules) ProtoMessage() {}
+
+func (x *AttributeRules) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeRules.ProtoReflect.Descriptor instead.
+func (*AttributeRules) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttributeRules) GetMinLength() int32 {
+	if x != nil {
+		return x.MinLength
+	}
+	return 0
+}
+
+func (x *AttributeRules) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *AttributeRules) GetPattern() string {
+	if x != nil {
+		return x.Pattern
+	}
+	return ""
+}
+
+func (x *AttributeRules) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *AttributeRules) GetMinDate() string {
+	if x != nil {
+		return x.MinDate
+	}
+	return ""
+}
+
+func (x *AttributeRules) GetMaxDate() string {
+	if x != nil {
+		return x.MaxDate
+	}
+	return ""
+}
+
+type ProfileAttribute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key       int32           `protobuf:"varint,1,opt,name=key,proto3" json:"key,omitempty"`  // profile 的 key, 由服務分配
+	Name      string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"` // camel case, e.g. nickname
+	Type      string          `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"` // string, date, enum, phone
+	Rules     *AttributeRules `protobuf:"bytes,4,opt,name=rules,proto3" json:"rules,omitempty"`
+	Unique    bool            `protobuf:"varint,5,opt,name=unique,proto3" json:"unique,omitempty"`              // 值只能屬於一個 user
+	PiiClass  string          `protobuf:"bytes,6,opt,name=piiClass,proto3" json:"piiClass,omitempty"`           // none, personal, sensitive
+	VisibleTo []int32         `protobuf:"varint,7,rep,packed,name=visibleTo,proto3" json:"visibleTo,omitempty"` // 可見的客戶端, 使用 pkg/enum/client_type 的id, 未指定時只有 Backend 可見
+	System    bool            `protobuf:"varint,8,opt,name=system,proto3" json:"system,omitempty"`              // 內建屬性
+}
+
+func (x *ProfileAttribute) Reset() {
+	*x = ProfileAttribute{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileAttribute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileAttribute) ProtoMessage() {}
+
+func (x *ProfileAttribute) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileAttribute.ProtoReflect.Descriptor instead.
+func (*ProfileAttribute) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ProfileAttribute) GetKey() int32 {
+	if x != nil {
+		return x.Key
+	}
+	return 0
+}
+
+func (x *ProfileAttribute) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProfileAttribute) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProfileAttribute) GetRules() *AttributeRules {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *ProfileAttribute) GetUnique() bool {
+	if x != nil {
+		return x.Unique
+	}
+	return false
+}
+
+func (x *ProfileAttribute) GetPiiClass() string {
+	if x != nil {
+		return x.PiiClass
+	}
+	return ""
+}
+
+func (x *ProfileAttribute) GetVisibleTo() []int32 {
+	if x != nil {
+		return x.VisibleTo
+	}
+	return nil
+}
+
+func (x *ProfileAttribute) GetSystem() bool {
+	if x != nil {
+		return x.System
+	}
+	return false
+}
+
+// 屬性的值, 依型別正規化: date 為 YYYY-MM-DD, phone 為 E.164
+type ProfileAttributeValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"` // string, date, enum, phone
+	// Types that are assignable to Value:
+	//	*ProfileAttributeValue_StringValue
+	//	*ProfileAttributeValue_DateValue
+	//	*ProfileAttributeValue_EnumValue
+	//	*ProfileAttributeValue_PhoneValue
+	Value isProfileAttributeValue_Value `protobuf_oneof:"value"`
+}
+
+func (x *ProfileAttributeValue) Reset() {
+	*x = ProfileAttributeValue{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProfileAttributeValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfileAttributeValue) ProtoMessage() {}
+
+func (x *ProfileAttributeValue) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfileAttributeValue.ProtoReflect.Descriptor instead.
+func (*ProfileAttributeValue) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ProfileAttributeValue) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (m *ProfileAttributeValue) GetValue() isProfileAttributeValue_Value {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (x *ProfileAttributeValue) GetStringValue() string {
+	if x, ok := x.GetValue().(*ProfileAttributeValue_StringValue); ok {
+		return x.StringValue
+	}
+	return ""
+}
+
+func (x *ProfileAttributeValue) GetDateValue() string {
+	if x, ok := x.GetValue().(*ProfileAttributeValue_DateValue); ok {
+		return x.DateValue
+	}
+	return ""
+}
+
+func (x *ProfileAttributeValue) GetEnumValue() string {
+	if x, ok := x.GetValue().(*ProfileAttributeValue_EnumValue); ok {
+		return x.EnumValue
+	}
+	return ""
+}
+
+func (x *ProfileAttributeValue) GetPhoneValue() string {
+	if x, ok := x.GetValue().(*ProfileAttributeValue_PhoneValue); ok {
+		return x.PhoneValue
+	}
+	return ""
+}
+
+type isProfileAttributeValue_Value interface {
+	isProfileAttributeValue_Value()
+}
+
+type ProfileAttributeValue_StringValue struct {
+	StringValue string `protobuf:"bytes,2,opt,name=stringValue,proto3,oneof"`
+}
+
+type ProfileAttributeValue_DateValue struct {
+	DateValue string `protobuf:"bytes,3,opt,name=dateValue,proto3,oneof"`
+}
+
+type ProfileAttributeValue_EnumValue struct {
+	EnumValue string `protobuf:"bytes,4,opt,name=enumValue,proto3,oneof"`
+}
+
+type ProfileAttributeValue_PhoneValue struct {
+	PhoneValue string `protobuf:"bytes,5,opt,name=phoneValue,proto3,oneof"`
+}
+
+func (*ProfileAttributeValue_StringValue) isProfileAttributeValue_Value() {}
+
+func (*ProfileAttributeValue_DateValue) isProfileAttributeValue_Value() {}
+
+func (*ProfileAttributeValue_EnumValue) isProfileAttributeValue_Value() {}
+
+func (*ProfileAttributeValue_PhoneValue) isProfileAttributeValue_Value() {}
+
+type ListProfileAttributesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProfileAttributesRequest) Reset() {
+	*x = ListProfileAttributesRequest{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfileAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfileAttributesRequest) ProtoMessage() {}
+
+func (x *ListProfileAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfileAttributesRequest.ProtoReflect.Descriptor instead.
+func (*ListProfileAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{3}
+}
+
+type ListProfileAttributesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attributes []*ProfileAttribute `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+}
+
+func (x *ListProfileAttributesResponse) Reset() {
+	*x = ListProfileAttributesResponse{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProfileAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfileAttributesResponse) ProtoMessage() {}
+
+func (x *ListProfileAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfileAttributesResponse.ProtoReflect.Descriptor instead.
+func (*ListProfileAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListProfileAttributesResponse) GetAttributes() []*ProfileAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type DefineProfileAttributeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attribute *ProfileAttribute `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"` // key, system 會被忽略
+}
+
+func (x *DefineProfileAttributeRequest) Reset() {
+	*x = DefineProfileAttributeRequest{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineProfileAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineProfileAttributeRequest) ProtoMessage() {}
+
+func (x *DefineProfileAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineProfileAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DefineProfileAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DefineProfileAttributeRequest) GetAttribute() *ProfileAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type DefineProfileAttributeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attribute *ProfileAttribute `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+}
+
+func (x *DefineProfileAttributeResponse) Reset() {
+	*x = DefineProfileAttributeResponse{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DefineProfileAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DefineProfileAttributeResponse) ProtoMessage() {}
+
+func (x *DefineProfileAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DefineProfileAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DefineProfileAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DefineProfileAttributeResponse) GetAttribute() *ProfileAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type UpdateProfileAttributeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attribute *ProfileAttribute `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"` // 以 name 指定屬性, piiClass, visibleTo 未指定時不變更
+}
+
+func (x *UpdateProfileAttributeRequest) Reset() {
+	*x = UpdateProfileAttributeRequest{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileAttributeRequest) ProtoMessage() {}
+
+func (x *UpdateProfileAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileAttributeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateProfileAttributeRequest) GetAttribute() *ProfileAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type UpdateProfileAttributeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attribute *ProfileAttribute `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+}
+
+func (x *UpdateProfileAttributeResponse) Reset() {
+	*x = UpdateProfileAttributeResponse{}
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileAttributeResponse) ProtoMessage() {}
+
+func (x *UpdateProfileAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileAttributeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateProfileAttributeResponse) GetAttribute() *ProfileAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+var File_pkg_pb_protos_user_profile_attribute_proto protoreflect.FileDescriptor
+
+var file_pkg_pb_protos_user_profile_attribute_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f,
+	0x75, 0x73, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x61, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x4c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x74, 0x65, 0x22, 0xe2, 0x01, 0x0a, 0x10, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x72, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x05, 0x72,
+	0x75, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x69, 0x69, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x69, 0x69, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x76, 0x69, 0x73, 0x69,
+	0x62, 0x6c, 0x65, 0x54, 0x6f, 0x18, 0x07, 0x20, 0x03, 0x28, 0x05, 0x52, 0x09, 0x76, 0x69, 0x73,
+	0x69, 0x62, 0x6c, 0x65, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x22, 0xba,
+	0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0b,
+	0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1e, 0x0a, 0x09, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x1e, 0x0a, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x20, 0x0a, 0x0a, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x57, 0x0a, 0x1d, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x36, 0x0a, 0x0a,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x22, 0x55, 0x0a, 0x1d, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65,
+	0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x22, 0x56, 0x0a, 0x1e, 0x44,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a,
+	0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x22, 0x55, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52,
+	0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x22, 0x56, 0x0a, 0x1e, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x09,
+	0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x09, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x32, 0xc5, 0x02, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x60,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74,
+	0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x22, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x63, 0x0a, 0x16, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12,
+	0x23, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x07, 0x5a, 0x05, 0x2f, 0x75,
+	0x73, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_pkg_pb_protos_user_profile_attribute_proto_rawDescOnce sync.Once
+	file_pkg_pb_protos_user_profile_attribute_proto_rawDescData = file_pkg_pb_protos_user_profile_attribute_proto_rawDesc
+)
+
+func file_pkg_pb_protos_user_profile_attribute_proto_rawDescGZIP() []byte {
+	file_pkg_pb_protos_user_profile_attribute_proto_rawDescOnce.Do(func() {
+		file_pkg_pb_protos_user_profile_attribute_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_pb_protos_user_profile_attribute_proto_rawDescData)
+	})
+	return file_pkg_pb_protos_user_profile_attribute_proto_rawDescData
+}
+
+var file_pkg_pb_protos_user_profile_attribute_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_pkg_pb_protos_user_profile_attribute_proto_goTypes = []any{
+	(*AttributeRules)(nil),                 // 0: user.AttributeRules
+	(*ProfileAttribute)(nil),               // 1: user.ProfileAttribute
+	(*ProfileAttributeValue)(nil),          // 2: user.ProfileAttributeValue
+	(*ListProfileAttributesRequest)(nil),   // 3: user.ListProfileAttributesRequest
+	(*ListProfileAttributesResponse)(nil),  // 4: user.ListProfileAttributesResponse
+	(*DefineProfileAttributeRequest)(nil),  // 5: user.DefineProfileAttributeRequest
+	(*DefineProfileAttributeResponse)(nil), // 6: user.DefineProfileAttributeResponse
+	(*UpdateProfileAttributeRequest)(nil),  // 7: user.UpdateProfileAttributeRequest
+	(*UpdateProfileAttributeResponse)(nil), // 8: user.UpdateProfileAttributeResponse
+}
+var file_pkg_pb_protos_user_profile_attribute_proto_depIdxs = []int32{
+	0, // 0: user.ProfileAttribute.rules:type_name -> user.AttributeRules
+	1, // 1: user.ListProfileAttributesResponse.attributes:type_name -> user.ProfileAttribute
+	1, // 2: user.DefineProfileAttributeRequest.attribute:type_name -> user.ProfileAttribute
+	1, // 3: user.DefineProfileAttributeResponse.attribute:type_name -> user.ProfileAttribute
+	1, // 4: user.UpdateProfileAttributeRequest.attribute:type_name -> user.ProfileAttribute
+	1, // 5: user.UpdateProfileAttributeResponse.attribute:type_name -> user.ProfileAttribute
+	3, // 6: user.ProfileAttributeService.ListProfileAttributes:input_type -> user.ListProfileAttributesRequest
+	5, // 7: user.ProfileAttributeService.DefineProfileAttribute:input_type -> user.DefineProfileAttributeRequest
+	7, // 8: user.ProfileAttributeService.UpdateProfileAttribute:input_type -> user.UpdateProfileAttributeRequest
+	4, // 9: user.ProfileAttributeService.ListProfileAttributes:output_type -> user.ListProfileAttributesResponse
+	6, // 10: user.ProfileAttributeService.DefineProfileAttribute:output_type -> user.DefineProfileAttributeResponse
+	8, // 11: user.ProfileAttributeService.UpdateProfileAttribute:output_type -> user.UpdateProfileAttributeResponse
+	9, // [9:12] is the sub-list for method output_type
+	6, // [6:9] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_pkg_pb_protos_user_profile_attribute_proto_init() }
+func file_pkg_pb_protos_user_profile_attribute_proto_init() {
+	if File_pkg_pb_protos_user_profile_attribute_proto != nil {
+		return
+	}
+	file_pkg_pb_protos_user_profile_attribute_proto_msgTypes[2].OneofWrappers = []any{
+		(*ProfileAttributeValue_StringValue)(nil),
+		(*ProfileAttributeValue_DateValue)(nil),
+		(*ProfileAttributeValue_EnumValue)(nil),
+		(*ProfileAttributeValue_PhoneValue)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_pkg_pb_protos_user_profile_attribute_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_pkg_pb_protos_user_profile_attribute_proto_goTypes,
+		DependencyIndexes: file_pkg_pb_protos_user_profile_attribute_proto_depIdxs,
+		MessageInfos:      file_pkg_pb_protos_user_profile_attribute_proto_msgTypes,
+	}.Build()
+	File_pkg_pb_protos_user_profile_attribute_proto = out.File
+	file_pkg_pb_protos_user_profile_attribute_proto_rawDesc = nil
+	file_pkg_pb_protos_user_profile_attribute_proto_goTypes = nil
+	file_pkg_pb_protos_user_profile_attribute_proto_depIdxs = nil
+}
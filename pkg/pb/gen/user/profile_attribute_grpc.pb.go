@@ -0,0 +1,208 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.28.2
+// source: pkg/pb/protos/user/profile_attribute.proto
+
+package user
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ProfileAttributeService_ListProfileAttributes_FullMethodName  = "/user.ProfileAttributeService/ListProfileAttributes"
+	ProfileAttributeService_DefineProfileAttribute_FullMethodName = "/user.ProfileAttributeService/DefineProfileAttribute"
+	ProfileAttributeService_UpdateProfileAttribute_FullMethodName = "/user.ProfileAttributeService/UpdateProfileAttribute"
+)
+
+// ProfileAttributeServiceClient is the client API for ProfileAttributeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// profile 屬性的註冊表, 商戶可在不重新部署的情況下新增屬性(如 nickname, birthday, kycLevel)
+type ProfileAttributeServiceClient interface {
+	// 列出所有屬性, 包含內建的屬性
+	ListProfileAttributes(ctx context.Context, in *ListProfileAttributesRequest, opts ...grpc.CallOption) (*ListProfileAttributesResponse, error)
+	// 定義新的屬性, key 由服務分配
+	DefineProfileAttribute(ctx context.Context, in *DefineProfileAttributeRequest, opts ...grpc.CallOption) (*DefineProfileAttributeResponse, error)
+	// 變更屬性的驗證規則, PII 分類及可見的客戶端, 型別及唯一性不可變更, 內建屬性不可變更
+	UpdateProfileAttribute(ctx context.Context, in *UpdateProfileAttributeRequest, opts ...grpc.CallOption) (*UpdateProfileAttributeResponse, error)
+}
+
+type profileAttributeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProfileAttributeServiceClient(cc grpc.ClientConnInterface) ProfileAttributeServiceClient {
+	return &profileAttributeServiceClient{cc}
+}
+
+func (c *profileAttributeServiceClient) ListProfileAttributes(ctx context.Context, in *ListProfileAttributesRequest, opts ...grpc.CallOption) (*ListProfileAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProfileAttributesResponse)
+	err := c.cc.Invoke(ctx, ProfileAttributeService_ListProfileAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileAttributeServiceClient) DefineProfileAttribute(ctx context.Context, in *DefineProfileAttributeRequest, opts ...grpc.CallOption) (*DefineProfileAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DefineProfileAttributeResponse)
+	err := c.cc.Invoke(ctx, ProfileAttributeService_DefineProfileAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileAttributeServiceClient) UpdateProfileAttribute(ctx context.Context, in *UpdateProfileAttributeRequest, opts ...grpc.CallOption) (*UpdateProfileAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileAttributeResponse)
+	err := c.cc.Invoke(ctx, ProfileAttributeService_UpdateProfileAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProfileAttributeServiceServer is the server API for ProfileAttributeService service.
+// All implementations must embed UnimplementedProfileAttributeServiceServer
+// for forward compatibility.
+//
+// profile 屬性的註冊表, 商戶可在不重新部署的情況下新增屬性(如 nickname, birthday, kycLevel)
+type ProfileAttributeServiceServer interface {
+	// 列出所有屬性, 包含內建的屬性
+	ListProfileAttributes(context.Context, *ListProfileAttributesRequest) (*ListProfileAttributesResponse, error)
+	// 定義新的屬性, key 由服務分配
+	DefineProfileAttribute(context.Context, *DefineProfileAttributeRequest) (*DefineProfileAttributeResponse, error)
+	// 變更屬性的驗證規則, PII 分類及可見的客戶端, 型別及唯一性不可變更, 內建屬性不可變更
+	UpdateProfileAttribute(context.Context, *UpdateProfileAttributeRequest) (*UpdateProfileAttributeResponse, error)
+	mustEmbedUnimplementedProfileAttributeServiceServer()
+}
+
+// UnimplementedProfileAttributeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedProfileAttributeServiceServer struct{}
+
+func (UnimplementedProfileAttributeServiceServer) ListProfileAttributes(context.Context, *ListProfileAttributesRequest) (*ListProfileAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfileAttributes not implemented")
+}
+func (UnimplementedProfileAttributeServiceServer) DefineProfileAttribute(context.Context, *DefineProfileAttributeRequest) (*DefineProfileAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DefineProfileAttribute not implemented")
+}
+func (UnimplementedProfileAttributeServiceServer) UpdateProfileAttribute(context.Context, *UpdateProfileAttributeRequest) (*UpdateProfileAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfileAttribute not implemented")
+}
+func (UnimplementedProfileAttributeServiceServer) mustEmbedUnimplementedProfileAttributeServiceServer() {
+}
+func (UnimplementedProfileAttributeServiceServer) testEmbeddedByValue() {}
+
+// UnsafeProfileAttributeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProfileAttributeServiceServer will
+// result in compilation errors.
+type UnsafeProfileAttributeServiceServer interface {
+	mustEmbedUnimplementedProfileAttributeServiceServer()
+}
+
+func RegisterProfileAttributeServiceServer(s grpc.ServiceRegistrar, srv ProfileAttributeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedProfileAttributeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ProfileAttributeService_ServiceDesc, srv)
+}
+
+func _ProfileAttributeService_ListProfileAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfileAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileAttributeServiceServer).ListProfileAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileAttributeService_ListProfileAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileAttributeServiceServer).ListProfileAttributes(ctx, req.(*ListProfileAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileAttributeService_DefineProfileAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DefineProfileAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileAttributeServiceServer).DefineProfileAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileAttributeService_DefineProfileAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileAttributeServiceServer).DefineProfileAttribute(ctx, req.(*DefineProfileAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProfileAttributeService_UpdateProfileAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileAttributeServiceServer).UpdateProfileAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProfileAttributeService_UpdateProfileAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileAttributeServiceServer).UpdateProfileAttribute(ctx, req.(*UpdateProfileAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProfileAttributeService_ServiceDesc is the grpc.ServiceDesc for ProfileAttributeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProfileAttributeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "user.ProfileAttributeService",
+	HandlerType: (*ProfileAttributeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListProfileAttributes",
+			Handler:    _ProfileAttributeService_ListProfileAttributes_Handler,
+		},
+		{
+			MethodName: "DefineProfileAttribute",
+			Handler:    _ProfileAttributeService_DefineProfileAttribute_Handler,
+		},
+		{
+			MethodName: "UpdateProfileAttribute",
+			Handler:    _ProfileAttributeService_UpdateProfileAttribute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/pb/protos/user/profile_attribute.proto",
+}
@@ -26,6 +26,12 @@ const (
 	UserService_CheckEmailExistence_FullMethodName  = "/user.UserService/CheckEmailExistence"
 	UserService_IsAccountExist_FullMethodName       = "/user.UserService/IsAccountExist"
 	UserService_GetLoginUserInfo_FullMethodName     = "/user.UserService/GetLoginUserInfo"
+	UserService_VerifyOAuth_FullMethodName          = "/user.UserService/VerifyOAuth"
+	UserService_LinkOAuth_FullMethodName            = "/user.UserService/LinkOAuth"
+	UserService_UnlinkOAuth_FullMethodName          = "/user.UserService/UnlinkOAuth"
+	UserService_UpdateProfile_FullMethodName        = "/user.UserService/UpdateProfile"
+	UserService_SetProfileAttributes_FullMethodName = "/user.UserService/SetProfileAttributes"
+	UserService_SearchUsers_FullMethodName          = "/user.UserService/SearchUsers"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -41,6 +47,18 @@ type UserServiceClient interface {
 	IsAccountExist(ctx context.Context, in *IsAccountExistRequest, opts ...grpc.CallOption) (*ExistenceResponse, error)
 	// 登入取得 user 資料 Request值不一定是
 	GetLoginUserInfo(ctx context.Context, in *GetLoginUserInfoRequest, opts ...grpc.CallOption) (*GetLoginUserInfoResponse, error)
+	// 驗證第三方登入的 token, 並取得綁定該 openID 的 user
+	VerifyOAuth(ctx context.Context, in *VerifyOAuthRequest, opts ...grpc.CallOption) (*VerifyOAuthResponse, error)
+	// 驗證第三方登入的 token, 並將 openID 綁定到 user
+	LinkOAuth(ctx context.Context, in *LinkOAuthRequest, opts ...grpc.CallOption) (*LinkOAuthResponse, error)
+	// 解除 user 綁定的第三方帳號
+	UnlinkOAuth(ctx context.Context, in *UnlinkOAuthRequest, opts ...grpc.CallOption) (*UnlinkOAuthResponse, error)
+	// 變更 user 的 email 或手機號碼, 新的值需帶 type=updateProfile 的驗證碼, 舊的值保留為停用
+	UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error)
+	// 設定 user 的自訂屬性, 值依屬性的型別及規則驗證, 內建屬性需以各自的流程變更
+	SetProfileAttributes(ctx context.Context, in *SetProfileAttributesRequest, opts ...grpc.CallOption) (*SetProfileAttributesResponse, error)
+	// 後台以帳號、email、手機、狀態、註冊時間及商戶/客戶端搜尋 user, 以游標分頁並合併 auth 的狀態及角色
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
 }
 
 type userServiceClient struct {
@@ -121,6 +139,66 @@ func (c *userServiceClient) GetLoginUserInfo(ctx context.Context, in *GetLoginUs
 	return out, nil
 }
 
+func (c *userServiceClient) VerifyOAuth(ctx context.Context, in *VerifyOAuthRequest, opts ...grpc.CallOption) (*VerifyOAuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyOAuthResponse)
+	err := c.cc.Invoke(ctx, UserService_VerifyOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) LinkOAuth(ctx context.Context, in *LinkOAuthRequest, opts ...grpc.CallOption) (*LinkOAuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkOAuthResponse)
+	err := c.cc.Invoke(ctx, UserService_LinkOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnlinkOAuth(ctx context.Context, in *UnlinkOAuthRequest, opts ...grpc.CallOption) (*UnlinkOAuthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkOAuthResponse)
+	err := c.cc.Invoke(ctx, UserService_UnlinkOAuth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UpdateProfile(ctx context.Context, in *UpdateProfileRequest, opts ...grpc.CallOption) (*UpdateProfileResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProfileResponse)
+	err := c.cc.Invoke(ctx, UserService_UpdateProfile_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetProfileAttributes(ctx context.Context, in *SetProfileAttributesRequest, opts ...grpc.CallOption) (*SetProfileAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetProfileAttributesResponse)
+	err := c.cc.Invoke(ctx, UserService_SetProfileAttributes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility.
@@ -134,6 +212,18 @@ type UserServiceServer interface {
 	IsAccountExist(context.Context, *IsAccountExistRequest) (*ExistenceResponse, error)
 	// 登入取得 user 資料 Request值不一定是
 	GetLoginUserInfo(context.Context, *GetLoginUserInfoRequest) (*GetLoginUserInfoResponse, error)
+	// 驗證第三方登入的 token, 並取得綁定該 openID 的 user
+	VerifyOAuth(context.Context, *VerifyOAuthRequest) (*VerifyOAuthResponse, error)
+	// 驗證第三方登入的 token, 並將 openID 綁定到 user
+	LinkOAuth(context.Context, *LinkOAuthRequest) (*LinkOAuthResponse, error)
+	// 解除 user 綁定的第三方帳號
+	UnlinkOAuth(context.Context, *UnlinkOAuthRequest) (*UnlinkOAuthResponse, error)
+	// 變更 user 的 email 或手機號碼, 新的值需帶 type=updateProfile 的驗證碼, 舊的值保留為停用
+	UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error)
+	// 設定 user 的自訂屬性, 值依屬性的型別及規則驗證, 內建屬性需以各自的流程變更
+	SetProfileAttributes(context.Context, *SetProfileAttributesRequest) (*SetProfileAttributesResponse, error)
+	// 後台以帳號、email、手機、狀態、註冊時間及商戶/客戶端搜尋 user, 以游標分頁並合併 auth 的狀態及角色
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -165,6 +255,24 @@ func (UnimplementedUserServiceServer) IsAccountExist(context.Context, *IsAccount
 func (UnimplementedUserServiceServer) GetLoginUserInfo(context.Context, *GetLoginUserInfoRequest) (*GetLoginUserInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLoginUserInfo not implemented")
 }
+func (UnimplementedUserServiceServer) VerifyOAuth(context.Context, *VerifyOAuthRequest) (*VerifyOAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyOAuth not implemented")
+}
+func (UnimplementedUserServiceServer) LinkOAuth(context.Context, *LinkOAuthRequest) (*LinkOAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkOAuth not implemented")
+}
+func (UnimplementedUserServiceServer) UnlinkOAuth(context.Context, *UnlinkOAuthRequest) (*UnlinkOAuthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkOAuth not implemented")
+}
+func (UnimplementedUserServiceServer) UpdateProfile(context.Context, *UpdateProfileRequest) (*UpdateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProfile not implemented")
+}
+func (UnimplementedUserServiceServer) SetProfileAttributes(context.Context, *SetProfileAttributesRequest) (*SetProfileAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProfileAttributes not implemented")
+}
+func (UnimplementedUserServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
 
@@ -312,6 +420,114 @@ func _UserService_GetLoginUserInfo_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_VerifyOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyOAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).VerifyOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_VerifyOAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).VerifyOAuth(ctx, req.(*VerifyOAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_LinkOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkOAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).LinkOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_LinkOAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).LinkOAuth(ctx, req.(*LinkOAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnlinkOAuth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkOAuthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnlinkOAuth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UnlinkOAuth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnlinkOAuth(ctx, req.(*UnlinkOAuthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UpdateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UpdateProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_UpdateProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UpdateProfile(ctx, req.(*UpdateProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetProfileAttributes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProfileAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetProfileAttributes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetProfileAttributes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetProfileAttributes(ctx, req.(*SetProfileAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -347,6 +563,30 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLoginUserInfo",
 			Handler:    _UserService_GetLoginUserInfo_Handler,
 		},
+		{
+			MethodName: "VerifyOAuth",
+			Handler:    _UserService_VerifyOAuth_Handler,
+		},
+		{
+			MethodName: "LinkOAuth",
+			Handler:    _UserService_LinkOAuth_Handler,
+		},
+		{
+			MethodName: "UnlinkOAuth",
+			Handler:    _UserService_UnlinkOAuth_Handler,
+		},
+		{
+			MethodName: "UpdateProfile",
+			Handler:    _UserService_UpdateProfile_Handler,
+		},
+		{
+			MethodName: "SetProfileAttributes",
+			Handler:    _UserService_SetProfileAttributes_Handler,
+		},
+		{
+			MethodName: "SearchUsers",
+			Handler:    _UserService_SearchUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/protos/user/user.proto",
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VerifyService_RegisterVerification_FullMethodName = "/user.VerifyService/RegisterVerification"
-	VerifyService_Verification_FullMethodName         = "/user.VerifyService/Verification"
+	VerifyService_RegisterVerification_FullMethodName       = "/user.VerifyService/RegisterVerification"
+	VerifyService_Verification_FullMethodName               = "/user.VerifyService/Verification"
+	VerifyService_ListNotificationDeliveries_FullMethodName = "/user.VerifyService/ListNotificationDeliveries"
 )
 
 // VerifyServiceClient is the client API for VerifyService service.
@@ -29,6 +30,7 @@ const (
 type VerifyServiceClient interface {
 	RegisterVerification(ctx context.Context, in *RegisterVerificationRequest, opts ...grpc.CallOption) (*RegisterVerificationResponse, error)
 	Verification(ctx context.Context, in *VerificationRequest, opts ...grpc.CallOption) (*VerificationResponse, error)
+	ListNotificationDeliveries(ctx context.Context, in *ListNotificationDeliveriesRequest, opts ...grpc.CallOption) (*ListNotificationDeliveriesResponse, error)
 }
 
 type verifyServiceClient struct {
@@ -59,12 +61,23 @@ func (c *verifyServiceClient) Verification(ctx context.Context, in *Verification
 	return out, nil
 }
 
+func (c *verifyServiceClient) ListNotificationDeliveries(ctx context.Context, in *ListNotificationDeliveriesRequest, opts ...grpc.CallOption) (*ListNotificationDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNotificationDeliveriesResponse)
+	err := c.cc.Invoke(ctx, VerifyService_ListNotificationDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VerifyServiceServer is the server API for VerifyService service.
 // All implementations must embed UnimplementedVerifyServiceServer
 // for forward compatibility.
 type VerifyServiceServer interface {
 	RegisterVerification(context.Context, *RegisterVerificationRequest) (*RegisterVerificationResponse, error)
 	Verification(context.Context, *VerificationRequest) (*VerificationResponse, error)
+	ListNotificationDeliveries(context.Context, *ListNotificationDeliveriesRequest) (*ListNotificationDeliveriesResponse, error)
 	mustEmbedUnimplementedVerifyServiceServer()
 }
 
@@ -81,6 +94,9 @@ func (UnimplementedVerifyServiceServer) RegisterVerification(context.Context, *R
 func (UnimplementedVerifyServiceServer) Verification(context.Context, *VerificationRequest) (*VerificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Verification not implemented")
 }
+func (UnimplementedVerifyServiceServer) ListNotificationDeliveries(context.Context, *ListNotificationDeliveriesRequest) (*ListNotificationDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNotificationDeliveries not implemented")
+}
 func (UnimplementedVerifyServiceServer) mustEmbedUnimplementedVerifyServiceServer() {}
 func (UnimplementedVerifyServiceServer) testEmbeddedByValue()                       {}
 
@@ -138,6 +154,24 @@ func _VerifyService_Verification_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VerifyService_ListNotificationDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNotificationDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VerifyServiceServer).ListNotificationDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VerifyService_ListNotificationDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VerifyServiceServer).ListNotificationDeliveries(ctx, req.(*ListNotificationDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VerifyService_ServiceDesc is the grpc.ServiceDesc for VerifyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +187,10 @@ var VerifyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Verification",
 			Handler:    _VerifyService_Verification_Handler,
 		},
+		{
+			MethodName: "ListNotificationDeliveries",
+			Handler:    _VerifyService_ListNotificationDeliveries_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/pb/protos/user/verify.proto",
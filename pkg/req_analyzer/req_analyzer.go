@@ -42,6 +42,8 @@ type IPInfo struct {
 	City        string
 	Asp         string
 	CountryCode string
+	Latitude    float64
+	Longitude   float64
 }
 
 // GetUserAgentInfo extracts and returns detailed information about the user agent string provided.
@@ -97,5 +99,7 @@ func (r reqAnalyzerImpl) GetIpInfo(ctx context.Context, ip string) IPInfo {
 		City:        res.City,
 		Asp:         res.ISP,
 		CountryCode: res.CountryCode,
+		Latitude:    res.Coordinates.Latitude,
+		Longitude:   res.Coordinates.Longitude,
 	}
 }
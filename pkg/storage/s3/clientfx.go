@@ -4,6 +4,10 @@ import "go.uber.org/fx"
 
 func NewS3ClientFx() fx.Option {
 	return fx.Module("s3",
-		fx.Provide(NewAWSConfig, NewClient, NewService),
+		fx.Provide(
+			NewAWSConfig,
+			fx.Annotate(NewClient, fx.As(new(S3ClientAPI))),
+			NewService,
+		),
 	)
 }
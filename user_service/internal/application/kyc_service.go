@@ -0,0 +1,206 @@
+package application
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/pb/gen/user"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/domain/vo"
+)
+
+type KycService struct {
+	user.KycServiceServer
+	kycService *service.KycService
+	db         db.Database
+}
+
+var _ user.KycServiceServer = (*KycService)(nil)
+
+func NewKycService(kycService *service.KycService, db db.Database) *KycService {
+	return &KycService{
+		kycService: kycService,
+		db:         db,
+	}
+}
+
+func (s *KycService) SubmitKyc(ctx context.Context, req *user.SubmitKycRequest) (*user.SubmitKycResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	uploads := make([]vo.KycUpload, 0, len(req.GetDocuments()))
+	for _, document := range req.GetDocuments() {
+		documentType, err := enum.KycDocumentTypeFromString(document.GetType())
+		if err != nil {
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+		uploads = append(uploads, vo.KycUpload{
+			Type:        documentType,
+			ContentType: document.GetContentType(),
+			Content:     document.GetContent(),
+		})
+	}
+
+	ctx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := s.kycService.Submit(ctx, req.GetUserId(), uploads)
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return &user.SubmitKycResponse{
+		Submission: toKycSubmissionMessage(submission),
+	}, nil
+}
+
+func (s *KycService) GetKycSubmission(ctx context.Context, req *user.GetKycSubmissionRequest) (*user.GetKycSubmissionResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	submission, err := s.kycService.GetLatestSubmission(ctx, req.GetUserId())
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			return &user.GetKycSubmissionResponse{}, nil
+		}
+		return nil, err
+	}
+
+	return &user.GetKycSubmissionResponse{
+		Submission: toKycSubmissionMessage(submission),
+	}, nil
+}
+
+func (s *KycService) ListKycSubmissions(ctx context.Context, req *user.ListKycSubmissionsRequest) (*user.ListKycSubmissionsResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	status := enum.KycStatusType.Pending
+	if req.GetStatus() != "" {
+		var err *cus_err.CusError
+		status, err = enum.KycStatusFromString(req.GetStatus())
+		if err != nil {
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+	}
+
+	pagination := vo.Pagination{Page: int(req.GetPage()), PageSize: int(req.GetPageSize())}.Normalize()
+	submissions, total, err := s.kycService.ListSubmissions(ctx, status, pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &user.ListKycSubmissionsResponse{
+		Submissions: make([]*user.KycSubmission, 0, len(submissions)),
+		Total:       int64(total),
+		Page:        int32(pagination.Page),
+		PageSize:    int32(pagination.PageSize),
+	}
+	for _, submission := range submissions {
+		res.Submissions = append(res.Submissions, toKycSubmissionMessage(submission))
+	}
+
+	return res, nil
+}
+
+func (s *KycService) GetKycDocument(ctx context.Context, req *user.GetKycDocumentRequest) (*user.GetKycDocumentResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	documentType, err := enum.KycDocumentTypeFromString(req.GetType())
+	if err != nil {
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	document, content, err := s.kycService.GetDocument(ctx, req.GetSubmissionId(), documentType)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.GetKycDocumentResponse{
+		Document: toKycDocumentMessage(document),
+		Content:  content,
+	}, nil
+}
+
+func (s *KycService) ReviewKyc(ctx context.Context, req *user.ReviewKycRequest) (*user.ReviewKycResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	ctx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	submission, err := s.kycService.Review(ctx, req.GetSubmissionId(), req.GetReviewerId(), req.GetApproved(), req.GetNote())
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return &user.ReviewKycResponse{
+		Submission: toKycSubmissionMessage(submission),
+	}, nil
+}
+
+func toKycSubmissionMessage(submission *entity.KycSubmission) *user.KycSubmission {
+	message := &user.KycSubmission{
+		Id:           submission.Id,
+		UserId:       submission.UserId,
+		Status:       submission.Status.String,
+		Documents:    make([]*user.KycDocument, 0, len(submission.Documents)),
+		ReviewerId:   submission.ReviewerId,
+		ReviewerNote: submission.ReviewerNote,
+		SubmittedAt:  submission.SubmittedAt.Unix(),
+	}
+	for _, document := range submission.Documents {
+		message.Documents = append(message.Documents, toKycDocumentMessage(document))
+	}
+	if submission.ReviewedAt != nil {
+		message.ReviewedAt = submission.ReviewedAt.Unix()
+	}
+	return message
+}
+
+// toKycDocumentMessage hides the object key of the document
+func toKycDocumentMessage(document vo.KycDocument) *user.KycDocument {
+	message := &user.KycDocument{
+		ContentType: document.ContentType,
+		Size:        int32(document.Size),
+	}
+	if documentType, err := enum.KycDocumentTypeFromId(document.Type); err == nil {
+		message.Type = documentType.String
+	}
+	return message
+}
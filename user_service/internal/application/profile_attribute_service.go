@@ -0,0 +1,209 @@
+package application
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/pb/gen/user"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/domain/vo"
+)
+
+type ProfileAttributeService struct {
+	user.ProfileAttributeServiceServer
+	attributeService *service.ProfileAttributeService
+	db               db.Database
+}
+
+var _ user.ProfileAttributeServiceServer = (*ProfileAttributeService)(nil)
+
+func NewProfileAttributeService(attributeService *service.ProfileAttributeService, db db.Database) *ProfileAttributeService {
+	return &ProfileAttributeService{
+		attributeService: attributeService,
+		db:               db,
+	}
+}
+
+func (s *ProfileAttributeService) ListProfileAttributes(ctx context.Context, req *user.ListProfileAttributesRequest) (*user.ListProfileAttributesResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	attributes, err := s.attributeService.ListAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &user.ListProfileAttributesResponse{
+		Attributes: make([]*user.ProfileAttribute, 0, len(attributes)),
+	}
+	for _, attribute := range attributes {
+		res.Attributes = append(res.Attributes, toProfileAttributeMessage(attribute))
+	}
+
+	return res, nil
+}
+
+func (s *ProfileAttributeService) DefineProfileAttribute(ctx context.Context, req *user.DefineProfileAttributeRequest) (*user.DefineProfileAttributeResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	attribute, err := fromProfileAttributeMessage(req.GetAttribute(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attribute, err = s.attributeService.DefineAttribute(ctx, attribute)
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return &user.DefineProfileAttributeResponse{
+		Attribute: toProfileAttributeMessage(attribute),
+	}, nil
+}
+
+func (s *ProfileAttributeService) UpdateProfileAttribute(ctx context.Context, req *user.UpdateProfileAttributeRequest) (*user.UpdateProfileAttributeResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	attribute, err := fromProfileAttributeMessage(req.GetAttribute(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attribute, err = s.attributeService.UpdateAttribute(ctx, attribute)
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return &user.UpdateProfileAttributeResponse{
+		Attribute: toProfileAttributeMessage(attribute),
+	}, nil
+}
+
+// fromProfileAttributeMessage converts the attribute of the request, the type is only read for the definition
+// and the pii class is optional for the update
+func fromProfileAttributeMessage(message *user.ProfileAttribute, define bool) (*entity.ProfileAttribute, *cus_err.CusError) {
+	if message == nil {
+		return nil, cus_err.New(cus_err.InvalidArgument, "attribute is required")
+	}
+
+	rules := message.GetRules()
+	attribute := &entity.ProfileAttribute{
+		Name: message.GetName(),
+		Rules: vo.AttributeRules{
+			MinLength: int(rules.GetMinLength()),
+			MaxLength: int(rules.GetMaxLength()),
+			Pattern:   rules.GetPattern(),
+			Options:   rules.GetOptions(),
+			MinDate:   rules.GetMinDate(),
+			MaxDate:   rules.GetMaxDate(),
+		},
+		Unique: message.GetUnique(),
+	}
+
+	var err *cus_err.CusError
+	if define {
+		attribute.Type, err = enum.AttributeTypeFromString(message.GetType())
+		if err != nil {
+			return nil, err
+		}
+	}
+	if define || message.GetPiiClass() != "" {
+		attribute.PII, err = enum.PIIClassFromString(message.GetPiiClass())
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, id := range message.GetVisibleTo() {
+		client, err := enum.ClientTypeFromId(int(id))
+		if err != nil {
+			return nil, cus_err.New(cus_err.InvalidArgument, "invalid client type", err)
+		}
+		attribute.VisibleTo = append(attribute.VisibleTo, client)
+	}
+
+	return attribute, nil
+}
+
+func toProfileAttributeMessage(attribute *entity.ProfileAttribute) *user.ProfileAttribute {
+	visibleTo := make([]int32, 0, len(attribute.VisibleTo))
+	for _, client := range attribute.VisibleTo {
+		visibleTo = append(visibleTo, int32(client.Id))
+	}
+
+	return &user.ProfileAttribute{
+		Key:  int32(attribute.Key),
+		Name: attribute.Name,
+		Type: attribute.Type.String,
+		Rules: &user.AttributeRules{
+			MinLength: int32(attribute.Rules.MinLength),
+			MaxLength: int32(attribute.Rules.MaxLength),
+			Pattern:   attribute.Rules.Pattern,
+			Options:   attribute.Rules.Options,
+			MinDate:   attribute.Rules.MinDate,
+			MaxDate:   attribute.Rules.MaxDate,
+		},
+		Unique:    attribute.Unique,
+		PiiClass:  attribute.PII.String,
+		VisibleTo: visibleTo,
+		System:    attribute.System,
+	}
+}
+
+// toAttributeValueMessages converts the typed values of the attributes, the value is set to the field of its type
+func toAttributeValueMessages(values map[string]vo.AttributeValue) map[string]*user.ProfileAttributeValue {
+	messages := make(map[string]*user.ProfileAttributeValue, len(values))
+	for name, value := range values {
+		message := &user.ProfileAttributeValue{Type: value.Type.String}
+		switch value.Type {
+		case enum.AttributeTypes.Date:
+			message.Value = &user.ProfileAttributeValue_DateValue{DateValue: value.Value}
+		case enum.AttributeTypes.Enum:
+			message.Value = &user.ProfileAttributeValue_EnumValue{EnumValue: value.Value}
+		case enum.AttributeTypes.Phone:
+			message.Value = &user.ProfileAttributeValue_PhoneValue{PhoneValue: value.Value}
+		default:
+			message.Value = &user.ProfileAttributeValue_StringValue{StringValue: value.Value}
+		}
+		messages[name] = message
+	}
+	return messages
+}
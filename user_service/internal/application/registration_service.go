@@ -0,0 +1,230 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/pb/gen/user"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"time"
+)
+
+type RegistrationService struct {
+	user.RegistrationServiceServer
+	registrationService *service.RegistrationService
+	verifyService       *service.VerifyService
+	userService         *service.UserService
+	db                  db.Database
+}
+
+var _ user.RegistrationServiceServer = (*RegistrationService)(nil)
+
+func NewRegistrationService(registrationService *service.RegistrationService, verifyService *service.VerifyService, userService *service.UserService, db db.Database) *RegistrationService {
+	return &RegistrationService{
+		registrationService: registrationService,
+		verifyService:       verifyService,
+		userService:         userService,
+		db:                  db,
+	}
+}
+
+func (s *RegistrationService) RegisterUser(ctx context.Context, req *user.RegisterUserRequest) (*user.RegisterUserResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	profile := entity.Profile{
+		Account:      req.GetAccount(),
+		Email:        req.GetEmail(),
+		CountryCode:  req.GetCountryCode(),
+		MobileNumber: req.GetMobileNumber(),
+	}
+
+	userId, err := s.register(ctx, req.GetClientId(), req.GetIdempotencyKey(), req.GetUserId(), profile, req.GetPassword(), func(ctx context.Context) *cus_err.CusError {
+		verified, err := s.verifyService.Verification(ctx, vo.NewVerificationSession(
+			"",
+			req.GetVerificationCodePrefix(),
+			req.GetVerificationCode(),
+			req.GetVerificationCodeToken(),
+		))
+		if err != nil {
+			return err
+		}
+		if !verified {
+			err = cus_err.New(cus_err.InvalidArgument, "verification failed")
+			cus_otel.Warn(ctx, err.Error())
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.RegisterUserResponse{UserId: userId}, nil
+}
+
+func (s *RegistrationService) RegisterOAuthUser(ctx context.Context, req *user.RegisterOAuthUserRequest) (*user.RegisterUserResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	provider, err := enum.OAuthProviderFromString(req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	// The openID is taken from the provider instead of the caller
+	identity, err := s.userService.VerifyOAuth(ctx, vo.NewOAuthSession(provider, req.GetAccessToken()))
+	if err != nil {
+		return nil, err
+	}
+
+	profile := entity.Profile{
+		Account: req.GetAccount(),
+	}
+	profile.ThirdParties.Set(provider, entity.ThirdParty{ID: identity.OpenID})
+
+	// The user without password signs in by the oauth account only
+	userId, err := s.register(ctx, req.GetClientId(), req.GetIdempotencyKey(), req.GetUserId(), profile, "", func(ctx context.Context) *cus_err.CusError {
+		if identity.UserId != 0 {
+			err := cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("%s account is already linked to another user", provider.String))
+			cus_otel.Warn(ctx, err.Error())
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.RegisterUserResponse{UserId: userId}, nil
+}
+
+// register runs the registration saga, the retry of the idempotency key gets the result of the first registration without verifying again
+func (s *RegistrationService) register(ctx context.Context, clientId int64, idempotencyKey string, userId int64, profile entity.Profile, password string, verify func(ctx context.Context) *cus_err.CusError) (int64, *cus_err.CusError) {
+	// The retry gets the result of the first registration, the verification code is already used by it
+	saga, err := s.registrationService.FindSaga(ctx, clientId, idempotencyKey)
+	if err == nil {
+		err = s.registrationService.Replay(ctx, saga, profile)
+		if err != nil {
+			return 0, err
+		}
+		return saga.UserId, nil
+	}
+	if err.Code().Int() != cus_err.ResourceNotFound {
+		return 0, err
+	}
+
+	err = verify(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	saga, err = s.inTx(ctx, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+		return s.registrationService.Start(ctx, clientId, idempotencyKey, userId, profile)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	created, err := s.inTx(ctx, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+		return s.registrationService.CreateAccount(ctx, saga, password)
+	})
+	if err != nil {
+		s.compensate(ctx, saga, err)
+		return 0, err
+	}
+
+	_, err = s.inTx(ctx, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+		return s.registrationService.CreateProfile(ctx, created)
+	})
+	if err != nil {
+		s.compensate(ctx, created, err)
+		return 0, err
+	}
+
+	return saga.UserId, nil
+}
+
+// RecoverStaleRegistrations resumes or rolls back the registrations which are interrupted.
+// The profile is created for the saga whose auth user is created, the rest are compensated.
+func (s *RegistrationService) RecoverStaleRegistrations(ctx context.Context) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	sagas, err := s.registrationService.ListStaleSagas(ctx, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	for _, saga := range sagas {
+		switch saga.Status {
+		case enum.RegistrationStatusType.Started:
+			// It's unknown whether the auth user is created, deleting it is safe either way
+			s.compensate(ctx, saga, cus_err.New(cus_err.InternalServerError, "the registration is interrupted"))
+
+		case enum.RegistrationStatusType.AuthUserCreated:
+			_, err = s.inTx(ctx, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+				return s.registrationService.CreateProfile(ctx, saga)
+			})
+			if err != nil && err.Code().Int() != cus_err.Conflict {
+				s.compensate(ctx, saga, err)
+			}
+
+		case enum.RegistrationStatusType.Compensating:
+			s.compensate(ctx, saga, saga.Err())
+		}
+	}
+
+	cus_otel.Info(ctx, "stale registrations are recovered", cus_otel.NewField("count", len(sagas)))
+	return nil
+}
+
+// compensate fails the saga with the cause and deletes the auth user, the saga is left for the recovery when it fails
+func (s *RegistrationService) compensate(ctx context.Context, saga *entity.RegistrationSaga, cause *cus_err.CusError) {
+	failed, err := s.inTx(ctx, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+		return s.registrationService.Fail(ctx, saga, cause)
+	})
+	if err != nil {
+		cus_otel.Error(ctx, "failed to fail the registration", cus_otel.NewField("sagaId", saga.Id), cus_otel.NewField("error", err))
+		return
+	}
+
+	_, err = s.inTx(ctx, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+		return s.registrationService.Compensate(ctx, failed)
+	})
+	if err != nil {
+		cus_otel.Error(ctx, "failed to compensate the registration", cus_otel.NewField("sagaId", saga.Id), cus_otel.NewField("error", err))
+	}
+}
+
+// inTx runs the step of the saga in a transaction, the step is rolled back when it fails
+func (s *RegistrationService) inTx(ctx context.Context, step func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError)) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	saga, err := step(ctx)
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return saga, nil
+}
@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"fmt"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/db"
@@ -11,20 +12,25 @@ import (
 	"go_micro_service_api/user_service/internal/domain/entity"
 	"go_micro_service_api/user_service/internal/domain/service"
 	"go_micro_service_api/user_service/internal/domain/vo"
+	"time"
 )
 
 type UserService struct {
 	user.UserServiceServer
-	userService *service.UserService
-	db          db.Database
+	userService      *service.UserService
+	attributeService *service.ProfileAttributeService
+	searchService    *service.UserSearchService
+	db               db.Database
 }
 
 var _ user.UserServiceServer = (*UserService)(nil)
 
-func NewUserService(userService *service.UserService, db db.Database) *UserService {
+func NewUserService(userService *service.UserService, attributeService *service.ProfileAttributeService, searchService *service.UserSearchService, db db.Database) *UserService {
 	return &UserService{
-		userService: userService,
-		db:          db,
+		userService:      userService,
+		attributeService: attributeService,
+		searchService:    searchService,
+		db:               db,
 	}
 }
 
@@ -71,20 +77,27 @@ func (s *UserService) GetProfile(ctx context.Context, req *user.GetProfileReques
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
 
-	u := &aggregate.User{
-		ID:      req.GetId(),
-		Profile: entity.Profile{},
+	// The frontend sees the least attributes when the client isn't given
+	client := enum.ClientType.Frontend
+	if req.GetClientType() != 0 {
+		var err *cus_err.CusError
+		client, err = enum.ClientTypeFromId(int(req.GetClientType()))
+		if err != nil {
+			return nil, cus_err.New(cus_err.InvalidArgument, "invalid client type", err)
+		}
 	}
 
-	u, err := s.userService.GetProfile(ctx, u, nil)
+	attributes, err := s.attributeService.GetAttributes(ctx, req.GetId(), client)
 	if err != nil {
 		return nil, err
 	}
 
 	return &user.GetProfileResponse{
-		Email:        u.Profile.Email,
-		CountryCode:  u.Profile.CountryCode,
-		MobileNumber: u.Profile.MobileNumber,
+		Username:     attributes[enum.ProfileKey.Account.String].Value,
+		Email:        attributes[enum.ProfileKey.Email.String].Value,
+		CountryCode:  attributes[enum.ProfileKey.CountryCode.String].Value,
+		MobileNumber: attributes[enum.ProfileKey.MobileNumber.String].Value,
+		Attributes:   toAttributeValueMessages(attributes),
 	}, nil
 }
 
@@ -213,3 +226,248 @@ func (s *UserService) GetLoginUserInfo(ctx context.Context, req *user.GetLoginUs
 		MobileNumber: u.Profile.MobileNumber,
 	}, nil
 }
+
+func (s *UserService) VerifyOAuth(ctx context.Context, req *user.VerifyOAuthRequest) (*user.VerifyOAuthResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	provider, err := enum.OAuthProviderFromString(req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := s.userService.VerifyOAuth(ctx, vo.NewOAuthSession(provider, req.GetAccessToken()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &user.VerifyOAuthResponse{
+		OpenID: identity.OpenID,
+		UserId: identity.UserId,
+	}, nil
+}
+
+func (s *UserService) LinkOAuth(ctx context.Context, req *user.LinkOAuthRequest) (*user.LinkOAuthResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	provider, err := enum.OAuthProviderFromString(req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	identity, err := s.userService.LinkOAuth(ctx, req.GetUserId(), vo.NewOAuthSession(provider, req.GetAccessToken()))
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return &user.LinkOAuthResponse{
+		OpenID: identity.OpenID,
+	}, nil
+}
+
+func (s *UserService) UnlinkOAuth(ctx context.Context, req *user.UnlinkOAuthRequest) (*user.UnlinkOAuthResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	provider, err := enum.OAuthProviderFromString(req.GetProvider())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err = s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.userService.UnlinkOAuth(ctx, req.GetUserId(), provider)
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return &user.UnlinkOAuthResponse{}, nil
+}
+
+func (s *UserService) UpdateProfile(ctx context.Context, req *user.UpdateProfileRequest) (*user.UpdateProfileResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	update := &vo.ProfileUpdate{
+		Email:              req.GetEmail(),
+		EmailVerification:  newProfileVerificationSession(req.GetEmailVerification()),
+		CountryCode:        req.GetCountryCode(),
+		MobileNumber:       req.GetMobileNumber(),
+		MobileVerification: newProfileVerificationSession(req.GetMobileVerification()),
+	}
+
+	ctx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.userService.UpdateProfile(ctx, req.GetUserId(), update)
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return &user.UpdateProfileResponse{
+		Account:      u.Profile.Account,
+		Email:        u.Profile.Email,
+		CountryCode:  u.Profile.CountryCode,
+		MobileNumber: u.Profile.MobileNumber,
+	}, nil
+}
+
+// newProfileVerificationSession converts the verification of the new email or mobile number, nil when it's absent
+func newProfileVerificationSession(verification *user.ProfileVerification) *vo.VerificationSession {
+	if verification == nil {
+		return nil
+	}
+
+	return vo.NewVerificationSession(
+		enum.VerificationTypes.UpdateProfile.String,
+		verification.GetVerificationCodePrefix(),
+		verification.GetVerificationCode(),
+		verification.GetVerificationCodeToken(),
+	)
+}
+
+func (s *UserService) SetProfileAttributes(ctx context.Context, req *user.SetProfileAttributesRequest) (*user.SetProfileAttributesResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	ctx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := s.attributeService.SetAttributes(ctx, req.GetUserId(), req.GetAttributes())
+	if err != nil {
+		_, rollbackErr := s.db.Rollback(ctx)
+		if rollbackErr != nil {
+			cus_otel.Error(ctx, rollbackErr.Error())
+			err = rollbackErr
+		}
+		return nil, err
+	}
+
+	// Commit the transaction
+	_, commitErr := s.db.Commit(ctx)
+	if commitErr != nil {
+		cus_otel.Error(ctx, commitErr.Error())
+		return nil, commitErr
+	}
+
+	return &user.SetProfileAttributesResponse{
+		Attributes: toAttributeValueMessages(attributes),
+	}, nil
+}
+
+func (s *UserService) SearchUsers(ctx context.Context, req *user.SearchUsersRequest) (*user.SearchUsersResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	search := vo.UserSearch{
+		AccountPrefix: req.GetAccountPrefix(),
+		Email:         req.GetEmail(),
+		CountryCode:   req.GetCountryCode(),
+		MobileNumber:  req.GetMobileNumber(),
+		Sort:          req.GetSort(),
+		Ascending:     req.GetAscending(),
+		Cursor:        req.GetCursor(),
+		Limit:         int(req.GetLimit()),
+	}
+	if req.GetStatus() != 0 {
+		status, err := enum.UserStatusFromInt(int(req.GetStatus()))
+		if err != nil {
+			err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("invalid user status: %d", req.GetStatus()))
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+		search.Status = &status
+	}
+	if req.GetMerchantId() != 0 {
+		merchantId := req.GetMerchantId()
+		search.MerchantId = &merchantId
+	}
+	if req.GetClientId() != 0 {
+		clientId := req.GetClientId()
+		search.ClientId = &clientId
+	}
+	if req.GetCreatedFrom() != 0 {
+		createdFrom := time.Unix(req.GetCreatedFrom(), 0)
+		search.CreatedFrom = &createdFrom
+	}
+	if req.GetCreatedTo() != 0 {
+		createdTo := time.Unix(req.GetCreatedTo(), 0)
+		search.CreatedTo = &createdTo
+	}
+
+	page, err := s.searchService.SearchUsers(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &user.SearchUsersResponse{
+		Users:      make([]*user.UserListItem, 0, len(page.Users)),
+		NextCursor: page.NextCursor,
+	}
+	for _, u := range page.Users {
+		res.Users = append(res.Users, &user.UserListItem{
+			UserId:       u.UserId,
+			Account:      u.Account,
+			Email:        u.Email,
+			CountryCode:  u.CountryCode,
+			MobileNumber: u.MobileNumber,
+			Status:       int32(u.Status),
+			RoleId:       u.RoleId,
+			RoleName:     u.RoleName,
+			ClientId:     u.ClientId,
+			MerchantId:   u.MerchantId,
+			CreatedAt:    u.CreatedAt.Unix(),
+		})
+	}
+
+	return res, nil
+}
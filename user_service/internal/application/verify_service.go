@@ -2,7 +2,9 @@ package application
 
 import (
 	"context"
+	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
 	"go_micro_service_api/pkg/pb/gen/user"
 	"go_micro_service_api/user_service/internal/domain/service"
 	"go_micro_service_api/user_service/internal/domain/vo"
@@ -25,14 +27,13 @@ func (s *VerifyService) RegisterVerification(ctx context.Context, req *user.Regi
 	_, span := cus_otel.StartTrace(ctx)
 	defer span.End()
 
-	session, err := s.verifyService.RegisterVerification(ctx, req.GetType(), req.GetEmail(), req.GetCountryCode(), req.GetMobileNumber())
+	session, err := s.verifyService.RegisterVerification(ctx, req.GetType(), req.GetEmail(), req.GetCountryCode(), req.GetMobileNumber(), req.GetLocale())
 	if err != nil {
 		return &user.RegisterVerificationResponse{}, err
 	}
 
 	return &user.RegisterVerificationResponse{
 		VerificationCodePrefix: session.Prefix,
-		VerificationCode:       session.Code,
 		VerificationCodeToken:  session.Token,
 	}, nil
 }
@@ -48,6 +49,20 @@ func (s *VerifyService) Verification(ctx context.Context, req *user.Verification
 		req.GetVerificationCodeToken(),
 	)
 
+	// The password is reset or the login is completed for the verified identity,
+	// so the identity must be the only one the code is sent to and the token must belong to it
+	identityBound := req.GetType() == enum.VerificationTypes.ForgotPwd.String ||
+		req.GetType() == enum.VerificationTypes.UnusualLogin.String
+	if identityBound {
+		err := s.verifyService.ValidateRecipient(ctx, req.GetEmail(), req.GetCountryCode(), req.GetMobileNumber())
+		if err != nil {
+			return &user.VerificationResponse{}, err
+		}
+		if !session.IsIssuedFor(req.GetType(), req.GetEmail(), req.GetCountryCode(), req.GetMobileNumber()) {
+			return &user.VerificationResponse{}, cus_err.New(cus_err.InvalidArgument, "verification token is not issued for the identity")
+		}
+	}
+
 	res, err := s.verifyService.Verification(ctx, session)
 	if err != nil {
 		return &user.VerificationResponse{}, err
@@ -57,3 +72,32 @@ func (s *VerifyService) Verification(ctx context.Context, req *user.Verification
 		Result: res,
 	}, nil
 }
+
+func (s *VerifyService) ListNotificationDeliveries(ctx context.Context, req *user.ListNotificationDeliveriesRequest) (*user.ListNotificationDeliveriesResponse, error) {
+	_, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	deliveries, err := s.verifyService.ListNotificationDeliveries(ctx, req.GetEmail(), req.GetCountryCode(), req.GetMobileNumber(), int(req.GetLimit()))
+	if err != nil {
+		return &user.ListNotificationDeliveriesResponse{}, err
+	}
+
+	res := &user.ListNotificationDeliveriesResponse{
+		Deliveries: make([]*user.NotificationDelivery, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		res.Deliveries = append(res.Deliveries, &user.NotificationDelivery{
+			Id:        delivery.Id,
+			Channel:   string(delivery.Channel),
+			Recipient: delivery.Recipient,
+			Type:      delivery.Type,
+			Status:    string(delivery.Status),
+			Attempts:  int32(delivery.Attempts),
+			LastError: delivery.LastError,
+			CreatedAt: delivery.CreatedAt.Unix(),
+			UpdatedAt: delivery.UpdatedAt.Unix(),
+		})
+	}
+
+	return res, nil
+}
@@ -46,12 +46,55 @@ type (
 		VerificationTokenTotalAttempts    int `env:"VERIFICATION_TOKEN_TOTAL_ATTEMPTS"`
 	}
 
+	OAUTH struct {
+		GoogleClientIDs []string `env:"OAUTH_GOOGLE_CLIENT_IDS"` // Accepted aud of the google id tokens, comma separated
+		LINEChannelIDs  []string `env:"OAUTH_LINE_CHANNEL_IDS"`  // Accepted aud of the line id tokens and client_id of the access tokens, comma separated
+		OIDCCacheSecs   int      `env:"OAUTH_OIDC_CACHE_SECS"`   // How long the discovery documents and the JWKS are cached
+	}
+
+	NOTIFY struct {
+		NotifyDriver        string `env:"NOTIFY_DRIVER"`         // log: write the messages to NOTIFY_LOG_FILE, provider: send by smtp and sms provider
+		NotifyLogFile       string `env:"NOTIFY_LOG_FILE"`       // Messages are appended to the file when the driver is log
+		NotifyDefaultLocale string `env:"NOTIFY_DEFAULT_LOCALE"` // Locale of the templates when the request has none or an unsupported one
+		SmtpHost            string `env:"SMTP_HOST"`
+		SmtpPort            int    `env:"SMTP_PORT"`
+		SmtpUser            string `env:"SMTP_USER"`
+		SmtpPass            string `env:"SMTP_PASS"`
+		SmtpFrom            string `env:"SMTP_FROM"`
+		SmsAccountSid       string `env:"SMS_ACCOUNT_SID"`
+		SmsAuthToken        string `env:"SMS_AUTH_TOKEN"`
+		SmsFrom             string `env:"SMS_FROM"`
+		NotifyMaxAttempts   int    `env:"NOTIFY_MAX_ATTEMPTS"`     // Sending attempts before the event is dead-lettered
+		NotifyRetryDelay    int    `env:"NOTIFY_RETRY_DELAY_SECS"` // Delay between the attempts
+		NotifyStatusTTLSecs int    `env:"NOTIFY_STATUS_TTL_SECS"`  // How long the delivery status can be queried
+	}
+
+	RabbitMQ struct {
+		RabbitMQUser string `env:"RABBITMQ_USER"`
+		RabbitMQPass string `env:"RABBITMQ_PASS"`
+		RabbitMQHost string `env:"RABBITMQ_HOST"`
+		RabbitMQPort int    `env:"RABBITMQ_PORT"`
+	}
+
+	Auth struct {
+		AuthUrl string `env:"AUTH_URL"`
+	}
+
+	KYC struct {
+		KycBucket string `env:"KYC_BUCKET"` // Private S3 bucket of the KYC documents, the AWS credentials are loaded by the default chain
+	}
+
 	Config struct {
 		Host
 		Otel
 		Redis
 		DB
 		VERIFICATION
+		OAUTH
+		NOTIFY
+		RabbitMQ
+		Auth
+		KYC
 	}
 )
 
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"strings"
+	"time"
+)
+
+// KycSubmission is a set of the identity documents of the player waiting for or passed the review
+type KycSubmission struct {
+	Id           int64
+	UserId       int64
+	Status       enum.KycStatus
+	Documents    []vo.KycDocument
+	ReviewerId   int64  // 0 until it is reviewed
+	ReviewerNote string // Shown to the player, required by the rejection
+	SubmittedAt  time.Time
+	ReviewedAt   *time.Time
+}
+
+// Review moves the pending submission to approved or rejected
+func (s *KycSubmission) Review(reviewerId int64, approved bool, note string, now time.Time) *cus_err.CusError {
+	if s.Status != enum.KycStatusType.Pending {
+		return cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("kyc submission %d is already %s", s.Id, s.Status.String))
+	}
+	if reviewerId == 0 {
+		return cus_err.New(cus_err.InvalidArgument, "reviewer is required")
+	}
+	note = strings.TrimSpace(note)
+	if !approved && note == "" {
+		return cus_err.New(cus_err.InvalidArgument, "the reason of the rejection is required")
+	}
+
+	s.Status = enum.KycStatusType.Rejected
+	if approved {
+		s.Status = enum.KycStatusType.Approved
+	}
+	s.ReviewerId = reviewerId
+	s.ReviewerNote = note
+	s.ReviewedAt = &now
+	return nil
+}
+
+// Document finds the document of the type
+func (s *KycSubmission) Document(documentType enum.KycDocument) (vo.KycDocument, bool) {
+	for _, document := range s.Documents {
+		if document.Type == documentType.Id {
+			return document, true
+		}
+	}
+	return vo.KycDocument{}, false
+}
@@ -1,5 +1,7 @@
 package entity
 
+import "go_micro_service_api/pkg/enum"
+
 type Profile struct {
 	Account      string
 	Email        string
@@ -18,3 +20,48 @@ type ThridParties struct {
 type ThirdParty struct {
 	ID string
 }
+
+// Get returns the third party of the oauth provider
+func (t *ThridParties) Get(provider enum.Provider) ThirdParty {
+	switch provider {
+	case enum.OAuthProvider.Google:
+		return t.Google
+	case enum.OAuthProvider.Meta:
+		return t.Meta
+	case enum.OAuthProvider.Twitter:
+		return t.Twitter
+	case enum.OAuthProvider.LINE:
+		return t.LINE
+	}
+	return ThirdParty{}
+}
+
+// Set sets the third party of the oauth provider
+func (t *ThridParties) Set(provider enum.Provider, thirdParty ThirdParty) {
+	switch provider {
+	case enum.OAuthProvider.Google:
+		t.Google = thirdParty
+	case enum.OAuthProvider.Meta:
+		t.Meta = thirdParty
+	case enum.OAuthProvider.Twitter:
+		t.Twitter = thirdParty
+	case enum.OAuthProvider.LINE:
+		t.LINE = thirdParty
+	}
+}
+
+// Linked returns the oauth providers bound to the user
+func (t *ThridParties) Linked() []enum.Provider {
+	providers := make([]enum.Provider, 0)
+	for _, provider := range []enum.Provider{
+		enum.OAuthProvider.Google,
+		enum.OAuthProvider.Meta,
+		enum.OAuthProvider.Twitter,
+		enum.OAuthProvider.LINE,
+	} {
+		if t.Get(provider).ID != "" {
+			providers = append(providers, provider)
+		}
+	}
+	return providers
+}
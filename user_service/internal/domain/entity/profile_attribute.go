@@ -0,0 +1,196 @@
+package entity
+
+import (
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// CustomAttributeKeyStart is the first key of the attributes defined at runtime,
+// the keys below it are reserved for the built-in attributes of enum.ProfileKey
+const CustomAttributeKeyStart = 1000
+
+var (
+	attributeNamePattern = regexp.MustCompile(`^[a-z][a-zA-Z0-9]{1,31}$`)
+	phonePattern         = regexp.MustCompile(`^\+[1-9][0-9]{6,14}$`)
+	phoneSeparators      = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "")
+)
+
+// ProfileAttribute declares a key of the profile and how its value is validated and shown
+type ProfileAttribute struct {
+	Key       int // The key of the profile rows
+	Name      string
+	Type      enum.AttributeType
+	Rules     vo.AttributeRules
+	Unique    bool // The active value can only belong to one user
+	PII       enum.PII
+	VisibleTo []enum.Client
+	System    bool // Declared by the service, can't be changed at runtime
+}
+
+// BuiltinAttributes are the attributes of enum.ProfileKey
+func BuiltinAttributes() []*ProfileAttribute {
+	everyone := []enum.Client{enum.ClientType.Frontend, enum.ClientType.Backend}
+	backend := []enum.Client{enum.ClientType.Backend}
+	openID := func(key enum.Profile) *ProfileAttribute {
+		return &ProfileAttribute{Key: key.ID, Name: key.String, Type: enum.AttributeTypes.String, Unique: true, PII: enum.PIIClass.Personal, VisibleTo: backend, System: true}
+	}
+
+	return []*ProfileAttribute{
+		{
+			Key:       enum.ProfileKey.Email.ID,
+			Name:      enum.ProfileKey.Email.String,
+			Type:      enum.AttributeTypes.String,
+			Rules:     vo.AttributeRules{MaxLength: 254, Pattern: `^[^@\s]+@[^@\s]+\.[^@\s]+$`},
+			Unique:    true,
+			PII:       enum.PIIClass.Personal,
+			VisibleTo: everyone,
+			System:    true,
+		},
+		{
+			Key:       enum.ProfileKey.CountryCode.ID,
+			Name:      enum.ProfileKey.CountryCode.String,
+			Type:      enum.AttributeTypes.String,
+			Rules:     vo.AttributeRules{Pattern: `^[0-9]{1,4}$`},
+			PII:       enum.PIIClass.Personal,
+			VisibleTo: everyone,
+			System:    true,
+		},
+		{
+			// Unique together with the country code
+			Key:       enum.ProfileKey.MobileNumber.ID,
+			Name:      enum.ProfileKey.MobileNumber.String,
+			Type:      enum.AttributeTypes.String,
+			Rules:     vo.AttributeRules{Pattern: `^[0-9]{4,15}$`},
+			PII:       enum.PIIClass.Personal,
+			VisibleTo: everyone,
+			System:    true,
+		},
+		{
+			Key:       enum.ProfileKey.Account.ID,
+			Name:      enum.ProfileKey.Account.String,
+			Type:      enum.AttributeTypes.String,
+			Rules:     vo.AttributeRules{MinLength: 3, MaxLength: 50},
+			Unique:    true,
+			PII:       enum.PIIClass.Personal,
+			VisibleTo: everyone,
+			System:    true,
+		},
+		openID(enum.ProfileKey.GoogleOpenID),
+		openID(enum.ProfileKey.MetaOpenID),
+		openID(enum.ProfileKey.TwitterOpenID),
+		openID(enum.ProfileKey.LINEOpenID),
+	}
+}
+
+// ProfileKey returns the profile key of the attribute
+func (a *ProfileAttribute) ProfileKey() enum.Profile {
+	return enum.Profile{ID: a.Key, String: a.Name}
+}
+
+// IsVisibleTo checks whether the value of the attribute can be shown to the client
+func (a *ProfileAttribute) IsVisibleTo(client enum.Client) bool {
+	return slices.Contains(a.VisibleTo, client)
+}
+
+// Check checks the declaration of the attribute
+func (a *ProfileAttribute) Check() *cus_err.CusError {
+	if !attributeNamePattern.MatchString(a.Name) {
+		return cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("attribute name %q must be camel case of 2 to 32 letters or digits", a.Name))
+	}
+	if _, err := enum.AttributeTypeFromId(a.Type.Id); err != nil {
+		return err
+	}
+	if _, err := enum.PIIClassFromId(a.PII.Id); err != nil {
+		return err
+	}
+	for _, client := range a.VisibleTo {
+		if _, err := enum.ClientTypeFromId(client.Id); err != nil {
+			return cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("invalid client type %d", client.Id))
+		}
+	}
+
+	rules := a.Rules
+	if rules.MinLength < 0 || rules.MaxLength < 0 || (rules.MaxLength > 0 && rules.MinLength > rules.MaxLength) {
+		return cus_err.New(cus_err.InvalidArgument, "invalid length of the attribute")
+	}
+	if rules.Pattern != "" {
+		if _, err := regexp.Compile(rules.Pattern); err != nil {
+			return cus_err.New(cus_err.InvalidArgument, "invalid pattern of the attribute", err)
+		}
+	}
+	for _, date := range []string{rules.MinDate, rules.MaxDate} {
+		if date == "" {
+			continue
+		}
+		if _, err := time.Parse(vo.AttributeDateLayout, date); err != nil {
+			return cus_err.New(cus_err.InvalidArgument, "invalid date range of the attribute", err)
+		}
+	}
+	if a.Type == enum.AttributeTypes.Enum {
+		if len(rules.Options) == 0 {
+			return cus_err.New(cus_err.InvalidArgument, "options are required by the enum attribute")
+		}
+		for i, option := range rules.Options {
+			if strings.TrimSpace(option) == "" || slices.Contains(rules.Options[:i], option) {
+				return cus_err.New(cus_err.InvalidArgument, "options of the enum attribute must be non-empty and distinct")
+			}
+		}
+	}
+
+	return nil
+}
+
+// Normalize validates the value by the type and the rules of the attribute, and returns it in the canonical form.
+// Dates are formatted as 2006-01-02 and phone numbers as E.164.
+func (a *ProfileAttribute) Normalize(value string) (string, *cus_err.CusError) {
+	value = strings.TrimSpace(value)
+	rules := a.Rules
+
+	switch a.Type {
+	case enum.AttributeTypes.Date:
+		date, err := time.Parse(vo.AttributeDateLayout, value)
+		if err != nil {
+			return "", a.invalid("must be a date of YYYY-MM-DD")
+		}
+		value = date.Format(vo.AttributeDateLayout)
+		// The dates of the same layout are ordered as strings
+		if (rules.MinDate != "" && value < rules.MinDate) || (rules.MaxDate != "" && value > rules.MaxDate) {
+			return "", a.invalid("is out of the date range")
+		}
+		return value, nil
+	case enum.AttributeTypes.Enum:
+		if !slices.Contains(rules.Options, value) {
+			return "", a.invalid(fmt.Sprintf("must be one of %s", strings.Join(rules.Options, ", ")))
+		}
+		return value, nil
+	case enum.AttributeTypes.Phone:
+		value = phoneSeparators.Replace(value)
+		if !phonePattern.MatchString(value) {
+			return "", a.invalid("must be a phone number of E.164, e.g. +886912345678")
+		}
+	}
+
+	length := utf8.RuneCountInString(value)
+	if length < rules.MinLength || (rules.MaxLength > 0 && length > rules.MaxLength) {
+		return "", a.invalid("has an invalid length")
+	}
+	if rules.Pattern != "" {
+		matched, err := regexp.MatchString(rules.Pattern, value)
+		if err != nil || !matched {
+			return "", a.invalid("has an invalid format")
+		}
+	}
+
+	return value, nil
+}
+
+func (a *ProfileAttribute) invalid(reason string) *cus_err.CusError {
+	return cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("attribute %s %s", a.Name, reason))
+}
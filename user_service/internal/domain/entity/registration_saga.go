@@ -0,0 +1,51 @@
+package entity
+
+import (
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
+// RegistrationSaga records the steps of a registration, the user is created in the auth service and then the profile is created.
+// The openID of the oauth signup is linked with the profile in the same step.
+// The auth user is deleted when the registration fails after it is created, so the account can be registered again.
+type RegistrationSaga struct {
+	Id             int64
+	ClientId       int64
+	IdempotencyKey string // The retries of the client share the key and get the result of the first registration
+	UserId         int64
+	Profile        Profile
+	Status         enum.RegistrationStatus
+	ErrCode        int // The cus_err code of the failed registration, 0 if it doesn't fail
+	ErrMessage     string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// IsDone checks the registration is completed or compensated, the rest steps are resumed by the recovery
+func (s *RegistrationSaga) IsDone() bool {
+	return s.Status == enum.RegistrationStatusType.Completed || s.Status == enum.RegistrationStatusType.Compensated
+}
+
+// SameRequest checks the retry registers the same account and profile as the saga
+func (s *RegistrationSaga) SameRequest(profile Profile) bool {
+	return s.Profile.Account == profile.Account &&
+		s.Profile.Email == profile.Email &&
+		s.Profile.CountryCode == profile.CountryCode &&
+		s.Profile.MobileNumber == profile.MobileNumber &&
+		s.Profile.ThirdParties == profile.ThirdParties
+}
+
+// Fail keeps the cause of the failure, it's returned to the retries
+func (s *RegistrationSaga) Fail(err *cus_err.CusError) {
+	s.ErrCode = err.Code().Int()
+	s.ErrMessage = err.Message()
+}
+
+// Err rebuilds the error of the failed registration
+func (s *RegistrationSaga) Err() *cus_err.CusError {
+	if s.ErrCode == 0 {
+		return nil
+	}
+	return cus_err.New(cus_err.CusCode(s.ErrCode), s.ErrMessage)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/user_service/internal/domain/vo"
+)
+
+// AccountRepo manages the accounts of the users in the auth service
+type AccountRepo interface {
+	// SearchAccounts finds a page of the accounts matching the search, only the accounts of the user ids are searched when they aren't nil.
+	// The profile fields of the users are left empty.
+	SearchAccounts(ctx context.Context, search vo.UserSearch, userIds []int64) (*vo.UserListPage, *cus_err.CusError)
+	// CreateAccount creates the active user of the client with the password
+	CreateAccount(ctx context.Context, clientId int64, userId int64, account string, password string) *cus_err.CusError
+	// DeleteAccount deletes the user who has never logged in, deleting a deleted user succeeds
+	DeleteAccount(ctx context.Context, clientId int64, userId int64) *cus_err.CusError
+}
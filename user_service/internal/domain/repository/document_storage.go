@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+)
+
+// DocumentStorage keeps the files uploaded by the users, e.g. the KYC documents
+type DocumentStorage interface {
+	PutDocument(ctx context.Context, key string, contentType string, content []byte) *cus_err.CusError
+	GetDocument(ctx context.Context, key string) ([]byte, *cus_err.CusError)
+	DeleteDocument(ctx context.Context, key string) *cus_err.CusError
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/outbox"
+)
+
+// EventRepo records the domain events in the outbox, they must be added in the transaction of the change
+type EventRepo interface {
+	AddEvents(ctx context.Context, events ...*outbox.Event) *cus_err.CusError
+}
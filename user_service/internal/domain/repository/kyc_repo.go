@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/vo"
+)
+
+// KycRepo keeps the KYC submissions, the documents are kept by the DocumentStorage
+type KycRepo interface {
+	CreateSubmission(ctx context.Context, submission *entity.KycSubmission) (*entity.KycSubmission, *cus_err.CusError)
+	FindSubmission(ctx context.Context, id int64) (*entity.KycSubmission, *cus_err.CusError)
+	// FindLatestSubmission returns ResourceNotFound when the user has never submitted
+	FindLatestSubmission(ctx context.Context, userId int64) (*entity.KycSubmission, *cus_err.CusError)
+	// ListSubmissions lists the submissions of the status from the earliest, the total is the count of all the pages
+	ListSubmissions(ctx context.Context, status enum.KycStatus, pagination vo.Pagination) ([]*entity.KycSubmission, int, *cus_err.CusError)
+	UpdateReview(ctx context.Context, submission *entity.KycSubmission) (*entity.KycSubmission, *cus_err.CusError)
+}
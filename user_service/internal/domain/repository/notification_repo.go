@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/user_service/internal/domain/vo"
+)
+
+type NotificationRepo interface {
+	// SaveDelivery creates or updates the delivery status, the new delivery is indexed by its recipient
+	SaveDelivery(ctx context.Context, delivery *vo.NotificationDelivery) *cus_err.CusError
+	FindDelivery(ctx context.Context, id string) (*vo.NotificationDelivery, *cus_err.CusError)
+	// ListDeliveries lists the latest deliveries of the recipient, from the latest
+	ListDeliveries(ctx context.Context, recipient string, limit int) ([]*vo.NotificationDelivery, *cus_err.CusError)
+}
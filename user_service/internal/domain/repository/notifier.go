@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/user_service/internal/domain/vo"
+)
+
+// Notifier delivers the messages to the users out-of-band
+type Notifier interface {
+	SendVerification(ctx context.Context, notice *vo.VerificationNotice) *cus_err.CusError
+}
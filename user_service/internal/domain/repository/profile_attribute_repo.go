@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/user_service/internal/domain/entity"
+)
+
+// ProfileAttributeRepo keeps the profile attributes defined at runtime
+type ProfileAttributeRepo interface {
+	ListAttributes(ctx context.Context) ([]*entity.ProfileAttribute, *cus_err.CusError)
+	CreateAttribute(ctx context.Context, attribute *entity.ProfileAttribute) (*entity.ProfileAttribute, *cus_err.CusError)
+	UpdateAttribute(ctx context.Context, attribute *entity.ProfileAttribute) (*entity.ProfileAttribute, *cus_err.CusError)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"time"
+)
+
+type RegistrationSagaRepo interface {
+	// CreateSaga returns ResourceIsExist when the client has used the idempotency key
+	CreateSaga(ctx context.Context, saga *entity.RegistrationSaga) (*entity.RegistrationSaga, *cus_err.CusError)
+	FindSagaByKey(ctx context.Context, clientId int64, idempotencyKey string) (*entity.RegistrationSaga, *cus_err.CusError)
+	// UpdateSagaStatus moves the saga from the status to the status of the saga, it returns Conflict when the saga isn't at the status,
+	// e.g. it is moved by the recovery at the same time
+	UpdateSagaStatus(ctx context.Context, saga *entity.RegistrationSaga, from enum.RegistrationStatus) (*entity.RegistrationSaga, *cus_err.CusError)
+	// ListStaleSagas lists the sagas which aren't done and haven't moved since the time, from the earliest
+	ListStaleSagas(ctx context.Context, before time.Time, limit int) ([]*entity.RegistrationSaga, *cus_err.CusError)
+}
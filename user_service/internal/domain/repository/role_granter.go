@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+)
+
+// RoleGranter changes the role of the user in the auth service
+type RoleGranter interface {
+	// GrantKycVerifiedRole upgrades the user to the KYC verified player, the tokens of the user carry the new role after they are refreshed
+	GrantKycVerifiedRole(ctx context.Context, userId int64) *cus_err.CusError
+}
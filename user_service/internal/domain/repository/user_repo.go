@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
 	"go_micro_service_api/user_service/internal/domain/aggregate"
 	"go_micro_service_api/user_service/internal/domain/vo"
 )
@@ -10,9 +11,16 @@ import (
 type UserRepo interface {
 	CreateProfile(ctx context.Context, u *aggregate.User) (*aggregate.User, *cus_err.CusError)
 	GetProfile(ctx context.Context, u *aggregate.User, keys []int) (*aggregate.User, *cus_err.CusError)
+	GetProfileValues(ctx context.Context, userId int64, keys []int) (map[int]string, *cus_err.CusError)
 	GetProfileFromOAuth(ctx context.Context, u *aggregate.User, session *vo.OAuthSession) (*aggregate.User, *cus_err.CusError)
 	CheckMobileExistence(ctx context.Context, mobileNumber string, countryCode string) (bool, *cus_err.CusError)
 	CheckEmailExistence(ctx context.Context, email string) (bool, *cus_err.CusError)
 	IsAccountExist(ctx context.Context, account string) (bool, *cus_err.CusError)
+	IsProfileValueTaken(ctx context.Context, userId int64, key int, value string) (bool, *cus_err.CusError)
 	GetUserIdByProfile(ctx context.Context, mapping map[int]string) (int, *cus_err.CusError)
+	FindUserIdsByProfile(ctx context.Context, key int, value string) ([]int64, *cus_err.CusError)
+	ListProfileValues(ctx context.Context, userIds []int64, keys []int) (map[int64]map[int]string, *cus_err.CusError)
+	AddProfileItem(ctx context.Context, userId int64, key enum.Profile, value string) *cus_err.CusError
+	DeactivateProfileItem(ctx context.Context, userId int64, key enum.Profile) *cus_err.CusError
+	UpdateProfileItem(ctx context.Context, userId int64, key enum.Profile, value string) *cus_err.CusError
 }
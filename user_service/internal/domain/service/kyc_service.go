@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"slices"
+	"time"
+)
+
+const (
+	// KycMaxDocuments is the number of the documents of a submission
+	KycMaxDocuments = 3
+
+	// KycMaxDocumentBytes is the size limit of a document
+	KycMaxDocumentBytes = 5 << 20
+)
+
+// kycContentTypes are the accepted formats of the documents
+var kycContentTypes = []string{"image/jpeg", "image/png", "application/pdf"}
+
+type KycService struct {
+	kycRepo   repository.KycRepo
+	storage   repository.DocumentStorage
+	eventRepo repository.EventRepo
+}
+
+func NewKycService(kycRepo repository.KycRepo, storage repository.DocumentStorage, eventRepo repository.EventRepo) *KycService {
+	return &KycService{
+		kycRepo:   kycRepo,
+		storage:   storage,
+		eventRepo: eventRepo,
+	}
+}
+
+// Submit uploads the documents of the player and creates a pending submission.
+// It needs an identity document, the player can't submit again while the submission is pending or after it is approved.
+func (s *KycService) Submit(ctx context.Context, userId int64, uploads []vo.KycUpload) (*entity.KycSubmission, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	err := s.checkUploads(uploads)
+	if err != nil {
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	latest, err := s.kycRepo.FindLatestSubmission(ctx, userId)
+	if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+		return nil, err
+	}
+	if latest != nil {
+		switch latest.Status {
+		case enum.KycStatusType.Pending:
+			err = cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("kyc submission of user %d is under review", userId))
+		case enum.KycStatusType.Approved:
+			err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("user %d is already kyc verified", userId))
+		}
+		if err != nil {
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+	}
+
+	// The uploaded objects are deleted if the submission isn't created
+	documents := make([]vo.KycDocument, 0, len(uploads))
+	for _, upload := range uploads {
+		key, err := newKycDocumentKey(userId, upload.Type)
+		if err != nil {
+			cus_otel.Error(ctx, err.Error())
+			s.deleteDocuments(ctx, documents)
+			return nil, err
+		}
+		err = s.storage.PutDocument(ctx, key, upload.ContentType, upload.Content)
+		if err != nil {
+			s.deleteDocuments(ctx, documents)
+			return nil, err
+		}
+		documents = append(documents, vo.KycDocument{
+			Type:        upload.Type.Id,
+			Key:         key,
+			ContentType: upload.ContentType,
+			Size:        len(upload.Content),
+		})
+	}
+
+	submission, err := s.kycRepo.CreateSubmission(ctx, &entity.KycSubmission{
+		UserId:      userId,
+		Status:      enum.KycStatusType.Pending,
+		Documents:   documents,
+		SubmittedAt: time.Now().UTC(),
+	})
+	if err != nil {
+		s.deleteDocuments(ctx, documents)
+		return nil, err
+	}
+
+	return submission, nil
+}
+
+// GetLatestSubmission gets the latest submission of the player, it returns ResourceNotFound when the player has never submitted
+func (s *KycService) GetLatestSubmission(ctx context.Context, userId int64) (*entity.KycSubmission, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	return s.kycRepo.FindLatestSubmission(ctx, userId)
+}
+
+// ListSubmissions lists a page of the submissions of the status from the earliest, e.g. the pending ones to review
+func (s *KycService) ListSubmissions(ctx context.Context, status enum.KycStatus, pagination vo.Pagination) ([]*entity.KycSubmission, int, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	return s.kycRepo.ListSubmissions(ctx, status, pagination.Normalize())
+}
+
+// GetDocument gets the document of the submission for the reviewer
+func (s *KycService) GetDocument(ctx context.Context, submissionId int64, documentType enum.KycDocument) (vo.KycDocument, []byte, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	submission, err := s.kycRepo.FindSubmission(ctx, submissionId)
+	if err != nil {
+		return vo.KycDocument{}, nil, err
+	}
+	document, ok := submission.Document(documentType)
+	if !ok {
+		err = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("kyc submission %d has no %s", submissionId, documentType.String))
+		cus_otel.Warn(ctx, err.Error())
+		return vo.KycDocument{}, nil, err
+	}
+
+	content, err := s.storage.GetDocument(ctx, document.Key)
+	if err != nil {
+		return vo.KycDocument{}, nil, err
+	}
+
+	return document, content, nil
+}
+
+// Review approves or rejects the pending submission with the note of the reviewer.
+// The kyc.reviewed event is added in the transaction of the review, the outbox relay upgrades the approved player
+// to the KYC verified player before publishing it, so no remote call holds the transaction.
+func (s *KycService) Review(ctx context.Context, submissionId int64, reviewerId int64, approved bool, note string) (*entity.KycSubmission, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	submission, err := s.kycRepo.FindSubmission(ctx, submissionId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = submission.Review(reviewerId, approved, note, time.Now().UTC())
+	if err != nil {
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	submission, err = s.kycRepo.UpdateReview(ctx, submission)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.eventRepo.AddEvents(ctx, vo.NewKycReviewedEvent(vo.KycReviewedPayload{
+		SubmissionId: submission.Id,
+		UserId:       submission.UserId,
+		Status:       submission.Status.String,
+		ReviewedAt:   *submission.ReviewedAt,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return submission, nil
+}
+
+// deleteDocuments deletes the uploaded documents of the submission which isn't created, the objects left by the failures
+// are never referenced since their keys are never exposed
+func (s *KycService) deleteDocuments(ctx context.Context, documents []vo.KycDocument) {
+	for _, document := range documents {
+		if err := s.storage.DeleteDocument(ctx, document.Key); err != nil {
+			cus_otel.Warn(ctx, err.Error())
+		}
+	}
+}
+
+// checkUploads checks the number, the types, the formats and the sizes of the documents
+func (s *KycService) checkUploads(uploads []vo.KycUpload) *cus_err.CusError {
+	if len(uploads) == 0 || len(uploads) > KycMaxDocuments {
+		return cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("1 to %d documents are required", KycMaxDocuments))
+	}
+
+	types := make(map[int]bool, len(uploads))
+	hasIdentity := false
+	for _, upload := range uploads {
+		if upload.Type.Id == 0 {
+			return cus_err.New(cus_err.InvalidArgument, "document type is required")
+		}
+		if types[upload.Type.Id] {
+			return cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("document %s is uploaded twice", upload.Type.String))
+		}
+		types[upload.Type.Id] = true
+		hasIdentity = hasIdentity || upload.Type.Identity
+
+		if !slices.Contains(kycContentTypes, upload.ContentType) {
+			return cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("document %s must be one of %v", upload.Type.String, kycContentTypes))
+		}
+		if len(upload.Content) == 0 || len(upload.Content) > KycMaxDocumentBytes {
+			return cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("document %s must be 1 to %d bytes", upload.Type.String, KycMaxDocumentBytes))
+		}
+	}
+	if !hasIdentity {
+		return cus_err.New(cus_err.InvalidArgument, "an id card, a passport or a driver license is required")
+	}
+
+	return nil
+}
+
+// newKycDocumentKey generates an unguessable object key of the document under the prefix of the user
+func newKycDocumentKey(userId int64, documentType enum.KycDocument) (string, *cus_err.CusError) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", cus_err.New(cus_err.InternalServerError, "failed to generate the document key", err)
+	}
+	return fmt.Sprintf("kyc/%d/%s-%s", userId, documentType.String, hex.EncodeToString(b)), nil
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"strings"
+)
+
+type ProfileAttributeService struct {
+	attributeRepo repository.ProfileAttributeRepo
+	userRepo      repository.UserRepo
+}
+
+func NewProfileAttributeService(attributeRepo repository.ProfileAttributeRepo, userRepo repository.UserRepo) *ProfileAttributeService {
+	return &ProfileAttributeService{
+		attributeRepo: attributeRepo,
+		userRepo:      userRepo,
+	}
+}
+
+// ListAttributes lists the built-in attributes and the attributes defined at runtime
+func (s *ProfileAttributeService) ListAttributes(ctx context.Context) ([]*entity.ProfileAttribute, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	custom, err := s.attributeRepo.ListAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(entity.BuiltinAttributes(), custom...), nil
+}
+
+// DefineAttribute declares a new attribute, the key of it is assigned by the repository.
+// The attribute is visible to the backend only when no client is given.
+func (s *ProfileAttributeService) DefineAttribute(ctx context.Context, attribute *entity.ProfileAttribute) (*entity.ProfileAttribute, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	attribute.System = false
+	if len(attribute.VisibleTo) == 0 {
+		attribute.VisibleTo = []enum.Client{enum.ClientType.Backend}
+	}
+	err := attribute.Check()
+	if err != nil {
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	for _, builtin := range entity.BuiltinAttributes() {
+		if strings.EqualFold(builtin.Name, attribute.Name) {
+			err = cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("attribute %s is built in", attribute.Name))
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+	}
+
+	return s.attributeRepo.CreateAttribute(ctx, attribute)
+}
+
+// UpdateAttribute changes the rules, the pii class and the visibility of the attribute defined at runtime,
+// the pii class and the visibility are kept when they are absent.
+// The type and the uniqueness are kept, so the stored values stay valid.
+func (s *ProfileAttributeService) UpdateAttribute(ctx context.Context, attribute *entity.ProfileAttribute) (*entity.ProfileAttribute, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	current, err := s.findAttribute(ctx, attribute.Name)
+	if err != nil {
+		return nil, err
+	}
+	if current.System {
+		err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("built-in attribute %s can't be changed", current.Name))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	updated := *current
+	updated.Rules = attribute.Rules
+	if attribute.PII.Id != 0 {
+		updated.PII = attribute.PII
+	}
+	if len(attribute.VisibleTo) > 0 {
+		updated.VisibleTo = attribute.VisibleTo
+	}
+	err = updated.Check()
+	if err != nil {
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	return s.attributeRepo.UpdateAttribute(ctx, &updated)
+}
+
+// GetAttributes gets the typed values of the attributes of the user which are visible to the client
+func (s *ProfileAttributeService) GetAttributes(ctx context.Context, userId int64, client enum.Client) (map[string]vo.AttributeValue, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	attributes, err := s.ListAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	visible := make(map[int]*entity.ProfileAttribute)
+	keys := make([]int, 0, len(attributes))
+	for _, attribute := range attributes {
+		if attribute.IsVisibleTo(client) {
+			visible[attribute.Key] = attribute
+			keys = append(keys, attribute.Key)
+		}
+	}
+
+	values, err := s.userRepo.GetProfileValues(ctx, userId, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	typed := make(map[string]vo.AttributeValue, len(values))
+	for key, value := range values {
+		attribute := visible[key]
+		typed[attribute.Name] = vo.AttributeValue{Type: attribute.Type, Value: value}
+	}
+
+	return typed, nil
+}
+
+// SetAttributes validates and stores the values of the attributes defined at runtime, the empty value clears the attribute.
+// The built-in attributes are changed by their own flows, e.g. the email needs a verification.
+func (s *ProfileAttributeService) SetAttributes(ctx context.Context, userId int64, values map[string]string) (map[string]vo.AttributeValue, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	attributes, err := s.ListAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*entity.ProfileAttribute, len(attributes))
+	for _, attribute := range attributes {
+		byName[attribute.Name] = attribute
+	}
+
+	// Validate every value before anything is written
+	normalized := make(map[*entity.ProfileAttribute]string, len(values))
+	for name, value := range values {
+		attribute, ok := byName[name]
+		if !ok {
+			err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("attribute %s is not defined", name))
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+		if attribute.System {
+			err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("built-in attribute %s can't be set directly", name))
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+
+		if strings.TrimSpace(value) == "" {
+			normalized[attribute] = ""
+			continue
+		}
+		value, err = attribute.Normalize(value)
+		if err != nil {
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+		if attribute.Unique {
+			taken, err := s.userRepo.IsProfileValueTaken(ctx, userId, attribute.Key, value)
+			if err != nil {
+				return nil, err
+			}
+			if taken {
+				err = cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("attribute %s is already used by another user", name))
+				cus_otel.Warn(ctx, err.Error())
+				return nil, err
+			}
+		}
+		normalized[attribute] = value
+	}
+
+	typed := make(map[string]vo.AttributeValue, len(normalized))
+	for attribute, value := range normalized {
+		err = s.userRepo.UpdateProfileItem(ctx, userId, attribute.ProfileKey(), value)
+		if err != nil {
+			return nil, err
+		}
+		if value != "" {
+			typed[attribute.Name] = vo.AttributeValue{Type: attribute.Type, Value: value}
+		}
+	}
+
+	return typed, nil
+}
+
+// findAttribute finds the attribute by its name
+func (s *ProfileAttributeService) findAttribute(ctx context.Context, name string) (*entity.ProfileAttribute, *cus_err.CusError) {
+	attributes, err := s.ListAttributes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, attribute := range attributes {
+		if attribute.Name == name {
+			return attribute, nil
+		}
+	}
+
+	err = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("attribute %s is not defined", name))
+	cus_otel.Warn(ctx, err.Error())
+	return nil, err
+}
@@ -0,0 +1,214 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/aggregate"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"strings"
+	"time"
+)
+
+const (
+	// RegistrationStaleAfter is how long a registration can stay at a step before the recovery takes it over,
+	// it is longer than a registration request so the registrations in progress aren't touched
+	RegistrationStaleAfter = 5 * time.Minute
+
+	// RegistrationRecoveryBatch is the number of the stale registrations recovered at a time
+	RegistrationRecoveryBatch = 100
+)
+
+// RegistrationService runs the steps of the registration saga.
+//
+// Each step moves the saga in its own transaction: the auth user is created, and then the profile is created.
+// The openID of the oauth signup is linked with the profile, so the user is never left without a way to sign in.
+// When a step fails after the auth user may be created, the saga is compensated by deleting the auth user.
+type RegistrationService struct {
+	sagaRepo    repository.RegistrationSagaRepo
+	accountRepo repository.AccountRepo
+	userRepo    repository.UserRepo
+	eventRepo   repository.EventRepo
+}
+
+func NewRegistrationService(sagaRepo repository.RegistrationSagaRepo, accountRepo repository.AccountRepo, userRepo repository.UserRepo, eventRepo repository.EventRepo) *RegistrationService {
+	return &RegistrationService{
+		sagaRepo:    sagaRepo,
+		accountRepo: accountRepo,
+		userRepo:    userRepo,
+		eventRepo:   eventRepo,
+	}
+}
+
+// FindSaga finds the saga of the idempotency key of the client, it returns ResourceNotFound for the first request
+func (s *RegistrationService) FindSaga(ctx context.Context, clientId int64, idempotencyKey string) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	return s.sagaRepo.FindSagaByKey(ctx, clientId, idempotencyKey)
+}
+
+// Replay returns the result of the registration to the retry of the same request,
+// it's nil when the registration is completed and Conflict while it's in progress
+func (s *RegistrationService) Replay(ctx context.Context, saga *entity.RegistrationSaga, profile entity.Profile) *cus_err.CusError {
+	_, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if !saga.SameRequest(profile) {
+		return cus_err.New(cus_err.InvalidArgument, "the idempotency key is used by another registration")
+	}
+
+	switch saga.Status {
+	case enum.RegistrationStatusType.Completed:
+		return nil
+	case enum.RegistrationStatusType.Compensated:
+		return saga.Err()
+	default:
+		return cus_err.New(cus_err.Conflict, "the registration is in progress")
+	}
+}
+
+// Start records the registration before the auth user is created
+func (s *RegistrationService) Start(ctx context.Context, clientId int64, idempotencyKey string, userId int64, profile entity.Profile) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if strings.TrimSpace(idempotencyKey) == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "idempotency key is required")
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+	if userId == 0 || strings.TrimSpace(profile.Account) == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "user id and account are required")
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	saga, err := s.sagaRepo.CreateSaga(ctx, &entity.RegistrationSaga{
+		ClientId:       clientId,
+		IdempotencyKey: idempotencyKey,
+		UserId:         userId,
+		Profile:        profile,
+		Status:         enum.RegistrationStatusType.Started,
+	})
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceIsExist {
+			// The same request is registering at the same time
+			return nil, cus_err.New(cus_err.Conflict, "the registration is in progress")
+		}
+		return nil, err
+	}
+
+	return saga, nil
+}
+
+// CreateAccount creates the auth user of the saga and moves it to AuthUserCreated
+func (s *RegistrationService) CreateAccount(ctx context.Context, saga *entity.RegistrationSaga, password string) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	err := s.accountRepo.CreateAccount(ctx, saga.ClientId, saga.UserId, saga.Profile.Account, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.moveSaga(ctx, saga, enum.RegistrationStatusType.AuthUserCreated)
+}
+
+// CreateProfile creates the profile of the saga with its openID and completes it, they must be in the same transaction
+func (s *RegistrationService) CreateProfile(ctx context.Context, saga *entity.RegistrationSaga) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if saga.Status != enum.RegistrationStatusType.AuthUserCreated {
+		err := cus_err.New(cus_err.Conflict, fmt.Sprintf("registration %d is %s", saga.Id, saga.Status.String))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	// An openID can only be linked to one user, another signup may have linked it
+	for _, provider := range saga.Profile.ThirdParties.Linked() {
+		key, err := enum.OpenIDProfileKey(provider)
+		if err != nil {
+			return nil, err
+		}
+		_, err = s.userRepo.GetUserIdByProfile(ctx, map[int]string{key.ID: saga.Profile.ThirdParties.Get(provider).ID})
+		if err == nil {
+			err = cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("%s account is already linked to another user", provider.String))
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+		if err.Code().Int() != cus_err.ResourceNotFound {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+	}
+
+	_, err := s.userRepo.CreateProfile(ctx, &aggregate.User{ID: saga.UserId, Profile: saga.Profile})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.eventRepo.AddEvents(ctx, vo.NewProfileCreatedEvent(vo.ProfileCreatedPayload{
+		UserId:  saga.UserId,
+		Account: saga.Profile.Account,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return s.moveSaga(ctx, saga, enum.RegistrationStatusType.Completed)
+}
+
+// Fail moves the saga to Compensating with the cause, the auth user is deleted by Compensate
+func (s *RegistrationService) Fail(ctx context.Context, saga *entity.RegistrationSaga, cause *cus_err.CusError) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if saga.Status == enum.RegistrationStatusType.Compensating {
+		return saga, nil
+	}
+
+	failed := *saga
+	failed.Fail(cause)
+	return s.moveSaga(ctx, &failed, enum.RegistrationStatusType.Compensating)
+}
+
+// Compensate deletes the auth user of the failed saga and moves it to Compensated
+func (s *RegistrationService) Compensate(ctx context.Context, saga *entity.RegistrationSaga) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if saga.Status != enum.RegistrationStatusType.Compensating {
+		err := cus_err.New(cus_err.Conflict, fmt.Sprintf("registration %d is %s", saga.Id, saga.Status.String))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	err := s.accountRepo.DeleteAccount(ctx, saga.ClientId, saga.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.moveSaga(ctx, saga, enum.RegistrationStatusType.Compensated)
+}
+
+// ListStaleSagas lists the sagas which are interrupted, e.g. the service crashed in the middle of the registration
+func (s *RegistrationService) ListStaleSagas(ctx context.Context, now time.Time) ([]*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	return s.sagaRepo.ListStaleSagas(ctx, now.Add(-RegistrationStaleAfter), RegistrationRecoveryBatch)
+}
+
+// moveSaga saves the saga at the next status, it fails when another process has moved it
+func (s *RegistrationService) moveSaga(ctx context.Context, saga *entity.RegistrationSaga, next enum.RegistrationStatus) (*entity.RegistrationSaga, *cus_err.CusError) {
+	from := saga.Status
+	moved := *saga
+	moved.Status = next
+	return s.sagaRepo.UpdateSagaStatus(ctx, &moved, from)
+}
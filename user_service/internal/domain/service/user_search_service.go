@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"slices"
+)
+
+// userListProfileKeys are the profile values listed with the users
+var userListProfileKeys = []int{
+	enum.ProfileKey.Email.ID,
+	enum.ProfileKey.CountryCode.ID,
+	enum.ProfileKey.MobileNumber.ID,
+}
+
+// UserSearchService searches the users for the customer support, it joins the accounts of the auth service with the profiles
+type UserSearchService struct {
+	userRepo    repository.UserRepo
+	accountRepo repository.AccountRepo
+}
+
+func NewUserSearchService(userRepo repository.UserRepo, accountRepo repository.AccountRepo) *UserSearchService {
+	return &UserSearchService{
+		userRepo:    userRepo,
+		accountRepo: accountRepo,
+	}
+}
+
+// SearchUsers finds a page of the users matching the search.
+// The email and the mobile are resolved to the user ids first, the accounts of the ids are then searched and paged by the auth service.
+func (s *UserSearchService) SearchUsers(ctx context.Context, search vo.UserSearch) (*vo.UserListPage, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if search.CountryCode != "" && search.MobileNumber == "" {
+		err := cus_err.New(cus_err.InvalidArgument, "the country code must be searched with the mobile number")
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	userIds, err := s.findUserIdsByProfile(ctx, search)
+	if err != nil {
+		return nil, err
+	}
+	if userIds != nil && len(userIds) == 0 {
+		return &vo.UserListPage{Users: []*vo.UserList{}}, nil
+	}
+
+	page, err := s.accountRepo.SearchAccounts(ctx, search, userIds)
+	if err != nil {
+		return nil, err
+	}
+	if len(page.Users) == 0 {
+		return page, nil
+	}
+
+	ids := make([]int64, 0, len(page.Users))
+	for _, user := range page.Users {
+		ids = append(ids, user.UserId)
+	}
+	values, err := s.userRepo.ListProfileValues(ctx, ids, userListProfileKeys)
+	if err != nil {
+		return nil, err
+	}
+	for _, user := range page.Users {
+		user.Email = values[user.UserId][enum.ProfileKey.Email.ID]
+		user.CountryCode = values[user.UserId][enum.ProfileKey.CountryCode.ID]
+		user.MobileNumber = values[user.UserId][enum.ProfileKey.MobileNumber.ID]
+	}
+
+	return page, nil
+}
+
+// findUserIdsByProfile finds the users matching the email and the mobile of the search,
+// it returns nil when the search doesn't filter them
+func (s *UserSearchService) findUserIdsByProfile(ctx context.Context, search vo.UserSearch) ([]int64, *cus_err.CusError) {
+	filters := map[int]string{
+		enum.ProfileKey.Email.ID:        search.Email,
+		enum.ProfileKey.MobileNumber.ID: search.MobileNumber,
+		enum.ProfileKey.CountryCode.ID:  search.CountryCode,
+	}
+
+	var userIds []int64
+	for key, value := range filters {
+		if value == "" {
+			continue
+		}
+		ids, err := s.userRepo.FindUserIdsByProfile(ctx, key, value)
+		if err != nil {
+			return nil, err
+		}
+		if userIds == nil {
+			userIds = ids
+			continue
+		}
+		userIds = slices.DeleteFunc(userIds, func(id int64) bool {
+			return !slices.Contains(ids, id)
+		})
+	}
+
+	return userIds, nil
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/enum"
@@ -11,12 +12,16 @@ import (
 )
 
 type UserService struct {
-	userRepo repository.UserRepo
+	userRepo      repository.UserRepo
+	eventRepo     repository.EventRepo
+	verifyService *VerifyService
 }
 
-func NewUserService(userRepo repository.UserRepo) *UserService {
+func NewUserService(userRepo repository.UserRepo, eventRepo repository.EventRepo, verifyService *VerifyService) *UserService {
 	return &UserService{
-		userRepo: userRepo,
+		userRepo:      userRepo,
+		eventRepo:     eventRepo,
+		verifyService: verifyService,
 	}
 }
 
@@ -24,7 +29,20 @@ func (s *UserService) CreateProfile(ctx context.Context, user *aggregate.User) (
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
 
-	return s.userRepo.CreateProfile(ctx, user)
+	u, err := s.userRepo.CreateProfile(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.eventRepo.AddEvents(ctx, vo.NewProfileCreatedEvent(vo.ProfileCreatedPayload{
+		UserId:  u.ID,
+		Account: u.Profile.Account,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
 }
 
 func (s *UserService) GetProfile(ctx context.Context, user *aggregate.User, keys []int) (*aggregate.User, *cus_err.CusError) {
@@ -84,3 +102,259 @@ func (s *UserService) GetLoginUserInfo(ctx context.Context, identifiers map[int]
 
 	return u, nil
 }
+
+// UpdateProfile changes the email or the mobile number of the user.
+// The new value must be verified by the code sent to it, and the old value is deactivated instead of overwritten.
+func (s *UserService) UpdateProfile(ctx context.Context, userId int64, update *vo.ProfileUpdate) (*aggregate.User, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if (update.CountryCode == "") != (update.MobileNumber == "") {
+		err := cus_err.New(cus_err.InvalidArgument, "country code and mobile number must be updated together")
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	u, err := s.userRepo.GetProfile(ctx, &aggregate.User{ID: userId}, []int{
+		enum.ProfileKey.Account.ID,
+		enum.ProfileKey.Email.ID,
+		enum.ProfileKey.CountryCode.ID,
+		enum.ProfileKey.MobileNumber.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	emailChanged := update.Email != "" && update.Email != u.Profile.Email
+	mobileChanged := update.MobileNumber != "" &&
+		(update.CountryCode != u.Profile.CountryCode || update.MobileNumber != u.Profile.MobileNumber)
+	if !emailChanged && !mobileChanged {
+		return u, nil
+	}
+
+	// Check the new values are free before the codes are used up
+	if emailChanged {
+		exist, err := s.userRepo.CheckEmailExistence(ctx, update.Email)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			err = cus_err.New(cus_err.ResourceIsExist, "email is already used by another user")
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+	}
+	if mobileChanged {
+		exist, err := s.userRepo.CheckMobileExistence(ctx, update.CountryCode, update.MobileNumber)
+		if err != nil {
+			return nil, err
+		}
+		if exist {
+			err = cus_err.New(cus_err.ResourceIsExist, "mobile number is already used by another user")
+			cus_otel.Warn(ctx, err.Error())
+			return nil, err
+		}
+	}
+
+	if emailChanged {
+		err = s.verifyContact(ctx, update.EmailVerification, update.Email, "", "")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if mobileChanged {
+		err = s.verifyContact(ctx, update.MobileVerification, "", update.CountryCode, update.MobileNumber)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if emailChanged {
+		err = s.userRepo.UpdateProfileItem(ctx, userId, enum.ProfileKey.Email, update.Email)
+		if err != nil {
+			return nil, err
+		}
+		u.Profile.Email = update.Email
+	}
+	if mobileChanged {
+		err = s.userRepo.UpdateProfileItem(ctx, userId, enum.ProfileKey.CountryCode, update.CountryCode)
+		if err != nil {
+			return nil, err
+		}
+		err = s.userRepo.UpdateProfileItem(ctx, userId, enum.ProfileKey.MobileNumber, update.MobileNumber)
+		if err != nil {
+			return nil, err
+		}
+		u.Profile.CountryCode = update.CountryCode
+		u.Profile.MobileNumber = update.MobileNumber
+	}
+
+	// The event names the changed keys only, the contacts aren't published
+	keys := make([]string, 0, 3)
+	if emailChanged {
+		keys = append(keys, enum.ProfileKey.Email.String)
+	}
+	if mobileChanged {
+		keys = append(keys, enum.ProfileKey.CountryCode.String, enum.ProfileKey.MobileNumber.String)
+	}
+	err = s.eventRepo.AddEvents(ctx, vo.NewProfileUpdatedEvent(vo.ProfileUpdatedPayload{UserId: userId, Keys: keys}))
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// verifyContact verifies the code of the new email or mobile number,
+// the session must be issued for the profile update of the same value
+func (s *UserService) verifyContact(ctx context.Context, session *vo.VerificationSession, email, countryCode, mobileNumber string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	verificationType := enum.VerificationTypes.UpdateProfile.String
+	if session == nil {
+		err := cus_err.New(cus_err.InvalidArgument, "verification is required to change the email or mobile number")
+		cus_otel.Warn(ctx, err.Error())
+		return err
+	}
+	if !session.IsIssuedFor(verificationType, email, countryCode, mobileNumber) {
+		err := cus_err.New(cus_err.InvalidArgument, "verification token is not issued for the new email or mobile number")
+		cus_otel.Warn(ctx, err.Error())
+		return err
+	}
+
+	session.Type = verificationType
+	verified, err := s.verifyService.Verification(ctx, session)
+	if err != nil {
+		return err
+	}
+	if !verified {
+		err = cus_err.New(cus_err.InvalidVerificationCode, "verification code is invalid")
+		cus_otel.Warn(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// VerifyOAuth verifies the access token of the oauth provider, and finds the user bound to its openID.
+// The user id of the identity is 0 when the openID isn't bound to any user.
+func (s *UserService) VerifyOAuth(ctx context.Context, session *vo.OAuthSession) (*vo.OAuthIdentity, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	key, err := enum.OpenIDProfileKey(session.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := s.userRepo.GetProfileFromOAuth(ctx, &aggregate.User{}, session)
+	if err != nil {
+		return nil, err
+	}
+
+	identity := &vo.OAuthIdentity{
+		Provider: session.Provider,
+		OpenID:   u.Profile.ThirdParties.Get(session.Provider).ID,
+	}
+
+	uid, err := s.userRepo.GetUserIdByProfile(ctx, map[int]string{key.ID: identity.OpenID})
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			return identity, nil
+		}
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+	identity.UserId = int64(uid)
+
+	return identity, nil
+}
+
+// LinkOAuth verifies the access token of the oauth provider, and binds its openID to the user.
+// An openID can only be bound to one user, and a user can only bind one openID of each provider.
+func (s *UserService) LinkOAuth(ctx context.Context, userId int64, session *vo.OAuthSession) (*vo.OAuthIdentity, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	identity, err := s.VerifyOAuth(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+
+	// Linking the same openID again changes nothing
+	if identity.UserId == userId {
+		return identity, nil
+	}
+	if identity.UserId != 0 {
+		err = cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("%s account is already linked to another user", session.Provider.String))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	u, err := s.getOAuthProfile(ctx, userId)
+	if err != nil {
+		return nil, err
+	}
+	if u.Profile.ThirdParties.Get(session.Provider).ID != "" {
+		err = cus_err.New(cus_err.ResourceIsExist, fmt.Sprintf("User %d has already linked a %s account", userId, session.Provider.String))
+		cus_otel.Warn(ctx, err.Error())
+		return nil, err
+	}
+
+	key, err := enum.OpenIDProfileKey(session.Provider)
+	if err != nil {
+		return nil, err
+	}
+	err = s.userRepo.AddProfileItem(ctx, userId, key, identity.OpenID)
+	if err != nil {
+		return nil, err
+	}
+	identity.UserId = userId
+
+	return identity, nil
+}
+
+// UnlinkOAuth unbinds the openID of the oauth provider from the user.
+// The last way to sign in of the user can't be unlinked.
+func (s *UserService) UnlinkOAuth(ctx context.Context, userId int64, provider enum.Provider) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	key, err := enum.OpenIDProfileKey(provider)
+	if err != nil {
+		return err
+	}
+
+	u, err := s.getOAuthProfile(ctx, userId)
+	if err != nil {
+		return err
+	}
+	if u.Profile.ThirdParties.Get(provider).ID == "" {
+		err = cus_err.New(cus_err.ResourceNotFound, fmt.Sprintf("User %d hasn't linked a %s account", userId, provider.String))
+		cus_otel.Warn(ctx, err.Error())
+		return err
+	}
+
+	hasLogin := u.Profile.Account != "" || u.Profile.Email != "" || u.Profile.MobileNumber != ""
+	if !hasLogin && len(u.Profile.ThirdParties.Linked()) == 1 {
+		err = cus_err.New(cus_err.InvalidArgument, fmt.Sprintf("The %s account is the only way to sign in of user %d", provider.String, userId))
+		cus_otel.Warn(ctx, err.Error())
+		return err
+	}
+
+	return s.userRepo.DeactivateProfileItem(ctx, userId, key)
+}
+
+// getOAuthProfile gets the login identifiers and the linked openIDs of the user
+func (s *UserService) getOAuthProfile(ctx context.Context, userId int64) (*aggregate.User, *cus_err.CusError) {
+	return s.userRepo.GetProfile(ctx, &aggregate.User{ID: userId}, []int{
+		enum.ProfileKey.Account.ID,
+		enum.ProfileKey.Email.ID,
+		enum.ProfileKey.MobileNumber.ID,
+		enum.ProfileKey.GoogleOpenID.ID,
+		enum.ProfileKey.MetaOpenID.ID,
+		enum.ProfileKey.TwitterOpenID.ID,
+		enum.ProfileKey.LINEOpenID.ID,
+	})
+}
@@ -9,25 +9,63 @@ import (
 )
 
 type VerifyService struct {
-	verifyRepo repository.VerifyRepo
+	verifyRepo       repository.VerifyRepo
+	notifier         repository.Notifier
+	notificationRepo repository.NotificationRepo
 }
 
-func NewVerifyService(verifyRepo repository.VerifyRepo) *VerifyService {
+func NewVerifyService(verifyRepo repository.VerifyRepo, notifier repository.Notifier, notificationRepo repository.NotificationRepo) *VerifyService {
 	return &VerifyService{
-		verifyRepo: verifyRepo,
+		verifyRepo:       verifyRepo,
+		notifier:         notifier,
+		notificationRepo: notificationRepo,
 	}
 }
 
-func (s *VerifyService) RegisterVerification(ctx context.Context, values ...string) (*vo.VerificationSession, *cus_err.CusError) {
+// ValidateRecipient checks exactly one of the email and the mobile number is given,
+// so the code is sent to and verified for the same identity
+func (s *VerifyService) ValidateRecipient(ctx context.Context, email, countryCode, mobileNumber string) *cus_err.CusError {
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
 
+	if email == "" && (countryCode == "" || mobileNumber == "") {
+		err := cus_err.New(cus_err.InvalidArgument, "email or mobile number is required")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+	if email != "" && (countryCode != "" || mobileNumber != "") {
+		err := cus_err.New(cus_err.InvalidArgument, "either email or mobile number is allowed, not both")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RegisterVerification generates the code for the email or the mobile number
+// and hands it to the notifier, the code is never returned to the caller
+func (s *VerifyService) RegisterVerification(ctx context.Context, verificationType, email, countryCode, mobileNumber, locale string) (*vo.VerificationSession, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	err := s.ValidateRecipient(ctx, email, countryCode, mobileNumber)
+	if err != nil {
+		return nil, err
+	}
+
 	// generate code
 	session := &vo.VerificationSession{}
-	session.NextCode().NextToken(ctx, values...)
+	session.NextCode().NextToken(verificationType, email, countryCode, mobileNumber)
 
 	// store code
-	err := s.verifyRepo.RegisterVerification(ctx, session)
+	err = s.verifyRepo.RegisterVerification(ctx, session)
+	if err != nil {
+		return session, err
+	}
+
+	// send code
+	notice := vo.NewVerificationNotice(session, verificationType, email, countryCode, mobileNumber, locale)
+	err = s.notifier.SendVerification(ctx, notice)
 	if err != nil {
 		return session, err
 	}
@@ -46,3 +84,18 @@ func (s *VerifyService) Verification(ctx context.Context, session *vo.Verificati
 
 	return res, nil
 }
+
+// ListNotificationDeliveries lists the latest delivery status of the notifications sent to the email
+// or the mobile number, so the support can see whether a code was sent
+func (s *VerifyService) ListNotificationDeliveries(ctx context.Context, email, countryCode, mobileNumber string, limit int) ([]*vo.NotificationDelivery, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	err := s.ValidateRecipient(ctx, email, countryCode, mobileNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	_, recipient := vo.NotifyRecipient(email, countryCode, mobileNumber)
+	return s.notificationRepo.ListDeliveries(ctx, recipient, limit)
+}
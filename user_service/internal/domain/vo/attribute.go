@@ -0,0 +1,30 @@
+package vo
+
+import (
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
+// AttributeDateLayout is the layout of the date attributes
+const AttributeDateLayout = time.DateOnly
+
+// AttributeRules are the validation rules of a profile attribute, the zero value isn't checked
+type AttributeRules struct {
+	MinLength int      `json:"minLength,omitempty"`
+	MaxLength int      `json:"maxLength,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"` // Regular expression of the string and the phone attributes
+	Options   []string `json:"options,omitempty"` // Allowed values of the enum attributes
+	MinDate   string   `json:"minDate,omitempty"` // Earliest date of the date attributes, e.g. 1900-01-01
+	MaxDate   string   `json:"maxDate,omitempty"` // Latest date of the date attributes
+}
+
+// AttributeValue is the normalized value of a profile attribute with its type
+type AttributeValue struct {
+	Type  enum.AttributeType
+	Value string
+}
+
+// Date parses the value of the date attribute
+func (v AttributeValue) Date() (time.Time, error) {
+	return time.Parse(AttributeDateLayout, v.Value)
+}
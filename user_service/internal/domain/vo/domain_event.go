@@ -0,0 +1,58 @@
+package vo
+
+import (
+	"go_micro_service_api/pkg/outbox"
+	"strconv"
+	"time"
+)
+
+// UserAggregate is the aggregate of the domain events, the events of a user are published in order
+const UserAggregate = "user"
+
+// The types of the domain events published to the user exchange, they are the routing keys.
+// The version of a type is increased when its payload changes incompatibly.
+const (
+	ProfileCreatedEvent = "profile.created"
+	ProfileUpdatedEvent = "profile.updated"
+	KycReviewedEvent    = "kyc.reviewed"
+)
+
+type ProfileCreatedPayload struct {
+	UserId  int64  `json:"userId"`
+	Account string `json:"account"`
+}
+
+// ProfileUpdatedPayload names the changed items only, consumers get the values by gRPC
+type ProfileUpdatedPayload struct {
+	UserId int64    `json:"userId"`
+	Keys   []string `json:"keys"` // The profile keys, e.g. Email, MobileNumber
+}
+
+type KycReviewedPayload struct {
+	SubmissionId int64     `json:"submissionId"`
+	UserId       int64     `json:"userId"`
+	Status       string    `json:"status"` // approved or rejected
+	ReviewedAt   time.Time `json:"reviewedAt"`
+}
+
+func NewProfileCreatedEvent(payload ProfileCreatedPayload) *outbox.Event {
+	return newUserEvent(payload.UserId, ProfileCreatedEvent, payload)
+}
+
+func NewProfileUpdatedEvent(payload ProfileUpdatedPayload) *outbox.Event {
+	return newUserEvent(payload.UserId, ProfileUpdatedEvent, payload)
+}
+
+func NewKycReviewedEvent(payload KycReviewedPayload) *outbox.Event {
+	return newUserEvent(payload.UserId, KycReviewedEvent, payload)
+}
+
+func newUserEvent(userId int64, eventType string, payload any) *outbox.Event {
+	return &outbox.Event{
+		AggregateType: UserAggregate,
+		AggregateId:   strconv.FormatInt(userId, 10),
+		Type:          eventType,
+		Version:       1,
+		Payload:       payload,
+	}
+}
@@ -0,0 +1,18 @@
+package vo
+
+import "go_micro_service_api/pkg/enum"
+
+// KycUpload is a document uploaded by the player
+type KycUpload struct {
+	Type        enum.KycDocument
+	ContentType string // image/jpeg, image/png or application/pdf
+	Content     []byte
+}
+
+// KycDocument is a document of the submission kept in the storage
+type KycDocument struct {
+	Type        int    `json:"type"` // pkg/enum/kyc_document_type
+	Key         string `json:"key"`  // The object key in the storage
+	ContentType string `json:"content_type"`
+	Size        int    `json:"size"`
+}
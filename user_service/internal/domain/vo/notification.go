@@ -0,0 +1,93 @@
+package vo
+
+import (
+	"strings"
+	"time"
+)
+
+type NotifyChannel string
+
+const (
+	EmailChannel NotifyChannel = "email"
+	SMSChannel   NotifyChannel = "sms"
+)
+
+// RegisterVerificationType is the template of the verification requested without a type, which is the registration
+const RegisterVerificationType = "register"
+
+// VerificationNotice is the verification code delivered to the user out-of-band
+type VerificationNotice struct {
+	Channel   NotifyChannel
+	Recipient string // Email address or E.164 mobile number
+	Type      string // register, forgotPwd or unusualLogin
+	Locale    string // e.g. en, zh-TW, empty for the default locale
+	Prefix    string
+	Code      string
+}
+
+// NewVerificationNotice delivers the code of the session to the email or the mobile number, only one of them is given
+func NewVerificationNotice(session *VerificationSession, verificationType, email, countryCode, mobileNumber, locale string) *VerificationNotice {
+	notice := &VerificationNotice{
+		Type:   verificationType,
+		Locale: locale,
+		Prefix: session.Prefix,
+		Code:   session.Code,
+	}
+	if notice.Type == "" {
+		notice.Type = RegisterVerificationType
+	}
+	notice.Channel, notice.Recipient = NotifyRecipient(email, countryCode, mobileNumber)
+	return notice
+}
+
+// NotifyRecipient returns the email, or the E.164 mobile number when the email is empty, and its channel.
+// The callers make sure only one of them is given, see VerifyService.ValidateRecipient
+func NotifyRecipient(email, countryCode, mobileNumber string) (NotifyChannel, string) {
+	if email != "" {
+		return EmailChannel, email
+	}
+	return SMSChannel, "+" + strings.TrimPrefix(countryCode, "+") + mobileNumber
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryQueued   DeliveryStatus = "queued"   // Published, waiting for the worker
+	DeliveryRetrying DeliveryStatus = "retrying" // Failed to send, waiting for the next attempt
+	DeliverySent     DeliveryStatus = "sent"     // Accepted by the email or sms provider
+	DeliveryDead     DeliveryStatus = "dead"     // Gave up, the event is kept in the dead letter queue
+)
+
+// NotificationEvent is the notice published to the broker, the id is the idempotency key of its delivery
+type NotificationEvent struct {
+	Id        string
+	Notice    VerificationNotice
+	Attempts  int // The number of the sending attempts made
+	CreatedAt time.Time
+}
+
+// NotificationDelivery is the delivery status of a notification event, the code isn't kept
+type NotificationDelivery struct {
+	Id        string
+	Channel   NotifyChannel
+	Recipient string
+	Type      string
+	Status    DeliveryStatus
+	Attempts  int
+	LastError string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewNotificationDelivery(event *NotificationEvent) *NotificationDelivery {
+	return &NotificationDelivery{
+		Id:        event.Id,
+		Channel:   event.Notice.Channel,
+		Recipient: event.Notice.Recipient,
+		Type:      event.Notice.Type,
+		Status:    DeliveryQueued,
+		Attempts:  event.Attempts,
+		CreatedAt: event.CreatedAt,
+		UpdatedAt: event.CreatedAt,
+	}
+}
@@ -13,3 +13,10 @@ func NewOAuthSession(provider enum.Provider, accessToken string) *OAuthSession {
 		AccessToken: accessToken,
 	}
 }
+
+// OAuthIdentity is the user of an oauth provider verified by its access token
+type OAuthIdentity struct {
+	Provider enum.Provider
+	OpenID   string
+	UserId   int64 // The user bound to the openID, 0 when the openID isn't bound yet
+}
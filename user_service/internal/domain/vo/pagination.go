@@ -0,0 +1,31 @@
+package vo
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+)
+
+// Pagination is the page of a query, the page starts from 1
+type Pagination struct {
+	Page     int
+	PageSize int
+}
+
+// Normalize falls back to the first page and the default page size, and caps the page size
+func (p Pagination) Normalize() Pagination {
+	if p.Page < 1 {
+		p.Page = 1
+	}
+	if p.PageSize < 1 {
+		p.PageSize = DefaultPageSize
+	}
+	if p.PageSize > MaxPageSize {
+		p.PageSize = MaxPageSize
+	}
+	return p
+}
+
+// Offset is the number of the rows skipped before the page
+func (p Pagination) Offset() int {
+	return (p.Page - 1) * p.PageSize
+}
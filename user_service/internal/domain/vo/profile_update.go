@@ -0,0 +1,11 @@
+package vo
+
+// ProfileUpdate is the change of the contact of the user, the empty value is left unchanged.
+// A new email or mobile number comes with the verification session issued for it.
+type ProfileUpdate struct {
+	Email              string
+	EmailVerification  *VerificationSession
+	CountryCode        string
+	MobileNumber       string
+	MobileVerification *VerificationSession
+}
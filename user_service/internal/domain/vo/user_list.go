@@ -1,9 +1,44 @@
 package vo
 
+import (
+	"go_micro_service_api/pkg/enum"
+	"time"
+)
+
 type UserList struct {
-	UserId       int64  `json:"user_id"`
-	Account      string `json:"account"`
-	Email        string `json:"email"`
-	CountryCode  string `json:"country_code"`
-	MobileNumber string `json:"mobile_number"`
+	UserId       int64           `json:"user_id"`
+	Account      string          `json:"account"`
+	Email        string          `json:"email"`
+	CountryCode  string          `json:"country_code"`
+	MobileNumber string          `json:"mobile_number"`
+	Status       enum.UserStatus `json:"status"`
+	RoleId       int64           `json:"role_id"`
+	RoleName     string          `json:"role_name"`
+	ClientId     int64           `json:"client_id"`
+	MerchantId   int64           `json:"merchant_id"`
+	CreatedAt    time.Time       `json:"created_at"`
+}
+
+// UserSearch filters the users searched by the backend, the empty fields don't filter.
+// The email and the mobile are matched on the profiles, the rest are matched on the accounts of the auth service.
+type UserSearch struct {
+	AccountPrefix string
+	Email         string
+	CountryCode   string
+	MobileNumber  string
+	Status        *enum.UserStatus
+	MerchantId    *int64
+	ClientId      *int64
+	CreatedFrom   *time.Time // Inclusive
+	CreatedTo     *time.Time // Exclusive
+	Sort          string     // createdAt by default, account or id
+	Ascending     bool
+	Cursor        string // The next cursor of the previous page, empty for the first page
+	Limit         int
+}
+
+// UserListPage is a page of the searched users, NextCursor is empty on the last page
+type UserListPage struct {
+	Users      []*UserList
+	NextCursor string
 }
@@ -1,10 +1,8 @@
 package vo
 
 import (
-	"context"
 	"crypto/md5"
 	"fmt"
-	"go_micro_service_api/pkg/cus_otel"
 	"time"
 
 	"math/rand"
@@ -59,10 +57,8 @@ func (vs *VerificationSession) NextCode() *VerificationSession {
 	return vs
 }
 
-func (vs *VerificationSession) NextToken(ctx context.Context, data ...string) *VerificationSession {
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-
-	vs.Token = generateToken(ctx, r, data...)
+func (vs *VerificationSession) NextToken(data ...string) *VerificationSession {
+	vs.Token = hashToken(data...)
 
 	return vs
 }
@@ -71,6 +67,12 @@ func (vs *VerificationSession) Verify(code string, token string) bool {
 	return vs.GetVerificationCode() == code && vs.Token == token
 }
 
+// IsIssuedFor checks the token is generated from the given data,
+// so the verified code can't be used for another email or mobile number.
+func (vs *VerificationSession) IsIssuedFor(data ...string) bool {
+	return vs.Token == hashToken(data...)
+}
+
 func generatePrefix(r *rand.Rand, length int) string {
 	prefix := make([]byte, length)
 	for i := range prefix {
@@ -92,14 +94,13 @@ func generateCode(r *rand.Rand, length int) string {
 	return string(code)
 }
 
-func generateToken(ctx context.Context, r *rand.Rand, data ...string) string {
+// hashToken hashes the data into the same token every time.
+// Each field is prefixed by its length, so the fields can't be shifted into each other, e.g. ("ab", "c") and ("a", "bc").
+func hashToken(data ...string) string {
 	hasher := md5.New()
 	for _, d := range data {
-		_, err := hasher.Write([]byte(d))
-		if err != nil {
-			cus_otel.Warn(ctx, "failed to create token", cus_otel.NewField("error", err.Error()))
-			return generateCode(r, 12)
-		}
+		// Writing to a hash never returns an error
+		fmt.Fprintf(hasher, "%d:%s", len(d), d)
 	}
 	return fmt.Sprintf("%x", hasher.Sum(nil))
 }
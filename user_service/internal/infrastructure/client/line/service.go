@@ -0,0 +1,227 @@
+package line
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/pkg/cus_otel"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const logKey = "line callback error"
+const URL = "https://api.line.me"
+const PathVerify = "/oauth2/v2.1/verify"
+const PathGetProfile = "/v2/profile"
+
+// ErrUnexpectedChannel is returned when the access token is issued to a channel which isn't accepted
+var ErrUnexpectedChannel = errors.New("line access token is issued by another channel")
+
+type Service struct {
+	url    *url.URL
+	client *resty.Client
+}
+
+func NewService(client *resty.Client) *Service {
+	serviceURL, _ := url.Parse(URL)
+	return &Service{
+		url:    serviceURL,
+		client: client,
+	}
+}
+
+type ErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+	Message          string `json:"message"` // the profile api answers the message instead of the error
+}
+
+type VerifyResponse struct {
+	Scope     string `json:"scope"`
+	ClientID  string `json:"client_id"` // the channel which issued the token
+	ExpiresIn int64  `json:"expires_in"`
+}
+
+type VerifyIDTokenResponse struct {
+	Sub   string `json:"sub"` // same as the user id of the profile
+	Aud   string `json:"aud"` // the channel which issued the token
+	Email string `json:"email"`
+}
+
+type ProfileResponse struct {
+	UserID      string `json:"userId"`
+	DisplayName string `json:"displayName"`
+}
+
+type GetMeResponse struct {
+	ID    string
+	Email string
+}
+
+// Verify introspects the access token
+// Parameters:
+//   - ctx: context
+//   - accessToken: line access token
+//
+// Returns:
+//   - *VerifyResponse: response body from PathVerify
+//   - error: error when the token is invalid or expired
+func (s *Service) Verify(ctx context.Context, accessToken string) (*VerifyResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	url := s.url.JoinPath(PathVerify).String()
+	result := &VerifyResponse{}
+	errResult := &ErrorResponse{}
+	resp, err := s.client.R().
+		SetHeader("Accept", "application/json").
+		SetQueryParam("access_token", accessToken).
+		SetResult(result).
+		SetError(errResult).
+		EnableTrace().
+		Get(url)
+
+	if err := s.checkResponse(ctx, url, resp, err, errResult); err != nil {
+		return result, err
+	}
+
+	if result.ExpiresIn <= 0 {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", "expired token"))
+		return result, fmt.Errorf("line access token is expired")
+	}
+
+	return result, nil
+}
+
+// VerifyIDToken verifies the id token issued with the access token, line only shares the email in the id token
+// Parameters:
+//   - ctx: context
+//   - idToken: line id token
+//   - channelID: the channel which the token is expected to be issued by
+//
+// Returns:
+//   - *VerifyIDTokenResponse: response body from PathVerify
+//   - error: error when the token is invalid, expired or issued by other channel
+func (s *Service) VerifyIDToken(ctx context.Context, idToken string, channelID string) (*VerifyIDTokenResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	url := s.url.JoinPath(PathVerify).String()
+	result := &VerifyIDTokenResponse{}
+	errResult := &ErrorResponse{}
+	resp, err := s.client.R().
+		SetHeader("Accept", "application/json").
+		SetFormData(map[string]string{
+			"id_token":  idToken,
+			"client_id": channelID,
+		}).
+		SetResult(result).
+		SetError(errResult).
+		EnableTrace().
+		Post(url)
+
+	if err := s.checkResponse(ctx, url, resp, err, errResult); err != nil {
+		return result, err
+	}
+
+	if result.Sub == "" {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", "empty sub"))
+		return result, fmt.Errorf("line responded without the user id")
+	}
+
+	return result, nil
+}
+
+// GetProfile returns the profile of the token's user
+// Parameters:
+//   - ctx: context
+//   - accessToken: line access token
+//
+// Returns:
+//   - *ProfileResponse: response body from PathGetProfile
+//   - error: error
+func (s *Service) GetProfile(ctx context.Context, accessToken string) (*ProfileResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	url := s.url.JoinPath(PathGetProfile).String()
+	result := &ProfileResponse{}
+	errResult := &ErrorResponse{}
+	resp, err := s.client.R().
+		SetHeader("Accept", "application/json").
+		SetAuthToken(accessToken).
+		SetResult(result).
+		SetError(errResult).
+		EnableTrace().
+		Get(url)
+
+	if err := s.checkResponse(ctx, url, resp, err, errResult); err != nil {
+		return result, err
+	}
+
+	if result.UserID == "" {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", "empty id"))
+		return result, fmt.Errorf("line responded without the user id")
+	}
+
+	return result, nil
+}
+
+// GetMe returns user info, the access token is introspected before fetching the profile.
+// The email is empty since line only shares it in the id token, see VerifyIDToken
+// Parameters:
+//   - ctx: context
+//   - accessToken: line access token
+//   - channelIDs: the channels which the token is accepted from
+//
+// Returns:
+//   - *GetMeResponse: user info
+//   - error: error, ErrUnexpectedChannel when the token is issued to another channel
+func (s *Service) GetMe(ctx context.Context, accessToken string, channelIDs []string) (*GetMeResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	result := &GetMeResponse{}
+	verified, err := s.Verify(ctx, accessToken)
+	if err != nil {
+		return result, err
+	}
+
+	// A token of another app mustn't sign in to ours
+	if !slices.Contains(channelIDs, verified.ClientID) {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", "unexpected channel"), cus_otel.NewField("client_id", verified.ClientID))
+		return result, ErrUnexpectedChannel
+	}
+
+	profile, err := s.GetProfile(ctx, accessToken)
+	if err != nil {
+		return result, err
+	}
+	result.ID = profile.UserID
+
+	return result, nil
+}
+
+// checkResponse logs the response and turns the failed one into an error
+func (s *Service) checkResponse(ctx context.Context, url string, resp *resty.Response, err error, errResult *ErrorResponse) error {
+	if err != nil {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", err))
+		return err
+	}
+
+	cus_otel.TraceRestyResponse(ctx, "line client trace info", url, resp)
+
+	if resp.StatusCode() != http.StatusOK {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("response_body", resp.String()))
+		message := errResult.ErrorDescription
+		if message == "" {
+			message = errResult.Message
+		}
+		return fmt.Errorf("line responded %d: %s", resp.StatusCode(), message)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+// Your good friend for development
+// https://developers.line.biz/en/docs/line-login/integrate-line-login/
+package line_test
+
+import (
+	"context"
+	"go_micro_service_api/user_service/internal/infrastructure/client/line"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/go-resty/resty/v2"
+)
+
+func TestGetMe(t *testing.T) {
+	channelID := "" // the channel of the valid token
+	tests := []struct {
+		name     string
+		token    string
+		expected bool
+	}{
+		{
+			name:     "valid token",
+			token:    "", // find it from your good friend on top of this file
+			expected: true,
+		},
+		{
+			name:     "empty token",
+			token:    "",
+			expected: false,
+		},
+		{
+			name:     "invalid token",
+			token:    "ejwdsdsadadad==",
+			expected: false,
+		},
+	}
+
+	if tests[0].token == "" {
+		t.Skip("please provide token")
+	}
+
+	client := resty.New()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			service := line.NewService(client)
+			res, _ := service.GetMe(ctx, test.token, []string{channelID})
+
+			assert.Equal(t, res.ID != "", test.expected)
+		})
+	}
+}
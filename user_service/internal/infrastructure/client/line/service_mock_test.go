@@ -0,0 +1,188 @@
+// Your good friend for development
+// https://developers.line.biz/en/reference/line-login/
+package line_test
+
+import (
+	"context"
+	"errors"
+	"go_micro_service_api/user_service/internal/infrastructure/client/line"
+	"net/http"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestMockGetMe(t *testing.T) {
+	validVerify := map[string]any{
+		"scope":      "profile openid email",
+		"client_id":  "1440057261",
+		"expires_in": 2591659,
+	}
+	validProfile := map[string]any{
+		"userId":      "U4af4980629ab6d1f2a1e0a1f3c8b2e0d",
+		"displayName": "cus",
+	}
+
+	tests := []struct {
+		name        string
+		verifyCode  int
+		verifyResp  any
+		profileCode int
+		profileResp any
+		expected    bool
+		expectedErr error
+	}{
+		{
+			name:        "valid token",
+			verifyCode:  http.StatusOK,
+			verifyResp:  validVerify,
+			profileCode: http.StatusOK,
+			profileResp: validProfile,
+			expected:    true,
+		},
+		{
+			name:       "expired token",
+			verifyCode: http.StatusBadRequest,
+			verifyResp: map[string]any{
+				"error":             "invalid_request",
+				"error_description": "access token expired",
+			},
+			profileCode: http.StatusOK,
+			profileResp: validProfile,
+			expected:    false,
+		},
+		{
+			name:       "no time left",
+			verifyCode: http.StatusOK,
+			verifyResp: map[string]any{
+				"scope":      "profile",
+				"client_id":  "1440057261",
+				"expires_in": 0,
+			},
+			profileCode: http.StatusOK,
+			profileResp: validProfile,
+			expected:    false,
+		},
+		{
+			name:       "token of another channel",
+			verifyCode: http.StatusOK,
+			verifyResp: map[string]any{
+				"scope":      "profile",
+				"client_id":  "1999999999",
+				"expires_in": 2591659,
+			},
+			profileCode: http.StatusOK,
+			profileResp: validProfile,
+			expected:    false,
+			expectedErr: line.ErrUnexpectedChannel,
+		},
+		{
+			name:        "profile is not authorized",
+			verifyCode:  http.StatusOK,
+			verifyResp:  validVerify,
+			profileCode: http.StatusUnauthorized,
+			profileResp: map[string]any{
+				"message": "Authentication failed. Confirm that the access token in the authorization header is valid.",
+			},
+			expected: false,
+		},
+	}
+
+	client := resty.New()
+	httpmock.ActivateNonDefault(client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			httpmock.RegisterResponder(
+				http.MethodGet,
+				line.URL+line.PathVerify,
+				httpmock.NewJsonResponderOrPanic(test.verifyCode, test.verifyResp),
+			)
+			httpmock.RegisterResponder(
+				http.MethodGet,
+				line.URL+line.PathGetProfile,
+				httpmock.NewJsonResponderOrPanic(test.profileCode, test.profileResp),
+			)
+
+			service := line.NewService(client)
+			res, err := service.GetMe(ctx, "", []string{"1440057261"})
+
+			assert.Equal(t, err == nil, test.expected)
+			assert.Equal(t, res.ID != "", test.expected)
+			if test.expectedErr != nil {
+				assert.Equal(t, errors.Is(err, test.expectedErr), true)
+			}
+		})
+	}
+}
+
+func TestMockVerifyIDToken(t *testing.T) {
+	tests := []struct {
+		name          string
+		respCode      int
+		resp          any
+		expected      bool
+		expectedEmail string
+	}{
+		{
+			name:     "valid token",
+			respCode: http.StatusOK,
+			resp: map[string]any{
+				"iss":   "https://access.line.me",
+				"sub":   "U4af4980629ab6d1f2a1e0a1f3c8b2e0d",
+				"aud":   "1440057261",
+				"exp":   1731628800,
+				"iat":   1731625200,
+				"name":  "cus",
+				"email": "xxx@cus.go",
+			},
+			expected:      true,
+			expectedEmail: "xxx@cus.go",
+		},
+		{
+			name:     "invalid token",
+			respCode: http.StatusBadRequest,
+			resp: map[string]any{
+				"error":             "invalid_request",
+				"error_description": "Invalid IdToken.",
+			},
+			expected: false,
+		},
+		{
+			name:     "other channel",
+			respCode: http.StatusBadRequest,
+			resp: map[string]any{
+				"error":             "invalid_request",
+				"error_description": "Invalid IdToken Audience.",
+			},
+			expected: false,
+		},
+	}
+
+	client := resty.New()
+	httpmock.ActivateNonDefault(client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			httpmock.RegisterResponder(
+				http.MethodPost,
+				line.URL+line.PathVerify,
+				httpmock.NewJsonResponderOrPanic(test.respCode, test.resp),
+			)
+
+			service := line.NewService(client)
+			res, err := service.VerifyIDToken(ctx, "", "1440057261")
+
+			assert.Equal(t, err == nil, test.expected)
+			assert.Equal(t, res.Email, test.expectedEmail)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"go_micro_service_api/pkg/cus_otel"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const logKey = "oidc callback error"
+const PathDiscovery = "/.well-known/openid-configuration"
+
+// DefaultCacheTTL is how long the discovery document and the JWKS are cached
+const DefaultCacheTTL = time.Hour
+
+// minRefreshInterval limits refetching the JWKS for an unknown kid, so forged tokens can't flood the provider
+const minRefreshInterval = time.Minute
+
+var (
+	GoogleIssuer = "https://accounts.google.com"
+	LINEIssuer   = "https://access.line.me"
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid id token")
+	ErrUnknownKey   = errors.New("unknown signing key")
+)
+
+// Provider is the OIDC provider and the client ids which the id tokens must be issued to
+type Provider struct {
+	// Issuer is the issuer url, the discovery document is fetched from it
+	Issuer string
+	// AltIssuers are the other iss values the provider signs with, e.g. google signs with accounts.google.com too
+	AltIssuers []string
+	// ClientIDs are the accepted aud values
+	ClientIDs []string
+	// CacheTTL is how long the discovery document and the JWKS are cached, DefaultCacheTTL when it isn't set
+	CacheTTL time.Duration
+}
+
+// Identity is the normalized user of the verified id token
+type Identity struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// Discovery is the part of the OIDC discovery document which the verifier needs
+type Discovery struct {
+	Issuer  string `json:"issuer"`
+	JwksURI string `json:"jwks_uri"`
+}
+
+type claims struct {
+	jwt.RegisteredClaims
+	Email         string `json:"email"`
+	EmailVerified any    `json:"email_verified"` // some providers answer it as a string
+}
+
+// Verifier verifies the id tokens of the provider locally, only the discovery document and the JWKS
+// are fetched from the provider and cached
+type Verifier struct {
+	client   *resty.Client
+	provider Provider
+	now      func() time.Time
+
+	mu          sync.Mutex
+	discovery   *Discovery
+	keys        map[string]crypto.PublicKey
+	expiresAt   time.Time
+	refreshedAt time.Time
+}
+
+func NewVerifier(client *resty.Client, provider Provider) *Verifier {
+	if provider.CacheTTL <= 0 {
+		provider.CacheTTL = DefaultCacheTTL
+	}
+	return &Verifier{
+		client:   client,
+		provider: provider,
+		now:      time.Now,
+	}
+}
+
+// Verify verifies the signature, iss, aud and exp of the id token
+// Parameters:
+//   - ctx: context
+//   - idToken: id token issued by the provider
+//
+// Returns:
+//   - *Identity: the user of the token
+//   - error: ErrInvalidToken when the token isn't accepted, other errors when the provider can't be reached
+func (v *Verifier) Verify(ctx context.Context, idToken string) (*Identity, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	if len(v.provider.ClientIDs) == 0 {
+		return nil, fmt.Errorf("no client id of %s is configured", v.provider.Issuer)
+	}
+
+	var fetchErr error
+	c := &claims{}
+	_, err := jwt.ParseWithClaims(idToken, c, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, err := v.getKey(ctx, kid)
+		if err != nil && !errors.Is(err, ErrUnknownKey) {
+			fetchErr = err
+		}
+		return key, err
+	},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwt.WithExpirationRequired(),
+		jwt.WithTimeFunc(v.now),
+	)
+	if fetchErr != nil {
+		return nil, fetchErr
+	}
+	if err != nil {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", err))
+		return nil, fmt.Errorf("%w: %w", ErrInvalidToken, err)
+	}
+
+	if !v.isIssuer(ctx, c.Issuer) {
+		return nil, fmt.Errorf("%w: unexpected issuer %s", ErrInvalidToken, c.Issuer)
+	}
+
+	if !slices.ContainsFunc(c.Audience, func(aud string) bool {
+		return slices.Contains(v.provider.ClientIDs, aud)
+	}) {
+		return nil, fmt.Errorf("%w: unexpected audience %v", ErrInvalidToken, c.Audience)
+	}
+
+	if c.Subject == "" {
+		return nil, fmt.Errorf("%w: empty subject", ErrInvalidToken)
+	}
+
+	identity := &Identity{
+		Subject: c.Subject,
+		Email:   c.Email,
+	}
+	switch verified := c.EmailVerified.(type) {
+	case bool:
+		identity.EmailVerified = verified
+	case string:
+		identity.EmailVerified, _ = strconv.ParseBool(verified)
+	}
+
+	return identity, nil
+}
+
+// isIssuer checks the iss against the discovery document and the configured issuers
+func (v *Verifier) isIssuer(ctx context.Context, iss string) bool {
+	if iss == v.provider.Issuer || slices.Contains(v.provider.AltIssuers, iss) {
+		return true
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.discovery != nil && v.discovery.Issuer == iss
+}
+
+// getKey returns the cached key of the kid, the discovery document and the JWKS are refetched
+// when the cache expires or the kid is unknown
+func (v *Verifier) getKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	now := v.now()
+	if v.keys == nil || now.After(v.expiresAt) {
+		if err := v.refresh(ctx, now); err != nil {
+			return nil, err
+		}
+	}
+
+	key, ok := v.keys[kid]
+	if ok {
+		return key, nil
+	}
+
+	// The provider may have rotated the keys before the cache expires
+	if now.Sub(v.refreshedAt) >= minRefreshInterval {
+		if err := v.refresh(ctx, now); err != nil {
+			return nil, err
+		}
+		if key, ok = v.keys[kid]; ok {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", ErrUnknownKey, kid)
+}
+
+// refresh fetches the discovery document and the JWKS, must be called with the lock held
+func (v *Verifier) refresh(ctx context.Context, now time.Time) error {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	discovery := &Discovery{}
+	issuerURL, err := url.Parse(v.provider.Issuer)
+	if err != nil {
+		return err
+	}
+	if err = v.get(ctx, issuerURL.JoinPath(PathDiscovery).String(), discovery); err != nil {
+		return err
+	}
+	if discovery.JwksURI == "" {
+		return fmt.Errorf("the discovery document of %s has no jwks_uri", v.provider.Issuer)
+	}
+
+	jwks := &JWKS{}
+	if err = v.get(ctx, discovery.JwksURI, jwks); err != nil {
+		return err
+	}
+
+	v.discovery = discovery
+	v.keys = jwks.parseKeys()
+	v.refreshedAt = now
+	v.expiresAt = now.Add(v.provider.CacheTTL)
+
+	return nil
+}
+
+func (v *Verifier) get(ctx context.Context, url string, result any) error {
+	resp, err := v.client.R().
+		SetHeader("Accept", "application/json").
+		SetResult(result).
+		EnableTrace().
+		Get(url)
+
+	if err != nil {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", err))
+		return err
+	}
+
+	cus_otel.TraceRestyResponse(ctx, "oidc client trace info", url, resp)
+
+	if resp.StatusCode() != http.StatusOK {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("response_body", resp.String()))
+		return fmt.Errorf("%s responded %d", url, resp.StatusCode())
+	}
+
+	return nil
+}
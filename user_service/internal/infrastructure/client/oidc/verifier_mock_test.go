@@ -0,0 +1,230 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"go_micro_service_api/user_service/internal/infrastructure/client/oidc"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	issuer   = "https://accounts.example.com"
+	jwksURI  = "https://www.example.com/oauth2/v3/certs"
+	clientID = "111111111111.apps.example.com"
+)
+
+func encode(i *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(i.Bytes())
+}
+
+func sign(t *testing.T, method jwt.SigningMethod, kid string, key any, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestMockVerify(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	client := resty.New()
+	httpmock.ActivateNonDefault(client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		issuer+oidc.PathDiscovery,
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{
+			"issuer":   issuer,
+			"jwks_uri": jwksURI,
+		}),
+	)
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		jwksURI,
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, map[string]any{
+			"keys": []map[string]any{
+				{
+					"kid": "rsa",
+					"kty": "RSA",
+					"alg": "RS256",
+					"use": "sig",
+					"n":   encode(rsaKey.N),
+					"e":   encode(big.NewInt(int64(rsaKey.E))),
+				},
+				{
+					"kid": "ec",
+					"kty": "EC",
+					"alg": "ES256",
+					"crv": "P-256",
+					"x":   encode(ecKey.X),
+					"y":   encode(ecKey.Y),
+				},
+			},
+		}),
+	)
+
+	verifier := oidc.NewVerifier(client, oidc.Provider{
+		Issuer:     issuer,
+		AltIssuers: []string{"accounts.example.com"},
+		ClientIDs:  []string{"other", clientID},
+	})
+
+	now := time.Now()
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":            issuer,
+			"aud":            clientID,
+			"sub":            "111111111111111111111",
+			"email":          "xxx@cus.go",
+			"email_verified": true,
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Hour).Unix(),
+		}
+	}
+	withClaim := func(key string, value any) jwt.MapClaims {
+		c := validClaims()
+		c[key] = value
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		expected *oidc.Identity
+		err      error
+	}{
+		{
+			name:  "valid RS256 token",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, validClaims()),
+			expected: &oidc.Identity{
+				Subject:       "111111111111111111111",
+				Email:         "xxx@cus.go",
+				EmailVerified: true,
+			},
+		},
+		{
+			name:  "valid ES256 token",
+			token: sign(t, jwt.SigningMethodES256, "ec", ecKey, validClaims()),
+			expected: &oidc.Identity{
+				Subject:       "111111111111111111111",
+				Email:         "xxx@cus.go",
+				EmailVerified: true,
+			},
+		},
+		{
+			name:  "alternative issuer and email_verified as string",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, withClaim("iss", "accounts.example.com")),
+			expected: &oidc.Identity{
+				Subject:       "111111111111111111111",
+				Email:         "xxx@cus.go",
+				EmailVerified: true,
+			},
+		},
+		{
+			name:  "unverified email",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, withClaim("email_verified", "false")),
+			expected: &oidc.Identity{
+				Subject: "111111111111111111111",
+				Email:   "xxx@cus.go",
+			},
+		},
+		{
+			name:  "unexpected issuer",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, withClaim("iss", "https://evil.example.com")),
+			err:   oidc.ErrInvalidToken,
+		},
+		{
+			name:  "unexpected audience",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, withClaim("aud", "someone-else")),
+			err:   oidc.ErrInvalidToken,
+		},
+		{
+			name:  "expired token",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", rsaKey, withClaim("exp", now.Add(-time.Minute).Unix())),
+			err:   oidc.ErrInvalidToken,
+		},
+		{
+			name:  "forged signature",
+			token: sign(t, jwt.SigningMethodRS256, "rsa", otherKey, validClaims()),
+			err:   oidc.ErrInvalidToken,
+		},
+		{
+			name:  "unknown kid",
+			token: sign(t, jwt.SigningMethodRS256, "unknown", rsaKey, validClaims()),
+			err:   oidc.ErrInvalidToken,
+		},
+		{
+			name:  "not a jwt",
+			token: "ejwdsdsadadad==",
+			err:   oidc.ErrInvalidToken,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			identity, err := verifier.Verify(context.Background(), test.token)
+			if test.err != nil {
+				assert.True(t, errors.Is(err, test.err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, identity)
+		})
+	}
+
+	// The discovery document and the JWKS are fetched once, the unknown kid refetches them only after the minimum interval
+	info := httpmock.GetCallCountInfo()
+	assert.Equal(t, 1, info["GET "+issuer+oidc.PathDiscovery])
+	assert.Equal(t, 1, info["GET "+jwksURI])
+}
+
+func TestMockVerifyProviderDown(t *testing.T) {
+	client := resty.New()
+	httpmock.ActivateNonDefault(client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		issuer+oidc.PathDiscovery,
+		httpmock.NewStringResponder(http.StatusServiceUnavailable, "unavailable"),
+	)
+
+	verifier := oidc.NewVerifier(client, oidc.Provider{
+		Issuer:    issuer,
+		ClientIDs: []string{clientID},
+	})
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	token := sign(t, jwt.SigningMethodRS256, "rsa", key, jwt.MapClaims{
+		"iss": issuer,
+		"aud": clientID,
+		"sub": "111111111111111111111",
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	_, err = verifier.Verify(context.Background(), token)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, oidc.ErrInvalidToken))
+}
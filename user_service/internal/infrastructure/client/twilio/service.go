@@ -0,0 +1,99 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/pkg/cus_otel"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const logKey = "twilio callback error"
+const URL = "https://api.twilio.com"
+const PathSendMessage = "/2010-04-01/Accounts/%s/Messages.json"
+
+type Service struct {
+	url        *url.URL
+	client     *resty.Client
+	accountSid string
+	authToken  string
+	from       string
+}
+
+func NewService(client *resty.Client, accountSid, authToken, from string) *Service {
+	serviceURL, _ := url.Parse(URL)
+	return &Service{
+		url:        serviceURL,
+		client:     client,
+		accountSid: accountSid,
+		authToken:  authToken,
+		from:       from,
+	}
+}
+
+type ErrorResponse struct {
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+	MoreInfo string `json:"more_info"`
+	Status   int    `json:"status"`
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("twilio responded %d, code %d: %s", e.Status, e.Code, e.Message)
+}
+
+type SendMessageResponse struct {
+	Sid    string `json:"sid"`
+	Status string `json:"status"`
+	To     string `json:"to"`
+}
+
+// SendMessage sends the sms
+// Parameters:
+//   - ctx: context
+//   - to: E.164 mobile number
+//   - body: sms content
+//
+// Returns:
+//   - *SendMessageResponse: response body from PathSendMessage
+//   - error: error
+func (s *Service) SendMessage(ctx context.Context, to string, body string) (*SendMessageResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	url := s.url.JoinPath(fmt.Sprintf(PathSendMessage, s.accountSid)).String()
+	result := &SendMessageResponse{}
+	errResult := &ErrorResponse{}
+	resp, err := s.client.R().
+		SetHeader("Accept", "application/json").
+		SetBasicAuth(s.accountSid, s.authToken).
+		SetFormData(map[string]string{
+			"To":   to,
+			"From": s.from,
+			"Body": body,
+		}).
+		SetResult(result).
+		SetError(errResult).
+		EnableTrace().
+		Post(url)
+
+	if err != nil {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", err))
+		return result, err
+	}
+
+	cus_otel.TraceRestyResponse(ctx, "twilio client trace info", url, resp)
+
+	// twilio answers 201 for the queued message
+	if resp.StatusCode() != http.StatusCreated && resp.StatusCode() != http.StatusOK {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("response_body", resp.String()))
+		if errResult.Status == 0 {
+			errResult.Status = resp.StatusCode()
+		}
+		return result, errResult
+	}
+
+	return result, nil
+}
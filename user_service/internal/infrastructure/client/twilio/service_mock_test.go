@@ -0,0 +1,79 @@
+// Your good friend for development
+// https://www.twilio.com/docs/messaging/api/message-resource#create-a-message-resource
+package twilio_test
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/user_service/internal/infrastructure/client/twilio"
+	"net/http"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestMockSendMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		respCode int
+		resp     any
+		expected bool
+	}{
+		{
+			name:     "queued",
+			respCode: http.StatusCreated,
+			resp: map[string]any{
+				"sid":    "SM1f0e8ae6ade43cb3c0ce4525424e404f",
+				"status": "queued",
+				"to":     "+886912345678",
+			},
+			expected: true,
+		},
+		{
+			name:     "invalid number",
+			respCode: http.StatusBadRequest,
+			resp: map[string]any{
+				"code":      21211,
+				"message":   "The 'To' number +886 is not a valid phone number.",
+				"more_info": "https://www.twilio.com/docs/errors/21211",
+				"status":    400,
+			},
+			expected: false,
+		},
+		{
+			name:     "invalid credentials",
+			respCode: http.StatusUnauthorized,
+			resp: map[string]any{
+				"code":      20003,
+				"message":   "Authenticate",
+				"more_info": "https://www.twilio.com/docs/errors/20003",
+				"status":    401,
+			},
+			expected: false,
+		},
+	}
+
+	client := resty.New()
+	httpmock.ActivateNonDefault(client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			httpmock.RegisterResponder(
+				http.MethodPost,
+				twilio.URL+fmt.Sprintf(twilio.PathSendMessage, "AC123"),
+				httpmock.NewJsonResponderOrPanic(test.respCode, test.resp),
+			)
+
+			service := twilio.NewService(client, "AC123", "token", "+15005550006")
+			res, err := service.SendMessage(ctx, "+886912345678", "ABC-123456")
+
+			assert.Equal(t, err == nil, test.expected)
+			assert.Equal(t, res.Sid != "", test.expected)
+		})
+	}
+}
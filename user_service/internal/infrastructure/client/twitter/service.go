@@ -0,0 +1,98 @@
+package twitter
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/pkg/cus_otel"
+	"net/http"
+	"net/url"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const logKey = "twitter callback error"
+const URL = "https://api.twitter.com"
+const PathGetMe = "/2/users/me"
+
+type Service struct {
+	url    *url.URL
+	client *resty.Client
+}
+
+func NewService(client *resty.Client) *Service {
+	serviceURL, _ := url.Parse(URL)
+	return &Service{
+		url:    serviceURL,
+		client: client,
+	}
+}
+
+type ErrorResponse struct {
+	Title  string `json:"title"`
+	Type   string `json:"type"`
+	Status int    `json:"status"`
+	Detail string `json:"detail"`
+}
+
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("twitter responded %d %s: %s", e.Status, e.Title, e.Detail)
+}
+
+type User struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Username string `json:"username"`
+	Email    string `json:"confirmed_email"` // only returned when the token has the users.email scope
+}
+
+type GetMeResponse struct {
+	Data User `json:"data"`
+}
+
+// GetMe returns user info, twitter has no introspection endpoint for the user tokens,
+// so an invalid or expired token is found by the unauthorized response
+// Parameters:
+//   - ctx: context
+//   - accessToken: twitter oauth2 user access token
+//
+// Returns:
+//   - *GetMeResponse: response body from PathGetMe
+//   - error: error
+func (s *Service) GetMe(ctx context.Context, accessToken string) (*GetMeResponse, error) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	url := s.url.JoinPath(PathGetMe).String()
+	result := &GetMeResponse{}
+	errResult := &ErrorResponse{}
+	resp, err := s.client.R().
+		SetHeader("Accept", "application/json").
+		SetAuthToken(accessToken).
+		SetQueryParam("user.fields", "id,name,username,confirmed_email").
+		SetResult(result).
+		SetError(errResult).
+		EnableTrace().
+		Get(url)
+
+	if err != nil {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", err))
+		return result, err
+	}
+
+	cus_otel.TraceRestyResponse(ctx, "twitter client trace info", url, resp)
+
+	if resp.StatusCode() != http.StatusOK {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("response_body", resp.String()))
+		if errResult.Status == 0 {
+			errResult.Status = resp.StatusCode()
+		}
+		return result, errResult
+	}
+
+	if result.Data.ID == "" {
+		cus_otel.Error(ctx, logKey, cus_otel.NewField("error", "empty id"))
+		return result, fmt.Errorf("twitter responded without the user id")
+	}
+
+	return result, nil
+}
@@ -0,0 +1,53 @@
+// Your good friend for development
+// https://developer.x.com/en/docs/authentication/oauth-2-0/user-access-token
+package twitter_test
+
+import (
+	"context"
+	"go_micro_service_api/user_service/internal/infrastructure/client/twitter"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/go-resty/resty/v2"
+)
+
+func TestGetMe(t *testing.T) {
+	tests := []struct {
+		name     string
+		token    string
+		expected bool
+	}{
+		{
+			name:     "valid token",
+			token:    "", // find it from your good friend on top of this file
+			expected: true,
+		},
+		{
+			name:     "empty token",
+			token:    "",
+			expected: false,
+		},
+		{
+			name:     "invalid token",
+			token:    "ejwdsdsadadad==",
+			expected: false,
+		},
+	}
+
+	if tests[0].token == "" {
+		t.Skip("please provide token")
+	}
+
+	client := resty.New()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			service := twitter.NewService(client)
+			res, _ := service.GetMe(ctx, test.token)
+
+			assert.Equal(t, res.Data.ID != "", test.expected)
+		})
+	}
+}
@@ -0,0 +1,96 @@
+// Your good friend for development
+// https://developer.x.com/en/docs/authentication/oauth-2-0/user-access-token
+package twitter_test
+
+import (
+	"context"
+	"go_micro_service_api/user_service/internal/infrastructure/client/twitter"
+	"net/http"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/go-resty/resty/v2"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestMockGetMe(t *testing.T) {
+	tests := []struct {
+		name          string
+		respCode      int
+		resp          any
+		expected      bool
+		expectedEmail string
+	}{
+		{
+			name:     "valid token",
+			respCode: http.StatusOK,
+			resp: map[string]map[string]any{
+				"data": {
+					"id":              "1460981234660155394",
+					"name":            "cus",
+					"username":        "cus_go",
+					"confirmed_email": "xxx@cus.go",
+				},
+			},
+			expected:      true,
+			expectedEmail: "xxx@cus.go",
+		},
+		{
+			name:     "valid token without email scope",
+			respCode: http.StatusOK,
+			resp: map[string]map[string]any{
+				"data": {
+					"id":       "1460981234660155394",
+					"name":     "cus",
+					"username": "cus_go",
+				},
+			},
+			expected: true,
+		},
+		{
+			name:     "empty token",
+			respCode: http.StatusUnauthorized,
+			resp: map[string]any{
+				"title":  "Unauthorized",
+				"type":   "about:blank",
+				"status": 401,
+				"detail": "Unauthorized",
+			},
+			expected: false,
+		},
+		{
+			name:     "insufficient scope",
+			respCode: http.StatusForbidden,
+			resp: map[string]any{
+				"title":  "Forbidden",
+				"type":   "about:blank",
+				"status": 403,
+				"detail": "Forbidden",
+			},
+			expected: false,
+		},
+	}
+
+	client := resty.New()
+	httpmock.ActivateNonDefault(client.GetClient())
+	defer httpmock.DeactivateAndReset()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			httpmock.RegisterResponder(
+				http.MethodGet,
+				twitter.URL+twitter.PathGetMe,
+				httpmock.NewJsonResponderOrPanic(test.respCode, test.resp),
+			)
+
+			service := twitter.NewService(client)
+			res, err := service.GetMe(ctx, "")
+
+			assert.Equal(t, err == nil, test.expected)
+			assert.Equal(t, res.Data.ID != "", test.expected)
+			assert.Equal(t, res.Data.Email, test.expectedEmail)
+		})
+	}
+}
@@ -11,7 +11,11 @@ import (
 
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/migrate"
 
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/outboxevent"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profile"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -23,8 +27,16 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// KycSubmission is the client for interacting with the KycSubmission builders.
+	KycSubmission *KycSubmissionClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// ProfileAttribute is the client for interacting with the ProfileAttribute builders.
+	ProfileAttribute *ProfileAttributeClient
+	// RegistrationSaga is the client for interacting with the RegistrationSaga builders.
+	RegistrationSaga *RegistrationSagaClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -36,7 +48,11 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.KycSubmission = NewKycSubmissionClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
 	c.Profile = NewProfileClient(c.config)
+	c.ProfileAttribute = NewProfileAttributeClient(c.config)
+	c.RegistrationSaga = NewRegistrationSagaClient(c.config)
 }
 
 type (
@@ -127,9 +143,13 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Profile: NewProfileClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		KycSubmission:    NewKycSubmissionClient(cfg),
+		OutboxEvent:      NewOutboxEventClient(cfg),
+		Profile:          NewProfileClient(cfg),
+		ProfileAttribute: NewProfileAttributeClient(cfg),
+		RegistrationSaga: NewRegistrationSagaClient(cfg),
 	}, nil
 }
 
@@ -147,16 +167,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:     ctx,
-		config:  cfg,
-		Profile: NewProfileClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		KycSubmission:    NewKycSubmissionClient(cfg),
+		OutboxEvent:      NewOutboxEventClient(cfg),
+		Profile:          NewProfileClient(cfg),
+		ProfileAttribute: NewProfileAttributeClient(cfg),
+		RegistrationSaga: NewRegistrationSagaClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Profile.
+//		KycSubmission.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -178,25 +202,307 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.KycSubmission.Use(hooks...)
+	c.OutboxEvent.Use(hooks...)
 	c.Profile.Use(hooks...)
+	c.ProfileAttribute.Use(hooks...)
+	c.RegistrationSaga.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.KycSubmission.Intercept(interceptors...)
+	c.OutboxEvent.Intercept(interceptors...)
 	c.Profile.Intercept(interceptors...)
+	c.ProfileAttribute.Intercept(interceptors...)
+	c.RegistrationSaga.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *KycSubmissionMutation:
+		return c.KycSubmission.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
 	case *ProfileMutation:
 		return c.Profile.mutate(ctx, m)
+	case *ProfileAttributeMutation:
+		return c.ProfileAttribute.mutate(ctx, m)
+	case *RegistrationSagaMutation:
+		return c.RegistrationSaga.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
 }
 
+// KycSubmissionClient is a client for the KycSubmission schema.
+type KycSubmissionClient struct {
+	config
+}
+
+// NewKycSubmissionClient returns a client for the KycSubmission from the given config.
+func NewKycSubmissionClient(c config) *KycSubmissionClient {
+	return &KycSubmissionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `kycsubmission.Hooks(f(g(h())))`.
+func (c *KycSubmissionClient) Use(hooks ...Hook) {
+	c.hooks.KycSubmission = append(c.hooks.KycSubmission, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `kycsubmission.Intercept(f(g(h())))`.
+func (c *KycSubmissionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KycSubmission = append(c.inters.KycSubmission, interceptors...)
+}
+
+// Create returns a builder for creating a KycSubmission entity.
+func (c *KycSubmissionClient) Create() *KycSubmissionCreate {
+	mutation := newKycSubmissionMutation(c.config, OpCreate)
+	return &KycSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KycSubmission entities.
+func (c *KycSubmissionClient) CreateBulk(builders ...*KycSubmissionCreate) *KycSubmissionCreateBulk {
+	return &KycSubmissionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KycSubmissionClient) MapCreateBulk(slice any, setFunc func(*KycSubmissionCreate, int)) *KycSubmissionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KycSubmissionCreateBulk{err: fmt.Errorf("calling to KycSubmissionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KycSubmissionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KycSubmissionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KycSubmission.
+func (c *KycSubmissionClient) Update() *KycSubmissionUpdate {
+	mutation := newKycSubmissionMutation(c.config, OpUpdate)
+	return &KycSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KycSubmissionClient) UpdateOne(ks *KycSubmission) *KycSubmissionUpdateOne {
+	mutation := newKycSubmissionMutation(c.config, OpUpdateOne, withKycSubmission(ks))
+	return &KycSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KycSubmissionClient) UpdateOneID(id int) *KycSubmissionUpdateOne {
+	mutation := newKycSubmissionMutation(c.config, OpUpdateOne, withKycSubmissionID(id))
+	return &KycSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KycSubmission.
+func (c *KycSubmissionClient) Delete() *KycSubmissionDelete {
+	mutation := newKycSubmissionMutation(c.config, OpDelete)
+	return &KycSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KycSubmissionClient) DeleteOne(ks *KycSubmission) *KycSubmissionDeleteOne {
+	return c.DeleteOneID(ks.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KycSubmissionClient) DeleteOneID(id int) *KycSubmissionDeleteOne {
+	builder := c.Delete().Where(kycsubmission.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KycSubmissionDeleteOne{builder}
+}
+
+// Query returns a query builder for KycSubmission.
+func (c *KycSubmissionClient) Query() *KycSubmissionQuery {
+	return &KycSubmissionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKycSubmission},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KycSubmission entity by its id.
+func (c *KycSubmissionClient) Get(ctx context.Context, id int) (*KycSubmission, error) {
+	return c.Query().Where(kycsubmission.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KycSubmissionClient) GetX(ctx context.Context, id int) *KycSubmission {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KycSubmissionClient) Hooks() []Hook {
+	return c.hooks.KycSubmission
+}
+
+// Interceptors returns the client interceptors.
+func (c *KycSubmissionClient) Interceptors() []Interceptor {
+	return c.inters.KycSubmission
+}
+
+func (c *KycSubmissionClient) mutate(ctx context.Context, m *KycSubmissionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KycSubmissionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KycSubmissionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KycSubmissionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KycSubmissionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KycSubmission mutation op: %q", m.Op())
+	}
+}
+
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(oe *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(oe))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id int) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(oe *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(oe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id int) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id int) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id int) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
 // ProfileClient is a client for the Profile schema.
 type ProfileClient struct {
 	config
@@ -330,12 +636,280 @@ func (c *ProfileClient) mutate(ctx context.Context, m *ProfileMutation) (Value,
 	}
 }
 
+// ProfileAttributeClient is a client for the ProfileAttribute schema.
+type ProfileAttributeClient struct {
+	config
+}
+
+// NewProfileAttributeClient returns a client for the ProfileAttribute from the given config.
+func NewProfileAttributeClient(c config) *ProfileAttributeClient {
+	return &ProfileAttributeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `profileattribute.Hooks(f(g(h())))`.
+func (c *ProfileAttributeClient) Use(hooks ...Hook) {
+	c.hooks.ProfileAttribute = append(c.hooks.ProfileAttribute, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `profileattribute.Intercept(f(g(h())))`.
+func (c *ProfileAttributeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProfileAttribute = append(c.inters.ProfileAttribute, interceptors...)
+}
+
+// Create returns a builder for creating a ProfileAttribute entity.
+func (c *ProfileAttributeClient) Create() *ProfileAttributeCreate {
+	mutation := newProfileAttributeMutation(c.config, OpCreate)
+	return &ProfileAttributeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ProfileAttribute entities.
+func (c *ProfileAttributeClient) CreateBulk(builders ...*ProfileAttributeCreate) *ProfileAttributeCreateBulk {
+	return &ProfileAttributeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ProfileAttributeClient) MapCreateBulk(slice any, setFunc func(*ProfileAttributeCreate, int)) *ProfileAttributeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ProfileAttributeCreateBulk{err: fmt.Errorf("calling to ProfileAttributeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ProfileAttributeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ProfileAttributeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ProfileAttribute.
+func (c *ProfileAttributeClient) Update() *ProfileAttributeUpdate {
+	mutation := newProfileAttributeMutation(c.config, OpUpdate)
+	return &ProfileAttributeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ProfileAttributeClient) UpdateOne(pa *ProfileAttribute) *ProfileAttributeUpdateOne {
+	mutation := newProfileAttributeMutation(c.config, OpUpdateOne, withProfileAttribute(pa))
+	return &ProfileAttributeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ProfileAttributeClient) UpdateOneID(id int) *ProfileAttributeUpdateOne {
+	mutation := newProfileAttributeMutation(c.config, OpUpdateOne, withProfileAttributeID(id))
+	return &ProfileAttributeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ProfileAttribute.
+func (c *ProfileAttributeClient) Delete() *ProfileAttributeDelete {
+	mutation := newProfileAttributeMutation(c.config, OpDelete)
+	return &ProfileAttributeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ProfileAttributeClient) DeleteOne(pa *ProfileAttribute) *ProfileAttributeDeleteOne {
+	return c.DeleteOneID(pa.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ProfileAttributeClient) DeleteOneID(id int) *ProfileAttributeDeleteOne {
+	builder := c.Delete().Where(profileattribute.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ProfileAttributeDeleteOne{builder}
+}
+
+// Query returns a query builder for ProfileAttribute.
+func (c *ProfileAttributeClient) Query() *ProfileAttributeQuery {
+	return &ProfileAttributeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeProfileAttribute},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ProfileAttribute entity by its id.
+func (c *ProfileAttributeClient) Get(ctx context.Context, id int) (*ProfileAttribute, error) {
+	return c.Query().Where(profileattribute.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ProfileAttributeClient) GetX(ctx context.Context, id int) *ProfileAttribute {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ProfileAttributeClient) Hooks() []Hook {
+	return c.hooks.ProfileAttribute
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProfileAttributeClient) Interceptors() []Interceptor {
+	return c.inters.ProfileAttribute
+}
+
+func (c *ProfileAttributeClient) mutate(ctx context.Context, m *ProfileAttributeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProfileAttributeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProfileAttributeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProfileAttributeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProfileAttributeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProfileAttribute mutation op: %q", m.Op())
+	}
+}
+
+// RegistrationSagaClient is a client for the RegistrationSaga schema.
+type RegistrationSagaClient struct {
+	config
+}
+
+// NewRegistrationSagaClient returns a client for the RegistrationSaga from the given config.
+func NewRegistrationSagaClient(c config) *RegistrationSagaClient {
+	return &RegistrationSagaClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `registrationsaga.Hooks(f(g(h())))`.
+func (c *RegistrationSagaClient) Use(hooks ...Hook) {
+	c.hooks.RegistrationSaga = append(c.hooks.RegistrationSaga, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `registrationsaga.Intercept(f(g(h())))`.
+func (c *RegistrationSagaClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RegistrationSaga = append(c.inters.RegistrationSaga, interceptors...)
+}
+
+// Create returns a builder for creating a RegistrationSaga entity.
+func (c *RegistrationSagaClient) Create() *RegistrationSagaCreate {
+	mutation := newRegistrationSagaMutation(c.config, OpCreate)
+	return &RegistrationSagaCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RegistrationSaga entities.
+func (c *RegistrationSagaClient) CreateBulk(builders ...*RegistrationSagaCreate) *RegistrationSagaCreateBulk {
+	return &RegistrationSagaCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RegistrationSagaClient) MapCreateBulk(slice any, setFunc func(*RegistrationSagaCreate, int)) *RegistrationSagaCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RegistrationSagaCreateBulk{err: fmt.Errorf("calling to RegistrationSagaClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RegistrationSagaCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RegistrationSagaCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RegistrationSaga.
+func (c *RegistrationSagaClient) Update() *RegistrationSagaUpdate {
+	mutation := newRegistrationSagaMutation(c.config, OpUpdate)
+	return &RegistrationSagaUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RegistrationSagaClient) UpdateOne(rs *RegistrationSaga) *RegistrationSagaUpdateOne {
+	mutation := newRegistrationSagaMutation(c.config, OpUpdateOne, withRegistrationSaga(rs))
+	return &RegistrationSagaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RegistrationSagaClient) UpdateOneID(id int) *RegistrationSagaUpdateOne {
+	mutation := newRegistrationSagaMutation(c.config, OpUpdateOne, withRegistrationSagaID(id))
+	return &RegistrationSagaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RegistrationSaga.
+func (c *RegistrationSagaClient) Delete() *RegistrationSagaDelete {
+	mutation := newRegistrationSagaMutation(c.config, OpDelete)
+	return &RegistrationSagaDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RegistrationSagaClient) DeleteOne(rs *RegistrationSaga) *RegistrationSagaDeleteOne {
+	return c.DeleteOneID(rs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RegistrationSagaClient) DeleteOneID(id int) *RegistrationSagaDeleteOne {
+	builder := c.Delete().Where(registrationsaga.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RegistrationSagaDeleteOne{builder}
+}
+
+// Query returns a query builder for RegistrationSaga.
+func (c *RegistrationSagaClient) Query() *RegistrationSagaQuery {
+	return &RegistrationSagaQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRegistrationSaga},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RegistrationSaga entity by its id.
+func (c *RegistrationSagaClient) Get(ctx context.Context, id int) (*RegistrationSaga, error) {
+	return c.Query().Where(registrationsaga.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RegistrationSagaClient) GetX(ctx context.Context, id int) *RegistrationSaga {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RegistrationSagaClient) Hooks() []Hook {
+	return c.hooks.RegistrationSaga
+}
+
+// Interceptors returns the client interceptors.
+func (c *RegistrationSagaClient) Interceptors() []Interceptor {
+	return c.inters.RegistrationSaga
+}
+
+func (c *RegistrationSagaClient) mutate(ctx context.Context, m *RegistrationSagaMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RegistrationSagaCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RegistrationSagaUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RegistrationSagaUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RegistrationSagaDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RegistrationSaga mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Profile []ent.Hook
+		KycSubmission, OutboxEvent, Profile, ProfileAttribute,
+		RegistrationSaga []ent.Hook
 	}
 	inters struct {
-		Profile []ent.Interceptor
+		KycSubmission, OutboxEvent, Profile, ProfileAttribute,
+		RegistrationSaga []ent.Interceptor
 	}
 )
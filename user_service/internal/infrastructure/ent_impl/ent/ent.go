@@ -6,7 +6,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/outboxevent"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profile"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
 	"reflect"
 	"sync"
 
@@ -73,7 +77,11 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			profile.Table: profile.ValidColumn,
+			kycsubmission.Table:    kycsubmission.ValidColumn,
+			outboxevent.Table:      outboxevent.ValidColumn,
+			profile.Table:          profile.ValidColumn,
+			profileattribute.Table: profileattribute.ValidColumn,
+			registrationsaga.Table: registrationsaga.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
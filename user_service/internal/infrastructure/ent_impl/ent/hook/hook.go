@@ -8,6 +8,30 @@ import (
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent"
 )
 
+// The KycSubmissionFunc type is an adapter to allow the use of ordinary
+// function as KycSubmission mutator.
+type KycSubmissionFunc func(context.Context, *ent.KycSubmissionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KycSubmissionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KycSubmissionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KycSubmissionMutation", m)
+}
+
+// The OutboxEventFunc type is an adapter to allow the use of ordinary
+// function as OutboxEvent mutator.
+type OutboxEventFunc func(context.Context, *ent.OutboxEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OutboxEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutboxEventMutation", m)
+}
+
 // The ProfileFunc type is an adapter to allow the use of ordinary
 // function as Profile mutator.
 type ProfileFunc func(context.Context, *ent.ProfileMutation) (ent.Value, error)
@@ -20,6 +44,30 @@ func (f ProfileFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileMutation", m)
 }
 
+// The ProfileAttributeFunc type is an adapter to allow the use of ordinary
+// function as ProfileAttribute mutator.
+type ProfileAttributeFunc func(context.Context, *ent.ProfileAttributeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProfileAttributeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProfileAttributeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProfileAttributeMutation", m)
+}
+
+// The RegistrationSagaFunc type is an adapter to allow the use of ordinary
+// function as RegistrationSaga mutator.
+type RegistrationSagaFunc func(context.Context, *ent.RegistrationSagaMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RegistrationSagaFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RegistrationSagaMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RegistrationSagaMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// KYC submissions of the players and their reviews
+type KycSubmission struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// pkg/enum/kyc_status
+	Status int `json:"status,omitempty"`
+	// The object keys of the documents in the storage
+	Documents []vo.KycDocument `json:"documents,omitempty"`
+	// ReviewerID holds the value of the "reviewer_id" field.
+	ReviewerID *int64 `json:"reviewer_id,omitempty"`
+	// ReviewerNote holds the value of the "reviewer_note" field.
+	ReviewerNote string `json:"reviewer_note,omitempty"`
+	// ReviewedAt holds the value of the "reviewed_at" field.
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KycSubmission) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case kycsubmission.FieldDocuments:
+			values[i] = new([]byte)
+		case kycsubmission.FieldID, kycsubmission.FieldUserID, kycsubmission.FieldStatus, kycsubmission.FieldReviewerID:
+			values[i] = new(sql.NullInt64)
+		case kycsubmission.FieldReviewerNote:
+			values[i] = new(sql.NullString)
+		case kycsubmission.FieldCreatedAt, kycsubmission.FieldUpdatedAt, kycsubmission.FieldReviewedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KycSubmission fields.
+func (ks *KycSubmission) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case kycsubmission.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ks.ID = int(value.Int64)
+		case kycsubmission.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ks.CreatedAt = value.Time
+			}
+		case kycsubmission.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ks.UpdatedAt = value.Time
+			}
+		case kycsubmission.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				ks.UserID = value.Int64
+			}
+		case kycsubmission.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				ks.Status = int(value.Int64)
+			}
+		case kycsubmission.FieldDocuments:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field documents", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ks.Documents); err != nil {
+					return fmt.Errorf("unmarshal field documents: %w", err)
+				}
+			}
+		case kycsubmission.FieldReviewerID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_id", values[i])
+			} else if value.Valid {
+				ks.ReviewerID = new(int64)
+				*ks.ReviewerID = value.Int64
+			}
+		case kycsubmission.FieldReviewerNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewer_note", values[i])
+			} else if value.Valid {
+				ks.ReviewerNote = value.String
+			}
+		case kycsubmission.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				ks.ReviewedAt = new(time.Time)
+				*ks.ReviewedAt = value.Time
+			}
+		default:
+			ks.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KycSubmission.
+// This includes values selected through modifiers, order, etc.
+func (ks *KycSubmission) Value(name string) (ent.Value, error) {
+	return ks.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this KycSubmission.
+// Note that you need to call KycSubmission.Unwrap() before calling this method if this KycSubmission
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ks *KycSubmission) Update() *KycSubmissionUpdateOne {
+	return NewKycSubmissionClient(ks.config).UpdateOne(ks)
+}
+
+// Unwrap unwraps the KycSubmission entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ks *KycSubmission) Unwrap() *KycSubmission {
+	_tx, ok := ks.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KycSubmission is not a transactional entity")
+	}
+	ks.config.driver = _tx.drv
+	return ks
+}
+
+// String implements the fmt.Stringer.
+func (ks *KycSubmission) String() string {
+	var builder strings.Builder
+	builder.WriteString("KycSubmission(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ks.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(ks.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ks.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", ks.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", ks.Status))
+	builder.WriteString(", ")
+	builder.WriteString("documents=")
+	builder.WriteString(fmt.Sprintf("%v", ks.Documents))
+	builder.WriteString(", ")
+	if v := ks.ReviewerID; v != nil {
+		builder.WriteString("reviewer_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reviewer_note=")
+	builder.WriteString(ks.ReviewerNote)
+	builder.WriteString(", ")
+	if v := ks.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KycSubmissions is a parsable slice of KycSubmission.
+type KycSubmissions []*KycSubmission
@@ -0,0 +1,430 @@
+// Code generated by ent, DO NOT EDIT.
+
+package kycsubmission
+
+import (
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldUserID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldStatus, v))
+}
+
+// ReviewerID applies equality check predicate on the "reviewer_id" field. It's identical to ReviewerIDEQ.
+func ReviewerID(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerNote applies equality check predicate on the "reviewer_note" field. It's identical to ReviewerNoteEQ.
+func ReviewerNote(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldReviewerNote, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLTE(FieldUserID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLTE(FieldStatus, v))
+}
+
+// ReviewerIDEQ applies the EQ predicate on the "reviewer_id" field.
+func ReviewerIDEQ(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDNEQ applies the NEQ predicate on the "reviewer_id" field.
+func ReviewerIDNEQ(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNEQ(FieldReviewerID, v))
+}
+
+// ReviewerIDIn applies the In predicate on the "reviewer_id" field.
+func ReviewerIDIn(vs ...int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDNotIn applies the NotIn predicate on the "reviewer_id" field.
+func ReviewerIDNotIn(vs ...int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotIn(FieldReviewerID, vs...))
+}
+
+// ReviewerIDGT applies the GT predicate on the "reviewer_id" field.
+func ReviewerIDGT(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGT(FieldReviewerID, v))
+}
+
+// ReviewerIDGTE applies the GTE predicate on the "reviewer_id" field.
+func ReviewerIDGTE(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGTE(FieldReviewerID, v))
+}
+
+// ReviewerIDLT applies the LT predicate on the "reviewer_id" field.
+func ReviewerIDLT(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLT(FieldReviewerID, v))
+}
+
+// ReviewerIDLTE applies the LTE predicate on the "reviewer_id" field.
+func ReviewerIDLTE(v int64) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLTE(FieldReviewerID, v))
+}
+
+// ReviewerIDIsNil applies the IsNil predicate on the "reviewer_id" field.
+func ReviewerIDIsNil() predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIsNull(FieldReviewerID))
+}
+
+// ReviewerIDNotNil applies the NotNil predicate on the "reviewer_id" field.
+func ReviewerIDNotNil() predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotNull(FieldReviewerID))
+}
+
+// ReviewerNoteEQ applies the EQ predicate on the "reviewer_note" field.
+func ReviewerNoteEQ(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldReviewerNote, v))
+}
+
+// ReviewerNoteNEQ applies the NEQ predicate on the "reviewer_note" field.
+func ReviewerNoteNEQ(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNEQ(FieldReviewerNote, v))
+}
+
+// ReviewerNoteIn applies the In predicate on the "reviewer_note" field.
+func ReviewerNoteIn(vs ...string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIn(FieldReviewerNote, vs...))
+}
+
+// ReviewerNoteNotIn applies the NotIn predicate on the "reviewer_note" field.
+func ReviewerNoteNotIn(vs ...string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotIn(FieldReviewerNote, vs...))
+}
+
+// ReviewerNoteGT applies the GT predicate on the "reviewer_note" field.
+func ReviewerNoteGT(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGT(FieldReviewerNote, v))
+}
+
+// ReviewerNoteGTE applies the GTE predicate on the "reviewer_note" field.
+func ReviewerNoteGTE(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGTE(FieldReviewerNote, v))
+}
+
+// ReviewerNoteLT applies the LT predicate on the "reviewer_note" field.
+func ReviewerNoteLT(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLT(FieldReviewerNote, v))
+}
+
+// ReviewerNoteLTE applies the LTE predicate on the "reviewer_note" field.
+func ReviewerNoteLTE(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLTE(FieldReviewerNote, v))
+}
+
+// ReviewerNoteContains applies the Contains predicate on the "reviewer_note" field.
+func ReviewerNoteContains(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldContains(FieldReviewerNote, v))
+}
+
+// ReviewerNoteHasPrefix applies the HasPrefix predicate on the "reviewer_note" field.
+func ReviewerNoteHasPrefix(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldHasPrefix(FieldReviewerNote, v))
+}
+
+// ReviewerNoteHasSuffix applies the HasSuffix predicate on the "reviewer_note" field.
+func ReviewerNoteHasSuffix(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldHasSuffix(FieldReviewerNote, v))
+}
+
+// ReviewerNoteEqualFold applies the EqualFold predicate on the "reviewer_note" field.
+func ReviewerNoteEqualFold(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEqualFold(FieldReviewerNote, v))
+}
+
+// ReviewerNoteContainsFold applies the ContainsFold predicate on the "reviewer_note" field.
+func ReviewerNoteContainsFold(v string) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldContainsFold(FieldReviewerNote, v))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.KycSubmission {
+	return predicate.KycSubmission(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KycSubmission) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KycSubmission) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KycSubmission) predicate.KycSubmission {
+	return predicate.KycSubmission(sql.NotPredicates(p))
+}
@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KycSubmissionCreate is the builder for creating a KycSubmission entity.
+type KycSubmissionCreate struct {
+	config
+	mutation *KycSubmissionMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ksc *KycSubmissionCreate) SetCreatedAt(t time.Time) *KycSubmissionCreate {
+	ksc.mutation.SetCreatedAt(t)
+	return ksc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ksc *KycSubmissionCreate) SetNillableCreatedAt(t *time.Time) *KycSubmissionCreate {
+	if t != nil {
+		ksc.SetCreatedAt(*t)
+	}
+	return ksc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ksc *KycSubmissionCreate) SetUpdatedAt(t time.Time) *KycSubmissionCreate {
+	ksc.mutation.SetUpdatedAt(t)
+	return ksc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ksc *KycSubmissionCreate) SetNillableUpdatedAt(t *time.Time) *KycSubmissionCreate {
+	if t != nil {
+		ksc.SetUpdatedAt(*t)
+	}
+	return ksc
+}
+
+// SetUserID sets the "user_id" field.
+func (ksc *KycSubmissionCreate) SetUserID(i int64) *KycSubmissionCreate {
+	ksc.mutation.SetUserID(i)
+	return ksc
+}
+
+// SetStatus sets the "status" field.
+func (ksc *KycSubmissionCreate) SetStatus(i int) *KycSubmissionCreate {
+	ksc.mutation.SetStatus(i)
+	return ksc
+}
+
+// SetDocuments sets the "documents" field.
+func (ksc *KycSubmissionCreate) SetDocuments(vd []vo.KycDocument) *KycSubmissionCreate {
+	ksc.mutation.SetDocuments(vd)
+	return ksc
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (ksc *KycSubmissionCreate) SetReviewerID(i int64) *KycSubmissionCreate {
+	ksc.mutation.SetReviewerID(i)
+	return ksc
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (ksc *KycSubmissionCreate) SetNillableReviewerID(i *int64) *KycSubmissionCreate {
+	if i != nil {
+		ksc.SetReviewerID(*i)
+	}
+	return ksc
+}
+
+// SetReviewerNote sets the "reviewer_note" field.
+func (ksc *KycSubmissionCreate) SetReviewerNote(s string) *KycSubmissionCreate {
+	ksc.mutation.SetReviewerNote(s)
+	return ksc
+}
+
+// SetNillableReviewerNote sets the "reviewer_note" field if the given value is not nil.
+func (ksc *KycSubmissionCreate) SetNillableReviewerNote(s *string) *KycSubmissionCreate {
+	if s != nil {
+		ksc.SetReviewerNote(*s)
+	}
+	return ksc
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ksc *KycSubmissionCreate) SetReviewedAt(t time.Time) *KycSubmissionCreate {
+	ksc.mutation.SetReviewedAt(t)
+	return ksc
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ksc *KycSubmissionCreate) SetNillableReviewedAt(t *time.Time) *KycSubmissionCreate {
+	if t != nil {
+		ksc.SetReviewedAt(*t)
+	}
+	return ksc
+}
+
+// Mutation returns the KycSubmissionMutation object of the builder.
+func (ksc *KycSubmissionCreate) Mutation() *KycSubmissionMutation {
+	return ksc.mutation
+}
+
+// Save creates the KycSubmission in the database.
+func (ksc *KycSubmissionCreate) Save(ctx context.Context) (*KycSubmission, error) {
+	ksc.defaults()
+	return withHooks(ctx, ksc.sqlSave, ksc.mutation, ksc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ksc *KycSubmissionCreate) SaveX(ctx context.Context) *KycSubmission {
+	v, err := ksc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ksc *KycSubmissionCreate) Exec(ctx context.Context) error {
+	_, err := ksc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ksc *KycSubmissionCreate) ExecX(ctx context.Context) {
+	if err := ksc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ksc *KycSubmissionCreate) defaults() {
+	if _, ok := ksc.mutation.CreatedAt(); !ok {
+		v := kycsubmission.DefaultCreatedAt()
+		ksc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ksc.mutation.UpdatedAt(); !ok {
+		v := kycsubmission.DefaultUpdatedAt()
+		ksc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := ksc.mutation.ReviewerNote(); !ok {
+		v := kycsubmission.DefaultReviewerNote
+		ksc.mutation.SetReviewerNote(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ksc *KycSubmissionCreate) check() error {
+	if _, ok := ksc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KycSubmission.created_at"`)}
+	}
+	if _, ok := ksc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "KycSubmission.updated_at"`)}
+	}
+	if _, ok := ksc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "KycSubmission.user_id"`)}
+	}
+	if _, ok := ksc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "KycSubmission.status"`)}
+	}
+	if _, ok := ksc.mutation.Documents(); !ok {
+		return &ValidationError{Name: "documents", err: errors.New(`ent: missing required field "KycSubmission.documents"`)}
+	}
+	if _, ok := ksc.mutation.ReviewerNote(); !ok {
+		return &ValidationError{Name: "reviewer_note", err: errors.New(`ent: missing required field "KycSubmission.reviewer_note"`)}
+	}
+	return nil
+}
+
+func (ksc *KycSubmissionCreate) sqlSave(ctx context.Context) (*KycSubmission, error) {
+	if err := ksc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ksc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ksc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ksc.mutation.id = &_node.ID
+	ksc.mutation.done = true
+	return _node, nil
+}
+
+func (ksc *KycSubmissionCreate) createSpec() (*KycSubmission, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KycSubmission{config: ksc.config}
+		_spec = sqlgraph.NewCreateSpec(kycsubmission.Table, sqlgraph.NewFieldSpec(kycsubmission.FieldID, field.TypeInt))
+	)
+	if value, ok := ksc.mutation.CreatedAt(); ok {
+		_spec.SetField(kycsubmission.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ksc.mutation.UpdatedAt(); ok {
+		_spec.SetField(kycsubmission.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := ksc.mutation.UserID(); ok {
+		_spec.SetField(kycsubmission.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := ksc.mutation.Status(); ok {
+		_spec.SetField(kycsubmission.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := ksc.mutation.Documents(); ok {
+		_spec.SetField(kycsubmission.FieldDocuments, field.TypeJSON, value)
+		_node.Documents = value
+	}
+	if value, ok := ksc.mutation.ReviewerID(); ok {
+		_spec.SetField(kycsubmission.FieldReviewerID, field.TypeInt64, value)
+		_node.ReviewerID = &value
+	}
+	if value, ok := ksc.mutation.ReviewerNote(); ok {
+		_spec.SetField(kycsubmission.FieldReviewerNote, field.TypeString, value)
+		_node.ReviewerNote = value
+	}
+	if value, ok := ksc.mutation.ReviewedAt(); ok {
+		_spec.SetField(kycsubmission.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	return _node, _spec
+}
+
+// KycSubmissionCreateBulk is the builder for creating many KycSubmission entities in bulk.
+type KycSubmissionCreateBulk struct {
+	config
+	err      error
+	builders []*KycSubmissionCreate
+}
+
+// Save creates the KycSubmission entities in the database.
+func (kscb *KycSubmissionCreateBulk) Save(ctx context.Context) ([]*KycSubmission, error) {
+	if kscb.err != nil {
+		return nil, kscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(kscb.builders))
+	nodes := make([]*KycSubmission, len(kscb.builders))
+	mutators := make([]Mutator, len(kscb.builders))
+	for i := range kscb.builders {
+		func(i int, root context.Context) {
+			builder := kscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KycSubmissionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, kscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, kscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, kscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (kscb *KycSubmissionCreateBulk) SaveX(ctx context.Context) []*KycSubmission {
+	v, err := kscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (kscb *KycSubmissionCreateBulk) Exec(ctx context.Context) error {
+	_, err := kscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (kscb *KycSubmissionCreateBulk) ExecX(ctx context.Context) {
+	if err := kscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KycSubmissionDelete is the builder for deleting a KycSubmission entity.
+type KycSubmissionDelete struct {
+	config
+	hooks    []Hook
+	mutation *KycSubmissionMutation
+}
+
+// Where appends a list predicates to the KycSubmissionDelete builder.
+func (ksd *KycSubmissionDelete) Where(ps ...predicate.KycSubmission) *KycSubmissionDelete {
+	ksd.mutation.Where(ps...)
+	return ksd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ksd *KycSubmissionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ksd.sqlExec, ksd.mutation, ksd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ksd *KycSubmissionDelete) ExecX(ctx context.Context) int {
+	n, err := ksd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ksd *KycSubmissionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(kycsubmission.Table, sqlgraph.NewFieldSpec(kycsubmission.FieldID, field.TypeInt))
+	if ps := ksd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ksd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ksd.mutation.done = true
+	return affected, err
+}
+
+// KycSubmissionDeleteOne is the builder for deleting a single KycSubmission entity.
+type KycSubmissionDeleteOne struct {
+	ksd *KycSubmissionDelete
+}
+
+// Where appends a list predicates to the KycSubmissionDelete builder.
+func (ksdo *KycSubmissionDeleteOne) Where(ps ...predicate.KycSubmission) *KycSubmissionDeleteOne {
+	ksdo.ksd.mutation.Where(ps...)
+	return ksdo
+}
+
+// Exec executes the deletion query.
+func (ksdo *KycSubmissionDeleteOne) Exec(ctx context.Context) error {
+	n, err := ksdo.ksd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{kycsubmission.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ksdo *KycSubmissionDeleteOne) ExecX(ctx context.Context) {
+	if err := ksdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
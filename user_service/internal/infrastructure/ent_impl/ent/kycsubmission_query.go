@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KycSubmissionQuery is the builder for querying KycSubmission entities.
+type KycSubmissionQuery struct {
+	config
+	ctx        *QueryContext
+	order      []kycsubmission.OrderOption
+	inters     []Interceptor
+	predicates []predicate.KycSubmission
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the KycSubmissionQuery builder.
+func (ksq *KycSubmissionQuery) Where(ps ...predicate.KycSubmission) *KycSubmissionQuery {
+	ksq.predicates = append(ksq.predicates, ps...)
+	return ksq
+}
+
+// Limit the number of records to be returned by this query.
+func (ksq *KycSubmissionQuery) Limit(limit int) *KycSubmissionQuery {
+	ksq.ctx.Limit = &limit
+	return ksq
+}
+
+// Offset to start from.
+func (ksq *KycSubmissionQuery) Offset(offset int) *KycSubmissionQuery {
+	ksq.ctx.Offset = &offset
+	return ksq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ksq *KycSubmissionQuery) Unique(unique bool) *KycSubmissionQuery {
+	ksq.ctx.Unique = &unique
+	return ksq
+}
+
+// Order specifies how the records should be ordered.
+func (ksq *KycSubmissionQuery) Order(o ...kycsubmission.OrderOption) *KycSubmissionQuery {
+	ksq.order = append(ksq.order, o...)
+	return ksq
+}
+
+// First returns the first KycSubmission entity from the query.
+// Returns a *NotFoundError when no KycSubmission was found.
+func (ksq *KycSubmissionQuery) First(ctx context.Context) (*KycSubmission, error) {
+	nodes, err := ksq.Limit(1).All(setContextOp(ctx, ksq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{kycsubmission.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ksq *KycSubmissionQuery) FirstX(ctx context.Context) *KycSubmission {
+	node, err := ksq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first KycSubmission ID from the query.
+// Returns a *NotFoundError when no KycSubmission ID was found.
+func (ksq *KycSubmissionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ksq.Limit(1).IDs(setContextOp(ctx, ksq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{kycsubmission.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ksq *KycSubmissionQuery) FirstIDX(ctx context.Context) int {
+	id, err := ksq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single KycSubmission entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one KycSubmission entity is found.
+// Returns a *NotFoundError when no KycSubmission entities are found.
+func (ksq *KycSubmissionQuery) Only(ctx context.Context) (*KycSubmission, error) {
+	nodes, err := ksq.Limit(2).All(setContextOp(ctx, ksq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{kycsubmission.Label}
+	default:
+		return nil, &NotSingularError{kycsubmission.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ksq *KycSubmissionQuery) OnlyX(ctx context.Context) *KycSubmission {
+	node, err := ksq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only KycSubmission ID in the query.
+// Returns a *NotSingularError when more than one KycSubmission ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ksq *KycSubmissionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ksq.Limit(2).IDs(setContextOp(ctx, ksq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{kycsubmission.Label}
+	default:
+		err = &NotSingularError{kycsubmission.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ksq *KycSubmissionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ksq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of KycSubmissions.
+func (ksq *KycSubmissionQuery) All(ctx context.Context) ([]*KycSubmission, error) {
+	ctx = setContextOp(ctx, ksq.ctx, ent.OpQueryAll)
+	if err := ksq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*KycSubmission, *KycSubmissionQuery]()
+	return withInterceptors[[]*KycSubmission](ctx, ksq, qr, ksq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ksq *KycSubmissionQuery) AllX(ctx context.Context) []*KycSubmission {
+	nodes, err := ksq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of KycSubmission IDs.
+func (ksq *KycSubmissionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ksq.ctx.Unique == nil && ksq.path != nil {
+		ksq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ksq.ctx, ent.OpQueryIDs)
+	if err = ksq.Select(kycsubmission.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ksq *KycSubmissionQuery) IDsX(ctx context.Context) []int {
+	ids, err := ksq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ksq *KycSubmissionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ksq.ctx, ent.OpQueryCount)
+	if err := ksq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ksq, querierCount[*KycSubmissionQuery](), ksq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ksq *KycSubmissionQuery) CountX(ctx context.Context) int {
+	count, err := ksq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ksq *KycSubmissionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ksq.ctx, ent.OpQueryExist)
+	switch _, err := ksq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ksq *KycSubmissionQuery) ExistX(ctx context.Context) bool {
+	exist, err := ksq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the KycSubmissionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ksq *KycSubmissionQuery) Clone() *KycSubmissionQuery {
+	if ksq == nil {
+		return nil
+	}
+	return &KycSubmissionQuery{
+		config:     ksq.config,
+		ctx:        ksq.ctx.Clone(),
+		order:      append([]kycsubmission.OrderOption{}, ksq.order...),
+		inters:     append([]Interceptor{}, ksq.inters...),
+		predicates: append([]predicate.KycSubmission{}, ksq.predicates...),
+		// clone intermediate query.
+		sql:  ksq.sql.Clone(),
+		path: ksq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.KycSubmission.Query().
+//		GroupBy(kycsubmission.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ksq *KycSubmissionQuery) GroupBy(field string, fields ...string) *KycSubmissionGroupBy {
+	ksq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &KycSubmissionGroupBy{build: ksq}
+	grbuild.flds = &ksq.ctx.Fields
+	grbuild.label = kycsubmission.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.KycSubmission.Query().
+//		Select(kycsubmission.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (ksq *KycSubmissionQuery) Select(fields ...string) *KycSubmissionSelect {
+	ksq.ctx.Fields = append(ksq.ctx.Fields, fields...)
+	sbuild := &KycSubmissionSelect{KycSubmissionQuery: ksq}
+	sbuild.label = kycsubmission.Label
+	sbuild.flds, sbuild.scan = &ksq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a KycSubmissionSelect configured with the given aggregations.
+func (ksq *KycSubmissionQuery) Aggregate(fns ...AggregateFunc) *KycSubmissionSelect {
+	return ksq.Select().Aggregate(fns...)
+}
+
+func (ksq *KycSubmissionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ksq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ksq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ksq.ctx.Fields {
+		if !kycsubmission.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ksq.path != nil {
+		prev, err := ksq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ksq.sql = prev
+	}
+	return nil
+}
+
+func (ksq *KycSubmissionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*KycSubmission, error) {
+	var (
+		nodes = []*KycSubmission{}
+		_spec = ksq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*KycSubmission).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &KycSubmission{config: ksq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ksq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (ksq *KycSubmissionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ksq.querySpec()
+	_spec.Node.Columns = ksq.ctx.Fields
+	if len(ksq.ctx.Fields) > 0 {
+		_spec.Unique = ksq.ctx.Unique != nil && *ksq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ksq.driver, _spec)
+}
+
+func (ksq *KycSubmissionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(kycsubmission.Table, kycsubmission.Columns, sqlgraph.NewFieldSpec(kycsubmission.FieldID, field.TypeInt))
+	_spec.From = ksq.sql
+	if unique := ksq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ksq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ksq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kycsubmission.FieldID)
+		for i := range fields {
+			if fields[i] != kycsubmission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := ksq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ksq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ksq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ksq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ksq *KycSubmissionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ksq.driver.Dialect())
+	t1 := builder.Table(kycsubmission.Table)
+	columns := ksq.ctx.Fields
+	if len(columns) == 0 {
+		columns = kycsubmission.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ksq.sql != nil {
+		selector = ksq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ksq.ctx.Unique != nil && *ksq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ksq.predicates {
+		p(selector)
+	}
+	for _, p := range ksq.order {
+		p(selector)
+	}
+	if offset := ksq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ksq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// KycSubmissionGroupBy is the group-by builder for KycSubmission entities.
+type KycSubmissionGroupBy struct {
+	selector
+	build *KycSubmissionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ksgb *KycSubmissionGroupBy) Aggregate(fns ...AggregateFunc) *KycSubmissionGroupBy {
+	ksgb.fns = append(ksgb.fns, fns...)
+	return ksgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ksgb *KycSubmissionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ksgb.build.ctx, ent.OpQueryGroupBy)
+	if err := ksgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KycSubmissionQuery, *KycSubmissionGroupBy](ctx, ksgb.build, ksgb, ksgb.build.inters, v)
+}
+
+func (ksgb *KycSubmissionGroupBy) sqlScan(ctx context.Context, root *KycSubmissionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ksgb.fns))
+	for _, fn := range ksgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ksgb.flds)+len(ksgb.fns))
+		for _, f := range *ksgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ksgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ksgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// KycSubmissionSelect is the builder for selecting fields of KycSubmission entities.
+type KycSubmissionSelect struct {
+	*KycSubmissionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (kss *KycSubmissionSelect) Aggregate(fns ...AggregateFunc) *KycSubmissionSelect {
+	kss.fns = append(kss.fns, fns...)
+	return kss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (kss *KycSubmissionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, kss.ctx, ent.OpQuerySelect)
+	if err := kss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*KycSubmissionQuery, *KycSubmissionSelect](ctx, kss.KycSubmissionQuery, kss, kss.inters, v)
+}
+
+func (kss *KycSubmissionSelect) sqlScan(ctx context.Context, root *KycSubmissionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(kss.fns))
+	for _, fn := range kss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*kss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := kss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
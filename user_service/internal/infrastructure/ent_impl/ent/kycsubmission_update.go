@@ -0,0 +1,424 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// KycSubmissionUpdate is the builder for updating KycSubmission entities.
+type KycSubmissionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KycSubmissionMutation
+}
+
+// Where appends a list predicates to the KycSubmissionUpdate builder.
+func (ksu *KycSubmissionUpdate) Where(ps ...predicate.KycSubmission) *KycSubmissionUpdate {
+	ksu.mutation.Where(ps...)
+	return ksu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ksu *KycSubmissionUpdate) SetUpdatedAt(t time.Time) *KycSubmissionUpdate {
+	ksu.mutation.SetUpdatedAt(t)
+	return ksu
+}
+
+// SetStatus sets the "status" field.
+func (ksu *KycSubmissionUpdate) SetStatus(i int) *KycSubmissionUpdate {
+	ksu.mutation.ResetStatus()
+	ksu.mutation.SetStatus(i)
+	return ksu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ksu *KycSubmissionUpdate) SetNillableStatus(i *int) *KycSubmissionUpdate {
+	if i != nil {
+		ksu.SetStatus(*i)
+	}
+	return ksu
+}
+
+// AddStatus adds i to the "status" field.
+func (ksu *KycSubmissionUpdate) AddStatus(i int) *KycSubmissionUpdate {
+	ksu.mutation.AddStatus(i)
+	return ksu
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (ksu *KycSubmissionUpdate) SetReviewerID(i int64) *KycSubmissionUpdate {
+	ksu.mutation.ResetReviewerID()
+	ksu.mutation.SetReviewerID(i)
+	return ksu
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (ksu *KycSubmissionUpdate) SetNillableReviewerID(i *int64) *KycSubmissionUpdate {
+	if i != nil {
+		ksu.SetReviewerID(*i)
+	}
+	return ksu
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (ksu *KycSubmissionUpdate) AddReviewerID(i int64) *KycSubmissionUpdate {
+	ksu.mutation.AddReviewerID(i)
+	return ksu
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (ksu *KycSubmissionUpdate) ClearReviewerID() *KycSubmissionUpdate {
+	ksu.mutation.ClearReviewerID()
+	return ksu
+}
+
+// SetReviewerNote sets the "reviewer_note" field.
+func (ksu *KycSubmissionUpdate) SetReviewerNote(s string) *KycSubmissionUpdate {
+	ksu.mutation.SetReviewerNote(s)
+	return ksu
+}
+
+// SetNillableReviewerNote sets the "reviewer_note" field if the given value is not nil.
+func (ksu *KycSubmissionUpdate) SetNillableReviewerNote(s *string) *KycSubmissionUpdate {
+	if s != nil {
+		ksu.SetReviewerNote(*s)
+	}
+	return ksu
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ksu *KycSubmissionUpdate) SetReviewedAt(t time.Time) *KycSubmissionUpdate {
+	ksu.mutation.SetReviewedAt(t)
+	return ksu
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ksu *KycSubmissionUpdate) SetNillableReviewedAt(t *time.Time) *KycSubmissionUpdate {
+	if t != nil {
+		ksu.SetReviewedAt(*t)
+	}
+	return ksu
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (ksu *KycSubmissionUpdate) ClearReviewedAt() *KycSubmissionUpdate {
+	ksu.mutation.ClearReviewedAt()
+	return ksu
+}
+
+// Mutation returns the KycSubmissionMutation object of the builder.
+func (ksu *KycSubmissionUpdate) Mutation() *KycSubmissionMutation {
+	return ksu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ksu *KycSubmissionUpdate) Save(ctx context.Context) (int, error) {
+	ksu.defaults()
+	return withHooks(ctx, ksu.sqlSave, ksu.mutation, ksu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ksu *KycSubmissionUpdate) SaveX(ctx context.Context) int {
+	affected, err := ksu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ksu *KycSubmissionUpdate) Exec(ctx context.Context) error {
+	_, err := ksu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ksu *KycSubmissionUpdate) ExecX(ctx context.Context) {
+	if err := ksu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ksu *KycSubmissionUpdate) defaults() {
+	if _, ok := ksu.mutation.UpdatedAt(); !ok {
+		v := kycsubmission.UpdateDefaultUpdatedAt()
+		ksu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (ksu *KycSubmissionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(kycsubmission.Table, kycsubmission.Columns, sqlgraph.NewFieldSpec(kycsubmission.FieldID, field.TypeInt))
+	if ps := ksu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ksu.mutation.UpdatedAt(); ok {
+		_spec.SetField(kycsubmission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ksu.mutation.Status(); ok {
+		_spec.SetField(kycsubmission.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := ksu.mutation.AddedStatus(); ok {
+		_spec.AddField(kycsubmission.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := ksu.mutation.ReviewerID(); ok {
+		_spec.SetField(kycsubmission.FieldReviewerID, field.TypeInt64, value)
+	}
+	if value, ok := ksu.mutation.AddedReviewerID(); ok {
+		_spec.AddField(kycsubmission.FieldReviewerID, field.TypeInt64, value)
+	}
+	if ksu.mutation.ReviewerIDCleared() {
+		_spec.ClearField(kycsubmission.FieldReviewerID, field.TypeInt64)
+	}
+	if value, ok := ksu.mutation.ReviewerNote(); ok {
+		_spec.SetField(kycsubmission.FieldReviewerNote, field.TypeString, value)
+	}
+	if value, ok := ksu.mutation.ReviewedAt(); ok {
+		_spec.SetField(kycsubmission.FieldReviewedAt, field.TypeTime, value)
+	}
+	if ksu.mutation.ReviewedAtCleared() {
+		_spec.ClearField(kycsubmission.FieldReviewedAt, field.TypeTime)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ksu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kycsubmission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ksu.mutation.done = true
+	return n, nil
+}
+
+// KycSubmissionUpdateOne is the builder for updating a single KycSubmission entity.
+type KycSubmissionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KycSubmissionMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ksuo *KycSubmissionUpdateOne) SetUpdatedAt(t time.Time) *KycSubmissionUpdateOne {
+	ksuo.mutation.SetUpdatedAt(t)
+	return ksuo
+}
+
+// SetStatus sets the "status" field.
+func (ksuo *KycSubmissionUpdateOne) SetStatus(i int) *KycSubmissionUpdateOne {
+	ksuo.mutation.ResetStatus()
+	ksuo.mutation.SetStatus(i)
+	return ksuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ksuo *KycSubmissionUpdateOne) SetNillableStatus(i *int) *KycSubmissionUpdateOne {
+	if i != nil {
+		ksuo.SetStatus(*i)
+	}
+	return ksuo
+}
+
+// AddStatus adds i to the "status" field.
+func (ksuo *KycSubmissionUpdateOne) AddStatus(i int) *KycSubmissionUpdateOne {
+	ksuo.mutation.AddStatus(i)
+	return ksuo
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (ksuo *KycSubmissionUpdateOne) SetReviewerID(i int64) *KycSubmissionUpdateOne {
+	ksuo.mutation.ResetReviewerID()
+	ksuo.mutation.SetReviewerID(i)
+	return ksuo
+}
+
+// SetNillableReviewerID sets the "reviewer_id" field if the given value is not nil.
+func (ksuo *KycSubmissionUpdateOne) SetNillableReviewerID(i *int64) *KycSubmissionUpdateOne {
+	if i != nil {
+		ksuo.SetReviewerID(*i)
+	}
+	return ksuo
+}
+
+// AddReviewerID adds i to the "reviewer_id" field.
+func (ksuo *KycSubmissionUpdateOne) AddReviewerID(i int64) *KycSubmissionUpdateOne {
+	ksuo.mutation.AddReviewerID(i)
+	return ksuo
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (ksuo *KycSubmissionUpdateOne) ClearReviewerID() *KycSubmissionUpdateOne {
+	ksuo.mutation.ClearReviewerID()
+	return ksuo
+}
+
+// SetReviewerNote sets the "reviewer_note" field.
+func (ksuo *KycSubmissionUpdateOne) SetReviewerNote(s string) *KycSubmissionUpdateOne {
+	ksuo.mutation.SetReviewerNote(s)
+	return ksuo
+}
+
+// SetNillableReviewerNote sets the "reviewer_note" field if the given value is not nil.
+func (ksuo *KycSubmissionUpdateOne) SetNillableReviewerNote(s *string) *KycSubmissionUpdateOne {
+	if s != nil {
+		ksuo.SetReviewerNote(*s)
+	}
+	return ksuo
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (ksuo *KycSubmissionUpdateOne) SetReviewedAt(t time.Time) *KycSubmissionUpdateOne {
+	ksuo.mutation.SetReviewedAt(t)
+	return ksuo
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (ksuo *KycSubmissionUpdateOne) SetNillableReviewedAt(t *time.Time) *KycSubmissionUpdateOne {
+	if t != nil {
+		ksuo.SetReviewedAt(*t)
+	}
+	return ksuo
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (ksuo *KycSubmissionUpdateOne) ClearReviewedAt() *KycSubmissionUpdateOne {
+	ksuo.mutation.ClearReviewedAt()
+	return ksuo
+}
+
+// Mutation returns the KycSubmissionMutation object of the builder.
+func (ksuo *KycSubmissionUpdateOne) Mutation() *KycSubmissionMutation {
+	return ksuo.mutation
+}
+
+// Where appends a list predicates to the KycSubmissionUpdate builder.
+func (ksuo *KycSubmissionUpdateOne) Where(ps ...predicate.KycSubmission) *KycSubmissionUpdateOne {
+	ksuo.mutation.Where(ps...)
+	return ksuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ksuo *KycSubmissionUpdateOne) Select(field string, fields ...string) *KycSubmissionUpdateOne {
+	ksuo.fields = append([]string{field}, fields...)
+	return ksuo
+}
+
+// Save executes the query and returns the updated KycSubmission entity.
+func (ksuo *KycSubmissionUpdateOne) Save(ctx context.Context) (*KycSubmission, error) {
+	ksuo.defaults()
+	return withHooks(ctx, ksuo.sqlSave, ksuo.mutation, ksuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ksuo *KycSubmissionUpdateOne) SaveX(ctx context.Context) *KycSubmission {
+	node, err := ksuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ksuo *KycSubmissionUpdateOne) Exec(ctx context.Context) error {
+	_, err := ksuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ksuo *KycSubmissionUpdateOne) ExecX(ctx context.Context) {
+	if err := ksuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ksuo *KycSubmissionUpdateOne) defaults() {
+	if _, ok := ksuo.mutation.UpdatedAt(); !ok {
+		v := kycsubmission.UpdateDefaultUpdatedAt()
+		ksuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (ksuo *KycSubmissionUpdateOne) sqlSave(ctx context.Context) (_node *KycSubmission, err error) {
+	_spec := sqlgraph.NewUpdateSpec(kycsubmission.Table, kycsubmission.Columns, sqlgraph.NewFieldSpec(kycsubmission.FieldID, field.TypeInt))
+	id, ok := ksuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KycSubmission.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ksuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, kycsubmission.FieldID)
+		for _, f := range fields {
+			if !kycsubmission.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != kycsubmission.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ksuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ksuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(kycsubmission.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := ksuo.mutation.Status(); ok {
+		_spec.SetField(kycsubmission.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := ksuo.mutation.AddedStatus(); ok {
+		_spec.AddField(kycsubmission.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := ksuo.mutation.ReviewerID(); ok {
+		_spec.SetField(kycsubmission.FieldReviewerID, field.TypeInt64, value)
+	}
+	if value, ok := ksuo.mutation.AddedReviewerID(); ok {
+		_spec.AddField(kycsubmission.FieldReviewerID, field.TypeInt64, value)
+	}
+	if ksuo.mutation.ReviewerIDCleared() {
+		_spec.ClearField(kycsubmission.FieldReviewerID, field.TypeInt64)
+	}
+	if value, ok := ksuo.mutation.ReviewerNote(); ok {
+		_spec.SetField(kycsubmission.FieldReviewerNote, field.TypeString, value)
+	}
+	if value, ok := ksuo.mutation.ReviewedAt(); ok {
+		_spec.SetField(kycsubmission.FieldReviewedAt, field.TypeTime, value)
+	}
+	if ksuo.mutation.ReviewedAtCleared() {
+		_spec.ClearField(kycsubmission.FieldReviewedAt, field.TypeTime)
+	}
+	_node = &KycSubmission{config: ksuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ksuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{kycsubmission.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ksuo.mutation.done = true
+	return _node, nil
+}
@@ -9,6 +9,79 @@ import (
 )
 
 var (
+	// KycSubmissionsColumns holds the columns for the "kyc_submissions" table.
+	KycSubmissionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "status", Type: field.TypeInt, Comment: "pkg/enum/kyc_status"},
+		{Name: "documents", Type: field.TypeJSON, Comment: "The object keys of the documents in the storage"},
+		{Name: "reviewer_id", Type: field.TypeInt64, Nullable: true},
+		{Name: "reviewer_note", Type: field.TypeString, Default: ""},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+	}
+	// KycSubmissionsTable holds the schema information for the "kyc_submissions" table.
+	KycSubmissionsTable = &schema.Table{
+		Name:       "kyc_submissions",
+		Comment:    "KYC submissions of the players and their reviews",
+		Columns:    KycSubmissionsColumns,
+		PrimaryKey: []*schema.Column{KycSubmissionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "kycsubmission_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{KycSubmissionsColumns[3]},
+			},
+			{
+				Name:    "kycsubmission_status",
+				Unique:  false,
+				Columns: []*schema.Column{KycSubmissionsColumns[4]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeString, Unique: true, Comment: "The consumers dedupe the events by it"},
+		{Name: "aggregate_type", Type: field.TypeString},
+		{Name: "aggregate_id", Type: field.TypeString},
+		{Name: "event_type", Type: field.TypeString, Comment: "The routing key of the event"},
+		{Name: "event_version", Type: field.TypeInt, Comment: "The version of the payload schema of the event type"},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "published_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Comment: "The failed publishing attempts", Default: 0},
+		{Name: "locked_until", Type: field.TypeTime, Nullable: true, Comment: "Claimed by a relay or waiting for the retry until then"},
+		{Name: "last_error", Type: field.TypeString, Default: ""},
+		{Name: "parked_at", Type: field.TypeTime, Nullable: true, Comment: "Not retried after the max attempts, the later events of the aggregate wait until it's resolved"},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Comment:    "The domain events written in the transactions of the changes, published by the outbox relay",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[0]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "published_at IS NULL",
+				},
+			},
+			{
+				Name:    "outboxevent_aggregate_type_aggregate_id_id",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[4], OutboxEventsColumns[5], OutboxEventsColumns[0]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "published_at IS NULL",
+				},
+			},
+		},
+	}
 	// ProfilesColumns holds the columns for the "profiles" table.
 	ProfilesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -43,12 +116,77 @@ var (
 			},
 		},
 	}
+	// ProfileAttributesColumns holds the columns for the "profile_attributes" table.
+	ProfileAttributesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "key", Type: field.TypeInt, Unique: true, Comment: "The key of the profiles, starts from 1000"},
+		{Name: "name", Type: field.TypeString, Unique: true},
+		{Name: "type", Type: field.TypeInt, Comment: "pkg/enum/attribute_type"},
+		{Name: "rules", Type: field.TypeJSON},
+		{Name: "is_unique", Type: field.TypeBool, Default: false},
+		{Name: "pii_class", Type: field.TypeInt, Comment: "pkg/enum/pii_class"},
+		{Name: "visible_to", Type: field.TypeJSON, Comment: "The ids of pkg/enum/client_type"},
+	}
+	// ProfileAttributesTable holds the schema information for the "profile_attributes" table.
+	ProfileAttributesTable = &schema.Table{
+		Name:       "profile_attributes",
+		Comment:    "Profile attributes defined at runtime, the built-in ones are declared in the code",
+		Columns:    ProfileAttributesColumns,
+		PrimaryKey: []*schema.Column{ProfileAttributesColumns[0]},
+	}
+	// RegistrationSagasColumns holds the columns for the "registration_sagas" table.
+	RegistrationSagasColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "client_id", Type: field.TypeInt64},
+		{Name: "idempotency_key", Type: field.TypeString, Comment: "Sent by the client, the retries of the registration share the key"},
+		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "account", Type: field.TypeString},
+		{Name: "email", Type: field.TypeString, Default: ""},
+		{Name: "country_code", Type: field.TypeString, Default: ""},
+		{Name: "mobile_number", Type: field.TypeString, Default: ""},
+		{Name: "oauth_provider", Type: field.TypeInt, Comment: "pkg/enum/oauth_provider, 0 for the registration without the oauth account", Default: 0},
+		{Name: "open_id", Type: field.TypeString, Comment: "The openID linked to the user when the profile is created", Default: ""},
+		{Name: "status", Type: field.TypeInt, Comment: "pkg/enum/registration_status"},
+		{Name: "err_code", Type: field.TypeInt, Comment: "The cus_err code of the failed registration", Default: 0},
+		{Name: "err_message", Type: field.TypeString, Default: ""},
+	}
+	// RegistrationSagasTable holds the schema information for the "registration_sagas" table.
+	RegistrationSagasTable = &schema.Table{
+		Name:       "registration_sagas",
+		Comment:    "The steps of the registrations across the auth service and the user service",
+		Columns:    RegistrationSagasColumns,
+		PrimaryKey: []*schema.Column{RegistrationSagasColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "registrationsaga_client_id_idempotency_key",
+				Unique:  true,
+				Columns: []*schema.Column{RegistrationSagasColumns[3], RegistrationSagasColumns[4]},
+			},
+			{
+				Name:    "registrationsaga_status_updated_at",
+				Unique:  false,
+				Columns: []*schema.Column{RegistrationSagasColumns[12], RegistrationSagasColumns[2]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		KycSubmissionsTable,
+		OutboxEventsTable,
 		ProfilesTable,
+		ProfileAttributesTable,
+		RegistrationSagasTable,
 	}
 )
 
 func init() {
+	KycSubmissionsTable.Annotation = &entsql.Annotation{}
+	OutboxEventsTable.Annotation = &entsql.Annotation{}
 	ProfilesTable.Annotation = &entsql.Annotation{}
+	ProfileAttributesTable.Annotation = &entsql.Annotation{}
+	RegistrationSagasTable.Annotation = &entsql.Annotation{}
 }
@@ -6,8 +6,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/outboxevent"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profile"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
 	"sync"
 	"time"
 
@@ -24,40 +29,48 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeProfile = "Profile"
+	TypeKycSubmission    = "KycSubmission"
+	TypeOutboxEvent      = "OutboxEvent"
+	TypeProfile          = "Profile"
+	TypeProfileAttribute = "ProfileAttribute"
+	TypeRegistrationSaga = "RegistrationSaga"
 )
 
-// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
-type ProfileMutation struct {
+// KycSubmissionMutation represents an operation that mutates the KycSubmission nodes in the graph.
+type KycSubmissionMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	created_at    *time.Time
-	updated_at    *time.Time
-	user_id       *int
-	adduser_id    *int
-	key           *int
-	addkey        *int
-	value         *string
-	is_active     *bool
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*Profile, error)
-	predicates    []predicate.Profile
+	op              Op
+	typ             string
+	id              *int
+	created_at      *time.Time
+	updated_at      *time.Time
+	user_id         *int64
+	adduser_id      *int64
+	status          *int
+	addstatus       *int
+	documents       *[]vo.KycDocument
+	appenddocuments []vo.KycDocument
+	reviewer_id     *int64
+	addreviewer_id  *int64
+	reviewer_note   *string
+	reviewed_at     *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*KycSubmission, error)
+	predicates      []predicate.KycSubmission
 }
 
-var _ ent.Mutation = (*ProfileMutation)(nil)
+var _ ent.Mutation = (*KycSubmissionMutation)(nil)
 
-// profileOption allows management of the mutation configuration using functional options.
-type profileOption func(*ProfileMutation)
+// kycsubmissionOption allows management of the mutation configuration using functional options.
+type kycsubmissionOption func(*KycSubmissionMutation)
 
-// newProfileMutation creates new mutation for the Profile entity.
-func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
-	m := &ProfileMutation{
+// newKycSubmissionMutation creates new mutation for the KycSubmission entity.
+func newKycSubmissionMutation(c config, op Op, opts ...kycsubmissionOption) *KycSubmissionMutation {
+	m := &KycSubmissionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProfile,
+		typ:           TypeKycSubmission,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -66,20 +79,20 @@ func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation
 	return m
 }
 
-// withProfileID sets the ID field of the mutation.
-func withProfileID(id int) profileOption {
-	return func(m *ProfileMutation) {
+// withKycSubmissionID sets the ID field of the mutation.
+func withKycSubmissionID(id int) kycsubmissionOption {
+	return func(m *KycSubmissionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Profile
+			value *KycSubmission
 		)
-		m.oldValue = func(ctx context.Context) (*Profile, error) {
+		m.oldValue = func(ctx context.Context) (*KycSubmission, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Profile.Get(ctx, id)
+					value, err = m.Client().KycSubmission.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -88,10 +101,10 @@ func withProfileID(id int) profileOption {
 	}
 }
 
-// withProfile sets the old Profile of the mutation.
-func withProfile(node *Profile) profileOption {
-	return func(m *ProfileMutation) {
-		m.oldValue = func(context.Context) (*Profile, error) {
+// withKycSubmission sets the old KycSubmission of the mutation.
+func withKycSubmission(node *KycSubmission) kycsubmissionOption {
+	return func(m *KycSubmissionMutation) {
+		m.oldValue = func(context.Context) (*KycSubmission, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -100,7 +113,7 @@ func withProfile(node *Profile) profileOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProfileMutation) Client() *Client {
+func (m KycSubmissionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -108,7 +121,7 @@ func (m ProfileMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProfileMutation) Tx() (*Tx, error) {
+func (m KycSubmissionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -119,7 +132,7 @@ func (m ProfileMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProfileMutation) ID() (id int, exists bool) {
+func (m *KycSubmissionMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -130,7 +143,7 @@ func (m *ProfileMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProfileMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *KycSubmissionMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -139,19 +152,19 @@ func (m *ProfileMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().KycSubmission.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *ProfileMutation) SetCreatedAt(t time.Time) {
+func (m *KycSubmissionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *KycSubmissionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -159,10 +172,10 @@ func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the KycSubmission entity.
+// If the KycSubmission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KycSubmissionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -177,17 +190,17 @@ func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProfileMutation) ResetCreatedAt() {
+func (m *KycSubmissionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *ProfileMutation) SetUpdatedAt(t time.Time) {
+func (m *KycSubmissionMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *KycSubmissionMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -195,10 +208,10 @@ func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the KycSubmission entity.
+// If the KycSubmission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *KycSubmissionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -213,18 +226,18 @@ func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *ProfileMutation) ResetUpdatedAt() {
+func (m *KycSubmissionMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
 // SetUserID sets the "user_id" field.
-func (m *ProfileMutation) SetUserID(i int) {
+func (m *KycSubmissionMutation) SetUserID(i int64) {
 	m.user_id = &i
 	m.adduser_id = nil
 }
 
 // UserID returns the value of the "user_id" field in the mutation.
-func (m *ProfileMutation) UserID() (r int, exists bool) {
+func (m *KycSubmissionMutation) UserID() (r int64, exists bool) {
 	v := m.user_id
 	if v == nil {
 		return
@@ -232,10 +245,10 @@ func (m *ProfileMutation) UserID() (r int, exists bool) {
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the KycSubmission entity.
+// If the KycSubmission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldUserID(ctx context.Context) (v int, err error) {
+func (m *KycSubmissionMutation) OldUserID(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
@@ -250,7 +263,7 @@ func (m *ProfileMutation) OldUserID(ctx context.Context) (v int, err error) {
 }
 
 // AddUserID adds i to the "user_id" field.
-func (m *ProfileMutation) AddUserID(i int) {
+func (m *KycSubmissionMutation) AddUserID(i int64) {
 	if m.adduser_id != nil {
 		*m.adduser_id += i
 	} else {
@@ -259,7 +272,7 @@ func (m *ProfileMutation) AddUserID(i int) {
 }
 
 // AddedUserID returns the value that was added to the "user_id" field in this mutation.
-func (m *ProfileMutation) AddedUserID() (r int, exists bool) {
+func (m *KycSubmissionMutation) AddedUserID() (r int64, exists bool) {
 	v := m.adduser_id
 	if v == nil {
 		return
@@ -268,148 +281,282 @@ func (m *ProfileMutation) AddedUserID() (r int, exists bool) {
 }
 
 // ResetUserID resets all changes to the "user_id" field.
-func (m *ProfileMutation) ResetUserID() {
+func (m *KycSubmissionMutation) ResetUserID() {
 	m.user_id = nil
 	m.adduser_id = nil
 }
 
-// SetKey sets the "key" field.
-func (m *ProfileMutation) SetKey(i int) {
-	m.key = &i
-	m.addkey = nil
+// SetStatus sets the "status" field.
+func (m *KycSubmissionMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
 }
 
-// Key returns the value of the "key" field in the mutation.
-func (m *ProfileMutation) Key() (r int, exists bool) {
-	v := m.key
+// Status returns the value of the "status" field in the mutation.
+func (m *KycSubmissionMutation) Status() (r int, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldKey returns the old "key" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the KycSubmission entity.
+// If the KycSubmission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldKey(ctx context.Context) (v int, err error) {
+func (m *KycSubmissionMutation) OldStatus(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldKey requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Key, nil
+	return oldValue.Status, nil
 }
 
-// AddKey adds i to the "key" field.
-func (m *ProfileMutation) AddKey(i int) {
-	if m.addkey != nil {
-		*m.addkey += i
+// AddStatus adds i to the "status" field.
+func (m *KycSubmissionMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
 	} else {
-		m.addkey = &i
+		m.addstatus = &i
 	}
 }
 
-// AddedKey returns the value that was added to the "key" field in this mutation.
-func (m *ProfileMutation) AddedKey() (r int, exists bool) {
-	v := m.addkey
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *KycSubmissionMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetKey resets all changes to the "key" field.
-func (m *ProfileMutation) ResetKey() {
-	m.key = nil
-	m.addkey = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *KycSubmissionMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
 }
 
-// SetValue sets the "value" field.
-func (m *ProfileMutation) SetValue(s string) {
-	m.value = &s
+// SetDocuments sets the "documents" field.
+func (m *KycSubmissionMutation) SetDocuments(vd []vo.KycDocument) {
+	m.documents = &vd
+	m.appenddocuments = nil
 }
 
-// Value returns the value of the "value" field in the mutation.
-func (m *ProfileMutation) Value() (r string, exists bool) {
-	v := m.value
+// Documents returns the value of the "documents" field in the mutation.
+func (m *KycSubmissionMutation) Documents() (r []vo.KycDocument, exists bool) {
+	v := m.documents
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldValue returns the old "value" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldDocuments returns the old "documents" field's value of the KycSubmission entity.
+// If the KycSubmission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldValue(ctx context.Context) (v string, err error) {
+func (m *KycSubmissionMutation) OldDocuments(ctx context.Context) (v []vo.KycDocument, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldDocuments is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldValue requires an ID field in the mutation")
+		return v, errors.New("OldDocuments requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldDocuments: %w", err)
 	}
-	return oldValue.Value, nil
+	return oldValue.Documents, nil
 }
 
-// ResetValue resets all changes to the "value" field.
-func (m *ProfileMutation) ResetValue() {
-	m.value = nil
+// AppendDocuments adds vd to the "documents" field.
+func (m *KycSubmissionMutation) AppendDocuments(vd []vo.KycDocument) {
+	m.appenddocuments = append(m.appenddocuments, vd...)
 }
 
-// SetIsActive sets the "is_active" field.
-func (m *ProfileMutation) SetIsActive(b bool) {
-	m.is_active = &b
+// AppendedDocuments returns the list of values that were appended to the "documents" field in this mutation.
+func (m *KycSubmissionMutation) AppendedDocuments() ([]vo.KycDocument, bool) {
+	if len(m.appenddocuments) == 0 {
+		return nil, false
+	}
+	return m.appenddocuments, true
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *ProfileMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
+// ResetDocuments resets all changes to the "documents" field.
+func (m *KycSubmissionMutation) ResetDocuments() {
+	m.documents = nil
+	m.appenddocuments = nil
+}
+
+// SetReviewerID sets the "reviewer_id" field.
+func (m *KycSubmissionMutation) SetReviewerID(i int64) {
+	m.reviewer_id = &i
+	m.addreviewer_id = nil
+}
+
+// ReviewerID returns the value of the "reviewer_id" field in the mutation.
+func (m *KycSubmissionMutation) ReviewerID() (r int64, exists bool) {
+	v := m.reviewer_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsActive returns the old "is_active" field's value of the Profile entity.
-// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// OldReviewerID returns the old "reviewer_id" field's value of the KycSubmission entity.
+// If the KycSubmission object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProfileMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+func (m *KycSubmissionMutation) OldReviewerID(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldReviewerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+		return v, errors.New("OldReviewerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldReviewerID: %w", err)
 	}
-	return oldValue.IsActive, nil
+	return oldValue.ReviewerID, nil
 }
 
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *ProfileMutation) ResetIsActive() {
-	m.is_active = nil
+// AddReviewerID adds i to the "reviewer_id" field.
+func (m *KycSubmissionMutation) AddReviewerID(i int64) {
+	if m.addreviewer_id != nil {
+		*m.addreviewer_id += i
+	} else {
+		m.addreviewer_id = &i
+	}
 }
 
-// Where appends a list predicates to the ProfileMutation builder.
-func (m *ProfileMutation) Where(ps ...predicate.Profile) {
+// AddedReviewerID returns the value that was added to the "reviewer_id" field in this mutation.
+func (m *KycSubmissionMutation) AddedReviewerID() (r int64, exists bool) {
+	v := m.addreviewer_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearReviewerID clears the value of the "reviewer_id" field.
+func (m *KycSubmissionMutation) ClearReviewerID() {
+	m.reviewer_id = nil
+	m.addreviewer_id = nil
+	m.clearedFields[kycsubmission.FieldReviewerID] = struct{}{}
+}
+
+// ReviewerIDCleared returns if the "reviewer_id" field was cleared in this mutation.
+func (m *KycSubmissionMutation) ReviewerIDCleared() bool {
+	_, ok := m.clearedFields[kycsubmission.FieldReviewerID]
+	return ok
+}
+
+// ResetReviewerID resets all changes to the "reviewer_id" field.
+func (m *KycSubmissionMutation) ResetReviewerID() {
+	m.reviewer_id = nil
+	m.addreviewer_id = nil
+	delete(m.clearedFields, kycsubmission.FieldReviewerID)
+}
+
+// SetReviewerNote sets the "reviewer_note" field.
+func (m *KycSubmissionMutation) SetReviewerNote(s string) {
+	m.reviewer_note = &s
+}
+
+// ReviewerNote returns the value of the "reviewer_note" field in the mutation.
+func (m *KycSubmissionMutation) ReviewerNote() (r string, exists bool) {
+	v := m.reviewer_note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewerNote returns the old "reviewer_note" field's value of the KycSubmission entity.
+// If the KycSubmission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KycSubmissionMutation) OldReviewerNote(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewerNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewerNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewerNote: %w", err)
+	}
+	return oldValue.ReviewerNote, nil
+}
+
+// ResetReviewerNote resets all changes to the "reviewer_note" field.
+func (m *KycSubmissionMutation) ResetReviewerNote() {
+	m.reviewer_note = nil
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *KycSubmissionMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *KycSubmissionMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the KycSubmission entity.
+// If the KycSubmission object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KycSubmissionMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *KycSubmissionMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[kycsubmission.FieldReviewedAt] = struct{}{}
+}
+
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *KycSubmissionMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[kycsubmission.FieldReviewedAt]
+	return ok
+}
+
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *KycSubmissionMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, kycsubmission.FieldReviewedAt)
+}
+
+// Where appends a list predicates to the KycSubmissionMutation builder.
+func (m *KycSubmissionMutation) Where(ps ...predicate.KycSubmission) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
+// WhereP appends storage-level predicates to the KycSubmissionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Profile, len(ps))
+func (m *KycSubmissionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KycSubmission, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -417,42 +564,48 @@ func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProfileMutation) Op() Op {
+func (m *KycSubmissionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProfileMutation) SetOp(op Op) {
+func (m *KycSubmissionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Profile).
-func (m *ProfileMutation) Type() string {
+// Type returns the node type of this mutation (KycSubmission).
+func (m *KycSubmissionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProfileMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *KycSubmissionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
 	if m.created_at != nil {
-		fields = append(fields, profile.FieldCreatedAt)
+		fields = append(fields, kycsubmission.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, profile.FieldUpdatedAt)
+		fields = append(fields, kycsubmission.FieldUpdatedAt)
 	}
 	if m.user_id != nil {
-		fields = append(fields, profile.FieldUserID)
+		fields = append(fields, kycsubmission.FieldUserID)
 	}
-	if m.key != nil {
-		fields = append(fields, profile.FieldKey)
+	if m.status != nil {
+		fields = append(fields, kycsubmission.FieldStatus)
 	}
-	if m.value != nil {
-		fields = append(fields, profile.FieldValue)
+	if m.documents != nil {
+		fields = append(fields, kycsubmission.FieldDocuments)
 	}
-	if m.is_active != nil {
-		fields = append(fields, profile.FieldIsActive)
+	if m.reviewer_id != nil {
+		fields = append(fields, kycsubmission.FieldReviewerID)
+	}
+	if m.reviewer_note != nil {
+		fields = append(fields, kycsubmission.FieldReviewerNote)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, kycsubmission.FieldReviewedAt)
 	}
 	return fields
 }
@@ -460,20 +613,24 @@ func (m *ProfileMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
+func (m *KycSubmissionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case profile.FieldCreatedAt:
+	case kycsubmission.FieldCreatedAt:
 		return m.CreatedAt()
-	case profile.FieldUpdatedAt:
+	case kycsubmission.FieldUpdatedAt:
 		return m.UpdatedAt()
-	case profile.FieldUserID:
+	case kycsubmission.FieldUserID:
 		return m.UserID()
-	case profile.FieldKey:
-		return m.Key()
-	case profile.FieldValue:
-		return m.Value()
-	case profile.FieldIsActive:
-		return m.IsActive()
+	case kycsubmission.FieldStatus:
+		return m.Status()
+	case kycsubmission.FieldDocuments:
+		return m.Documents()
+	case kycsubmission.FieldReviewerID:
+		return m.ReviewerID()
+	case kycsubmission.FieldReviewerNote:
+		return m.ReviewerNote()
+	case kycsubmission.FieldReviewedAt:
+		return m.ReviewedAt()
 	}
 	return nil, false
 }
@@ -481,84 +638,105 @@ func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *KycSubmissionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case profile.FieldCreatedAt:
+	case kycsubmission.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case profile.FieldUpdatedAt:
+	case kycsubmission.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
-	case profile.FieldUserID:
+	case kycsubmission.FieldUserID:
 		return m.OldUserID(ctx)
-	case profile.FieldKey:
-		return m.OldKey(ctx)
-	case profile.FieldValue:
-		return m.OldValue(ctx)
-	case profile.FieldIsActive:
-		return m.OldIsActive(ctx)
+	case kycsubmission.FieldStatus:
+		return m.OldStatus(ctx)
+	case kycsubmission.FieldDocuments:
+		return m.OldDocuments(ctx)
+	case kycsubmission.FieldReviewerID:
+		return m.OldReviewerID(ctx)
+	case kycsubmission.FieldReviewerNote:
+		return m.OldReviewerNote(ctx)
+	case kycsubmission.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Profile field %s", name)
+	return nil, fmt.Errorf("unknown KycSubmission field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProfileMutation) SetField(name string, value ent.Value) error {
+func (m *KycSubmissionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case profile.FieldCreatedAt:
+	case kycsubmission.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case profile.FieldUpdatedAt:
+	case kycsubmission.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUpdatedAt(v)
 		return nil
-	case profile.FieldUserID:
-		v, ok := value.(int)
+	case kycsubmission.FieldUserID:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case profile.FieldKey:
+	case kycsubmission.FieldStatus:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetKey(v)
+		m.SetStatus(v)
 		return nil
-	case profile.FieldValue:
+	case kycsubmission.FieldDocuments:
+		v, ok := value.([]vo.KycDocument)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDocuments(v)
+		return nil
+	case kycsubmission.FieldReviewerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewerID(v)
+		return nil
+	case kycsubmission.FieldReviewerNote:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetValue(v)
+		m.SetReviewerNote(v)
 		return nil
-	case profile.FieldIsActive:
-		v, ok := value.(bool)
+	case kycsubmission.FieldReviewedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsActive(v)
+		m.SetReviewedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Profile field %s", name)
+	return fmt.Errorf("unknown KycSubmission field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProfileMutation) AddedFields() []string {
+func (m *KycSubmissionMutation) AddedFields() []string {
 	var fields []string
 	if m.adduser_id != nil {
-		fields = append(fields, profile.FieldUserID)
+		fields = append(fields, kycsubmission.FieldUserID)
 	}
-	if m.addkey != nil {
-		fields = append(fields, profile.FieldKey)
+	if m.addstatus != nil {
+		fields = append(fields, kycsubmission.FieldStatus)
+	}
+	if m.addreviewer_id != nil {
+		fields = append(fields, kycsubmission.FieldReviewerID)
 	}
 	return fields
 }
@@ -566,12 +744,1810 @@ func (m *ProfileMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
+func (m *KycSubmissionMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case profile.FieldUserID:
+	case kycsubmission.FieldUserID:
 		return m.AddedUserID()
-	case profile.FieldKey:
-		return m.AddedKey()
+	case kycsubmission.FieldStatus:
+		return m.AddedStatus()
+	case kycsubmission.FieldReviewerID:
+		return m.AddedReviewerID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KycSubmissionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case kycsubmission.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case kycsubmission.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case kycsubmission.FieldReviewerID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReviewerID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KycSubmission numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KycSubmissionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(kycsubmission.FieldReviewerID) {
+		fields = append(fields, kycsubmission.FieldReviewerID)
+	}
+	if m.FieldCleared(kycsubmission.FieldReviewedAt) {
+		fields = append(fields, kycsubmission.FieldReviewedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KycSubmissionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KycSubmissionMutation) ClearField(name string) error {
+	switch name {
+	case kycsubmission.FieldReviewerID:
+		m.ClearReviewerID()
+		return nil
+	case kycsubmission.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KycSubmission nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KycSubmissionMutation) ResetField(name string) error {
+	switch name {
+	case kycsubmission.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case kycsubmission.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case kycsubmission.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case kycsubmission.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case kycsubmission.FieldDocuments:
+		m.ResetDocuments()
+		return nil
+	case kycsubmission.FieldReviewerID:
+		m.ResetReviewerID()
+		return nil
+	case kycsubmission.FieldReviewerNote:
+		m.ResetReviewerNote()
+		return nil
+	case kycsubmission.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown KycSubmission field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KycSubmissionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KycSubmissionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KycSubmissionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KycSubmissionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KycSubmissionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KycSubmissionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KycSubmissionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KycSubmission unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KycSubmissionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KycSubmission edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	created_at       *time.Time
+	updated_at       *time.Time
+	event_id         *string
+	aggregate_type   *string
+	aggregate_id     *string
+	event_type       *string
+	event_version    *int
+	addevent_version *int
+	payload          *[]byte
+	published_at     *time.Time
+	attempts         *int
+	addattempts      *int
+	locked_until     *time.Time
+	last_error       *string
+	parked_at        *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*OutboxEvent, error)
+	predicates       []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id int) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OutboxEventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OutboxEventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OutboxEventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *OutboxEventMutation) SetEventID(s string) {
+	m.event_id = &s
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *OutboxEventMutation) EventID() (r string, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldEventID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *OutboxEventMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetAggregateType sets the "aggregate_type" field.
+func (m *OutboxEventMutation) SetAggregateType(s string) {
+	m.aggregate_type = &s
+}
+
+// AggregateType returns the value of the "aggregate_type" field in the mutation.
+func (m *OutboxEventMutation) AggregateType() (r string, exists bool) {
+	v := m.aggregate_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateType returns the old "aggregate_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAggregateType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateType: %w", err)
+	}
+	return oldValue.AggregateType, nil
+}
+
+// ResetAggregateType resets all changes to the "aggregate_type" field.
+func (m *OutboxEventMutation) ResetAggregateType() {
+	m.aggregate_type = nil
+}
+
+// SetAggregateID sets the "aggregate_id" field.
+func (m *OutboxEventMutation) SetAggregateID(s string) {
+	m.aggregate_id = &s
+}
+
+// AggregateID returns the value of the "aggregate_id" field in the mutation.
+func (m *OutboxEventMutation) AggregateID() (r string, exists bool) {
+	v := m.aggregate_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAggregateID returns the old "aggregate_id" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAggregateID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAggregateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAggregateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAggregateID: %w", err)
+	}
+	return oldValue.AggregateID, nil
+}
+
+// ResetAggregateID resets all changes to the "aggregate_id" field.
+func (m *OutboxEventMutation) ResetAggregateID() {
+	m.aggregate_id = nil
+}
+
+// SetEventType sets the "event_type" field.
+func (m *OutboxEventMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *OutboxEventMutation) EventType() (r string, exists bool) {
+	v := m.event_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventType returns the old "event_type" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldEventType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
+	}
+	return oldValue.EventType, nil
+}
+
+// ResetEventType resets all changes to the "event_type" field.
+func (m *OutboxEventMutation) ResetEventType() {
+	m.event_type = nil
+}
+
+// SetEventVersion sets the "event_version" field.
+func (m *OutboxEventMutation) SetEventVersion(i int) {
+	m.event_version = &i
+	m.addevent_version = nil
+}
+
+// EventVersion returns the value of the "event_version" field in the mutation.
+func (m *OutboxEventMutation) EventVersion() (r int, exists bool) {
+	v := m.event_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventVersion returns the old "event_version" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldEventVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventVersion: %w", err)
+	}
+	return oldValue.EventVersion, nil
+}
+
+// AddEventVersion adds i to the "event_version" field.
+func (m *OutboxEventMutation) AddEventVersion(i int) {
+	if m.addevent_version != nil {
+		*m.addevent_version += i
+	} else {
+		m.addevent_version = &i
+	}
+}
+
+// AddedEventVersion returns the value that was added to the "event_version" field in this mutation.
+func (m *OutboxEventMutation) AddedEventVersion() (r int, exists bool) {
+	v := m.addevent_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEventVersion resets all changes to the "event_version" field.
+func (m *OutboxEventMutation) ResetEventVersion() {
+	m.event_version = nil
+	m.addevent_version = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetPublishedAt sets the "published_at" field.
+func (m *OutboxEventMutation) SetPublishedAt(t time.Time) {
+	m.published_at = &t
+}
+
+// PublishedAt returns the value of the "published_at" field in the mutation.
+func (m *OutboxEventMutation) PublishedAt() (r time.Time, exists bool) {
+	v := m.published_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishedAt returns the old "published_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPublishedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishedAt: %w", err)
+	}
+	return oldValue.PublishedAt, nil
+}
+
+// ClearPublishedAt clears the value of the "published_at" field.
+func (m *OutboxEventMutation) ClearPublishedAt() {
+	m.published_at = nil
+	m.clearedFields[outboxevent.FieldPublishedAt] = struct{}{}
+}
+
+// PublishedAtCleared returns if the "published_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) PublishedAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldPublishedAt]
+	return ok
+}
+
+// ResetPublishedAt resets all changes to the "published_at" field.
+func (m *OutboxEventMutation) ResetPublishedAt() {
+	m.published_at = nil
+	delete(m.clearedFields, outboxevent.FieldPublishedAt)
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLockedUntil sets the "locked_until" field.
+func (m *OutboxEventMutation) SetLockedUntil(t time.Time) {
+	m.locked_until = &t
+}
+
+// LockedUntil returns the value of the "locked_until" field in the mutation.
+func (m *OutboxEventMutation) LockedUntil() (r time.Time, exists bool) {
+	v := m.locked_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLockedUntil returns the old "locked_until" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLockedUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLockedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLockedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLockedUntil: %w", err)
+	}
+	return oldValue.LockedUntil, nil
+}
+
+// ClearLockedUntil clears the value of the "locked_until" field.
+func (m *OutboxEventMutation) ClearLockedUntil() {
+	m.locked_until = nil
+	m.clearedFields[outboxevent.FieldLockedUntil] = struct{}{}
+}
+
+// LockedUntilCleared returns if the "locked_until" field was cleared in this mutation.
+func (m *OutboxEventMutation) LockedUntilCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLockedUntil]
+	return ok
+}
+
+// ResetLockedUntil resets all changes to the "locked_until" field.
+func (m *OutboxEventMutation) ResetLockedUntil() {
+	m.locked_until = nil
+	delete(m.clearedFields, outboxevent.FieldLockedUntil)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+}
+
+// SetParkedAt sets the "parked_at" field.
+func (m *OutboxEventMutation) SetParkedAt(t time.Time) {
+	m.parked_at = &t
+}
+
+// ParkedAt returns the value of the "parked_at" field in the mutation.
+func (m *OutboxEventMutation) ParkedAt() (r time.Time, exists bool) {
+	v := m.parked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParkedAt returns the old "parked_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldParkedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParkedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParkedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParkedAt: %w", err)
+	}
+	return oldValue.ParkedAt, nil
+}
+
+// ClearParkedAt clears the value of the "parked_at" field.
+func (m *OutboxEventMutation) ClearParkedAt() {
+	m.parked_at = nil
+	m.clearedFields[outboxevent.FieldParkedAt] = struct{}{}
+}
+
+// ParkedAtCleared returns if the "parked_at" field was cleared in this mutation.
+func (m *OutboxEventMutation) ParkedAtCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldParkedAt]
+	return ok
+}
+
+// ResetParkedAt resets all changes to the "parked_at" field.
+func (m *OutboxEventMutation) ResetParkedAt() {
+	m.parked_at = nil
+	delete(m.clearedFields, outboxevent.FieldParkedAt)
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.created_at != nil {
+		fields = append(fields, outboxevent.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, outboxevent.FieldUpdatedAt)
+	}
+	if m.event_id != nil {
+		fields = append(fields, outboxevent.FieldEventID)
+	}
+	if m.aggregate_type != nil {
+		fields = append(fields, outboxevent.FieldAggregateType)
+	}
+	if m.aggregate_id != nil {
+		fields = append(fields, outboxevent.FieldAggregateID)
+	}
+	if m.event_type != nil {
+		fields = append(fields, outboxevent.FieldEventType)
+	}
+	if m.event_version != nil {
+		fields = append(fields, outboxevent.FieldEventVersion)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.published_at != nil {
+		fields = append(fields, outboxevent.FieldPublishedAt)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.locked_until != nil {
+		fields = append(fields, outboxevent.FieldLockedUntil)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.parked_at != nil {
+		fields = append(fields, outboxevent.FieldParkedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		return m.CreatedAt()
+	case outboxevent.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case outboxevent.FieldEventID:
+		return m.EventID()
+	case outboxevent.FieldAggregateType:
+		return m.AggregateType()
+	case outboxevent.FieldAggregateID:
+		return m.AggregateID()
+	case outboxevent.FieldEventType:
+		return m.EventType()
+	case outboxevent.FieldEventVersion:
+		return m.EventVersion()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldPublishedAt:
+		return m.PublishedAt()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldLockedUntil:
+		return m.LockedUntil()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	case outboxevent.FieldParkedAt:
+		return m.ParkedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case outboxevent.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case outboxevent.FieldEventID:
+		return m.OldEventID(ctx)
+	case outboxevent.FieldAggregateType:
+		return m.OldAggregateType(ctx)
+	case outboxevent.FieldAggregateID:
+		return m.OldAggregateID(ctx)
+	case outboxevent.FieldEventType:
+		return m.OldEventType(ctx)
+	case outboxevent.FieldEventVersion:
+		return m.OldEventVersion(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldPublishedAt:
+		return m.OldPublishedAt(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldLockedUntil:
+		return m.OldLockedUntil(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxevent.FieldParkedAt:
+		return m.OldParkedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case outboxevent.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case outboxevent.FieldEventID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case outboxevent.FieldAggregateType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateType(v)
+		return nil
+	case outboxevent.FieldAggregateID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAggregateID(v)
+		return nil
+	case outboxevent.FieldEventType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventType(v)
+		return nil
+	case outboxevent.FieldEventVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventVersion(v)
+		return nil
+	case outboxevent.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldPublishedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishedAt(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldLockedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLockedUntil(v)
+		return nil
+	case outboxevent.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case outboxevent.FieldParkedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParkedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addevent_version != nil {
+		fields = append(fields, outboxevent.FieldEventVersion)
+	}
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldEventVersion:
+		return m.AddedEventVersion()
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldEventVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEventVersion(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldPublishedAt) {
+		fields = append(fields, outboxevent.FieldPublishedAt)
+	}
+	if m.FieldCleared(outboxevent.FieldLockedUntil) {
+		fields = append(fields, outboxevent.FieldLockedUntil)
+	}
+	if m.FieldCleared(outboxevent.FieldParkedAt) {
+		fields = append(fields, outboxevent.FieldParkedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldPublishedAt:
+		m.ClearPublishedAt()
+		return nil
+	case outboxevent.FieldLockedUntil:
+		m.ClearLockedUntil()
+		return nil
+	case outboxevent.FieldParkedAt:
+		m.ClearParkedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case outboxevent.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case outboxevent.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case outboxevent.FieldAggregateType:
+		m.ResetAggregateType()
+		return nil
+	case outboxevent.FieldAggregateID:
+		m.ResetAggregateID()
+		return nil
+	case outboxevent.FieldEventType:
+		m.ResetEventType()
+		return nil
+	case outboxevent.FieldEventVersion:
+		m.ResetEventVersion()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldPublishedAt:
+		m.ResetPublishedAt()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldLockedUntil:
+		m.ResetLockedUntil()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case outboxevent.FieldParkedAt:
+		m.ResetParkedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// ProfileMutation represents an operation that mutates the Profile nodes in the graph.
+type ProfileMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	user_id       *int
+	adduser_id    *int
+	key           *int
+	addkey        *int
+	value         *string
+	is_active     *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Profile, error)
+	predicates    []predicate.Profile
+}
+
+var _ ent.Mutation = (*ProfileMutation)(nil)
+
+// profileOption allows management of the mutation configuration using functional options.
+type profileOption func(*ProfileMutation)
+
+// newProfileMutation creates new mutation for the Profile entity.
+func newProfileMutation(c config, op Op, opts ...profileOption) *ProfileMutation {
+	m := &ProfileMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfile,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfileID sets the ID field of the mutation.
+func withProfileID(id int) profileOption {
+	return func(m *ProfileMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Profile
+		)
+		m.oldValue = func(ctx context.Context) (*Profile, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Profile.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfile sets the old Profile of the mutation.
+func withProfile(node *Profile) profileOption {
+	return func(m *ProfileMutation) {
+		m.oldValue = func(context.Context) (*Profile, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Profile.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfileMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfileMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfileMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ProfileMutation) SetUserID(i int) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ProfileMutation) UserID() (r int, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldUserID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *ProfileMutation) AddUserID(i int) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *ProfileMutation) AddedUserID() (r int, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ProfileMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetKey sets the "key" field.
+func (m *ProfileMutation) SetKey(i int) {
+	m.key = &i
+	m.addkey = nil
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ProfileMutation) Key() (r int, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldKey(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// AddKey adds i to the "key" field.
+func (m *ProfileMutation) AddKey(i int) {
+	if m.addkey != nil {
+		*m.addkey += i
+	} else {
+		m.addkey = &i
+	}
+}
+
+// AddedKey returns the value that was added to the "key" field in this mutation.
+func (m *ProfileMutation) AddedKey() (r int, exists bool) {
+	v := m.addkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ProfileMutation) ResetKey() {
+	m.key = nil
+	m.addkey = nil
+}
+
+// SetValue sets the "value" field.
+func (m *ProfileMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *ProfileMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *ProfileMutation) ResetValue() {
+	m.value = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *ProfileMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *ProfileMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the Profile entity.
+// If the Profile object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *ProfileMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// Where appends a list predicates to the ProfileMutation builder.
+func (m *ProfileMutation) Where(ps ...predicate.Profile) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Profile, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Profile).
+func (m *ProfileMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.created_at != nil {
+		fields = append(fields, profile.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profile.FieldUpdatedAt)
+	}
+	if m.user_id != nil {
+		fields = append(fields, profile.FieldUserID)
+	}
+	if m.key != nil {
+		fields = append(fields, profile.FieldKey)
+	}
+	if m.value != nil {
+		fields = append(fields, profile.FieldValue)
+	}
+	if m.is_active != nil {
+		fields = append(fields, profile.FieldIsActive)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profile.FieldCreatedAt:
+		return m.CreatedAt()
+	case profile.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case profile.FieldUserID:
+		return m.UserID()
+	case profile.FieldKey:
+		return m.Key()
+	case profile.FieldValue:
+		return m.Value()
+	case profile.FieldIsActive:
+		return m.IsActive()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profile.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profile.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case profile.FieldUserID:
+		return m.OldUserID(ctx)
+	case profile.FieldKey:
+		return m.OldKey(ctx)
+	case profile.FieldValue:
+		return m.OldValue(ctx)
+	case profile.FieldIsActive:
+		return m.OldIsActive(ctx)
+	}
+	return nil, fmt.Errorf("unknown Profile field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profile.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profile.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case profile.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case profile.FieldKey:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case profile.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	case profile.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Profile field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, profile.FieldUserID)
+	}
+	if m.addkey != nil {
+		fields = append(fields, profile.FieldKey)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case profile.FieldUserID:
+		return m.AddedUserID()
+	case profile.FieldKey:
+		return m.AddedKey()
 	}
 	return nil, false
 }
@@ -581,113 +2557,2185 @@ func (m *ProfileMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ProfileMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case profile.FieldUserID:
+	case profile.FieldUserID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case profile.FieldKey:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Profile numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Profile nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileMutation) ResetField(name string) error {
+	switch name {
+	case profile.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profile.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case profile.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case profile.FieldKey:
+		m.ResetKey()
+		return nil
+	case profile.FieldValue:
+		m.ResetValue()
+		return nil
+	case profile.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	}
+	return fmt.Errorf("unknown Profile field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Profile unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Profile edge %s", name)
+}
+
+// ProfileAttributeMutation represents an operation that mutates the ProfileAttribute nodes in the graph.
+type ProfileAttributeMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *int
+	created_at       *time.Time
+	updated_at       *time.Time
+	key              *int
+	addkey           *int
+	name             *string
+	_type            *int
+	add_type         *int
+	rules            *vo.AttributeRules
+	is_unique        *bool
+	pii_class        *int
+	addpii_class     *int
+	visible_to       *[]int
+	appendvisible_to []int
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*ProfileAttribute, error)
+	predicates       []predicate.ProfileAttribute
+}
+
+var _ ent.Mutation = (*ProfileAttributeMutation)(nil)
+
+// profileattributeOption allows management of the mutation configuration using functional options.
+type profileattributeOption func(*ProfileAttributeMutation)
+
+// newProfileAttributeMutation creates new mutation for the ProfileAttribute entity.
+func newProfileAttributeMutation(c config, op Op, opts ...profileattributeOption) *ProfileAttributeMutation {
+	m := &ProfileAttributeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProfileAttribute,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProfileAttributeID sets the ID field of the mutation.
+func withProfileAttributeID(id int) profileattributeOption {
+	return func(m *ProfileAttributeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProfileAttribute
+		)
+		m.oldValue = func(ctx context.Context) (*ProfileAttribute, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProfileAttribute.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProfileAttribute sets the old ProfileAttribute of the mutation.
+func withProfileAttribute(node *ProfileAttribute) profileattributeOption {
+	return func(m *ProfileAttributeMutation) {
+		m.oldValue = func(context.Context) (*ProfileAttribute, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProfileAttributeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProfileAttributeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProfileAttributeMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProfileAttributeMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProfileAttribute.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProfileAttributeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProfileAttributeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProfileAttributeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProfileAttributeMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProfileAttributeMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProfileAttributeMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetKey sets the "key" field.
+func (m *ProfileAttributeMutation) SetKey(i int) {
+	m.key = &i
+	m.addkey = nil
+}
+
+// Key returns the value of the "key" field in the mutation.
+func (m *ProfileAttributeMutation) Key() (r int, exists bool) {
+	v := m.key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKey returns the old "key" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldKey(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKey: %w", err)
+	}
+	return oldValue.Key, nil
+}
+
+// AddKey adds i to the "key" field.
+func (m *ProfileAttributeMutation) AddKey(i int) {
+	if m.addkey != nil {
+		*m.addkey += i
+	} else {
+		m.addkey = &i
+	}
+}
+
+// AddedKey returns the value that was added to the "key" field in this mutation.
+func (m *ProfileAttributeMutation) AddedKey() (r int, exists bool) {
+	v := m.addkey
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetKey resets all changes to the "key" field.
+func (m *ProfileAttributeMutation) ResetKey() {
+	m.key = nil
+	m.addkey = nil
+}
+
+// SetName sets the "name" field.
+func (m *ProfileAttributeMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *ProfileAttributeMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *ProfileAttributeMutation) ResetName() {
+	m.name = nil
+}
+
+// SetType sets the "type" field.
+func (m *ProfileAttributeMutation) SetType(i int) {
+	m._type = &i
+	m.add_type = nil
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *ProfileAttributeMutation) GetType() (r int, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldType(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// AddType adds i to the "type" field.
+func (m *ProfileAttributeMutation) AddType(i int) {
+	if m.add_type != nil {
+		*m.add_type += i
+	} else {
+		m.add_type = &i
+	}
+}
+
+// AddedType returns the value that was added to the "type" field in this mutation.
+func (m *ProfileAttributeMutation) AddedType() (r int, exists bool) {
+	v := m.add_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *ProfileAttributeMutation) ResetType() {
+	m._type = nil
+	m.add_type = nil
+}
+
+// SetRules sets the "rules" field.
+func (m *ProfileAttributeMutation) SetRules(vr vo.AttributeRules) {
+	m.rules = &vr
+}
+
+// Rules returns the value of the "rules" field in the mutation.
+func (m *ProfileAttributeMutation) Rules() (r vo.AttributeRules, exists bool) {
+	v := m.rules
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRules returns the old "rules" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldRules(ctx context.Context) (v vo.AttributeRules, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRules is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRules requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRules: %w", err)
+	}
+	return oldValue.Rules, nil
+}
+
+// ResetRules resets all changes to the "rules" field.
+func (m *ProfileAttributeMutation) ResetRules() {
+	m.rules = nil
+}
+
+// SetIsUnique sets the "is_unique" field.
+func (m *ProfileAttributeMutation) SetIsUnique(b bool) {
+	m.is_unique = &b
+}
+
+// IsUnique returns the value of the "is_unique" field in the mutation.
+func (m *ProfileAttributeMutation) IsUnique() (r bool, exists bool) {
+	v := m.is_unique
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsUnique returns the old "is_unique" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldIsUnique(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsUnique is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsUnique requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsUnique: %w", err)
+	}
+	return oldValue.IsUnique, nil
+}
+
+// ResetIsUnique resets all changes to the "is_unique" field.
+func (m *ProfileAttributeMutation) ResetIsUnique() {
+	m.is_unique = nil
+}
+
+// SetPiiClass sets the "pii_class" field.
+func (m *ProfileAttributeMutation) SetPiiClass(i int) {
+	m.pii_class = &i
+	m.addpii_class = nil
+}
+
+// PiiClass returns the value of the "pii_class" field in the mutation.
+func (m *ProfileAttributeMutation) PiiClass() (r int, exists bool) {
+	v := m.pii_class
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPiiClass returns the old "pii_class" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldPiiClass(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPiiClass is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPiiClass requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPiiClass: %w", err)
+	}
+	return oldValue.PiiClass, nil
+}
+
+// AddPiiClass adds i to the "pii_class" field.
+func (m *ProfileAttributeMutation) AddPiiClass(i int) {
+	if m.addpii_class != nil {
+		*m.addpii_class += i
+	} else {
+		m.addpii_class = &i
+	}
+}
+
+// AddedPiiClass returns the value that was added to the "pii_class" field in this mutation.
+func (m *ProfileAttributeMutation) AddedPiiClass() (r int, exists bool) {
+	v := m.addpii_class
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPiiClass resets all changes to the "pii_class" field.
+func (m *ProfileAttributeMutation) ResetPiiClass() {
+	m.pii_class = nil
+	m.addpii_class = nil
+}
+
+// SetVisibleTo sets the "visible_to" field.
+func (m *ProfileAttributeMutation) SetVisibleTo(i []int) {
+	m.visible_to = &i
+	m.appendvisible_to = nil
+}
+
+// VisibleTo returns the value of the "visible_to" field in the mutation.
+func (m *ProfileAttributeMutation) VisibleTo() (r []int, exists bool) {
+	v := m.visible_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVisibleTo returns the old "visible_to" field's value of the ProfileAttribute entity.
+// If the ProfileAttribute object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProfileAttributeMutation) OldVisibleTo(ctx context.Context) (v []int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVisibleTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVisibleTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVisibleTo: %w", err)
+	}
+	return oldValue.VisibleTo, nil
+}
+
+// AppendVisibleTo adds i to the "visible_to" field.
+func (m *ProfileAttributeMutation) AppendVisibleTo(i []int) {
+	m.appendvisible_to = append(m.appendvisible_to, i...)
+}
+
+// AppendedVisibleTo returns the list of values that were appended to the "visible_to" field in this mutation.
+func (m *ProfileAttributeMutation) AppendedVisibleTo() ([]int, bool) {
+	if len(m.appendvisible_to) == 0 {
+		return nil, false
+	}
+	return m.appendvisible_to, true
+}
+
+// ResetVisibleTo resets all changes to the "visible_to" field.
+func (m *ProfileAttributeMutation) ResetVisibleTo() {
+	m.visible_to = nil
+	m.appendvisible_to = nil
+}
+
+// Where appends a list predicates to the ProfileAttributeMutation builder.
+func (m *ProfileAttributeMutation) Where(ps ...predicate.ProfileAttribute) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProfileAttributeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProfileAttributeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProfileAttribute, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProfileAttributeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProfileAttributeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProfileAttribute).
+func (m *ProfileAttributeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProfileAttributeMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_at != nil {
+		fields = append(fields, profileattribute.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, profileattribute.FieldUpdatedAt)
+	}
+	if m.key != nil {
+		fields = append(fields, profileattribute.FieldKey)
+	}
+	if m.name != nil {
+		fields = append(fields, profileattribute.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, profileattribute.FieldType)
+	}
+	if m.rules != nil {
+		fields = append(fields, profileattribute.FieldRules)
+	}
+	if m.is_unique != nil {
+		fields = append(fields, profileattribute.FieldIsUnique)
+	}
+	if m.pii_class != nil {
+		fields = append(fields, profileattribute.FieldPiiClass)
+	}
+	if m.visible_to != nil {
+		fields = append(fields, profileattribute.FieldVisibleTo)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProfileAttributeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case profileattribute.FieldCreatedAt:
+		return m.CreatedAt()
+	case profileattribute.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case profileattribute.FieldKey:
+		return m.Key()
+	case profileattribute.FieldName:
+		return m.Name()
+	case profileattribute.FieldType:
+		return m.GetType()
+	case profileattribute.FieldRules:
+		return m.Rules()
+	case profileattribute.FieldIsUnique:
+		return m.IsUnique()
+	case profileattribute.FieldPiiClass:
+		return m.PiiClass()
+	case profileattribute.FieldVisibleTo:
+		return m.VisibleTo()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProfileAttributeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case profileattribute.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case profileattribute.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case profileattribute.FieldKey:
+		return m.OldKey(ctx)
+	case profileattribute.FieldName:
+		return m.OldName(ctx)
+	case profileattribute.FieldType:
+		return m.OldType(ctx)
+	case profileattribute.FieldRules:
+		return m.OldRules(ctx)
+	case profileattribute.FieldIsUnique:
+		return m.OldIsUnique(ctx)
+	case profileattribute.FieldPiiClass:
+		return m.OldPiiClass(ctx)
+	case profileattribute.FieldVisibleTo:
+		return m.OldVisibleTo(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProfileAttribute field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileAttributeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case profileattribute.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case profileattribute.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case profileattribute.FieldKey:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKey(v)
+		return nil
+	case profileattribute.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case profileattribute.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case profileattribute.FieldRules:
+		v, ok := value.(vo.AttributeRules)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRules(v)
+		return nil
+	case profileattribute.FieldIsUnique:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsUnique(v)
+		return nil
+	case profileattribute.FieldPiiClass:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPiiClass(v)
+		return nil
+	case profileattribute.FieldVisibleTo:
+		v, ok := value.([]int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVisibleTo(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileAttribute field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProfileAttributeMutation) AddedFields() []string {
+	var fields []string
+	if m.addkey != nil {
+		fields = append(fields, profileattribute.FieldKey)
+	}
+	if m.add_type != nil {
+		fields = append(fields, profileattribute.FieldType)
+	}
+	if m.addpii_class != nil {
+		fields = append(fields, profileattribute.FieldPiiClass)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProfileAttributeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case profileattribute.FieldKey:
+		return m.AddedKey()
+	case profileattribute.FieldType:
+		return m.AddedType()
+	case profileattribute.FieldPiiClass:
+		return m.AddedPiiClass()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProfileAttributeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case profileattribute.FieldKey:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddKey(v)
+		return nil
+	case profileattribute.FieldType:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddType(v)
+		return nil
+	case profileattribute.FieldPiiClass:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPiiClass(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileAttribute numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProfileAttributeMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProfileAttributeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProfileAttributeMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ProfileAttribute nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProfileAttributeMutation) ResetField(name string) error {
+	switch name {
+	case profileattribute.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case profileattribute.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case profileattribute.FieldKey:
+		m.ResetKey()
+		return nil
+	case profileattribute.FieldName:
+		m.ResetName()
+		return nil
+	case profileattribute.FieldType:
+		m.ResetType()
+		return nil
+	case profileattribute.FieldRules:
+		m.ResetRules()
+		return nil
+	case profileattribute.FieldIsUnique:
+		m.ResetIsUnique()
+		return nil
+	case profileattribute.FieldPiiClass:
+		m.ResetPiiClass()
+		return nil
+	case profileattribute.FieldVisibleTo:
+		m.ResetVisibleTo()
+		return nil
+	}
+	return fmt.Errorf("unknown ProfileAttribute field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProfileAttributeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProfileAttributeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProfileAttributeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProfileAttributeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProfileAttributeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProfileAttributeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProfileAttributeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProfileAttribute unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProfileAttributeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProfileAttribute edge %s", name)
+}
+
+// RegistrationSagaMutation represents an operation that mutates the RegistrationSaga nodes in the graph.
+type RegistrationSagaMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *int
+	created_at        *time.Time
+	updated_at        *time.Time
+	client_id         *int64
+	addclient_id      *int64
+	idempotency_key   *string
+	user_id           *int64
+	adduser_id        *int64
+	account           *string
+	email             *string
+	country_code      *string
+	mobile_number     *string
+	oauth_provider    *int
+	addoauth_provider *int
+	open_id           *string
+	status            *int
+	addstatus         *int
+	err_code          *int
+	adderr_code       *int
+	err_message       *string
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*RegistrationSaga, error)
+	predicates        []predicate.RegistrationSaga
+}
+
+var _ ent.Mutation = (*RegistrationSagaMutation)(nil)
+
+// registrationsagaOption allows management of the mutation configuration using functional options.
+type registrationsagaOption func(*RegistrationSagaMutation)
+
+// newRegistrationSagaMutation creates new mutation for the RegistrationSaga entity.
+func newRegistrationSagaMutation(c config, op Op, opts ...registrationsagaOption) *RegistrationSagaMutation {
+	m := &RegistrationSagaMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegistrationSaga,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegistrationSagaID sets the ID field of the mutation.
+func withRegistrationSagaID(id int) registrationsagaOption {
+	return func(m *RegistrationSagaMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RegistrationSaga
+		)
+		m.oldValue = func(ctx context.Context) (*RegistrationSaga, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RegistrationSaga.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegistrationSaga sets the old RegistrationSaga of the mutation.
+func withRegistrationSaga(node *RegistrationSaga) registrationsagaOption {
+	return func(m *RegistrationSagaMutation) {
+		m.oldValue = func(context.Context) (*RegistrationSaga, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegistrationSagaMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegistrationSagaMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegistrationSagaMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegistrationSagaMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RegistrationSaga.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RegistrationSagaMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RegistrationSagaMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RegistrationSagaMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RegistrationSagaMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RegistrationSagaMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RegistrationSagaMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetClientID sets the "client_id" field.
+func (m *RegistrationSagaMutation) SetClientID(i int64) {
+	m.client_id = &i
+	m.addclient_id = nil
+}
+
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *RegistrationSagaMutation) ClientID() (r int64, exists bool) {
+	v := m.client_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientID returns the old "client_id" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldClientID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+	}
+	return oldValue.ClientID, nil
+}
+
+// AddClientID adds i to the "client_id" field.
+func (m *RegistrationSagaMutation) AddClientID(i int64) {
+	if m.addclient_id != nil {
+		*m.addclient_id += i
+	} else {
+		m.addclient_id = &i
+	}
+}
+
+// AddedClientID returns the value that was added to the "client_id" field in this mutation.
+func (m *RegistrationSagaMutation) AddedClientID() (r int64, exists bool) {
+	v := m.addclient_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetClientID resets all changes to the "client_id" field.
+func (m *RegistrationSagaMutation) ResetClientID() {
+	m.client_id = nil
+	m.addclient_id = nil
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (m *RegistrationSagaMutation) SetIdempotencyKey(s string) {
+	m.idempotency_key = &s
+}
+
+// IdempotencyKey returns the value of the "idempotency_key" field in the mutation.
+func (m *RegistrationSagaMutation) IdempotencyKey() (r string, exists bool) {
+	v := m.idempotency_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIdempotencyKey returns the old "idempotency_key" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldIdempotencyKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIdempotencyKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIdempotencyKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIdempotencyKey: %w", err)
+	}
+	return oldValue.IdempotencyKey, nil
+}
+
+// ResetIdempotencyKey resets all changes to the "idempotency_key" field.
+func (m *RegistrationSagaMutation) ResetIdempotencyKey() {
+	m.idempotency_key = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RegistrationSagaMutation) SetUserID(i int64) {
+	m.user_id = &i
+	m.adduser_id = nil
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RegistrationSagaMutation) UserID() (r int64, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldUserID(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// AddUserID adds i to the "user_id" field.
+func (m *RegistrationSagaMutation) AddUserID(i int64) {
+	if m.adduser_id != nil {
+		*m.adduser_id += i
+	} else {
+		m.adduser_id = &i
+	}
+}
+
+// AddedUserID returns the value that was added to the "user_id" field in this mutation.
+func (m *RegistrationSagaMutation) AddedUserID() (r int64, exists bool) {
+	v := m.adduser_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RegistrationSagaMutation) ResetUserID() {
+	m.user_id = nil
+	m.adduser_id = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *RegistrationSagaMutation) SetAccount(s string) {
+	m.account = &s
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *RegistrationSagaMutation) Account() (r string, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldAccount(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *RegistrationSagaMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *RegistrationSagaMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *RegistrationSagaMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *RegistrationSagaMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetCountryCode sets the "country_code" field.
+func (m *RegistrationSagaMutation) SetCountryCode(s string) {
+	m.country_code = &s
+}
+
+// CountryCode returns the value of the "country_code" field in the mutation.
+func (m *RegistrationSagaMutation) CountryCode() (r string, exists bool) {
+	v := m.country_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountryCode returns the old "country_code" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldCountryCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountryCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountryCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountryCode: %w", err)
+	}
+	return oldValue.CountryCode, nil
+}
+
+// ResetCountryCode resets all changes to the "country_code" field.
+func (m *RegistrationSagaMutation) ResetCountryCode() {
+	m.country_code = nil
+}
+
+// SetMobileNumber sets the "mobile_number" field.
+func (m *RegistrationSagaMutation) SetMobileNumber(s string) {
+	m.mobile_number = &s
+}
+
+// MobileNumber returns the value of the "mobile_number" field in the mutation.
+func (m *RegistrationSagaMutation) MobileNumber() (r string, exists bool) {
+	v := m.mobile_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMobileNumber returns the old "mobile_number" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldMobileNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMobileNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMobileNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMobileNumber: %w", err)
+	}
+	return oldValue.MobileNumber, nil
+}
+
+// ResetMobileNumber resets all changes to the "mobile_number" field.
+func (m *RegistrationSagaMutation) ResetMobileNumber() {
+	m.mobile_number = nil
+}
+
+// SetOauthProvider sets the "oauth_provider" field.
+func (m *RegistrationSagaMutation) SetOauthProvider(i int) {
+	m.oauth_provider = &i
+	m.addoauth_provider = nil
+}
+
+// OauthProvider returns the value of the "oauth_provider" field in the mutation.
+func (m *RegistrationSagaMutation) OauthProvider() (r int, exists bool) {
+	v := m.oauth_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOauthProvider returns the old "oauth_provider" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldOauthProvider(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOauthProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOauthProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOauthProvider: %w", err)
+	}
+	return oldValue.OauthProvider, nil
+}
+
+// AddOauthProvider adds i to the "oauth_provider" field.
+func (m *RegistrationSagaMutation) AddOauthProvider(i int) {
+	if m.addoauth_provider != nil {
+		*m.addoauth_provider += i
+	} else {
+		m.addoauth_provider = &i
+	}
+}
+
+// AddedOauthProvider returns the value that was added to the "oauth_provider" field in this mutation.
+func (m *RegistrationSagaMutation) AddedOauthProvider() (r int, exists bool) {
+	v := m.addoauth_provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOauthProvider resets all changes to the "oauth_provider" field.
+func (m *RegistrationSagaMutation) ResetOauthProvider() {
+	m.oauth_provider = nil
+	m.addoauth_provider = nil
+}
+
+// SetOpenID sets the "open_id" field.
+func (m *RegistrationSagaMutation) SetOpenID(s string) {
+	m.open_id = &s
+}
+
+// OpenID returns the value of the "open_id" field in the mutation.
+func (m *RegistrationSagaMutation) OpenID() (r string, exists bool) {
+	v := m.open_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenID returns the old "open_id" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldOpenID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenID: %w", err)
+	}
+	return oldValue.OpenID, nil
+}
+
+// ResetOpenID resets all changes to the "open_id" field.
+func (m *RegistrationSagaMutation) ResetOpenID() {
+	m.open_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *RegistrationSagaMutation) SetStatus(i int) {
+	m.status = &i
+	m.addstatus = nil
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *RegistrationSagaMutation) Status() (r int, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldStatus(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// AddStatus adds i to the "status" field.
+func (m *RegistrationSagaMutation) AddStatus(i int) {
+	if m.addstatus != nil {
+		*m.addstatus += i
+	} else {
+		m.addstatus = &i
+	}
+}
+
+// AddedStatus returns the value that was added to the "status" field in this mutation.
+func (m *RegistrationSagaMutation) AddedStatus() (r int, exists bool) {
+	v := m.addstatus
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *RegistrationSagaMutation) ResetStatus() {
+	m.status = nil
+	m.addstatus = nil
+}
+
+// SetErrCode sets the "err_code" field.
+func (m *RegistrationSagaMutation) SetErrCode(i int) {
+	m.err_code = &i
+	m.adderr_code = nil
+}
+
+// ErrCode returns the value of the "err_code" field in the mutation.
+func (m *RegistrationSagaMutation) ErrCode() (r int, exists bool) {
+	v := m.err_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrCode returns the old "err_code" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldErrCode(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrCode: %w", err)
+	}
+	return oldValue.ErrCode, nil
+}
+
+// AddErrCode adds i to the "err_code" field.
+func (m *RegistrationSagaMutation) AddErrCode(i int) {
+	if m.adderr_code != nil {
+		*m.adderr_code += i
+	} else {
+		m.adderr_code = &i
+	}
+}
+
+// AddedErrCode returns the value that was added to the "err_code" field in this mutation.
+func (m *RegistrationSagaMutation) AddedErrCode() (r int, exists bool) {
+	v := m.adderr_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetErrCode resets all changes to the "err_code" field.
+func (m *RegistrationSagaMutation) ResetErrCode() {
+	m.err_code = nil
+	m.adderr_code = nil
+}
+
+// SetErrMessage sets the "err_message" field.
+func (m *RegistrationSagaMutation) SetErrMessage(s string) {
+	m.err_message = &s
+}
+
+// ErrMessage returns the value of the "err_message" field in the mutation.
+func (m *RegistrationSagaMutation) ErrMessage() (r string, exists bool) {
+	v := m.err_message
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrMessage returns the old "err_message" field's value of the RegistrationSaga entity.
+// If the RegistrationSaga object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationSagaMutation) OldErrMessage(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrMessage: %w", err)
+	}
+	return oldValue.ErrMessage, nil
+}
+
+// ResetErrMessage resets all changes to the "err_message" field.
+func (m *RegistrationSagaMutation) ResetErrMessage() {
+	m.err_message = nil
+}
+
+// Where appends a list predicates to the RegistrationSagaMutation builder.
+func (m *RegistrationSagaMutation) Where(ps ...predicate.RegistrationSaga) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegistrationSagaMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegistrationSagaMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RegistrationSaga, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegistrationSagaMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegistrationSagaMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RegistrationSaga).
+func (m *RegistrationSagaMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegistrationSagaMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.created_at != nil {
+		fields = append(fields, registrationsaga.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, registrationsaga.FieldUpdatedAt)
+	}
+	if m.client_id != nil {
+		fields = append(fields, registrationsaga.FieldClientID)
+	}
+	if m.idempotency_key != nil {
+		fields = append(fields, registrationsaga.FieldIdempotencyKey)
+	}
+	if m.user_id != nil {
+		fields = append(fields, registrationsaga.FieldUserID)
+	}
+	if m.account != nil {
+		fields = append(fields, registrationsaga.FieldAccount)
+	}
+	if m.email != nil {
+		fields = append(fields, registrationsaga.FieldEmail)
+	}
+	if m.country_code != nil {
+		fields = append(fields, registrationsaga.FieldCountryCode)
+	}
+	if m.mobile_number != nil {
+		fields = append(fields, registrationsaga.FieldMobileNumber)
+	}
+	if m.oauth_provider != nil {
+		fields = append(fields, registrationsaga.FieldOauthProvider)
+	}
+	if m.open_id != nil {
+		fields = append(fields, registrationsaga.FieldOpenID)
+	}
+	if m.status != nil {
+		fields = append(fields, registrationsaga.FieldStatus)
+	}
+	if m.err_code != nil {
+		fields = append(fields, registrationsaga.FieldErrCode)
+	}
+	if m.err_message != nil {
+		fields = append(fields, registrationsaga.FieldErrMessage)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegistrationSagaMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case registrationsaga.FieldCreatedAt:
+		return m.CreatedAt()
+	case registrationsaga.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case registrationsaga.FieldClientID:
+		return m.ClientID()
+	case registrationsaga.FieldIdempotencyKey:
+		return m.IdempotencyKey()
+	case registrationsaga.FieldUserID:
+		return m.UserID()
+	case registrationsaga.FieldAccount:
+		return m.Account()
+	case registrationsaga.FieldEmail:
+		return m.Email()
+	case registrationsaga.FieldCountryCode:
+		return m.CountryCode()
+	case registrationsaga.FieldMobileNumber:
+		return m.MobileNumber()
+	case registrationsaga.FieldOauthProvider:
+		return m.OauthProvider()
+	case registrationsaga.FieldOpenID:
+		return m.OpenID()
+	case registrationsaga.FieldStatus:
+		return m.Status()
+	case registrationsaga.FieldErrCode:
+		return m.ErrCode()
+	case registrationsaga.FieldErrMessage:
+		return m.ErrMessage()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegistrationSagaMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case registrationsaga.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case registrationsaga.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case registrationsaga.FieldClientID:
+		return m.OldClientID(ctx)
+	case registrationsaga.FieldIdempotencyKey:
+		return m.OldIdempotencyKey(ctx)
+	case registrationsaga.FieldUserID:
+		return m.OldUserID(ctx)
+	case registrationsaga.FieldAccount:
+		return m.OldAccount(ctx)
+	case registrationsaga.FieldEmail:
+		return m.OldEmail(ctx)
+	case registrationsaga.FieldCountryCode:
+		return m.OldCountryCode(ctx)
+	case registrationsaga.FieldMobileNumber:
+		return m.OldMobileNumber(ctx)
+	case registrationsaga.FieldOauthProvider:
+		return m.OldOauthProvider(ctx)
+	case registrationsaga.FieldOpenID:
+		return m.OldOpenID(ctx)
+	case registrationsaga.FieldStatus:
+		return m.OldStatus(ctx)
+	case registrationsaga.FieldErrCode:
+		return m.OldErrCode(ctx)
+	case registrationsaga.FieldErrMessage:
+		return m.OldErrMessage(ctx)
+	}
+	return nil, fmt.Errorf("unknown RegistrationSaga field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistrationSagaMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case registrationsaga.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case registrationsaga.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case registrationsaga.FieldClientID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientID(v)
+		return nil
+	case registrationsaga.FieldIdempotencyKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIdempotencyKey(v)
+		return nil
+	case registrationsaga.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case registrationsaga.FieldAccount:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case registrationsaga.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case registrationsaga.FieldCountryCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountryCode(v)
+		return nil
+	case registrationsaga.FieldMobileNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMobileNumber(v)
+		return nil
+	case registrationsaga.FieldOauthProvider:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOauthProvider(v)
+		return nil
+	case registrationsaga.FieldOpenID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenID(v)
+		return nil
+	case registrationsaga.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case registrationsaga.FieldErrCode:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
+		m.SetErrCode(v)
+		return nil
+	case registrationsaga.FieldErrMessage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrMessage(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationSaga field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegistrationSagaMutation) AddedFields() []string {
+	var fields []string
+	if m.addclient_id != nil {
+		fields = append(fields, registrationsaga.FieldClientID)
+	}
+	if m.adduser_id != nil {
+		fields = append(fields, registrationsaga.FieldUserID)
+	}
+	if m.addoauth_provider != nil {
+		fields = append(fields, registrationsaga.FieldOauthProvider)
+	}
+	if m.addstatus != nil {
+		fields = append(fields, registrationsaga.FieldStatus)
+	}
+	if m.adderr_code != nil {
+		fields = append(fields, registrationsaga.FieldErrCode)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegistrationSagaMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case registrationsaga.FieldClientID:
+		return m.AddedClientID()
+	case registrationsaga.FieldUserID:
+		return m.AddedUserID()
+	case registrationsaga.FieldOauthProvider:
+		return m.AddedOauthProvider()
+	case registrationsaga.FieldStatus:
+		return m.AddedStatus()
+	case registrationsaga.FieldErrCode:
+		return m.AddedErrCode()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistrationSagaMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case registrationsaga.FieldClientID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClientID(v)
+		return nil
+	case registrationsaga.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
 		m.AddUserID(v)
 		return nil
-	case profile.FieldKey:
+	case registrationsaga.FieldOauthProvider:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddKey(v)
+		m.AddOauthProvider(v)
+		return nil
+	case registrationsaga.FieldStatus:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStatus(v)
+		return nil
+	case registrationsaga.FieldErrCode:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddErrCode(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Profile numeric field %s", name)
+	return fmt.Errorf("unknown RegistrationSaga numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProfileMutation) ClearedFields() []string {
+func (m *RegistrationSagaMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProfileMutation) FieldCleared(name string) bool {
+func (m *RegistrationSagaMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProfileMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Profile nullable field %s", name)
+func (m *RegistrationSagaMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RegistrationSaga nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProfileMutation) ResetField(name string) error {
+func (m *RegistrationSagaMutation) ResetField(name string) error {
 	switch name {
-	case profile.FieldCreatedAt:
+	case registrationsaga.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case profile.FieldUpdatedAt:
+	case registrationsaga.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
-	case profile.FieldUserID:
+	case registrationsaga.FieldClientID:
+		m.ResetClientID()
+		return nil
+	case registrationsaga.FieldIdempotencyKey:
+		m.ResetIdempotencyKey()
+		return nil
+	case registrationsaga.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case profile.FieldKey:
-		m.ResetKey()
+	case registrationsaga.FieldAccount:
+		m.ResetAccount()
 		return nil
-	case profile.FieldValue:
-		m.ResetValue()
+	case registrationsaga.FieldEmail:
+		m.ResetEmail()
 		return nil
-	case profile.FieldIsActive:
-		m.ResetIsActive()
+	case registrationsaga.FieldCountryCode:
+		m.ResetCountryCode()
+		return nil
+	case registrationsaga.FieldMobileNumber:
+		m.ResetMobileNumber()
+		return nil
+	case registrationsaga.FieldOauthProvider:
+		m.ResetOauthProvider()
+		return nil
+	case registrationsaga.FieldOpenID:
+		m.ResetOpenID()
+		return nil
+	case registrationsaga.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case registrationsaga.FieldErrCode:
+		m.ResetErrCode()
+		return nil
+	case registrationsaga.FieldErrMessage:
+		m.ResetErrMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Profile field %s", name)
+	return fmt.Errorf("unknown RegistrationSaga field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProfileMutation) AddedEdges() []string {
+func (m *RegistrationSagaMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProfileMutation) AddedIDs(name string) []ent.Value {
+func (m *RegistrationSagaMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProfileMutation) RemovedEdges() []string {
+func (m *RegistrationSagaMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProfileMutation) RemovedIDs(name string) []ent.Value {
+func (m *RegistrationSagaMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProfileMutation) ClearedEdges() []string {
+func (m *RegistrationSagaMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProfileMutation) EdgeCleared(name string) bool {
+func (m *RegistrationSagaMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProfileMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Profile unique edge %s", name)
+func (m *RegistrationSagaMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RegistrationSaga unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProfileMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Profile edge %s", name)
+func (m *RegistrationSagaMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RegistrationSaga edge %s", name)
 }
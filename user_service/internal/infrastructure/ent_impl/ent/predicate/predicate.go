@@ -6,5 +6,17 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// KycSubmission is the predicate function for kycsubmission builders.
+type KycSubmission func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // Profile is the predicate function for profile builders.
 type Profile func(*sql.Selector)
+
+// ProfileAttribute is the predicate function for profileattribute builders.
+type ProfileAttribute func(*sql.Selector)
+
+// RegistrationSaga is the predicate function for registrationsaga builders.
+type RegistrationSaga func(*sql.Selector)
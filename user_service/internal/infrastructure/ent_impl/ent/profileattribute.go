@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// Profile attributes defined at runtime, the built-in ones are declared in the code
+type ProfileAttribute struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// The key of the profiles, starts from 1000
+	Key int `json:"key,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// pkg/enum/attribute_type
+	Type int `json:"type,omitempty"`
+	// Rules holds the value of the "rules" field.
+	Rules vo.AttributeRules `json:"rules,omitempty"`
+	// IsUnique holds the value of the "is_unique" field.
+	IsUnique bool `json:"is_unique,omitempty"`
+	// pkg/enum/pii_class
+	PiiClass int `json:"pii_class,omitempty"`
+	// The ids of pkg/enum/client_type
+	VisibleTo    []int `json:"visible_to,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProfileAttribute) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case profileattribute.FieldRules, profileattribute.FieldVisibleTo:
+			values[i] = new([]byte)
+		case profileattribute.FieldIsUnique:
+			values[i] = new(sql.NullBool)
+		case profileattribute.FieldID, profileattribute.FieldKey, profileattribute.FieldType, profileattribute.FieldPiiClass:
+			values[i] = new(sql.NullInt64)
+		case profileattribute.FieldName:
+			values[i] = new(sql.NullString)
+		case profileattribute.FieldCreatedAt, profileattribute.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProfileAttribute fields.
+func (pa *ProfileAttribute) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case profileattribute.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pa.ID = int(value.Int64)
+		case profileattribute.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pa.CreatedAt = value.Time
+			}
+		case profileattribute.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pa.UpdatedAt = value.Time
+			}
+		case profileattribute.FieldKey:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field key", values[i])
+			} else if value.Valid {
+				pa.Key = int(value.Int64)
+			}
+		case profileattribute.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pa.Name = value.String
+			}
+		case profileattribute.FieldType:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				pa.Type = int(value.Int64)
+			}
+		case profileattribute.FieldRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.Rules); err != nil {
+					return fmt.Errorf("unmarshal field rules: %w", err)
+				}
+			}
+		case profileattribute.FieldIsUnique:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_unique", values[i])
+			} else if value.Valid {
+				pa.IsUnique = value.Bool
+			}
+		case profileattribute.FieldPiiClass:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pii_class", values[i])
+			} else if value.Valid {
+				pa.PiiClass = int(value.Int64)
+			}
+		case profileattribute.FieldVisibleTo:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field visible_to", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &pa.VisibleTo); err != nil {
+					return fmt.Errorf("unmarshal field visible_to: %w", err)
+				}
+			}
+		default:
+			pa.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProfileAttribute.
+// This includes values selected through modifiers, order, etc.
+func (pa *ProfileAttribute) Value(name string) (ent.Value, error) {
+	return pa.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProfileAttribute.
+// Note that you need to call ProfileAttribute.Unwrap() before calling this method if this ProfileAttribute
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pa *ProfileAttribute) Update() *ProfileAttributeUpdateOne {
+	return NewProfileAttributeClient(pa.config).UpdateOne(pa)
+}
+
+// Unwrap unwraps the ProfileAttribute entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pa *ProfileAttribute) Unwrap() *ProfileAttribute {
+	_tx, ok := pa.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProfileAttribute is not a transactional entity")
+	}
+	pa.config.driver = _tx.drv
+	return pa
+}
+
+// String implements the fmt.Stringer.
+func (pa *ProfileAttribute) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProfileAttribute(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pa.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(pa.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pa.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("key=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Key))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pa.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Type))
+	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(fmt.Sprintf("%v", pa.Rules))
+	builder.WriteString(", ")
+	builder.WriteString("is_unique=")
+	builder.WriteString(fmt.Sprintf("%v", pa.IsUnique))
+	builder.WriteString(", ")
+	builder.WriteString("pii_class=")
+	builder.WriteString(fmt.Sprintf("%v", pa.PiiClass))
+	builder.WriteString(", ")
+	builder.WriteString("visible_to=")
+	builder.WriteString(fmt.Sprintf("%v", pa.VisibleTo))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProfileAttributes is a parsable slice of ProfileAttribute.
+type ProfileAttributes []*ProfileAttribute
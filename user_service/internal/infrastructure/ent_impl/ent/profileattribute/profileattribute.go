@@ -0,0 +1,114 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profileattribute
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the profileattribute type in the database.
+	Label = "profile_attribute"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldKey holds the string denoting the key field in the database.
+	FieldKey = "key"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldRules holds the string denoting the rules field in the database.
+	FieldRules = "rules"
+	// FieldIsUnique holds the string denoting the is_unique field in the database.
+	FieldIsUnique = "is_unique"
+	// FieldPiiClass holds the string denoting the pii_class field in the database.
+	FieldPiiClass = "pii_class"
+	// FieldVisibleTo holds the string denoting the visible_to field in the database.
+	FieldVisibleTo = "visible_to"
+	// Table holds the table name of the profileattribute in the database.
+	Table = "profile_attributes"
+)
+
+// Columns holds all SQL columns for profileattribute fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldKey,
+	FieldName,
+	FieldType,
+	FieldRules,
+	FieldIsUnique,
+	FieldPiiClass,
+	FieldVisibleTo,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultIsUnique holds the default value on creation for the "is_unique" field.
+	DefaultIsUnique bool
+)
+
+// OrderOption defines the ordering options for the ProfileAttribute queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByKey orders the results by the key field.
+func ByKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKey, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByIsUnique orders the results by the is_unique field.
+func ByIsUnique(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsUnique, opts...).ToFunc()
+}
+
+// ByPiiClass orders the results by the pii_class field.
+func ByPiiClass(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPiiClass, opts...).ToFunc()
+}
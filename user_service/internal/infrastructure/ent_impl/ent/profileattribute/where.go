@@ -0,0 +1,380 @@
+// Code generated by ent, DO NOT EDIT.
+
+package profileattribute
+
+import (
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// Key applies equality check predicate on the "key" field. It's identical to KeyEQ.
+func Key(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldKey, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldName, v))
+}
+
+// Type applies equality check predicate on the "type" field. It's identical to TypeEQ.
+func Type(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldType, v))
+}
+
+// IsUnique applies equality check predicate on the "is_unique" field. It's identical to IsUniqueEQ.
+func IsUnique(v bool) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldIsUnique, v))
+}
+
+// PiiClass applies equality check predicate on the "pii_class" field. It's identical to PiiClassEQ.
+func PiiClass(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldPiiClass, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// KeyEQ applies the EQ predicate on the "key" field.
+func KeyEQ(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldKey, v))
+}
+
+// KeyNEQ applies the NEQ predicate on the "key" field.
+func KeyNEQ(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNEQ(FieldKey, v))
+}
+
+// KeyIn applies the In predicate on the "key" field.
+func KeyIn(vs ...int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldIn(FieldKey, vs...))
+}
+
+// KeyNotIn applies the NotIn predicate on the "key" field.
+func KeyNotIn(vs ...int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNotIn(FieldKey, vs...))
+}
+
+// KeyGT applies the GT predicate on the "key" field.
+func KeyGT(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGT(FieldKey, v))
+}
+
+// KeyGTE applies the GTE predicate on the "key" field.
+func KeyGTE(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGTE(FieldKey, v))
+}
+
+// KeyLT applies the LT predicate on the "key" field.
+func KeyLT(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLT(FieldKey, v))
+}
+
+// KeyLTE applies the LTE predicate on the "key" field.
+func KeyLTE(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLTE(FieldKey, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldContainsFold(FieldName, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNotIn(FieldType, vs...))
+}
+
+// TypeGT applies the GT predicate on the "type" field.
+func TypeGT(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGT(FieldType, v))
+}
+
+// TypeGTE applies the GTE predicate on the "type" field.
+func TypeGTE(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGTE(FieldType, v))
+}
+
+// TypeLT applies the LT predicate on the "type" field.
+func TypeLT(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLT(FieldType, v))
+}
+
+// TypeLTE applies the LTE predicate on the "type" field.
+func TypeLTE(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLTE(FieldType, v))
+}
+
+// IsUniqueEQ applies the EQ predicate on the "is_unique" field.
+func IsUniqueEQ(v bool) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldIsUnique, v))
+}
+
+// IsUniqueNEQ applies the NEQ predicate on the "is_unique" field.
+func IsUniqueNEQ(v bool) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNEQ(FieldIsUnique, v))
+}
+
+// PiiClassEQ applies the EQ predicate on the "pii_class" field.
+func PiiClassEQ(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldEQ(FieldPiiClass, v))
+}
+
+// PiiClassNEQ applies the NEQ predicate on the "pii_class" field.
+func PiiClassNEQ(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNEQ(FieldPiiClass, v))
+}
+
+// PiiClassIn applies the In predicate on the "pii_class" field.
+func PiiClassIn(vs ...int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldIn(FieldPiiClass, vs...))
+}
+
+// PiiClassNotIn applies the NotIn predicate on the "pii_class" field.
+func PiiClassNotIn(vs ...int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldNotIn(FieldPiiClass, vs...))
+}
+
+// PiiClassGT applies the GT predicate on the "pii_class" field.
+func PiiClassGT(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGT(FieldPiiClass, v))
+}
+
+// PiiClassGTE applies the GTE predicate on the "pii_class" field.
+func PiiClassGTE(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldGTE(FieldPiiClass, v))
+}
+
+// PiiClassLT applies the LT predicate on the "pii_class" field.
+func PiiClassLT(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLT(FieldPiiClass, v))
+}
+
+// PiiClassLTE applies the LTE predicate on the "pii_class" field.
+func PiiClassLTE(v int) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.FieldLTE(FieldPiiClass, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProfileAttribute) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProfileAttribute) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProfileAttribute) predicate.ProfileAttribute {
+	return predicate.ProfileAttribute(sql.NotPredicates(p))
+}
@@ -0,0 +1,331 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileAttributeCreate is the builder for creating a ProfileAttribute entity.
+type ProfileAttributeCreate struct {
+	config
+	mutation *ProfileAttributeMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (pac *ProfileAttributeCreate) SetCreatedAt(t time.Time) *ProfileAttributeCreate {
+	pac.mutation.SetCreatedAt(t)
+	return pac
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (pac *ProfileAttributeCreate) SetNillableCreatedAt(t *time.Time) *ProfileAttributeCreate {
+	if t != nil {
+		pac.SetCreatedAt(*t)
+	}
+	return pac
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pac *ProfileAttributeCreate) SetUpdatedAt(t time.Time) *ProfileAttributeCreate {
+	pac.mutation.SetUpdatedAt(t)
+	return pac
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (pac *ProfileAttributeCreate) SetNillableUpdatedAt(t *time.Time) *ProfileAttributeCreate {
+	if t != nil {
+		pac.SetUpdatedAt(*t)
+	}
+	return pac
+}
+
+// SetKey sets the "key" field.
+func (pac *ProfileAttributeCreate) SetKey(i int) *ProfileAttributeCreate {
+	pac.mutation.SetKey(i)
+	return pac
+}
+
+// SetName sets the "name" field.
+func (pac *ProfileAttributeCreate) SetName(s string) *ProfileAttributeCreate {
+	pac.mutation.SetName(s)
+	return pac
+}
+
+// SetType sets the "type" field.
+func (pac *ProfileAttributeCreate) SetType(i int) *ProfileAttributeCreate {
+	pac.mutation.SetType(i)
+	return pac
+}
+
+// SetRules sets the "rules" field.
+func (pac *ProfileAttributeCreate) SetRules(vr vo.AttributeRules) *ProfileAttributeCreate {
+	pac.mutation.SetRules(vr)
+	return pac
+}
+
+// SetIsUnique sets the "is_unique" field.
+func (pac *ProfileAttributeCreate) SetIsUnique(b bool) *ProfileAttributeCreate {
+	pac.mutation.SetIsUnique(b)
+	return pac
+}
+
+// SetNillableIsUnique sets the "is_unique" field if the given value is not nil.
+func (pac *ProfileAttributeCreate) SetNillableIsUnique(b *bool) *ProfileAttributeCreate {
+	if b != nil {
+		pac.SetIsUnique(*b)
+	}
+	return pac
+}
+
+// SetPiiClass sets the "pii_class" field.
+func (pac *ProfileAttributeCreate) SetPiiClass(i int) *ProfileAttributeCreate {
+	pac.mutation.SetPiiClass(i)
+	return pac
+}
+
+// SetVisibleTo sets the "visible_to" field.
+func (pac *ProfileAttributeCreate) SetVisibleTo(i []int) *ProfileAttributeCreate {
+	pac.mutation.SetVisibleTo(i)
+	return pac
+}
+
+// Mutation returns the ProfileAttributeMutation object of the builder.
+func (pac *ProfileAttributeCreate) Mutation() *ProfileAttributeMutation {
+	return pac.mutation
+}
+
+// Save creates the ProfileAttribute in the database.
+func (pac *ProfileAttributeCreate) Save(ctx context.Context) (*ProfileAttribute, error) {
+	pac.defaults()
+	return withHooks(ctx, pac.sqlSave, pac.mutation, pac.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (pac *ProfileAttributeCreate) SaveX(ctx context.Context) *ProfileAttribute {
+	v, err := pac.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pac *ProfileAttributeCreate) Exec(ctx context.Context) error {
+	_, err := pac.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pac *ProfileAttributeCreate) ExecX(ctx context.Context) {
+	if err := pac.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pac *ProfileAttributeCreate) defaults() {
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		v := profileattribute.DefaultCreatedAt()
+		pac.mutation.SetCreatedAt(v)
+	}
+	if _, ok := pac.mutation.UpdatedAt(); !ok {
+		v := profileattribute.DefaultUpdatedAt()
+		pac.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := pac.mutation.IsUnique(); !ok {
+		v := profileattribute.DefaultIsUnique
+		pac.mutation.SetIsUnique(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pac *ProfileAttributeCreate) check() error {
+	if _, ok := pac.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProfileAttribute.created_at"`)}
+	}
+	if _, ok := pac.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProfileAttribute.updated_at"`)}
+	}
+	if _, ok := pac.mutation.Key(); !ok {
+		return &ValidationError{Name: "key", err: errors.New(`ent: missing required field "ProfileAttribute.key"`)}
+	}
+	if _, ok := pac.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ProfileAttribute.name"`)}
+	}
+	if _, ok := pac.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "ProfileAttribute.type"`)}
+	}
+	if _, ok := pac.mutation.Rules(); !ok {
+		return &ValidationError{Name: "rules", err: errors.New(`ent: missing required field "ProfileAttribute.rules"`)}
+	}
+	if _, ok := pac.mutation.IsUnique(); !ok {
+		return &ValidationError{Name: "is_unique", err: errors.New(`ent: missing required field "ProfileAttribute.is_unique"`)}
+	}
+	if _, ok := pac.mutation.PiiClass(); !ok {
+		return &ValidationError{Name: "pii_class", err: errors.New(`ent: missing required field "ProfileAttribute.pii_class"`)}
+	}
+	if _, ok := pac.mutation.VisibleTo(); !ok {
+		return &ValidationError{Name: "visible_to", err: errors.New(`ent: missing required field "ProfileAttribute.visible_to"`)}
+	}
+	return nil
+}
+
+func (pac *ProfileAttributeCreate) sqlSave(ctx context.Context) (*ProfileAttribute, error) {
+	if err := pac.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := pac.createSpec()
+	if err := sqlgraph.CreateNode(ctx, pac.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	pac.mutation.id = &_node.ID
+	pac.mutation.done = true
+	return _node, nil
+}
+
+func (pac *ProfileAttributeCreate) createSpec() (*ProfileAttribute, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProfileAttribute{config: pac.config}
+		_spec = sqlgraph.NewCreateSpec(profileattribute.Table, sqlgraph.NewFieldSpec(profileattribute.FieldID, field.TypeInt))
+	)
+	if value, ok := pac.mutation.CreatedAt(); ok {
+		_spec.SetField(profileattribute.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := pac.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileattribute.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := pac.mutation.Key(); ok {
+		_spec.SetField(profileattribute.FieldKey, field.TypeInt, value)
+		_node.Key = value
+	}
+	if value, ok := pac.mutation.Name(); ok {
+		_spec.SetField(profileattribute.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := pac.mutation.GetType(); ok {
+		_spec.SetField(profileattribute.FieldType, field.TypeInt, value)
+		_node.Type = value
+	}
+	if value, ok := pac.mutation.Rules(); ok {
+		_spec.SetField(profileattribute.FieldRules, field.TypeJSON, value)
+		_node.Rules = value
+	}
+	if value, ok := pac.mutation.IsUnique(); ok {
+		_spec.SetField(profileattribute.FieldIsUnique, field.TypeBool, value)
+		_node.IsUnique = value
+	}
+	if value, ok := pac.mutation.PiiClass(); ok {
+		_spec.SetField(profileattribute.FieldPiiClass, field.TypeInt, value)
+		_node.PiiClass = value
+	}
+	if value, ok := pac.mutation.VisibleTo(); ok {
+		_spec.SetField(profileattribute.FieldVisibleTo, field.TypeJSON, value)
+		_node.VisibleTo = value
+	}
+	return _node, _spec
+}
+
+// ProfileAttributeCreateBulk is the builder for creating many ProfileAttribute entities in bulk.
+type ProfileAttributeCreateBulk struct {
+	config
+	err      error
+	builders []*ProfileAttributeCreate
+}
+
+// Save creates the ProfileAttribute entities in the database.
+func (pacb *ProfileAttributeCreateBulk) Save(ctx context.Context) ([]*ProfileAttribute, error) {
+	if pacb.err != nil {
+		return nil, pacb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(pacb.builders))
+	nodes := make([]*ProfileAttribute, len(pacb.builders))
+	mutators := make([]Mutator, len(pacb.builders))
+	for i := range pacb.builders {
+		func(i int, root context.Context) {
+			builder := pacb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProfileAttributeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, pacb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, pacb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, pacb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pacb *ProfileAttributeCreateBulk) SaveX(ctx context.Context) []*ProfileAttribute {
+	v, err := pacb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (pacb *ProfileAttributeCreateBulk) Exec(ctx context.Context) error {
+	_, err := pacb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pacb *ProfileAttributeCreateBulk) ExecX(ctx context.Context) {
+	if err := pacb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileAttributeDelete is the builder for deleting a ProfileAttribute entity.
+type ProfileAttributeDelete struct {
+	config
+	hooks    []Hook
+	mutation *ProfileAttributeMutation
+}
+
+// Where appends a list predicates to the ProfileAttributeDelete builder.
+func (pad *ProfileAttributeDelete) Where(ps ...predicate.ProfileAttribute) *ProfileAttributeDelete {
+	pad.mutation.Where(ps...)
+	return pad
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (pad *ProfileAttributeDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, pad.sqlExec, pad.mutation, pad.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pad *ProfileAttributeDelete) ExecX(ctx context.Context) int {
+	n, err := pad.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (pad *ProfileAttributeDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(profileattribute.Table, sqlgraph.NewFieldSpec(profileattribute.FieldID, field.TypeInt))
+	if ps := pad.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, pad.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	pad.mutation.done = true
+	return affected, err
+}
+
+// ProfileAttributeDeleteOne is the builder for deleting a single ProfileAttribute entity.
+type ProfileAttributeDeleteOne struct {
+	pad *ProfileAttributeDelete
+}
+
+// Where appends a list predicates to the ProfileAttributeDelete builder.
+func (pado *ProfileAttributeDeleteOne) Where(ps ...predicate.ProfileAttribute) *ProfileAttributeDeleteOne {
+	pado.pad.mutation.Where(ps...)
+	return pado
+}
+
+// Exec executes the deletion query.
+func (pado *ProfileAttributeDeleteOne) Exec(ctx context.Context) error {
+	n, err := pado.pad.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{profileattribute.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pado *ProfileAttributeDeleteOne) ExecX(ctx context.Context) {
+	if err := pado.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
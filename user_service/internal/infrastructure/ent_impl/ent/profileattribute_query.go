@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileAttributeQuery is the builder for querying ProfileAttribute entities.
+type ProfileAttributeQuery struct {
+	config
+	ctx        *QueryContext
+	order      []profileattribute.OrderOption
+	inters     []Interceptor
+	predicates []predicate.ProfileAttribute
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ProfileAttributeQuery builder.
+func (paq *ProfileAttributeQuery) Where(ps ...predicate.ProfileAttribute) *ProfileAttributeQuery {
+	paq.predicates = append(paq.predicates, ps...)
+	return paq
+}
+
+// Limit the number of records to be returned by this query.
+func (paq *ProfileAttributeQuery) Limit(limit int) *ProfileAttributeQuery {
+	paq.ctx.Limit = &limit
+	return paq
+}
+
+// Offset to start from.
+func (paq *ProfileAttributeQuery) Offset(offset int) *ProfileAttributeQuery {
+	paq.ctx.Offset = &offset
+	return paq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (paq *ProfileAttributeQuery) Unique(unique bool) *ProfileAttributeQuery {
+	paq.ctx.Unique = &unique
+	return paq
+}
+
+// Order specifies how the records should be ordered.
+func (paq *ProfileAttributeQuery) Order(o ...profileattribute.OrderOption) *ProfileAttributeQuery {
+	paq.order = append(paq.order, o...)
+	return paq
+}
+
+// First returns the first ProfileAttribute entity from the query.
+// Returns a *NotFoundError when no ProfileAttribute was found.
+func (paq *ProfileAttributeQuery) First(ctx context.Context) (*ProfileAttribute, error) {
+	nodes, err := paq.Limit(1).All(setContextOp(ctx, paq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{profileattribute.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (paq *ProfileAttributeQuery) FirstX(ctx context.Context) *ProfileAttribute {
+	node, err := paq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ProfileAttribute ID from the query.
+// Returns a *NotFoundError when no ProfileAttribute ID was found.
+func (paq *ProfileAttributeQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = paq.Limit(1).IDs(setContextOp(ctx, paq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{profileattribute.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (paq *ProfileAttributeQuery) FirstIDX(ctx context.Context) int {
+	id, err := paq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ProfileAttribute entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ProfileAttribute entity is found.
+// Returns a *NotFoundError when no ProfileAttribute entities are found.
+func (paq *ProfileAttributeQuery) Only(ctx context.Context) (*ProfileAttribute, error) {
+	nodes, err := paq.Limit(2).All(setContextOp(ctx, paq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{profileattribute.Label}
+	default:
+		return nil, &NotSingularError{profileattribute.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (paq *ProfileAttributeQuery) OnlyX(ctx context.Context) *ProfileAttribute {
+	node, err := paq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ProfileAttribute ID in the query.
+// Returns a *NotSingularError when more than one ProfileAttribute ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (paq *ProfileAttributeQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = paq.Limit(2).IDs(setContextOp(ctx, paq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{profileattribute.Label}
+	default:
+		err = &NotSingularError{profileattribute.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (paq *ProfileAttributeQuery) OnlyIDX(ctx context.Context) int {
+	id, err := paq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ProfileAttributes.
+func (paq *ProfileAttributeQuery) All(ctx context.Context) ([]*ProfileAttribute, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryAll)
+	if err := paq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ProfileAttribute, *ProfileAttributeQuery]()
+	return withInterceptors[[]*ProfileAttribute](ctx, paq, qr, paq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (paq *ProfileAttributeQuery) AllX(ctx context.Context) []*ProfileAttribute {
+	nodes, err := paq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ProfileAttribute IDs.
+func (paq *ProfileAttributeQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if paq.ctx.Unique == nil && paq.path != nil {
+		paq.Unique(true)
+	}
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryIDs)
+	if err = paq.Select(profileattribute.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (paq *ProfileAttributeQuery) IDsX(ctx context.Context) []int {
+	ids, err := paq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (paq *ProfileAttributeQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryCount)
+	if err := paq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, paq, querierCount[*ProfileAttributeQuery](), paq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (paq *ProfileAttributeQuery) CountX(ctx context.Context) int {
+	count, err := paq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (paq *ProfileAttributeQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, paq.ctx, ent.OpQueryExist)
+	switch _, err := paq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (paq *ProfileAttributeQuery) ExistX(ctx context.Context) bool {
+	exist, err := paq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ProfileAttributeQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (paq *ProfileAttributeQuery) Clone() *ProfileAttributeQuery {
+	if paq == nil {
+		return nil
+	}
+	return &ProfileAttributeQuery{
+		config:     paq.config,
+		ctx:        paq.ctx.Clone(),
+		order:      append([]profileattribute.OrderOption{}, paq.order...),
+		inters:     append([]Interceptor{}, paq.inters...),
+		predicates: append([]predicate.ProfileAttribute{}, paq.predicates...),
+		// clone intermediate query.
+		sql:  paq.sql.Clone(),
+		path: paq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ProfileAttribute.Query().
+//		GroupBy(profileattribute.FieldCreatedAt).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (paq *ProfileAttributeQuery) GroupBy(field string, fields ...string) *ProfileAttributeGroupBy {
+	paq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ProfileAttributeGroupBy{build: paq}
+	grbuild.flds = &paq.ctx.Fields
+	grbuild.label = profileattribute.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		CreatedAt time.Time `json:"created_at,omitempty"`
+//	}
+//
+//	client.ProfileAttribute.Query().
+//		Select(profileattribute.FieldCreatedAt).
+//		Scan(ctx, &v)
+func (paq *ProfileAttributeQuery) Select(fields ...string) *ProfileAttributeSelect {
+	paq.ctx.Fields = append(paq.ctx.Fields, fields...)
+	sbuild := &ProfileAttributeSelect{ProfileAttributeQuery: paq}
+	sbuild.label = profileattribute.Label
+	sbuild.flds, sbuild.scan = &paq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ProfileAttributeSelect configured with the given aggregations.
+func (paq *ProfileAttributeQuery) Aggregate(fns ...AggregateFunc) *ProfileAttributeSelect {
+	return paq.Select().Aggregate(fns...)
+}
+
+func (paq *ProfileAttributeQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range paq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, paq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range paq.ctx.Fields {
+		if !profileattribute.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if paq.path != nil {
+		prev, err := paq.path(ctx)
+		if err != nil {
+			return err
+		}
+		paq.sql = prev
+	}
+	return nil
+}
+
+func (paq *ProfileAttributeQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ProfileAttribute, error) {
+	var (
+		nodes = []*ProfileAttribute{}
+		_spec = paq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ProfileAttribute).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ProfileAttribute{config: paq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, paq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (paq *ProfileAttributeQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := paq.querySpec()
+	_spec.Node.Columns = paq.ctx.Fields
+	if len(paq.ctx.Fields) > 0 {
+		_spec.Unique = paq.ctx.Unique != nil && *paq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, paq.driver, _spec)
+}
+
+func (paq *ProfileAttributeQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(profileattribute.Table, profileattribute.Columns, sqlgraph.NewFieldSpec(profileattribute.FieldID, field.TypeInt))
+	_spec.From = paq.sql
+	if unique := paq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if paq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := paq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profileattribute.FieldID)
+		for i := range fields {
+			if fields[i] != profileattribute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := paq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := paq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := paq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := paq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (paq *ProfileAttributeQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(paq.driver.Dialect())
+	t1 := builder.Table(profileattribute.Table)
+	columns := paq.ctx.Fields
+	if len(columns) == 0 {
+		columns = profileattribute.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if paq.sql != nil {
+		selector = paq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if paq.ctx.Unique != nil && *paq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range paq.predicates {
+		p(selector)
+	}
+	for _, p := range paq.order {
+		p(selector)
+	}
+	if offset := paq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := paq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ProfileAttributeGroupBy is the group-by builder for ProfileAttribute entities.
+type ProfileAttributeGroupBy struct {
+	selector
+	build *ProfileAttributeQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (pagb *ProfileAttributeGroupBy) Aggregate(fns ...AggregateFunc) *ProfileAttributeGroupBy {
+	pagb.fns = append(pagb.fns, fns...)
+	return pagb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pagb *ProfileAttributeGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pagb.build.ctx, ent.OpQueryGroupBy)
+	if err := pagb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileAttributeQuery, *ProfileAttributeGroupBy](ctx, pagb.build, pagb, pagb.build.inters, v)
+}
+
+func (pagb *ProfileAttributeGroupBy) sqlScan(ctx context.Context, root *ProfileAttributeQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(pagb.fns))
+	for _, fn := range pagb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*pagb.flds)+len(pagb.fns))
+		for _, f := range *pagb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*pagb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pagb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ProfileAttributeSelect is the builder for selecting fields of ProfileAttribute entities.
+type ProfileAttributeSelect struct {
+	*ProfileAttributeQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pas *ProfileAttributeSelect) Aggregate(fns ...AggregateFunc) *ProfileAttributeSelect {
+	pas.fns = append(pas.fns, fns...)
+	return pas
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pas *ProfileAttributeSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pas.ctx, ent.OpQuerySelect)
+	if err := pas.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ProfileAttributeQuery, *ProfileAttributeSelect](ctx, pas.ProfileAttributeQuery, pas, pas.inters, v)
+}
+
+func (pas *ProfileAttributeSelect) sqlScan(ctx context.Context, root *ProfileAttributeQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pas.fns))
+	for _, fn := range pas.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pas.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pas.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
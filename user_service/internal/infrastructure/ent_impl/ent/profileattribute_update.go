@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileAttributeUpdate is the builder for updating ProfileAttribute entities.
+type ProfileAttributeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProfileAttributeMutation
+}
+
+// Where appends a list predicates to the ProfileAttributeUpdate builder.
+func (pau *ProfileAttributeUpdate) Where(ps ...predicate.ProfileAttribute) *ProfileAttributeUpdate {
+	pau.mutation.Where(ps...)
+	return pau
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pau *ProfileAttributeUpdate) SetUpdatedAt(t time.Time) *ProfileAttributeUpdate {
+	pau.mutation.SetUpdatedAt(t)
+	return pau
+}
+
+// SetRules sets the "rules" field.
+func (pau *ProfileAttributeUpdate) SetRules(vr vo.AttributeRules) *ProfileAttributeUpdate {
+	pau.mutation.SetRules(vr)
+	return pau
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (pau *ProfileAttributeUpdate) SetNillableRules(vr *vo.AttributeRules) *ProfileAttributeUpdate {
+	if vr != nil {
+		pau.SetRules(*vr)
+	}
+	return pau
+}
+
+// SetIsUnique sets the "is_unique" field.
+func (pau *ProfileAttributeUpdate) SetIsUnique(b bool) *ProfileAttributeUpdate {
+	pau.mutation.SetIsUnique(b)
+	return pau
+}
+
+// SetNillableIsUnique sets the "is_unique" field if the given value is not nil.
+func (pau *ProfileAttributeUpdate) SetNillableIsUnique(b *bool) *ProfileAttributeUpdate {
+	if b != nil {
+		pau.SetIsUnique(*b)
+	}
+	return pau
+}
+
+// SetPiiClass sets the "pii_class" field.
+func (pau *ProfileAttributeUpdate) SetPiiClass(i int) *ProfileAttributeUpdate {
+	pau.mutation.ResetPiiClass()
+	pau.mutation.SetPiiClass(i)
+	return pau
+}
+
+// SetNillablePiiClass sets the "pii_class" field if the given value is not nil.
+func (pau *ProfileAttributeUpdate) SetNillablePiiClass(i *int) *ProfileAttributeUpdate {
+	if i != nil {
+		pau.SetPiiClass(*i)
+	}
+	return pau
+}
+
+// AddPiiClass adds i to the "pii_class" field.
+func (pau *ProfileAttributeUpdate) AddPiiClass(i int) *ProfileAttributeUpdate {
+	pau.mutation.AddPiiClass(i)
+	return pau
+}
+
+// SetVisibleTo sets the "visible_to" field.
+func (pau *ProfileAttributeUpdate) SetVisibleTo(i []int) *ProfileAttributeUpdate {
+	pau.mutation.SetVisibleTo(i)
+	return pau
+}
+
+// AppendVisibleTo appends i to the "visible_to" field.
+func (pau *ProfileAttributeUpdate) AppendVisibleTo(i []int) *ProfileAttributeUpdate {
+	pau.mutation.AppendVisibleTo(i)
+	return pau
+}
+
+// Mutation returns the ProfileAttributeMutation object of the builder.
+func (pau *ProfileAttributeUpdate) Mutation() *ProfileAttributeMutation {
+	return pau.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pau *ProfileAttributeUpdate) Save(ctx context.Context) (int, error) {
+	pau.defaults()
+	return withHooks(ctx, pau.sqlSave, pau.mutation, pau.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pau *ProfileAttributeUpdate) SaveX(ctx context.Context) int {
+	affected, err := pau.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pau *ProfileAttributeUpdate) Exec(ctx context.Context) error {
+	_, err := pau.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pau *ProfileAttributeUpdate) ExecX(ctx context.Context) {
+	if err := pau.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pau *ProfileAttributeUpdate) defaults() {
+	if _, ok := pau.mutation.UpdatedAt(); !ok {
+		v := profileattribute.UpdateDefaultUpdatedAt()
+		pau.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (pau *ProfileAttributeUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(profileattribute.Table, profileattribute.Columns, sqlgraph.NewFieldSpec(profileattribute.FieldID, field.TypeInt))
+	if ps := pau.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pau.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileattribute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pau.mutation.Rules(); ok {
+		_spec.SetField(profileattribute.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := pau.mutation.IsUnique(); ok {
+		_spec.SetField(profileattribute.FieldIsUnique, field.TypeBool, value)
+	}
+	if value, ok := pau.mutation.PiiClass(); ok {
+		_spec.SetField(profileattribute.FieldPiiClass, field.TypeInt, value)
+	}
+	if value, ok := pau.mutation.AddedPiiClass(); ok {
+		_spec.AddField(profileattribute.FieldPiiClass, field.TypeInt, value)
+	}
+	if value, ok := pau.mutation.VisibleTo(); ok {
+		_spec.SetField(profileattribute.FieldVisibleTo, field.TypeJSON, value)
+	}
+	if value, ok := pau.mutation.AppendedVisibleTo(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, profileattribute.FieldVisibleTo, value)
+		})
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pau.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profileattribute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pau.mutation.done = true
+	return n, nil
+}
+
+// ProfileAttributeUpdateOne is the builder for updating a single ProfileAttribute entity.
+type ProfileAttributeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProfileAttributeMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (pauo *ProfileAttributeUpdateOne) SetUpdatedAt(t time.Time) *ProfileAttributeUpdateOne {
+	pauo.mutation.SetUpdatedAt(t)
+	return pauo
+}
+
+// SetRules sets the "rules" field.
+func (pauo *ProfileAttributeUpdateOne) SetRules(vr vo.AttributeRules) *ProfileAttributeUpdateOne {
+	pauo.mutation.SetRules(vr)
+	return pauo
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (pauo *ProfileAttributeUpdateOne) SetNillableRules(vr *vo.AttributeRules) *ProfileAttributeUpdateOne {
+	if vr != nil {
+		pauo.SetRules(*vr)
+	}
+	return pauo
+}
+
+// SetIsUnique sets the "is_unique" field.
+func (pauo *ProfileAttributeUpdateOne) SetIsUnique(b bool) *ProfileAttributeUpdateOne {
+	pauo.mutation.SetIsUnique(b)
+	return pauo
+}
+
+// SetNillableIsUnique sets the "is_unique" field if the given value is not nil.
+func (pauo *ProfileAttributeUpdateOne) SetNillableIsUnique(b *bool) *ProfileAttributeUpdateOne {
+	if b != nil {
+		pauo.SetIsUnique(*b)
+	}
+	return pauo
+}
+
+// SetPiiClass sets the "pii_class" field.
+func (pauo *ProfileAttributeUpdateOne) SetPiiClass(i int) *ProfileAttributeUpdateOne {
+	pauo.mutation.ResetPiiClass()
+	pauo.mutation.SetPiiClass(i)
+	return pauo
+}
+
+// SetNillablePiiClass sets the "pii_class" field if the given value is not nil.
+func (pauo *ProfileAttributeUpdateOne) SetNillablePiiClass(i *int) *ProfileAttributeUpdateOne {
+	if i != nil {
+		pauo.SetPiiClass(*i)
+	}
+	return pauo
+}
+
+// AddPiiClass adds i to the "pii_class" field.
+func (pauo *ProfileAttributeUpdateOne) AddPiiClass(i int) *ProfileAttributeUpdateOne {
+	pauo.mutation.AddPiiClass(i)
+	return pauo
+}
+
+// SetVisibleTo sets the "visible_to" field.
+func (pauo *ProfileAttributeUpdateOne) SetVisibleTo(i []int) *ProfileAttributeUpdateOne {
+	pauo.mutation.SetVisibleTo(i)
+	return pauo
+}
+
+// AppendVisibleTo appends i to the "visible_to" field.
+func (pauo *ProfileAttributeUpdateOne) AppendVisibleTo(i []int) *ProfileAttributeUpdateOne {
+	pauo.mutation.AppendVisibleTo(i)
+	return pauo
+}
+
+// Mutation returns the ProfileAttributeMutation object of the builder.
+func (pauo *ProfileAttributeUpdateOne) Mutation() *ProfileAttributeMutation {
+	return pauo.mutation
+}
+
+// Where appends a list predicates to the ProfileAttributeUpdate builder.
+func (pauo *ProfileAttributeUpdateOne) Where(ps ...predicate.ProfileAttribute) *ProfileAttributeUpdateOne {
+	pauo.mutation.Where(ps...)
+	return pauo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (pauo *ProfileAttributeUpdateOne) Select(field string, fields ...string) *ProfileAttributeUpdateOne {
+	pauo.fields = append([]string{field}, fields...)
+	return pauo
+}
+
+// Save executes the query and returns the updated ProfileAttribute entity.
+func (pauo *ProfileAttributeUpdateOne) Save(ctx context.Context) (*ProfileAttribute, error) {
+	pauo.defaults()
+	return withHooks(ctx, pauo.sqlSave, pauo.mutation, pauo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pauo *ProfileAttributeUpdateOne) SaveX(ctx context.Context) *ProfileAttribute {
+	node, err := pauo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (pauo *ProfileAttributeUpdateOne) Exec(ctx context.Context) error {
+	_, err := pauo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pauo *ProfileAttributeUpdateOne) ExecX(ctx context.Context) {
+	if err := pauo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (pauo *ProfileAttributeUpdateOne) defaults() {
+	if _, ok := pauo.mutation.UpdatedAt(); !ok {
+		v := profileattribute.UpdateDefaultUpdatedAt()
+		pauo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (pauo *ProfileAttributeUpdateOne) sqlSave(ctx context.Context) (_node *ProfileAttribute, err error) {
+	_spec := sqlgraph.NewUpdateSpec(profileattribute.Table, profileattribute.Columns, sqlgraph.NewFieldSpec(profileattribute.FieldID, field.TypeInt))
+	id, ok := pauo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProfileAttribute.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := pauo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, profileattribute.FieldID)
+		for _, f := range fields {
+			if !profileattribute.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != profileattribute.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := pauo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pauo.mutation.UpdatedAt(); ok {
+		_spec.SetField(profileattribute.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := pauo.mutation.Rules(); ok {
+		_spec.SetField(profileattribute.FieldRules, field.TypeJSON, value)
+	}
+	if value, ok := pauo.mutation.IsUnique(); ok {
+		_spec.SetField(profileattribute.FieldIsUnique, field.TypeBool, value)
+	}
+	if value, ok := pauo.mutation.PiiClass(); ok {
+		_spec.SetField(profileattribute.FieldPiiClass, field.TypeInt, value)
+	}
+	if value, ok := pauo.mutation.AddedPiiClass(); ok {
+		_spec.AddField(profileattribute.FieldPiiClass, field.TypeInt, value)
+	}
+	if value, ok := pauo.mutation.VisibleTo(); ok {
+		_spec.SetField(profileattribute.FieldVisibleTo, field.TypeJSON, value)
+	}
+	if value, ok := pauo.mutation.AppendedVisibleTo(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, profileattribute.FieldVisibleTo, value)
+		})
+	}
+	_node = &ProfileAttribute{config: pauo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, pauo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{profileattribute.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	pauo.mutation.done = true
+	return _node, nil
+}
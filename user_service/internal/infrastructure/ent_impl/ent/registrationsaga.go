@@ -0,0 +1,249 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+)
+
+// The steps of the registrations across the auth service and the user service
+type RegistrationSaga struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// ClientID holds the value of the "client_id" field.
+	ClientID int64 `json:"client_id,omitempty"`
+	// Sent by the client, the retries of the registration share the key
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int64 `json:"user_id,omitempty"`
+	// Account holds the value of the "account" field.
+	Account string `json:"account,omitempty"`
+	// Email holds the value of the "email" field.
+	Email string `json:"email,omitempty"`
+	// CountryCode holds the value of the "country_code" field.
+	CountryCode string `json:"country_code,omitempty"`
+	// MobileNumber holds the value of the "mobile_number" field.
+	MobileNumber string `json:"mobile_number,omitempty"`
+	// pkg/enum/oauth_provider, 0 for the registration without the oauth account
+	OauthProvider int `json:"oauth_provider,omitempty"`
+	// The openID linked to the user when the profile is created
+	OpenID string `json:"open_id,omitempty"`
+	// pkg/enum/registration_status
+	Status int `json:"status,omitempty"`
+	// The cus_err code of the failed registration
+	ErrCode int `json:"err_code,omitempty"`
+	// ErrMessage holds the value of the "err_message" field.
+	ErrMessage   string `json:"err_message,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RegistrationSaga) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case registrationsaga.FieldID, registrationsaga.FieldClientID, registrationsaga.FieldUserID, registrationsaga.FieldOauthProvider, registrationsaga.FieldStatus, registrationsaga.FieldErrCode:
+			values[i] = new(sql.NullInt64)
+		case registrationsaga.FieldIdempotencyKey, registrationsaga.FieldAccount, registrationsaga.FieldEmail, registrationsaga.FieldCountryCode, registrationsaga.FieldMobileNumber, registrationsaga.FieldOpenID, registrationsaga.FieldErrMessage:
+			values[i] = new(sql.NullString)
+		case registrationsaga.FieldCreatedAt, registrationsaga.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RegistrationSaga fields.
+func (rs *RegistrationSaga) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case registrationsaga.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rs.ID = int(value.Int64)
+		case registrationsaga.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				rs.CreatedAt = value.Time
+			}
+		case registrationsaga.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rs.UpdatedAt = value.Time
+			}
+		case registrationsaga.FieldClientID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field client_id", values[i])
+			} else if value.Valid {
+				rs.ClientID = value.Int64
+			}
+		case registrationsaga.FieldIdempotencyKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field idempotency_key", values[i])
+			} else if value.Valid {
+				rs.IdempotencyKey = value.String
+			}
+		case registrationsaga.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				rs.UserID = value.Int64
+			}
+		case registrationsaga.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				rs.Account = value.String
+			}
+		case registrationsaga.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				rs.Email = value.String
+			}
+		case registrationsaga.FieldCountryCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country_code", values[i])
+			} else if value.Valid {
+				rs.CountryCode = value.String
+			}
+		case registrationsaga.FieldMobileNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mobile_number", values[i])
+			} else if value.Valid {
+				rs.MobileNumber = value.String
+			}
+		case registrationsaga.FieldOauthProvider:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field oauth_provider", values[i])
+			} else if value.Valid {
+				rs.OauthProvider = int(value.Int64)
+			}
+		case registrationsaga.FieldOpenID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field open_id", values[i])
+			} else if value.Valid {
+				rs.OpenID = value.String
+			}
+		case registrationsaga.FieldStatus:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				rs.Status = int(value.Int64)
+			}
+		case registrationsaga.FieldErrCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field err_code", values[i])
+			} else if value.Valid {
+				rs.ErrCode = int(value.Int64)
+			}
+		case registrationsaga.FieldErrMessage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field err_message", values[i])
+			} else if value.Valid {
+				rs.ErrMessage = value.String
+			}
+		default:
+			rs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RegistrationSaga.
+// This includes values selected through modifiers, order, etc.
+func (rs *RegistrationSaga) Value(name string) (ent.Value, error) {
+	return rs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RegistrationSaga.
+// Note that you need to call RegistrationSaga.Unwrap() before calling this method if this RegistrationSaga
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rs *RegistrationSaga) Update() *RegistrationSagaUpdateOne {
+	return NewRegistrationSagaClient(rs.config).UpdateOne(rs)
+}
+
+// Unwrap unwraps the RegistrationSaga entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rs *RegistrationSaga) Unwrap() *RegistrationSaga {
+	_tx, ok := rs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RegistrationSaga is not a transactional entity")
+	}
+	rs.config.driver = _tx.drv
+	return rs
+}
+
+// String implements the fmt.Stringer.
+func (rs *RegistrationSaga) String() string {
+	var builder strings.Builder
+	builder.WriteString("RegistrationSaga(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rs.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(rs.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("client_id=")
+	builder.WriteString(fmt.Sprintf("%v", rs.ClientID))
+	builder.WriteString(", ")
+	builder.WriteString("idempotency_key=")
+	builder.WriteString(rs.IdempotencyKey)
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", rs.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(rs.Account)
+	builder.WriteString(", ")
+	builder.WriteString("email=")
+	builder.WriteString(rs.Email)
+	builder.WriteString(", ")
+	builder.WriteString("country_code=")
+	builder.WriteString(rs.CountryCode)
+	builder.WriteString(", ")
+	builder.WriteString("mobile_number=")
+	builder.WriteString(rs.MobileNumber)
+	builder.WriteString(", ")
+	builder.WriteString("oauth_provider=")
+	builder.WriteString(fmt.Sprintf("%v", rs.OauthProvider))
+	builder.WriteString(", ")
+	builder.WriteString("open_id=")
+	builder.WriteString(rs.OpenID)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Status))
+	builder.WriteString(", ")
+	builder.WriteString("err_code=")
+	builder.WriteString(fmt.Sprintf("%v", rs.ErrCode))
+	builder.WriteString(", ")
+	builder.WriteString("err_message=")
+	builder.WriteString(rs.ErrMessage)
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RegistrationSagas is a parsable slice of RegistrationSaga.
+type RegistrationSagas []*RegistrationSaga
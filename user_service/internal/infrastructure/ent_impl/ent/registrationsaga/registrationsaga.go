@@ -0,0 +1,176 @@
+// Code generated by ent, DO NOT EDIT.
+
+package registrationsaga
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the registrationsaga type in the database.
+	Label = "registration_saga"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// FieldClientID holds the string denoting the client_id field in the database.
+	FieldClientID = "client_id"
+	// FieldIdempotencyKey holds the string denoting the idempotency_key field in the database.
+	FieldIdempotencyKey = "idempotency_key"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldEmail holds the string denoting the email field in the database.
+	FieldEmail = "email"
+	// FieldCountryCode holds the string denoting the country_code field in the database.
+	FieldCountryCode = "country_code"
+	// FieldMobileNumber holds the string denoting the mobile_number field in the database.
+	FieldMobileNumber = "mobile_number"
+	// FieldOauthProvider holds the string denoting the oauth_provider field in the database.
+	FieldOauthProvider = "oauth_provider"
+	// FieldOpenID holds the string denoting the open_id field in the database.
+	FieldOpenID = "open_id"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldErrCode holds the string denoting the err_code field in the database.
+	FieldErrCode = "err_code"
+	// FieldErrMessage holds the string denoting the err_message field in the database.
+	FieldErrMessage = "err_message"
+	// Table holds the table name of the registrationsaga in the database.
+	Table = "registration_sagas"
+)
+
+// Columns holds all SQL columns for registrationsaga fields.
+var Columns = []string{
+	FieldID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+	FieldClientID,
+	FieldIdempotencyKey,
+	FieldUserID,
+	FieldAccount,
+	FieldEmail,
+	FieldCountryCode,
+	FieldMobileNumber,
+	FieldOauthProvider,
+	FieldOpenID,
+	FieldStatus,
+	FieldErrCode,
+	FieldErrMessage,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultEmail holds the default value on creation for the "email" field.
+	DefaultEmail string
+	// DefaultCountryCode holds the default value on creation for the "country_code" field.
+	DefaultCountryCode string
+	// DefaultMobileNumber holds the default value on creation for the "mobile_number" field.
+	DefaultMobileNumber string
+	// DefaultOauthProvider holds the default value on creation for the "oauth_provider" field.
+	DefaultOauthProvider int
+	// DefaultOpenID holds the default value on creation for the "open_id" field.
+	DefaultOpenID string
+	// DefaultErrCode holds the default value on creation for the "err_code" field.
+	DefaultErrCode int
+	// DefaultErrMessage holds the default value on creation for the "err_message" field.
+	DefaultErrMessage string
+)
+
+// OrderOption defines the ordering options for the RegistrationSaga queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByClientID orders the results by the client_id field.
+func ByClientID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientID, opts...).ToFunc()
+}
+
+// ByIdempotencyKey orders the results by the idempotency_key field.
+func ByIdempotencyKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIdempotencyKey, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
+// ByEmail orders the results by the email field.
+func ByEmail(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEmail, opts...).ToFunc()
+}
+
+// ByCountryCode orders the results by the country_code field.
+func ByCountryCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCountryCode, opts...).ToFunc()
+}
+
+// ByMobileNumber orders the results by the mobile_number field.
+func ByMobileNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMobileNumber, opts...).ToFunc()
+}
+
+// ByOauthProvider orders the results by the oauth_provider field.
+func ByOauthProvider(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOauthProvider, opts...).ToFunc()
+}
+
+// ByOpenID orders the results by the open_id field.
+func ByOpenID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenID, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByErrCode orders the results by the err_code field.
+func ByErrCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrCode, opts...).ToFunc()
+}
+
+// ByErrMessage orders the results by the err_message field.
+func ByErrMessage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldErrMessage, opts...).ToFunc()
+}
@@ -0,0 +1,875 @@
+// Code generated by ent, DO NOT EDIT.
+
+package registrationsaga
+
+import (
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ClientID applies equality check predicate on the "client_id" field. It's identical to ClientIDEQ.
+func ClientID(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldClientID, v))
+}
+
+// IdempotencyKey applies equality check predicate on the "idempotency_key" field. It's identical to IdempotencyKeyEQ.
+func IdempotencyKey(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldUserID, v))
+}
+
+// Account applies equality check predicate on the "account" field. It's identical to AccountEQ.
+func Account(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldAccount, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldEmail, v))
+}
+
+// CountryCode applies equality check predicate on the "country_code" field. It's identical to CountryCodeEQ.
+func CountryCode(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldCountryCode, v))
+}
+
+// MobileNumber applies equality check predicate on the "mobile_number" field. It's identical to MobileNumberEQ.
+func MobileNumber(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldMobileNumber, v))
+}
+
+// OauthProvider applies equality check predicate on the "oauth_provider" field. It's identical to OauthProviderEQ.
+func OauthProvider(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldOauthProvider, v))
+}
+
+// OpenID applies equality check predicate on the "open_id" field. It's identical to OpenIDEQ.
+func OpenID(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldOpenID, v))
+}
+
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldStatus, v))
+}
+
+// ErrCode applies equality check predicate on the "err_code" field. It's identical to ErrCodeEQ.
+func ErrCode(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldErrCode, v))
+}
+
+// ErrMessage applies equality check predicate on the "err_message" field. It's identical to ErrMessageEQ.
+func ErrMessage(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldErrMessage, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// ClientIDEQ applies the EQ predicate on the "client_id" field.
+func ClientIDEQ(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldClientID, v))
+}
+
+// ClientIDNEQ applies the NEQ predicate on the "client_id" field.
+func ClientIDNEQ(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldClientID, v))
+}
+
+// ClientIDIn applies the In predicate on the "client_id" field.
+func ClientIDIn(vs ...int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldClientID, vs...))
+}
+
+// ClientIDNotIn applies the NotIn predicate on the "client_id" field.
+func ClientIDNotIn(vs ...int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldClientID, vs...))
+}
+
+// ClientIDGT applies the GT predicate on the "client_id" field.
+func ClientIDGT(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldClientID, v))
+}
+
+// ClientIDGTE applies the GTE predicate on the "client_id" field.
+func ClientIDGTE(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldClientID, v))
+}
+
+// ClientIDLT applies the LT predicate on the "client_id" field.
+func ClientIDLT(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldClientID, v))
+}
+
+// ClientIDLTE applies the LTE predicate on the "client_id" field.
+func ClientIDLTE(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldClientID, v))
+}
+
+// IdempotencyKeyEQ applies the EQ predicate on the "idempotency_key" field.
+func IdempotencyKeyEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyNEQ applies the NEQ predicate on the "idempotency_key" field.
+func IdempotencyKeyNEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyIn applies the In predicate on the "idempotency_key" field.
+func IdempotencyKeyIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyNotIn applies the NotIn predicate on the "idempotency_key" field.
+func IdempotencyKeyNotIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldIdempotencyKey, vs...))
+}
+
+// IdempotencyKeyGT applies the GT predicate on the "idempotency_key" field.
+func IdempotencyKeyGT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyGTE applies the GTE predicate on the "idempotency_key" field.
+func IdempotencyKeyGTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLT applies the LT predicate on the "idempotency_key" field.
+func IdempotencyKeyLT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyLTE applies the LTE predicate on the "idempotency_key" field.
+func IdempotencyKeyLTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContains applies the Contains predicate on the "idempotency_key" field.
+func IdempotencyKeyContains(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContains(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasPrefix applies the HasPrefix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasPrefix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasPrefix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyHasSuffix applies the HasSuffix predicate on the "idempotency_key" field.
+func IdempotencyKeyHasSuffix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasSuffix(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyEqualFold applies the EqualFold predicate on the "idempotency_key" field.
+func IdempotencyKeyEqualFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEqualFold(FieldIdempotencyKey, v))
+}
+
+// IdempotencyKeyContainsFold applies the ContainsFold predicate on the "idempotency_key" field.
+func IdempotencyKeyContainsFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContainsFold(FieldIdempotencyKey, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v int64) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldUserID, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// AccountGT applies the GT predicate on the "account" field.
+func AccountGT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldAccount, v))
+}
+
+// AccountGTE applies the GTE predicate on the "account" field.
+func AccountGTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldAccount, v))
+}
+
+// AccountLT applies the LT predicate on the "account" field.
+func AccountLT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldAccount, v))
+}
+
+// AccountLTE applies the LTE predicate on the "account" field.
+func AccountLTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldAccount, v))
+}
+
+// AccountContains applies the Contains predicate on the "account" field.
+func AccountContains(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContains(FieldAccount, v))
+}
+
+// AccountHasPrefix applies the HasPrefix predicate on the "account" field.
+func AccountHasPrefix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasPrefix(FieldAccount, v))
+}
+
+// AccountHasSuffix applies the HasSuffix predicate on the "account" field.
+func AccountHasSuffix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasSuffix(FieldAccount, v))
+}
+
+// AccountEqualFold applies the EqualFold predicate on the "account" field.
+func AccountEqualFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEqualFold(FieldAccount, v))
+}
+
+// AccountContainsFold applies the ContainsFold predicate on the "account" field.
+func AccountContainsFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContainsFold(FieldAccount, v))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// CountryCodeEQ applies the EQ predicate on the "country_code" field.
+func CountryCodeEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldCountryCode, v))
+}
+
+// CountryCodeNEQ applies the NEQ predicate on the "country_code" field.
+func CountryCodeNEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldCountryCode, v))
+}
+
+// CountryCodeIn applies the In predicate on the "country_code" field.
+func CountryCodeIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldCountryCode, vs...))
+}
+
+// CountryCodeNotIn applies the NotIn predicate on the "country_code" field.
+func CountryCodeNotIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldCountryCode, vs...))
+}
+
+// CountryCodeGT applies the GT predicate on the "country_code" field.
+func CountryCodeGT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldCountryCode, v))
+}
+
+// CountryCodeGTE applies the GTE predicate on the "country_code" field.
+func CountryCodeGTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldCountryCode, v))
+}
+
+// CountryCodeLT applies the LT predicate on the "country_code" field.
+func CountryCodeLT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldCountryCode, v))
+}
+
+// CountryCodeLTE applies the LTE predicate on the "country_code" field.
+func CountryCodeLTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldCountryCode, v))
+}
+
+// CountryCodeContains applies the Contains predicate on the "country_code" field.
+func CountryCodeContains(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContains(FieldCountryCode, v))
+}
+
+// CountryCodeHasPrefix applies the HasPrefix predicate on the "country_code" field.
+func CountryCodeHasPrefix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasPrefix(FieldCountryCode, v))
+}
+
+// CountryCodeHasSuffix applies the HasSuffix predicate on the "country_code" field.
+func CountryCodeHasSuffix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasSuffix(FieldCountryCode, v))
+}
+
+// CountryCodeEqualFold applies the EqualFold predicate on the "country_code" field.
+func CountryCodeEqualFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEqualFold(FieldCountryCode, v))
+}
+
+// CountryCodeContainsFold applies the ContainsFold predicate on the "country_code" field.
+func CountryCodeContainsFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContainsFold(FieldCountryCode, v))
+}
+
+// MobileNumberEQ applies the EQ predicate on the "mobile_number" field.
+func MobileNumberEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldMobileNumber, v))
+}
+
+// MobileNumberNEQ applies the NEQ predicate on the "mobile_number" field.
+func MobileNumberNEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldMobileNumber, v))
+}
+
+// MobileNumberIn applies the In predicate on the "mobile_number" field.
+func MobileNumberIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldMobileNumber, vs...))
+}
+
+// MobileNumberNotIn applies the NotIn predicate on the "mobile_number" field.
+func MobileNumberNotIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldMobileNumber, vs...))
+}
+
+// MobileNumberGT applies the GT predicate on the "mobile_number" field.
+func MobileNumberGT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldMobileNumber, v))
+}
+
+// MobileNumberGTE applies the GTE predicate on the "mobile_number" field.
+func MobileNumberGTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldMobileNumber, v))
+}
+
+// MobileNumberLT applies the LT predicate on the "mobile_number" field.
+func MobileNumberLT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldMobileNumber, v))
+}
+
+// MobileNumberLTE applies the LTE predicate on the "mobile_number" field.
+func MobileNumberLTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldMobileNumber, v))
+}
+
+// MobileNumberContains applies the Contains predicate on the "mobile_number" field.
+func MobileNumberContains(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContains(FieldMobileNumber, v))
+}
+
+// MobileNumberHasPrefix applies the HasPrefix predicate on the "mobile_number" field.
+func MobileNumberHasPrefix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasPrefix(FieldMobileNumber, v))
+}
+
+// MobileNumberHasSuffix applies the HasSuffix predicate on the "mobile_number" field.
+func MobileNumberHasSuffix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasSuffix(FieldMobileNumber, v))
+}
+
+// MobileNumberEqualFold applies the EqualFold predicate on the "mobile_number" field.
+func MobileNumberEqualFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEqualFold(FieldMobileNumber, v))
+}
+
+// MobileNumberContainsFold applies the ContainsFold predicate on the "mobile_number" field.
+func MobileNumberContainsFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContainsFold(FieldMobileNumber, v))
+}
+
+// OauthProviderEQ applies the EQ predicate on the "oauth_provider" field.
+func OauthProviderEQ(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldOauthProvider, v))
+}
+
+// OauthProviderNEQ applies the NEQ predicate on the "oauth_provider" field.
+func OauthProviderNEQ(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldOauthProvider, v))
+}
+
+// OauthProviderIn applies the In predicate on the "oauth_provider" field.
+func OauthProviderIn(vs ...int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldOauthProvider, vs...))
+}
+
+// OauthProviderNotIn applies the NotIn predicate on the "oauth_provider" field.
+func OauthProviderNotIn(vs ...int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldOauthProvider, vs...))
+}
+
+// OauthProviderGT applies the GT predicate on the "oauth_provider" field.
+func OauthProviderGT(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldOauthProvider, v))
+}
+
+// OauthProviderGTE applies the GTE predicate on the "oauth_provider" field.
+func OauthProviderGTE(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldOauthProvider, v))
+}
+
+// OauthProviderLT applies the LT predicate on the "oauth_provider" field.
+func OauthProviderLT(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldOauthProvider, v))
+}
+
+// OauthProviderLTE applies the LTE predicate on the "oauth_provider" field.
+func OauthProviderLTE(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldOauthProvider, v))
+}
+
+// OpenIDEQ applies the EQ predicate on the "open_id" field.
+func OpenIDEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldOpenID, v))
+}
+
+// OpenIDNEQ applies the NEQ predicate on the "open_id" field.
+func OpenIDNEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldOpenID, v))
+}
+
+// OpenIDIn applies the In predicate on the "open_id" field.
+func OpenIDIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldOpenID, vs...))
+}
+
+// OpenIDNotIn applies the NotIn predicate on the "open_id" field.
+func OpenIDNotIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldOpenID, vs...))
+}
+
+// OpenIDGT applies the GT predicate on the "open_id" field.
+func OpenIDGT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldOpenID, v))
+}
+
+// OpenIDGTE applies the GTE predicate on the "open_id" field.
+func OpenIDGTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldOpenID, v))
+}
+
+// OpenIDLT applies the LT predicate on the "open_id" field.
+func OpenIDLT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldOpenID, v))
+}
+
+// OpenIDLTE applies the LTE predicate on the "open_id" field.
+func OpenIDLTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldOpenID, v))
+}
+
+// OpenIDContains applies the Contains predicate on the "open_id" field.
+func OpenIDContains(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContains(FieldOpenID, v))
+}
+
+// OpenIDHasPrefix applies the HasPrefix predicate on the "open_id" field.
+func OpenIDHasPrefix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasPrefix(FieldOpenID, v))
+}
+
+// OpenIDHasSuffix applies the HasSuffix predicate on the "open_id" field.
+func OpenIDHasSuffix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasSuffix(FieldOpenID, v))
+}
+
+// OpenIDEqualFold applies the EqualFold predicate on the "open_id" field.
+func OpenIDEqualFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEqualFold(FieldOpenID, v))
+}
+
+// OpenIDContainsFold applies the ContainsFold predicate on the "open_id" field.
+func OpenIDContainsFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContainsFold(FieldOpenID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldStatus, v))
+}
+
+// ErrCodeEQ applies the EQ predicate on the "err_code" field.
+func ErrCodeEQ(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldErrCode, v))
+}
+
+// ErrCodeNEQ applies the NEQ predicate on the "err_code" field.
+func ErrCodeNEQ(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldErrCode, v))
+}
+
+// ErrCodeIn applies the In predicate on the "err_code" field.
+func ErrCodeIn(vs ...int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldErrCode, vs...))
+}
+
+// ErrCodeNotIn applies the NotIn predicate on the "err_code" field.
+func ErrCodeNotIn(vs ...int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldErrCode, vs...))
+}
+
+// ErrCodeGT applies the GT predicate on the "err_code" field.
+func ErrCodeGT(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldErrCode, v))
+}
+
+// ErrCodeGTE applies the GTE predicate on the "err_code" field.
+func ErrCodeGTE(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldErrCode, v))
+}
+
+// ErrCodeLT applies the LT predicate on the "err_code" field.
+func ErrCodeLT(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldErrCode, v))
+}
+
+// ErrCodeLTE applies the LTE predicate on the "err_code" field.
+func ErrCodeLTE(v int) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldErrCode, v))
+}
+
+// ErrMessageEQ applies the EQ predicate on the "err_message" field.
+func ErrMessageEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEQ(FieldErrMessage, v))
+}
+
+// ErrMessageNEQ applies the NEQ predicate on the "err_message" field.
+func ErrMessageNEQ(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNEQ(FieldErrMessage, v))
+}
+
+// ErrMessageIn applies the In predicate on the "err_message" field.
+func ErrMessageIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldIn(FieldErrMessage, vs...))
+}
+
+// ErrMessageNotIn applies the NotIn predicate on the "err_message" field.
+func ErrMessageNotIn(vs ...string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldNotIn(FieldErrMessage, vs...))
+}
+
+// ErrMessageGT applies the GT predicate on the "err_message" field.
+func ErrMessageGT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGT(FieldErrMessage, v))
+}
+
+// ErrMessageGTE applies the GTE predicate on the "err_message" field.
+func ErrMessageGTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldGTE(FieldErrMessage, v))
+}
+
+// ErrMessageLT applies the LT predicate on the "err_message" field.
+func ErrMessageLT(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLT(FieldErrMessage, v))
+}
+
+// ErrMessageLTE applies the LTE predicate on the "err_message" field.
+func ErrMessageLTE(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldLTE(FieldErrMessage, v))
+}
+
+// ErrMessageContains applies the Contains predicate on the "err_message" field.
+func ErrMessageContains(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContains(FieldErrMessage, v))
+}
+
+// ErrMessageHasPrefix applies the HasPrefix predicate on the "err_message" field.
+func ErrMessageHasPrefix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasPrefix(FieldErrMessage, v))
+}
+
+// ErrMessageHasSuffix applies the HasSuffix predicate on the "err_message" field.
+func ErrMessageHasSuffix(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldHasSuffix(FieldErrMessage, v))
+}
+
+// ErrMessageEqualFold applies the EqualFold predicate on the "err_message" field.
+func ErrMessageEqualFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldEqualFold(FieldErrMessage, v))
+}
+
+// ErrMessageContainsFold applies the ContainsFold predicate on the "err_message" field.
+func ErrMessageContainsFold(v string) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.FieldContainsFold(FieldErrMessage, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RegistrationSaga) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RegistrationSaga) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RegistrationSaga) predicate.RegistrationSaga {
+	return predicate.RegistrationSaga(sql.NotPredicates(p))
+}
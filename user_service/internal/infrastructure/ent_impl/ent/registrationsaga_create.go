@@ -0,0 +1,467 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegistrationSagaCreate is the builder for creating a RegistrationSaga entity.
+type RegistrationSagaCreate struct {
+	config
+	mutation *RegistrationSagaMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (rsc *RegistrationSagaCreate) SetCreatedAt(t time.Time) *RegistrationSagaCreate {
+	rsc.mutation.SetCreatedAt(t)
+	return rsc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableCreatedAt(t *time.Time) *RegistrationSagaCreate {
+	if t != nil {
+		rsc.SetCreatedAt(*t)
+	}
+	return rsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsc *RegistrationSagaCreate) SetUpdatedAt(t time.Time) *RegistrationSagaCreate {
+	rsc.mutation.SetUpdatedAt(t)
+	return rsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableUpdatedAt(t *time.Time) *RegistrationSagaCreate {
+	if t != nil {
+		rsc.SetUpdatedAt(*t)
+	}
+	return rsc
+}
+
+// SetClientID sets the "client_id" field.
+func (rsc *RegistrationSagaCreate) SetClientID(i int64) *RegistrationSagaCreate {
+	rsc.mutation.SetClientID(i)
+	return rsc
+}
+
+// SetIdempotencyKey sets the "idempotency_key" field.
+func (rsc *RegistrationSagaCreate) SetIdempotencyKey(s string) *RegistrationSagaCreate {
+	rsc.mutation.SetIdempotencyKey(s)
+	return rsc
+}
+
+// SetUserID sets the "user_id" field.
+func (rsc *RegistrationSagaCreate) SetUserID(i int64) *RegistrationSagaCreate {
+	rsc.mutation.SetUserID(i)
+	return rsc
+}
+
+// SetAccount sets the "account" field.
+func (rsc *RegistrationSagaCreate) SetAccount(s string) *RegistrationSagaCreate {
+	rsc.mutation.SetAccount(s)
+	return rsc
+}
+
+// SetEmail sets the "email" field.
+func (rsc *RegistrationSagaCreate) SetEmail(s string) *RegistrationSagaCreate {
+	rsc.mutation.SetEmail(s)
+	return rsc
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableEmail(s *string) *RegistrationSagaCreate {
+	if s != nil {
+		rsc.SetEmail(*s)
+	}
+	return rsc
+}
+
+// SetCountryCode sets the "country_code" field.
+func (rsc *RegistrationSagaCreate) SetCountryCode(s string) *RegistrationSagaCreate {
+	rsc.mutation.SetCountryCode(s)
+	return rsc
+}
+
+// SetNillableCountryCode sets the "country_code" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableCountryCode(s *string) *RegistrationSagaCreate {
+	if s != nil {
+		rsc.SetCountryCode(*s)
+	}
+	return rsc
+}
+
+// SetMobileNumber sets the "mobile_number" field.
+func (rsc *RegistrationSagaCreate) SetMobileNumber(s string) *RegistrationSagaCreate {
+	rsc.mutation.SetMobileNumber(s)
+	return rsc
+}
+
+// SetNillableMobileNumber sets the "mobile_number" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableMobileNumber(s *string) *RegistrationSagaCreate {
+	if s != nil {
+		rsc.SetMobileNumber(*s)
+	}
+	return rsc
+}
+
+// SetOauthProvider sets the "oauth_provider" field.
+func (rsc *RegistrationSagaCreate) SetOauthProvider(i int) *RegistrationSagaCreate {
+	rsc.mutation.SetOauthProvider(i)
+	return rsc
+}
+
+// SetNillableOauthProvider sets the "oauth_provider" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableOauthProvider(i *int) *RegistrationSagaCreate {
+	if i != nil {
+		rsc.SetOauthProvider(*i)
+	}
+	return rsc
+}
+
+// SetOpenID sets the "open_id" field.
+func (rsc *RegistrationSagaCreate) SetOpenID(s string) *RegistrationSagaCreate {
+	rsc.mutation.SetOpenID(s)
+	return rsc
+}
+
+// SetNillableOpenID sets the "open_id" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableOpenID(s *string) *RegistrationSagaCreate {
+	if s != nil {
+		rsc.SetOpenID(*s)
+	}
+	return rsc
+}
+
+// SetStatus sets the "status" field.
+func (rsc *RegistrationSagaCreate) SetStatus(i int) *RegistrationSagaCreate {
+	rsc.mutation.SetStatus(i)
+	return rsc
+}
+
+// SetErrCode sets the "err_code" field.
+func (rsc *RegistrationSagaCreate) SetErrCode(i int) *RegistrationSagaCreate {
+	rsc.mutation.SetErrCode(i)
+	return rsc
+}
+
+// SetNillableErrCode sets the "err_code" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableErrCode(i *int) *RegistrationSagaCreate {
+	if i != nil {
+		rsc.SetErrCode(*i)
+	}
+	return rsc
+}
+
+// SetErrMessage sets the "err_message" field.
+func (rsc *RegistrationSagaCreate) SetErrMessage(s string) *RegistrationSagaCreate {
+	rsc.mutation.SetErrMessage(s)
+	return rsc
+}
+
+// SetNillableErrMessage sets the "err_message" field if the given value is not nil.
+func (rsc *RegistrationSagaCreate) SetNillableErrMessage(s *string) *RegistrationSagaCreate {
+	if s != nil {
+		rsc.SetErrMessage(*s)
+	}
+	return rsc
+}
+
+// Mutation returns the RegistrationSagaMutation object of the builder.
+func (rsc *RegistrationSagaCreate) Mutation() *RegistrationSagaMutation {
+	return rsc.mutation
+}
+
+// Save creates the RegistrationSaga in the database.
+func (rsc *RegistrationSagaCreate) Save(ctx context.Context) (*RegistrationSaga, error) {
+	rsc.defaults()
+	return withHooks(ctx, rsc.sqlSave, rsc.mutation, rsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rsc *RegistrationSagaCreate) SaveX(ctx context.Context) *RegistrationSaga {
+	v, err := rsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rsc *RegistrationSagaCreate) Exec(ctx context.Context) error {
+	_, err := rsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsc *RegistrationSagaCreate) ExecX(ctx context.Context) {
+	if err := rsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsc *RegistrationSagaCreate) defaults() {
+	if _, ok := rsc.mutation.CreatedAt(); !ok {
+		v := registrationsaga.DefaultCreatedAt()
+		rsc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		v := registrationsaga.DefaultUpdatedAt()
+		rsc.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := rsc.mutation.Email(); !ok {
+		v := registrationsaga.DefaultEmail
+		rsc.mutation.SetEmail(v)
+	}
+	if _, ok := rsc.mutation.CountryCode(); !ok {
+		v := registrationsaga.DefaultCountryCode
+		rsc.mutation.SetCountryCode(v)
+	}
+	if _, ok := rsc.mutation.MobileNumber(); !ok {
+		v := registrationsaga.DefaultMobileNumber
+		rsc.mutation.SetMobileNumber(v)
+	}
+	if _, ok := rsc.mutation.OauthProvider(); !ok {
+		v := registrationsaga.DefaultOauthProvider
+		rsc.mutation.SetOauthProvider(v)
+	}
+	if _, ok := rsc.mutation.OpenID(); !ok {
+		v := registrationsaga.DefaultOpenID
+		rsc.mutation.SetOpenID(v)
+	}
+	if _, ok := rsc.mutation.ErrCode(); !ok {
+		v := registrationsaga.DefaultErrCode
+		rsc.mutation.SetErrCode(v)
+	}
+	if _, ok := rsc.mutation.ErrMessage(); !ok {
+		v := registrationsaga.DefaultErrMessage
+		rsc.mutation.SetErrMessage(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsc *RegistrationSagaCreate) check() error {
+	if _, ok := rsc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RegistrationSaga.created_at"`)}
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RegistrationSaga.updated_at"`)}
+	}
+	if _, ok := rsc.mutation.ClientID(); !ok {
+		return &ValidationError{Name: "client_id", err: errors.New(`ent: missing required field "RegistrationSaga.client_id"`)}
+	}
+	if _, ok := rsc.mutation.IdempotencyKey(); !ok {
+		return &ValidationError{Name: "idempotency_key", err: errors.New(`ent: missing required field "RegistrationSaga.idempotency_key"`)}
+	}
+	if _, ok := rsc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RegistrationSaga.user_id"`)}
+	}
+	if _, ok := rsc.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "RegistrationSaga.account"`)}
+	}
+	if _, ok := rsc.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "RegistrationSaga.email"`)}
+	}
+	if _, ok := rsc.mutation.CountryCode(); !ok {
+		return &ValidationError{Name: "country_code", err: errors.New(`ent: missing required field "RegistrationSaga.country_code"`)}
+	}
+	if _, ok := rsc.mutation.MobileNumber(); !ok {
+		return &ValidationError{Name: "mobile_number", err: errors.New(`ent: missing required field "RegistrationSaga.mobile_number"`)}
+	}
+	if _, ok := rsc.mutation.OauthProvider(); !ok {
+		return &ValidationError{Name: "oauth_provider", err: errors.New(`ent: missing required field "RegistrationSaga.oauth_provider"`)}
+	}
+	if _, ok := rsc.mutation.OpenID(); !ok {
+		return &ValidationError{Name: "open_id", err: errors.New(`ent: missing required field "RegistrationSaga.open_id"`)}
+	}
+	if _, ok := rsc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "RegistrationSaga.status"`)}
+	}
+	if _, ok := rsc.mutation.ErrCode(); !ok {
+		return &ValidationError{Name: "err_code", err: errors.New(`ent: missing required field "RegistrationSaga.err_code"`)}
+	}
+	if _, ok := rsc.mutation.ErrMessage(); !ok {
+		return &ValidationError{Name: "err_message", err: errors.New(`ent: missing required field "RegistrationSaga.err_message"`)}
+	}
+	return nil
+}
+
+func (rsc *RegistrationSagaCreate) sqlSave(ctx context.Context) (*RegistrationSaga, error) {
+	if err := rsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rsc.mutation.id = &_node.ID
+	rsc.mutation.done = true
+	return _node, nil
+}
+
+func (rsc *RegistrationSagaCreate) createSpec() (*RegistrationSaga, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RegistrationSaga{config: rsc.config}
+		_spec = sqlgraph.NewCreateSpec(registrationsaga.Table, sqlgraph.NewFieldSpec(registrationsaga.FieldID, field.TypeInt))
+	)
+	if value, ok := rsc.mutation.CreatedAt(); ok {
+		_spec.SetField(registrationsaga.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := rsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationsaga.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := rsc.mutation.ClientID(); ok {
+		_spec.SetField(registrationsaga.FieldClientID, field.TypeInt64, value)
+		_node.ClientID = value
+	}
+	if value, ok := rsc.mutation.IdempotencyKey(); ok {
+		_spec.SetField(registrationsaga.FieldIdempotencyKey, field.TypeString, value)
+		_node.IdempotencyKey = value
+	}
+	if value, ok := rsc.mutation.UserID(); ok {
+		_spec.SetField(registrationsaga.FieldUserID, field.TypeInt64, value)
+		_node.UserID = value
+	}
+	if value, ok := rsc.mutation.Account(); ok {
+		_spec.SetField(registrationsaga.FieldAccount, field.TypeString, value)
+		_node.Account = value
+	}
+	if value, ok := rsc.mutation.Email(); ok {
+		_spec.SetField(registrationsaga.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := rsc.mutation.CountryCode(); ok {
+		_spec.SetField(registrationsaga.FieldCountryCode, field.TypeString, value)
+		_node.CountryCode = value
+	}
+	if value, ok := rsc.mutation.MobileNumber(); ok {
+		_spec.SetField(registrationsaga.FieldMobileNumber, field.TypeString, value)
+		_node.MobileNumber = value
+	}
+	if value, ok := rsc.mutation.OauthProvider(); ok {
+		_spec.SetField(registrationsaga.FieldOauthProvider, field.TypeInt, value)
+		_node.OauthProvider = value
+	}
+	if value, ok := rsc.mutation.OpenID(); ok {
+		_spec.SetField(registrationsaga.FieldOpenID, field.TypeString, value)
+		_node.OpenID = value
+	}
+	if value, ok := rsc.mutation.Status(); ok {
+		_spec.SetField(registrationsaga.FieldStatus, field.TypeInt, value)
+		_node.Status = value
+	}
+	if value, ok := rsc.mutation.ErrCode(); ok {
+		_spec.SetField(registrationsaga.FieldErrCode, field.TypeInt, value)
+		_node.ErrCode = value
+	}
+	if value, ok := rsc.mutation.ErrMessage(); ok {
+		_spec.SetField(registrationsaga.FieldErrMessage, field.TypeString, value)
+		_node.ErrMessage = value
+	}
+	return _node, _spec
+}
+
+// RegistrationSagaCreateBulk is the builder for creating many RegistrationSaga entities in bulk.
+type RegistrationSagaCreateBulk struct {
+	config
+	err      error
+	builders []*RegistrationSagaCreate
+}
+
+// Save creates the RegistrationSaga entities in the database.
+func (rscb *RegistrationSagaCreateBulk) Save(ctx context.Context) ([]*RegistrationSaga, error) {
+	if rscb.err != nil {
+		return nil, rscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rscb.builders))
+	nodes := make([]*RegistrationSaga, len(rscb.builders))
+	mutators := make([]Mutator, len(rscb.builders))
+	for i := range rscb.builders {
+		func(i int, root context.Context) {
+			builder := rscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RegistrationSagaMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rscb *RegistrationSagaCreateBulk) SaveX(ctx context.Context) []*RegistrationSaga {
+	v, err := rscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rscb *RegistrationSagaCreateBulk) Exec(ctx context.Context) error {
+	_, err := rscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rscb *RegistrationSagaCreateBulk) ExecX(ctx context.Context) {
+	if err := rscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegistrationSagaDelete is the builder for deleting a RegistrationSaga entity.
+type RegistrationSagaDelete struct {
+	config
+	hooks    []Hook
+	mutation *RegistrationSagaMutation
+}
+
+// Where appends a list predicates to the RegistrationSagaDelete builder.
+func (rsd *RegistrationSagaDelete) Where(ps ...predicate.RegistrationSaga) *RegistrationSagaDelete {
+	rsd.mutation.Where(ps...)
+	return rsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rsd *RegistrationSagaDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsd.sqlExec, rsd.mutation, rsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsd *RegistrationSagaDelete) ExecX(ctx context.Context) int {
+	n, err := rsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rsd *RegistrationSagaDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(registrationsaga.Table, sqlgraph.NewFieldSpec(registrationsaga.FieldID, field.TypeInt))
+	if ps := rsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rsd.mutation.done = true
+	return affected, err
+}
+
+// RegistrationSagaDeleteOne is the builder for deleting a single RegistrationSaga entity.
+type RegistrationSagaDeleteOne struct {
+	rsd *RegistrationSagaDelete
+}
+
+// Where appends a list predicates to the RegistrationSagaDelete builder.
+func (rsdo *RegistrationSagaDeleteOne) Where(ps ...predicate.RegistrationSaga) *RegistrationSagaDeleteOne {
+	rsdo.rsd.mutation.Where(ps...)
+	return rsdo
+}
+
+// Exec executes the deletion query.
+func (rsdo *RegistrationSagaDeleteOne) Exec(ctx context.Context) error {
+	n, err := rsdo.rsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{registrationsaga.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsdo *RegistrationSagaDeleteOne) ExecX(ctx context.Context) {
+	if err := rsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,354 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// RegistrationSagaUpdate is the builder for updating RegistrationSaga entities.
+type RegistrationSagaUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RegistrationSagaMutation
+}
+
+// Where appends a list predicates to the RegistrationSagaUpdate builder.
+func (rsu *RegistrationSagaUpdate) Where(ps ...predicate.RegistrationSaga) *RegistrationSagaUpdate {
+	rsu.mutation.Where(ps...)
+	return rsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsu *RegistrationSagaUpdate) SetUpdatedAt(t time.Time) *RegistrationSagaUpdate {
+	rsu.mutation.SetUpdatedAt(t)
+	return rsu
+}
+
+// SetStatus sets the "status" field.
+func (rsu *RegistrationSagaUpdate) SetStatus(i int) *RegistrationSagaUpdate {
+	rsu.mutation.ResetStatus()
+	rsu.mutation.SetStatus(i)
+	return rsu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rsu *RegistrationSagaUpdate) SetNillableStatus(i *int) *RegistrationSagaUpdate {
+	if i != nil {
+		rsu.SetStatus(*i)
+	}
+	return rsu
+}
+
+// AddStatus adds i to the "status" field.
+func (rsu *RegistrationSagaUpdate) AddStatus(i int) *RegistrationSagaUpdate {
+	rsu.mutation.AddStatus(i)
+	return rsu
+}
+
+// SetErrCode sets the "err_code" field.
+func (rsu *RegistrationSagaUpdate) SetErrCode(i int) *RegistrationSagaUpdate {
+	rsu.mutation.ResetErrCode()
+	rsu.mutation.SetErrCode(i)
+	return rsu
+}
+
+// SetNillableErrCode sets the "err_code" field if the given value is not nil.
+func (rsu *RegistrationSagaUpdate) SetNillableErrCode(i *int) *RegistrationSagaUpdate {
+	if i != nil {
+		rsu.SetErrCode(*i)
+	}
+	return rsu
+}
+
+// AddErrCode adds i to the "err_code" field.
+func (rsu *RegistrationSagaUpdate) AddErrCode(i int) *RegistrationSagaUpdate {
+	rsu.mutation.AddErrCode(i)
+	return rsu
+}
+
+// SetErrMessage sets the "err_message" field.
+func (rsu *RegistrationSagaUpdate) SetErrMessage(s string) *RegistrationSagaUpdate {
+	rsu.mutation.SetErrMessage(s)
+	return rsu
+}
+
+// SetNillableErrMessage sets the "err_message" field if the given value is not nil.
+func (rsu *RegistrationSagaUpdate) SetNillableErrMessage(s *string) *RegistrationSagaUpdate {
+	if s != nil {
+		rsu.SetErrMessage(*s)
+	}
+	return rsu
+}
+
+// Mutation returns the RegistrationSagaMutation object of the builder.
+func (rsu *RegistrationSagaUpdate) Mutation() *RegistrationSagaMutation {
+	return rsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rsu *RegistrationSagaUpdate) Save(ctx context.Context) (int, error) {
+	rsu.defaults()
+	return withHooks(ctx, rsu.sqlSave, rsu.mutation, rsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsu *RegistrationSagaUpdate) SaveX(ctx context.Context) int {
+	affected, err := rsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rsu *RegistrationSagaUpdate) Exec(ctx context.Context) error {
+	_, err := rsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsu *RegistrationSagaUpdate) ExecX(ctx context.Context) {
+	if err := rsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsu *RegistrationSagaUpdate) defaults() {
+	if _, ok := rsu.mutation.UpdatedAt(); !ok {
+		v := registrationsaga.UpdateDefaultUpdatedAt()
+		rsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (rsu *RegistrationSagaUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(registrationsaga.Table, registrationsaga.Columns, sqlgraph.NewFieldSpec(registrationsaga.FieldID, field.TypeInt))
+	if ps := rsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationsaga.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rsu.mutation.Status(); ok {
+		_spec.SetField(registrationsaga.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.AddedStatus(); ok {
+		_spec.AddField(registrationsaga.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.ErrCode(); ok {
+		_spec.SetField(registrationsaga.FieldErrCode, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.AddedErrCode(); ok {
+		_spec.AddField(registrationsaga.FieldErrCode, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.ErrMessage(); ok {
+		_spec.SetField(registrationsaga.FieldErrMessage, field.TypeString, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationsaga.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rsu.mutation.done = true
+	return n, nil
+}
+
+// RegistrationSagaUpdateOne is the builder for updating a single RegistrationSaga entity.
+type RegistrationSagaUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RegistrationSagaMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsuo *RegistrationSagaUpdateOne) SetUpdatedAt(t time.Time) *RegistrationSagaUpdateOne {
+	rsuo.mutation.SetUpdatedAt(t)
+	return rsuo
+}
+
+// SetStatus sets the "status" field.
+func (rsuo *RegistrationSagaUpdateOne) SetStatus(i int) *RegistrationSagaUpdateOne {
+	rsuo.mutation.ResetStatus()
+	rsuo.mutation.SetStatus(i)
+	return rsuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (rsuo *RegistrationSagaUpdateOne) SetNillableStatus(i *int) *RegistrationSagaUpdateOne {
+	if i != nil {
+		rsuo.SetStatus(*i)
+	}
+	return rsuo
+}
+
+// AddStatus adds i to the "status" field.
+func (rsuo *RegistrationSagaUpdateOne) AddStatus(i int) *RegistrationSagaUpdateOne {
+	rsuo.mutation.AddStatus(i)
+	return rsuo
+}
+
+// SetErrCode sets the "err_code" field.
+func (rsuo *RegistrationSagaUpdateOne) SetErrCode(i int) *RegistrationSagaUpdateOne {
+	rsuo.mutation.ResetErrCode()
+	rsuo.mutation.SetErrCode(i)
+	return rsuo
+}
+
+// SetNillableErrCode sets the "err_code" field if the given value is not nil.
+func (rsuo *RegistrationSagaUpdateOne) SetNillableErrCode(i *int) *RegistrationSagaUpdateOne {
+	if i != nil {
+		rsuo.SetErrCode(*i)
+	}
+	return rsuo
+}
+
+// AddErrCode adds i to the "err_code" field.
+func (rsuo *RegistrationSagaUpdateOne) AddErrCode(i int) *RegistrationSagaUpdateOne {
+	rsuo.mutation.AddErrCode(i)
+	return rsuo
+}
+
+// SetErrMessage sets the "err_message" field.
+func (rsuo *RegistrationSagaUpdateOne) SetErrMessage(s string) *RegistrationSagaUpdateOne {
+	rsuo.mutation.SetErrMessage(s)
+	return rsuo
+}
+
+// SetNillableErrMessage sets the "err_message" field if the given value is not nil.
+func (rsuo *RegistrationSagaUpdateOne) SetNillableErrMessage(s *string) *RegistrationSagaUpdateOne {
+	if s != nil {
+		rsuo.SetErrMessage(*s)
+	}
+	return rsuo
+}
+
+// Mutation returns the RegistrationSagaMutation object of the builder.
+func (rsuo *RegistrationSagaUpdateOne) Mutation() *RegistrationSagaMutation {
+	return rsuo.mutation
+}
+
+// Where appends a list predicates to the RegistrationSagaUpdate builder.
+func (rsuo *RegistrationSagaUpdateOne) Where(ps ...predicate.RegistrationSaga) *RegistrationSagaUpdateOne {
+	rsuo.mutation.Where(ps...)
+	return rsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rsuo *RegistrationSagaUpdateOne) Select(field string, fields ...string) *RegistrationSagaUpdateOne {
+	rsuo.fields = append([]string{field}, fields...)
+	return rsuo
+}
+
+// Save executes the query and returns the updated RegistrationSaga entity.
+func (rsuo *RegistrationSagaUpdateOne) Save(ctx context.Context) (*RegistrationSaga, error) {
+	rsuo.defaults()
+	return withHooks(ctx, rsuo.sqlSave, rsuo.mutation, rsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsuo *RegistrationSagaUpdateOne) SaveX(ctx context.Context) *RegistrationSaga {
+	node, err := rsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rsuo *RegistrationSagaUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsuo *RegistrationSagaUpdateOne) ExecX(ctx context.Context) {
+	if err := rsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsuo *RegistrationSagaUpdateOne) defaults() {
+	if _, ok := rsuo.mutation.UpdatedAt(); !ok {
+		v := registrationsaga.UpdateDefaultUpdatedAt()
+		rsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (rsuo *RegistrationSagaUpdateOne) sqlSave(ctx context.Context) (_node *RegistrationSaga, err error) {
+	_spec := sqlgraph.NewUpdateSpec(registrationsaga.Table, registrationsaga.Columns, sqlgraph.NewFieldSpec(registrationsaga.FieldID, field.TypeInt))
+	id, ok := rsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RegistrationSaga.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, registrationsaga.FieldID)
+		for _, f := range fields {
+			if !registrationsaga.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != registrationsaga.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationsaga.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := rsuo.mutation.Status(); ok {
+		_spec.SetField(registrationsaga.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.AddedStatus(); ok {
+		_spec.AddField(registrationsaga.FieldStatus, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.ErrCode(); ok {
+		_spec.SetField(registrationsaga.FieldErrCode, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.AddedErrCode(); ok {
+		_spec.AddField(registrationsaga.FieldErrCode, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.ErrMessage(); ok {
+		_spec.SetField(registrationsaga.FieldErrMessage, field.TypeString, value)
+	}
+	_node = &RegistrationSaga{config: rsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationsaga.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rsuo.mutation.done = true
+	return _node, nil
+}
@@ -3,7 +3,11 @@
 package ent
 
 import (
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/outboxevent"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profile"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/schema"
 	"time"
 )
@@ -12,6 +16,48 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	kycsubmissionMixin := schema.KycSubmission{}.Mixin()
+	kycsubmissionMixinFields0 := kycsubmissionMixin[0].Fields()
+	_ = kycsubmissionMixinFields0
+	kycsubmissionFields := schema.KycSubmission{}.Fields()
+	_ = kycsubmissionFields
+	// kycsubmissionDescCreatedAt is the schema descriptor for created_at field.
+	kycsubmissionDescCreatedAt := kycsubmissionMixinFields0[0].Descriptor()
+	// kycsubmission.DefaultCreatedAt holds the default value on creation for the created_at field.
+	kycsubmission.DefaultCreatedAt = kycsubmissionDescCreatedAt.Default.(func() time.Time)
+	// kycsubmissionDescUpdatedAt is the schema descriptor for updated_at field.
+	kycsubmissionDescUpdatedAt := kycsubmissionMixinFields0[1].Descriptor()
+	// kycsubmission.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	kycsubmission.DefaultUpdatedAt = kycsubmissionDescUpdatedAt.Default.(func() time.Time)
+	// kycsubmission.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	kycsubmission.UpdateDefaultUpdatedAt = kycsubmissionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// kycsubmissionDescReviewerNote is the schema descriptor for reviewer_note field.
+	kycsubmissionDescReviewerNote := kycsubmissionFields[4].Descriptor()
+	// kycsubmission.DefaultReviewerNote holds the default value on creation for the reviewer_note field.
+	kycsubmission.DefaultReviewerNote = kycsubmissionDescReviewerNote.Default.(string)
+	outboxeventMixin := schema.OutboxEvent{}.Mixin()
+	outboxeventMixinFields0 := outboxeventMixin[0].Fields()
+	_ = outboxeventMixinFields0
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventMixinFields0[0].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	// outboxeventDescUpdatedAt is the schema descriptor for updated_at field.
+	outboxeventDescUpdatedAt := outboxeventMixinFields0[1].Descriptor()
+	// outboxevent.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	outboxevent.DefaultUpdatedAt = outboxeventDescUpdatedAt.Default.(func() time.Time)
+	// outboxevent.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	outboxevent.UpdateDefaultUpdatedAt = outboxeventDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescLastError is the schema descriptor for last_error field.
+	outboxeventDescLastError := outboxeventFields[9].Descriptor()
+	// outboxevent.DefaultLastError holds the default value on creation for the last_error field.
+	outboxevent.DefaultLastError = outboxeventDescLastError.Default.(string)
 	profileMixin := schema.Profile{}.Mixin()
 	profileMixinFields0 := profileMixin[0].Fields()
 	_ = profileMixinFields0
@@ -31,4 +77,66 @@ func init() {
 	profileDescIsActive := profileFields[3].Descriptor()
 	// profile.DefaultIsActive holds the default value on creation for the is_active field.
 	profile.DefaultIsActive = profileDescIsActive.Default.(bool)
+	profileattributeMixin := schema.ProfileAttribute{}.Mixin()
+	profileattributeMixinFields0 := profileattributeMixin[0].Fields()
+	_ = profileattributeMixinFields0
+	profileattributeFields := schema.ProfileAttribute{}.Fields()
+	_ = profileattributeFields
+	// profileattributeDescCreatedAt is the schema descriptor for created_at field.
+	profileattributeDescCreatedAt := profileattributeMixinFields0[0].Descriptor()
+	// profileattribute.DefaultCreatedAt holds the default value on creation for the created_at field.
+	profileattribute.DefaultCreatedAt = profileattributeDescCreatedAt.Default.(func() time.Time)
+	// profileattributeDescUpdatedAt is the schema descriptor for updated_at field.
+	profileattributeDescUpdatedAt := profileattributeMixinFields0[1].Descriptor()
+	// profileattribute.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	profileattribute.DefaultUpdatedAt = profileattributeDescUpdatedAt.Default.(func() time.Time)
+	// profileattribute.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	profileattribute.UpdateDefaultUpdatedAt = profileattributeDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// profileattributeDescIsUnique is the schema descriptor for is_unique field.
+	profileattributeDescIsUnique := profileattributeFields[4].Descriptor()
+	// profileattribute.DefaultIsUnique holds the default value on creation for the is_unique field.
+	profileattribute.DefaultIsUnique = profileattributeDescIsUnique.Default.(bool)
+	registrationsagaMixin := schema.RegistrationSaga{}.Mixin()
+	registrationsagaMixinFields0 := registrationsagaMixin[0].Fields()
+	_ = registrationsagaMixinFields0
+	registrationsagaFields := schema.RegistrationSaga{}.Fields()
+	_ = registrationsagaFields
+	// registrationsagaDescCreatedAt is the schema descriptor for created_at field.
+	registrationsagaDescCreatedAt := registrationsagaMixinFields0[0].Descriptor()
+	// registrationsaga.DefaultCreatedAt holds the default value on creation for the created_at field.
+	registrationsaga.DefaultCreatedAt = registrationsagaDescCreatedAt.Default.(func() time.Time)
+	// registrationsagaDescUpdatedAt is the schema descriptor for updated_at field.
+	registrationsagaDescUpdatedAt := registrationsagaMixinFields0[1].Descriptor()
+	// registrationsaga.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	registrationsaga.DefaultUpdatedAt = registrationsagaDescUpdatedAt.Default.(func() time.Time)
+	// registrationsaga.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	registrationsaga.UpdateDefaultUpdatedAt = registrationsagaDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// registrationsagaDescEmail is the schema descriptor for email field.
+	registrationsagaDescEmail := registrationsagaFields[4].Descriptor()
+	// registrationsaga.DefaultEmail holds the default value on creation for the email field.
+	registrationsaga.DefaultEmail = registrationsagaDescEmail.Default.(string)
+	// registrationsagaDescCountryCode is the schema descriptor for country_code field.
+	registrationsagaDescCountryCode := registrationsagaFields[5].Descriptor()
+	// registrationsaga.DefaultCountryCode holds the default value on creation for the country_code field.
+	registrationsaga.DefaultCountryCode = registrationsagaDescCountryCode.Default.(string)
+	// registrationsagaDescMobileNumber is the schema descriptor for mobile_number field.
+	registrationsagaDescMobileNumber := registrationsagaFields[6].Descriptor()
+	// registrationsaga.DefaultMobileNumber holds the default value on creation for the mobile_number field.
+	registrationsaga.DefaultMobileNumber = registrationsagaDescMobileNumber.Default.(string)
+	// registrationsagaDescOauthProvider is the schema descriptor for oauth_provider field.
+	registrationsagaDescOauthProvider := registrationsagaFields[7].Descriptor()
+	// registrationsaga.DefaultOauthProvider holds the default value on creation for the oauth_provider field.
+	registrationsaga.DefaultOauthProvider = registrationsagaDescOauthProvider.Default.(int)
+	// registrationsagaDescOpenID is the schema descriptor for open_id field.
+	registrationsagaDescOpenID := registrationsagaFields[8].Descriptor()
+	// registrationsaga.DefaultOpenID holds the default value on creation for the open_id field.
+	registrationsaga.DefaultOpenID = registrationsagaDescOpenID.Default.(string)
+	// registrationsagaDescErrCode is the schema descriptor for err_code field.
+	registrationsagaDescErrCode := registrationsagaFields[10].Descriptor()
+	// registrationsaga.DefaultErrCode holds the default value on creation for the err_code field.
+	registrationsaga.DefaultErrCode = registrationsagaDescErrCode.Default.(int)
+	// registrationsagaDescErrMessage is the schema descriptor for err_message field.
+	registrationsagaDescErrMessage := registrationsagaFields[11].Descriptor()
+	// registrationsaga.DefaultErrMessage holds the default value on creation for the err_message field.
+	registrationsaga.DefaultErrMessage = registrationsagaDescErrMessage.Default.(string)
 }
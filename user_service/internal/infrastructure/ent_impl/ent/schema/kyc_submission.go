@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"go_micro_service_api/user_service/internal/domain/vo"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// KycSubmission holds the schema definition for the KycSubmission entity.
+type KycSubmission struct {
+	ent.Schema
+}
+
+// Mixin of the KycSubmission.
+func (KycSubmission) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the KycSubmission.
+func (KycSubmission) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("user_id").Immutable(),
+		field.Int("status").Comment("pkg/enum/kyc_status"),
+		field.JSON("documents", []vo.KycDocument{}).Immutable().Comment("The object keys of the documents in the storage"),
+		field.Int64("reviewer_id").Optional().Nillable(),
+		field.String("reviewer_note").Default(""),
+		field.Time("reviewed_at").Optional().Nillable(),
+	}
+}
+
+// Edges of the KycSubmission.
+func (KycSubmission) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the KycSubmission.
+func (KycSubmission) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("status"),
+	}
+}
+
+func (KycSubmission) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("KYC submissions of the players and their reviews"),
+	}
+}
@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"go_micro_service_api/user_service/internal/domain/vo"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+)
+
+// ProfileAttribute holds the schema definition for the ProfileAttribute entity.
+type ProfileAttribute struct {
+	ent.Schema
+}
+
+// Mixin of the ProfileAttribute.
+func (ProfileAttribute) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the ProfileAttribute.
+func (ProfileAttribute) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("key").Unique().Immutable().Comment("The key of the profiles, starts from 1000"),
+		field.String("name").Unique().Immutable(),
+		field.Int("type").Immutable().Comment("pkg/enum/attribute_type"),
+		field.JSON("rules", vo.AttributeRules{}),
+		field.Bool("is_unique").Default(false),
+		field.Int("pii_class").Comment("pkg/enum/pii_class"),
+		field.JSON("visible_to", []int{}).Comment("The ids of pkg/enum/client_type"),
+	}
+}
+
+// Edges of the ProfileAttribute.
+func (ProfileAttribute) Edges() []ent.Edge {
+	return nil
+}
+
+func (ProfileAttribute) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("Profile attributes defined at runtime, the built-in ones are declared in the code"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// RegistrationSaga holds the schema definition for the RegistrationSaga entity.
+type RegistrationSaga struct {
+	ent.Schema
+}
+
+// Mixin of the RegistrationSaga.
+func (RegistrationSaga) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		TimeMixin{},
+	}
+}
+
+// Fields of the RegistrationSaga.
+func (RegistrationSaga) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int64("client_id").Immutable(),
+		field.String("idempotency_key").Immutable().Comment("Sent by the client, the retries of the registration share the key"),
+		field.Int64("user_id").Immutable(),
+		field.String("account").Immutable(),
+		field.String("email").Immutable().Default(""),
+		field.String("country_code").Immutable().Default(""),
+		field.String("mobile_number").Immutable().Default(""),
+		field.Int("oauth_provider").Immutable().Default(0).Comment("pkg/enum/oauth_provider, 0 for the registration without the oauth account"),
+		field.String("open_id").Immutable().Default("").Comment("The openID linked to the user when the profile is created"),
+		field.Int("status").Comment("pkg/enum/registration_status"),
+		field.Int("err_code").Default(0).Comment("The cus_err code of the failed registration"),
+		field.String("err_message").Default(""),
+	}
+}
+
+// Edges of the RegistrationSaga.
+func (RegistrationSaga) Edges() []ent.Edge {
+	return nil
+}
+
+// Indexes of the RegistrationSaga.
+func (RegistrationSaga) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("client_id", "idempotency_key").Unique(),
+		index.Fields("status", "updated_at"),
+	}
+}
+
+func (RegistrationSaga) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entsql.WithComments(true),
+		schema.Comment("The steps of the registrations across the auth service and the user service"),
+	}
+}
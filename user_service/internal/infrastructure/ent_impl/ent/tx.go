@@ -12,8 +12,16 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// KycSubmission is the client for interacting with the KycSubmission builders.
+	KycSubmission *KycSubmissionClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Profile is the client for interacting with the Profile builders.
 	Profile *ProfileClient
+	// ProfileAttribute is the client for interacting with the ProfileAttribute builders.
+	ProfileAttribute *ProfileAttributeClient
+	// RegistrationSaga is the client for interacting with the RegistrationSaga builders.
+	RegistrationSaga *RegistrationSagaClient
 
 	// lazily loaded.
 	client     *Client
@@ -145,7 +153,11 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.KycSubmission = NewKycSubmissionClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
 	tx.Profile = NewProfileClient(tx.config)
+	tx.ProfileAttribute = NewProfileAttributeClient(tx.config)
+	tx.RegistrationSaga = NewRegistrationSagaClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -155,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Profile.QueryXXX(), the query will be executed
+// applies a query, for example: KycSubmission.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,193 @@
+package ent_impl
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/kycsubmission"
+)
+
+type KycRepo struct {
+	db db.Database
+}
+
+var _ repository.KycRepo = (*KycRepo)(nil)
+
+func NewKycRepo(db db.Database) repository.KycRepo {
+	return &KycRepo{
+		db: db,
+	}
+}
+
+func (repo *KycRepo) CreateSubmission(ctx context.Context, submission *entity.KycSubmission) (*entity.KycSubmission, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	instance, err := tx.KycSubmission.Create().
+		SetUserID(submission.UserId).
+		SetStatus(submission.Status.Id).
+		SetDocuments(submission.Documents).
+		SetCreatedAt(submission.SubmittedAt).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to create kyc submission", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toKycSubmission(instance)
+}
+
+func (repo *KycRepo) FindSubmission(ctx context.Context, id int64) (*entity.KycSubmission, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	instance, err := client.KycSubmission.Get(ctx, int(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, cus_err.New(cus_err.ResourceNotFound, "kyc submission not found", err)
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to get kyc submission", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toKycSubmission(instance)
+}
+
+func (repo *KycRepo) FindLatestSubmission(ctx context.Context, userId int64) (*entity.KycSubmission, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	instance, err := client.KycSubmission.Query().
+		Where(kycsubmission.UserID(userId)).
+		Order(ent.Desc(kycsubmission.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, cus_err.New(cus_err.ResourceNotFound, "kyc submission not found", err)
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to get the latest kyc submission", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toKycSubmission(instance)
+}
+
+func (repo *KycRepo) ListSubmissions(ctx context.Context, status enum.KycStatus, pagination vo.Pagination) ([]*entity.KycSubmission, int, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+	query := client.KycSubmission.Query().Where(kycsubmission.Status(status.Id))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to count kyc submissions", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, 0, cusErr
+	}
+
+	instances, err := query.
+		Order(ent.Asc(kycsubmission.FieldID)).
+		Offset(pagination.Offset()).
+		Limit(pagination.PageSize).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to list kyc submissions", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, 0, cusErr
+	}
+
+	submissions := make([]*entity.KycSubmission, 0, len(instances))
+	for _, instance := range instances {
+		submission, cusErr := toKycSubmission(instance)
+		if cusErr != nil {
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, 0, cusErr
+		}
+		submissions = append(submissions, submission)
+	}
+
+	return submissions, total, nil
+}
+
+// UpdateReview saves the status and the review of the submission,
+// the submission must still be pending so two reviewers can't review it at the same time
+func (repo *KycRepo) UpdateReview(ctx context.Context, submission *entity.KycSubmission) (*entity.KycSubmission, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	affected, err := tx.KycSubmission.Update().
+		Where(
+			kycsubmission.ID(int(submission.Id)),
+			kycsubmission.Status(enum.KycStatusType.Pending.Id),
+		).
+		SetStatus(submission.Status.Id).
+		SetReviewerID(submission.ReviewerId).
+		SetReviewerNote(submission.ReviewerNote).
+		SetNillableReviewedAt(submission.ReviewedAt).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to update kyc submission", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+	if affected == 0 {
+		cusErr := cus_err.New(cus_err.InvalidArgument, "kyc submission is already reviewed")
+		cus_otel.Warn(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	instance, err := tx.KycSubmission.Get(ctx, int(submission.Id))
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to get kyc submission", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toKycSubmission(instance)
+}
+
+func toKycSubmission(instance *ent.KycSubmission) (*entity.KycSubmission, *cus_err.CusError) {
+	status, err := enum.KycStatusFromId(instance.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	submission := &entity.KycSubmission{
+		Id:           int64(instance.ID),
+		UserId:       instance.UserID,
+		Status:       status,
+		Documents:    instance.Documents,
+		ReviewerNote: instance.ReviewerNote,
+		SubmittedAt:  instance.CreatedAt,
+		ReviewedAt:   instance.ReviewedAt,
+	}
+	if instance.ReviewerID != nil {
+		submission.ReviewerId = *instance.ReviewerID
+	}
+
+	return submission, nil
+}
@@ -0,0 +1,39 @@
+package ent_impl
+
+import (
+	"go_micro_service_api/user_service/internal/config"
+	"go_micro_service_api/user_service/internal/infrastructure/client/oidc"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// OIDCVerifiers verifies the id tokens of the oauth providers locally,
+// the provider falls back to its remote api when its verifier is nil
+type OIDCVerifiers struct {
+	Google *oidc.Verifier
+	LINE   *oidc.Verifier
+
+	LINEChannelIDs []string // The channels the line access tokens are accepted from
+}
+
+func NewOIDCVerifiers() *OIDCVerifiers {
+	cfg := config.GetConfig().OAUTH
+	client := resty.New()
+	ttl := time.Duration(cfg.OIDCCacheSecs) * time.Second
+
+	return &OIDCVerifiers{
+		Google: oidc.NewVerifier(client, oidc.Provider{
+			Issuer:     oidc.GoogleIssuer,
+			AltIssuers: []string{"accounts.google.com"},
+			ClientIDs:  cfg.GoogleClientIDs,
+			CacheTTL:   ttl,
+		}),
+		LINE: oidc.NewVerifier(client, oidc.Provider{
+			Issuer:    oidc.LINEIssuer,
+			ClientIDs: cfg.LINEChannelIDs,
+			CacheTTL:  ttl,
+		}),
+		LINEChannelIDs: cfg.LINEChannelIDs,
+	}
+}
@@ -0,0 +1,228 @@
+package ent_impl
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/outbox"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/outboxevent"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+// OutboxRepo is the outbox of the user service, the events are added by the domain and published by the relay
+type OutboxRepo struct {
+	db db.Database
+}
+
+var _ repository.EventRepo = (*OutboxRepo)(nil)
+var _ outbox.Store = (*OutboxRepo)(nil)
+
+func NewOutboxRepo(db db.Database) *OutboxRepo {
+	return &OutboxRepo{
+		db: db,
+	}
+}
+
+func (repo *OutboxRepo) AddEvents(ctx context.Context, events ...*outbox.Event) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	now := time.Now().UTC()
+	ops := make([]*ent.OutboxEventCreate, 0, len(events))
+	for _, event := range events {
+		m, cusErr := outbox.NewMessage(event, now)
+		if cusErr != nil {
+			cus_otel.Error(ctx, cusErr.Error())
+			return cusErr
+		}
+		ops = append(ops, tx.OutboxEvent.Create().
+			SetEventID(m.EventId).
+			SetAggregateType(m.AggregateType).
+			SetAggregateID(m.AggregateId).
+			SetEventType(m.EventType).
+			SetEventVersion(m.EventVersion).
+			SetPayload(m.Payload).
+			SetCreatedAt(m.OccurredAt))
+	}
+
+	_, err := tx.OutboxEvent.CreateBulk(ops...).Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to add outbox events", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+func (repo *OutboxRepo) ListPending(ctx context.Context, now time.Time, limit int) ([]*outbox.Message, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	instances, err := client.OutboxEvent.Query().
+		Where(
+			outboxevent.PublishedAtIsNil(),
+			outboxevent.ParkedAtIsNil(),
+			outboxevent.Or(
+				outboxevent.LockedUntilIsNil(),
+				outboxevent.LockedUntilLTE(now),
+			),
+			// The aggregate is held by its earlier event which is leased, waiting for the retry or parked
+			func(s *sql.Selector) {
+				held := sql.Table(outboxevent.Table).As("held")
+				s.Where(sql.Not(sql.Exists(
+					sql.Select(held.C(outboxevent.FieldID)).
+						From(held).
+						Where(sql.And(
+							sql.ColumnsEQ(held.C(outboxevent.FieldAggregateType), s.C(outboxevent.FieldAggregateType)),
+							sql.ColumnsEQ(held.C(outboxevent.FieldAggregateID), s.C(outboxevent.FieldAggregateID)),
+							sql.ColumnsLT(held.C(outboxevent.FieldID), s.C(outboxevent.FieldID)),
+							sql.IsNull(held.C(outboxevent.FieldPublishedAt)),
+							sql.Or(
+								sql.NotNull(held.C(outboxevent.FieldParkedAt)),
+								sql.GT(held.C(outboxevent.FieldLockedUntil), now),
+							),
+						)),
+				)))
+			},
+		).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to list pending outbox events", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	messages := make([]*outbox.Message, 0, len(instances))
+	for _, instance := range instances {
+		messages = append(messages, &outbox.Message{
+			Id:            int64(instance.ID),
+			EventId:       instance.EventID,
+			AggregateType: instance.AggregateType,
+			AggregateId:   instance.AggregateID,
+			EventType:     instance.EventType,
+			EventVersion:  instance.EventVersion,
+			Payload:       instance.Payload,
+			OccurredAt:    instance.CreatedAt,
+			Attempts:      instance.Attempts,
+			LockedUntil:   instance.LockedUntil,
+			LastError:     instance.LastError,
+		})
+	}
+
+	return messages, nil
+}
+
+func (repo *OutboxRepo) Claim(ctx context.Context, id int64, now time.Time, until time.Time) (bool, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return false, cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	affected, err := tx.OutboxEvent.Update().
+		Where(
+			outboxevent.ID(int(id)),
+			outboxevent.PublishedAtIsNil(),
+			outboxevent.ParkedAtIsNil(),
+			outboxevent.Or(
+				outboxevent.LockedUntilIsNil(),
+				outboxevent.LockedUntilLTE(now),
+			),
+		).
+		SetLockedUntil(until).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to claim outbox event", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return false, cusErr
+	}
+
+	return affected > 0, nil
+}
+
+func (repo *OutboxRepo) MarkPublished(ctx context.Context, id int64, publishedAt time.Time) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	err := tx.OutboxEvent.UpdateOneID(int(id)).
+		SetPublishedAt(publishedAt).
+		ClearLockedUntil().
+		SetLastError("").
+		Exec(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to mark outbox event as published", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+func (repo *OutboxRepo) Retry(ctx context.Context, id int64, retryAt time.Time, lastError string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	err := tx.OutboxEvent.UpdateOneID(int(id)).
+		AddAttempts(1).
+		SetLockedUntil(retryAt).
+		SetLastError(lastError).
+		Exec(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to record outbox event retry", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+func (repo *OutboxRepo) Park(ctx context.Context, id int64, parkedAt time.Time, lastError string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	err := tx.OutboxEvent.UpdateOneID(int(id)).
+		AddAttempts(1).
+		SetParkedAt(parkedAt).
+		ClearLockedUntil().
+		SetLastError(lastError).
+		Exec(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to park outbox event", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
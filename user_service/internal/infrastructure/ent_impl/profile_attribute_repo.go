@@ -0,0 +1,164 @@
+package ent_impl
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profileattribute"
+)
+
+type ProfileAttributeRepo struct {
+	db db.Database
+}
+
+var _ repository.ProfileAttributeRepo = (*ProfileAttributeRepo)(nil)
+
+func NewProfileAttributeRepo(db db.Database) repository.ProfileAttributeRepo {
+	return &ProfileAttributeRepo{
+		db: db,
+	}
+}
+
+func (repo *ProfileAttributeRepo) ListAttributes(ctx context.Context) ([]*entity.ProfileAttribute, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	instances, err := client.ProfileAttribute.Query().Order(ent.Asc(profileattribute.FieldKey)).All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to list profile attributes", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	attributes := make([]*entity.ProfileAttribute, 0, len(instances))
+	for _, instance := range instances {
+		attribute, cusErr := toProfileAttribute(instance)
+		if cusErr != nil {
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		attributes = append(attributes, attribute)
+	}
+
+	return attributes, nil
+}
+
+// CreateAttribute creates the attribute with the next free key
+func (repo *ProfileAttributeRepo) CreateAttribute(ctx context.Context, attribute *entity.ProfileAttribute) (*entity.ProfileAttribute, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	key := entity.CustomAttributeKeyStart
+	last, err := tx.ProfileAttribute.Query().Order(ent.Desc(profileattribute.FieldKey)).First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to get the last profile attribute", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+	if last != nil {
+		key = last.Key + 1
+	}
+
+	instance, err := tx.ProfileAttribute.Create().
+		SetKey(key).
+		SetName(attribute.Name).
+		SetType(attribute.Type.Id).
+		SetRules(attribute.Rules).
+		SetIsUnique(attribute.Unique).
+		SetPiiClass(attribute.PII.Id).
+		SetVisibleTo(clientIds(attribute.VisibleTo)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, cus_err.New(cus_err.ResourceIsExist, "profile attribute already exists", err)
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to create profile attribute", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toProfileAttribute(instance)
+}
+
+// UpdateAttribute updates the rules, the pii class and the visibility of the attribute by its key
+func (repo *ProfileAttributeRepo) UpdateAttribute(ctx context.Context, attribute *entity.ProfileAttribute) (*entity.ProfileAttribute, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	instance, err := tx.ProfileAttribute.Query().Where(profileattribute.KeyEQ(attribute.Key)).Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, cus_err.New(cus_err.ResourceNotFound, "profile attribute not found", err)
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to get profile attribute", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	instance, err = instance.Update().
+		SetRules(attribute.Rules).
+		SetPiiClass(attribute.PII.Id).
+		SetVisibleTo(clientIds(attribute.VisibleTo)).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to update profile attribute", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toProfileAttribute(instance)
+}
+
+func toProfileAttribute(instance *ent.ProfileAttribute) (*entity.ProfileAttribute, *cus_err.CusError) {
+	attributeType, err := enum.AttributeTypeFromId(instance.Type)
+	if err != nil {
+		return nil, err
+	}
+	pii, err := enum.PIIClassFromId(instance.PiiClass)
+	if err != nil {
+		return nil, err
+	}
+	visibleTo := make([]enum.Client, 0, len(instance.VisibleTo))
+	for _, id := range instance.VisibleTo {
+		client, err := enum.ClientTypeFromId(id)
+		if err != nil {
+			return nil, err
+		}
+		visibleTo = append(visibleTo, client)
+	}
+
+	return &entity.ProfileAttribute{
+		Key:       instance.Key,
+		Name:      instance.Name,
+		Type:      attributeType,
+		Rules:     instance.Rules,
+		Unique:    instance.IsUnique,
+		PII:       pii,
+		VisibleTo: visibleTo,
+	}, nil
+}
+
+func clientIds(clients []enum.Client) []int {
+	ids := make([]int, 0, len(clients))
+	for _, client := range clients {
+		ids = append(ids, client.Id)
+	}
+	return ids
+}
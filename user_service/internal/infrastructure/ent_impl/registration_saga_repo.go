@@ -0,0 +1,199 @@
+package ent_impl
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/registrationsaga"
+	"time"
+)
+
+type RegistrationSagaRepo struct {
+	db db.Database
+}
+
+var _ repository.RegistrationSagaRepo = (*RegistrationSagaRepo)(nil)
+
+func NewRegistrationSagaRepo(db db.Database) repository.RegistrationSagaRepo {
+	return &RegistrationSagaRepo{
+		db: db,
+	}
+}
+
+func (repo *RegistrationSagaRepo) CreateSaga(ctx context.Context, saga *entity.RegistrationSaga) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	create := tx.RegistrationSaga.Create().
+		SetClientID(saga.ClientId).
+		SetIdempotencyKey(saga.IdempotencyKey).
+		SetUserID(saga.UserId).
+		SetAccount(saga.Profile.Account).
+		SetEmail(saga.Profile.Email).
+		SetCountryCode(saga.Profile.CountryCode).
+		SetMobileNumber(saga.Profile.MobileNumber).
+		SetStatus(saga.Status.Id)
+
+	// The oauth signup links a single openID
+	if providers := saga.Profile.ThirdParties.Linked(); len(providers) > 0 {
+		create.SetOauthProvider(providers[0].ID).SetOpenID(saga.Profile.ThirdParties.Get(providers[0]).ID)
+	}
+
+	instance, err := create.Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			cusErr := cus_err.New(cus_err.ResourceIsExist, "the idempotency key is used", err)
+			cus_otel.Warn(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to create registration saga", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toRegistrationSaga(instance)
+}
+
+func (repo *RegistrationSagaRepo) FindSagaByKey(ctx context.Context, clientId int64, idempotencyKey string) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	instance, err := client.RegistrationSaga.Query().
+		Where(
+			registrationsaga.ClientID(clientId),
+			registrationsaga.IdempotencyKey(idempotencyKey),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, cus_err.New(cus_err.ResourceNotFound, "registration saga not found", err)
+		}
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to get registration saga", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toRegistrationSaga(instance)
+}
+
+func (repo *RegistrationSagaRepo) UpdateSagaStatus(ctx context.Context, saga *entity.RegistrationSaga, from enum.RegistrationStatus) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	affected, err := tx.RegistrationSaga.Update().
+		Where(
+			registrationsaga.ID(int(saga.Id)),
+			registrationsaga.Status(from.Id),
+		).
+		SetStatus(saga.Status.Id).
+		SetErrCode(saga.ErrCode).
+		SetErrMessage(saga.ErrMessage).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to update registration saga", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+	if affected == 0 {
+		cusErr := cus_err.New(cus_err.Conflict, "registration saga is moved by another process")
+		cus_otel.Warn(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	instance, err := tx.RegistrationSaga.Get(ctx, int(saga.Id))
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to get registration saga", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return toRegistrationSaga(instance)
+}
+
+func (repo *RegistrationSagaRepo) ListStaleSagas(ctx context.Context, before time.Time, limit int) ([]*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	instances, err := client.RegistrationSaga.Query().
+		Where(
+			registrationsaga.StatusIn(
+				enum.RegistrationStatusType.Started.Id,
+				enum.RegistrationStatusType.AuthUserCreated.Id,
+				enum.RegistrationStatusType.Compensating.Id,
+			),
+			registrationsaga.UpdatedAtLT(before),
+		).
+		Order(ent.Asc(registrationsaga.FieldUpdatedAt), ent.Asc(registrationsaga.FieldID)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to list stale registration sagas", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	sagas := make([]*entity.RegistrationSaga, 0, len(instances))
+	for _, instance := range instances {
+		saga, cusErr := toRegistrationSaga(instance)
+		if cusErr != nil {
+			cus_otel.Error(ctx, cusErr.Error())
+			return nil, cusErr
+		}
+		sagas = append(sagas, saga)
+	}
+
+	return sagas, nil
+}
+
+func toRegistrationSaga(instance *ent.RegistrationSaga) (*entity.RegistrationSaga, *cus_err.CusError) {
+	status, err := enum.RegistrationStatusFromId(instance.Status)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := entity.Profile{
+		Account:      instance.Account,
+		Email:        instance.Email,
+		CountryCode:  instance.CountryCode,
+		MobileNumber: instance.MobileNumber,
+	}
+	if instance.OauthProvider != 0 {
+		provider, err := enum.OAuthProviderFromId(instance.OauthProvider)
+		if err != nil {
+			return nil, err
+		}
+		profile.ThirdParties.Set(provider, entity.ThirdParty{ID: instance.OpenID})
+	}
+
+	return &entity.RegistrationSaga{
+		Id:             int64(instance.ID),
+		ClientId:       instance.ClientID,
+		IdempotencyKey: instance.IdempotencyKey,
+		UserId:         instance.UserID,
+		Profile:        profile,
+		Status:         status,
+		ErrCode:        instance.ErrCode,
+		ErrMessage:     instance.ErrMessage,
+		CreatedAt:      instance.CreatedAt,
+		UpdatedAt:      instance.UpdatedAt,
+	}, nil
+}
@@ -2,15 +2,21 @@ package ent_impl
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/cus_otel"
 	"go_micro_service_api/pkg/db"
 	"go_micro_service_api/pkg/enum"
 	"go_micro_service_api/user_service/internal/domain/aggregate"
+	"go_micro_service_api/user_service/internal/domain/entity"
 	"go_micro_service_api/user_service/internal/domain/repository"
 	"go_micro_service_api/user_service/internal/domain/vo"
 	"go_micro_service_api/user_service/internal/infrastructure/client/google"
+	"go_micro_service_api/user_service/internal/infrastructure/client/line"
 	"go_micro_service_api/user_service/internal/infrastructure/client/meta"
+	"go_micro_service_api/user_service/internal/infrastructure/client/oidc"
+	"go_micro_service_api/user_service/internal/infrastructure/client/twitter"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/predicate"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profile"
@@ -20,14 +26,16 @@ import (
 )
 
 type UserRepo struct {
-	db db.Database
+	db        db.Database
+	verifiers *OIDCVerifiers
 }
 
 var _ repository.UserRepo = (*UserRepo)(nil)
 
-func NewUserRepo(db db.Database) repository.UserRepo {
+func NewUserRepo(db db.Database, verifiers *OIDCVerifiers) repository.UserRepo {
 	return &UserRepo{
-		db: db,
+		db:        db,
+		verifiers: verifiers,
 	}
 }
 
@@ -46,6 +54,13 @@ func (repo *UserRepo) CreateProfile(ctx context.Context, u *aggregate.User) (*ag
 		enum.ProfileKey.CountryCode:  u.Profile.CountryCode,
 		enum.ProfileKey.MobileNumber: u.Profile.MobileNumber,
 	}
+	for _, provider := range u.Profile.ThirdParties.Linked() {
+		key, cusErr := enum.OpenIDProfileKey(provider)
+		if cusErr != nil {
+			return nil, cusErr
+		}
+		items[key] = u.Profile.ThirdParties.Get(provider).ID
+	}
 	ops := make([]*ent.ProfileCreate, 0)
 	for k, v := range items {
 		if strings.TrimSpace(v) != "" {
@@ -66,7 +81,7 @@ func (repo *UserRepo) GetProfile(ctx context.Context, u *aggregate.User, keys []
 
 	client := repo.db.GetClient(ctx).(*ent.Client)
 
-	instances, err := client.Profile.Query().Where(profile.UserIDEQ(int(u.ID))).Where(profile.KeyIn(keys...)).All(ctx)
+	instances, err := client.Profile.Query().Where(profile.UserIDEQ(int(u.ID)), profile.IsActive(true)).Where(profile.KeyIn(keys...)).All(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			cusErr := cus_err.New(cus_err.ResourceNotFound, "profile not found", err)
@@ -87,64 +102,281 @@ func (repo *UserRepo) GetProfile(ctx context.Context, u *aggregate.User, keys []
 			u.Profile.CountryCode = instance.Value
 		case enum.ProfileKey.MobileNumber.ID:
 			u.Profile.MobileNumber = instance.Value
+		case enum.ProfileKey.Account.ID:
+			u.Profile.Account = instance.Value
+		case enum.ProfileKey.GoogleOpenID.ID:
+			u.Profile.ThirdParties.Google.ID = instance.Value
+		case enum.ProfileKey.MetaOpenID.ID:
+			u.Profile.ThirdParties.Meta.ID = instance.Value
+		case enum.ProfileKey.TwitterOpenID.ID:
+			u.Profile.ThirdParties.Twitter.ID = instance.Value
+		case enum.ProfileKey.LINEOpenID.ID:
+			u.Profile.ThirdParties.LINE.ID = instance.Value
 		}
 	}
 
 	return u, nil
 }
 
+// GetProfileValues gets the active values of the profile keys of the user, by the key
+func (repo *UserRepo) GetProfileValues(ctx context.Context, userId int64, keys []int) (map[int]string, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	instances, err := client.Profile.Query().
+		Where(profile.UserIDEQ(int(userId)), profile.KeyIn(keys...), profile.IsActive(true)).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to get profile", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	values := make(map[int]string, len(instances))
+	for _, instance := range instances {
+		values[instance.Key] = instance.Value
+	}
+
+	return values, nil
+}
+
+// ListProfileValues gets the active values of the keys of many users at once, keyed by the user id and then the profile key
+func (repo *UserRepo) ListProfileValues(ctx context.Context, userIds []int64, keys []int) (map[int64]map[int]string, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	ids := make([]int, 0, len(userIds))
+	for _, userId := range userIds {
+		ids = append(ids, int(userId))
+	}
+	instances, err := client.Profile.Query().
+		Where(profile.UserIDIn(ids...), profile.KeyIn(keys...), profile.IsActive(true)).
+		All(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to list profile values", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	values := make(map[int64]map[int]string, len(userIds))
+	for _, instance := range instances {
+		userId := int64(instance.UserID)
+		if values[userId] == nil {
+			values[userId] = make(map[int]string, len(keys))
+		}
+		values[userId][instance.Key] = instance.Value
+	}
+
+	return values, nil
+}
+
 func (repo *UserRepo) GetProfileFromOAuth(ctx context.Context, u *aggregate.User, session *vo.OAuthSession) (*aggregate.User, *cus_err.CusError) {
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
 
 	client := resty.New()
+	var openID string
 	switch session.Provider {
 	case enum.OAuthProvider.Google:
+		// The google token is an id token, verify it locally instead of calling the tokeninfo api
+		if repo.verifiers != nil && repo.verifiers.Google != nil {
+			identity, cusErr := repo.verifyIDToken(ctx, repo.verifiers.Google, session.AccessToken)
+			if cusErr != nil {
+				return u, cusErr
+			}
+
+			openID = identity.Subject
+			break
+		}
+
 		response, err := google.NewService(client).GetMe(ctx, session.AccessToken)
 		if err != nil {
 			return u, cus_err.New(cus_err.ThirdPartyError, "failed to get user info from oauth", err)
 		}
 
-		u.Profile.ThirdParties.Google.ID = response.ID
+		openID = response.ID
 	case enum.OAuthProvider.Meta:
 		response, err := meta.NewService(client).GetMe(ctx, session.AccessToken)
 		if err != nil {
 			return u, cus_err.New(cus_err.ThirdPartyError, "failed to get user info from oauth", err)
 		}
 
-		u.Profile.ThirdParties.Meta.ID = response.ID
+		openID = response.ID
 	case enum.OAuthProvider.Twitter:
-		// x.NewService().GetMe(ctx, req.AccessToken)
+		response, err := twitter.NewService(client).GetMe(ctx, session.AccessToken)
+		if err != nil {
+			return u, cus_err.New(cus_err.ThirdPartyError, "failed to get user info from oauth", err)
+		}
+
+		openID = response.Data.ID
+	case enum.OAuthProvider.LINE:
+		// The line id token is verified locally, the access token is verified by the line api
+		if repo.verifiers != nil && repo.verifiers.LINE != nil && isJWT(session.AccessToken) {
+			identity, cusErr := repo.verifyIDToken(ctx, repo.verifiers.LINE, session.AccessToken)
+			if cusErr != nil {
+				return u, cusErr
+			}
+
+			openID = identity.Subject
+			break
+		}
+
+		var channelIDs []string
+		if repo.verifiers != nil {
+			channelIDs = repo.verifiers.LINEChannelIDs
+		}
+		response, err := line.NewService(client).GetMe(ctx, session.AccessToken, channelIDs)
+		if err != nil {
+			if errors.Is(err, line.ErrUnexpectedChannel) {
+				cusErr := cus_err.New(cus_err.Unauthorized, "the line access token is issued to another channel", err)
+				cus_otel.Warn(ctx, cusErr.Error())
+				return u, cusErr
+			}
+			return u, cus_err.New(cus_err.ThirdPartyError, "failed to get user info from oauth", err)
+		}
+
+		openID = response.ID
+	default:
+		cusErr := cus_err.New(cus_err.NotImplemented, fmt.Sprintf("oauth provider %s is not supported", session.Provider.String))
+		cus_otel.Error(ctx, cusErr.Error())
+		return u, cusErr
+	}
+
+	// The provider answers without the user when the token is invalid
+	if openID == "" {
+		cusErr := cus_err.New(cus_err.ThirdPartyError, "failed to get user info from oauth, the token is invalid")
+		cus_otel.Error(ctx, cusErr.Error())
+		return u, cusErr
 	}
+	u.Profile.ThirdParties.Set(session.Provider, entity.ThirdParty{ID: openID})
 
 	return u, nil
 }
 
+// verifyIDToken verifies the id token of the oauth provider, the rejected token is a ThirdPartyError
+func (repo *UserRepo) verifyIDToken(ctx context.Context, verifier *oidc.Verifier, idToken string) (*oidc.Identity, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	identity, err := verifier.Verify(ctx, idToken)
+	if err != nil {
+		var code cus_err.CusCode = cus_err.ThirdPartyError
+		if !errors.Is(err, oidc.ErrInvalidToken) {
+			// The provider can't be reached, the token may be valid
+			code = cus_err.InternalServerError
+		}
+		cusErr := cus_err.New(code, "failed to verify the id token from oauth", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return identity, nil
+}
+
+// isJWT checks whether the token is a JWT instead of an opaque token
+func isJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// AddProfileItem adds the value of the profile key to the user
+func (repo *UserRepo) AddProfileItem(ctx context.Context, userId int64, key enum.Profile, value string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	_, err := tx.Profile.Create().SetUserID(int(userId)).SetKey(key.ID).SetValue(value).Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to create profile", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+// DeactivateProfileItem deactivates the values of the profile key of the user,
+// the rows are kept as the history of the profile
+func (repo *UserRepo) DeactivateProfileItem(ctx context.Context, userId int64, key enum.Profile) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	tx, ok := repo.db.GetTx(ctx).(*ent.Tx)
+	if !ok {
+		return cus_err.New(cus_err.InternalServerError, "failed to get transaction", nil)
+	}
+
+	_, err := tx.Profile.Update().
+		Where(profile.UserIDEQ(int(userId)), profile.KeyEQ(key.ID), profile.IsActive(true)).
+		SetIsActive(false).
+		Save(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to deactivate profile", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+// UpdateProfileItem deactivates the current value of the profile key of the user and adds the new one,
+// the key is only deactivated when the value is empty
+func (repo *UserRepo) UpdateProfileItem(ctx context.Context, userId int64, key enum.Profile, value string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	err := repo.DeactivateProfileItem(ctx, userId, key)
+	if err != nil {
+		return err
+	}
+
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+	return repo.AddProfileItem(ctx, userId, key, value)
+}
+
 func (repo *UserRepo) CheckMobileExistence(ctx context.Context, countryCode string, mobileNumber string) (bool, *cus_err.CusError) {
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
 
 	client := repo.db.GetClient(ctx).(*ent.Client)
 
-	conds := []predicate.Profile{
-		profile.And(
-			profile.KeyEQ(enum.ProfileKey.CountryCode.ID),
-			profile.ValueEQ(countryCode),
-		),
-		profile.And(
+	// The country code is shared by many users, so it's checked on the users of the mobile number
+	userIds, err := client.Profile.Query().
+		Where(
 			profile.KeyEQ(enum.ProfileKey.MobileNumber.ID),
 			profile.ValueEQ(mobileNumber),
-		),
+			profile.IsActive(true),
+		).
+		Select(profile.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		return false, cus_err.New(cus_err.InternalServerError, "failed to query profile", err)
+	}
+	if len(userIds) == 0 {
+		return false, nil
 	}
 
-	counts, err := client.Profile.Query().
-		Where(profile.Or(conds...)).
-		Count(ctx)
+	exist, err := client.Profile.Query().
+		Where(
+			profile.UserIDIn(userIds...),
+			profile.KeyEQ(enum.ProfileKey.CountryCode.ID),
+			profile.ValueEQ(countryCode),
+			profile.IsActive(true),
+		).Exist(ctx)
 	if err != nil {
 		return false, cus_err.New(cus_err.InternalServerError, "failed to query profile", err)
 	}
 
-	return counts == len(conds), nil
+	return exist, nil
 }
 
 func (repo *UserRepo) CheckEmailExistence(ctx context.Context, email string) (bool, *cus_err.CusError) {
@@ -157,6 +389,7 @@ func (repo *UserRepo) CheckEmailExistence(ctx context.Context, email string) (bo
 		Where(
 			profile.Key(enum.ProfileKey.Email.ID),
 			profile.Value(email),
+			profile.IsActive(true),
 		).Exist(ctx)
 	if err != nil {
 		return true, cus_err.New(cus_err.InternalServerError, "failed to query error", err)
@@ -174,6 +407,7 @@ func (repo *UserRepo) IsAccountExist(ctx context.Context, account string) (bool,
 		Where(
 			profile.Key(enum.LoginTypes.Account.Id),
 			profile.Value(account),
+			profile.IsActive(true),
 		).Exist(ctx)
 
 	if err != nil {
@@ -182,6 +416,54 @@ func (repo *UserRepo) IsAccountExist(ctx context.Context, account string) (bool,
 	return exist, nil
 }
 
+// IsProfileValueTaken checks whether the value of the profile key is active for another user
+func (repo *UserRepo) IsProfileValueTaken(ctx context.Context, userId int64, key int, value string) (bool, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	exist, err := client.Profile.Query().
+		Where(
+			profile.KeyEQ(key),
+			profile.ValueEQ(value),
+			profile.IsActive(true),
+			profile.UserIDNEQ(int(userId)),
+		).Exist(ctx)
+	if err != nil {
+		return true, cus_err.New(cus_err.InternalServerError, "failed to query profile", err)
+	}
+	return exist, nil
+}
+
+// FindUserIdsByProfile finds the users whose active value of the key is exactly the value
+func (repo *UserRepo) FindUserIdsByProfile(ctx context.Context, key int, value string) ([]int64, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	client := repo.db.GetClient(ctx).(*ent.Client)
+
+	ids, err := client.Profile.Query().
+		Where(
+			profile.KeyEQ(key),
+			profile.ValueEQ(value),
+			profile.IsActive(true),
+		).
+		Select(profile.FieldUserID).
+		Ints(ctx)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to query profile", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	userIds := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		userIds = append(userIds, int64(id))
+	}
+	return userIds, nil
+}
+
 func (repo *UserRepo) GetUserIdByProfile(ctx context.Context, mapping map[int]string) (int, *cus_err.CusError) {
 	ctx, span := cus_otel.StartTrace(ctx)
 	defer span.End()
@@ -192,7 +474,7 @@ func (repo *UserRepo) GetUserIdByProfile(ctx context.Context, mapping map[int]st
 	for k, v := range mapping {
 		predicates = append(predicates, profile.And(profile.KeyEQ(k), profile.ValueEQ(v)))
 	}
-	instance, err := client.Profile.Query().Where(predicates...).Only(ctx)
+	instance, err := client.Profile.Query().Where(profile.IsActive(true)).Where(predicates...).Only(ctx)
 
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -0,0 +1,167 @@
+package grpc_client
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	otelgrpc "go_micro_service_api/pkg/cus_otel/grpc"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/pb/gen/auth"
+	"go_micro_service_api/user_service/internal/config"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// AuthClient calls the user service of the auth service
+type AuthClient struct {
+	conn           *grpc.ClientConn
+	userGrpcClient auth.UserServiceClient
+}
+
+var _ repository.RoleGranter = (*AuthClient)(nil)
+var _ repository.AccountRepo = (*AuthClient)(nil)
+
+// NewAuthClient connects to the auth service of AUTH_URL with the tracing middleware
+func NewAuthClient() (*AuthClient, error) {
+	conn, err := grpc.NewClient(
+		config.GetConfig().AuthUrl,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithStatsHandler(otelgrpc.TracingMiddleware(otelgrpc.RoleClient)),
+	)
+	if err != nil {
+		return &AuthClient{}, err
+	}
+
+	return &AuthClient{
+		conn:           conn,
+		userGrpcClient: auth.NewUserServiceClient(conn),
+	}, nil
+}
+
+func (c *AuthClient) GrantKycVerifiedRole(ctx context.Context, userId int64) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, grpcErr := c.userGrpcClient.PromoteKycVerifiedUser(ctx, &auth.PromoteKycVerifiedUserRequest{
+		UserId: userId,
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "failed to promote the kyc verified user", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (c *AuthClient) SearchAccounts(ctx context.Context, search vo.UserSearch, userIds []int64) (*vo.UserListPage, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	req := &auth.SearchUsersRequest{
+		UserIds:       userIds,
+		AccountPrefix: search.AccountPrefix,
+		Sort:          search.Sort,
+		Ascending:     search.Ascending,
+		Cursor:        search.Cursor,
+		Limit:         int32(search.Limit),
+	}
+	if search.Status != nil {
+		req.Status = int32(search.Status.Int())
+	}
+	if search.MerchantId != nil {
+		req.MerchantId = *search.MerchantId
+	}
+	if search.ClientId != nil {
+		req.ClientId = *search.ClientId
+	}
+	if search.CreatedFrom != nil {
+		req.CreatedFrom = search.CreatedFrom.Unix()
+	}
+	if search.CreatedTo != nil {
+		req.CreatedTo = search.CreatedTo.Unix()
+	}
+
+	res, grpcErr := c.userGrpcClient.SearchUsers(ctx, req)
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return nil, err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "failed to search the accounts", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return nil, err
+	}
+
+	page := &vo.UserListPage{
+		Users:      make([]*vo.UserList, 0, len(res.GetUsers())),
+		NextCursor: res.GetNextCursor(),
+	}
+	for _, user := range res.GetUsers() {
+		page.Users = append(page.Users, &vo.UserList{
+			UserId:     user.GetId(),
+			Account:    user.GetAccount(),
+			Status:     enum.UserStatus(user.GetStatus()),
+			RoleId:     user.GetRoleId(),
+			RoleName:   user.GetRoleName(),
+			ClientId:   user.GetClientId(),
+			MerchantId: user.GetMerchantId(),
+			CreatedAt:  time.Unix(user.GetCreateAt(), 0).UTC(),
+		})
+	}
+
+	return page, nil
+}
+
+func (c *AuthClient) CreateAccount(ctx context.Context, clientId int64, userId int64, account string, password string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, grpcErr := c.userGrpcClient.CreateUser(ctx, &auth.CreateUserRequest{
+		ClientId: clientId,
+		Id:       userId,
+		Account:  account,
+		Password: password,
+		Status:   int32(enum.UserStatusType.Active),
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "failed to create the account", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (c *AuthClient) DeleteAccount(ctx context.Context, clientId int64, userId int64) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, grpcErr := c.userGrpcClient.DeleteUser(ctx, &auth.DeleteUserRequest{
+		ClientId: clientId,
+		UserId:   userId,
+	})
+	if grpcErr != nil {
+		if err, ok := cus_err.FromGrpcErr(grpcErr); ok {
+			cus_otel.Error(ctx, err.Error())
+			return err
+		}
+		err := cus_err.New(cus_err.InternalServerError, "failed to delete the account", grpcErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	otelgrpc "go_micro_service_api/pkg/cus_otel/grpc"
 	"go_micro_service_api/user_service/internal/application"
 	"go_micro_service_api/user_service/internal/config"
+	"go_micro_service_api/user_service/internal/domain/service"
 	"log"
 	"net"
 
@@ -17,12 +18,14 @@ import (
 	"google.golang.org/grpc"
 )
 
-func NewGrpcServer(lc fx.Lifecycle, userService *application.UserService, verifyService *application.VerifyService) *grpc.Server {
+func NewGrpcServer(lc fx.Lifecycle, userService *application.UserService, verifyService *application.VerifyService, attributeService *application.ProfileAttributeService, kycService *application.KycService, registrationService *application.RegistrationService) *grpc.Server {
 	// Get config
 	cfg := config.GetConfig()
 
 	// New grpc server
 	s := grpc.NewServer(
+		// The KYC documents are uploaded in one request
+		grpc.MaxRecvMsgSize(service.KycMaxDocuments*service.KycMaxDocumentBytes+1<<20),
 		grpc.StatsHandler(otelgrpc.TracingMiddleware(otelgrpc.RoleServer)),
 		grpc.ChainUnaryInterceptor(
 			cus_err.ErrorInterceptor,
@@ -54,6 +57,9 @@ func NewGrpcServer(lc fx.Lifecycle, userService *application.UserService, verify
 			go func() {
 				user.RegisterUserServiceServer(s, userService)
 				user.RegisterVerifyServiceServer(s, verifyService)
+				user.RegisterProfileAttributeServiceServer(s, attributeService)
+				user.RegisterKycServiceServer(s, kycService)
+				user.RegisterRegistrationServiceServer(s, registrationService)
 				if err := s.Serve(lis); err != nil {
 					cus_otel.Error(ctx, "failed to serve", cus_otel.NewField("error", err))
 				}
@@ -0,0 +1,81 @@
+package notify_impl
+
+import (
+	"context"
+	rabbitmq "go_micro_service_api/pkg/broker/rabbitmq_pool"
+	"go_micro_service_api/user_service/internal/config"
+
+	"go.uber.org/fx"
+)
+
+const (
+	// Exchange routes the notification events to their queues
+	Exchange = "notification"
+	// DeadLetterExchange routes the events which can't be delivered to the dead letter queues
+	DeadLetterExchange = "notification.dlx"
+
+	VerificationRoutingKey = "verification"
+	VerificationQueue      = "notification.verification"
+	// VerificationRetryQueue holds the failed events for the retry delay, then routes them back to the VerificationQueue
+	VerificationRetryQueue = "notification.verification.retry"
+	// VerificationDeadQueue keeps the events which failed all the attempts or can't be decoded
+	VerificationDeadQueue = "notification.verification.dead"
+)
+
+// NewBroker connects to rabbitmq and declares the exchanges and queues of the notifications.
+// The broker is shared by the publisher, the worker and the outbox relay, the connection is closed when the application stops
+func NewBroker(lc fx.Lifecycle) (rabbitmq.Broker, error) {
+	cfg := config.GetConfig()
+
+	mapping := &rabbitmq.BrokerMapping{
+		Exchanges: []rabbitmq.ExchangeOpt{
+			{Name: Exchange, Kind: "direct", Durable: true},
+			{Name: DeadLetterExchange, Kind: "direct", Durable: true},
+		},
+		Queues: []rabbitmq.QueueOpt{
+			{
+				Name:               VerificationQueue,
+				Durable:            true,
+				DeadLetterExchange: DeadLetterExchange,
+			},
+			{
+				Name:                 VerificationRetryQueue,
+				Durable:              true,
+				DeadLetterExchange:   Exchange,
+				DeadLetterRoutingKey: VerificationRoutingKey,
+				MessageTTL:           cfg.NotifyRetryDelay * 1000,
+			},
+			{
+				Name:    VerificationDeadQueue,
+				Durable: true,
+			},
+		},
+		Binds: []rabbitmq.BindOpt{
+			{QueueName: VerificationQueue, ExchangeName: Exchange, RoutingKey: VerificationRoutingKey},
+			{QueueName: VerificationDeadQueue, ExchangeName: DeadLetterExchange, RoutingKey: VerificationRoutingKey},
+		},
+	}
+
+	broker, err := rabbitmq.NewBroker(
+		cfg.RabbitMQUser,
+		cfg.RabbitMQPass,
+		cfg.RabbitMQHost,
+		cfg.RabbitMQPort,
+		rabbitmq.WithMapping(mapping),
+	)
+	if err != nil {
+		// Return the error explicitly, a nil *cus_err.CusError isn't a nil error
+		return nil, err
+	}
+
+	lc.Append(fx.Hook{
+		OnStop: func(ctx context.Context) error {
+			if err := broker.Close(); err != nil {
+				return err
+			}
+			return nil
+		},
+	})
+
+	return broker, nil
+}
@@ -0,0 +1,76 @@
+package notify_impl
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/user_service/internal/config"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/client/twilio"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	DriverLog      = "log"
+	DriverProvider = "provider"
+)
+
+// Dispatcher sends the notices inline, the worker uses it to deliver the published notices
+type Dispatcher struct {
+	senders       map[vo.NotifyChannel]sender
+	defaultLocale string
+	expireMins    int
+}
+
+var _ repository.Notifier = (*Dispatcher)(nil)
+
+func NewDispatcher() repository.Notifier {
+	cfg := config.GetConfig()
+
+	senders := map[vo.NotifyChannel]sender{
+		vo.EmailChannel: &logSender{path: cfg.NotifyLogFile, channel: string(vo.EmailChannel)},
+		vo.SMSChannel:   &logSender{path: cfg.NotifyLogFile, channel: string(vo.SMSChannel)},
+	}
+	if cfg.NotifyDriver == DriverProvider {
+		senders = map[vo.NotifyChannel]sender{
+			vo.EmailChannel: newSmtpSender(cfg.SmtpHost, cfg.SmtpPort, cfg.SmtpUser, cfg.SmtpPass, cfg.SmtpFrom),
+			vo.SMSChannel:   &smsSender{service: twilio.NewService(resty.New(), cfg.SmsAccountSid, cfg.SmsAuthToken, cfg.SmsFrom)},
+		}
+	}
+
+	return &Dispatcher{
+		senders:       senders,
+		defaultLocale: cfg.NotifyDefaultLocale,
+		expireMins:    (cfg.VerificationTokenExpiry + 59) / 60,
+	}
+}
+
+// SendVerification renders the notice by the template of its type and locale, and sends it by its channel
+func (n *Dispatcher) SendVerification(ctx context.Context, notice *vo.VerificationNotice) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	sender, ok := n.senders[notice.Channel]
+	if !ok {
+		err := cus_err.New(cus_err.NotImplemented, "notify channel "+string(notice.Channel)+" is not supported")
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	subject, body, renderErr := renderVerification(notice, n.defaultLocale, n.expireMins)
+	if renderErr != nil {
+		err := cus_err.New(cus_err.InternalServerError, "failed to render the verification notice", renderErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	if sendErr := sender.Send(ctx, notice.Recipient, subject, body); sendErr != nil {
+		err := cus_err.New(cus_err.ThirdPartyError, "failed to send the verification code", sendErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package notify_impl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	rabbitmq "go_micro_service_api/pkg/broker/rabbitmq_pool"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"time"
+)
+
+// Publisher publishes the notices to the broker instead of sending them inline, so the request isn't
+// blocked by the email or sms provider, the worker delivers them
+type Publisher struct {
+	broker           rabbitmq.Broker
+	notificationRepo repository.NotificationRepo
+}
+
+var _ repository.Notifier = (*Publisher)(nil)
+
+func NewPublisher(broker rabbitmq.Broker, notificationRepo repository.NotificationRepo) repository.Notifier {
+	return &Publisher{
+		broker:           broker,
+		notificationRepo: notificationRepo,
+	}
+}
+
+// SendVerification publishes the notice with a new idempotency key, its delivery is queued until the worker sends it
+func (p *Publisher) SendVerification(ctx context.Context, notice *vo.VerificationNotice) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	id, err := newEventId()
+	if err != nil {
+		return err
+	}
+
+	event := &vo.NotificationEvent{
+		Id:        id,
+		Notice:    *notice,
+		CreatedAt: time.Now(),
+	}
+	delivery := vo.NewNotificationDelivery(event)
+
+	// The delivery is saved before publishing, so the worker never updates a delivery which doesn't exist
+	err = p.notificationRepo.SaveDelivery(ctx, delivery)
+	if err != nil {
+		return err
+	}
+
+	body, marshalErr := json.Marshal(event)
+	if marshalErr != nil {
+		err = cus_err.New(cus_err.InternalServerError, "failed to marshal the notification event", marshalErr)
+		cus_otel.Error(ctx, err.Error())
+		return err
+	}
+
+	err = p.broker.Publish(ctx, Exchange, VerificationRoutingKey, true, body)
+	if err != nil {
+		cus_otel.Error(ctx, err.Error())
+		delivery.Status = vo.DeliveryDead
+		delivery.LastError = err.Error()
+		delivery.UpdatedAt = time.Now()
+		if saveErr := p.notificationRepo.SaveDelivery(ctx, delivery); saveErr != nil {
+			cus_otel.Error(ctx, saveErr.Error())
+		}
+		return err
+	}
+
+	return nil
+}
+
+// newEventId generates the idempotency key of the event
+func newEventId() (string, *cus_err.CusError) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", cus_err.New(cus_err.InternalServerError, "failed to generate the notification id", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,94 @@
+package notify_impl
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/user_service/internal/infrastructure/client/twilio"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sender delivers the rendered message to the recipient of a channel
+type sender interface {
+	Send(ctx context.Context, recipient, subject, body string) error
+}
+
+// smtpSender sends the email by the smtp server
+type smtpSender struct {
+	addr string
+	auth smtp.Auth
+	from string
+}
+
+func newSmtpSender(host string, port int, user, pass, from string) *smtpSender {
+	return &smtpSender{
+		addr: fmt.Sprintf("%s:%d", host, port),
+		auth: smtp.PlainAuth("", user, pass, host),
+		from: from,
+	}
+}
+
+func (s *smtpSender) Send(ctx context.Context, recipient, subject, body string) error {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	msg := strings.Join([]string{
+		"From: " + s.from,
+		"To: " + recipient,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	err := smtp.SendMail(s.addr, s.auth, s.from, []string{recipient}, []byte(msg))
+	if err != nil {
+		cus_otel.Error(ctx, "failed to send email", cus_otel.NewField("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// smsSender sends the sms by the sms provider
+type smsSender struct {
+	service *twilio.Service
+}
+
+func (s *smsSender) Send(ctx context.Context, recipient, _, body string) error {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, err := s.service.SendMessage(ctx, recipient, body)
+	return err
+}
+
+// logSender appends the messages to the file instead of sending them, for the local development
+type logSender struct {
+	mu      sync.Mutex
+	path    string
+	channel string
+}
+
+func (s *logSender) Send(ctx context.Context, recipient, subject, body string) error {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	file, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		cus_otel.Error(ctx, "failed to open the notification log", cus_otel.NewField("error", err))
+		return err
+	}
+	defer file.Close()
+
+	_, err = fmt.Fprintf(file, "%s [%s] to=%s subject=%q body=%q\n", time.Now().Format(time.RFC3339), s.channel, recipient, subject, body)
+	return err
+}
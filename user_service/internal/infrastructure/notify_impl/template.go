@@ -0,0 +1,106 @@
+package notify_impl
+
+import (
+	"bytes"
+	"fmt"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"text/template"
+)
+
+// fallbackLocale is used when neither the requested locale nor the default locale has the template
+const fallbackLocale = "en"
+
+type messageTemplate struct {
+	Subject *template.Template // Only used by the email
+	Body    *template.Template
+}
+
+type templateData struct {
+	Prefix     string
+	Code       string
+	ExpireMins int
+}
+
+func newTemplate(subject, body string) messageTemplate {
+	return messageTemplate{
+		Subject: template.Must(template.New("subject").Parse(subject)),
+		Body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+// verificationTemplates are the templates by the verification type and the locale
+var verificationTemplates = map[string]map[string]messageTemplate{
+	vo.RegisterVerificationType: {
+		"en": newTemplate(
+			"Your registration verification code",
+			"Your verification code is {{.Prefix}}-{{.Code}}, it expires in {{.ExpireMins}} minutes. Please don't share it with anyone.",
+		),
+		"zh-TW": newTemplate(
+			"註冊驗證碼",
+			"您的註冊驗證碼為 {{.Prefix}}-{{.Code}}，{{.ExpireMins}} 分鐘內有效，請勿將驗證碼提供給他人。",
+		),
+	},
+	"forgotPwd": {
+		"en": newTemplate(
+			"Reset your password",
+			"Your verification code to reset the password is {{.Prefix}}-{{.Code}}, it expires in {{.ExpireMins}} minutes. Ignore this message if you didn't request it.",
+		),
+		"zh-TW": newTemplate(
+			"重設密碼驗證碼",
+			"您的重設密碼驗證碼為 {{.Prefix}}-{{.Code}}，{{.ExpireMins}} 分鐘內有效，若非本人操作請忽略此訊息。",
+		),
+	},
+	"unusualLogin": {
+		"en": newTemplate(
+			"Confirm your sign-in",
+			"We noticed a sign-in from a new device or location. Your verification code is {{.Prefix}}-{{.Code}}, it expires in {{.ExpireMins}} minutes. Change your password if it wasn't you.",
+		),
+		"zh-TW": newTemplate(
+			"異常登入驗證碼",
+			"偵測到您從新的裝置或地點登入，驗證碼為 {{.Prefix}}-{{.Code}}，{{.ExpireMins}} 分鐘內有效，若非本人操作請盡快變更密碼。",
+		),
+	},
+	"updateProfile": {
+		"en": newTemplate(
+			"Confirm your new contact",
+			"Your verification code to change the contact of your account is {{.Prefix}}-{{.Code}}, it expires in {{.ExpireMins}} minutes. Ignore this message if you didn't request it.",
+		),
+		"zh-TW": newTemplate(
+			"變更聯絡資訊驗證碼",
+			"您變更帳號聯絡資訊的驗證碼為 {{.Prefix}}-{{.Code}}，{{.ExpireMins}} 分鐘內有效，若非本人操作請忽略此訊息。",
+		),
+	},
+}
+
+// renderVerification renders the subject and the body of the notice in its locale,
+// falls back to the default locale and then the fallback locale
+func renderVerification(notice *vo.VerificationNotice, defaultLocale string, expireMins int) (subject string, body string, err error) {
+	templates, ok := verificationTemplates[notice.Type]
+	if !ok {
+		return "", "", fmt.Errorf("no template of the verification type %s", notice.Type)
+	}
+
+	tmpl, ok := templates[notice.Locale]
+	if !ok {
+		tmpl, ok = templates[defaultLocale]
+	}
+	if !ok {
+		tmpl = templates[fallbackLocale]
+	}
+
+	data := templateData{
+		Prefix:     notice.Prefix,
+		Code:       notice.Code,
+		ExpireMins: expireMins,
+	}
+
+	var subjectBuf, bodyBuf bytes.Buffer
+	if err = tmpl.Subject.Execute(&subjectBuf, data); err != nil {
+		return "", "", err
+	}
+	if err = tmpl.Body.Execute(&bodyBuf, data); err != nil {
+		return "", "", err
+	}
+
+	return subjectBuf.String(), bodyBuf.String(), nil
+}
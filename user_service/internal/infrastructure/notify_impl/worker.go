@@ -0,0 +1,170 @@
+package notify_impl
+
+import (
+	"context"
+	"encoding/json"
+	rabbitmq "go_micro_service_api/pkg/broker/rabbitmq_pool"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/user_service/internal/config"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/fx"
+)
+
+const consumerName = "user-service-notification-worker"
+
+// Worker consumes the published notices and delivers them by the sender, the failed notices are retried
+// through the retry queue and dead-lettered after the max attempts
+type Worker struct {
+	broker           rabbitmq.Broker
+	notificationRepo repository.NotificationRepo
+	sender           repository.Notifier
+	maxAttempts      int
+}
+
+func NewWorker(broker rabbitmq.Broker, notificationRepo repository.NotificationRepo, sender repository.Notifier, maxAttempts int) *Worker {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &Worker{
+		broker:           broker,
+		notificationRepo: notificationRepo,
+		sender:           sender,
+		maxAttempts:      maxAttempts,
+	}
+}
+
+// NewWorkerFx creates the worker with the dispatcher and runs it with the application, stopping it cancels the consumer only
+// since the broker is shared with the publisher and the outbox relay
+func NewWorkerFx(lc fx.Lifecycle, broker rabbitmq.Broker, notificationRepo repository.NotificationRepo) *Worker {
+	w := NewWorker(broker, notificationRepo, NewDispatcher(), config.GetConfig().NotifyMaxAttempts)
+
+	var cancel context.CancelFunc
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			var runCtx context.Context
+			runCtx, cancel = context.WithCancel(context.Background())
+
+			deliveries, err := broker.Consume(runCtx, consumerName, VerificationQueue)
+			if err != nil {
+				cancel()
+				return err
+			}
+
+			go w.Run(runCtx, deliveries)
+
+			cus_otel.Info(ctx, "notification worker started")
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if cancel != nil {
+				cancel()
+			}
+
+			cus_otel.Info(ctx, "notification worker stopped")
+			return nil
+		},
+	})
+
+	return w
+}
+
+// Run handles the deliveries until the context is canceled or the channel is closed
+func (w *Worker) Run(ctx context.Context, deliveries <-chan amqp.Delivery) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case msg, ok := <-deliveries:
+			if !ok {
+				return
+			}
+			w.Handle(ctx, &msg)
+		}
+	}
+}
+
+// Handle delivers the notice of the message, the message is acked once it's sent, retried or skipped
+func (w *Worker) Handle(ctx context.Context, msg *amqp.Delivery) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	event := &vo.NotificationEvent{}
+	if err := json.Unmarshal(msg.Body, event); err != nil || event.Id == "" {
+		// The message can never be handled, keep it in the dead letter queue
+		cus_otel.Error(ctx, "invalid notification event", cus_otel.NewField("error", err))
+		w.nack(ctx, msg, false)
+		return
+	}
+
+	delivery, err := w.notificationRepo.FindDelivery(ctx, event.Id)
+	if err != nil {
+		if err.Code().Int() != cus_err.ResourceNotFound {
+			// The status is unknown, try again later
+			w.nack(ctx, msg, true)
+			return
+		}
+		delivery = vo.NewNotificationDelivery(event)
+	}
+
+	// The event is redelivered after it's sent, e.g. the ack was lost
+	if delivery.Status == vo.DeliverySent {
+		w.ack(ctx, msg)
+		return
+	}
+
+	event.Attempts++
+	delivery.Attempts = event.Attempts
+	delivery.UpdatedAt = time.Now()
+
+	sendErr := w.sender.SendVerification(ctx, &event.Notice)
+	switch {
+	case sendErr == nil:
+		delivery.Status = vo.DeliverySent
+		delivery.LastError = ""
+		w.saveDelivery(ctx, delivery)
+		w.ack(ctx, msg)
+
+	case event.Attempts < w.maxAttempts:
+		delivery.Status = vo.DeliveryRetrying
+		delivery.LastError = sendErr.Error()
+
+		body, _ := json.Marshal(event)
+		// Publish to the retry queue by the default exchange, it routes the event back after the delay
+		if err := w.broker.Publish(ctx, "", VerificationRetryQueue, true, body); err != nil {
+			cus_otel.Error(ctx, err.Error())
+			w.nack(ctx, msg, true)
+			return
+		}
+		w.saveDelivery(ctx, delivery)
+		w.ack(ctx, msg)
+
+	default:
+		delivery.Status = vo.DeliveryDead
+		delivery.LastError = sendErr.Error()
+		w.saveDelivery(ctx, delivery)
+		w.nack(ctx, msg, false)
+	}
+}
+
+func (w *Worker) saveDelivery(ctx context.Context, delivery *vo.NotificationDelivery) {
+	if err := w.notificationRepo.SaveDelivery(ctx, delivery); err != nil {
+		cus_otel.Error(ctx, err.Error())
+	}
+}
+
+func (w *Worker) ack(ctx context.Context, msg *amqp.Delivery) {
+	if err := w.broker.Ack(msg); err != nil {
+		cus_otel.Error(ctx, err.Error())
+	}
+}
+
+func (w *Worker) nack(ctx context.Context, msg *amqp.Delivery, requeue bool) {
+	if err := w.broker.Nack(msg, requeue); err != nil {
+		cus_otel.Error(ctx, err.Error())
+	}
+}
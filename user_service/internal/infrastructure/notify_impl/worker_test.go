@@ -0,0 +1,264 @@
+package notify_impl_test
+
+import (
+	"context"
+	"encoding/json"
+	rabbitmq "go_micro_service_api/pkg/broker/rabbitmq_pool"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/notify_impl"
+	"testing"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type published struct {
+	exchange   string
+	routingKey string
+	body       []byte
+}
+
+type fakeBroker struct {
+	rabbitmq.Broker
+	published  []published
+	acked      int
+	nacked     []bool // requeue of each nack
+	publishErr *cus_err.CusError
+}
+
+func (b *fakeBroker) Publish(ctx context.Context, exchange string, routingKey string, durable bool, msg []byte) *cus_err.CusError {
+	if b.publishErr != nil {
+		return b.publishErr
+	}
+	b.published = append(b.published, published{exchange: exchange, routingKey: routingKey, body: msg})
+	return nil
+}
+
+func (b *fakeBroker) Ack(msg *amqp.Delivery) *cus_err.CusError {
+	b.acked++
+	return nil
+}
+
+func (b *fakeBroker) Nack(msg *amqp.Delivery, requeue bool) *cus_err.CusError {
+	b.nacked = append(b.nacked, requeue)
+	return nil
+}
+
+type fakeNotificationRepo struct {
+	deliveries map[string]*vo.NotificationDelivery
+}
+
+func (r *fakeNotificationRepo) SaveDelivery(ctx context.Context, delivery *vo.NotificationDelivery) *cus_err.CusError {
+	copied := *delivery
+	r.deliveries[delivery.Id] = &copied
+	return nil
+}
+
+func (r *fakeNotificationRepo) FindDelivery(ctx context.Context, id string) (*vo.NotificationDelivery, *cus_err.CusError) {
+	delivery, ok := r.deliveries[id]
+	if !ok {
+		return nil, cus_err.New(cus_err.ResourceNotFound, "notification delivery not found")
+	}
+	copied := *delivery
+	return &copied, nil
+}
+
+func (r *fakeNotificationRepo) ListDeliveries(ctx context.Context, recipient string, limit int) ([]*vo.NotificationDelivery, *cus_err.CusError) {
+	return nil, nil
+}
+
+type fakeSender struct {
+	sent int
+	err  *cus_err.CusError
+}
+
+func (s *fakeSender) SendVerification(ctx context.Context, notice *vo.VerificationNotice) *cus_err.CusError {
+	s.sent++
+	return s.err
+}
+
+func newEventMessage(t *testing.T, event *vo.NotificationEvent) *amqp.Delivery {
+	body, err := json.Marshal(event)
+	require.NoError(t, err)
+	return &amqp.Delivery{Body: body}
+}
+
+func TestPublisher(t *testing.T) {
+	ctx := context.Background()
+	notice := &vo.VerificationNotice{
+		Channel:   vo.EmailChannel,
+		Recipient: "test@cus.go",
+		Type:      "forgotPwd",
+		Prefix:    "ABC",
+		Code:      "123456",
+	}
+
+	t.Run("Published and queued", func(t *testing.T) {
+		broker := &fakeBroker{}
+		repo := &fakeNotificationRepo{deliveries: map[string]*vo.NotificationDelivery{}}
+
+		err := notify_impl.NewPublisher(broker, repo).SendVerification(ctx, notice)
+		require.Nil(t, err)
+		require.Len(t, broker.published, 1)
+		assert.Equal(t, notify_impl.Exchange, broker.published[0].exchange)
+		assert.Equal(t, notify_impl.VerificationRoutingKey, broker.published[0].routingKey)
+
+		event := &vo.NotificationEvent{}
+		require.NoError(t, json.Unmarshal(broker.published[0].body, event))
+		assert.NotEmpty(t, event.Id)
+		assert.Equal(t, *notice, event.Notice)
+
+		delivery := repo.deliveries[event.Id]
+		require.NotNil(t, delivery)
+		assert.Equal(t, vo.DeliveryQueued, delivery.Status)
+		assert.Equal(t, "test@cus.go", delivery.Recipient)
+	})
+
+	t.Run("Failed to publish", func(t *testing.T) {
+		broker := &fakeBroker{publishErr: cus_err.New(cus_err.InternalServerError, "broker is down")}
+		repo := &fakeNotificationRepo{deliveries: map[string]*vo.NotificationDelivery{}}
+
+		err := notify_impl.NewPublisher(broker, repo).SendVerification(ctx, notice)
+		require.NotNil(t, err)
+		require.Len(t, repo.deliveries, 1)
+		for _, delivery := range repo.deliveries {
+			assert.Equal(t, vo.DeliveryDead, delivery.Status)
+		}
+	})
+}
+
+func TestWorkerHandle(t *testing.T) {
+	ctx := context.Background()
+	const maxAttempts = 3
+
+	newEvent := func(attempts int) *vo.NotificationEvent {
+		return &vo.NotificationEvent{
+			Id: "event-1",
+			Notice: vo.VerificationNotice{
+				Channel:   vo.SMSChannel,
+				Recipient: "+886912345678",
+				Type:      vo.RegisterVerificationType,
+				Prefix:    "ABC",
+				Code:      "123456",
+			},
+			Attempts:  attempts,
+			CreatedAt: time.Now(),
+		}
+	}
+
+	tcs := []struct {
+		name          string
+		attempts      int
+		existing      vo.DeliveryStatus
+		sendErr       *cus_err.CusError
+		wantSent      int
+		wantStatus    vo.DeliveryStatus
+		wantAcked     int
+		wantNacked    []bool
+		wantRetried   bool
+		wantAttempts  int
+		wantLastError bool
+	}{
+		{
+			name:         "Sent",
+			existing:     vo.DeliveryQueued,
+			wantSent:     1,
+			wantStatus:   vo.DeliverySent,
+			wantAcked:    1,
+			wantAttempts: 1,
+		},
+		{
+			name:          "Retry after failure",
+			attempts:      1,
+			existing:      vo.DeliveryQueued,
+			sendErr:       cus_err.New(cus_err.ThirdPartyError, "sms provider is down"),
+			wantSent:      1,
+			wantStatus:    vo.DeliveryRetrying,
+			wantAcked:     1,
+			wantRetried:   true,
+			wantAttempts:  2,
+			wantLastError: true,
+		},
+		{
+			name:          "Dead letter after the max attempts",
+			attempts:      maxAttempts - 1,
+			existing:      vo.DeliveryRetrying,
+			sendErr:       cus_err.New(cus_err.ThirdPartyError, "sms provider is down"),
+			wantSent:      1,
+			wantStatus:    vo.DeliveryDead,
+			wantNacked:    []bool{false},
+			wantAttempts:  maxAttempts,
+			wantLastError: true,
+		},
+		{
+			name:         "Skip the redelivered event which was sent",
+			attempts:     1,
+			existing:     vo.DeliverySent,
+			wantStatus:   vo.DeliverySent,
+			wantAcked:    1,
+			wantAttempts: 1,
+		},
+		{
+			name:         "Sent without the queued status",
+			wantSent:     1,
+			wantStatus:   vo.DeliverySent,
+			wantAcked:    1,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			broker := &fakeBroker{}
+			repo := &fakeNotificationRepo{deliveries: map[string]*vo.NotificationDelivery{}}
+			sender := &fakeSender{err: tc.sendErr}
+			worker := notify_impl.NewWorker(broker, repo, sender, maxAttempts)
+
+			event := newEvent(tc.attempts)
+			if tc.existing != "" {
+				delivery := vo.NewNotificationDelivery(event)
+				delivery.Status = tc.existing
+				repo.deliveries[event.Id] = delivery
+			}
+
+			worker.Handle(ctx, newEventMessage(t, event))
+
+			assert.Equal(t, tc.wantSent, sender.sent)
+			assert.Equal(t, tc.wantAcked, broker.acked)
+			assert.Equal(t, tc.wantNacked, broker.nacked)
+
+			delivery := repo.deliveries[event.Id]
+			require.NotNil(t, delivery)
+			assert.Equal(t, tc.wantStatus, delivery.Status)
+			assert.Equal(t, tc.wantAttempts, delivery.Attempts)
+			assert.Equal(t, tc.wantLastError, delivery.LastError != "")
+
+			if tc.wantRetried {
+				require.Len(t, broker.published, 1)
+				assert.Equal(t, "", broker.published[0].exchange)
+				assert.Equal(t, notify_impl.VerificationRetryQueue, broker.published[0].routingKey)
+
+				retried := &vo.NotificationEvent{}
+				require.NoError(t, json.Unmarshal(broker.published[0].body, retried))
+				assert.Equal(t, event.Id, retried.Id)
+				assert.Equal(t, tc.wantAttempts, retried.Attempts)
+			} else {
+				assert.Empty(t, broker.published)
+			}
+		})
+	}
+
+	t.Run("Invalid event is dead-lettered", func(t *testing.T) {
+		broker := &fakeBroker{}
+		sender := &fakeSender{}
+		worker := notify_impl.NewWorker(broker, &fakeNotificationRepo{deliveries: map[string]*vo.NotificationDelivery{}}, sender, maxAttempts)
+
+		worker.Handle(ctx, &amqp.Delivery{Body: []byte("not a json")})
+
+		assert.Equal(t, 0, sender.sent)
+		assert.Equal(t, []bool{false}, broker.nacked)
+	})
+}
@@ -0,0 +1,81 @@
+package outbox_impl
+
+import (
+	"context"
+	"encoding/json"
+	rabbitmq "go_micro_service_api/pkg/broker/rabbitmq_pool"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/outbox"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+)
+
+const (
+	// EventExchange is the topic exchange of the user events, the event types are the routing keys
+	EventExchange = "user.events"
+	// EventSource is the source of the user events in the envelopes
+	EventSource = "user_service"
+)
+
+// NewRelay declares the event exchange on the broker of the notifications, and publishes the events in the outbox to it.
+// The approved players are upgraded to the KYC verified players before their kyc.reviewed events are published.
+func NewRelay(store outbox.Store, db db.Database, broker rabbitmq.Broker, roleGranter repository.RoleGranter) (*outbox.Relay, error) {
+	err := broker.CreateExchange(EventExchange, "topic", true)
+	if err != nil {
+		// Return the error explicitly, a nil *cus_err.CusError isn't a nil error
+		return nil, err
+	}
+
+	return outbox.NewRelay(store, db, NewKycRolePublisher(broker, roleGranter), outbox.RelayOpt{
+		Source:   EventSource,
+		Exchange: EventExchange,
+	}), nil
+}
+
+// KycRolePublisher grants the KYC verified role of the approved review before publishing its kyc.reviewed event.
+// A failed grant fails the publishing, so the relay retries the event until the role is granted,
+// and granting the role again is a no-op in the auth service.
+type KycRolePublisher struct {
+	publisher   outbox.Publisher
+	roleGranter repository.RoleGranter
+}
+
+var _ outbox.Publisher = (*KycRolePublisher)(nil)
+
+func NewKycRolePublisher(publisher outbox.Publisher, roleGranter repository.RoleGranter) *KycRolePublisher {
+	return &KycRolePublisher{
+		publisher:   publisher,
+		roleGranter: roleGranter,
+	}
+}
+
+func (p *KycRolePublisher) Publish(ctx context.Context, exchange string, routingKey string, durable bool, msg []byte) *cus_err.CusError {
+	if routingKey == vo.KycReviewedEvent {
+		ctx, span := cus_otel.StartTrace(ctx)
+		defer span.End()
+
+		envelope := &outbox.Envelope{}
+		payload := &vo.KycReviewedPayload{}
+		if err := json.Unmarshal(msg, envelope); err != nil {
+			cusErr := cus_err.New(cus_err.InternalServerError, "failed to unmarshal the event envelope", err)
+			cus_otel.Error(ctx, cusErr.Error())
+			return cusErr
+		}
+		if err := json.Unmarshal(envelope.Payload, payload); err != nil {
+			cusErr := cus_err.New(cus_err.InternalServerError, "failed to unmarshal the kyc reviewed payload", err)
+			cus_otel.Error(ctx, cusErr.Error())
+			return cusErr
+		}
+
+		if payload.Status == enum.KycStatusType.Approved.String {
+			if err := p.roleGranter.GrantKycVerifiedRole(ctx, payload.UserId); err != nil {
+				return err
+			}
+		}
+	}
+
+	return p.publisher.Publish(ctx, exchange, routingKey, durable, msg)
+}
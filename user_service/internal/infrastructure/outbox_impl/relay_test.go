@@ -0,0 +1,92 @@
+package outbox_impl_test
+
+import (
+	"context"
+	"encoding/json"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/outbox"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/outbox_impl"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakePublisher struct {
+	published []string // The routing keys of the published events
+}
+
+func (p *fakePublisher) Publish(ctx context.Context, exchange string, routingKey string, durable bool, msg []byte) *cus_err.CusError {
+	p.published = append(p.published, routingKey)
+	return nil
+}
+
+// fakeRoleGranter records the promoted users, it fails when err is set
+type fakeRoleGranter struct {
+	promoted []int64
+	err      *cus_err.CusError
+}
+
+func (g *fakeRoleGranter) GrantKycVerifiedRole(ctx context.Context, userId int64) *cus_err.CusError {
+	if g.err != nil {
+		return g.err
+	}
+	g.promoted = append(g.promoted, userId)
+	return nil
+}
+
+func newEventBody(t *testing.T, event *outbox.Event) []byte {
+	m, err := outbox.NewMessage(event, time.Now().UTC())
+	require.Nil(t, err)
+	body, marshalErr := json.Marshal(outbox.NewEnvelope(outbox_impl.EventSource, m))
+	require.Nil(t, marshalErr)
+	return body
+}
+
+func TestKycRolePublisher(t *testing.T) {
+	ctx := context.Background()
+	reviewed := func(userId int64, status enum.KycStatus) *outbox.Event {
+		return vo.NewKycReviewedEvent(vo.KycReviewedPayload{
+			SubmissionId: 1,
+			UserId:       userId,
+			Status:       status.String,
+			ReviewedAt:   time.Now().UTC(),
+		})
+	}
+
+	t.Run("The approved player is promoted before the event is published", func(t *testing.T) {
+		publisher := &fakePublisher{}
+		roleGranter := &fakeRoleGranter{}
+		kycRolePublisher := outbox_impl.NewKycRolePublisher(publisher, roleGranter)
+
+		// The relay retries the event when the role can't be granted
+		roleGranter.err = cus_err.New(cus_err.InternalServerError, "auth service is unavailable")
+		err := kycRolePublisher.Publish(ctx, outbox_impl.EventExchange, vo.KycReviewedEvent, true, newEventBody(t, reviewed(5001, enum.KycStatusType.Approved)))
+		require.NotNil(t, err)
+		assert.Empty(t, publisher.published)
+
+		roleGranter.err = nil
+		err = kycRolePublisher.Publish(ctx, outbox_impl.EventExchange, vo.KycReviewedEvent, true, newEventBody(t, reviewed(5001, enum.KycStatusType.Approved)))
+		require.Nil(t, err)
+		assert.Equal(t, []int64{5001}, roleGranter.promoted)
+		assert.Equal(t, []string{vo.KycReviewedEvent}, publisher.published)
+	})
+
+	t.Run("The other events are published only", func(t *testing.T) {
+		publisher := &fakePublisher{}
+		roleGranter := &fakeRoleGranter{}
+		kycRolePublisher := outbox_impl.NewKycRolePublisher(publisher, roleGranter)
+
+		err := kycRolePublisher.Publish(ctx, outbox_impl.EventExchange, vo.KycReviewedEvent, true, newEventBody(t, reviewed(5002, enum.KycStatusType.Rejected)))
+		require.Nil(t, err)
+		created := vo.NewProfileCreatedEvent(vo.ProfileCreatedPayload{UserId: 5002, Account: "player"})
+		err = kycRolePublisher.Publish(ctx, outbox_impl.EventExchange, vo.ProfileCreatedEvent, true, newEventBody(t, created))
+		require.Nil(t, err)
+
+		assert.Empty(t, roleGranter.promoted)
+		assert.Equal(t, []string{vo.KycReviewedEvent, vo.ProfileCreatedEvent}, publisher.published)
+	})
+}
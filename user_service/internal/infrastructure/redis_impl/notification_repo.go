@@ -0,0 +1,115 @@
+package redis_impl
+
+import (
+	"context"
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/user_service/internal/config"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"time"
+)
+
+const (
+	notificationDeliveryKey  = "notification:delivery:%s"
+	notificationRecipientKey = "notification:recipient:%s"
+
+	// maxIndexedDeliveries is how many latest deliveries of a recipient are kept in the index
+	maxIndexedDeliveries = 20
+)
+
+type NotificationRepo struct {
+	cache db.Cache
+}
+
+var _ repository.NotificationRepo = (*NotificationRepo)(nil)
+
+func NewNotificationRepo(cache db.Cache) repository.NotificationRepo {
+	return &NotificationRepo{
+		cache: cache,
+	}
+}
+
+func (repo *NotificationRepo) SaveDelivery(ctx context.Context, delivery *vo.NotificationDelivery) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	ttl := time.Duration(config.GetConfig().NotifyStatusTTLSecs) * time.Second
+
+	// index the new delivery by its recipient
+	if delivery.Status == vo.DeliveryQueued {
+		ids := make([]string, 0)
+		indexKey := fmt.Sprintf(notificationRecipientKey, delivery.Recipient)
+		err := repo.cache.GetObject(ctx, indexKey, &ids)
+		if err != nil && err.Code().Int() != cus_err.ResourceNotFound {
+			return cus_err.New(cus_err.InternalServerError, "failed to get notification deliveries of the recipient", err)
+		}
+
+		ids = append([]string{delivery.Id}, ids...)
+		if len(ids) > maxIndexedDeliveries {
+			ids = ids[:maxIndexedDeliveries]
+		}
+		err = repo.cache.SetObject(ctx, indexKey, ids, ttl)
+		if err != nil {
+			return cus_err.New(cus_err.InternalServerError, "failed to set notification deliveries of the recipient", err)
+		}
+	}
+
+	err := repo.cache.SetObject(ctx, fmt.Sprintf(notificationDeliveryKey, delivery.Id), delivery, ttl)
+	if err != nil {
+		return cus_err.New(cus_err.InternalServerError, "failed to set notification delivery", err)
+	}
+
+	return nil
+}
+
+func (repo *NotificationRepo) FindDelivery(ctx context.Context, id string) (*vo.NotificationDelivery, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	delivery := &vo.NotificationDelivery{}
+	err := repo.cache.GetObject(ctx, fmt.Sprintf(notificationDeliveryKey, id), delivery)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			return nil, cus_err.New(cus_err.ResourceNotFound, "notification delivery not found", err)
+		}
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to get notification delivery", err)
+	}
+
+	return delivery, nil
+}
+
+func (repo *NotificationRepo) ListDeliveries(ctx context.Context, recipient string, limit int) ([]*vo.NotificationDelivery, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	ids := make([]string, 0)
+	err := repo.cache.GetObject(ctx, fmt.Sprintf(notificationRecipientKey, recipient), &ids)
+	if err != nil {
+		if err.Code().Int() == cus_err.ResourceNotFound {
+			return []*vo.NotificationDelivery{}, nil
+		}
+		return nil, cus_err.New(cus_err.InternalServerError, "failed to get notification deliveries of the recipient", err)
+	}
+
+	if limit > 0 && len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	deliveries := make([]*vo.NotificationDelivery, 0, len(ids))
+	for _, id := range ids {
+		delivery, err := repo.FindDelivery(ctx, id)
+		if err != nil {
+			// the delivery may expire before the index
+			if err.Code().Int() == cus_err.ResourceNotFound {
+				continue
+			}
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,83 @@
+package s3_impl
+
+import (
+	"bytes"
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/pkg/storage/s3"
+	"go_micro_service_api/user_service/internal/config"
+	"go_micro_service_api/user_service/internal/domain/repository"
+	"io"
+)
+
+// DocumentStorage keeps the documents in a private bucket of S3
+type DocumentStorage struct {
+	service *s3.Service
+	bucket  string
+}
+
+var _ repository.DocumentStorage = (*DocumentStorage)(nil)
+
+func NewDocumentStorage(service *s3.Service, bucket string) *DocumentStorage {
+	return &DocumentStorage{
+		service: service,
+		bucket:  bucket,
+	}
+}
+
+// NewDocumentStorageFx keeps the documents in the KYC bucket of the config
+func NewDocumentStorageFx(service *s3.Service) repository.DocumentStorage {
+	return NewDocumentStorage(service, config.GetConfig().KycBucket)
+}
+
+// PutDocument uploads the document, the content type is checked by the domain before it is uploaded
+func (s *DocumentStorage) PutDocument(ctx context.Context, key string, contentType string, content []byte) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	_, err := s.service.PutObject(ctx, &s.bucket, &key, bytes.NewReader(content))
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to upload the document", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
+
+func (s *DocumentStorage) GetDocument(ctx context.Context, key string) ([]byte, *cus_err.CusError) {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	object, err := s.service.GetObject(ctx, &s.bucket, &key)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to download the document", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+	defer object.Body.Close()
+
+	content, err := io.ReadAll(object.Body)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to read the document", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return nil, cusErr
+	}
+
+	return content, nil
+}
+
+func (s *DocumentStorage) DeleteDocument(ctx context.Context, key string) *cus_err.CusError {
+	ctx, span := cus_otel.StartTrace(ctx)
+	defer span.End()
+
+	err := s.service.DeleteObject(ctx, &s.bucket, &key)
+	if err != nil {
+		cusErr := cus_err.New(cus_err.InternalServerError, "failed to delete the document", err)
+		cus_otel.Error(ctx, cusErr.Error())
+		return cusErr
+	}
+
+	return nil
+}
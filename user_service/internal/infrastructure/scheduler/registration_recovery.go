@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_otel"
+	"go_micro_service_api/user_service/internal/application"
+	"time"
+
+	"go.uber.org/fx"
+)
+
+// registrationRecoveryInterval is how often the stale registrations are recovered
+const registrationRecoveryInterval = time.Minute
+
+// NewRegistrationRecoveryJob resumes or rolls back the registrations interrupted by a crash periodically until the application stops
+func NewRegistrationRecoveryJob(lc fx.Lifecycle, registrationService *application.RegistrationService) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	lc.Append(fx.Hook{
+		OnStart: func(startCtx context.Context) error {
+			go func() {
+				defer close(done)
+				ticker := time.NewTicker(registrationRecoveryInterval)
+				defer ticker.Stop()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						err := registrationService.RecoverStaleRegistrations(ctx)
+						if err != nil {
+							cus_otel.Error(ctx, "failed to recover stale registrations", cus_otel.NewField("error", err))
+						}
+					}
+				}
+			}()
+
+			cus_otel.Info(startCtx, "registration recovery job started")
+			return nil
+		},
+		OnStop: func(stopCtx context.Context) error {
+			cancel()
+			select {
+			case <-done:
+			case <-stopCtx.Done():
+				return stopCtx.Err()
+			}
+
+			cus_otel.Info(stopCtx, "registration recovery job stopped")
+			return nil
+		},
+	})
+}
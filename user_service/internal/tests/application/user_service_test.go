@@ -6,6 +6,7 @@ import (
 	"go_micro_service_api/user_service/internal/application"
 	domainService "go_micro_service_api/user_service/internal/domain/service"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl"
+	"go_micro_service_api/user_service/internal/infrastructure/redis_impl"
 	"go_micro_service_api/user_service/internal/tests"
 	"testing"
 )
@@ -14,10 +15,13 @@ func setUpUserApplication() (userApp *application.UserService, db db.Database, c
 	db = tests.NewMemoryDB()
 	redis, closeFunc := tests.NewMemoryRedis()
 	cache = redis_cache.NewRedisCache(redis)
-	userRepo := ent_impl.NewUserRepo(db)
+	userRepo := ent_impl.NewUserRepo(db, &ent_impl.OIDCVerifiers{})
 
-	userService := domainService.NewUserService(userRepo)
-	userApp = application.NewUserService(userService, db)
+	verifyService := domainService.NewVerifyService(redis_impl.NewVerifyRepo(cache), nil, nil)
+	userService := domainService.NewUserService(userRepo, ent_impl.NewOutboxRepo(db), verifyService)
+	attributeService := domainService.NewProfileAttributeService(ent_impl.NewProfileAttributeRepo(db), userRepo)
+	searchService := domainService.NewUserSearchService(userRepo, nil)
+	userApp = application.NewUserService(userService, attributeService, searchService, db)
 
 	return userApp, db, cache, closeFunc
 }
@@ -0,0 +1,247 @@
+package domain_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/pkg/storage/s3"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/outboxevent"
+	"go_micro_service_api/user_service/internal/infrastructure/s3_impl"
+	"go_micro_service_api/user_service/internal/tests"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	awss3 "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeS3Client keeps the objects in memory by the bucket and the key, putErr fails the uploads of the keys containing failKey
+type fakeS3Client struct {
+	objects map[string][]byte
+	failKey string
+	putErr  error
+}
+
+var _ s3.S3ClientAPI = (*fakeS3Client)(nil)
+
+func (c *fakeS3Client) ListObjectsV2(ctx context.Context, params *awss3.ListObjectsV2Input, optFns ...func(*awss3.Options)) (*awss3.ListObjectsV2Output, error) {
+	return &awss3.ListObjectsV2Output{}, nil
+}
+
+func (c *fakeS3Client) GetObject(ctx context.Context, params *awss3.GetObjectInput, optFns ...func(*awss3.Options)) (*awss3.GetObjectOutput, error) {
+	content, ok := c.objects[*params.Bucket+"/"+*params.Key]
+	if !ok {
+		return nil, fmt.Errorf("no such key %s", *params.Key)
+	}
+	return &awss3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(content))}, nil
+}
+
+func (c *fakeS3Client) PutObject(ctx context.Context, params *awss3.PutObjectInput, optFns ...func(*awss3.Options)) (*awss3.PutObjectOutput, error) {
+	if c.putErr != nil && strings.Contains(*params.Key, c.failKey) {
+		return nil, c.putErr
+	}
+	content, err := io.ReadAll(params.Body)
+	if err != nil {
+		return nil, err
+	}
+	c.objects[*params.Bucket+"/"+*params.Key] = content
+	return &awss3.PutObjectOutput{}, nil
+}
+
+func (c *fakeS3Client) DeleteObject(ctx context.Context, params *awss3.DeleteObjectInput, optFns ...func(*awss3.Options)) (*awss3.DeleteObjectOutput, error) {
+	delete(c.objects, *params.Bucket+"/"+*params.Key)
+	return &awss3.DeleteObjectOutput{}, nil
+}
+
+func TestKyc(t *testing.T) {
+	db := tests.NewMemoryDB()
+	s3Client := &fakeS3Client{objects: make(map[string][]byte)}
+	kycService := service.NewKycService(
+		ent_impl.NewKycRepo(db),
+		s3_impl.NewDocumentStorage(s3.NewService(s3Client), "kyc-documents"),
+		ent_impl.NewOutboxRepo(db),
+	)
+
+	ctx := context.Background()
+	reviewerId := int64(9001)
+
+	inTx := func(t *testing.T, f func(ctx context.Context) *cus_err.CusError) *cus_err.CusError {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		err = f(ctx)
+		if err != nil {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+			return err
+		}
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+		return nil
+	}
+	submit := func(t *testing.T, userId int64, uploads ...vo.KycUpload) (*entity.KycSubmission, *cus_err.CusError) {
+		var submission *entity.KycSubmission
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			var err *cus_err.CusError
+			submission, err = kycService.Submit(ctx, userId, uploads)
+			return err
+		})
+		return submission, err
+	}
+	review := func(t *testing.T, submissionId int64, approved bool, note string) (*entity.KycSubmission, *cus_err.CusError) {
+		var submission *entity.KycSubmission
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			var err *cus_err.CusError
+			submission, err = kycService.Review(ctx, submissionId, reviewerId, approved, note)
+			return err
+		})
+		return submission, err
+	}
+	passport := vo.KycUpload{Type: enum.KycDocumentType.Passport, ContentType: "image/jpeg", Content: []byte("passport")}
+	selfie := vo.KycUpload{Type: enum.KycDocumentType.Selfie, ContentType: "image/png", Content: []byte("selfie")}
+
+	t.Run("Invalid uploads", func(t *testing.T) {
+		tcs := []struct {
+			name    string
+			uploads []vo.KycUpload
+		}{
+			{name: "No document"},
+			{name: "No identity document", uploads: []vo.KycUpload{selfie}},
+			{name: "The same type twice", uploads: []vo.KycUpload{passport, passport}},
+			{name: "Unsupported format", uploads: []vo.KycUpload{{Type: enum.KycDocumentType.IdCard, ContentType: "image/gif", Content: []byte("gif")}}},
+			{name: "Empty document", uploads: []vo.KycUpload{{Type: enum.KycDocumentType.IdCard, ContentType: "image/jpeg"}}},
+			{name: "Too large", uploads: []vo.KycUpload{{Type: enum.KycDocumentType.IdCard, ContentType: "image/jpeg", Content: make([]byte, service.KycMaxDocumentBytes+1)}}},
+		}
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := submit(t, 5001, tc.uploads...)
+				require.NotNil(t, err)
+				assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+			})
+		}
+		assert.Empty(t, s3Client.objects)
+	})
+
+	t.Run("The uploaded documents are deleted when the submission fails", func(t *testing.T) {
+		userId := int64(5004)
+
+		// The selfie fails after the passport is uploaded
+		s3Client.failKey, s3Client.putErr = "/selfie-", fmt.Errorf("s3 is unavailable")
+		_, err := submit(t, userId, passport, selfie)
+		s3Client.failKey, s3Client.putErr = "", nil
+		require.NotNil(t, err)
+		assert.Empty(t, s3Client.objects)
+
+		// The submission can't be created without the transaction
+		_, err = kycService.Submit(ctx, userId, []vo.KycUpload{passport, selfie})
+		require.NotNil(t, err)
+		assert.Empty(t, s3Client.objects)
+
+		_, err = kycService.GetLatestSubmission(ctx, userId)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+
+	t.Run("Reject and submit again", func(t *testing.T) {
+		userId := int64(5002)
+		submission, err := submit(t, userId, passport, selfie)
+		require.Nil(t, err)
+		assert.Equal(t, enum.KycStatusType.Pending, submission.Status)
+		require.Len(t, submission.Documents, 2)
+		assert.True(t, strings.HasPrefix(submission.Documents[0].Key, fmt.Sprintf("kyc/%d/passport-", userId)))
+		assert.Equal(t, []byte("passport"), s3Client.objects["kyc-documents/"+submission.Documents[0].Key])
+
+		// Can't submit while it is pending
+		_, err = submit(t, userId, passport)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceIsExist, err.Code().Int())
+
+		// The rejection needs a reason
+		_, err = review(t, submission.Id, false, " ")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+
+		rejected, err := review(t, submission.Id, false, "The photo is blurred")
+		require.Nil(t, err)
+		assert.Equal(t, enum.KycStatusType.Rejected, rejected.Status)
+		assert.Equal(t, reviewerId, rejected.ReviewerId)
+		assert.Equal(t, "The photo is blurred", rejected.ReviewerNote)
+		assert.NotNil(t, rejected.ReviewedAt)
+
+		// The reviewed submission can't be reviewed again
+		_, err = review(t, submission.Id, true, "")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+
+		resubmitted, err := submit(t, userId, passport)
+		require.Nil(t, err)
+		latest, err := kycService.GetLatestSubmission(ctx, userId)
+		require.Nil(t, err)
+		assert.Equal(t, resubmitted.Id, latest.Id)
+	})
+
+	t.Run("Approve the player", func(t *testing.T) {
+		userId := int64(5003)
+		submission, err := submit(t, userId, passport, selfie)
+		require.Nil(t, err)
+
+		// The reviewer downloads the documents
+		document, content, err := kycService.GetDocument(ctx, submission.Id, enum.KycDocumentType.Selfie)
+		require.Nil(t, err)
+		assert.Equal(t, "image/png", document.ContentType)
+		assert.Equal(t, []byte("selfie"), content)
+		_, _, err = kycService.GetDocument(ctx, submission.Id, enum.KycDocumentType.IdCard)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+
+		approved, err := review(t, submission.Id, true, "")
+		require.Nil(t, err)
+		assert.Equal(t, enum.KycStatusType.Approved, approved.Status)
+
+		// The relay promotes the player by the announced review
+		events, queryErr := db.GetClient(ctx).(*ent.Client).OutboxEvent.Query().
+			Where(outboxevent.AggregateIDEQ(strconv.FormatInt(userId, 10))).
+			All(ctx)
+		require.Nil(t, queryErr)
+		require.Len(t, events, 1)
+		assert.Equal(t, vo.KycReviewedEvent, events[0].EventType)
+		assert.Contains(t, string(events[0].Payload), `"status":"approved"`)
+
+		// The verified player can't submit again
+		_, err = submit(t, userId, passport)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+
+	t.Run("List the submissions to review", func(t *testing.T) {
+		pending, total, err := kycService.ListSubmissions(ctx, enum.KycStatusType.Pending, vo.Pagination{})
+		require.Nil(t, err)
+		assert.Equal(t, 1, total)
+		require.Len(t, pending, 1)
+		assert.Equal(t, int64(5002), pending[0].UserId)
+
+		_, total, err = kycService.ListSubmissions(ctx, enum.KycStatusType.Rejected, vo.Pagination{Page: 1, PageSize: 10})
+		require.Nil(t, err)
+		assert.Equal(t, 1, total)
+
+		page, total, err := kycService.ListSubmissions(ctx, enum.KycStatusType.Approved, vo.Pagination{Page: 2, PageSize: 10})
+		require.Nil(t, err)
+		assert.Equal(t, 1, total)
+		assert.Empty(t, page)
+	})
+
+	t.Run("Never submitted", func(t *testing.T) {
+		_, err := kycService.GetLatestSubmission(ctx, 5999)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+}
@@ -0,0 +1,245 @@
+package domain_test
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/aggregate"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl"
+	"go_micro_service_api/user_service/internal/tests"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfileAttributes(t *testing.T) {
+	db := tests.NewMemoryDB()
+	userRepo := ent_impl.NewUserRepo(db, &ent_impl.OIDCVerifiers{})
+	attributeService := service.NewProfileAttributeService(ent_impl.NewProfileAttributeRepo(db), userRepo)
+
+	ctx := context.Background()
+	userId := int64(4001)
+	otherUserId := int64(4002)
+
+	inTx := func(t *testing.T, f func(ctx context.Context) *cus_err.CusError) *cus_err.CusError {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		err = f(ctx)
+		if err != nil {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+			return err
+		}
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+		return nil
+	}
+	define := func(t *testing.T, attribute *entity.ProfileAttribute) (*entity.ProfileAttribute, *cus_err.CusError) {
+		var defined *entity.ProfileAttribute
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			var err *cus_err.CusError
+			defined, err = attributeService.DefineAttribute(ctx, attribute)
+			return err
+		})
+		return defined, err
+	}
+	set := func(t *testing.T, userId int64, values map[string]string) (map[string]vo.AttributeValue, *cus_err.CusError) {
+		var typed map[string]vo.AttributeValue
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			var err *cus_err.CusError
+			typed, err = attributeService.SetAttributes(ctx, userId, values)
+			return err
+		})
+		return typed, err
+	}
+
+	err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+		_, err := userRepo.CreateProfile(ctx, &aggregate.User{ID: userId, Profile: entity.Profile{Account: "account4001", Email: "attr@gmail.com"}})
+		return err
+	})
+	require.Nil(t, err)
+
+	t.Run("Define attributes", func(t *testing.T) {
+		nickname, err := define(t, &entity.ProfileAttribute{
+			Name:      "nickname",
+			Type:      enum.AttributeTypes.String,
+			Rules:     vo.AttributeRules{MinLength: 2, MaxLength: 20},
+			Unique:    true,
+			PII:       enum.PIIClass.None,
+			VisibleTo: []enum.Client{enum.ClientType.Frontend, enum.ClientType.Backend},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, entity.CustomAttributeKeyStart, nickname.Key)
+
+		birthday, err := define(t, &entity.ProfileAttribute{
+			Name:      "birthday",
+			Type:      enum.AttributeTypes.Date,
+			Rules:     vo.AttributeRules{MinDate: "1900-01-01"},
+			PII:       enum.PIIClass.Sensitive,
+			VisibleTo: []enum.Client{enum.ClientType.Frontend, enum.ClientType.Backend},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, entity.CustomAttributeKeyStart+1, birthday.Key)
+
+		// Visible to the backend only by default
+		kycLevel, err := define(t, &entity.ProfileAttribute{
+			Name:  "kycLevel",
+			Type:  enum.AttributeTypes.Enum,
+			Rules: vo.AttributeRules{Options: []string{"none", "basic", "full"}},
+			PII:   enum.PIIClass.Sensitive,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []enum.Client{enum.ClientType.Backend}, kycLevel.VisibleTo)
+
+		_, err = define(t, &entity.ProfileAttribute{
+			Name: "backupPhone",
+			Type: enum.AttributeTypes.Phone,
+			PII:  enum.PIIClass.Personal,
+		})
+		require.Nil(t, err)
+
+		attributes, err := attributeService.ListAttributes(ctx)
+		require.Nil(t, err)
+		assert.Len(t, attributes, len(entity.BuiltinAttributes())+4)
+	})
+
+	t.Run("Invalid definitions", func(t *testing.T) {
+		tcs := []struct {
+			name      string
+			attribute *entity.ProfileAttribute
+			wantCode  int
+		}{
+			{
+				name:      "The name is defined",
+				attribute: &entity.ProfileAttribute{Name: "nickname", Type: enum.AttributeTypes.String, PII: enum.PIIClass.None},
+				wantCode:  cus_err.ResourceIsExist,
+			},
+			{
+				name:      "The name is built in",
+				attribute: &entity.ProfileAttribute{Name: "email", Type: enum.AttributeTypes.String, PII: enum.PIIClass.Personal},
+				wantCode:  cus_err.ResourceIsExist,
+			},
+			{
+				name:      "The name isn't camel case",
+				attribute: &entity.ProfileAttribute{Name: "Nick Name", Type: enum.AttributeTypes.String, PII: enum.PIIClass.None},
+				wantCode:  cus_err.InvalidArgument,
+			},
+			{
+				name:      "The enum has no options",
+				attribute: &entity.ProfileAttribute{Name: "vipLevel", Type: enum.AttributeTypes.Enum, PII: enum.PIIClass.None},
+				wantCode:  cus_err.InvalidArgument,
+			},
+			{
+				name:      "The pattern is invalid",
+				attribute: &entity.ProfileAttribute{Name: "referral", Type: enum.AttributeTypes.String, Rules: vo.AttributeRules{Pattern: "("}, PII: enum.PIIClass.None},
+				wantCode:  cus_err.InvalidArgument,
+			},
+			{
+				name:      "The pii class is missing",
+				attribute: &entity.ProfileAttribute{Name: "referral", Type: enum.AttributeTypes.String},
+				wantCode:  cus_err.InvalidArgument,
+			},
+		}
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := define(t, tc.attribute)
+				require.NotNil(t, err)
+				assert.Equal(t, tc.wantCode, err.Code().Int())
+			})
+		}
+	})
+
+	t.Run("Set and get the typed values", func(t *testing.T) {
+		typed, err := set(t, userId, map[string]string{
+			"nickname":    " Ricky ",
+			"birthday":    "2000-01-31",
+			"kycLevel":    "basic",
+			"backupPhone": "+886 912-345-678",
+		})
+		require.Nil(t, err)
+		assert.Equal(t, vo.AttributeValue{Type: enum.AttributeTypes.String, Value: "Ricky"}, typed["nickname"])
+		assert.Equal(t, vo.AttributeValue{Type: enum.AttributeTypes.Phone, Value: "+886912345678"}, typed["backupPhone"])
+
+		// The frontend can't see the kyc level
+		frontend, err := attributeService.GetAttributes(ctx, userId, enum.ClientType.Frontend)
+		require.Nil(t, err)
+		assert.Equal(t, "account4001", frontend["Account"].Value)
+		assert.Equal(t, "attr@gmail.com", frontend["Email"].Value)
+		assert.Equal(t, enum.AttributeTypes.Date, frontend["birthday"].Type)
+		date, dateErr := frontend["birthday"].Date()
+		require.Nil(t, dateErr)
+		assert.Equal(t, 31, date.Day())
+		assert.NotContains(t, frontend, "kycLevel")
+		assert.NotContains(t, frontend, "backupPhone")
+
+		backend, err := attributeService.GetAttributes(ctx, userId, enum.ClientType.Backend)
+		require.Nil(t, err)
+		assert.Equal(t, "basic", backend["kycLevel"].Value)
+
+		// Clear the attribute
+		_, err = set(t, userId, map[string]string{"backupPhone": ""})
+		require.Nil(t, err)
+		backend, err = attributeService.GetAttributes(ctx, userId, enum.ClientType.Backend)
+		require.Nil(t, err)
+		assert.NotContains(t, backend, "backupPhone")
+	})
+
+	t.Run("Invalid values", func(t *testing.T) {
+		tcs := []struct {
+			name     string
+			values   map[string]string
+			wantCode int
+		}{
+			{name: "Undefined attribute", values: map[string]string{"hobby": "golf"}, wantCode: cus_err.InvalidArgument},
+			{name: "Built-in attribute", values: map[string]string{"Email": "new@gmail.com"}, wantCode: cus_err.InvalidArgument},
+			{name: "Too short", values: map[string]string{"nickname": "R"}, wantCode: cus_err.InvalidArgument},
+			{name: "Not a date", values: map[string]string{"birthday": "31/01/2000"}, wantCode: cus_err.InvalidArgument},
+			{name: "Before the min date", values: map[string]string{"birthday": "1899-12-31"}, wantCode: cus_err.InvalidArgument},
+			{name: "Not an option", values: map[string]string{"kycLevel": "gold"}, wantCode: cus_err.InvalidArgument},
+			{name: "Not a phone number", values: map[string]string{"backupPhone": "0912345678"}, wantCode: cus_err.InvalidArgument},
+			{name: "Unique value of another user", values: map[string]string{"nickname": "Ricky"}, wantCode: cus_err.ResourceIsExist},
+		}
+		for _, tc := range tcs {
+			t.Run(tc.name, func(t *testing.T) {
+				_, err := set(t, otherUserId, tc.values)
+				require.NotNil(t, err)
+				assert.Equal(t, tc.wantCode, err.Code().Int())
+			})
+		}
+
+		// The user can keep the own unique value
+		_, err := set(t, userId, map[string]string{"nickname": "Ricky"})
+		require.Nil(t, err)
+	})
+
+	t.Run("Update the attribute", func(t *testing.T) {
+		var updated *entity.ProfileAttribute
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			var err *cus_err.CusError
+			updated, err = attributeService.UpdateAttribute(ctx, &entity.ProfileAttribute{
+				Name:      "kycLevel",
+				Rules:     vo.AttributeRules{Options: []string{"none", "basic", "full", "enhanced"}},
+				VisibleTo: []enum.Client{enum.ClientType.Frontend, enum.ClientType.Backend},
+			})
+			return err
+		})
+		require.Nil(t, err)
+		assert.Equal(t, enum.AttributeTypes.Enum, updated.Type)
+		assert.Equal(t, enum.PIIClass.Sensitive, updated.PII)
+
+		frontend, err := attributeService.GetAttributes(ctx, userId, enum.ClientType.Frontend)
+		require.Nil(t, err)
+		assert.Equal(t, "basic", frontend["kycLevel"].Value)
+
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := attributeService.UpdateAttribute(ctx, &entity.ProfileAttribute{Name: "Email"})
+			return err
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+}
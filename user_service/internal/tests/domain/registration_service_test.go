@@ -0,0 +1,254 @@
+package domain_test
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/db"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/aggregate"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runSagaStep runs the step of the saga in a transaction like the application does
+func runSagaStep(t *testing.T, db db.Database, step func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError)) (*entity.RegistrationSaga, *cus_err.CusError) {
+	ctx, err := db.Begin(context.Background())
+	require.Nil(t, err)
+
+	saga, err := step(ctx)
+	if err != nil {
+		_, rollbackErr := db.Rollback(ctx)
+		require.Nil(t, rollbackErr)
+		return nil, err
+	}
+
+	_, err = db.Commit(ctx)
+	require.Nil(t, err)
+	return saga, nil
+}
+
+func TestRegistrationSaga(t *testing.T) {
+	_, userRepo, db, _ := setupUserService()
+	accountRepo := &fakeAccountRepo{}
+	registrationService := service.NewRegistrationService(ent_impl.NewRegistrationSagaRepo(db), accountRepo, userRepo, ent_impl.NewOutboxRepo(db))
+
+	ctx := context.Background()
+	clientId := int64(1)
+
+	t.Run("Complete the registration", func(t *testing.T) {
+		profile := entity.Profile{Account: "saga-alice", Email: "saga-alice@example.com"}
+		saga, err := runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Start(ctx, clientId, "key-alice", 8001, profile)
+		})
+		require.Nil(t, err)
+		assert.Equal(t, enum.RegistrationStatusType.Started, saga.Status)
+
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.CreateAccount(ctx, saga, "password")
+		})
+		require.Nil(t, err)
+		assert.Equal(t, enum.RegistrationStatusType.AuthUserCreated, saga.Status)
+		assert.Equal(t, "saga-alice", accountRepo.created[8001])
+
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.CreateProfile(ctx, saga)
+		})
+		require.Nil(t, err)
+		assert.Equal(t, enum.RegistrationStatusType.Completed, saga.Status)
+
+		user, err := userRepo.GetProfile(ctx, &aggregate.User{ID: 8001}, []int{enum.ProfileKey.Email.ID})
+		require.Nil(t, err)
+		assert.Equal(t, "saga-alice@example.com", user.Profile.Email)
+
+		// The retry gets the result of the completed registration
+		found, err := registrationService.FindSaga(ctx, clientId, "key-alice")
+		require.Nil(t, err)
+		assert.Equal(t, int64(8001), found.UserId)
+		assert.Nil(t, registrationService.Replay(ctx, found, profile))
+
+		// The key can't be reused by another registration
+		err = registrationService.Replay(ctx, found, entity.Profile{Account: "saga-bob"})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+
+	t.Run("The same key registers once", func(t *testing.T) {
+		_, err := runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Start(ctx, clientId, "key-alice", 8002, entity.Profile{Account: "saga-alice"})
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.Conflict, err.Code().Int())
+
+		// Another client can use the same key
+		_, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Start(ctx, clientId+1, "key-alice", 8003, entity.Profile{Account: "saga-carol"})
+		})
+		assert.Nil(t, err)
+
+		_, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Start(ctx, clientId, " ", 8004, entity.Profile{Account: "saga-dave"})
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+
+	t.Run("Compensate the failed registration", func(t *testing.T) {
+		profile := entity.Profile{Account: "saga-erin"}
+		saga, err := runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Start(ctx, clientId, "key-erin", 8005, profile)
+		})
+		require.Nil(t, err)
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.CreateAccount(ctx, saga, "password")
+		})
+		require.Nil(t, err)
+
+		// The profile can't be created
+		cause := cus_err.New(cus_err.InternalServerError, "failed to create profile")
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Fail(ctx, saga, cause)
+		})
+		require.Nil(t, err)
+		assert.Equal(t, enum.RegistrationStatusType.Compensating, saga.Status)
+
+		// The auth service is down, the saga is left for the recovery
+		accountRepo.deleteErr = cus_err.New(cus_err.InternalServerError, "auth service is unavailable")
+		_, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Compensate(ctx, saga)
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, accountRepo.created, int64(8005))
+
+		found, err := registrationService.FindSaga(ctx, clientId, "key-erin")
+		require.Nil(t, err)
+		err = registrationService.Replay(ctx, found, profile)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.Conflict, err.Code().Int())
+
+		stale, err := registrationService.ListStaleSagas(ctx, time.Now().UTC().Add(service.RegistrationStaleAfter))
+		require.Nil(t, err)
+		var recovered *entity.RegistrationSaga
+		for _, s := range stale {
+			assert.False(t, s.IsDone())
+			if s.Id == saga.Id {
+				recovered = s
+			}
+		}
+		require.NotNil(t, recovered)
+		assert.Equal(t, enum.RegistrationStatusType.Compensating, recovered.Status)
+		assert.Equal(t, cause.Code(), recovered.Err().Code())
+
+		// The recovery deletes the auth user so the account can be registered again
+		accountRepo.deleteErr = nil
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Compensate(ctx, recovered)
+		})
+		require.Nil(t, err)
+		assert.Equal(t, enum.RegistrationStatusType.Compensated, saga.Status)
+		assert.NotContains(t, accountRepo.created, int64(8005))
+
+		// The retry gets the cause of the failure
+		found, err = registrationService.FindSaga(ctx, clientId, "key-erin")
+		require.Nil(t, err)
+		err = registrationService.Replay(ctx, found, profile)
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InternalServerError, err.Code().Int())
+		assert.Equal(t, "failed to create profile", err.Message())
+	})
+
+	t.Run("Link the openID of the oauth signup", func(t *testing.T) {
+		profile := entity.Profile{Account: "google8006"}
+		profile.ThirdParties.Set(enum.OAuthProvider.Google, entity.ThirdParty{ID: "saga-google-frank"})
+		saga, err := runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Start(ctx, clientId, "key-frank", 8006, profile)
+		})
+		require.Nil(t, err)
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.CreateAccount(ctx, saga, "")
+		})
+		require.Nil(t, err)
+
+		// The recovery gets the openID from the saga
+		found, err := registrationService.FindSaga(ctx, clientId, "key-frank")
+		require.Nil(t, err)
+		assert.Equal(t, "saga-google-frank", found.Profile.ThirdParties.Google.ID)
+
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.CreateProfile(ctx, found)
+		})
+		require.Nil(t, err)
+		assert.Equal(t, enum.RegistrationStatusType.Completed, saga.Status)
+
+		user, err := userRepo.GetProfile(ctx, &aggregate.User{ID: 8006}, []int{enum.ProfileKey.Account.ID, enum.ProfileKey.GoogleOpenID.ID})
+		require.Nil(t, err)
+		assert.Equal(t, "google8006", user.Profile.Account)
+		assert.Equal(t, "saga-google-frank", user.Profile.ThirdParties.Google.ID)
+
+		found, err = registrationService.FindSaga(ctx, clientId, "key-frank")
+		require.Nil(t, err)
+		assert.Nil(t, registrationService.Replay(ctx, found, profile))
+		err = registrationService.Replay(ctx, found, entity.Profile{Account: "google8006"})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+
+		// Another signup of the same openID fails before the profile is created and is compensated
+		another := entity.Profile{Account: "google8007"}
+		another.ThirdParties.Set(enum.OAuthProvider.Google, entity.ThirdParty{ID: "saga-google-frank"})
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Start(ctx, clientId, "key-frank-again", 8007, another)
+		})
+		require.Nil(t, err)
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.CreateAccount(ctx, saga, "")
+		})
+		require.Nil(t, err)
+		_, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.CreateProfile(ctx, saga)
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceIsExist, err.Code().Int())
+
+		// The profile isn't created
+		user, err = userRepo.GetProfile(ctx, &aggregate.User{ID: 8007}, []int{enum.ProfileKey.Account.ID})
+		require.Nil(t, err)
+		assert.Empty(t, user.Profile.Account)
+
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Fail(ctx, saga, cus_err.New(cus_err.ResourceIsExist, "Google account is already linked to another user"))
+		})
+		require.Nil(t, err)
+		saga, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Compensate(ctx, saga)
+		})
+		require.Nil(t, err)
+		assert.Equal(t, enum.RegistrationStatusType.Compensated, saga.Status)
+		assert.NotContains(t, accountRepo.created, int64(8007))
+	})
+
+	t.Run("The step of another process fails", func(t *testing.T) {
+		found, err := registrationService.FindSaga(ctx, clientId, "key-alice")
+		require.Nil(t, err)
+
+		// The completed saga can't be compensated
+		_, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Compensate(ctx, found)
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.Conflict, err.Code().Int())
+
+		// The saga has been moved by another process
+		stale := *found
+		stale.Status = enum.RegistrationStatusType.AuthUserCreated
+		_, err = runSagaStep(t, db, func(ctx context.Context) (*entity.RegistrationSaga, *cus_err.CusError) {
+			return registrationService.Fail(ctx, &stale, cus_err.New(cus_err.InternalServerError, "failed"))
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.Conflict, err.Code().Int())
+	})
+}
@@ -0,0 +1,140 @@
+package domain_test
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/pkg/enum"
+	"go_micro_service_api/user_service/internal/domain/aggregate"
+	"go_micro_service_api/user_service/internal/domain/entity"
+	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAccountRepo returns the accounts of the user ids from the latest, it records the searched user ids.
+// The created accounts are kept by the user ids, createErr and deleteErr fail the calls.
+type fakeAccountRepo struct {
+	accounts  []*vo.UserList
+	userIds   [][]int64
+	created   map[int64]string
+	createErr *cus_err.CusError
+	deleteErr *cus_err.CusError
+}
+
+func (r *fakeAccountRepo) SearchAccounts(ctx context.Context, search vo.UserSearch, userIds []int64) (*vo.UserListPage, *cus_err.CusError) {
+	r.userIds = append(r.userIds, userIds)
+
+	page := &vo.UserListPage{Users: []*vo.UserList{}, NextCursor: "next"}
+	for _, account := range r.accounts {
+		if userIds != nil && !slices.Contains(userIds, account.UserId) {
+			continue
+		}
+		copied := *account
+		page.Users = append(page.Users, &copied)
+	}
+	return page, nil
+}
+
+func (r *fakeAccountRepo) CreateAccount(ctx context.Context, clientId int64, userId int64, account string, password string) *cus_err.CusError {
+	if r.createErr != nil {
+		return r.createErr
+	}
+	if r.created == nil {
+		r.created = map[int64]string{}
+	}
+	r.created[userId] = account
+	return nil
+}
+
+func (r *fakeAccountRepo) DeleteAccount(ctx context.Context, clientId int64, userId int64) *cus_err.CusError {
+	if r.deleteErr != nil {
+		return r.deleteErr
+	}
+	delete(r.created, userId)
+	return nil
+}
+
+func TestSearchUsers(t *testing.T) {
+	userService, userRepo, db, _ := setupUserService()
+	accountRepo := &fakeAccountRepo{}
+	searchService := service.NewUserSearchService(userRepo, accountRepo)
+
+	ctx := context.Background()
+	profiles := []entity.Profile{
+		{Account: "alice", Email: "alice@example.com", CountryCode: "886", MobileNumber: "912345678"},
+		{Account: "bob", Email: "bob@example.com", CountryCode: "81", MobileNumber: "912345678"},
+		{Account: "carol", CountryCode: "886", MobileNumber: "987654321"},
+	}
+	for i, profile := range profiles {
+		userId := int64(7001 + i)
+		txCtx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		_, err = userService.CreateProfile(txCtx, &aggregate.User{ID: userId, Profile: profile})
+		require.Nil(t, err)
+		_, err = db.Commit(txCtx)
+		require.Nil(t, err)
+
+		accountRepo.accounts = append([]*vo.UserList{{
+			UserId:   userId,
+			Account:  profile.Account,
+			Status:   enum.UserStatusType.Active,
+			RoleId:   2,
+			RoleName: "Player",
+		}}, accountRepo.accounts...)
+	}
+
+	t.Run("Join the profiles with the accounts", func(t *testing.T) {
+		page, err := searchService.SearchUsers(ctx, vo.UserSearch{})
+		require.Nil(t, err)
+		assert.Nil(t, accountRepo.userIds[len(accountRepo.userIds)-1])
+		assert.Equal(t, "next", page.NextCursor)
+		require.Len(t, page.Users, 3)
+
+		user := page.Users[2]
+		assert.Equal(t, int64(7001), user.UserId)
+		assert.Equal(t, "alice", user.Account)
+		assert.Equal(t, "alice@example.com", user.Email)
+		assert.Equal(t, "886", user.CountryCode)
+		assert.Equal(t, "912345678", user.MobileNumber)
+		assert.Equal(t, "Player", user.RoleName)
+		assert.Empty(t, page.Users[0].Email)
+	})
+
+	t.Run("Search by the email and the mobile", func(t *testing.T) {
+		page, err := searchService.SearchUsers(ctx, vo.UserSearch{Email: "bob@example.com"})
+		require.Nil(t, err)
+		require.Len(t, page.Users, 1)
+		assert.Equal(t, "bob", page.Users[0].Account)
+
+		page, err = searchService.SearchUsers(ctx, vo.UserSearch{MobileNumber: "912345678"})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []int64{7001, 7002}, accountRepo.userIds[len(accountRepo.userIds)-1])
+		assert.Len(t, page.Users, 2)
+
+		page, err = searchService.SearchUsers(ctx, vo.UserSearch{CountryCode: "81", MobileNumber: "912345678"})
+		require.Nil(t, err)
+		require.Len(t, page.Users, 1)
+		assert.Equal(t, "bob", page.Users[0].Account)
+	})
+
+	t.Run("Nothing matches the profiles", func(t *testing.T) {
+		searched := len(accountRepo.userIds)
+		page, err := searchService.SearchUsers(ctx, vo.UserSearch{Email: "alice@example.com", CountryCode: "81", MobileNumber: "912345678"})
+		require.Nil(t, err)
+		assert.Empty(t, page.Users)
+		assert.Empty(t, page.NextCursor)
+
+		// The accounts aren't searched
+		assert.Len(t, accountRepo.userIds, searched)
+	})
+
+	t.Run("The country code needs the mobile number", func(t *testing.T) {
+		_, err := searchService.SearchUsers(ctx, vo.UserSearch{CountryCode: "886"})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+}
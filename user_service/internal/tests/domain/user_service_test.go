@@ -2,16 +2,20 @@ package domain_test
 
 import (
 	"context"
+	"go_micro_service_api/pkg/cus_err"
 	"go_micro_service_api/pkg/db"
 	"go_micro_service_api/pkg/enum"
 	"go_micro_service_api/user_service/internal/domain/aggregate"
 	"go_micro_service_api/user_service/internal/domain/entity"
 	"go_micro_service_api/user_service/internal/domain/repository"
 	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/domain/vo"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent"
+	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/outboxevent"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl/ent/profile"
 	"go_micro_service_api/user_service/internal/tests"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,9 +24,11 @@ import (
 
 func setupUserService() (userService *service.UserService, userRepo repository.UserRepo, db db.Database, closeFunc func()) {
 	db = tests.NewMemoryDB()
-	userRepo = ent_impl.NewUserRepo(db)
+	userRepo = ent_impl.NewUserRepo(db, &ent_impl.OIDCVerifiers{})
 
-	return service.NewUserService(userRepo), userRepo, db, closeFunc
+	verifyService := service.NewVerifyService(&fakeVerifyRepo{}, &fakeNotifier{}, nil)
+
+	return service.NewUserService(userRepo, ent_impl.NewOutboxRepo(db), verifyService), userRepo, db, closeFunc
 }
 
 func TestCreateProfile(t *testing.T) {
@@ -92,10 +98,25 @@ func TestCreateProfile(t *testing.T) {
 					require.Nil(t, err)
 					assert.True(t, exist)
 				}
+
+				// The event is added in the transaction of the profile
+				event, queryErr := client.OutboxEvent.Query().
+					Where(outboxevent.AggregateIDEQ(strconv.FormatInt(u.ID, 10))).
+					Only(ctx)
+				require.Nil(t, queryErr)
+				assert.Equal(t, vo.ProfileCreatedEvent, event.EventType)
+				assert.Nil(t, event.PublishedAt)
 			}
 
 			_, err = db.Rollback(ctx)
 			require.Nil(t, err)
+
+			// The event is rolled back with the profile
+			exist, queryErr := db.GetClient(context.Background()).(*ent.Client).OutboxEvent.Query().
+				Where(outboxevent.AggregateIDEQ(strconv.FormatInt(u.ID, 10))).
+				Exist(context.Background())
+			require.Nil(t, queryErr)
+			assert.False(t, exist)
 		})
 	}
 }
@@ -288,3 +309,300 @@ func TestCheckEmailExistence(t *testing.T) {
 		})
 	}
 }
+
+// fakeOAuthRepo answers the openID of the access token instead of asking the oauth provider
+type fakeOAuthRepo struct {
+	repository.UserRepo
+	openIDs map[string]string // access token to openID
+}
+
+func (r *fakeOAuthRepo) GetProfileFromOAuth(ctx context.Context, u *aggregate.User, session *vo.OAuthSession) (*aggregate.User, *cus_err.CusError) {
+	openID, ok := r.openIDs[session.AccessToken]
+	if !ok {
+		return u, cus_err.New(cus_err.ThirdPartyError, "invalid token")
+	}
+	u.Profile.ThirdParties.Set(session.Provider, entity.ThirdParty{ID: openID})
+	return u, nil
+}
+
+func TestOAuthLink(t *testing.T) {
+	db := tests.NewMemoryDB()
+	userRepo := &fakeOAuthRepo{
+		UserRepo: ent_impl.NewUserRepo(db, &ent_impl.OIDCVerifiers{}),
+		openIDs: map[string]string{
+			"google-token":       "google-open-id",
+			"other-google-token": "other-google-open-id",
+			"meta-token":         "meta-open-id",
+		},
+	}
+	userService := service.NewUserService(userRepo, ent_impl.NewOutboxRepo(db), service.NewVerifyService(&fakeVerifyRepo{}, &fakeNotifier{}, nil))
+
+	ctx := context.Background()
+	socialUserId := int64(2001)
+	accountUserId := int64(2002)
+
+	// The social user signs up with google, the other user signs up with an account
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+	_, err = userService.LinkOAuth(ctx, socialUserId, vo.NewOAuthSession(enum.OAuthProvider.Google, "google-token"))
+	require.Nil(t, err)
+	_, err = userRepo.CreateProfile(ctx, &aggregate.User{ID: accountUserId, Profile: entity.Profile{Account: "account2002"}})
+	require.Nil(t, err)
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	inTx := func(t *testing.T, f func(ctx context.Context) *cus_err.CusError) *cus_err.CusError {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		err = f(ctx)
+		if err != nil {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+			return err
+		}
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+		return nil
+	}
+
+	t.Run("Verify the bound openID", func(t *testing.T) {
+		identity, err := userService.VerifyOAuth(ctx, vo.NewOAuthSession(enum.OAuthProvider.Google, "google-token"))
+		require.Nil(t, err)
+		assert.Equal(t, "google-open-id", identity.OpenID)
+		assert.Equal(t, socialUserId, identity.UserId)
+
+		identity, err = userService.VerifyOAuth(ctx, vo.NewOAuthSession(enum.OAuthProvider.Meta, "meta-token"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), identity.UserId)
+
+		_, err = userService.VerifyOAuth(ctx, vo.NewOAuthSession(enum.OAuthProvider.Google, "invalid-token"))
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ThirdPartyError, err.Code().Int())
+	})
+
+	t.Run("The openID is bound to one user only", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := userService.LinkOAuth(ctx, accountUserId, vo.NewOAuthSession(enum.OAuthProvider.Google, "google-token"))
+			return err
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceIsExist, err.Code().Int())
+	})
+
+	t.Run("The user links one account of each provider", func(t *testing.T) {
+		// Linking the same account again changes nothing
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := userService.LinkOAuth(ctx, socialUserId, vo.NewOAuthSession(enum.OAuthProvider.Google, "google-token"))
+			return err
+		})
+		require.Nil(t, err)
+
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := userService.LinkOAuth(ctx, socialUserId, vo.NewOAuthSession(enum.OAuthProvider.Google, "other-google-token"))
+			return err
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceIsExist, err.Code().Int())
+	})
+
+	t.Run("The last way to sign in can't be unlinked", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			return userService.UnlinkOAuth(ctx, socialUserId, enum.OAuthProvider.Google)
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+
+	t.Run("Link and unlink another provider", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := userService.LinkOAuth(ctx, socialUserId, vo.NewOAuthSession(enum.OAuthProvider.Meta, "meta-token"))
+			return err
+		})
+		require.Nil(t, err)
+
+		identity, err := userService.VerifyOAuth(ctx, vo.NewOAuthSession(enum.OAuthProvider.Meta, "meta-token"))
+		require.Nil(t, err)
+		assert.Equal(t, socialUserId, identity.UserId)
+
+		// Google can be unlinked now, the user still signs in with meta
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			return userService.UnlinkOAuth(ctx, socialUserId, enum.OAuthProvider.Google)
+		})
+		require.Nil(t, err)
+
+		identity, err = userService.VerifyOAuth(ctx, vo.NewOAuthSession(enum.OAuthProvider.Google, "google-token"))
+		require.Nil(t, err)
+		assert.Equal(t, int64(0), identity.UserId)
+
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			return userService.UnlinkOAuth(ctx, socialUserId, enum.OAuthProvider.Google)
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceNotFound, err.Code().Int())
+	})
+
+	t.Run("The user with an account can unlink every provider", func(t *testing.T) {
+		err := inTx(t, func(ctx context.Context) *cus_err.CusError {
+			_, err := userService.LinkOAuth(ctx, accountUserId, vo.NewOAuthSession(enum.OAuthProvider.Google, "other-google-token"))
+			return err
+		})
+		require.Nil(t, err)
+
+		err = inTx(t, func(ctx context.Context) *cus_err.CusError {
+			return userService.UnlinkOAuth(ctx, accountUserId, enum.OAuthProvider.Google)
+		})
+		require.Nil(t, err)
+	})
+}
+
+func TestUpdateProfile(t *testing.T) {
+	db := tests.NewMemoryDB()
+	userRepo := ent_impl.NewUserRepo(db, &ent_impl.OIDCVerifiers{})
+	verifyService := service.NewVerifyService(&fakeVerifyRepo{}, &fakeNotifier{}, nil)
+	userService := service.NewUserService(userRepo, ent_impl.NewOutboxRepo(db), verifyService)
+
+	ctx := context.Background()
+	userId := int64(3001)
+	otherUserId := int64(3002)
+	updateType := enum.VerificationTypes.UpdateProfile.String
+
+	ctx, err := db.Begin(ctx)
+	require.Nil(t, err)
+	_, err = userRepo.CreateProfile(ctx, &aggregate.User{ID: userId, Profile: entity.Profile{
+		Account:      "account3001",
+		Email:        "old@gmail.com",
+		CountryCode:  "886",
+		MobileNumber: "912345678",
+	}})
+	require.Nil(t, err)
+	_, err = userRepo.CreateProfile(ctx, &aggregate.User{ID: otherUserId, Profile: entity.Profile{
+		Email:        "other@gmail.com",
+		CountryCode:  "886",
+		MobileNumber: "987654321",
+	}})
+	require.Nil(t, err)
+	ctx, err = db.Commit(ctx)
+	require.Nil(t, err)
+
+	// register asks the verify service for a code of the new value
+	register := func(t *testing.T, email, countryCode, mobileNumber string) *vo.VerificationSession {
+		session, err := verifyService.RegisterVerification(ctx, updateType, email, countryCode, mobileNumber, "")
+		require.Nil(t, err)
+		return vo.NewVerificationSession(updateType, session.Prefix, session.Code, session.Token)
+	}
+	update := func(t *testing.T, u *vo.ProfileUpdate) (*aggregate.User, *cus_err.CusError) {
+		ctx, err := db.Begin(ctx)
+		require.Nil(t, err)
+		res, err := userService.UpdateProfile(ctx, userId, u)
+		if err != nil {
+			_, rollbackErr := db.Rollback(ctx)
+			require.Nil(t, rollbackErr)
+			return nil, err
+		}
+		_, err = db.Commit(ctx)
+		require.Nil(t, err)
+		return res, nil
+	}
+
+	t.Run("The new email must be verified", func(t *testing.T) {
+		_, err := update(t, &vo.ProfileUpdate{Email: "new@gmail.com"})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+
+		// The code of another email can't be used
+		_, err = update(t, &vo.ProfileUpdate{
+			Email:             "new@gmail.com",
+			EmailVerification: register(t, "another@gmail.com", "", ""),
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+
+		session := register(t, "new@gmail.com", "", "")
+		wrongCode := "000000"
+		if session.Code == wrongCode {
+			wrongCode = "111111"
+		}
+		session.Code = wrongCode
+		_, err = update(t, &vo.ProfileUpdate{Email: "new@gmail.com", EmailVerification: session})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidVerificationCode, err.Code().Int())
+	})
+
+	t.Run("The email used by another user can't be taken", func(t *testing.T) {
+		_, err := update(t, &vo.ProfileUpdate{
+			Email:             "other@gmail.com",
+			EmailVerification: register(t, "other@gmail.com", "", ""),
+		})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ResourceIsExist, err.Code().Int())
+	})
+
+	t.Run("Update the email and the mobile number", func(t *testing.T) {
+		u, err := update(t, &vo.ProfileUpdate{
+			Email:              "new@gmail.com",
+			EmailVerification:  register(t, "new@gmail.com", "", ""),
+			CountryCode:        "81",
+			MobileNumber:       "9012345678",
+			MobileVerification: register(t, "", "81", "9012345678"),
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "account3001", u.Profile.Account)
+		assert.Equal(t, "new@gmail.com", u.Profile.Email)
+		assert.Equal(t, "81", u.Profile.CountryCode)
+		assert.Equal(t, "9012345678", u.Profile.MobileNumber)
+
+		u, err = userService.GetProfile(ctx, &aggregate.User{ID: userId}, []int{
+			enum.ProfileKey.Email.ID,
+			enum.ProfileKey.CountryCode.ID,
+			enum.ProfileKey.MobileNumber.ID,
+		})
+		require.Nil(t, err)
+		assert.Equal(t, "new@gmail.com", u.Profile.Email)
+		assert.Equal(t, "81", u.Profile.CountryCode)
+		assert.Equal(t, "9012345678", u.Profile.MobileNumber)
+
+		// The old values are kept as inactive rows
+		client := db.GetClient(ctx).(*ent.Client)
+		history, queryErr := client.Profile.Query().
+			Where(profile.UserIDEQ(int(userId)), profile.KeyEQ(enum.ProfileKey.Email.ID)).
+			All(ctx)
+		require.Nil(t, queryErr)
+		require.Len(t, history, 2)
+		for _, row := range history {
+			assert.Equal(t, row.Value == "new@gmail.com", row.IsActive)
+		}
+
+		// The event names the changed keys without the values
+		event, queryErr := client.OutboxEvent.Query().
+			Where(
+				outboxevent.AggregateIDEQ(strconv.FormatInt(userId, 10)),
+				outboxevent.EventTypeEQ(vo.ProfileUpdatedEvent),
+			).
+			Only(ctx)
+		require.Nil(t, queryErr)
+		assert.JSONEq(t, `{"userId":3001,"keys":["Email","CountryCode","MobileNumber"]}`, string(event.Payload))
+
+		// The old email is free again, the new one is taken
+		exist, err := userService.CheckEmailExistence(ctx, "old@gmail.com")
+		require.Nil(t, err)
+		assert.False(t, exist)
+		exist, err = userService.CheckMobileExistence(ctx, "81", "9012345678")
+		require.Nil(t, err)
+		assert.True(t, exist)
+		exist, err = userService.CheckMobileExistence(ctx, "886", "912345678")
+		require.Nil(t, err)
+		assert.False(t, exist)
+	})
+
+	t.Run("The unchanged value needs no verification", func(t *testing.T) {
+		u, err := update(t, &vo.ProfileUpdate{Email: "new@gmail.com"})
+		require.Nil(t, err)
+		assert.Equal(t, "new@gmail.com", u.Profile.Email)
+	})
+
+	t.Run("The mobile number is updated with the country code", func(t *testing.T) {
+		_, err := update(t, &vo.ProfileUpdate{MobileNumber: "912345678"})
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+	})
+}
@@ -0,0 +1,136 @@
+package domain_test
+
+import (
+	"context"
+	"go_micro_service_api/pkg/cus_err"
+	"go_micro_service_api/user_service/internal/domain/service"
+	"go_micro_service_api/user_service/internal/domain/vo"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeVerifyRepo struct {
+	sessions []*vo.VerificationSession
+}
+
+func (r *fakeVerifyRepo) RegisterVerification(ctx context.Context, session *vo.VerificationSession) *cus_err.CusError {
+	r.sessions = append(r.sessions, session)
+	return nil
+}
+
+// Verification verifies the session against the registered ones, the verified session is used up
+func (r *fakeVerifyRepo) Verification(ctx context.Context, session *vo.VerificationSession) (bool, *cus_err.CusError) {
+	for i, registered := range r.sessions {
+		if registered.Verify(session.GetVerificationCode(), session.Token) {
+			r.sessions = append(r.sessions[:i], r.sessions[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type fakeNotifier struct {
+	notices []*vo.VerificationNotice
+	err     *cus_err.CusError
+}
+
+func (n *fakeNotifier) SendVerification(ctx context.Context, notice *vo.VerificationNotice) *cus_err.CusError {
+	n.notices = append(n.notices, notice)
+	return n.err
+}
+
+func TestRegisterVerificationNotify(t *testing.T) {
+	ctx := context.Background()
+
+	tcs := []struct {
+		name             string
+		verificationType string
+		email            string
+		countryCode      string
+		mobileNumber     string
+		locale           string
+		wantChannel      vo.NotifyChannel
+		wantRecipient    string
+		wantType         string
+	}{
+		{
+			name:          "Register by email",
+			email:         "test@cus.go",
+			locale:        "en",
+			wantChannel:   vo.EmailChannel,
+			wantRecipient: "test@cus.go",
+			wantType:      vo.RegisterVerificationType,
+		},
+		{
+			name:             "Forgot password by mobile number",
+			verificationType: "forgotPwd",
+			countryCode:      "886",
+			mobileNumber:     "912345678",
+			locale:           "zh-TW",
+			wantChannel:      vo.SMSChannel,
+			wantRecipient:    "+886912345678",
+			wantType:         "forgotPwd",
+		},
+		{
+			name:             "Unusual login by email",
+			verificationType: "unusualLogin",
+			email:            "test@cus.go",
+			wantChannel:      vo.EmailChannel,
+			wantRecipient:    "test@cus.go",
+			wantType:         "unusualLogin",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &fakeVerifyRepo{}
+			notifier := &fakeNotifier{}
+			verifyService := service.NewVerifyService(repo, notifier, nil)
+
+			session, err := verifyService.RegisterVerification(ctx, tc.verificationType, tc.email, tc.countryCode, tc.mobileNumber, tc.locale)
+			require.Nil(t, err)
+			require.Len(t, repo.sessions, 1)
+			require.Len(t, notifier.notices, 1)
+
+			notice := notifier.notices[0]
+			assert.Equal(t, tc.wantChannel, notice.Channel)
+			assert.Equal(t, tc.wantRecipient, notice.Recipient)
+			assert.Equal(t, tc.wantType, notice.Type)
+			assert.Equal(t, tc.locale, notice.Locale)
+			assert.Equal(t, session.Prefix, notice.Prefix)
+			assert.Equal(t, session.Code, notice.Code)
+			assert.True(t, session.IsIssuedFor(tc.verificationType, tc.email, tc.countryCode, tc.mobileNumber))
+		})
+	}
+
+	t.Run("Missing recipient", func(t *testing.T) {
+		notifier := &fakeNotifier{}
+		verifyService := service.NewVerifyService(&fakeVerifyRepo{}, notifier, nil)
+
+		_, err := verifyService.RegisterVerification(ctx, "", "", "886", "", "")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+		assert.Empty(t, notifier.notices)
+	})
+
+	t.Run("Both email and mobile number", func(t *testing.T) {
+		notifier := &fakeNotifier{}
+		verifyService := service.NewVerifyService(&fakeVerifyRepo{}, notifier, nil)
+
+		_, err := verifyService.RegisterVerification(ctx, "forgotPwd", "test@cus.go", "886", "912345678", "")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.InvalidArgument, err.Code().Int())
+		assert.Empty(t, notifier.notices)
+	})
+
+	t.Run("Failed to send", func(t *testing.T) {
+		notifier := &fakeNotifier{err: cus_err.New(cus_err.ThirdPartyError, "smtp is down")}
+		verifyService := service.NewVerifyService(&fakeVerifyRepo{}, notifier, nil)
+
+		_, err := verifyService.RegisterVerification(ctx, "", "test@cus.go", "", "", "")
+		require.NotNil(t, err)
+		assert.Equal(t, cus_err.ThirdPartyError, err.Code().Int())
+	})
+}
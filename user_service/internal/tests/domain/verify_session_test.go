@@ -1,7 +1,6 @@
 package domain_test
 
 import (
-	"context"
 	"crypto/md5"
 	"fmt"
 	"go_micro_service_api/user_service/internal/domain/vo"
@@ -22,10 +21,11 @@ func TestGenerateVerificationCode(t *testing.T) {
 
 	t.Run("check token", func(t *testing.T) {
 		session := &vo.VerificationSession{}
-		session.NextToken(context.Background(), "123", "456")
+		session.NextToken("123", "456")
 
 		hasher := md5.New()
-		hasher.Write([]byte("123456"))
+		// Each field is prefixed by its length
+		hasher.Write([]byte("3:1233:456"))
 		expected := fmt.Sprintf("%x", hasher.Sum(nil))
 
 		assert.Equal(t, expected, session.Token)
@@ -56,4 +56,18 @@ func TestGenerateVerificationCode(t *testing.T) {
 		assert.False(t, session.Verify("ABC-123456", "126789"))
 		assert.False(t, session.Verify("AB1456", "126789"))
 	})
+
+	t.Run("check token identity", func(t *testing.T) {
+		session := &vo.VerificationSession{}
+		session.NextToken("forgotPwd", "test@gmail.com", "", "")
+
+		assert.True(t, session.IsIssuedFor("forgotPwd", "test@gmail.com", "", ""))
+		assert.False(t, session.IsIssuedFor("forgotPwd", "other@gmail.com", "", ""))
+
+		// The fields can't be shifted into each other
+		session.NextToken("updateInfo", "", "886", "912345678")
+		assert.True(t, session.IsIssuedFor("updateInfo", "", "886", "912345678"))
+		assert.False(t, session.IsIssuedFor("updateInfo", "", "8869", "12345678"))
+		assert.False(t, session.IsIssuedFor("updateInfo", "886", "912345678", ""))
+	})
 }
@@ -3,12 +3,20 @@ package main
 import (
 	"go_micro_service_api/pkg/db"
 	redis_cache "go_micro_service_api/pkg/db/redis"
+	"go_micro_service_api/pkg/outbox"
+	"go_micro_service_api/pkg/storage/s3"
 	"go_micro_service_api/user_service/internal/application"
+	"go_micro_service_api/user_service/internal/domain/repository"
 	"go_micro_service_api/user_service/internal/domain/service"
 	"go_micro_service_api/user_service/internal/infrastructure/db_impl"
 	"go_micro_service_api/user_service/internal/infrastructure/ent_impl"
+	"go_micro_service_api/user_service/internal/infrastructure/grpc_client"
 	"go_micro_service_api/user_service/internal/infrastructure/grpc_impl"
+	"go_micro_service_api/user_service/internal/infrastructure/notify_impl"
+	"go_micro_service_api/user_service/internal/infrastructure/outbox_impl"
 	"go_micro_service_api/user_service/internal/infrastructure/redis_impl"
+	"go_micro_service_api/user_service/internal/infrastructure/s3_impl"
+	"go_micro_service_api/user_service/internal/infrastructure/scheduler"
 
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
@@ -17,14 +25,40 @@ import (
 func main() {
 	fx.New(
 		db_impl.NewEntDbFx(),
+		s3.NewS3ClientFx(),
 		fx.Provide(
 			grpc_impl.NewGrpcServer,
 			application.NewUserService,
 			application.NewVerifyService,
+			application.NewProfileAttributeService,
+			application.NewKycService,
+			application.NewRegistrationService,
 			service.NewUserService,
 			service.NewVerifyService,
+			service.NewProfileAttributeService,
+			service.NewKycService,
+			service.NewUserSearchService,
+			service.NewRegistrationService,
 			ent_impl.NewUserRepo,
+			ent_impl.NewProfileAttributeRepo,
+			ent_impl.NewKycRepo,
+			ent_impl.NewRegistrationSagaRepo,
+			fx.Annotate(
+				ent_impl.NewOutboxRepo,
+				fx.As(new(repository.EventRepo), new(outbox.Store)),
+			),
+			outbox_impl.NewRelay,
+			s3_impl.NewDocumentStorageFx,
+			fx.Annotate(
+				grpc_client.NewAuthClient,
+				fx.As(new(repository.RoleGranter), new(repository.AccountRepo)),
+			),
+			ent_impl.NewOIDCVerifiers,
 			redis_impl.NewVerifyRepo,
+			redis_impl.NewNotificationRepo,
+			notify_impl.NewBroker,
+			notify_impl.NewPublisher,
+			notify_impl.NewWorkerFx,
 			fx.Annotate(
 				redis_cache.NewRedisCache,
 				fx.As(new(db.Cache)),
@@ -32,7 +66,9 @@ func main() {
 			redis_impl.NewRedisClient,
 		),
 		fx.Invoke(
-			func(server *grpc.Server) {},
+			func(server *grpc.Server, worker *notify_impl.Worker) {},
+			scheduler.NewRegistrationRecoveryJob,
+			scheduler.NewOutboxRelayJob,
 		),
 	).Run()
 
@@ -0,0 +1,16 @@
+-- Create "profile_attributes" table
+CREATE TABLE "profile_attributes" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "key" bigint NOT NULL, "name" character varying NOT NULL, "type" bigint NOT NULL, "rules" jsonb NOT NULL, "is_unique" boolean NOT NULL DEFAULT false, "pii_class" bigint NOT NULL, "visible_to" jsonb NOT NULL, PRIMARY KEY ("id"));
+-- Create index "profile_attributes_key_key" to table: "profile_attributes"
+CREATE UNIQUE INDEX "profile_attributes_key_key" ON "profile_attributes" ("key");
+-- Create index "profile_attributes_name_key" to table: "profile_attributes"
+CREATE UNIQUE INDEX "profile_attributes_name_key" ON "profile_attributes" ("name");
+-- Set comment to table: "profile_attributes"
+COMMENT ON TABLE "profile_attributes" IS 'Profile attributes defined at runtime, the built-in ones are declared in the code';
+-- Set comment to column: "key" on table: "profile_attributes"
+COMMENT ON COLUMN "profile_attributes"."key" IS 'The key of the profiles, starts from 1000';
+-- Set comment to column: "type" on table: "profile_attributes"
+COMMENT ON COLUMN "profile_attributes"."type" IS 'pkg/enum/attribute_type';
+-- Set comment to column: "pii_class" on table: "profile_attributes"
+COMMENT ON COLUMN "profile_attributes"."pii_class" IS 'pkg/enum/pii_class';
+-- Set comment to column: "visible_to" on table: "profile_attributes"
+COMMENT ON COLUMN "profile_attributes"."visible_to" IS 'The ids of pkg/enum/client_type';
@@ -0,0 +1,12 @@
+-- Create "kyc_submissions" table
+CREATE TABLE "kyc_submissions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "user_id" bigint NOT NULL, "status" bigint NOT NULL, "documents" jsonb NOT NULL, "reviewer_id" bigint NULL, "reviewer_note" character varying NOT NULL DEFAULT '', "reviewed_at" timestamptz NULL, PRIMARY KEY ("id"));
+-- Create index "kycsubmission_status" to table: "kyc_submissions"
+CREATE INDEX "kycsubmission_status" ON "kyc_submissions" ("status");
+-- Create index "kycsubmission_user_id" to table: "kyc_submissions"
+CREATE INDEX "kycsubmission_user_id" ON "kyc_submissions" ("user_id");
+-- Set comment to table: "kyc_submissions"
+COMMENT ON TABLE "kyc_submissions" IS 'KYC submissions of the players and their reviews';
+-- Set comment to column: "status" on table: "kyc_submissions"
+COMMENT ON COLUMN "kyc_submissions"."status" IS 'pkg/enum/kyc_status';
+-- Set comment to column: "documents" on table: "kyc_submissions"
+COMMENT ON COLUMN "kyc_submissions"."documents" IS 'The object keys of the documents in the storage';
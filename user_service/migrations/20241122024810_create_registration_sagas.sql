@@ -0,0 +1,14 @@
+-- Create "registration_sagas" table
+CREATE TABLE "registration_sagas" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_at" timestamptz NOT NULL, "updated_at" timestamptz NOT NULL, "client_id" bigint NOT NULL, "idempotency_key" character varying NOT NULL, "user_id" bigint NOT NULL, "account" character varying NOT NULL, "email" character varying NOT NULL DEFAULT '', "country_code" character varying NOT NULL DEFAULT '', "mobile_number" character varying NOT NULL DEFAULT '', "status" bigint NOT NULL, "err_code" bigint NOT NULL DEFAULT 0, "err_message" character varying NOT NULL DEFAULT '', PRIMARY KEY ("id"));
+-- Create index "registrationsaga_client_id_idempotency_key" to table: "registration_sagas"
+CREATE UNIQUE INDEX "registrationsaga_client_id_idempotency_key" ON "registration_sagas" ("client_id", "idempotency_key");
+-- Create index "registrationsaga_status_updated_at" to table: "registration_sagas"
+CREATE INDEX "registrationsaga_status_updated_at" ON "registration_sagas" ("status", "updated_at");
+-- Set comment to table: "registration_sagas"
+COMMENT ON TABLE "registration_sagas" IS 'The steps of the registrations across the auth service and the user service';
+-- Set comment to column: "idempotency_key" on table: "registration_sagas"
+COMMENT ON COLUMN "registration_sagas"."idempotency_key" IS 'Sent by the client, the retries of the registration share the key';
+-- Set comment to column: "status" on table: "registration_sagas"
+COMMENT ON COLUMN "registration_sagas"."status" IS 'pkg/enum/registration_status';
+-- Set comment to column: "err_code" on table: "registration_sagas"
+COMMENT ON COLUMN "registration_sagas"."err_code" IS 'The cus_err code of the failed registration';
@@ -0,0 +1,6 @@
+-- Modify "registration_sagas" table
+ALTER TABLE "registration_sagas" ADD COLUMN "oauth_provider" bigint NOT NULL DEFAULT 0, ADD COLUMN "open_id" character varying NOT NULL DEFAULT '';
+-- Set comment to column: "oauth_provider" on table: "registration_sagas"
+COMMENT ON COLUMN "registration_sagas"."oauth_provider" IS 'pkg/enum/oauth_provider, 0 for the registration without the oauth account';
+-- Set comment to column: "open_id" on table: "registration_sagas"
+COMMENT ON COLUMN "registration_sagas"."open_id" IS 'The openID linked to the user when the profile is created';